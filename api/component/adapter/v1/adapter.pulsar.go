@@ -13,15 +13,63 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_2_list)(nil)
+
+type _Params_2_list struct {
+	list *[]string
+}
+
+func (x *_Params_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedCoexistingKeys as it is not of Message kind"))
+}
+
+func (x *_Params_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_Params                              protoreflect.MessageDescriptor
 	fd_Params_max_passthrough_payload_size protoreflect.FieldDescriptor
+	fd_Params_allowed_coexisting_keys      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_noble_orbiter_component_adapter_v1_adapter_proto_init()
 	md_Params = File_noble_orbiter_component_adapter_v1_adapter_proto.Messages().ByName("Params")
 	fd_Params_max_passthrough_payload_size = md_Params.Fields().ByName("max_passthrough_payload_size")
+	fd_Params_allowed_coexisting_keys = md_Params.Fields().ByName("allowed_coexisting_keys")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -95,6 +143,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.AllowedCoexistingKeys) != 0 {
+		value := protoreflect.ValueOfList(&_Params_2_list{list: &x.AllowedCoexistingKeys})
+		if !f(fd_Params_allowed_coexisting_keys, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -112,6 +166,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "noble.orbiter.component.adapter.v1.Params.max_passthrough_payload_size":
 		return x.MaxPassthroughPayloadSize != uint32(0)
+	case "noble.orbiter.component.adapter.v1.Params.allowed_coexisting_keys":
+		return len(x.AllowedCoexistingKeys) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.adapter.v1.Params"))
@@ -130,6 +186,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "noble.orbiter.component.adapter.v1.Params.max_passthrough_payload_size":
 		x.MaxPassthroughPayloadSize = uint32(0)
+	case "noble.orbiter.component.adapter.v1.Params.allowed_coexisting_keys":
+		x.AllowedCoexistingKeys = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.adapter.v1.Params"))
@@ -149,6 +207,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "noble.orbiter.component.adapter.v1.Params.max_passthrough_payload_size":
 		value := x.MaxPassthroughPayloadSize
 		return protoreflect.ValueOfUint32(value)
+	case "noble.orbiter.component.adapter.v1.Params.allowed_coexisting_keys":
+		if len(x.AllowedCoexistingKeys) == 0 {
+			return protoreflect.ValueOfList(&_Params_2_list{})
+		}
+		listValue := &_Params_2_list{list: &x.AllowedCoexistingKeys}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.adapter.v1.Params"))
@@ -171,6 +235,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "noble.orbiter.component.adapter.v1.Params.max_passthrough_payload_size":
 		x.MaxPassthroughPayloadSize = uint32(value.Uint())
+	case "noble.orbiter.component.adapter.v1.Params.allowed_coexisting_keys":
+		lv := value.List()
+		clv := lv.(*_Params_2_list)
+		x.AllowedCoexistingKeys = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.adapter.v1.Params"))
@@ -191,6 +259,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "noble.orbiter.component.adapter.v1.Params.allowed_coexisting_keys":
+		if x.AllowedCoexistingKeys == nil {
+			x.AllowedCoexistingKeys = []string{}
+		}
+		value := &_Params_2_list{list: &x.AllowedCoexistingKeys}
+		return protoreflect.ValueOfList(value)
 	case "noble.orbiter.component.adapter.v1.Params.max_passthrough_payload_size":
 		panic(fmt.Errorf("field max_passthrough_payload_size of message noble.orbiter.component.adapter.v1.Params is not mutable"))
 	default:
@@ -208,6 +282,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "noble.orbiter.component.adapter.v1.Params.max_passthrough_payload_size":
 		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.orbiter.component.adapter.v1.Params.allowed_coexisting_keys":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.adapter.v1.Params"))
@@ -280,6 +357,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.MaxPassthroughPayloadSize != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxPassthroughPayloadSize))
 		}
+		if len(x.AllowedCoexistingKeys) > 0 {
+			for _, s := range x.AllowedCoexistingKeys {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -309,6 +392,15 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AllowedCoexistingKeys) > 0 {
+			for iNdEx := len(x.AllowedCoexistingKeys) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedCoexistingKeys[iNdEx])
+				copy(dAtA[i:], x.AllowedCoexistingKeys[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedCoexistingKeys[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.MaxPassthroughPayloadSize != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxPassthroughPayloadSize))
 			i--
@@ -382,6 +474,38 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedCoexistingKeys", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedCoexistingKeys = append(x.AllowedCoexistingKeys, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -439,6 +563,11 @@ type Params struct {
 	// max_passthrough_payload_size is the maximum number of bytes
 	// of the passthrough payload that can be sent in a forwarding.
 	MaxPassthroughPayloadSize uint32 `protobuf:"varint,1,opt,name=max_passthrough_payload_size,json=maxPassthroughPayloadSize,proto3" json:"max_passthrough_payload_size,omitempty"`
+	// allowed_coexisting_keys is the list of root level keys of a JSON
+	// payload allowed alongside the orbiter key. These keys are not
+	// consumed by the module and are left to other payload consumers.
+	// If empty, only the orbiter key is allowed.
+	AllowedCoexistingKeys []string `protobuf:"bytes,2,rep,name=allowed_coexisting_keys,json=allowedCoexistingKeys,proto3" json:"allowed_coexisting_keys,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -468,6 +597,13 @@ func (x *Params) GetMaxPassthroughPayloadSize() uint32 {
 	return 0
 }
 
+func (x *Params) GetAllowedCoexistingKeys() []string {
+	if x != nil {
+		return x.AllowedCoexistingKeys
+	}
+	return nil
+}
+
 var File_noble_orbiter_component_adapter_v1_adapter_proto protoreflect.FileDescriptor
 
 var file_noble_orbiter_component_adapter_v1_adapter_proto_rawDesc = []byte{
@@ -477,32 +613,36 @@ var file_noble_orbiter_component_adapter_v1_adapter_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x22, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65,
 	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x64, 0x61, 0x70,
 	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d,
-	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x06, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x74,
-	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x19, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68,
-	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x42, 0xb8, 0x02, 0x0a, 0x26,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65,
-	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x64, 0x61, 0x70,
-	0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x53, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f,
-	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2f, 0x76,
-	0x31, 0x3b, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x04, 0x4e, 0x4f,
-	0x43, 0x41, 0xaa, 0x02, 0x22, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74,
-	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x61,
-	0x70, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x22, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
-	0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x5c, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2e, 0x4e,
-	0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5c, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5c, 0x56,
-	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x26,
-	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a,
-	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x41, 0x64, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x01, 0x0a, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x46, 0x0a, 0x1c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x17,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67,
+	0x4b, 0x65, 0x79, 0x73, 0x42, 0xb8, 0x02, 0x0a, 0x26, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42,
+	0x0c, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x53, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72,
+	0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f,
+	0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x04, 0x4e, 0x4f, 0x43, 0x41, 0xaa, 0x02, 0x22, 0x4e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x22, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x5c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5c, 0x41, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2e, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72,
+	0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5c,
+	0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x26, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a,
+	0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x3a, 0x3a, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,1535 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package dispatcherv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	v1 "github.com/noble-assets/orbiter/v2/api/core/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ForwardingFailure                 protoreflect.MessageDescriptor
+	fd_ForwardingFailure_index           protoreflect.FieldDescriptor
+	fd_ForwardingFailure_protocol_id     protoreflect.FieldDescriptor
+	fd_ForwardingFailure_counterparty_id protoreflect.FieldDescriptor
+	fd_ForwardingFailure_reason          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_orbiter_component_dispatcher_v1_events_proto_init()
+	md_ForwardingFailure = File_noble_orbiter_component_dispatcher_v1_events_proto.Messages().ByName("ForwardingFailure")
+	fd_ForwardingFailure_index = md_ForwardingFailure.Fields().ByName("index")
+	fd_ForwardingFailure_protocol_id = md_ForwardingFailure.Fields().ByName("protocol_id")
+	fd_ForwardingFailure_counterparty_id = md_ForwardingFailure.Fields().ByName("counterparty_id")
+	fd_ForwardingFailure_reason = md_ForwardingFailure.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardingFailure)(nil)
+
+type fastReflection_ForwardingFailure ForwardingFailure
+
+func (x *ForwardingFailure) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardingFailure)(x)
+}
+
+func (x *ForwardingFailure) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_orbiter_component_dispatcher_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardingFailure_messageType fastReflection_ForwardingFailure_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardingFailure_messageType{}
+
+type fastReflection_ForwardingFailure_messageType struct{}
+
+func (x fastReflection_ForwardingFailure_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardingFailure)(nil)
+}
+func (x fastReflection_ForwardingFailure_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardingFailure)
+}
+func (x fastReflection_ForwardingFailure_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardingFailure
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardingFailure) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardingFailure
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardingFailure) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardingFailure_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardingFailure) New() protoreflect.Message {
+	return new(fastReflection_ForwardingFailure)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardingFailure) Interface() protoreflect.ProtoMessage {
+	return (*ForwardingFailure)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardingFailure) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Index)
+		if !f(fd_ForwardingFailure_index, value) {
+			return
+		}
+	}
+	if x.ProtocolId != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ProtocolId))
+		if !f(fd_ForwardingFailure_protocol_id, value) {
+			return
+		}
+	}
+	if x.CounterpartyId != "" {
+		value := protoreflect.ValueOfString(x.CounterpartyId)
+		if !f(fd_ForwardingFailure_counterparty_id, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_ForwardingFailure_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardingFailure) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.index":
+		return x.Index != uint32(0)
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.protocol_id":
+		return x.ProtocolId != 0
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.counterparty_id":
+		return x.CounterpartyId != ""
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.ForwardingFailure"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.ForwardingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingFailure) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.index":
+		x.Index = uint32(0)
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.protocol_id":
+		x.ProtocolId = 0
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.counterparty_id":
+		x.CounterpartyId = ""
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.ForwardingFailure"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.ForwardingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardingFailure) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.index":
+		value := x.Index
+		return protoreflect.ValueOfUint32(value)
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.protocol_id":
+		value := x.ProtocolId
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.counterparty_id":
+		value := x.CounterpartyId
+		return protoreflect.ValueOfString(value)
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.ForwardingFailure"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.ForwardingFailure does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingFailure) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.index":
+		x.Index = uint32(value.Uint())
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.protocol_id":
+		x.ProtocolId = (v1.ProtocolID)(value.Enum())
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.counterparty_id":
+		x.CounterpartyId = value.Interface().(string)
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.ForwardingFailure"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.ForwardingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingFailure) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.index":
+		panic(fmt.Errorf("field index of message noble.orbiter.component.dispatcher.v1.ForwardingFailure is not mutable"))
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.protocol_id":
+		panic(fmt.Errorf("field protocol_id of message noble.orbiter.component.dispatcher.v1.ForwardingFailure is not mutable"))
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.counterparty_id":
+		panic(fmt.Errorf("field counterparty_id of message noble.orbiter.component.dispatcher.v1.ForwardingFailure is not mutable"))
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.reason":
+		panic(fmt.Errorf("field reason of message noble.orbiter.component.dispatcher.v1.ForwardingFailure is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.ForwardingFailure"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.ForwardingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardingFailure) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.protocol_id":
+		return protoreflect.ValueOfEnum(0)
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.counterparty_id":
+		return protoreflect.ValueOfString("")
+	case "noble.orbiter.component.dispatcher.v1.ForwardingFailure.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.ForwardingFailure"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.ForwardingFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardingFailure) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.component.dispatcher.v1.ForwardingFailure", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardingFailure) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingFailure) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardingFailure) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardingFailure) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardingFailure)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.ProtocolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProtocolId))
+		}
+		l = len(x.CounterpartyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardingFailure)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CounterpartyId) > 0 {
+			i -= len(x.CounterpartyId)
+			copy(dAtA[i:], x.CounterpartyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ProtocolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardingFailure)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardingFailure: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardingFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+				}
+				x.ProtocolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProtocolId |= v1.ProtocolID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CounterpartyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventFallbackForwardingUsed_4_list)(nil)
+
+type _EventFallbackForwardingUsed_4_list struct {
+	list *[]*ForwardingFailure
+}
+
+func (x *_EventFallbackForwardingUsed_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventFallbackForwardingUsed_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_EventFallbackForwardingUsed_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForwardingFailure)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventFallbackForwardingUsed_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForwardingFailure)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventFallbackForwardingUsed_4_list) AppendMutable() protoreflect.Value {
+	v := new(ForwardingFailure)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventFallbackForwardingUsed_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventFallbackForwardingUsed_4_list) NewElement() protoreflect.Value {
+	v := new(ForwardingFailure)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_EventFallbackForwardingUsed_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventFallbackForwardingUsed                 protoreflect.MessageDescriptor
+	fd_EventFallbackForwardingUsed_index           protoreflect.FieldDescriptor
+	fd_EventFallbackForwardingUsed_protocol_id     protoreflect.FieldDescriptor
+	fd_EventFallbackForwardingUsed_counterparty_id protoreflect.FieldDescriptor
+	fd_EventFallbackForwardingUsed_failures        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_orbiter_component_dispatcher_v1_events_proto_init()
+	md_EventFallbackForwardingUsed = File_noble_orbiter_component_dispatcher_v1_events_proto.Messages().ByName("EventFallbackForwardingUsed")
+	fd_EventFallbackForwardingUsed_index = md_EventFallbackForwardingUsed.Fields().ByName("index")
+	fd_EventFallbackForwardingUsed_protocol_id = md_EventFallbackForwardingUsed.Fields().ByName("protocol_id")
+	fd_EventFallbackForwardingUsed_counterparty_id = md_EventFallbackForwardingUsed.Fields().ByName("counterparty_id")
+	fd_EventFallbackForwardingUsed_failures = md_EventFallbackForwardingUsed.Fields().ByName("failures")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventFallbackForwardingUsed)(nil)
+
+type fastReflection_EventFallbackForwardingUsed EventFallbackForwardingUsed
+
+func (x *EventFallbackForwardingUsed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventFallbackForwardingUsed)(x)
+}
+
+func (x *EventFallbackForwardingUsed) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_orbiter_component_dispatcher_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventFallbackForwardingUsed_messageType fastReflection_EventFallbackForwardingUsed_messageType
+var _ protoreflect.MessageType = fastReflection_EventFallbackForwardingUsed_messageType{}
+
+type fastReflection_EventFallbackForwardingUsed_messageType struct{}
+
+func (x fastReflection_EventFallbackForwardingUsed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventFallbackForwardingUsed)(nil)
+}
+func (x fastReflection_EventFallbackForwardingUsed_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventFallbackForwardingUsed)
+}
+func (x fastReflection_EventFallbackForwardingUsed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFallbackForwardingUsed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventFallbackForwardingUsed) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventFallbackForwardingUsed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventFallbackForwardingUsed) Type() protoreflect.MessageType {
+	return _fastReflection_EventFallbackForwardingUsed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventFallbackForwardingUsed) New() protoreflect.Message {
+	return new(fastReflection_EventFallbackForwardingUsed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventFallbackForwardingUsed) Interface() protoreflect.ProtoMessage {
+	return (*EventFallbackForwardingUsed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventFallbackForwardingUsed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Index)
+		if !f(fd_EventFallbackForwardingUsed_index, value) {
+			return
+		}
+	}
+	if x.ProtocolId != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ProtocolId))
+		if !f(fd_EventFallbackForwardingUsed_protocol_id, value) {
+			return
+		}
+	}
+	if x.CounterpartyId != "" {
+		value := protoreflect.ValueOfString(x.CounterpartyId)
+		if !f(fd_EventFallbackForwardingUsed_counterparty_id, value) {
+			return
+		}
+	}
+	if len(x.Failures) != 0 {
+		value := protoreflect.ValueOfList(&_EventFallbackForwardingUsed_4_list{list: &x.Failures})
+		if !f(fd_EventFallbackForwardingUsed_failures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventFallbackForwardingUsed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.index":
+		return x.Index != uint32(0)
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.protocol_id":
+		return x.ProtocolId != 0
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.counterparty_id":
+		return x.CounterpartyId != ""
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.failures":
+		return len(x.Failures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFallbackForwardingUsed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.index":
+		x.Index = uint32(0)
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.protocol_id":
+		x.ProtocolId = 0
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.counterparty_id":
+		x.CounterpartyId = ""
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.failures":
+		x.Failures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventFallbackForwardingUsed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.index":
+		value := x.Index
+		return protoreflect.ValueOfUint32(value)
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.protocol_id":
+		value := x.ProtocolId
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.counterparty_id":
+		value := x.CounterpartyId
+		return protoreflect.ValueOfString(value)
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.failures":
+		if len(x.Failures) == 0 {
+			return protoreflect.ValueOfList(&_EventFallbackForwardingUsed_4_list{})
+		}
+		listValue := &_EventFallbackForwardingUsed_4_list{list: &x.Failures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFallbackForwardingUsed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.index":
+		x.Index = uint32(value.Uint())
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.protocol_id":
+		x.ProtocolId = (v1.ProtocolID)(value.Enum())
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.counterparty_id":
+		x.CounterpartyId = value.Interface().(string)
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.failures":
+		lv := value.List()
+		clv := lv.(*_EventFallbackForwardingUsed_4_list)
+		x.Failures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFallbackForwardingUsed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.failures":
+		if x.Failures == nil {
+			x.Failures = []*ForwardingFailure{}
+		}
+		value := &_EventFallbackForwardingUsed_4_list{list: &x.Failures}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.index":
+		panic(fmt.Errorf("field index of message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed is not mutable"))
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.protocol_id":
+		panic(fmt.Errorf("field protocol_id of message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed is not mutable"))
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.counterparty_id":
+		panic(fmt.Errorf("field counterparty_id of message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventFallbackForwardingUsed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.protocol_id":
+		return protoreflect.ValueOfEnum(0)
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.counterparty_id":
+		return protoreflect.ValueOfString("")
+	case "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.failures":
+		list := []*ForwardingFailure{}
+		return protoreflect.ValueOfList(&_EventFallbackForwardingUsed_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventFallbackForwardingUsed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventFallbackForwardingUsed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventFallbackForwardingUsed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventFallbackForwardingUsed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventFallbackForwardingUsed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventFallbackForwardingUsed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		if x.ProtocolId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProtocolId))
+		}
+		l = len(x.CounterpartyId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Failures) > 0 {
+			for _, e := range x.Failures {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventFallbackForwardingUsed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Failures) > 0 {
+			for iNdEx := len(x.Failures) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Failures[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.CounterpartyId) > 0 {
+			i -= len(x.CounterpartyId)
+			copy(dAtA[i:], x.CounterpartyId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CounterpartyId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ProtocolId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProtocolId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventFallbackForwardingUsed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFallbackForwardingUsed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventFallbackForwardingUsed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+				}
+				x.ProtocolId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProtocolId |= v1.ProtocolID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CounterpartyId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Failures = append(x.Failures, &ForwardingFailure{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Failures[len(x.Failures)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/orbiter/component/dispatcher/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ForwardingFailure represents a forwarding of a payload
+// whose dispatch failed.
+type ForwardingFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the position of the forwarding in the dispatch order, where 0
+	// is the payload forwarding and i is the i-th fallback forwarding.
+	Index          uint32        `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ProtocolId     v1.ProtocolID `protobuf:"varint,2,opt,name=protocol_id,json=protocolId,proto3,enum=noble.orbiter.core.v1.ProtocolID" json:"protocol_id,omitempty"`
+	CounterpartyId string        `protobuf:"bytes,3,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// reason is the error returned by the forwarding dispatch.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ForwardingFailure) Reset() {
+	*x = ForwardingFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_component_dispatcher_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardingFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardingFailure) ProtoMessage() {}
+
+// Deprecated: Use ForwardingFailure.ProtoReflect.Descriptor instead.
+func (*ForwardingFailure) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ForwardingFailure) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ForwardingFailure) GetProtocolId() v1.ProtocolID {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return v1.ProtocolID(0)
+}
+
+func (x *ForwardingFailure) GetCounterpartyId() string {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return ""
+}
+
+func (x *ForwardingFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventFallbackForwardingUsed is emitted when the payload forwarding fails
+// and one of the fallback forwardings is dispatched instead.
+type EventFallbackForwardingUsed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index is the position of the forwarding used in the dispatch order,
+	// where i is the i-th fallback forwarding.
+	Index          uint32        `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ProtocolId     v1.ProtocolID `protobuf:"varint,2,opt,name=protocol_id,json=protocolId,proto3,enum=noble.orbiter.core.v1.ProtocolID" json:"protocol_id,omitempty"`
+	CounterpartyId string        `protobuf:"bytes,3,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// failures are the forwardings tried before the one used.
+	Failures []*ForwardingFailure `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *EventFallbackForwardingUsed) Reset() {
+	*x = EventFallbackForwardingUsed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_component_dispatcher_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventFallbackForwardingUsed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventFallbackForwardingUsed) ProtoMessage() {}
+
+// Deprecated: Use EventFallbackForwardingUsed.ProtoReflect.Descriptor instead.
+func (*EventFallbackForwardingUsed) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventFallbackForwardingUsed) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *EventFallbackForwardingUsed) GetProtocolId() v1.ProtocolID {
+	if x != nil {
+		return x.ProtocolId
+	}
+	return v1.ProtocolID(0)
+}
+
+func (x *EventFallbackForwardingUsed) GetCounterpartyId() string {
+	if x != nil {
+		return x.CounterpartyId
+	}
+	return ""
+}
+
+func (x *EventFallbackForwardingUsed) GetFailures() []*ForwardingFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+var File_noble_orbiter_component_dispatcher_v1_events_proto protoreflect.FileDescriptor
+
+var file_noble_orbiter_component_dispatcher_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x32, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x25, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69,
+	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69,
+	0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
+	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69,
+	0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x64, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x01, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x42, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81, 0x02, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x42, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x49, 0x44, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
+	0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x42, 0xcc, 0x02, 0x0a, 0x29, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x59, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f,
+	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x76,
+	0x31, 0xa2, 0x02, 0x04, 0x4e, 0x4f, 0x43, 0x44, 0xaa, 0x02, 0x25, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x25, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x5c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5c, 0x44, 0x69, 0x73, 0x70, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x31, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
+	0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x5c, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x29, 0x4e,
+	0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescOnce sync.Once
+	file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescData = file_noble_orbiter_component_dispatcher_v1_events_proto_rawDesc
+)
+
+func file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescGZIP() []byte {
+	file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescOnce.Do(func() {
+		file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescData)
+	})
+	return file_noble_orbiter_component_dispatcher_v1_events_proto_rawDescData
+}
+
+var file_noble_orbiter_component_dispatcher_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_noble_orbiter_component_dispatcher_v1_events_proto_goTypes = []interface{}{
+	(*ForwardingFailure)(nil),           // 0: noble.orbiter.component.dispatcher.v1.ForwardingFailure
+	(*EventFallbackForwardingUsed)(nil), // 1: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed
+	(v1.ProtocolID)(0),                  // 2: noble.orbiter.core.v1.ProtocolID
+}
+var file_noble_orbiter_component_dispatcher_v1_events_proto_depIdxs = []int32{
+	2, // 0: noble.orbiter.component.dispatcher.v1.ForwardingFailure.protocol_id:type_name -> noble.orbiter.core.v1.ProtocolID
+	2, // 1: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.protocol_id:type_name -> noble.orbiter.core.v1.ProtocolID
+	0, // 2: noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed.failures:type_name -> noble.orbiter.component.dispatcher.v1.ForwardingFailure
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_noble_orbiter_component_dispatcher_v1_events_proto_init() }
+func file_noble_orbiter_component_dispatcher_v1_events_proto_init() {
+	if File_noble_orbiter_component_dispatcher_v1_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_noble_orbiter_component_dispatcher_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardingFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_orbiter_component_dispatcher_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventFallbackForwardingUsed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_noble_orbiter_component_dispatcher_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_noble_orbiter_component_dispatcher_v1_events_proto_goTypes,
+		DependencyIndexes: file_noble_orbiter_component_dispatcher_v1_events_proto_depIdxs,
+		MessageInfos:      file_noble_orbiter_component_dispatcher_v1_events_proto_msgTypes,
+	}.Build()
+	File_noble_orbiter_component_dispatcher_v1_events_proto = out.File
+	file_noble_orbiter_component_dispatcher_v1_events_proto_rawDesc = nil
+	file_noble_orbiter_component_dispatcher_v1_events_proto_goTypes = nil
+	file_noble_orbiter_component_dispatcher_v1_events_proto_depIdxs = nil
+}
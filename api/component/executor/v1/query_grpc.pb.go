@@ -19,8 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_PausedActions_FullMethodName  = "/noble.orbiter.component.executor.v1.Query/PausedActions"
-	Query_IsActionPaused_FullMethodName = "/noble.orbiter.component.executor.v1.Query/IsActionPaused"
+	Query_PausedActions_FullMethodName      = "/noble.orbiter.component.executor.v1.Query/PausedActions"
+	Query_IsActionPaused_FullMethodName     = "/noble.orbiter.component.executor.v1.Query/IsActionPaused"
+	Query_RouteFees_FullMethodName          = "/noble.orbiter.component.executor.v1.Query/RouteFees"
+	Query_RouteFee_FullMethodName           = "/noble.orbiter.component.executor.v1.Query/RouteFee"
+	Query_RouteFeesCollected_FullMethodName = "/noble.orbiter.component.executor.v1.Query/RouteFeesCollected"
+	Query_RouteFeeCollected_FullMethodName  = "/noble.orbiter.component.executor.v1.Query/RouteFeeCollected"
+	Query_Integrators_FullMethodName        = "/noble.orbiter.component.executor.v1.Query/Integrators"
+	Query_Integrator_FullMethodName         = "/noble.orbiter.component.executor.v1.Query/Integrator"
+	Query_IntegratorStats_FullMethodName    = "/noble.orbiter.component.executor.v1.Query/IntegratorStats"
 )
 
 // QueryClient is the client API for Query service.
@@ -32,6 +39,20 @@ const (
 type QueryClient interface {
 	PausedActions(ctx context.Context, in *QueryPausedActionsRequest, opts ...grpc.CallOption) (*QueryPausedActionsResponse, error)
 	IsActionPaused(ctx context.Context, in *QueryIsActionPausedRequest, opts ...grpc.CallOption) (*QueryIsActionPausedResponse, error)
+	// RouteFees retrieves all the route fees.
+	RouteFees(ctx context.Context, in *QueryRouteFeesRequest, opts ...grpc.CallOption) (*QueryRouteFeesResponse, error)
+	// RouteFee retrieves the fee of a route.
+	RouteFee(ctx context.Context, in *QueryRouteFeeRequest, opts ...grpc.CallOption) (*QueryRouteFeeResponse, error)
+	// RouteFeesCollected retrieves the total fees collected for all the routes.
+	RouteFeesCollected(ctx context.Context, in *QueryRouteFeesCollectedRequest, opts ...grpc.CallOption) (*QueryRouteFeesCollectedResponse, error)
+	// RouteFeeCollected retrieves the total fee collected for a route.
+	RouteFeeCollected(ctx context.Context, in *QueryRouteFeeCollectedRequest, opts ...grpc.CallOption) (*QueryRouteFeeCollectedResponse, error)
+	// Integrators retrieves all the registered integrators.
+	Integrators(ctx context.Context, in *QueryIntegratorsRequest, opts ...grpc.CallOption) (*QueryIntegratorsResponse, error)
+	// Integrator retrieves a registered integrator.
+	Integrator(ctx context.Context, in *QueryIntegratorRequest, opts ...grpc.CallOption) (*QueryIntegratorResponse, error)
+	// IntegratorStats retrieves the volume stats of an integrator per denom.
+	IntegratorStats(ctx context.Context, in *QueryIntegratorStatsRequest, opts ...grpc.CallOption) (*QueryIntegratorStatsResponse, error)
 }
 
 type queryClient struct {
@@ -62,6 +83,76 @@ func (c *queryClient) IsActionPaused(ctx context.Context, in *QueryIsActionPause
 	return out, nil
 }
 
+func (c *queryClient) RouteFees(ctx context.Context, in *QueryRouteFeesRequest, opts ...grpc.CallOption) (*QueryRouteFeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRouteFeesResponse)
+	err := c.cc.Invoke(ctx, Query_RouteFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RouteFee(ctx context.Context, in *QueryRouteFeeRequest, opts ...grpc.CallOption) (*QueryRouteFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRouteFeeResponse)
+	err := c.cc.Invoke(ctx, Query_RouteFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RouteFeesCollected(ctx context.Context, in *QueryRouteFeesCollectedRequest, opts ...grpc.CallOption) (*QueryRouteFeesCollectedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRouteFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, Query_RouteFeesCollected_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RouteFeeCollected(ctx context.Context, in *QueryRouteFeeCollectedRequest, opts ...grpc.CallOption) (*QueryRouteFeeCollectedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRouteFeeCollectedResponse)
+	err := c.cc.Invoke(ctx, Query_RouteFeeCollected_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Integrators(ctx context.Context, in *QueryIntegratorsRequest, opts ...grpc.CallOption) (*QueryIntegratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIntegratorsResponse)
+	err := c.cc.Invoke(ctx, Query_Integrators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Integrator(ctx context.Context, in *QueryIntegratorRequest, opts ...grpc.CallOption) (*QueryIntegratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIntegratorResponse)
+	err := c.cc.Invoke(ctx, Query_Integrator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegratorStats(ctx context.Context, in *QueryIntegratorStatsRequest, opts ...grpc.CallOption) (*QueryIntegratorStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryIntegratorStatsResponse)
+	err := c.cc.Invoke(ctx, Query_IntegratorStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -71,6 +162,20 @@ func (c *queryClient) IsActionPaused(ctx context.Context, in *QueryIsActionPause
 type QueryServer interface {
 	PausedActions(context.Context, *QueryPausedActionsRequest) (*QueryPausedActionsResponse, error)
 	IsActionPaused(context.Context, *QueryIsActionPausedRequest) (*QueryIsActionPausedResponse, error)
+	// RouteFees retrieves all the route fees.
+	RouteFees(context.Context, *QueryRouteFeesRequest) (*QueryRouteFeesResponse, error)
+	// RouteFee retrieves the fee of a route.
+	RouteFee(context.Context, *QueryRouteFeeRequest) (*QueryRouteFeeResponse, error)
+	// RouteFeesCollected retrieves the total fees collected for all the routes.
+	RouteFeesCollected(context.Context, *QueryRouteFeesCollectedRequest) (*QueryRouteFeesCollectedResponse, error)
+	// RouteFeeCollected retrieves the total fee collected for a route.
+	RouteFeeCollected(context.Context, *QueryRouteFeeCollectedRequest) (*QueryRouteFeeCollectedResponse, error)
+	// Integrators retrieves all the registered integrators.
+	Integrators(context.Context, *QueryIntegratorsRequest) (*QueryIntegratorsResponse, error)
+	// Integrator retrieves a registered integrator.
+	Integrator(context.Context, *QueryIntegratorRequest) (*QueryIntegratorResponse, error)
+	// IntegratorStats retrieves the volume stats of an integrator per denom.
+	IntegratorStats(context.Context, *QueryIntegratorStatsRequest) (*QueryIntegratorStatsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -87,6 +192,27 @@ func (UnimplementedQueryServer) PausedActions(context.Context, *QueryPausedActio
 func (UnimplementedQueryServer) IsActionPaused(context.Context, *QueryIsActionPausedRequest) (*QueryIsActionPausedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsActionPaused not implemented")
 }
+func (UnimplementedQueryServer) RouteFees(context.Context, *QueryRouteFeesRequest) (*QueryRouteFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteFees not implemented")
+}
+func (UnimplementedQueryServer) RouteFee(context.Context, *QueryRouteFeeRequest) (*QueryRouteFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteFee not implemented")
+}
+func (UnimplementedQueryServer) RouteFeesCollected(context.Context, *QueryRouteFeesCollectedRequest) (*QueryRouteFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteFeesCollected not implemented")
+}
+func (UnimplementedQueryServer) RouteFeeCollected(context.Context, *QueryRouteFeeCollectedRequest) (*QueryRouteFeeCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteFeeCollected not implemented")
+}
+func (UnimplementedQueryServer) Integrators(context.Context, *QueryIntegratorsRequest) (*QueryIntegratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integrators not implemented")
+}
+func (UnimplementedQueryServer) Integrator(context.Context, *QueryIntegratorRequest) (*QueryIntegratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integrator not implemented")
+}
+func (UnimplementedQueryServer) IntegratorStats(context.Context, *QueryIntegratorStatsRequest) (*QueryIntegratorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegratorStats not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -144,6 +270,132 @@ func _Query_IsActionPaused_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RouteFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RouteFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteFees(ctx, req.(*QueryRouteFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RouteFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteFee(ctx, req.(*QueryRouteFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RouteFeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteFeesCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteFeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RouteFeesCollected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteFeesCollected(ctx, req.(*QueryRouteFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RouteFeeCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteFeeCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteFeeCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RouteFeeCollected_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteFeeCollected(ctx, req.(*QueryRouteFeeCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Integrators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Integrators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Integrators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Integrators(ctx, req.(*QueryIntegratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Integrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Integrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Integrator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Integrator(ctx, req.(*QueryIntegratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegratorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegratorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegratorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_IntegratorStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegratorStats(ctx, req.(*QueryIntegratorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -159,6 +411,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsActionPaused",
 			Handler:    _Query_IsActionPaused_Handler,
 		},
+		{
+			MethodName: "RouteFees",
+			Handler:    _Query_RouteFees_Handler,
+		},
+		{
+			MethodName: "RouteFee",
+			Handler:    _Query_RouteFee_Handler,
+		},
+		{
+			MethodName: "RouteFeesCollected",
+			Handler:    _Query_RouteFeesCollected_Handler,
+		},
+		{
+			MethodName: "RouteFeeCollected",
+			Handler:    _Query_RouteFeeCollected_Handler,
+		},
+		{
+			MethodName: "Integrators",
+			Handler:    _Query_Integrators_Handler,
+		},
+		{
+			MethodName: "Integrator",
+			Handler:    _Query_Integrator_Handler,
+		},
+		{
+			MethodName: "IntegratorStats",
+			Handler:    _Query_IntegratorStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/orbiter/component/executor/v1/query.proto",
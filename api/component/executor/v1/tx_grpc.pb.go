@@ -19,8 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_PauseAction_FullMethodName   = "/noble.orbiter.component.executor.v1.Msg/PauseAction"
-	Msg_UnpauseAction_FullMethodName = "/noble.orbiter.component.executor.v1.Msg/UnpauseAction"
+	Msg_PauseAction_FullMethodName      = "/noble.orbiter.component.executor.v1.Msg/PauseAction"
+	Msg_UnpauseAction_FullMethodName    = "/noble.orbiter.component.executor.v1.Msg/UnpauseAction"
+	Msg_SetRouteFee_FullMethodName      = "/noble.orbiter.component.executor.v1.Msg/SetRouteFee"
+	Msg_RemoveRouteFee_FullMethodName   = "/noble.orbiter.component.executor.v1.Msg/RemoveRouteFee"
+	Msg_SetIntegrator_FullMethodName    = "/noble.orbiter.component.executor.v1.Msg/SetIntegrator"
+	Msg_RemoveIntegrator_FullMethodName = "/noble.orbiter.component.executor.v1.Msg/RemoveIntegrator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +37,14 @@ type MsgClient interface {
 	PauseAction(ctx context.Context, in *MsgPauseAction, opts ...grpc.CallOption) (*MsgPauseActionResponse, error)
 	// UnpauseAction resumes a specific action.
 	UnpauseAction(ctx context.Context, in *MsgUnpauseAction, opts ...grpc.CallOption) (*MsgUnpauseActionResponse, error)
+	// SetRouteFee sets the fee applied to the transfers of a route.
+	SetRouteFee(ctx context.Context, in *MsgSetRouteFee, opts ...grpc.CallOption) (*MsgSetRouteFeeResponse, error)
+	// RemoveRouteFee removes the fee applied to the transfers of a route.
+	RemoveRouteFee(ctx context.Context, in *MsgRemoveRouteFee, opts ...grpc.CallOption) (*MsgRemoveRouteFeeResponse, error)
+	// SetIntegrator registers or updates an integrator.
+	SetIntegrator(ctx context.Context, in *MsgSetIntegrator, opts ...grpc.CallOption) (*MsgSetIntegratorResponse, error)
+	// RemoveIntegrator removes a registered integrator.
+	RemoveIntegrator(ctx context.Context, in *MsgRemoveIntegrator, opts ...grpc.CallOption) (*MsgRemoveIntegratorResponse, error)
 }
 
 type msgClient struct {
@@ -63,6 +75,46 @@ func (c *msgClient) UnpauseAction(ctx context.Context, in *MsgUnpauseAction, opt
 	return out, nil
 }
 
+func (c *msgClient) SetRouteFee(ctx context.Context, in *MsgSetRouteFee, opts ...grpc.CallOption) (*MsgSetRouteFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRouteFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRouteFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRouteFee(ctx context.Context, in *MsgRemoveRouteFee, opts ...grpc.CallOption) (*MsgRemoveRouteFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveRouteFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveRouteFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIntegrator(ctx context.Context, in *MsgSetIntegrator, opts ...grpc.CallOption) (*MsgSetIntegratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetIntegratorResponse)
+	err := c.cc.Invoke(ctx, Msg_SetIntegrator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveIntegrator(ctx context.Context, in *MsgRemoveIntegrator, opts ...grpc.CallOption) (*MsgRemoveIntegratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveIntegratorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveIntegrator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -73,6 +125,14 @@ type MsgServer interface {
 	PauseAction(context.Context, *MsgPauseAction) (*MsgPauseActionResponse, error)
 	// UnpauseAction resumes a specific action.
 	UnpauseAction(context.Context, *MsgUnpauseAction) (*MsgUnpauseActionResponse, error)
+	// SetRouteFee sets the fee applied to the transfers of a route.
+	SetRouteFee(context.Context, *MsgSetRouteFee) (*MsgSetRouteFeeResponse, error)
+	// RemoveRouteFee removes the fee applied to the transfers of a route.
+	RemoveRouteFee(context.Context, *MsgRemoveRouteFee) (*MsgRemoveRouteFeeResponse, error)
+	// SetIntegrator registers or updates an integrator.
+	SetIntegrator(context.Context, *MsgSetIntegrator) (*MsgSetIntegratorResponse, error)
+	// RemoveIntegrator removes a registered integrator.
+	RemoveIntegrator(context.Context, *MsgRemoveIntegrator) (*MsgRemoveIntegratorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -89,6 +149,18 @@ func (UnimplementedMsgServer) PauseAction(context.Context, *MsgPauseAction) (*Ms
 func (UnimplementedMsgServer) UnpauseAction(context.Context, *MsgUnpauseAction) (*MsgUnpauseActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnpauseAction not implemented")
 }
+func (UnimplementedMsgServer) SetRouteFee(context.Context, *MsgSetRouteFee) (*MsgSetRouteFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRouteFee not implemented")
+}
+func (UnimplementedMsgServer) RemoveRouteFee(context.Context, *MsgRemoveRouteFee) (*MsgRemoveRouteFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRouteFee not implemented")
+}
+func (UnimplementedMsgServer) SetIntegrator(context.Context, *MsgSetIntegrator) (*MsgSetIntegratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIntegrator not implemented")
+}
+func (UnimplementedMsgServer) RemoveIntegrator(context.Context, *MsgRemoveIntegrator) (*MsgRemoveIntegratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveIntegrator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -146,6 +218,78 @@ func _Msg_UnpauseAction_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRouteFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRouteFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRouteFee(ctx, req.(*MsgSetRouteFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRouteFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveRouteFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRouteFee(ctx, req.(*MsgRemoveRouteFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIntegrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIntegrator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIntegrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetIntegrator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIntegrator(ctx, req.(*MsgSetIntegrator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveIntegrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveIntegrator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveIntegrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveIntegrator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveIntegrator(ctx, req.(*MsgRemoveIntegrator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +305,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnpauseAction",
 			Handler:    _Msg_UnpauseAction_Handler,
 		},
+		{
+			MethodName: "SetRouteFee",
+			Handler:    _Msg_SetRouteFee_Handler,
+		},
+		{
+			MethodName: "RemoveRouteFee",
+			Handler:    _Msg_RemoveRouteFee_Handler,
+		},
+		{
+			MethodName: "SetIntegrator",
+			Handler:    _Msg_SetIntegrator_Handler,
+		},
+		{
+			MethodName: "RemoveIntegrator",
+			Handler:    _Msg_RemoveIntegrator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/orbiter/component/executor/v1/tx.proto",
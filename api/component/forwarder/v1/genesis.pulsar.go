@@ -5,6 +5,7 @@ import (
 	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	v1 "github.com/noble-assets/orbiter/v2/api/core/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -111,10 +112,378 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*InFlightPacket
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InFlightPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InFlightPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(InFlightPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(InFlightPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*EscrowedPacket
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EscrowedPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EscrowedPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(EscrowedPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(EscrowedPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*HyperlaneTokenRoute
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HyperlaneTokenRoute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HyperlaneTokenRoute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(HyperlaneTokenRoute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(HyperlaneTokenRoute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*DeferredPacket
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DeferredPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DeferredPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(DeferredPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(DeferredPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*AmountLimit
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AmountLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AmountLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(AmountLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(AmountLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*HeldBatchEntry
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HeldBatchEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*HeldBatchEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(HeldBatchEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(HeldBatchEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*EscrowedPacket
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EscrowedPacket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EscrowedPacket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(EscrowedPacket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(EscrowedPacket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                        protoreflect.MessageDescriptor
-	fd_GenesisState_paused_protocol_ids    protoreflect.FieldDescriptor
-	fd_GenesisState_paused_cross_chain_ids protoreflect.FieldDescriptor
+	md_GenesisState                             protoreflect.MessageDescriptor
+	fd_GenesisState_paused_protocol_ids         protoreflect.FieldDescriptor
+	fd_GenesisState_paused_cross_chain_ids      protoreflect.FieldDescriptor
+	fd_GenesisState_in_flight_packets           protoreflect.FieldDescriptor
+	fd_GenesisState_params                      protoreflect.FieldDescriptor
+	fd_GenesisState_escrowed_packets            protoreflect.FieldDescriptor
+	fd_GenesisState_escrowed_packets_sequence   protoreflect.FieldDescriptor
+	fd_GenesisState_hyperlane_token_routes      protoreflect.FieldDescriptor
+	fd_GenesisState_deferred_packets            protoreflect.FieldDescriptor
+	fd_GenesisState_deferred_packets_sequence   protoreflect.FieldDescriptor
+	fd_GenesisState_amount_limits               protoreflect.FieldDescriptor
+	fd_GenesisState_held_batch_entries          protoreflect.FieldDescriptor
+	fd_GenesisState_held_batch_entries_sequence protoreflect.FieldDescriptor
+	fd_GenesisState_held_escrowed_packets       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -122,6 +491,17 @@ func init() {
 	md_GenesisState = File_noble_orbiter_component_forwarder_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_paused_protocol_ids = md_GenesisState.Fields().ByName("paused_protocol_ids")
 	fd_GenesisState_paused_cross_chain_ids = md_GenesisState.Fields().ByName("paused_cross_chain_ids")
+	fd_GenesisState_in_flight_packets = md_GenesisState.Fields().ByName("in_flight_packets")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_escrowed_packets = md_GenesisState.Fields().ByName("escrowed_packets")
+	fd_GenesisState_escrowed_packets_sequence = md_GenesisState.Fields().ByName("escrowed_packets_sequence")
+	fd_GenesisState_hyperlane_token_routes = md_GenesisState.Fields().ByName("hyperlane_token_routes")
+	fd_GenesisState_deferred_packets = md_GenesisState.Fields().ByName("deferred_packets")
+	fd_GenesisState_deferred_packets_sequence = md_GenesisState.Fields().ByName("deferred_packets_sequence")
+	fd_GenesisState_amount_limits = md_GenesisState.Fields().ByName("amount_limits")
+	fd_GenesisState_held_batch_entries = md_GenesisState.Fields().ByName("held_batch_entries")
+	fd_GenesisState_held_batch_entries_sequence = md_GenesisState.Fields().ByName("held_batch_entries_sequence")
+	fd_GenesisState_held_escrowed_packets = md_GenesisState.Fields().ByName("held_escrowed_packets")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -201,10 +581,76 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
-}
-
-// Has reports whether a field is populated.
-//
+	if len(x.InFlightPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.InFlightPackets})
+		if !f(fd_GenesisState_in_flight_packets, value) {
+			return
+		}
+	}
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.EscrowedPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.EscrowedPackets})
+		if !f(fd_GenesisState_escrowed_packets, value) {
+			return
+		}
+	}
+	if x.EscrowedPacketsSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EscrowedPacketsSequence)
+		if !f(fd_GenesisState_escrowed_packets_sequence, value) {
+			return
+		}
+	}
+	if len(x.HyperlaneTokenRoutes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.HyperlaneTokenRoutes})
+		if !f(fd_GenesisState_hyperlane_token_routes, value) {
+			return
+		}
+	}
+	if len(x.DeferredPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.DeferredPackets})
+		if !f(fd_GenesisState_deferred_packets, value) {
+			return
+		}
+	}
+	if x.DeferredPacketsSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DeferredPacketsSequence)
+		if !f(fd_GenesisState_deferred_packets_sequence, value) {
+			return
+		}
+	}
+	if len(x.AmountLimits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.AmountLimits})
+		if !f(fd_GenesisState_amount_limits, value) {
+			return
+		}
+	}
+	if len(x.HeldBatchEntries) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.HeldBatchEntries})
+		if !f(fd_GenesisState_held_batch_entries, value) {
+			return
+		}
+	}
+	if x.HeldBatchEntriesSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HeldBatchEntriesSequence)
+		if !f(fd_GenesisState_held_batch_entries_sequence, value) {
+			return
+		}
+	}
+	if len(x.HeldEscrowedPackets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.HeldEscrowedPackets})
+		if !f(fd_GenesisState_held_escrowed_packets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
 // Some fields have the property of nullability where it is possible to
 // distinguish between the default value of a field and whether the field
 // was explicitly populated with the default value. Singular message fields,
@@ -220,6 +666,28 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.PausedProtocolIds) != 0
 	case "noble.orbiter.component.forwarder.v1.GenesisState.paused_cross_chain_ids":
 		return len(x.PausedCrossChainIds) != 0
+	case "noble.orbiter.component.forwarder.v1.GenesisState.in_flight_packets":
+		return len(x.InFlightPackets) != 0
+	case "noble.orbiter.component.forwarder.v1.GenesisState.params":
+		return x.Params != nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets":
+		return len(x.EscrowedPackets) != 0
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets_sequence":
+		return x.EscrowedPacketsSequence != uint64(0)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.hyperlane_token_routes":
+		return len(x.HyperlaneTokenRoutes) != 0
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets":
+		return len(x.DeferredPackets) != 0
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets_sequence":
+		return x.DeferredPacketsSequence != uint64(0)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.amount_limits":
+		return len(x.AmountLimits) != 0
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries":
+		return len(x.HeldBatchEntries) != 0
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries_sequence":
+		return x.HeldBatchEntriesSequence != uint64(0)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_escrowed_packets":
+		return len(x.HeldEscrowedPackets) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.forwarder.v1.GenesisState"))
@@ -240,6 +708,28 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.PausedProtocolIds = nil
 	case "noble.orbiter.component.forwarder.v1.GenesisState.paused_cross_chain_ids":
 		x.PausedCrossChainIds = nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.in_flight_packets":
+		x.InFlightPackets = nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.params":
+		x.Params = nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets":
+		x.EscrowedPackets = nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets_sequence":
+		x.EscrowedPacketsSequence = uint64(0)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.hyperlane_token_routes":
+		x.HyperlaneTokenRoutes = nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets":
+		x.DeferredPackets = nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets_sequence":
+		x.DeferredPacketsSequence = uint64(0)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.amount_limits":
+		x.AmountLimits = nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries":
+		x.HeldBatchEntries = nil
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries_sequence":
+		x.HeldBatchEntriesSequence = uint64(0)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_escrowed_packets":
+		x.HeldEscrowedPackets = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.forwarder.v1.GenesisState"))
@@ -268,6 +758,60 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_2_list{list: &x.PausedCrossChainIds}
 		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.in_flight_packets":
+		if len(x.InFlightPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.InFlightPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets":
+		if len(x.EscrowedPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.EscrowedPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets_sequence":
+		value := x.EscrowedPacketsSequence
+		return protoreflect.ValueOfUint64(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.hyperlane_token_routes":
+		if len(x.HyperlaneTokenRoutes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.HyperlaneTokenRoutes}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets":
+		if len(x.DeferredPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.DeferredPackets}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets_sequence":
+		value := x.DeferredPacketsSequence
+		return protoreflect.ValueOfUint64(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.amount_limits":
+		if len(x.AmountLimits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.AmountLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries":
+		if len(x.HeldBatchEntries) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.HeldBatchEntries}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries_sequence":
+		value := x.HeldBatchEntriesSequence
+		return protoreflect.ValueOfUint64(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_escrowed_packets":
+		if len(x.HeldEscrowedPackets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.HeldEscrowedPackets}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.forwarder.v1.GenesisState"))
@@ -296,6 +840,42 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_2_list)
 		x.PausedCrossChainIds = *clv.list
+	case "noble.orbiter.component.forwarder.v1.GenesisState.in_flight_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.InFlightPackets = *clv.list
+	case "noble.orbiter.component.forwarder.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.EscrowedPackets = *clv.list
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets_sequence":
+		x.EscrowedPacketsSequence = value.Uint()
+	case "noble.orbiter.component.forwarder.v1.GenesisState.hyperlane_token_routes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.HyperlaneTokenRoutes = *clv.list
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.DeferredPackets = *clv.list
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets_sequence":
+		x.DeferredPacketsSequence = value.Uint()
+	case "noble.orbiter.component.forwarder.v1.GenesisState.amount_limits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.AmountLimits = *clv.list
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.HeldBatchEntries = *clv.list
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries_sequence":
+		x.HeldBatchEntriesSequence = value.Uint()
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_escrowed_packets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.HeldEscrowedPackets = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.forwarder.v1.GenesisState"))
@@ -328,6 +908,59 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.PausedCrossChainIds}
 		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.in_flight_packets":
+		if x.InFlightPackets == nil {
+			x.InFlightPackets = []*InFlightPacket{}
+		}
+		value := &_GenesisState_3_list{list: &x.InFlightPackets}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets":
+		if x.EscrowedPackets == nil {
+			x.EscrowedPackets = []*EscrowedPacket{}
+		}
+		value := &_GenesisState_5_list{list: &x.EscrowedPackets}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.hyperlane_token_routes":
+		if x.HyperlaneTokenRoutes == nil {
+			x.HyperlaneTokenRoutes = []*HyperlaneTokenRoute{}
+		}
+		value := &_GenesisState_7_list{list: &x.HyperlaneTokenRoutes}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets":
+		if x.DeferredPackets == nil {
+			x.DeferredPackets = []*DeferredPacket{}
+		}
+		value := &_GenesisState_8_list{list: &x.DeferredPackets}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.amount_limits":
+		if x.AmountLimits == nil {
+			x.AmountLimits = []*AmountLimit{}
+		}
+		value := &_GenesisState_10_list{list: &x.AmountLimits}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries":
+		if x.HeldBatchEntries == nil {
+			x.HeldBatchEntries = []*HeldBatchEntry{}
+		}
+		value := &_GenesisState_11_list{list: &x.HeldBatchEntries}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_escrowed_packets":
+		if x.HeldEscrowedPackets == nil {
+			x.HeldEscrowedPackets = []*EscrowedPacket{}
+		}
+		value := &_GenesisState_13_list{list: &x.HeldEscrowedPackets}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets_sequence":
+		panic(fmt.Errorf("field escrowed_packets_sequence of message noble.orbiter.component.forwarder.v1.GenesisState is not mutable"))
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets_sequence":
+		panic(fmt.Errorf("field deferred_packets_sequence of message noble.orbiter.component.forwarder.v1.GenesisState is not mutable"))
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries_sequence":
+		panic(fmt.Errorf("field held_batch_entries_sequence of message noble.orbiter.component.forwarder.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.forwarder.v1.GenesisState"))
@@ -347,6 +980,36 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "noble.orbiter.component.forwarder.v1.GenesisState.paused_cross_chain_ids":
 		list := []*v1.CrossChainID{}
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "noble.orbiter.component.forwarder.v1.GenesisState.in_flight_packets":
+		list := []*InFlightPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "noble.orbiter.component.forwarder.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets":
+		list := []*EscrowedPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.orbiter.component.forwarder.v1.GenesisState.hyperlane_token_routes":
+		list := []*HyperlaneTokenRoute{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets":
+		list := []*DeferredPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.orbiter.component.forwarder.v1.GenesisState.amount_limits":
+		list := []*AmountLimit{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries":
+		list := []*HeldBatchEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.orbiter.component.forwarder.v1.GenesisState.held_escrowed_packets":
+		list := []*EscrowedPacket{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.component.forwarder.v1.GenesisState"))
@@ -429,6 +1092,61 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.InFlightPackets) > 0 {
+			for _, e := range x.InFlightPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.EscrowedPackets) > 0 {
+			for _, e := range x.EscrowedPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.EscrowedPacketsSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.EscrowedPacketsSequence))
+		}
+		if len(x.HyperlaneTokenRoutes) > 0 {
+			for _, e := range x.HyperlaneTokenRoutes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeferredPackets) > 0 {
+			for _, e := range x.DeferredPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.DeferredPacketsSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.DeferredPacketsSequence))
+		}
+		if len(x.AmountLimits) > 0 {
+			for _, e := range x.AmountLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.HeldBatchEntries) > 0 {
+			for _, e := range x.HeldBatchEntries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.HeldBatchEntriesSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.HeldBatchEntriesSequence))
+		}
+		if len(x.HeldEscrowedPackets) > 0 {
+			for _, e := range x.HeldEscrowedPackets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -458,6 +1176,147 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.HeldEscrowedPackets) > 0 {
+			for iNdEx := len(x.HeldEscrowedPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HeldEscrowedPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if x.HeldBatchEntriesSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HeldBatchEntriesSequence))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.HeldBatchEntries) > 0 {
+			for iNdEx := len(x.HeldBatchEntries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HeldBatchEntries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.AmountLimits) > 0 {
+			for iNdEx := len(x.AmountLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AmountLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.DeferredPacketsSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DeferredPacketsSequence))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.DeferredPackets) > 0 {
+			for iNdEx := len(x.DeferredPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DeferredPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.HyperlaneTokenRoutes) > 0 {
+			for iNdEx := len(x.HyperlaneTokenRoutes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.HyperlaneTokenRoutes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.EscrowedPacketsSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EscrowedPacketsSequence))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.EscrowedPackets) > 0 {
+			for iNdEx := len(x.EscrowedPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EscrowedPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.InFlightPackets) > 0 {
+			for iNdEx := len(x.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InFlightPackets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.PausedCrossChainIds) > 0 {
 			for iNdEx := len(x.PausedCrossChainIds) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.PausedCrossChainIds[iNdEx])
@@ -488,134 +1347,465 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					num >>= 7
 					j1++
 				}
-				dAtA[j1] = uint8(num)
-				j1++
-			}
-			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType == 0 {
+					var v v1.ProtocolID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= v1.ProtocolID(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.PausedProtocolIds = append(x.PausedProtocolIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					if elementCount != 0 && len(x.PausedProtocolIds) == 0 {
+						x.PausedProtocolIds = make([]v1.ProtocolID, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v v1.ProtocolID
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= v1.ProtocolID(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.PausedProtocolIds = append(x.PausedProtocolIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedProtocolIds", wireType)
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedCrossChainIds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PausedCrossChainIds = append(x.PausedCrossChainIds, &v1.CrossChainID{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PausedCrossChainIds[len(x.PausedCrossChainIds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InFlightPackets = append(x.InFlightPackets, &InFlightPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InFlightPackets[len(x.InFlightPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EscrowedPackets = append(x.EscrowedPackets, &EscrowedPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EscrowedPackets[len(x.EscrowedPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EscrowedPacketsSequence", wireType)
+				}
+				x.EscrowedPacketsSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EscrowedPacketsSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HyperlaneTokenRoutes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HyperlaneTokenRoutes = append(x.HyperlaneTokenRoutes, &HyperlaneTokenRoute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HyperlaneTokenRoutes[len(x.HyperlaneTokenRoutes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeferredPackets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.DeferredPackets = append(x.DeferredPackets, &DeferredPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeferredPackets[len(x.DeferredPackets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType == 0 {
-					var v v1.ProtocolID
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= v1.ProtocolID(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeferredPacketsSequence", wireType)
+				}
+				x.DeferredPacketsSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					x.PausedProtocolIds = append(x.PausedProtocolIds, v)
-				} else if wireType == 2 {
-					var packedLen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						packedLen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					if packedLen < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DeferredPacketsSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					postIndex := iNdEx + packedLen
-					if postIndex < 0 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AmountLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 					}
-					if postIndex > l {
+					if iNdEx >= l {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 					}
-					var elementCount int
-					if elementCount != 0 && len(x.PausedProtocolIds) == 0 {
-						x.PausedProtocolIds = make([]v1.ProtocolID, 0, elementCount)
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					for iNdEx < postIndex {
-						var v v1.ProtocolID
-						for shift := uint(0); ; shift += 7 {
-							if shift >= 64 {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-							}
-							if iNdEx >= l {
-								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-							}
-							b := dAtA[iNdEx]
-							iNdEx++
-							v |= v1.ProtocolID(b&0x7F) << shift
-							if b < 0x80 {
-								break
-							}
-						}
-						x.PausedProtocolIds = append(x.PausedProtocolIds, v)
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AmountLimits = append(x.AmountLimits, &AmountLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AmountLimits[len(x.AmountLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HeldBatchEntries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-				} else {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedProtocolIds", wireType)
 				}
-			case 2:
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HeldBatchEntries = append(x.HeldBatchEntries, &HeldBatchEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HeldBatchEntries[len(x.HeldBatchEntries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HeldBatchEntriesSequence", wireType)
+				}
+				x.HeldBatchEntriesSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HeldBatchEntriesSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedCrossChainIds", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HeldEscrowedPackets", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -642,8 +1832,8 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PausedCrossChainIds = append(x.PausedCrossChainIds, &v1.CrossChainID{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PausedCrossChainIds[len(x.PausedCrossChainIds)-1]); err != nil {
+				x.HeldEscrowedPackets = append(x.HeldEscrowedPackets, &EscrowedPacket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.HeldEscrowedPackets[len(x.HeldEscrowedPackets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -703,6 +1893,22 @@ type GenesisState struct {
 
 	PausedProtocolIds   []v1.ProtocolID    `protobuf:"varint,1,rep,packed,name=paused_protocol_ids,json=pausedProtocolIds,proto3,enum=noble.orbiter.core.v1.ProtocolID" json:"paused_protocol_ids,omitempty"`
 	PausedCrossChainIds []*v1.CrossChainID `protobuf:"bytes,2,rep,name=paused_cross_chain_ids,json=pausedCrossChainIds,proto3" json:"paused_cross_chain_ids,omitempty"`
+	InFlightPackets     []*InFlightPacket  `protobuf:"bytes,3,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets,omitempty"`
+	Params              *Params            `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	EscrowedPackets     []*EscrowedPacket  `protobuf:"bytes,5,rep,name=escrowed_packets,json=escrowedPackets,proto3" json:"escrowed_packets,omitempty"`
+	// escrowed_packets_sequence is the next ID assigned to an escrowed packet.
+	EscrowedPacketsSequence uint64                 `protobuf:"varint,6,opt,name=escrowed_packets_sequence,json=escrowedPacketsSequence,proto3" json:"escrowed_packets_sequence,omitempty"`
+	HyperlaneTokenRoutes    []*HyperlaneTokenRoute `protobuf:"bytes,7,rep,name=hyperlane_token_routes,json=hyperlaneTokenRoutes,proto3" json:"hyperlane_token_routes,omitempty"`
+	DeferredPackets         []*DeferredPacket      `protobuf:"bytes,8,rep,name=deferred_packets,json=deferredPackets,proto3" json:"deferred_packets,omitempty"`
+	// deferred_packets_sequence is the next ID assigned to a deferred packet.
+	DeferredPacketsSequence uint64            `protobuf:"varint,9,opt,name=deferred_packets_sequence,json=deferredPacketsSequence,proto3" json:"deferred_packets_sequence,omitempty"`
+	AmountLimits            []*AmountLimit    `protobuf:"bytes,10,rep,name=amount_limits,json=amountLimits,proto3" json:"amount_limits,omitempty"`
+	HeldBatchEntries        []*HeldBatchEntry `protobuf:"bytes,11,rep,name=held_batch_entries,json=heldBatchEntries,proto3" json:"held_batch_entries,omitempty"`
+	// held_batch_entries_sequence is the next ID assigned to a held batch entry.
+	HeldBatchEntriesSequence uint64 `protobuf:"varint,12,opt,name=held_batch_entries_sequence,json=heldBatchEntriesSequence,proto3" json:"held_batch_entries_sequence,omitempty"`
+	// held_escrowed_packets are the escrowed packets without a fallback
+	// recipient whose funds are held in the refunds account.
+	HeldEscrowedPackets []*EscrowedPacket `protobuf:"bytes,13,rep,name=held_escrowed_packets,json=heldEscrowedPackets,proto3" json:"held_escrowed_packets,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -739,6 +1945,83 @@ func (x *GenesisState) GetPausedCrossChainIds() []*v1.CrossChainID {
 	return nil
 }
 
+func (x *GenesisState) GetInFlightPackets() []*InFlightPacket {
+	if x != nil {
+		return x.InFlightPackets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEscrowedPackets() []*EscrowedPacket {
+	if x != nil {
+		return x.EscrowedPackets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEscrowedPacketsSequence() uint64 {
+	if x != nil {
+		return x.EscrowedPacketsSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetHyperlaneTokenRoutes() []*HyperlaneTokenRoute {
+	if x != nil {
+		return x.HyperlaneTokenRoutes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDeferredPackets() []*DeferredPacket {
+	if x != nil {
+		return x.DeferredPackets
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDeferredPacketsSequence() uint64 {
+	if x != nil {
+		return x.DeferredPacketsSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetAmountLimits() []*AmountLimit {
+	if x != nil {
+		return x.AmountLimits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetHeldBatchEntries() []*HeldBatchEntry {
+	if x != nil {
+		return x.HeldBatchEntries
+	}
+	return nil
+}
+
+func (x *GenesisState) GetHeldBatchEntriesSequence() uint64 {
+	if x != nil {
+		return x.HeldBatchEntriesSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetHeldEscrowedPackets() []*EscrowedPacket {
+	if x != nil {
+		return x.HeldEscrowedPackets
+	}
+	return nil
+}
+
 var File_noble_orbiter_component_forwarder_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_noble_orbiter_component_forwarder_v1_genesis_proto_rawDesc = []byte{
@@ -748,43 +2031,113 @@ var file_noble_orbiter_component_forwarder_v1_genesis_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x24, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69,
 	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f,
 	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
-	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x01,
-	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x58,
-	0x0a, 0x13, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6e, 0x6f,
-	0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x42, 0x05,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x5f, 0x0a, 0x16, 0x70, 0x61, 0x75, 0x73,
-	0x65, 0x64, 0x5f, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
-	0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x05, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x13, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x43, 0x72, 0x6f, 0x73,
-	0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73, 0x42, 0xc6, 0x02, 0x0a, 0x28, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e,
+	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67,
+	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x34, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74,
+	0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x09, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x13, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
+	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x11, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x49, 0x64, 0x73, 0x12, 0x5f, 0x0a, 0x16, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x63,
+	0x72, 0x6f, 0x73, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62,
+	0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x13, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x6b, 0x0a, 0x11, 0x69, 0x6e, 0x5f, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0f, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x4f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74,
+	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x6a, 0x0a, 0x10, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f,
+	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
+	0x3a, 0x0a, 0x19, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x17, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x7a, 0x0a, 0x16, 0x68,
+	0x79, 0x70, 0x65, 0x72, 0x6c, 0x61, 0x6e, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x61, 0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x14, 0x68, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x61, 0x6e, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x6a, 0x0a, 0x10, 0x64, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65,
+	0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65,
+	0x64, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x61, 0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f,
+	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x6d, 0x0a, 0x12, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x10, 0x68, 0x65, 0x6c, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x68, 0x65, 0x6c, 0x64, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x73, 0x0a, 0x15, 0x68, 0x65, 0x6c, 0x64, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e,
 	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x57, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f,
-	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72,
-	0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x76, 0x31, 0xa2,
-	0x02, 0x04, 0x4e, 0x4f, 0x43, 0x46, 0xaa, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f,
-	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x24,
-	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65,
-	0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x30, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62,
-	0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5c, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x28, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a,
-	0x3a, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
-	0x65, 0x6e, 0x74, 0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x13, 0x68, 0x65, 0x6c, 0x64, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x42, 0xc6, 0x02, 0x0a, 0x28, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x57, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6f, 0x72, 0x62, 0x69,
+	0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x04, 0x4e, 0x4f,
+	0x43, 0x46, 0xaa, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74,
+	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x2e, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x24, 0x4e, 0x6f, 0x62, 0x6c,
+	0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x30, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x5c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x28, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x62,
+	0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a,
+	0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -801,18 +2154,33 @@ func file_noble_orbiter_component_forwarder_v1_genesis_proto_rawDescGZIP() []byt
 
 var file_noble_orbiter_component_forwarder_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_noble_orbiter_component_forwarder_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: noble.orbiter.component.forwarder.v1.GenesisState
-	(v1.ProtocolID)(0),      // 1: noble.orbiter.core.v1.ProtocolID
-	(*v1.CrossChainID)(nil), // 2: noble.orbiter.core.v1.CrossChainID
+	(*GenesisState)(nil),        // 0: noble.orbiter.component.forwarder.v1.GenesisState
+	(v1.ProtocolID)(0),          // 1: noble.orbiter.core.v1.ProtocolID
+	(*v1.CrossChainID)(nil),     // 2: noble.orbiter.core.v1.CrossChainID
+	(*InFlightPacket)(nil),      // 3: noble.orbiter.component.forwarder.v1.InFlightPacket
+	(*Params)(nil),              // 4: noble.orbiter.component.forwarder.v1.Params
+	(*EscrowedPacket)(nil),      // 5: noble.orbiter.component.forwarder.v1.EscrowedPacket
+	(*HyperlaneTokenRoute)(nil), // 6: noble.orbiter.component.forwarder.v1.HyperlaneTokenRoute
+	(*DeferredPacket)(nil),      // 7: noble.orbiter.component.forwarder.v1.DeferredPacket
+	(*AmountLimit)(nil),         // 8: noble.orbiter.component.forwarder.v1.AmountLimit
+	(*HeldBatchEntry)(nil),      // 9: noble.orbiter.component.forwarder.v1.HeldBatchEntry
 }
 var file_noble_orbiter_component_forwarder_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: noble.orbiter.component.forwarder.v1.GenesisState.paused_protocol_ids:type_name -> noble.orbiter.core.v1.ProtocolID
-	2, // 1: noble.orbiter.component.forwarder.v1.GenesisState.paused_cross_chain_ids:type_name -> noble.orbiter.core.v1.CrossChainID
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: noble.orbiter.component.forwarder.v1.GenesisState.paused_protocol_ids:type_name -> noble.orbiter.core.v1.ProtocolID
+	2,  // 1: noble.orbiter.component.forwarder.v1.GenesisState.paused_cross_chain_ids:type_name -> noble.orbiter.core.v1.CrossChainID
+	3,  // 2: noble.orbiter.component.forwarder.v1.GenesisState.in_flight_packets:type_name -> noble.orbiter.component.forwarder.v1.InFlightPacket
+	4,  // 3: noble.orbiter.component.forwarder.v1.GenesisState.params:type_name -> noble.orbiter.component.forwarder.v1.Params
+	5,  // 4: noble.orbiter.component.forwarder.v1.GenesisState.escrowed_packets:type_name -> noble.orbiter.component.forwarder.v1.EscrowedPacket
+	6,  // 5: noble.orbiter.component.forwarder.v1.GenesisState.hyperlane_token_routes:type_name -> noble.orbiter.component.forwarder.v1.HyperlaneTokenRoute
+	7,  // 6: noble.orbiter.component.forwarder.v1.GenesisState.deferred_packets:type_name -> noble.orbiter.component.forwarder.v1.DeferredPacket
+	8,  // 7: noble.orbiter.component.forwarder.v1.GenesisState.amount_limits:type_name -> noble.orbiter.component.forwarder.v1.AmountLimit
+	9,  // 8: noble.orbiter.component.forwarder.v1.GenesisState.held_batch_entries:type_name -> noble.orbiter.component.forwarder.v1.HeldBatchEntry
+	5,  // 9: noble.orbiter.component.forwarder.v1.GenesisState.held_escrowed_packets:type_name -> noble.orbiter.component.forwarder.v1.EscrowedPacket
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_noble_orbiter_component_forwarder_v1_genesis_proto_init() }
@@ -820,6 +2188,7 @@ func file_noble_orbiter_component_forwarder_v1_genesis_proto_init() {
 	if File_noble_orbiter_component_forwarder_v1_genesis_proto != nil {
 		return
 	}
+	file_noble_orbiter_component_forwarder_v1_forwarder_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_noble_orbiter_component_forwarder_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
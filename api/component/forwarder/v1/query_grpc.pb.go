@@ -19,10 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_PausedProtocols_FullMethodName    = "/noble.orbiter.component.forwarder.v1.Query/PausedProtocols"
-	Query_PausedCrossChains_FullMethodName  = "/noble.orbiter.component.forwarder.v1.Query/PausedCrossChains"
-	Query_IsProtocolPaused_FullMethodName   = "/noble.orbiter.component.forwarder.v1.Query/IsProtocolPaused"
-	Query_IsCrossChainPaused_FullMethodName = "/noble.orbiter.component.forwarder.v1.Query/IsCrossChainPaused"
+	Query_PausedProtocols_FullMethodName      = "/noble.orbiter.component.forwarder.v1.Query/PausedProtocols"
+	Query_PausedCrossChains_FullMethodName    = "/noble.orbiter.component.forwarder.v1.Query/PausedCrossChains"
+	Query_IsProtocolPaused_FullMethodName     = "/noble.orbiter.component.forwarder.v1.Query/IsProtocolPaused"
+	Query_IsCrossChainPaused_FullMethodName   = "/noble.orbiter.component.forwarder.v1.Query/IsCrossChainPaused"
+	Query_InFlightPackets_FullMethodName      = "/noble.orbiter.component.forwarder.v1.Query/InFlightPackets"
+	Query_InFlightPacket_FullMethodName       = "/noble.orbiter.component.forwarder.v1.Query/InFlightPacket"
+	Query_Params_FullMethodName               = "/noble.orbiter.component.forwarder.v1.Query/Params"
+	Query_EscrowedPackets_FullMethodName      = "/noble.orbiter.component.forwarder.v1.Query/EscrowedPackets"
+	Query_EscrowedPacket_FullMethodName       = "/noble.orbiter.component.forwarder.v1.Query/EscrowedPacket"
+	Query_DeferredPackets_FullMethodName      = "/noble.orbiter.component.forwarder.v1.Query/DeferredPackets"
+	Query_DeferredPacket_FullMethodName       = "/noble.orbiter.component.forwarder.v1.Query/DeferredPacket"
+	Query_AmountLimits_FullMethodName         = "/noble.orbiter.component.forwarder.v1.Query/AmountLimits"
+	Query_AmountLimit_FullMethodName          = "/noble.orbiter.component.forwarder.v1.Query/AmountLimit"
+	Query_HyperlaneTokenRoutes_FullMethodName = "/noble.orbiter.component.forwarder.v1.Query/HyperlaneTokenRoutes"
+	Query_HyperlaneTokenRoute_FullMethodName  = "/noble.orbiter.component.forwarder.v1.Query/HyperlaneTokenRoute"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +51,29 @@ type QueryClient interface {
 	IsProtocolPaused(ctx context.Context, in *QueryIsProtocolPausedRequest, opts ...grpc.CallOption) (*QueryIsProtocolPausedResponse, error)
 	// IsCrossChainPaused checks if a specific counterparty for a protocol is paused.
 	IsCrossChainPaused(ctx context.Context, in *QueryIsCrossChainPausedRequest, opts ...grpc.CallOption) (*QueryIsCrossChainPausedResponse, error)
+	// InFlightPackets retrieves all the outgoing IBC packets not yet acknowledged.
+	InFlightPackets(ctx context.Context, in *QueryInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsResponse, error)
+	// InFlightPacket retrieves an outgoing IBC packet not yet acknowledged.
+	InFlightPacket(ctx context.Context, in *QueryInFlightPacketRequest, opts ...grpc.CallOption) (*QueryInFlightPacketResponse, error)
+	// Params retrieves the forwarder component parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EscrowedPackets retrieves all the packets held in the escrow queue.
+	EscrowedPackets(ctx context.Context, in *QueryEscrowedPacketsRequest, opts ...grpc.CallOption) (*QueryEscrowedPacketsResponse, error)
+	// EscrowedPacket retrieves a packet held in the escrow queue.
+	EscrowedPacket(ctx context.Context, in *QueryEscrowedPacketRequest, opts ...grpc.CallOption) (*QueryEscrowedPacketResponse, error)
+	// DeferredPackets retrieves all the packets held in the deferred queue.
+	DeferredPackets(ctx context.Context, in *QueryDeferredPacketsRequest, opts ...grpc.CallOption) (*QueryDeferredPacketsResponse, error)
+	// DeferredPacket retrieves a packet held in the deferred queue.
+	DeferredPacket(ctx context.Context, in *QueryDeferredPacketRequest, opts ...grpc.CallOption) (*QueryDeferredPacketResponse, error)
+	// AmountLimits retrieves all the amount limits of the destinations.
+	AmountLimits(ctx context.Context, in *QueryAmountLimitsRequest, opts ...grpc.CallOption) (*QueryAmountLimitsResponse, error)
+	// AmountLimit retrieves the amount limit of a destination and denom.
+	AmountLimit(ctx context.Context, in *QueryAmountLimitRequest, opts ...grpc.CallOption) (*QueryAmountLimitResponse, error)
+	// HyperlaneTokenRoutes retrieves all the Hyperlane warp token routes.
+	HyperlaneTokenRoutes(ctx context.Context, in *QueryHyperlaneTokenRoutesRequest, opts ...grpc.CallOption) (*QueryHyperlaneTokenRoutesResponse, error)
+	// HyperlaneTokenRoute retrieves the Hyperlane warp token route of a denom
+	// and destination domain.
+	HyperlaneTokenRoute(ctx context.Context, in *QueryHyperlaneTokenRouteRequest, opts ...grpc.CallOption) (*QueryHyperlaneTokenRouteResponse, error)
 }
 
 type queryClient struct {
@@ -90,6 +124,116 @@ func (c *queryClient) IsCrossChainPaused(ctx context.Context, in *QueryIsCrossCh
 	return out, nil
 }
 
+func (c *queryClient) InFlightPackets(ctx context.Context, in *QueryInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryInFlightPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_InFlightPackets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InFlightPacket(ctx context.Context, in *QueryInFlightPacketRequest, opts ...grpc.CallOption) (*QueryInFlightPacketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryInFlightPacketResponse)
+	err := c.cc.Invoke(ctx, Query_InFlightPacket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowedPackets(ctx context.Context, in *QueryEscrowedPacketsRequest, opts ...grpc.CallOption) (*QueryEscrowedPacketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEscrowedPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowedPackets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowedPacket(ctx context.Context, in *QueryEscrowedPacketRequest, opts ...grpc.CallOption) (*QueryEscrowedPacketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryEscrowedPacketResponse)
+	err := c.cc.Invoke(ctx, Query_EscrowedPacket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeferredPackets(ctx context.Context, in *QueryDeferredPacketsRequest, opts ...grpc.CallOption) (*QueryDeferredPacketsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDeferredPacketsResponse)
+	err := c.cc.Invoke(ctx, Query_DeferredPackets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeferredPacket(ctx context.Context, in *QueryDeferredPacketRequest, opts ...grpc.CallOption) (*QueryDeferredPacketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryDeferredPacketResponse)
+	err := c.cc.Invoke(ctx, Query_DeferredPacket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AmountLimits(ctx context.Context, in *QueryAmountLimitsRequest, opts ...grpc.CallOption) (*QueryAmountLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAmountLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_AmountLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AmountLimit(ctx context.Context, in *QueryAmountLimitRequest, opts ...grpc.CallOption) (*QueryAmountLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAmountLimitResponse)
+	err := c.cc.Invoke(ctx, Query_AmountLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HyperlaneTokenRoutes(ctx context.Context, in *QueryHyperlaneTokenRoutesRequest, opts ...grpc.CallOption) (*QueryHyperlaneTokenRoutesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHyperlaneTokenRoutesResponse)
+	err := c.cc.Invoke(ctx, Query_HyperlaneTokenRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HyperlaneTokenRoute(ctx context.Context, in *QueryHyperlaneTokenRouteRequest, opts ...grpc.CallOption) (*QueryHyperlaneTokenRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHyperlaneTokenRouteResponse)
+	err := c.cc.Invoke(ctx, Query_HyperlaneTokenRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -105,6 +249,29 @@ type QueryServer interface {
 	IsProtocolPaused(context.Context, *QueryIsProtocolPausedRequest) (*QueryIsProtocolPausedResponse, error)
 	// IsCrossChainPaused checks if a specific counterparty for a protocol is paused.
 	IsCrossChainPaused(context.Context, *QueryIsCrossChainPausedRequest) (*QueryIsCrossChainPausedResponse, error)
+	// InFlightPackets retrieves all the outgoing IBC packets not yet acknowledged.
+	InFlightPackets(context.Context, *QueryInFlightPacketsRequest) (*QueryInFlightPacketsResponse, error)
+	// InFlightPacket retrieves an outgoing IBC packet not yet acknowledged.
+	InFlightPacket(context.Context, *QueryInFlightPacketRequest) (*QueryInFlightPacketResponse, error)
+	// Params retrieves the forwarder component parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EscrowedPackets retrieves all the packets held in the escrow queue.
+	EscrowedPackets(context.Context, *QueryEscrowedPacketsRequest) (*QueryEscrowedPacketsResponse, error)
+	// EscrowedPacket retrieves a packet held in the escrow queue.
+	EscrowedPacket(context.Context, *QueryEscrowedPacketRequest) (*QueryEscrowedPacketResponse, error)
+	// DeferredPackets retrieves all the packets held in the deferred queue.
+	DeferredPackets(context.Context, *QueryDeferredPacketsRequest) (*QueryDeferredPacketsResponse, error)
+	// DeferredPacket retrieves a packet held in the deferred queue.
+	DeferredPacket(context.Context, *QueryDeferredPacketRequest) (*QueryDeferredPacketResponse, error)
+	// AmountLimits retrieves all the amount limits of the destinations.
+	AmountLimits(context.Context, *QueryAmountLimitsRequest) (*QueryAmountLimitsResponse, error)
+	// AmountLimit retrieves the amount limit of a destination and denom.
+	AmountLimit(context.Context, *QueryAmountLimitRequest) (*QueryAmountLimitResponse, error)
+	// HyperlaneTokenRoutes retrieves all the Hyperlane warp token routes.
+	HyperlaneTokenRoutes(context.Context, *QueryHyperlaneTokenRoutesRequest) (*QueryHyperlaneTokenRoutesResponse, error)
+	// HyperlaneTokenRoute retrieves the Hyperlane warp token route of a denom
+	// and destination domain.
+	HyperlaneTokenRoute(context.Context, *QueryHyperlaneTokenRouteRequest) (*QueryHyperlaneTokenRouteResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -127,6 +294,39 @@ func (UnimplementedQueryServer) IsProtocolPaused(context.Context, *QueryIsProtoc
 func (UnimplementedQueryServer) IsCrossChainPaused(context.Context, *QueryIsCrossChainPausedRequest) (*QueryIsCrossChainPausedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsCrossChainPaused not implemented")
 }
+func (UnimplementedQueryServer) InFlightPackets(context.Context, *QueryInFlightPacketsRequest) (*QueryInFlightPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPackets not implemented")
+}
+func (UnimplementedQueryServer) InFlightPacket(context.Context, *QueryInFlightPacketRequest) (*QueryInFlightPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPacket not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) EscrowedPackets(context.Context, *QueryEscrowedPacketsRequest) (*QueryEscrowedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedPackets not implemented")
+}
+func (UnimplementedQueryServer) EscrowedPacket(context.Context, *QueryEscrowedPacketRequest) (*QueryEscrowedPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedPacket not implemented")
+}
+func (UnimplementedQueryServer) DeferredPackets(context.Context, *QueryDeferredPacketsRequest) (*QueryDeferredPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferredPackets not implemented")
+}
+func (UnimplementedQueryServer) DeferredPacket(context.Context, *QueryDeferredPacketRequest) (*QueryDeferredPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferredPacket not implemented")
+}
+func (UnimplementedQueryServer) AmountLimits(context.Context, *QueryAmountLimitsRequest) (*QueryAmountLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmountLimits not implemented")
+}
+func (UnimplementedQueryServer) AmountLimit(context.Context, *QueryAmountLimitRequest) (*QueryAmountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmountLimit not implemented")
+}
+func (UnimplementedQueryServer) HyperlaneTokenRoutes(context.Context, *QueryHyperlaneTokenRoutesRequest) (*QueryHyperlaneTokenRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HyperlaneTokenRoutes not implemented")
+}
+func (UnimplementedQueryServer) HyperlaneTokenRoute(context.Context, *QueryHyperlaneTokenRouteRequest) (*QueryHyperlaneTokenRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HyperlaneTokenRoute not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -220,6 +420,204 @@ func _Query_IsCrossChainPaused_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInFlightPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InFlightPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPackets(ctx, req.(*QueryInFlightPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInFlightPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_InFlightPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPacket(ctx, req.(*QueryInFlightPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowedPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedPackets(ctx, req.(*QueryEscrowedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EscrowedPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedPacket(ctx, req.(*QueryEscrowedPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeferredPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeferredPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeferredPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeferredPackets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeferredPackets(ctx, req.(*QueryDeferredPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeferredPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeferredPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeferredPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DeferredPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeferredPacket(ctx, req.(*QueryDeferredPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AmountLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmountLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AmountLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AmountLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AmountLimits(ctx, req.(*QueryAmountLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AmountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmountLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AmountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AmountLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AmountLimit(ctx, req.(*QueryAmountLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HyperlaneTokenRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHyperlaneTokenRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HyperlaneTokenRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HyperlaneTokenRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HyperlaneTokenRoutes(ctx, req.(*QueryHyperlaneTokenRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HyperlaneTokenRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHyperlaneTokenRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HyperlaneTokenRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HyperlaneTokenRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HyperlaneTokenRoute(ctx, req.(*QueryHyperlaneTokenRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +641,50 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IsCrossChainPaused",
 			Handler:    _Query_IsCrossChainPaused_Handler,
 		},
+		{
+			MethodName: "InFlightPackets",
+			Handler:    _Query_InFlightPackets_Handler,
+		},
+		{
+			MethodName: "InFlightPacket",
+			Handler:    _Query_InFlightPacket_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EscrowedPackets",
+			Handler:    _Query_EscrowedPackets_Handler,
+		},
+		{
+			MethodName: "EscrowedPacket",
+			Handler:    _Query_EscrowedPacket_Handler,
+		},
+		{
+			MethodName: "DeferredPackets",
+			Handler:    _Query_DeferredPackets_Handler,
+		},
+		{
+			MethodName: "DeferredPacket",
+			Handler:    _Query_DeferredPacket_Handler,
+		},
+		{
+			MethodName: "AmountLimits",
+			Handler:    _Query_AmountLimits_Handler,
+		},
+		{
+			MethodName: "AmountLimit",
+			Handler:    _Query_AmountLimit_Handler,
+		},
+		{
+			MethodName: "HyperlaneTokenRoutes",
+			Handler:    _Query_HyperlaneTokenRoutes_Handler,
+		},
+		{
+			MethodName: "HyperlaneTokenRoute",
+			Handler:    _Query_HyperlaneTokenRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/orbiter/component/forwarder/v1/query.proto",
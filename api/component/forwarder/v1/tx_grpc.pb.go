@@ -19,11 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_PauseProtocol_FullMethodName         = "/noble.orbiter.component.forwarder.v1.Msg/PauseProtocol"
-	Msg_UnpauseProtocol_FullMethodName       = "/noble.orbiter.component.forwarder.v1.Msg/UnpauseProtocol"
-	Msg_PauseCrossChains_FullMethodName      = "/noble.orbiter.component.forwarder.v1.Msg/PauseCrossChains"
-	Msg_UnpauseCrossChains_FullMethodName    = "/noble.orbiter.component.forwarder.v1.Msg/UnpauseCrossChains"
-	Msg_ReplaceDepositForBurn_FullMethodName = "/noble.orbiter.component.forwarder.v1.Msg/ReplaceDepositForBurn"
+	Msg_PauseProtocol_FullMethodName             = "/noble.orbiter.component.forwarder.v1.Msg/PauseProtocol"
+	Msg_UnpauseProtocol_FullMethodName           = "/noble.orbiter.component.forwarder.v1.Msg/UnpauseProtocol"
+	Msg_PauseCrossChains_FullMethodName          = "/noble.orbiter.component.forwarder.v1.Msg/PauseCrossChains"
+	Msg_UnpauseCrossChains_FullMethodName        = "/noble.orbiter.component.forwarder.v1.Msg/UnpauseCrossChains"
+	Msg_ReplaceDepositForBurn_FullMethodName     = "/noble.orbiter.component.forwarder.v1.Msg/ReplaceDepositForBurn"
+	Msg_SetHyperlaneTokenRoute_FullMethodName    = "/noble.orbiter.component.forwarder.v1.Msg/SetHyperlaneTokenRoute"
+	Msg_RemoveHyperlaneTokenRoute_FullMethodName = "/noble.orbiter.component.forwarder.v1.Msg/RemoveHyperlaneTokenRoute"
+	Msg_SetAmountLimit_FullMethodName            = "/noble.orbiter.component.forwarder.v1.Msg/SetAmountLimit"
+	Msg_RemoveAmountLimit_FullMethodName         = "/noble.orbiter.component.forwarder.v1.Msg/RemoveAmountLimit"
+	Msg_ClaimInFlightRefund_FullMethodName       = "/noble.orbiter.component.forwarder.v1.Msg/ClaimInFlightRefund"
+	Msg_ClaimHeldBatchEntry_FullMethodName       = "/noble.orbiter.component.forwarder.v1.Msg/ClaimHeldBatchEntry"
+	Msg_ClaimHeldEscrowedPacket_FullMethodName   = "/noble.orbiter.component.forwarder.v1.Msg/ClaimHeldEscrowedPacket"
+	Msg_UpdateParams_FullMethodName              = "/noble.orbiter.component.forwarder.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,11 +44,33 @@ type MsgClient interface {
 	PauseProtocol(ctx context.Context, in *MsgPauseProtocol, opts ...grpc.CallOption) (*MsgPauseProtocolResponse, error)
 	// UnpauseProtocol resumes an entire forwarding protocol.
 	UnpauseProtocol(ctx context.Context, in *MsgUnpauseProtocol, opts ...grpc.CallOption) (*MsgUnpauseProtocolResponse, error)
-	// PauseCrossChains pauses specific counterparties for a protocol.
+	//  PauseCrossChains pauses specific counterparties for a protocol.
 	PauseCrossChains(ctx context.Context, in *MsgPauseCrossChains, opts ...grpc.CallOption) (*MsgPauseCrossChainsResponse, error)
 	// UnpauseCrossChains resumes specific counterparties for a protocol.
 	UnpauseCrossChains(ctx context.Context, in *MsgUnpauseCrossChains, opts ...grpc.CallOption) (*MsgUnpauseCrossChainsResponse, error)
 	ReplaceDepositForBurn(ctx context.Context, in *MsgReplaceDepositForBurn, opts ...grpc.CallOption) (*MsgReplaceDepositForBurnResponse, error)
+	// SetHyperlaneTokenRoute sets the Hyperlane warp token used for a denom
+	// and destination domain.
+	SetHyperlaneTokenRoute(ctx context.Context, in *MsgSetHyperlaneTokenRoute, opts ...grpc.CallOption) (*MsgSetHyperlaneTokenRouteResponse, error)
+	// RemoveHyperlaneTokenRoute removes the Hyperlane warp token used for a
+	// denom and destination domain.
+	RemoveHyperlaneTokenRoute(ctx context.Context, in *MsgRemoveHyperlaneTokenRoute, opts ...grpc.CallOption) (*MsgRemoveHyperlaneTokenRouteResponse, error)
+	// SetAmountLimit sets the minimum and maximum amount of a denom
+	// forwarded with a single transfer to a destination.
+	SetAmountLimit(ctx context.Context, in *MsgSetAmountLimit, opts ...grpc.CallOption) (*MsgSetAmountLimitResponse, error)
+	// RemoveAmountLimit removes the amount limit of a denom and destination.
+	RemoveAmountLimit(ctx context.Context, in *MsgRemoveAmountLimit, opts ...grpc.CallOption) (*MsgRemoveAmountLimitResponse, error)
+	// ClaimInFlightRefund sends the held funds of an in-flight packet
+	// whose refund failed.
+	ClaimInFlightRefund(ctx context.Context, in *MsgClaimInFlightRefund, opts ...grpc.CallOption) (*MsgClaimInFlightRefundResponse, error)
+	// ClaimHeldBatchEntry sends the held funds of a batch entry
+	// that could not be sent to its fallback recipient.
+	ClaimHeldBatchEntry(ctx context.Context, in *MsgClaimHeldBatchEntry, opts ...grpc.CallOption) (*MsgClaimHeldBatchEntryResponse, error)
+	// ClaimHeldEscrowedPacket sends the held funds of an escrowed
+	// packet without a fallback recipient.
+	ClaimHeldEscrowedPacket(ctx context.Context, in *MsgClaimHeldEscrowedPacket, opts ...grpc.CallOption) (*MsgClaimHeldEscrowedPacketResponse, error)
+	// UpdateParams updates the Forwarder component params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -101,6 +131,86 @@ func (c *msgClient) ReplaceDepositForBurn(ctx context.Context, in *MsgReplaceDep
 	return out, nil
 }
 
+func (c *msgClient) SetHyperlaneTokenRoute(ctx context.Context, in *MsgSetHyperlaneTokenRoute, opts ...grpc.CallOption) (*MsgSetHyperlaneTokenRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetHyperlaneTokenRouteResponse)
+	err := c.cc.Invoke(ctx, Msg_SetHyperlaneTokenRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveHyperlaneTokenRoute(ctx context.Context, in *MsgRemoveHyperlaneTokenRoute, opts ...grpc.CallOption) (*MsgRemoveHyperlaneTokenRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveHyperlaneTokenRouteResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveHyperlaneTokenRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAmountLimit(ctx context.Context, in *MsgSetAmountLimit, opts ...grpc.CallOption) (*MsgSetAmountLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetAmountLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAmountLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAmountLimit(ctx context.Context, in *MsgRemoveAmountLimit, opts ...grpc.CallOption) (*MsgRemoveAmountLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAmountLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAmountLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimInFlightRefund(ctx context.Context, in *MsgClaimInFlightRefund, opts ...grpc.CallOption) (*MsgClaimInFlightRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimInFlightRefundResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimInFlightRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimHeldBatchEntry(ctx context.Context, in *MsgClaimHeldBatchEntry, opts ...grpc.CallOption) (*MsgClaimHeldBatchEntryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimHeldBatchEntryResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimHeldBatchEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimHeldEscrowedPacket(ctx context.Context, in *MsgClaimHeldEscrowedPacket, opts ...grpc.CallOption) (*MsgClaimHeldEscrowedPacketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgClaimHeldEscrowedPacketResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimHeldEscrowedPacket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -111,11 +221,33 @@ type MsgServer interface {
 	PauseProtocol(context.Context, *MsgPauseProtocol) (*MsgPauseProtocolResponse, error)
 	// UnpauseProtocol resumes an entire forwarding protocol.
 	UnpauseProtocol(context.Context, *MsgUnpauseProtocol) (*MsgUnpauseProtocolResponse, error)
-	// PauseCrossChains pauses specific counterparties for a protocol.
+	//  PauseCrossChains pauses specific counterparties for a protocol.
 	PauseCrossChains(context.Context, *MsgPauseCrossChains) (*MsgPauseCrossChainsResponse, error)
 	// UnpauseCrossChains resumes specific counterparties for a protocol.
 	UnpauseCrossChains(context.Context, *MsgUnpauseCrossChains) (*MsgUnpauseCrossChainsResponse, error)
 	ReplaceDepositForBurn(context.Context, *MsgReplaceDepositForBurn) (*MsgReplaceDepositForBurnResponse, error)
+	// SetHyperlaneTokenRoute sets the Hyperlane warp token used for a denom
+	// and destination domain.
+	SetHyperlaneTokenRoute(context.Context, *MsgSetHyperlaneTokenRoute) (*MsgSetHyperlaneTokenRouteResponse, error)
+	// RemoveHyperlaneTokenRoute removes the Hyperlane warp token used for a
+	// denom and destination domain.
+	RemoveHyperlaneTokenRoute(context.Context, *MsgRemoveHyperlaneTokenRoute) (*MsgRemoveHyperlaneTokenRouteResponse, error)
+	// SetAmountLimit sets the minimum and maximum amount of a denom
+	// forwarded with a single transfer to a destination.
+	SetAmountLimit(context.Context, *MsgSetAmountLimit) (*MsgSetAmountLimitResponse, error)
+	// RemoveAmountLimit removes the amount limit of a denom and destination.
+	RemoveAmountLimit(context.Context, *MsgRemoveAmountLimit) (*MsgRemoveAmountLimitResponse, error)
+	// ClaimInFlightRefund sends the held funds of an in-flight packet
+	// whose refund failed.
+	ClaimInFlightRefund(context.Context, *MsgClaimInFlightRefund) (*MsgClaimInFlightRefundResponse, error)
+	// ClaimHeldBatchEntry sends the held funds of a batch entry
+	// that could not be sent to its fallback recipient.
+	ClaimHeldBatchEntry(context.Context, *MsgClaimHeldBatchEntry) (*MsgClaimHeldBatchEntryResponse, error)
+	// ClaimHeldEscrowedPacket sends the held funds of an escrowed
+	// packet without a fallback recipient.
+	ClaimHeldEscrowedPacket(context.Context, *MsgClaimHeldEscrowedPacket) (*MsgClaimHeldEscrowedPacketResponse, error)
+	// UpdateParams updates the Forwarder component params.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -141,6 +273,30 @@ func (UnimplementedMsgServer) UnpauseCrossChains(context.Context, *MsgUnpauseCro
 func (UnimplementedMsgServer) ReplaceDepositForBurn(context.Context, *MsgReplaceDepositForBurn) (*MsgReplaceDepositForBurnResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplaceDepositForBurn not implemented")
 }
+func (UnimplementedMsgServer) SetHyperlaneTokenRoute(context.Context, *MsgSetHyperlaneTokenRoute) (*MsgSetHyperlaneTokenRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHyperlaneTokenRoute not implemented")
+}
+func (UnimplementedMsgServer) RemoveHyperlaneTokenRoute(context.Context, *MsgRemoveHyperlaneTokenRoute) (*MsgRemoveHyperlaneTokenRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHyperlaneTokenRoute not implemented")
+}
+func (UnimplementedMsgServer) SetAmountLimit(context.Context, *MsgSetAmountLimit) (*MsgSetAmountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAmountLimit not implemented")
+}
+func (UnimplementedMsgServer) RemoveAmountLimit(context.Context, *MsgRemoveAmountLimit) (*MsgRemoveAmountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAmountLimit not implemented")
+}
+func (UnimplementedMsgServer) ClaimInFlightRefund(context.Context, *MsgClaimInFlightRefund) (*MsgClaimInFlightRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimInFlightRefund not implemented")
+}
+func (UnimplementedMsgServer) ClaimHeldBatchEntry(context.Context, *MsgClaimHeldBatchEntry) (*MsgClaimHeldBatchEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHeldBatchEntry not implemented")
+}
+func (UnimplementedMsgServer) ClaimHeldEscrowedPacket(context.Context, *MsgClaimHeldEscrowedPacket) (*MsgClaimHeldEscrowedPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHeldEscrowedPacket not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -252,6 +408,150 @@ func _Msg_ReplaceDepositForBurn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHyperlaneTokenRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHyperlaneTokenRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHyperlaneTokenRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetHyperlaneTokenRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHyperlaneTokenRoute(ctx, req.(*MsgSetHyperlaneTokenRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveHyperlaneTokenRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveHyperlaneTokenRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveHyperlaneTokenRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveHyperlaneTokenRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveHyperlaneTokenRoute(ctx, req.(*MsgRemoveHyperlaneTokenRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAmountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAmountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAmountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAmountLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAmountLimit(ctx, req.(*MsgSetAmountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAmountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAmountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAmountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAmountLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAmountLimit(ctx, req.(*MsgRemoveAmountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimInFlightRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimInFlightRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimInFlightRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimInFlightRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimInFlightRefund(ctx, req.(*MsgClaimInFlightRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHeldBatchEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimHeldBatchEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHeldBatchEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimHeldBatchEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHeldBatchEntry(ctx, req.(*MsgClaimHeldBatchEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHeldEscrowedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimHeldEscrowedPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHeldEscrowedPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimHeldEscrowedPacket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHeldEscrowedPacket(ctx, req.(*MsgClaimHeldEscrowedPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,6 +579,38 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReplaceDepositForBurn",
 			Handler:    _Msg_ReplaceDepositForBurn_Handler,
 		},
+		{
+			MethodName: "SetHyperlaneTokenRoute",
+			Handler:    _Msg_SetHyperlaneTokenRoute_Handler,
+		},
+		{
+			MethodName: "RemoveHyperlaneTokenRoute",
+			Handler:    _Msg_RemoveHyperlaneTokenRoute_Handler,
+		},
+		{
+			MethodName: "SetAmountLimit",
+			Handler:    _Msg_SetAmountLimit_Handler,
+		},
+		{
+			MethodName: "RemoveAmountLimit",
+			Handler:    _Msg_RemoveAmountLimit_Handler,
+		},
+		{
+			MethodName: "ClaimInFlightRefund",
+			Handler:    _Msg_ClaimInFlightRefund_Handler,
+		},
+		{
+			MethodName: "ClaimHeldBatchEntry",
+			Handler:    _Msg_ClaimHeldBatchEntry_Handler,
+		},
+		{
+			MethodName: "ClaimHeldEscrowedPacket",
+			Handler:    _Msg_ClaimHeldEscrowedPacket_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/orbiter/component/forwarder/v1/tx.proto",
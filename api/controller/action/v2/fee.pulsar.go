@@ -65,14 +65,16 @@ func (x *_FeeAttributes_1_list) IsValid() bool {
 }
 
 var (
-	md_FeeAttributes           protoreflect.MessageDescriptor
-	fd_FeeAttributes_fees_info protoreflect.FieldDescriptor
+	md_FeeAttributes               protoreflect.MessageDescriptor
+	fd_FeeAttributes_fees_info     protoreflect.FieldDescriptor
+	fd_FeeAttributes_max_total_fee protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_noble_orbiter_controller_action_v2_fee_proto_init()
 	md_FeeAttributes = File_noble_orbiter_controller_action_v2_fee_proto.Messages().ByName("FeeAttributes")
 	fd_FeeAttributes_fees_info = md_FeeAttributes.Fields().ByName("fees_info")
+	fd_FeeAttributes_max_total_fee = md_FeeAttributes.Fields().ByName("max_total_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_FeeAttributes)(nil)
@@ -146,6 +148,12 @@ func (x *fastReflection_FeeAttributes) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.MaxTotalFee != "" {
+		value := protoreflect.ValueOfString(x.MaxTotalFee)
+		if !f(fd_FeeAttributes_max_total_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -163,6 +171,8 @@ func (x *fastReflection_FeeAttributes) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "noble.orbiter.controller.action.v2.FeeAttributes.fees_info":
 		return len(x.FeesInfo) != 0
+	case "noble.orbiter.controller.action.v2.FeeAttributes.max_total_fee":
+		return x.MaxTotalFee != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeAttributes"))
@@ -181,6 +191,8 @@ func (x *fastReflection_FeeAttributes) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "noble.orbiter.controller.action.v2.FeeAttributes.fees_info":
 		x.FeesInfo = nil
+	case "noble.orbiter.controller.action.v2.FeeAttributes.max_total_fee":
+		x.MaxTotalFee = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeAttributes"))
@@ -203,6 +215,9 @@ func (x *fastReflection_FeeAttributes) Get(descriptor protoreflect.FieldDescript
 		}
 		listValue := &_FeeAttributes_1_list{list: &x.FeesInfo}
 		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.controller.action.v2.FeeAttributes.max_total_fee":
+		value := x.MaxTotalFee
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeAttributes"))
@@ -227,6 +242,8 @@ func (x *fastReflection_FeeAttributes) Set(fd protoreflect.FieldDescriptor, valu
 		lv := value.List()
 		clv := lv.(*_FeeAttributes_1_list)
 		x.FeesInfo = *clv.list
+	case "noble.orbiter.controller.action.v2.FeeAttributes.max_total_fee":
+		x.MaxTotalFee = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeAttributes"))
@@ -253,6 +270,8 @@ func (x *fastReflection_FeeAttributes) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_FeeAttributes_1_list{list: &x.FeesInfo}
 		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.controller.action.v2.FeeAttributes.max_total_fee":
+		panic(fmt.Errorf("field max_total_fee of message noble.orbiter.controller.action.v2.FeeAttributes is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeAttributes"))
@@ -269,6 +288,8 @@ func (x *fastReflection_FeeAttributes) NewField(fd protoreflect.FieldDescriptor)
 	case "noble.orbiter.controller.action.v2.FeeAttributes.fees_info":
 		list := []*FeeInfo{}
 		return protoreflect.ValueOfList(&_FeeAttributes_1_list{list: &list})
+	case "noble.orbiter.controller.action.v2.FeeAttributes.max_total_fee":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeAttributes"))
@@ -344,6 +365,10 @@ func (x *fastReflection_FeeAttributes) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		l = len(x.MaxTotalFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -373,6 +398,13 @@ func (x *fastReflection_FeeAttributes) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxTotalFee) > 0 {
+			i -= len(x.MaxTotalFee)
+			copy(dAtA[i:], x.MaxTotalFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxTotalFee)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.FeesInfo) > 0 {
 			for iNdEx := len(x.FeesInfo) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.FeesInfo[iNdEx])
@@ -472,6 +504,38 @@ func (x *fastReflection_FeeAttributes) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTotalFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxTotalFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -508,10 +572,11 @@ func (x *fastReflection_FeeAttributes) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_FeeInfo              protoreflect.MessageDescriptor
-	fd_FeeInfo_recipient    protoreflect.FieldDescriptor
-	fd_FeeInfo_basis_points protoreflect.FieldDescriptor
-	fd_FeeInfo_amount       protoreflect.FieldDescriptor
+	md_FeeInfo                      protoreflect.MessageDescriptor
+	fd_FeeInfo_recipient            protoreflect.FieldDescriptor
+	fd_FeeInfo_basis_points         protoreflect.FieldDescriptor
+	fd_FeeInfo_amount               protoreflect.FieldDescriptor
+	fd_FeeInfo_bounded_basis_points protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -520,6 +585,7 @@ func init() {
 	fd_FeeInfo_recipient = md_FeeInfo.Fields().ByName("recipient")
 	fd_FeeInfo_basis_points = md_FeeInfo.Fields().ByName("basis_points")
 	fd_FeeInfo_amount = md_FeeInfo.Fields().ByName("amount")
+	fd_FeeInfo_bounded_basis_points = md_FeeInfo.Fields().ByName("bounded_basis_points")
 }
 
 var _ protoreflect.Message = (*fastReflection_FeeInfo)(nil)
@@ -607,6 +673,12 @@ func (x *fastReflection_FeeInfo) Range(f func(protoreflect.FieldDescriptor, prot
 			if !f(fd_FeeInfo_amount, value) {
 				return
 			}
+		case *FeeInfo_BoundedBasisPoints_:
+			v := o.BoundedBasisPoints
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_FeeInfo_bounded_basis_points, value) {
+				return
+			}
 		}
 	}
 }
@@ -642,6 +714,14 @@ func (x *fastReflection_FeeInfo) Has(fd protoreflect.FieldDescriptor) bool {
 		} else {
 			return false
 		}
+	case "noble.orbiter.controller.action.v2.FeeInfo.bounded_basis_points":
+		if x.FeeType == nil {
+			return false
+		} else if _, ok := x.FeeType.(*FeeInfo_BoundedBasisPoints_); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo"))
@@ -664,6 +744,8 @@ func (x *fastReflection_FeeInfo) Clear(fd protoreflect.FieldDescriptor) {
 		x.FeeType = nil
 	case "noble.orbiter.controller.action.v2.FeeInfo.amount":
 		x.FeeType = nil
+	case "noble.orbiter.controller.action.v2.FeeInfo.bounded_basis_points":
+		x.FeeType = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo"))
@@ -699,6 +781,14 @@ func (x *fastReflection_FeeInfo) Get(descriptor protoreflect.FieldDescriptor) pr
 		} else {
 			return protoreflect.ValueOfMessage((*FeeInfo_Amount)(nil).ProtoReflect())
 		}
+	case "noble.orbiter.controller.action.v2.FeeInfo.bounded_basis_points":
+		if x.FeeType == nil {
+			return protoreflect.ValueOfMessage((*FeeInfo_BoundedBasisPoints)(nil).ProtoReflect())
+		} else if v, ok := x.FeeType.(*FeeInfo_BoundedBasisPoints_); ok {
+			return protoreflect.ValueOfMessage(v.BoundedBasisPoints.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*FeeInfo_BoundedBasisPoints)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo"))
@@ -727,6 +817,9 @@ func (x *fastReflection_FeeInfo) Set(fd protoreflect.FieldDescriptor, value prot
 	case "noble.orbiter.controller.action.v2.FeeInfo.amount":
 		cv := value.Message().Interface().(*FeeInfo_Amount)
 		x.FeeType = &FeeInfo_Amount_{Amount: cv}
+	case "noble.orbiter.controller.action.v2.FeeInfo.bounded_basis_points":
+		cv := value.Message().Interface().(*FeeInfo_BoundedBasisPoints)
+		x.FeeType = &FeeInfo_BoundedBasisPoints_{BoundedBasisPoints: cv}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo"))
@@ -779,6 +872,22 @@ func (x *fastReflection_FeeInfo) Mutable(fd protoreflect.FieldDescriptor) protor
 			x.FeeType = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
+	case "noble.orbiter.controller.action.v2.FeeInfo.bounded_basis_points":
+		if x.FeeType == nil {
+			value := &FeeInfo_BoundedBasisPoints{}
+			oneofValue := &FeeInfo_BoundedBasisPoints_{BoundedBasisPoints: value}
+			x.FeeType = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.FeeType.(type) {
+		case *FeeInfo_BoundedBasisPoints_:
+			return protoreflect.ValueOfMessage(m.BoundedBasisPoints.ProtoReflect())
+		default:
+			value := &FeeInfo_BoundedBasisPoints{}
+			oneofValue := &FeeInfo_BoundedBasisPoints_{BoundedBasisPoints: value}
+			x.FeeType = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	case "noble.orbiter.controller.action.v2.FeeInfo.recipient":
 		panic(fmt.Errorf("field recipient of message noble.orbiter.controller.action.v2.FeeInfo is not mutable"))
 	default:
@@ -802,6 +911,9 @@ func (x *fastReflection_FeeInfo) NewField(fd protoreflect.FieldDescriptor) proto
 	case "noble.orbiter.controller.action.v2.FeeInfo.amount":
 		value := &FeeInfo_Amount{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.orbiter.controller.action.v2.FeeInfo.bounded_basis_points":
+		value := &FeeInfo_BoundedBasisPoints{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo"))
@@ -824,6 +936,8 @@ func (x *fastReflection_FeeInfo) WhichOneof(d protoreflect.OneofDescriptor) prot
 			return x.Descriptor().Fields().ByName("basis_points")
 		case *FeeInfo_Amount_:
 			return x.Descriptor().Fields().ByName("amount")
+		case *FeeInfo_BoundedBasisPoints_:
+			return x.Descriptor().Fields().ByName("bounded_basis_points")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.controller.action.v2.FeeInfo", d.FullName()))
@@ -898,6 +1012,12 @@ func (x *fastReflection_FeeInfo) ProtoMethods() *protoiface.Methods {
 			}
 			l = options.Size(x.Amount)
 			n += 1 + l + runtime.Sov(uint64(l))
+		case *FeeInfo_BoundedBasisPoints_:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.BoundedBasisPoints)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -955,6 +1075,19 @@ func (x *fastReflection_FeeInfo) ProtoMethods() *protoiface.Methods {
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
+		case *FeeInfo_BoundedBasisPoints_:
+			encoded, err := options.Marshal(x.BoundedBasisPoints)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
 		}
 		if len(x.Recipient) > 0 {
 			i -= len(x.Recipient)
@@ -1114,6 +1247,41 @@ func (x *fastReflection_FeeInfo) ProtoMethods() *protoiface.Methods {
 				}
 				x.FeeType = &FeeInfo_Amount_{v}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BoundedBasisPoints", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &FeeInfo_BoundedBasisPoints{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.FeeType = &FeeInfo_BoundedBasisPoints_{v}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1973,123 +2141,673 @@ func (x *fastReflection_FeeInfo_Amount) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: noble/orbiter/controller/action/v2/fee.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_FeeInfo_BoundedBasisPoints            protoreflect.MessageDescriptor
+	fd_FeeInfo_BoundedBasisPoints_value      protoreflect.FieldDescriptor
+	fd_FeeInfo_BoundedBasisPoints_min_amount protoreflect.FieldDescriptor
+	fd_FeeInfo_BoundedBasisPoints_max_amount protoreflect.FieldDescriptor
 )
 
-// FeeAttributes defines the concrete implementation of the
-// ActionAttributes interface to execute a fee payment.
-type FeeAttributes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_noble_orbiter_controller_action_v2_fee_proto_init()
+	md_FeeInfo_BoundedBasisPoints = File_noble_orbiter_controller_action_v2_fee_proto.Messages().ByName("FeeInfo").Messages().ByName("BoundedBasisPoints")
+	fd_FeeInfo_BoundedBasisPoints_value = md_FeeInfo_BoundedBasisPoints.Fields().ByName("value")
+	fd_FeeInfo_BoundedBasisPoints_min_amount = md_FeeInfo_BoundedBasisPoints.Fields().ByName("min_amount")
+	fd_FeeInfo_BoundedBasisPoints_max_amount = md_FeeInfo_BoundedBasisPoints.Fields().ByName("max_amount")
+}
 
-	// fees_info defines the fees to be applied.
-	FeesInfo []*FeeInfo `protobuf:"bytes,1,rep,name=fees_info,json=feesInfo,proto3" json:"fees_info,omitempty"`
+var _ protoreflect.Message = (*fastReflection_FeeInfo_BoundedBasisPoints)(nil)
+
+type fastReflection_FeeInfo_BoundedBasisPoints FeeInfo_BoundedBasisPoints
+
+func (x *FeeInfo_BoundedBasisPoints) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeInfo_BoundedBasisPoints)(x)
 }
 
-func (x *FeeAttributes) Reset() {
-	*x = FeeAttributes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_orbiter_controller_action_v2_fee_proto_msgTypes[0]
+func (x *FeeInfo_BoundedBasisPoints) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_orbiter_controller_action_v2_fee_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *FeeAttributes) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_FeeInfo_BoundedBasisPoints_messageType fastReflection_FeeInfo_BoundedBasisPoints_messageType
+var _ protoreflect.MessageType = fastReflection_FeeInfo_BoundedBasisPoints_messageType{}
 
-func (*FeeAttributes) ProtoMessage() {}
+type fastReflection_FeeInfo_BoundedBasisPoints_messageType struct{}
 
-// Deprecated: Use FeeAttributes.ProtoReflect.Descriptor instead.
-func (*FeeAttributes) Descriptor() ([]byte, []int) {
-	return file_noble_orbiter_controller_action_v2_fee_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_FeeInfo_BoundedBasisPoints_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeInfo_BoundedBasisPoints)(nil)
 }
-
-func (x *FeeAttributes) GetFeesInfo() []*FeeInfo {
-	if x != nil {
-		return x.FeesInfo
-	}
-	return nil
+func (x fastReflection_FeeInfo_BoundedBasisPoints_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeInfo_BoundedBasisPoints)
 }
-
-// FeeInfo allows to specify a fee to apply to a forwarding and a recipient address.
-type FeeInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// recipient is the Noble address which is going to receive the fee.
-	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	// fee_type is the type of fee to apply.
-	//
-	// Types that are assignable to FeeType:
-	//
-	//	*FeeInfo_BasisPoints_
-	//	*FeeInfo_Amount_
-	FeeType isFeeInfo_FeeType `protobuf_oneof:"fee_type"`
+func (x fastReflection_FeeInfo_BoundedBasisPoints_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeInfo_BoundedBasisPoints
 }
 
-func (x *FeeInfo) Reset() {
-	*x = FeeInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_orbiter_controller_action_v2_fee_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeInfo_BoundedBasisPoints
 }
 
-func (x *FeeInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Type() protoreflect.MessageType {
+	return _fastReflection_FeeInfo_BoundedBasisPoints_messageType
 }
 
-func (*FeeInfo) ProtoMessage() {}
-
-// Deprecated: Use FeeInfo.ProtoReflect.Descriptor instead.
-func (*FeeInfo) Descriptor() ([]byte, []int) {
-	return file_noble_orbiter_controller_action_v2_fee_proto_rawDescGZIP(), []int{1}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) New() protoreflect.Message {
+	return new(fastReflection_FeeInfo_BoundedBasisPoints)
 }
 
-func (x *FeeInfo) GetRecipient() string {
-	if x != nil {
-		return x.Recipient
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Interface() protoreflect.ProtoMessage {
+	return (*FeeInfo_BoundedBasisPoints)(x)
 }
 
-func (x *FeeInfo) GetFeeType() isFeeInfo_FeeType {
-	if x != nil {
-		return x.FeeType
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Value != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Value)
+		if !f(fd_FeeInfo_BoundedBasisPoints_value, value) {
+			return
+		}
+	}
+	if x.MinAmount != "" {
+		value := protoreflect.ValueOfString(x.MinAmount)
+		if !f(fd_FeeInfo_BoundedBasisPoints_min_amount, value) {
+			return
+		}
+	}
+	if x.MaxAmount != "" {
+		value := protoreflect.ValueOfString(x.MaxAmount)
+		if !f(fd_FeeInfo_BoundedBasisPoints_max_amount, value) {
+			return
+		}
 	}
-	return nil
 }
 
-func (x *FeeInfo) GetBasisPoints() *FeeInfo_BasisPoints {
-	if x, ok := x.GetFeeType().(*FeeInfo_BasisPoints_); ok {
-		return x.BasisPoints
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.value":
+		return x.Value != uint32(0)
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.min_amount":
+		return x.MinAmount != ""
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.max_amount":
+		return x.MaxAmount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-func (x *FeeInfo) GetAmount() *FeeInfo_Amount {
-	if x, ok := x.GetFeeType().(*FeeInfo_Amount_); ok {
-		return x.Amount
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.value":
+		x.Value = uint32(0)
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.min_amount":
+		x.MinAmount = ""
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.max_amount":
+		x.MaxAmount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-type isFeeInfo_FeeType interface {
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.value":
+		value := x.Value
+		return protoreflect.ValueOfUint32(value)
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.min_amount":
+		value := x.MinAmount
+		return protoreflect.ValueOfString(value)
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.max_amount":
+		value := x.MaxAmount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.value":
+		x.Value = uint32(value.Uint())
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.min_amount":
+		x.MinAmount = value.Interface().(string)
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.max_amount":
+		x.MaxAmount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.value":
+		panic(fmt.Errorf("field value of message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints is not mutable"))
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.min_amount":
+		panic(fmt.Errorf("field min_amount of message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints is not mutable"))
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.max_amount":
+		panic(fmt.Errorf("field max_amount of message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.value":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.min_amount":
+		return protoreflect.ValueOfString("")
+	case "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints.max_amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeInfo_BoundedBasisPoints) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeInfo_BoundedBasisPoints)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Value != 0 {
+			n += 1 + runtime.Sov(uint64(x.Value))
+		}
+		l = len(x.MinAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeInfo_BoundedBasisPoints)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxAmount) > 0 {
+			i -= len(x.MaxAmount)
+			copy(dAtA[i:], x.MaxAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MinAmount) > 0 {
+			i -= len(x.MinAmount)
+			copy(dAtA[i:], x.MinAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinAmount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Value != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Value))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeInfo_BoundedBasisPoints)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeInfo_BoundedBasisPoints: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeInfo_BoundedBasisPoints: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				x.Value = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Value |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/orbiter/controller/action/v2/fee.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FeeAttributes defines the concrete implementation of the
+// ActionAttributes interface to execute a fee payment.
+type FeeAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fees_info defines the fees to be applied.
+	FeesInfo []*FeeInfo `protobuf:"bytes,1,rep,name=fees_info,json=feesInfo,proto3" json:"fees_info,omitempty"`
+	// max_total_fee is the maximum total amount of fees the sender
+	// accepts to pay, in the denom transferred when the action is
+	// executed. An empty value disables the cap.
+	MaxTotalFee string `protobuf:"bytes,2,opt,name=max_total_fee,json=maxTotalFee,proto3" json:"max_total_fee,omitempty"`
+}
+
+func (x *FeeAttributes) Reset() {
+	*x = FeeAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_controller_action_v2_fee_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeAttributes) ProtoMessage() {}
+
+// Deprecated: Use FeeAttributes.ProtoReflect.Descriptor instead.
+func (*FeeAttributes) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_controller_action_v2_fee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeeAttributes) GetFeesInfo() []*FeeInfo {
+	if x != nil {
+		return x.FeesInfo
+	}
+	return nil
+}
+
+func (x *FeeAttributes) GetMaxTotalFee() string {
+	if x != nil {
+		return x.MaxTotalFee
+	}
+	return ""
+}
+
+// FeeInfo allows to specify a fee to apply to a forwarding and a recipient address.
+type FeeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// recipient is the Noble address which is going to receive the fee.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// fee_type is the type of fee to apply.
+	//
+	// Types that are assignable to FeeType:
+	//	*FeeInfo_BasisPoints_
+	//	*FeeInfo_Amount_
+	//	*FeeInfo_BoundedBasisPoints_
+	FeeType isFeeInfo_FeeType `protobuf_oneof:"fee_type"`
+}
+
+func (x *FeeInfo) Reset() {
+	*x = FeeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_controller_action_v2_fee_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeInfo) ProtoMessage() {}
+
+// Deprecated: Use FeeInfo.ProtoReflect.Descriptor instead.
+func (*FeeInfo) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_controller_action_v2_fee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeInfo) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *FeeInfo) GetFeeType() isFeeInfo_FeeType {
+	if x != nil {
+		return x.FeeType
+	}
+	return nil
+}
+
+func (x *FeeInfo) GetBasisPoints() *FeeInfo_BasisPoints {
+	if x, ok := x.GetFeeType().(*FeeInfo_BasisPoints_); ok {
+		return x.BasisPoints
+	}
+	return nil
+}
+
+func (x *FeeInfo) GetAmount() *FeeInfo_Amount {
+	if x, ok := x.GetFeeType().(*FeeInfo_Amount_); ok {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *FeeInfo) GetBoundedBasisPoints() *FeeInfo_BoundedBasisPoints {
+	if x, ok := x.GetFeeType().(*FeeInfo_BoundedBasisPoints_); ok {
+		return x.BoundedBasisPoints
+	}
+	return nil
+}
+
+type isFeeInfo_FeeType interface {
 	isFeeInfo_FeeType()
 }
 
@@ -2105,10 +2823,19 @@ type FeeInfo_Amount_ struct {
 	Amount *FeeInfo_Amount `protobuf:"bytes,3,opt,name=amount,proto3,oneof"`
 }
 
+type FeeInfo_BoundedBasisPoints_ struct {
+	// bounded_basis_points represents the fee to apply to the tokens sent
+	// with a cross-chain packet in basis points, raised to the minimum
+	// amount and capped to the maximum amount.
+	BoundedBasisPoints *FeeInfo_BoundedBasisPoints `protobuf:"bytes,4,opt,name=bounded_basis_points,json=boundedBasisPoints,proto3,oneof"`
+}
+
 func (*FeeInfo_BasisPoints_) isFeeInfo_FeeType() {}
 
 func (*FeeInfo_Amount_) isFeeInfo_FeeType() {}
 
+func (*FeeInfo_BoundedBasisPoints_) isFeeInfo_FeeType() {}
+
 // BasisPoints allows to define a fee in terms of basis points.
 type FeeInfo_BasisPoints struct {
 	state         protoimpl.MessageState
@@ -2183,6 +2910,62 @@ func (x *FeeInfo_Amount) GetValue() string {
 	return ""
 }
 
+// BoundedBasisPoints allows to define a fee in terms of basis points
+// bounded by a minimum and a maximum amount.
+type FeeInfo_BoundedBasisPoints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Basis points value.
+	Value uint32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Minimum fee amount. An empty value disables the minimum.
+	MinAmount string `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// Maximum fee amount. An empty value disables the maximum.
+	MaxAmount string `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+}
+
+func (x *FeeInfo_BoundedBasisPoints) Reset() {
+	*x = FeeInfo_BoundedBasisPoints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_controller_action_v2_fee_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeInfo_BoundedBasisPoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeInfo_BoundedBasisPoints) ProtoMessage() {}
+
+// Deprecated: Use FeeInfo_BoundedBasisPoints.ProtoReflect.Descriptor instead.
+func (*FeeInfo_BoundedBasisPoints) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_controller_action_v2_fee_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *FeeInfo_BoundedBasisPoints) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FeeInfo_BoundedBasisPoints) GetMinAmount() string {
+	if x != nil {
+		return x.MinAmount
+	}
+	return ""
+}
+
+func (x *FeeInfo_BoundedBasisPoints) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
 var File_noble_orbiter_controller_action_v2_fee_proto protoreflect.FileDescriptor
 
 var file_noble_orbiter_controller_action_v2_fee_proto_rawDesc = []byte{
@@ -2192,55 +2975,72 @@ var file_noble_orbiter_controller_action_v2_fee_proto_rawDesc = []byte{
 	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
 	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x76, 0x32, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x01,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa4, 0x01,
 	0x0a, 0x0d, 0x46, 0x65, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
 	0x48, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74,
 	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x08, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x3a, 0x25, 0xca, 0xb4, 0x2d, 0x21, 0x6e,
-	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
-	0x22, 0xbe, 0x02, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x09,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e,
-	0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69,
-	0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x4c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74,
+	0x08, 0x66, 0x65, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x3a, 0x25, 0xca,
+	0xb4, 0x2d, 0x21, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x22, 0x9c, 0x04, 0x0a, 0x07, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x36, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x0c, 0x62, 0x61, 0x73, 0x69,
+	0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x42, 0x61, 0x73, 0x69,
+	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x61, 0x73, 0x69, 0x73,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f,
+	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x72, 0x0a, 0x14, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74,
 	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x2e,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x1a, 0x23, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x1e, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x42, 0xb3, 0x02, 0x0a, 0x26, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
-	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
-	0x65, 0x72, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x42, 0x08, 0x46, 0x65,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x76, 0x32, 0x3b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0xa2, 0x02, 0x04, 0x4e,
-	0x4f, 0x43, 0x41, 0xaa, 0x02, 0x22, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x62, 0x69,
-	0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x22, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
-	0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
-	0x6c, 0x65, 0x72, 0x5c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x32, 0xe2, 0x02, 0x2e,
-	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c,
-	0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x26, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x3a,
-	0x3a, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x3a, 0x3a, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x48, 0x00, 0x52, 0x12, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73,
+	0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x23, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x69,
+	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x1e, 0x0a,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x68, 0x0a,
+	0x12, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x61,
+	0x78, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x42, 0xb3, 0x02, 0x0a, 0x26, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
+	0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x32, 0x42, 0x08,
+	0x46, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x52, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
+	0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x76, 0x32, 0x3b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x32, 0xa2, 0x02,
+	0x04, 0x4e, 0x4f, 0x43, 0x41, 0xaa, 0x02, 0x22, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72,
+	0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x32, 0xca, 0x02, 0x22, 0x4e, 0x6f, 0x62,
+	0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x32, 0xe2,
+	0x02, 0x2e, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5c, 0x56, 0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x26, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65,
+	0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x3a, 0x3a, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -2255,22 +3055,24 @@ func file_noble_orbiter_controller_action_v2_fee_proto_rawDescGZIP() []byte {
 	return file_noble_orbiter_controller_action_v2_fee_proto_rawDescData
 }
 
-var file_noble_orbiter_controller_action_v2_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_noble_orbiter_controller_action_v2_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_noble_orbiter_controller_action_v2_fee_proto_goTypes = []interface{}{
-	(*FeeAttributes)(nil),       // 0: noble.orbiter.controller.action.v2.FeeAttributes
-	(*FeeInfo)(nil),             // 1: noble.orbiter.controller.action.v2.FeeInfo
-	(*FeeInfo_BasisPoints)(nil), // 2: noble.orbiter.controller.action.v2.FeeInfo.BasisPoints
-	(*FeeInfo_Amount)(nil),      // 3: noble.orbiter.controller.action.v2.FeeInfo.Amount
+	(*FeeAttributes)(nil),              // 0: noble.orbiter.controller.action.v2.FeeAttributes
+	(*FeeInfo)(nil),                    // 1: noble.orbiter.controller.action.v2.FeeInfo
+	(*FeeInfo_BasisPoints)(nil),        // 2: noble.orbiter.controller.action.v2.FeeInfo.BasisPoints
+	(*FeeInfo_Amount)(nil),             // 3: noble.orbiter.controller.action.v2.FeeInfo.Amount
+	(*FeeInfo_BoundedBasisPoints)(nil), // 4: noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints
 }
 var file_noble_orbiter_controller_action_v2_fee_proto_depIdxs = []int32{
 	1, // 0: noble.orbiter.controller.action.v2.FeeAttributes.fees_info:type_name -> noble.orbiter.controller.action.v2.FeeInfo
 	2, // 1: noble.orbiter.controller.action.v2.FeeInfo.basis_points:type_name -> noble.orbiter.controller.action.v2.FeeInfo.BasisPoints
 	3, // 2: noble.orbiter.controller.action.v2.FeeInfo.amount:type_name -> noble.orbiter.controller.action.v2.FeeInfo.Amount
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 3: noble.orbiter.controller.action.v2.FeeInfo.bounded_basis_points:type_name -> noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_noble_orbiter_controller_action_v2_fee_proto_init() }
@@ -2327,10 +3129,23 @@ func file_noble_orbiter_controller_action_v2_fee_proto_init() {
 				return nil
 			}
 		}
+		file_noble_orbiter_controller_action_v2_fee_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeInfo_BoundedBasisPoints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_noble_orbiter_controller_action_v2_fee_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*FeeInfo_BasisPoints_)(nil),
 		(*FeeInfo_Amount_)(nil),
+		(*FeeInfo_BoundedBasisPoints_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2338,7 +3153,7 @@ func file_noble_orbiter_controller_action_v2_fee_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_orbiter_controller_action_v2_fee_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
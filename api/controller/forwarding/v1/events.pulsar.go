@@ -0,0 +1,1992 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package forwardingv1
+
+import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_EventCCTPPayloadMessageSent                    protoreflect.MessageDescriptor
+	fd_EventCCTPPayloadMessageSent_destination_domain protoreflect.FieldDescriptor
+	fd_EventCCTPPayloadMessageSent_transfer_nonce     protoreflect.FieldDescriptor
+	fd_EventCCTPPayloadMessageSent_payload_nonce      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_orbiter_controller_forwarding_v1_events_proto_init()
+	md_EventCCTPPayloadMessageSent = File_noble_orbiter_controller_forwarding_v1_events_proto.Messages().ByName("EventCCTPPayloadMessageSent")
+	fd_EventCCTPPayloadMessageSent_destination_domain = md_EventCCTPPayloadMessageSent.Fields().ByName("destination_domain")
+	fd_EventCCTPPayloadMessageSent_transfer_nonce = md_EventCCTPPayloadMessageSent.Fields().ByName("transfer_nonce")
+	fd_EventCCTPPayloadMessageSent_payload_nonce = md_EventCCTPPayloadMessageSent.Fields().ByName("payload_nonce")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventCCTPPayloadMessageSent)(nil)
+
+type fastReflection_EventCCTPPayloadMessageSent EventCCTPPayloadMessageSent
+
+func (x *EventCCTPPayloadMessageSent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventCCTPPayloadMessageSent)(x)
+}
+
+func (x *EventCCTPPayloadMessageSent) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventCCTPPayloadMessageSent_messageType fastReflection_EventCCTPPayloadMessageSent_messageType
+var _ protoreflect.MessageType = fastReflection_EventCCTPPayloadMessageSent_messageType{}
+
+type fastReflection_EventCCTPPayloadMessageSent_messageType struct{}
+
+func (x fastReflection_EventCCTPPayloadMessageSent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventCCTPPayloadMessageSent)(nil)
+}
+func (x fastReflection_EventCCTPPayloadMessageSent_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventCCTPPayloadMessageSent)
+}
+func (x fastReflection_EventCCTPPayloadMessageSent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCCTPPayloadMessageSent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventCCTPPayloadMessageSent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Type() protoreflect.MessageType {
+	return _fastReflection_EventCCTPPayloadMessageSent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventCCTPPayloadMessageSent) New() protoreflect.Message {
+	return new(fastReflection_EventCCTPPayloadMessageSent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Interface() protoreflect.ProtoMessage {
+	return (*EventCCTPPayloadMessageSent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestinationDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DestinationDomain)
+		if !f(fd_EventCCTPPayloadMessageSent_destination_domain, value) {
+			return
+		}
+	}
+	if x.TransferNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TransferNonce)
+		if !f(fd_EventCCTPPayloadMessageSent_transfer_nonce, value) {
+			return
+		}
+	}
+	if x.PayloadNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PayloadNonce)
+		if !f(fd_EventCCTPPayloadMessageSent_payload_nonce, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.destination_domain":
+		return x.DestinationDomain != uint32(0)
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.transfer_nonce":
+		return x.TransferNonce != uint64(0)
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.payload_nonce":
+		return x.PayloadNonce != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.destination_domain":
+		x.DestinationDomain = uint32(0)
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.transfer_nonce":
+		x.TransferNonce = uint64(0)
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.payload_nonce":
+		x.PayloadNonce = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.destination_domain":
+		value := x.DestinationDomain
+		return protoreflect.ValueOfUint32(value)
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.transfer_nonce":
+		value := x.TransferNonce
+		return protoreflect.ValueOfUint64(value)
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.payload_nonce":
+		value := x.PayloadNonce
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.destination_domain":
+		x.DestinationDomain = uint32(value.Uint())
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.transfer_nonce":
+		x.TransferNonce = value.Uint()
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.payload_nonce":
+		x.PayloadNonce = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCCTPPayloadMessageSent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.destination_domain":
+		panic(fmt.Errorf("field destination_domain of message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent is not mutable"))
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.transfer_nonce":
+		panic(fmt.Errorf("field transfer_nonce of message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent is not mutable"))
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.payload_nonce":
+		panic(fmt.Errorf("field payload_nonce of message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventCCTPPayloadMessageSent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.destination_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.transfer_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent.payload_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventCCTPPayloadMessageSent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventCCTPPayloadMessageSent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventCCTPPayloadMessageSent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventCCTPPayloadMessageSent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventCCTPPayloadMessageSent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventCCTPPayloadMessageSent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DestinationDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		}
+		if x.TransferNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.TransferNonce))
+		}
+		if x.PayloadNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.PayloadNonce))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventCCTPPayloadMessageSent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PayloadNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PayloadNonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TransferNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TransferNonce))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.DestinationDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventCCTPPayloadMessageSent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCCTPPayloadMessageSent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventCCTPPayloadMessageSent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				}
+				x.DestinationDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferNonce", wireType)
+				}
+				x.TransferNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TransferNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayloadNonce", wireType)
+				}
+				x.PayloadNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PayloadNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventHyperlanePayloadMessageSent                     protoreflect.MessageDescriptor
+	fd_EventHyperlanePayloadMessageSent_destination_domain  protoreflect.FieldDescriptor
+	fd_EventHyperlanePayloadMessageSent_transfer_message_id protoreflect.FieldDescriptor
+	fd_EventHyperlanePayloadMessageSent_payload_message_id  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_orbiter_controller_forwarding_v1_events_proto_init()
+	md_EventHyperlanePayloadMessageSent = File_noble_orbiter_controller_forwarding_v1_events_proto.Messages().ByName("EventHyperlanePayloadMessageSent")
+	fd_EventHyperlanePayloadMessageSent_destination_domain = md_EventHyperlanePayloadMessageSent.Fields().ByName("destination_domain")
+	fd_EventHyperlanePayloadMessageSent_transfer_message_id = md_EventHyperlanePayloadMessageSent.Fields().ByName("transfer_message_id")
+	fd_EventHyperlanePayloadMessageSent_payload_message_id = md_EventHyperlanePayloadMessageSent.Fields().ByName("payload_message_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventHyperlanePayloadMessageSent)(nil)
+
+type fastReflection_EventHyperlanePayloadMessageSent EventHyperlanePayloadMessageSent
+
+func (x *EventHyperlanePayloadMessageSent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventHyperlanePayloadMessageSent)(x)
+}
+
+func (x *EventHyperlanePayloadMessageSent) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventHyperlanePayloadMessageSent_messageType fastReflection_EventHyperlanePayloadMessageSent_messageType
+var _ protoreflect.MessageType = fastReflection_EventHyperlanePayloadMessageSent_messageType{}
+
+type fastReflection_EventHyperlanePayloadMessageSent_messageType struct{}
+
+func (x fastReflection_EventHyperlanePayloadMessageSent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventHyperlanePayloadMessageSent)(nil)
+}
+func (x fastReflection_EventHyperlanePayloadMessageSent_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventHyperlanePayloadMessageSent)
+}
+func (x fastReflection_EventHyperlanePayloadMessageSent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHyperlanePayloadMessageSent
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHyperlanePayloadMessageSent
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Type() protoreflect.MessageType {
+	return _fastReflection_EventHyperlanePayloadMessageSent_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) New() protoreflect.Message {
+	return new(fastReflection_EventHyperlanePayloadMessageSent)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Interface() protoreflect.ProtoMessage {
+	return (*EventHyperlanePayloadMessageSent)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestinationDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DestinationDomain)
+		if !f(fd_EventHyperlanePayloadMessageSent_destination_domain, value) {
+			return
+		}
+	}
+	if x.TransferMessageId != "" {
+		value := protoreflect.ValueOfString(x.TransferMessageId)
+		if !f(fd_EventHyperlanePayloadMessageSent_transfer_message_id, value) {
+			return
+		}
+	}
+	if x.PayloadMessageId != "" {
+		value := protoreflect.ValueOfString(x.PayloadMessageId)
+		if !f(fd_EventHyperlanePayloadMessageSent_payload_message_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.destination_domain":
+		return x.DestinationDomain != uint32(0)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.transfer_message_id":
+		return x.TransferMessageId != ""
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.payload_message_id":
+		return x.PayloadMessageId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.destination_domain":
+		x.DestinationDomain = uint32(0)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.transfer_message_id":
+		x.TransferMessageId = ""
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.payload_message_id":
+		x.PayloadMessageId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.destination_domain":
+		value := x.DestinationDomain
+		return protoreflect.ValueOfUint32(value)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.transfer_message_id":
+		value := x.TransferMessageId
+		return protoreflect.ValueOfString(value)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.payload_message_id":
+		value := x.PayloadMessageId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.destination_domain":
+		x.DestinationDomain = uint32(value.Uint())
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.transfer_message_id":
+		x.TransferMessageId = value.Interface().(string)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.payload_message_id":
+		x.PayloadMessageId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.destination_domain":
+		panic(fmt.Errorf("field destination_domain of message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent is not mutable"))
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.transfer_message_id":
+		panic(fmt.Errorf("field transfer_message_id of message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent is not mutable"))
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.payload_message_id":
+		panic(fmt.Errorf("field payload_message_id of message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.destination_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.transfer_message_id":
+		return protoreflect.ValueOfString("")
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent.payload_message_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventHyperlanePayloadMessageSent) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventHyperlanePayloadMessageSent)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DestinationDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		}
+		l = len(x.TransferMessageId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PayloadMessageId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventHyperlanePayloadMessageSent)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PayloadMessageId) > 0 {
+			i -= len(x.PayloadMessageId)
+			copy(dAtA[i:], x.PayloadMessageId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PayloadMessageId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TransferMessageId) > 0 {
+			i -= len(x.TransferMessageId)
+			copy(dAtA[i:], x.TransferMessageId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TransferMessageId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DestinationDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventHyperlanePayloadMessageSent)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHyperlanePayloadMessageSent: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHyperlanePayloadMessageSent: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				}
+				x.DestinationDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferMessageId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferMessageId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayloadMessageId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayloadMessageId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventHyperlaneFeePaid                     protoreflect.MessageDescriptor
+	fd_EventHyperlaneFeePaid_destination_domain  protoreflect.FieldDescriptor
+	fd_EventHyperlaneFeePaid_transfer_message_id protoreflect.FieldDescriptor
+	fd_EventHyperlaneFeePaid_fee                 protoreflect.FieldDescriptor
+	fd_EventHyperlaneFeePaid_deducted            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_orbiter_controller_forwarding_v1_events_proto_init()
+	md_EventHyperlaneFeePaid = File_noble_orbiter_controller_forwarding_v1_events_proto.Messages().ByName("EventHyperlaneFeePaid")
+	fd_EventHyperlaneFeePaid_destination_domain = md_EventHyperlaneFeePaid.Fields().ByName("destination_domain")
+	fd_EventHyperlaneFeePaid_transfer_message_id = md_EventHyperlaneFeePaid.Fields().ByName("transfer_message_id")
+	fd_EventHyperlaneFeePaid_fee = md_EventHyperlaneFeePaid.Fields().ByName("fee")
+	fd_EventHyperlaneFeePaid_deducted = md_EventHyperlaneFeePaid.Fields().ByName("deducted")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventHyperlaneFeePaid)(nil)
+
+type fastReflection_EventHyperlaneFeePaid EventHyperlaneFeePaid
+
+func (x *EventHyperlaneFeePaid) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventHyperlaneFeePaid)(x)
+}
+
+func (x *EventHyperlaneFeePaid) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventHyperlaneFeePaid_messageType fastReflection_EventHyperlaneFeePaid_messageType
+var _ protoreflect.MessageType = fastReflection_EventHyperlaneFeePaid_messageType{}
+
+type fastReflection_EventHyperlaneFeePaid_messageType struct{}
+
+func (x fastReflection_EventHyperlaneFeePaid_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventHyperlaneFeePaid)(nil)
+}
+func (x fastReflection_EventHyperlaneFeePaid_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventHyperlaneFeePaid)
+}
+func (x fastReflection_EventHyperlaneFeePaid_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHyperlaneFeePaid
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventHyperlaneFeePaid) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventHyperlaneFeePaid
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventHyperlaneFeePaid) Type() protoreflect.MessageType {
+	return _fastReflection_EventHyperlaneFeePaid_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventHyperlaneFeePaid) New() protoreflect.Message {
+	return new(fastReflection_EventHyperlaneFeePaid)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventHyperlaneFeePaid) Interface() protoreflect.ProtoMessage {
+	return (*EventHyperlaneFeePaid)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventHyperlaneFeePaid) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DestinationDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DestinationDomain)
+		if !f(fd_EventHyperlaneFeePaid_destination_domain, value) {
+			return
+		}
+	}
+	if x.TransferMessageId != "" {
+		value := protoreflect.ValueOfString(x.TransferMessageId)
+		if !f(fd_EventHyperlaneFeePaid_transfer_message_id, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_EventHyperlaneFeePaid_fee, value) {
+			return
+		}
+	}
+	if x.Deducted != false {
+		value := protoreflect.ValueOfBool(x.Deducted)
+		if !f(fd_EventHyperlaneFeePaid_deducted, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventHyperlaneFeePaid) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.destination_domain":
+		return x.DestinationDomain != uint32(0)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.transfer_message_id":
+		return x.TransferMessageId != ""
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.fee":
+		return x.Fee != nil
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.deducted":
+		return x.Deducted != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHyperlaneFeePaid) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.destination_domain":
+		x.DestinationDomain = uint32(0)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.transfer_message_id":
+		x.TransferMessageId = ""
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.fee":
+		x.Fee = nil
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.deducted":
+		x.Deducted = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventHyperlaneFeePaid) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.destination_domain":
+		value := x.DestinationDomain
+		return protoreflect.ValueOfUint32(value)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.transfer_message_id":
+		value := x.TransferMessageId
+		return protoreflect.ValueOfString(value)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.fee":
+		value := x.Fee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.deducted":
+		value := x.Deducted
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHyperlaneFeePaid) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.destination_domain":
+		x.DestinationDomain = uint32(value.Uint())
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.transfer_message_id":
+		x.TransferMessageId = value.Interface().(string)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.fee":
+		x.Fee = value.Message().Interface().(*v1beta1.Coin)
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.deducted":
+		x.Deducted = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHyperlaneFeePaid) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.destination_domain":
+		panic(fmt.Errorf("field destination_domain of message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid is not mutable"))
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.transfer_message_id":
+		panic(fmt.Errorf("field transfer_message_id of message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid is not mutable"))
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.deducted":
+		panic(fmt.Errorf("field deducted of message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventHyperlaneFeePaid) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.destination_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.transfer_message_id":
+		return protoreflect.ValueOfString("")
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.deducted":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventHyperlaneFeePaid) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventHyperlaneFeePaid) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventHyperlaneFeePaid) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventHyperlaneFeePaid) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventHyperlaneFeePaid) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventHyperlaneFeePaid)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DestinationDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		}
+		l = len(x.TransferMessageId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Deducted {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventHyperlaneFeePaid)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Deducted {
+			i--
+			if x.Deducted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TransferMessageId) > 0 {
+			i -= len(x.TransferMessageId)
+			copy(dAtA[i:], x.TransferMessageId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TransferMessageId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.DestinationDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventHyperlaneFeePaid)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHyperlaneFeePaid: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventHyperlaneFeePaid: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				}
+				x.DestinationDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TransferMessageId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TransferMessageId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deducted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Deducted = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: noble/orbiter/controller/forwarding/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventCCTPPayloadMessageSent is emitted when the passthrough payload of a
+// CCTP forwarding is sent as a general message linked to the burn message.
+type EventCCTPPayloadMessageSent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	TransferNonce     uint64 `protobuf:"varint,2,opt,name=transfer_nonce,json=transferNonce,proto3" json:"transfer_nonce,omitempty"`
+	PayloadNonce      uint64 `protobuf:"varint,3,opt,name=payload_nonce,json=payloadNonce,proto3" json:"payload_nonce,omitempty"`
+}
+
+func (x *EventCCTPPayloadMessageSent) Reset() {
+	*x = EventCCTPPayloadMessageSent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCCTPPayloadMessageSent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCCTPPayloadMessageSent) ProtoMessage() {}
+
+// Deprecated: Use EventCCTPPayloadMessageSent.ProtoReflect.Descriptor instead.
+func (*EventCCTPPayloadMessageSent) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventCCTPPayloadMessageSent) GetDestinationDomain() uint32 {
+	if x != nil {
+		return x.DestinationDomain
+	}
+	return 0
+}
+
+func (x *EventCCTPPayloadMessageSent) GetTransferNonce() uint64 {
+	if x != nil {
+		return x.TransferNonce
+	}
+	return 0
+}
+
+func (x *EventCCTPPayloadMessageSent) GetPayloadNonce() uint64 {
+	if x != nil {
+		return x.PayloadNonce
+	}
+	return 0
+}
+
+// EventHyperlanePayloadMessageSent is emitted when the passthrough payload of a
+// Hyperlane forwarding is dispatched as a message linked to the warp transfer.
+type EventHyperlanePayloadMessageSent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	TransferMessageId string `protobuf:"bytes,2,opt,name=transfer_message_id,json=transferMessageId,proto3" json:"transfer_message_id,omitempty"`
+	PayloadMessageId  string `protobuf:"bytes,3,opt,name=payload_message_id,json=payloadMessageId,proto3" json:"payload_message_id,omitempty"`
+}
+
+func (x *EventHyperlanePayloadMessageSent) Reset() {
+	*x = EventHyperlanePayloadMessageSent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventHyperlanePayloadMessageSent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHyperlanePayloadMessageSent) ProtoMessage() {}
+
+// Deprecated: Use EventHyperlanePayloadMessageSent.ProtoReflect.Descriptor instead.
+func (*EventHyperlanePayloadMessageSent) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventHyperlanePayloadMessageSent) GetDestinationDomain() uint32 {
+	if x != nil {
+		return x.DestinationDomain
+	}
+	return 0
+}
+
+func (x *EventHyperlanePayloadMessageSent) GetTransferMessageId() string {
+	if x != nil {
+		return x.TransferMessageId
+	}
+	return ""
+}
+
+func (x *EventHyperlanePayloadMessageSent) GetPayloadMessageId() string {
+	if x != nil {
+		return x.PayloadMessageId
+	}
+	return ""
+}
+
+// EventHyperlaneFeePaid is emitted when the quoted post dispatch hooks fee of
+// a Hyperlane forwarding with automatic fee is paid.
+type EventHyperlaneFeePaid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DestinationDomain uint32        `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	TransferMessageId string        `protobuf:"bytes,2,opt,name=transfer_message_id,json=transferMessageId,proto3" json:"transfer_message_id,omitempty"`
+	Fee               *v1beta1.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// deducted is true if the fee has been deducted from the transferred amount.
+	Deducted bool `protobuf:"varint,4,opt,name=deducted,proto3" json:"deducted,omitempty"`
+}
+
+func (x *EventHyperlaneFeePaid) Reset() {
+	*x = EventHyperlaneFeePaid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventHyperlaneFeePaid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventHyperlaneFeePaid) ProtoMessage() {}
+
+// Deprecated: Use EventHyperlaneFeePaid.ProtoReflect.Descriptor instead.
+func (*EventHyperlaneFeePaid) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventHyperlaneFeePaid) GetDestinationDomain() uint32 {
+	if x != nil {
+		return x.DestinationDomain
+	}
+	return 0
+}
+
+func (x *EventHyperlaneFeePaid) GetTransferMessageId() string {
+	if x != nil {
+		return x.TransferMessageId
+	}
+	return ""
+}
+
+func (x *EventHyperlaneFeePaid) GetFee() *v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *EventHyperlaneFeePaid) GetDeducted() bool {
+	if x != nil {
+		return x.Deducted
+	}
+	return false
+}
+
+var File_noble_orbiter_controller_forwarding_v1_events_proto protoreflect.FileDescriptor
+
+var file_noble_orbiter_controller_forwarding_v1_events_proto_rawDesc = []byte{
+	0x0a, 0x33, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x26, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62,
+	0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x98, 0x01, 0x0a, 0x1b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x43, 0x54, 0x50, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4e, 0x6f, 0x6e, 0x63,
+	0x65, 0x22, 0xaf, 0x01, 0x0a, 0x20, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x79, 0x70, 0x65, 0x72,
+	0x6c, 0x61, 0x6e, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x79, 0x70,
+	0x65, 0x72, 0x6c, 0x61, 0x6e, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2d, 0x0a,
+	0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x13,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x64, 0x75, 0x63, 0x74, 0x65, 0x64,
+	0x42, 0xd2, 0x02, 0x0a, 0x2a, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f,
+	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f,
+	0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62,
+	0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x04, 0x4e, 0x4f, 0x43,
+	0x46, 0xaa, 0x02, 0x26, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x26, 0x4e, 0x6f, 0x62,
+	0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x32, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69,
+	0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x2a, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
+	0x3a, 0x3a, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescOnce sync.Once
+	file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescData = file_noble_orbiter_controller_forwarding_v1_events_proto_rawDesc
+)
+
+func file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescGZIP() []byte {
+	file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescOnce.Do(func() {
+		file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescData = protoimpl.X.CompressGZIP(file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescData)
+	})
+	return file_noble_orbiter_controller_forwarding_v1_events_proto_rawDescData
+}
+
+var file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_noble_orbiter_controller_forwarding_v1_events_proto_goTypes = []interface{}{
+	(*EventCCTPPayloadMessageSent)(nil),      // 0: noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent
+	(*EventHyperlanePayloadMessageSent)(nil), // 1: noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent
+	(*EventHyperlaneFeePaid)(nil),            // 2: noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid
+	(*v1beta1.Coin)(nil),                     // 3: cosmos.base.v1beta1.Coin
+}
+var file_noble_orbiter_controller_forwarding_v1_events_proto_depIdxs = []int32{
+	3, // 0: noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid.fee:type_name -> cosmos.base.v1beta1.Coin
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_noble_orbiter_controller_forwarding_v1_events_proto_init() }
+func file_noble_orbiter_controller_forwarding_v1_events_proto_init() {
+	if File_noble_orbiter_controller_forwarding_v1_events_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventCCTPPayloadMessageSent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventHyperlanePayloadMessageSent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventHyperlaneFeePaid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_noble_orbiter_controller_forwarding_v1_events_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_noble_orbiter_controller_forwarding_v1_events_proto_goTypes,
+		DependencyIndexes: file_noble_orbiter_controller_forwarding_v1_events_proto_depIdxs,
+		MessageInfos:      file_noble_orbiter_controller_forwarding_v1_events_proto_msgTypes,
+	}.Build()
+	File_noble_orbiter_controller_forwarding_v1_events_proto = out.File
+	file_noble_orbiter_controller_forwarding_v1_events_proto_rawDesc = nil
+	file_noble_orbiter_controller_forwarding_v1_events_proto_goTypes = nil
+	file_noble_orbiter_controller_forwarding_v1_events_proto_depIdxs = nil
+}
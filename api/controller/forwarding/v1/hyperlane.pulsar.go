@@ -25,6 +25,7 @@ var (
 	fd_HypAttributes_custom_hook_metadata protoreflect.FieldDescriptor
 	fd_HypAttributes_gas_limit            protoreflect.FieldDescriptor
 	fd_HypAttributes_max_fee              protoreflect.FieldDescriptor
+	fd_HypAttributes_auto_fee             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -37,6 +38,7 @@ func init() {
 	fd_HypAttributes_custom_hook_metadata = md_HypAttributes.Fields().ByName("custom_hook_metadata")
 	fd_HypAttributes_gas_limit = md_HypAttributes.Fields().ByName("gas_limit")
 	fd_HypAttributes_max_fee = md_HypAttributes.Fields().ByName("max_fee")
+	fd_HypAttributes_auto_fee = md_HypAttributes.Fields().ByName("auto_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_HypAttributes)(nil)
@@ -146,6 +148,12 @@ func (x *fastReflection_HypAttributes) Range(f func(protoreflect.FieldDescriptor
 			return
 		}
 	}
+	if x.AutoFee != false {
+		value := protoreflect.ValueOfBool(x.AutoFee)
+		if !f(fd_HypAttributes_auto_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -175,6 +183,8 @@ func (x *fastReflection_HypAttributes) Has(fd protoreflect.FieldDescriptor) bool
 		return x.GasLimit != ""
 	case "noble.orbiter.controller.forwarding.v1.HypAttributes.max_fee":
 		return x.MaxFee != nil
+	case "noble.orbiter.controller.forwarding.v1.HypAttributes.auto_fee":
+		return x.AutoFee != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.HypAttributes"))
@@ -205,6 +215,8 @@ func (x *fastReflection_HypAttributes) Clear(fd protoreflect.FieldDescriptor) {
 		x.GasLimit = ""
 	case "noble.orbiter.controller.forwarding.v1.HypAttributes.max_fee":
 		x.MaxFee = nil
+	case "noble.orbiter.controller.forwarding.v1.HypAttributes.auto_fee":
+		x.AutoFee = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.HypAttributes"))
@@ -242,6 +254,9 @@ func (x *fastReflection_HypAttributes) Get(descriptor protoreflect.FieldDescript
 	case "noble.orbiter.controller.forwarding.v1.HypAttributes.max_fee":
 		value := x.MaxFee
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.orbiter.controller.forwarding.v1.HypAttributes.auto_fee":
+		value := x.AutoFee
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.HypAttributes"))
@@ -276,6 +291,8 @@ func (x *fastReflection_HypAttributes) Set(fd protoreflect.FieldDescriptor, valu
 		x.GasLimit = value.Interface().(string)
 	case "noble.orbiter.controller.forwarding.v1.HypAttributes.max_fee":
 		x.MaxFee = value.Message().Interface().(*v1beta1.Coin)
+	case "noble.orbiter.controller.forwarding.v1.HypAttributes.auto_fee":
+		x.AutoFee = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.HypAttributes"))
@@ -313,6 +330,8 @@ func (x *fastReflection_HypAttributes) Mutable(fd protoreflect.FieldDescriptor)
 		panic(fmt.Errorf("field custom_hook_metadata of message noble.orbiter.controller.forwarding.v1.HypAttributes is not mutable"))
 	case "noble.orbiter.controller.forwarding.v1.HypAttributes.gas_limit":
 		panic(fmt.Errorf("field gas_limit of message noble.orbiter.controller.forwarding.v1.HypAttributes is not mutable"))
+	case "noble.orbiter.controller.forwarding.v1.HypAttributes.auto_fee":
+		panic(fmt.Errorf("field auto_fee of message noble.orbiter.controller.forwarding.v1.HypAttributes is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.HypAttributes"))
@@ -341,6 +360,8 @@ func (x *fastReflection_HypAttributes) NewField(fd protoreflect.FieldDescriptor)
 	case "noble.orbiter.controller.forwarding.v1.HypAttributes.max_fee":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.orbiter.controller.forwarding.v1.HypAttributes.auto_fee":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.controller.forwarding.v1.HypAttributes"))
@@ -437,6 +458,9 @@ func (x *fastReflection_HypAttributes) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.MaxFee)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.AutoFee {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -466,6 +490,16 @@ func (x *fastReflection_HypAttributes) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.AutoFee {
+			i--
+			if x.AutoFee {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
 		if x.MaxFee != nil {
 			encoded, err := options.Marshal(x.MaxFee)
 			if err != nil {
@@ -790,6 +824,26 @@ func (x *fastReflection_HypAttributes) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoFee", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.AutoFee = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -847,7 +901,8 @@ type HypAttributes struct {
 	unknownFields protoimpl.UnknownFields
 
 	// token_id represents the unique ID assigned to a token by the Hyperlane
-	// app router.
+	// app router. When empty, the token registered by the authority for the
+	// forwarded denom and the destination domain is used.
 	TokenId []byte `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 	// destination_domain represents the Hyperlane destination
 	// domain identifier: https://docs.hyperlane.xyz/docs/reference/domains.
@@ -867,6 +922,12 @@ type HypAttributes struct {
 	GasLimit string `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// max_fee is the maximum fee allowed for the execution of post dispatch hooks.
 	MaxFee *v1beta1.Coin `protobuf:"bytes,7,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	// auto_fee enables the on-chain quoting of the post dispatch hooks fee.
+	// The quoted fee is deducted from the transferred amount when the denoms
+	// match, otherwise it is paid by the module account. When enabled, the gas
+	// limit of the enrolled router is used and the max_fee, if set, caps the
+	// quoted fee.
+	AutoFee bool `protobuf:"varint,8,opt,name=auto_fee,json=autoFee,proto3" json:"auto_fee,omitempty"`
 }
 
 func (x *HypAttributes) Reset() {
@@ -938,6 +999,13 @@ func (x *HypAttributes) GetMaxFee() *v1beta1.Coin {
 	return nil
 }
 
+func (x *HypAttributes) GetAutoFee() bool {
+	if x != nil {
+		return x.AutoFee
+	}
+	return false
+}
+
 var File_noble_orbiter_controller_forwarding_v1_hyperlane_proto protoreflect.FileDescriptor
 
 var file_noble_orbiter_controller_forwarding_v1_hyperlane_proto_rawDesc = []byte{
@@ -953,7 +1021,7 @@ var file_noble_orbiter_controller_forwarding_v1_hyperlane_proto_rawDesc = []byte
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14,
 	0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x03, 0x0a, 0x0d, 0x48, 0x79, 0x70, 0x41, 0x74, 0x74, 0x72,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x03, 0x0a, 0x0d, 0x48, 0x79, 0x70, 0x41, 0x74, 0x74, 0x72,
 	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
 	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
@@ -979,31 +1047,33 @@ var file_noble_orbiter_controller_forwarding_v1_hyperlane_proto_rawDesc = []byte
 	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
 	0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63,
 	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65,
-	0x65, 0x3a, 0x29, 0xca, 0xb4, 0x2d, 0x25, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62,
-	0x69, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
-	0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x42, 0xd5, 0x02, 0x0a,
-	0x2a, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74,
-	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x66, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x48, 0x79, 0x70,
-	0x65, 0x72, 0x6c, 0x61, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5a, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d,
-	0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76,
-	0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69,
-	0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x66,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f, 0x72,
-	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x04, 0x4e, 0x4f, 0x43, 0x46,
-	0xaa, 0x02, 0x26, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x26, 0x4e, 0x6f, 0x62, 0x6c,
-	0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x32, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74,
-	0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x2a, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a,
-	0x3a, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
-	0x6c, 0x6c, 0x65, 0x72, 0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x46, 0x65, 0x65, 0x3a, 0x29, 0xca, 0xb4,
+	0x2d, 0x25, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x42, 0xd5, 0x02, 0x0a, 0x2a, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0e, 0x48, 0x79, 0x70, 0x65, 0x72, 0x6c, 0x61, 0x6e,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x5a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x04, 0x4e, 0x4f, 0x43, 0x46, 0xaa, 0x02, 0x26, 0x4e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x26, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62,
+	0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x32,
+	0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x2a, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x62, 0x69,
+	0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x3a,
+	0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2,6 +2,7 @@
 package corev1
 
 import (
+	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -10,6 +11,7 @@ import (
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -503,6 +505,8 @@ var (
 	fd_Forwarding_protocol_id         protoreflect.FieldDescriptor
 	fd_Forwarding_attributes          protoreflect.FieldDescriptor
 	fd_Forwarding_passthrough_payload protoreflect.FieldDescriptor
+	fd_Forwarding_deferred_until      protoreflect.FieldDescriptor
+	fd_Forwarding_batch               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -511,6 +515,8 @@ func init() {
 	fd_Forwarding_protocol_id = md_Forwarding.Fields().ByName("protocol_id")
 	fd_Forwarding_attributes = md_Forwarding.Fields().ByName("attributes")
 	fd_Forwarding_passthrough_payload = md_Forwarding.Fields().ByName("passthrough_payload")
+	fd_Forwarding_deferred_until = md_Forwarding.Fields().ByName("deferred_until")
+	fd_Forwarding_batch = md_Forwarding.Fields().ByName("batch")
 }
 
 var _ protoreflect.Message = (*fastReflection_Forwarding)(nil)
@@ -596,6 +602,18 @@ func (x *fastReflection_Forwarding) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if x.DeferredUntil != nil {
+		value := protoreflect.ValueOfMessage(x.DeferredUntil.ProtoReflect())
+		if !f(fd_Forwarding_deferred_until, value) {
+			return
+		}
+	}
+	if x.Batch != false {
+		value := protoreflect.ValueOfBool(x.Batch)
+		if !f(fd_Forwarding_batch, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -617,6 +635,10 @@ func (x *fastReflection_Forwarding) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Attributes != nil
 	case "noble.orbiter.core.v1.Forwarding.passthrough_payload":
 		return len(x.PassthroughPayload) != 0
+	case "noble.orbiter.core.v1.Forwarding.deferred_until":
+		return x.DeferredUntil != nil
+	case "noble.orbiter.core.v1.Forwarding.batch":
+		return x.Batch != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Forwarding"))
@@ -639,6 +661,10 @@ func (x *fastReflection_Forwarding) Clear(fd protoreflect.FieldDescriptor) {
 		x.Attributes = nil
 	case "noble.orbiter.core.v1.Forwarding.passthrough_payload":
 		x.PassthroughPayload = nil
+	case "noble.orbiter.core.v1.Forwarding.deferred_until":
+		x.DeferredUntil = nil
+	case "noble.orbiter.core.v1.Forwarding.batch":
+		x.Batch = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Forwarding"))
@@ -664,6 +690,12 @@ func (x *fastReflection_Forwarding) Get(descriptor protoreflect.FieldDescriptor)
 	case "noble.orbiter.core.v1.Forwarding.passthrough_payload":
 		value := x.PassthroughPayload
 		return protoreflect.ValueOfBytes(value)
+	case "noble.orbiter.core.v1.Forwarding.deferred_until":
+		value := x.DeferredUntil
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.orbiter.core.v1.Forwarding.batch":
+		value := x.Batch
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Forwarding"))
@@ -690,6 +722,10 @@ func (x *fastReflection_Forwarding) Set(fd protoreflect.FieldDescriptor, value p
 		x.Attributes = value.Message().Interface().(*anypb.Any)
 	case "noble.orbiter.core.v1.Forwarding.passthrough_payload":
 		x.PassthroughPayload = value.Bytes()
+	case "noble.orbiter.core.v1.Forwarding.deferred_until":
+		x.DeferredUntil = value.Message().Interface().(*Deferral)
+	case "noble.orbiter.core.v1.Forwarding.batch":
+		x.Batch = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Forwarding"))
@@ -715,10 +751,17 @@ func (x *fastReflection_Forwarding) Mutable(fd protoreflect.FieldDescriptor) pro
 			x.Attributes = new(anypb.Any)
 		}
 		return protoreflect.ValueOfMessage(x.Attributes.ProtoReflect())
+	case "noble.orbiter.core.v1.Forwarding.deferred_until":
+		if x.DeferredUntil == nil {
+			x.DeferredUntil = new(Deferral)
+		}
+		return protoreflect.ValueOfMessage(x.DeferredUntil.ProtoReflect())
 	case "noble.orbiter.core.v1.Forwarding.protocol_id":
 		panic(fmt.Errorf("field protocol_id of message noble.orbiter.core.v1.Forwarding is not mutable"))
 	case "noble.orbiter.core.v1.Forwarding.passthrough_payload":
 		panic(fmt.Errorf("field passthrough_payload of message noble.orbiter.core.v1.Forwarding is not mutable"))
+	case "noble.orbiter.core.v1.Forwarding.batch":
+		panic(fmt.Errorf("field batch of message noble.orbiter.core.v1.Forwarding is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Forwarding"))
@@ -739,6 +782,11 @@ func (x *fastReflection_Forwarding) NewField(fd protoreflect.FieldDescriptor) pr
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "noble.orbiter.core.v1.Forwarding.passthrough_payload":
 		return protoreflect.ValueOfBytes(nil)
+	case "noble.orbiter.core.v1.Forwarding.deferred_until":
+		m := new(Deferral)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.orbiter.core.v1.Forwarding.batch":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Forwarding"))
@@ -819,6 +867,13 @@ func (x *fastReflection_Forwarding) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DeferredUntil != nil {
+			l = options.Size(x.DeferredUntil)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Batch {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -848,6 +903,30 @@ func (x *fastReflection_Forwarding) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Batch {
+			i--
+			if x.Batch {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.DeferredUntil != nil {
+			encoded, err := options.Marshal(x.DeferredUntil)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.PassthroughPayload) > 0 {
 			i -= len(x.PassthroughPayload)
 			copy(dAtA[i:], x.PassthroughPayload)
@@ -1012,6 +1091,62 @@ func (x *fastReflection_Forwarding) ProtoMethods() *protoiface.Methods {
 					x.PassthroughPayload = []byte{}
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeferredUntil", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DeferredUntil == nil {
+					x.DeferredUntil = &Deferral{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeferredUntil); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Batch = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1047,79 +1182,28 @@ func (x *fastReflection_Forwarding) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Payload_1_list)(nil)
-
-type _Payload_1_list struct {
-	list *[]*Action
-}
-
-func (x *_Payload_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_Payload_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_Payload_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Action)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_Payload_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Action)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_Payload_1_list) AppendMutable() protoreflect.Value {
-	v := new(Action)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Payload_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Payload_1_list) NewElement() protoreflect.Value {
-	v := new(Action)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Payload_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_Payload             protoreflect.MessageDescriptor
-	fd_Payload_pre_actions protoreflect.FieldDescriptor
-	fd_Payload_forwarding  protoreflect.FieldDescriptor
+	md_Deferral        protoreflect.MessageDescriptor
+	fd_Deferral_height protoreflect.FieldDescriptor
+	fd_Deferral_time   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_noble_orbiter_core_v1_orbiter_proto_init()
-	md_Payload = File_noble_orbiter_core_v1_orbiter_proto.Messages().ByName("Payload")
-	fd_Payload_pre_actions = md_Payload.Fields().ByName("pre_actions")
-	fd_Payload_forwarding = md_Payload.Fields().ByName("forwarding")
+	md_Deferral = File_noble_orbiter_core_v1_orbiter_proto.Messages().ByName("Deferral")
+	fd_Deferral_height = md_Deferral.Fields().ByName("height")
+	fd_Deferral_time = md_Deferral.Fields().ByName("time")
 }
 
-var _ protoreflect.Message = (*fastReflection_Payload)(nil)
+var _ protoreflect.Message = (*fastReflection_Deferral)(nil)
 
-type fastReflection_Payload Payload
+type fastReflection_Deferral Deferral
 
-func (x *Payload) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Payload)(x)
+func (x *Deferral) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Deferral)(x)
 }
 
-func (x *Payload) slowProtoReflect() protoreflect.Message {
+func (x *Deferral) slowProtoReflect() protoreflect.Message {
 	mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1131,43 +1215,43 @@ func (x *Payload) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Payload_messageType fastReflection_Payload_messageType
-var _ protoreflect.MessageType = fastReflection_Payload_messageType{}
+var _fastReflection_Deferral_messageType fastReflection_Deferral_messageType
+var _ protoreflect.MessageType = fastReflection_Deferral_messageType{}
 
-type fastReflection_Payload_messageType struct{}
+type fastReflection_Deferral_messageType struct{}
 
-func (x fastReflection_Payload_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Payload)(nil)
+func (x fastReflection_Deferral_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Deferral)(nil)
 }
-func (x fastReflection_Payload_messageType) New() protoreflect.Message {
-	return new(fastReflection_Payload)
+func (x fastReflection_Deferral_messageType) New() protoreflect.Message {
+	return new(fastReflection_Deferral)
 }
-func (x fastReflection_Payload_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Payload
+func (x fastReflection_Deferral_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deferral
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Payload) Descriptor() protoreflect.MessageDescriptor {
-	return md_Payload
+func (x *fastReflection_Deferral) Descriptor() protoreflect.MessageDescriptor {
+	return md_Deferral
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Payload) Type() protoreflect.MessageType {
-	return _fastReflection_Payload_messageType
+func (x *fastReflection_Deferral) Type() protoreflect.MessageType {
+	return _fastReflection_Deferral_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Payload) New() protoreflect.Message {
-	return new(fastReflection_Payload)
+func (x *fastReflection_Deferral) New() protoreflect.Message {
+	return new(fastReflection_Deferral)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Payload) Interface() protoreflect.ProtoMessage {
-	return (*Payload)(x)
+func (x *fastReflection_Deferral) Interface() protoreflect.ProtoMessage {
+	return (*Deferral)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1175,16 +1259,1277 @@ func (x *fastReflection_Payload) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Payload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.PreActions) != 0 {
-		value := protoreflect.ValueOfList(&_Payload_1_list{list: &x.PreActions})
-		if !f(fd_Payload_pre_actions, value) {
+func (x *fastReflection_Deferral) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Height)
+		if !f(fd_Deferral_height, value) {
+			return
+		}
+	}
+	if x.Time != nil {
+		value := protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+		if !f(fd_Deferral_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Deferral) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.Deferral.height":
+		return x.Height != uint64(0)
+	case "noble.orbiter.core.v1.Deferral.time":
+		return x.Time != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Deferral"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.Deferral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deferral) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.Deferral.height":
+		x.Height = uint64(0)
+	case "noble.orbiter.core.v1.Deferral.time":
+		x.Time = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Deferral"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.Deferral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Deferral) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.orbiter.core.v1.Deferral.height":
+		value := x.Height
+		return protoreflect.ValueOfUint64(value)
+	case "noble.orbiter.core.v1.Deferral.time":
+		value := x.Time
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Deferral"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.Deferral does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deferral) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.Deferral.height":
+		x.Height = value.Uint()
+	case "noble.orbiter.core.v1.Deferral.time":
+		x.Time = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Deferral"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.Deferral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deferral) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.Deferral.time":
+		if x.Time == nil {
+			x.Time = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Time.ProtoReflect())
+	case "noble.orbiter.core.v1.Deferral.height":
+		panic(fmt.Errorf("field height of message noble.orbiter.core.v1.Deferral is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Deferral"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.Deferral does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Deferral) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.Deferral.height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "noble.orbiter.core.v1.Deferral.time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Deferral"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.Deferral does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Deferral) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.core.v1.Deferral", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Deferral) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Deferral) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Deferral) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Deferral) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Deferral)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Time != nil {
+			l = options.Size(x.Time)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Deferral)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Time != nil {
+			encoded, err := options.Marshal(x.Time)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Deferral)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deferral: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Deferral: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Time == nil {
+					x.Time = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Time); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForwardingLeg            protoreflect.MessageDescriptor
+	fd_ForwardingLeg_forwarding protoreflect.FieldDescriptor
+	fd_ForwardingLeg_bps        protoreflect.FieldDescriptor
+	fd_ForwardingLeg_amount     protoreflect.FieldDescriptor
+	fd_ForwardingLeg_remainder  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_orbiter_core_v1_orbiter_proto_init()
+	md_ForwardingLeg = File_noble_orbiter_core_v1_orbiter_proto.Messages().ByName("ForwardingLeg")
+	fd_ForwardingLeg_forwarding = md_ForwardingLeg.Fields().ByName("forwarding")
+	fd_ForwardingLeg_bps = md_ForwardingLeg.Fields().ByName("bps")
+	fd_ForwardingLeg_amount = md_ForwardingLeg.Fields().ByName("amount")
+	fd_ForwardingLeg_remainder = md_ForwardingLeg.Fields().ByName("remainder")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardingLeg)(nil)
+
+type fastReflection_ForwardingLeg ForwardingLeg
+
+func (x *ForwardingLeg) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardingLeg)(x)
+}
+
+func (x *ForwardingLeg) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardingLeg_messageType fastReflection_ForwardingLeg_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardingLeg_messageType{}
+
+type fastReflection_ForwardingLeg_messageType struct{}
+
+func (x fastReflection_ForwardingLeg_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardingLeg)(nil)
+}
+func (x fastReflection_ForwardingLeg_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardingLeg)
+}
+func (x fastReflection_ForwardingLeg_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardingLeg
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardingLeg) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardingLeg
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardingLeg) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardingLeg_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardingLeg) New() protoreflect.Message {
+	return new(fastReflection_ForwardingLeg)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardingLeg) Interface() protoreflect.ProtoMessage {
+	return (*ForwardingLeg)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardingLeg) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Forwarding != nil {
+		value := protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
+		if !f(fd_ForwardingLeg_forwarding, value) {
+			return
+		}
+	}
+	if x.Bps != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Bps)
+		if !f(fd_ForwardingLeg_bps, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_ForwardingLeg_amount, value) {
+			return
+		}
+	}
+	if x.Remainder != false {
+		value := protoreflect.ValueOfBool(x.Remainder)
+		if !f(fd_ForwardingLeg_remainder, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardingLeg) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.ForwardingLeg.forwarding":
+		return x.Forwarding != nil
+	case "noble.orbiter.core.v1.ForwardingLeg.bps":
+		return x.Bps != uint32(0)
+	case "noble.orbiter.core.v1.ForwardingLeg.amount":
+		return x.Amount != ""
+	case "noble.orbiter.core.v1.ForwardingLeg.remainder":
+		return x.Remainder != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.ForwardingLeg"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.ForwardingLeg does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingLeg) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.ForwardingLeg.forwarding":
+		x.Forwarding = nil
+	case "noble.orbiter.core.v1.ForwardingLeg.bps":
+		x.Bps = uint32(0)
+	case "noble.orbiter.core.v1.ForwardingLeg.amount":
+		x.Amount = ""
+	case "noble.orbiter.core.v1.ForwardingLeg.remainder":
+		x.Remainder = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.ForwardingLeg"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.ForwardingLeg does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardingLeg) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "noble.orbiter.core.v1.ForwardingLeg.forwarding":
+		value := x.Forwarding
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.orbiter.core.v1.ForwardingLeg.bps":
+		value := x.Bps
+		return protoreflect.ValueOfUint32(value)
+	case "noble.orbiter.core.v1.ForwardingLeg.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "noble.orbiter.core.v1.ForwardingLeg.remainder":
+		value := x.Remainder
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.ForwardingLeg"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.ForwardingLeg does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingLeg) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.ForwardingLeg.forwarding":
+		x.Forwarding = value.Message().Interface().(*Forwarding)
+	case "noble.orbiter.core.v1.ForwardingLeg.bps":
+		x.Bps = uint32(value.Uint())
+	case "noble.orbiter.core.v1.ForwardingLeg.amount":
+		x.Amount = value.Interface().(string)
+	case "noble.orbiter.core.v1.ForwardingLeg.remainder":
+		x.Remainder = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.ForwardingLeg"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.ForwardingLeg does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingLeg) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.ForwardingLeg.forwarding":
+		if x.Forwarding == nil {
+			x.Forwarding = new(Forwarding)
+		}
+		return protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
+	case "noble.orbiter.core.v1.ForwardingLeg.bps":
+		panic(fmt.Errorf("field bps of message noble.orbiter.core.v1.ForwardingLeg is not mutable"))
+	case "noble.orbiter.core.v1.ForwardingLeg.amount":
+		panic(fmt.Errorf("field amount of message noble.orbiter.core.v1.ForwardingLeg is not mutable"))
+	case "noble.orbiter.core.v1.ForwardingLeg.remainder":
+		panic(fmt.Errorf("field remainder of message noble.orbiter.core.v1.ForwardingLeg is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.ForwardingLeg"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.ForwardingLeg does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardingLeg) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "noble.orbiter.core.v1.ForwardingLeg.forwarding":
+		m := new(Forwarding)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.orbiter.core.v1.ForwardingLeg.bps":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "noble.orbiter.core.v1.ForwardingLeg.amount":
+		return protoreflect.ValueOfString("")
+	case "noble.orbiter.core.v1.ForwardingLeg.remainder":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.ForwardingLeg"))
+		}
+		panic(fmt.Errorf("message noble.orbiter.core.v1.ForwardingLeg does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardingLeg) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in noble.orbiter.core.v1.ForwardingLeg", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardingLeg) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingLeg) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardingLeg) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardingLeg) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardingLeg)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Forwarding != nil {
+			l = options.Size(x.Forwarding)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bps != 0 {
+			n += 1 + runtime.Sov(uint64(x.Bps))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Remainder {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardingLeg)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Remainder {
+			i--
+			if x.Remainder {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Bps != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Bps))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Forwarding != nil {
+			encoded, err := options.Marshal(x.Forwarding)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardingLeg)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardingLeg: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardingLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Forwarding == nil {
+					x.Forwarding = &Forwarding{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Forwarding); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+				}
+				x.Bps = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Bps |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Remainder = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Payload_1_list)(nil)
+
+type _Payload_1_list struct {
+	list *[]*Action
+}
+
+func (x *_Payload_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Payload_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Payload_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Action)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Payload_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Action)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Payload_1_list) AppendMutable() protoreflect.Value {
+	v := new(Action)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Payload_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Payload_1_list) NewElement() protoreflect.Value {
+	v := new(Action)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Payload_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Payload_4_list)(nil)
+
+type _Payload_4_list struct {
+	list *[]*ForwardingLeg
+}
+
+func (x *_Payload_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Payload_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Payload_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForwardingLeg)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Payload_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForwardingLeg)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Payload_4_list) AppendMutable() protoreflect.Value {
+	v := new(ForwardingLeg)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Payload_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Payload_4_list) NewElement() protoreflect.Value {
+	v := new(ForwardingLeg)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Payload_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Payload_5_list)(nil)
+
+type _Payload_5_list struct {
+	list *[]*Forwarding
+}
+
+func (x *_Payload_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Payload_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Payload_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Forwarding)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Payload_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Forwarding)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Payload_5_list) AppendMutable() protoreflect.Value {
+	v := new(Forwarding)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Payload_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Payload_5_list) NewElement() protoreflect.Value {
+	v := new(Forwarding)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Payload_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Payload                      protoreflect.MessageDescriptor
+	fd_Payload_pre_actions          protoreflect.FieldDescriptor
+	fd_Payload_forwarding           protoreflect.FieldDescriptor
+	fd_Payload_fallback_recipient   protoreflect.FieldDescriptor
+	fd_Payload_forwarding_legs      protoreflect.FieldDescriptor
+	fd_Payload_fallback_forwardings protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_noble_orbiter_core_v1_orbiter_proto_init()
+	md_Payload = File_noble_orbiter_core_v1_orbiter_proto.Messages().ByName("Payload")
+	fd_Payload_pre_actions = md_Payload.Fields().ByName("pre_actions")
+	fd_Payload_forwarding = md_Payload.Fields().ByName("forwarding")
+	fd_Payload_fallback_recipient = md_Payload.Fields().ByName("fallback_recipient")
+	fd_Payload_forwarding_legs = md_Payload.Fields().ByName("forwarding_legs")
+	fd_Payload_fallback_forwardings = md_Payload.Fields().ByName("fallback_forwardings")
+}
+
+var _ protoreflect.Message = (*fastReflection_Payload)(nil)
+
+type fastReflection_Payload Payload
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Payload)(x)
+}
+
+func (x *Payload) slowProtoReflect() protoreflect.Message {
+	mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Payload_messageType fastReflection_Payload_messageType
+var _ protoreflect.MessageType = fastReflection_Payload_messageType{}
+
+type fastReflection_Payload_messageType struct{}
+
+func (x fastReflection_Payload_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Payload)(nil)
+}
+func (x fastReflection_Payload_messageType) New() protoreflect.Message {
+	return new(fastReflection_Payload)
+}
+func (x fastReflection_Payload_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Payload
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Payload) Descriptor() protoreflect.MessageDescriptor {
+	return md_Payload
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Payload) Type() protoreflect.MessageType {
+	return _fastReflection_Payload_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Payload) New() protoreflect.Message {
+	return new(fastReflection_Payload)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Payload) Interface() protoreflect.ProtoMessage {
+	return (*Payload)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Payload) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PreActions) != 0 {
+		value := protoreflect.ValueOfList(&_Payload_1_list{list: &x.PreActions})
+		if !f(fd_Payload_pre_actions, value) {
+			return
+		}
+	}
+	if x.Forwarding != nil {
+		value := protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
+		if !f(fd_Payload_forwarding, value) {
+			return
+		}
+	}
+	if x.FallbackRecipient != "" {
+		value := protoreflect.ValueOfString(x.FallbackRecipient)
+		if !f(fd_Payload_fallback_recipient, value) {
 			return
 		}
 	}
-	if x.Forwarding != nil {
-		value := protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
-		if !f(fd_Payload_forwarding, value) {
+	if len(x.ForwardingLegs) != 0 {
+		value := protoreflect.ValueOfList(&_Payload_4_list{list: &x.ForwardingLegs})
+		if !f(fd_Payload_forwarding_legs, value) {
+			return
+		}
+	}
+	if len(x.FallbackForwardings) != 0 {
+		value := protoreflect.ValueOfList(&_Payload_5_list{list: &x.FallbackForwardings})
+		if !f(fd_Payload_fallback_forwardings, value) {
 			return
 		}
 	}
@@ -1207,6 +2552,12 @@ func (x *fastReflection_Payload) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.PreActions) != 0
 	case "noble.orbiter.core.v1.Payload.forwarding":
 		return x.Forwarding != nil
+	case "noble.orbiter.core.v1.Payload.fallback_recipient":
+		return x.FallbackRecipient != ""
+	case "noble.orbiter.core.v1.Payload.forwarding_legs":
+		return len(x.ForwardingLegs) != 0
+	case "noble.orbiter.core.v1.Payload.fallback_forwardings":
+		return len(x.FallbackForwardings) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Payload"))
@@ -1227,6 +2578,12 @@ func (x *fastReflection_Payload) Clear(fd protoreflect.FieldDescriptor) {
 		x.PreActions = nil
 	case "noble.orbiter.core.v1.Payload.forwarding":
 		x.Forwarding = nil
+	case "noble.orbiter.core.v1.Payload.fallback_recipient":
+		x.FallbackRecipient = ""
+	case "noble.orbiter.core.v1.Payload.forwarding_legs":
+		x.ForwardingLegs = nil
+	case "noble.orbiter.core.v1.Payload.fallback_forwardings":
+		x.FallbackForwardings = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Payload"))
@@ -1252,6 +2609,21 @@ func (x *fastReflection_Payload) Get(descriptor protoreflect.FieldDescriptor) pr
 	case "noble.orbiter.core.v1.Payload.forwarding":
 		value := x.Forwarding
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.orbiter.core.v1.Payload.fallback_recipient":
+		value := x.FallbackRecipient
+		return protoreflect.ValueOfString(value)
+	case "noble.orbiter.core.v1.Payload.forwarding_legs":
+		if len(x.ForwardingLegs) == 0 {
+			return protoreflect.ValueOfList(&_Payload_4_list{})
+		}
+		listValue := &_Payload_4_list{list: &x.ForwardingLegs}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.orbiter.core.v1.Payload.fallback_forwardings":
+		if len(x.FallbackForwardings) == 0 {
+			return protoreflect.ValueOfList(&_Payload_5_list{})
+		}
+		listValue := &_Payload_5_list{list: &x.FallbackForwardings}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Payload"))
@@ -1278,6 +2650,16 @@ func (x *fastReflection_Payload) Set(fd protoreflect.FieldDescriptor, value prot
 		x.PreActions = *clv.list
 	case "noble.orbiter.core.v1.Payload.forwarding":
 		x.Forwarding = value.Message().Interface().(*Forwarding)
+	case "noble.orbiter.core.v1.Payload.fallback_recipient":
+		x.FallbackRecipient = value.Interface().(string)
+	case "noble.orbiter.core.v1.Payload.forwarding_legs":
+		lv := value.List()
+		clv := lv.(*_Payload_4_list)
+		x.ForwardingLegs = *clv.list
+	case "noble.orbiter.core.v1.Payload.fallback_forwardings":
+		lv := value.List()
+		clv := lv.(*_Payload_5_list)
+		x.FallbackForwardings = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Payload"))
@@ -1309,6 +2691,20 @@ func (x *fastReflection_Payload) Mutable(fd protoreflect.FieldDescriptor) protor
 			x.Forwarding = new(Forwarding)
 		}
 		return protoreflect.ValueOfMessage(x.Forwarding.ProtoReflect())
+	case "noble.orbiter.core.v1.Payload.forwarding_legs":
+		if x.ForwardingLegs == nil {
+			x.ForwardingLegs = []*ForwardingLeg{}
+		}
+		value := &_Payload_4_list{list: &x.ForwardingLegs}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.core.v1.Payload.fallback_forwardings":
+		if x.FallbackForwardings == nil {
+			x.FallbackForwardings = []*Forwarding{}
+		}
+		value := &_Payload_5_list{list: &x.FallbackForwardings}
+		return protoreflect.ValueOfList(value)
+	case "noble.orbiter.core.v1.Payload.fallback_recipient":
+		panic(fmt.Errorf("field fallback_recipient of message noble.orbiter.core.v1.Payload is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Payload"))
@@ -1328,6 +2724,14 @@ func (x *fastReflection_Payload) NewField(fd protoreflect.FieldDescriptor) proto
 	case "noble.orbiter.core.v1.Payload.forwarding":
 		m := new(Forwarding)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.orbiter.core.v1.Payload.fallback_recipient":
+		return protoreflect.ValueOfString("")
+	case "noble.orbiter.core.v1.Payload.forwarding_legs":
+		list := []*ForwardingLeg{}
+		return protoreflect.ValueOfList(&_Payload_4_list{list: &list})
+	case "noble.orbiter.core.v1.Payload.fallback_forwardings":
+		list := []*Forwarding{}
+		return protoreflect.ValueOfList(&_Payload_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.orbiter.core.v1.Payload"))
@@ -1407,6 +2811,22 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Forwarding)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.FallbackRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ForwardingLegs) > 0 {
+			for _, e := range x.ForwardingLegs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FallbackForwardings) > 0 {
+			for _, e := range x.FallbackForwardings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1436,6 +2856,45 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.FallbackForwardings) > 0 {
+			for iNdEx := len(x.FallbackForwardings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FallbackForwardings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ForwardingLegs) > 0 {
+			for iNdEx := len(x.ForwardingLegs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ForwardingLegs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.FallbackRecipient) > 0 {
+			i -= len(x.FallbackRecipient)
+			copy(dAtA[i:], x.FallbackRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FallbackRecipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Forwarding != nil {
 			encoded, err := options.Marshal(x.Forwarding)
 			if err != nil {
@@ -1585,6 +3044,106 @@ func (x *fastReflection_Payload) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ForwardingLegs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ForwardingLegs = append(x.ForwardingLegs, &ForwardingLeg{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ForwardingLegs[len(x.ForwardingLegs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FallbackForwardings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FallbackForwardings = append(x.FallbackForwardings, &Forwarding{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FallbackForwardings[len(x.FallbackForwardings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1640,7 +3199,7 @@ func (x *PayloadWrapper) ProtoReflect() protoreflect.Message {
 }
 
 func (x *PayloadWrapper) slowProtoReflect() protoreflect.Message {
-	mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[3]
+	mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2140,6 +3699,13 @@ type Forwarding struct {
 	// to the destination chain. This field is not interpreted by
 	// the orbiter module.
 	PassthroughPayload []byte `protobuf:"bytes,3,opt,name=passthrough_payload,json=passthroughPayload,proto3" json:"passthrough_payload,omitempty"`
+	// deferred_until optionally holds the forwarding on Noble until the
+	// specified block height and time are reached.
+	DeferredUntil *Deferral `protobuf:"bytes,4,opt,name=deferred_until,json=deferredUntil,proto3" json:"deferred_until,omitempty"`
+	// batch opts in to aggregate the forwarding with the forwardings of the
+	// same block having identical protocol, attributes, and denom. The
+	// aggregated amount is sent with a single transfer at the end of the block.
+	Batch bool `protobuf:"varint,5,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
 func (x *Forwarding) Reset() {
@@ -2183,6 +3749,135 @@ func (x *Forwarding) GetPassthroughPayload() []byte {
 	return nil
 }
 
+func (x *Forwarding) GetDeferredUntil() *Deferral {
+	if x != nil {
+		return x.DeferredUntil
+	}
+	return nil
+}
+
+func (x *Forwarding) GetBatch() bool {
+	if x != nil {
+		return x.Batch
+	}
+	return false
+}
+
+// Deferral defines the block height and time from which a deferred
+// forwarding can be executed. When both are set, the forwarding is
+// executed once both are reached.
+type Deferral struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// height is the block height from which the forwarding can be executed.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time from which the forwarding can be executed.
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *Deferral) Reset() {
+	*x = Deferral{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Deferral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deferral) ProtoMessage() {}
+
+// Deprecated: Use Deferral.ProtoReflect.Descriptor instead.
+func (*Deferral) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_core_v1_orbiter_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Deferral) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Deferral) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// ForwardingLeg represents a share of the transferred amount routed
+// through its own forwarding when a payload splits the transfer.
+type ForwardingLeg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// forwarding contains the required information to route the leg amount.
+	Forwarding *Forwarding `protobuf:"bytes,1,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// bps is the share of the amount routed by the leg, expressed
+	// in basis points.
+	Bps uint32 `protobuf:"varint,2,opt,name=bps,proto3" json:"bps,omitempty"`
+	// amount is the fixed amount routed by the leg.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// remainder specifies if the leg routes the amount left after
+	// all the other legs, including the rounding dust.
+	Remainder bool `protobuf:"varint,4,opt,name=remainder,proto3" json:"remainder,omitempty"`
+}
+
+func (x *ForwardingLeg) Reset() {
+	*x = ForwardingLeg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardingLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardingLeg) ProtoMessage() {}
+
+// Deprecated: Use ForwardingLeg.ProtoReflect.Descriptor instead.
+func (*ForwardingLeg) Descriptor() ([]byte, []int) {
+	return file_noble_orbiter_core_v1_orbiter_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ForwardingLeg) GetForwarding() *Forwarding {
+	if x != nil {
+		return x.Forwarding
+	}
+	return nil
+}
+
+func (x *ForwardingLeg) GetBps() uint32 {
+	if x != nil {
+		return x.Bps
+	}
+	return 0
+}
+
+func (x *ForwardingLeg) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ForwardingLeg) GetRemainder() bool {
+	if x != nil {
+		return x.Remainder
+	}
+	return false
+}
+
 // Payload represents the data the orbiter module
 // requires to handle cross-chain packets.
 type Payload struct {
@@ -2196,12 +3891,22 @@ type Payload struct {
 	// forwarding contains the required information to complete a cross-chain
 	// routing through an orbiter-supported protocol.
 	Forwarding *Forwarding `protobuf:"bytes,2,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// fallback_recipient is an optional Noble address receiving the
+	// transferred funds when the payload dispatch fails.
+	FallbackRecipient string `protobuf:"bytes,3,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+	// forwarding_legs split the transferred amount across multiple
+	// forwardings. It cannot be used together with forwarding.
+	ForwardingLegs []*ForwardingLeg `protobuf:"bytes,4,rep,name=forwarding_legs,json=forwardingLegs,proto3" json:"forwarding_legs,omitempty"`
+	// fallback_forwardings are alternative forwardings tried in order
+	// when the forwarding fails. It cannot be used together with
+	// forwarding_legs.
+	FallbackForwardings []*Forwarding `protobuf:"bytes,5,rep,name=fallback_forwardings,json=fallbackForwardings,proto3" json:"fallback_forwardings,omitempty"`
 }
 
 func (x *Payload) Reset() {
 	*x = Payload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[2]
+		mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2215,7 +3920,7 @@ func (*Payload) ProtoMessage() {}
 
 // Deprecated: Use Payload.ProtoReflect.Descriptor instead.
 func (*Payload) Descriptor() ([]byte, []int) {
-	return file_noble_orbiter_core_v1_orbiter_proto_rawDescGZIP(), []int{2}
+	return file_noble_orbiter_core_v1_orbiter_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Payload) GetPreActions() []*Action {
@@ -2232,6 +3937,27 @@ func (x *Payload) GetForwarding() *Forwarding {
 	return nil
 }
 
+func (x *Payload) GetFallbackRecipient() string {
+	if x != nil {
+		return x.FallbackRecipient
+	}
+	return ""
+}
+
+func (x *Payload) GetForwardingLegs() []*ForwardingLeg {
+	if x != nil {
+		return x.ForwardingLegs
+	}
+	return nil
+}
+
+func (x *Payload) GetFallbackForwardings() []*Forwarding {
+	if x != nil {
+		return x.FallbackForwardings
+	}
+	return nil
+}
+
 // PayloadWrapper defines the expected JSON structure the module expect when receiving
 // the payload from protocols encoding metadata as string. This wrapper is used to
 // easily identify if the metadata containing the payload is correctly defined.
@@ -2248,7 +3974,7 @@ type PayloadWrapper struct {
 func (x *PayloadWrapper) Reset() {
 	*x = PayloadWrapper{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[3]
+		mi := &file_noble_orbiter_core_v1_orbiter_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2262,7 +3988,7 @@ func (*PayloadWrapper) ProtoMessage() {}
 
 // Deprecated: Use PayloadWrapper.ProtoReflect.Descriptor instead.
 func (*PayloadWrapper) Descriptor() ([]byte, []int) {
-	return file_noble_orbiter_core_v1_orbiter_proto_rawDescGZIP(), []int{3}
+	return file_noble_orbiter_core_v1_orbiter_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PayloadWrapper) GetOrbiter() *Payload {
@@ -2278,67 +4004,110 @@ var file_noble_orbiter_core_v1_orbiter_proto_rawDesc = []byte{
 	0x0a, 0x23, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f,
 	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62,
-	0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61,
-	0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f,
-	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f,
-	0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a, 0x06, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x25,
-	0xca, 0xb4, 0x2d, 0x21, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xe8, 0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6e, 0x6f,
-	0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x44, 0x52, 0x0a,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x0a, 0x61, 0x74,
-	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x42, 0x29, 0xca, 0xb4, 0x2d, 0x25, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
-	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52,
-	0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x70,
-	0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68,
-	0x72, 0x6f, 0x75, 0x67, 0x68, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x3a, 0x04, 0x88, 0xa0,
-	0x1f, 0x00, 0x22, 0x8c, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3e,
-	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69,
+	0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d,
+	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9c, 0x01, 0x0a,
+	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69,
 	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41,
-	0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x6f, 0x6e, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
+	0x6e, 0x79, 0x42, 0x25, 0xca, 0xb4, 0x2d, 0x21, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72,
+	0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xc6, 0x02, 0x0a, 0x0a,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x42, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x21, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x49, 0x44, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x5f,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x29, 0xca, 0xb4, 0x2d, 0x25, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x2f, 0x0a, 0x13, 0x70, 0x61, 0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x5f, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x70, 0x61,
+	0x73, 0x73, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x46, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x3a, 0x04,
+	0x88, 0xa0, 0x1f, 0x00, 0x22, 0x58, 0x0a, 0x08, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xcc,
+	0x01, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x67,
+	0x12, 0x41, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62,
+	0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x62, 0x70, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xfa, 0x02,
+	0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3e, 0x0a, 0x0b, 0x70, 0x72, 0x65,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x47, 0x0a, 0x12,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x11, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x4c, 0x65, 0x67, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x65, 0x67, 0x73, 0x12, 0x54, 0x0a, 0x14, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74,
 	0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
-	0x67, 0x22, 0x4a, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x07, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62,
-	0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x42, 0xe5, 0x01,
-	0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69,
-	0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4f, 0x72, 0x62,
-	0x69, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73,
-	0x73, 0x65, 0x74, 0x73, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65,
-	0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x72, 0x65, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x4e, 0x4f, 0x43, 0xaa, 0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f,
-	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43,
-	0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f,
-	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x72,
-	0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x13, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x4a, 0x0a, 0x0e, 0x50, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x07,
+	0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x6f,
+	0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x42, 0xe5, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x6f, 0x72,
+	0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62,
+	0x6c, 0x65, 0x2f, 0x6f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x63, 0x6f, 0x72, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x4f, 0x43, 0xaa,
+	0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x2e,
+	0x43, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c,
+	0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x21, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x4f, 0x72, 0x62, 0x69, 0x74, 0x65, 0x72, 0x5c,
+	0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x4f, 0x72, 0x62,
+	0x69, 0x74, 0x65, 0x72, 0x3a, 0x3a, 0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2353,29 +4122,37 @@ func file_noble_orbiter_core_v1_orbiter_proto_rawDescGZIP() []byte {
 	return file_noble_orbiter_core_v1_orbiter_proto_rawDescData
 }
 
-var file_noble_orbiter_core_v1_orbiter_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_noble_orbiter_core_v1_orbiter_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_noble_orbiter_core_v1_orbiter_proto_goTypes = []interface{}{
-	(*Action)(nil),         // 0: noble.orbiter.core.v1.Action
-	(*Forwarding)(nil),     // 1: noble.orbiter.core.v1.Forwarding
-	(*Payload)(nil),        // 2: noble.orbiter.core.v1.Payload
-	(*PayloadWrapper)(nil), // 3: noble.orbiter.core.v1.PayloadWrapper
-	(ActionID)(0),          // 4: noble.orbiter.core.v1.ActionID
-	(*anypb.Any)(nil),      // 5: google.protobuf.Any
-	(ProtocolID)(0),        // 6: noble.orbiter.core.v1.ProtocolID
+	(*Action)(nil),                // 0: noble.orbiter.core.v1.Action
+	(*Forwarding)(nil),            // 1: noble.orbiter.core.v1.Forwarding
+	(*Deferral)(nil),              // 2: noble.orbiter.core.v1.Deferral
+	(*ForwardingLeg)(nil),         // 3: noble.orbiter.core.v1.ForwardingLeg
+	(*Payload)(nil),               // 4: noble.orbiter.core.v1.Payload
+	(*PayloadWrapper)(nil),        // 5: noble.orbiter.core.v1.PayloadWrapper
+	(ActionID)(0),                 // 6: noble.orbiter.core.v1.ActionID
+	(*anypb.Any)(nil),             // 7: google.protobuf.Any
+	(ProtocolID)(0),               // 8: noble.orbiter.core.v1.ProtocolID
+	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
 }
 var file_noble_orbiter_core_v1_orbiter_proto_depIdxs = []int32{
-	4, // 0: noble.orbiter.core.v1.Action.id:type_name -> noble.orbiter.core.v1.ActionID
-	5, // 1: noble.orbiter.core.v1.Action.attributes:type_name -> google.protobuf.Any
-	6, // 2: noble.orbiter.core.v1.Forwarding.protocol_id:type_name -> noble.orbiter.core.v1.ProtocolID
-	5, // 3: noble.orbiter.core.v1.Forwarding.attributes:type_name -> google.protobuf.Any
-	0, // 4: noble.orbiter.core.v1.Payload.pre_actions:type_name -> noble.orbiter.core.v1.Action
-	1, // 5: noble.orbiter.core.v1.Payload.forwarding:type_name -> noble.orbiter.core.v1.Forwarding
-	2, // 6: noble.orbiter.core.v1.PayloadWrapper.orbiter:type_name -> noble.orbiter.core.v1.Payload
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	6,  // 0: noble.orbiter.core.v1.Action.id:type_name -> noble.orbiter.core.v1.ActionID
+	7,  // 1: noble.orbiter.core.v1.Action.attributes:type_name -> google.protobuf.Any
+	8,  // 2: noble.orbiter.core.v1.Forwarding.protocol_id:type_name -> noble.orbiter.core.v1.ProtocolID
+	7,  // 3: noble.orbiter.core.v1.Forwarding.attributes:type_name -> google.protobuf.Any
+	2,  // 4: noble.orbiter.core.v1.Forwarding.deferred_until:type_name -> noble.orbiter.core.v1.Deferral
+	9,  // 5: noble.orbiter.core.v1.Deferral.time:type_name -> google.protobuf.Timestamp
+	1,  // 6: noble.orbiter.core.v1.ForwardingLeg.forwarding:type_name -> noble.orbiter.core.v1.Forwarding
+	0,  // 7: noble.orbiter.core.v1.Payload.pre_actions:type_name -> noble.orbiter.core.v1.Action
+	1,  // 8: noble.orbiter.core.v1.Payload.forwarding:type_name -> noble.orbiter.core.v1.Forwarding
+	3,  // 9: noble.orbiter.core.v1.Payload.forwarding_legs:type_name -> noble.orbiter.core.v1.ForwardingLeg
+	1,  // 10: noble.orbiter.core.v1.Payload.fallback_forwardings:type_name -> noble.orbiter.core.v1.Forwarding
+	4,  // 11: noble.orbiter.core.v1.PayloadWrapper.orbiter:type_name -> noble.orbiter.core.v1.Payload
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_noble_orbiter_core_v1_orbiter_proto_init() }
@@ -2410,7 +4187,7 @@ func file_noble_orbiter_core_v1_orbiter_proto_init() {
 			}
 		}
 		file_noble_orbiter_core_v1_orbiter_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Payload); i {
+			switch v := v.(*Deferral); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2422,6 +4199,30 @@ func file_noble_orbiter_core_v1_orbiter_proto_init() {
 			}
 		}
 		file_noble_orbiter_core_v1_orbiter_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardingLeg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_orbiter_core_v1_orbiter_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_noble_orbiter_core_v1_orbiter_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PayloadWrapper); i {
 			case 0:
 				return &v.state
@@ -2440,7 +4241,7 @@ func file_noble_orbiter_core_v1_orbiter_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_noble_orbiter_core_v1_orbiter_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
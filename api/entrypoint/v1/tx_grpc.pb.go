@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: noble/orbiter/entrypoint/v1/tx.proto
+
+package entrypointv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Msg_ReceiveCCTPMessages_FullMethodName      = "/noble.orbiter.entrypoint.v1.Msg/ReceiveCCTPMessages"
+	Msg_ReceiveHyperlaneMessages_FullMethodName = "/noble.orbiter.entrypoint.v1.Msg/ReceiveHyperlaneMessages"
+	Msg_Orbit_FullMethodName                    = "/noble.orbiter.entrypoint.v1.Msg/Orbit"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Msg defines the RPC methods for the Orbiter entrypoints.
+type MsgClient interface {
+	// ReceiveCCTPMessages relays a CCTP transfer message along with the
+	// associated Orbiter payload message in the same transaction.
+	ReceiveCCTPMessages(ctx context.Context, in *MsgReceiveCCTPMessages, opts ...grpc.CallOption) (*MsgReceiveCCTPMessagesResponse, error)
+	// ReceiveHyperlaneMessages relays a Hyperlane warp transfer message along
+	// with the associated Orbiter payload message in the same transaction.
+	ReceiveHyperlaneMessages(ctx context.Context, in *MsgReceiveHyperlaneMessages, opts ...grpc.CallOption) (*MsgReceiveHyperlaneMessagesResponse, error)
+	// Orbit dispatches an Orbiter payload using funds already held
+	// on Noble by the sender.
+	Orbit(ctx context.Context, in *MsgOrbit, opts ...grpc.CallOption) (*MsgOrbitResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ReceiveCCTPMessages(ctx context.Context, in *MsgReceiveCCTPMessages, opts ...grpc.CallOption) (*MsgReceiveCCTPMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgReceiveCCTPMessagesResponse)
+	err := c.cc.Invoke(ctx, Msg_ReceiveCCTPMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReceiveHyperlaneMessages(ctx context.Context, in *MsgReceiveHyperlaneMessages, opts ...grpc.CallOption) (*MsgReceiveHyperlaneMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgReceiveHyperlaneMessagesResponse)
+	err := c.cc.Invoke(ctx, Msg_ReceiveHyperlaneMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Orbit(ctx context.Context, in *MsgOrbit, opts ...grpc.CallOption) (*MsgOrbitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgOrbitResponse)
+	err := c.cc.Invoke(ctx, Msg_Orbit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility.
+//
+// Msg defines the RPC methods for the Orbiter entrypoints.
+type MsgServer interface {
+	// ReceiveCCTPMessages relays a CCTP transfer message along with the
+	// associated Orbiter payload message in the same transaction.
+	ReceiveCCTPMessages(context.Context, *MsgReceiveCCTPMessages) (*MsgReceiveCCTPMessagesResponse, error)
+	// ReceiveHyperlaneMessages relays a Hyperlane warp transfer message along
+	// with the associated Orbiter payload message in the same transaction.
+	ReceiveHyperlaneMessages(context.Context, *MsgReceiveHyperlaneMessages) (*MsgReceiveHyperlaneMessagesResponse, error)
+	// Orbit dispatches an Orbiter payload using funds already held
+	// on Noble by the sender.
+	Orbit(context.Context, *MsgOrbit) (*MsgOrbitResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMsgServer struct{}
+
+func (UnimplementedMsgServer) ReceiveCCTPMessages(context.Context, *MsgReceiveCCTPMessages) (*MsgReceiveCCTPMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveCCTPMessages not implemented")
+}
+func (UnimplementedMsgServer) ReceiveHyperlaneMessages(context.Context, *MsgReceiveHyperlaneMessages) (*MsgReceiveHyperlaneMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveHyperlaneMessages not implemented")
+}
+func (UnimplementedMsgServer) Orbit(context.Context, *MsgOrbit) (*MsgOrbitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Orbit not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+func (UnimplementedMsgServer) testEmbeddedByValue()             {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	// If the following call pancis, it indicates UnimplementedMsgServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_ReceiveCCTPMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReceiveCCTPMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReceiveCCTPMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReceiveCCTPMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReceiveCCTPMessages(ctx, req.(*MsgReceiveCCTPMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReceiveHyperlaneMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReceiveHyperlaneMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReceiveHyperlaneMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReceiveHyperlaneMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReceiveHyperlaneMessages(ctx, req.(*MsgReceiveHyperlaneMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Orbit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOrbit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Orbit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Orbit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Orbit(ctx, req.(*MsgOrbit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.orbiter.entrypoint.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReceiveCCTPMessages",
+			Handler:    _Msg_ReceiveCCTPMessages_Handler,
+		},
+		{
+			MethodName: "ReceiveHyperlaneMessages",
+			Handler:    _Msg_ReceiveHyperlaneMessages_Handler,
+		},
+		{
+			MethodName: "Orbit",
+			Handler:    _Msg_Orbit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/orbiter/entrypoint/v1/tx.proto",
+}
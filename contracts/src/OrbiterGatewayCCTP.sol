@@ -112,7 +112,7 @@ contract OrbiterGatewayCCTP {
      * @param v The recovery byte of the permit signature.
      * @param r The first 32 bytes of the permit signature.
      * @param s The second 32 bytes of the permit signature.
-     * @param orbiterPayload Bytes of the Orbiter payload.
+     * @param orbiterPayload ABI encoded Orbiter payload.
      */
     function depositForBurnWithOrbiter(
         uint256 amount,
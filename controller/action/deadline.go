@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/controller"
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var _ types.ActionController = &DeadlineController{}
+
+// DeadlineController is the controller rejecting the
+// transfers dispatched after their deadline.
+type DeadlineController struct {
+	*controller.BaseController[core.ActionID]
+
+	logger log.Logger
+}
+
+// NewDeadlineController returns a new validated instance of
+// the deadline controller.
+func NewDeadlineController(logger log.Logger) (*DeadlineController, error) {
+	if logger == nil {
+		return nil, core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+
+	id := core.ACTION_DEADLINE
+	baseController, err := controller.NewBase(id)
+	if err != nil {
+		return nil, err
+	}
+
+	deadlineController := DeadlineController{
+		logger:         logger.With(core.ActionControllerName, baseController.Name()),
+		BaseController: baseController,
+	}
+
+	return &deadlineController, deadlineController.Validate()
+}
+
+// Validate performs basic validation for the deadline controller.
+func (c *DeadlineController) Validate() error {
+	if c.logger == nil {
+		return core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+	if c.BaseController == nil {
+		return core.ErrNilPointer.Wrap("base controller cannot be nil")
+	}
+
+	return nil
+}
+
+// HandlePacket process a deadline action packet. An error is returned
+// if the current block height or time is after the deadline, which
+// fails the dispatch of the payload.
+func (c *DeadlineController) HandlePacket(
+	ctx context.Context,
+	packet *types.ActionPacket,
+) error {
+	attr, err := c.GetAttributes(packet.Action)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if attr.IsExceeded(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return core.ErrDeadlineExceeded.Wrapf(
+			"deadline height %d and time %s exceeded at block height %d and time %s",
+			attr.Height,
+			formatDeadlineTime(attr.Time),
+			sdkCtx.BlockHeight(),
+			sdkCtx.BlockTime().Format(time.RFC3339),
+		)
+	}
+
+	return nil
+}
+
+// GetAttributes returns the deadline attributes concrete type
+// from a deadline action.
+func (c *DeadlineController) GetAttributes(
+	action *core.Action,
+) (*actiontypes.DeadlineAttributes, error) {
+	attr, err := c.extractAttributes(action)
+	if err != nil {
+		return nil, core.ErrInvalidAttributes.Wrap(err.Error())
+	}
+	if err = attr.Validate(); err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	return attr, nil
+}
+
+// extractAttributes extract the deadline attributes. Return an
+// error in case of invalid attributes.
+func (c *DeadlineController) extractAttributes(
+	action *core.Action,
+) (*actiontypes.DeadlineAttributes, error) {
+	if action == nil {
+		return nil, core.ErrNilPointer.Wrap("received nil deadline attributes")
+	}
+	attr, err := action.CachedAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	deadlineAttr, ok := attr.(*actiontypes.DeadlineAttributes)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf(
+			"expected %T, got %T",
+			&actiontypes.DeadlineAttributes{},
+			attr,
+		)
+	}
+
+	return deadlineAttr, nil
+}
+
+func formatDeadlineTime(t *time.Time) string {
+	if t == nil {
+		return "unset"
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	controllers "github.com/noble-assets/orbiter/v2/controller/action"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/testutil/testdata"
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestNewDeadlineController(t *testing.T) {
+	deps := mocks.NewDependencies(t)
+
+	_, err := controllers.NewDeadlineController(nil)
+	require.ErrorContains(t, err, "logger cannot be nil")
+
+	controller, err := controllers.NewDeadlineController(deps.Logger)
+	require.NoError(t, err)
+	require.Equal(t, core.ACTION_DEADLINE, controller.ID())
+}
+
+func TestDeadlineHandlePacket(t *testing.T) {
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newAction := func(height uint64, deadline *time.Time) func(*testing.T) *core.Action {
+		return func(tb *testing.T) *core.Action {
+			tb.Helper()
+
+			attr, err := actiontypes.NewDeadlineAttributes(height, deadline)
+			require.NoError(tb, err)
+			action, err := actiontypes.NewDeadlineAction(attr)
+			require.NoError(tb, err)
+
+			return action
+		}
+	}
+	before := blockTime.Add(-time.Second)
+	after := blockTime.Add(time.Second)
+
+	testCases := []struct {
+		name   string
+		action func(*testing.T) *core.Action
+		expErr string
+	}{
+		{
+			name:   "success - height not exceeded",
+			action: newAction(100, nil),
+		},
+		{
+			name:   "success - time not exceeded",
+			action: newAction(0, &after),
+		},
+		{
+			name:   "error - height exceeded",
+			action: newAction(99, nil),
+			expErr: core.ErrDeadlineExceeded.Error(),
+		},
+		{
+			name:   "error - time exceeded",
+			action: newAction(100, &before),
+			expErr: core.ErrDeadlineExceeded.Error(),
+		},
+		{
+			name: "error - invalid attributes",
+			action: func(tb *testing.T) *core.Action {
+				tb.Helper()
+
+				action, err := core.NewAction(
+					core.ACTION_DEADLINE,
+					&testdata.TestActionAttr{Whatever: "works"},
+				)
+				require.NoError(tb, err)
+
+				return action
+			},
+			expErr: "expected *action.DeadlineAttributes",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			deps := mocks.NewDependencies(t)
+			ctx := deps.SdkCtx.WithBlockHeight(100).WithBlockTime(blockTime)
+
+			controller, err := controllers.NewDeadlineController(deps.Logger)
+			require.NoError(t, err)
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_CCTP,
+				"1",
+				"uusdc",
+				sdkmath.NewInt(1_000_000),
+			)
+			require.NoError(t, err)
+
+			packet, err := types.NewActionPacket(transferAttr, tC.action(t))
+			require.NoError(t, err)
+
+			err = controller.HandlePacket(ctx, packet)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, sdkmath.NewInt(1_000_000), transferAttr.DestinationAmount())
+		})
+	}
+}
@@ -109,6 +109,7 @@ func (c *FeeController) HandlePacket(
 		transferAttr.DestinationAmount(),
 		transferAttr.DestinationDenom(),
 		attr.FeesInfo,
+		attr.MaxTotalFeeAmount(),
 	)
 	if err != nil {
 		return err
@@ -126,10 +127,19 @@ func (c *FeeController) HandlePacket(
 		transferAttr.DestinationAmount().Sub(feesToDistribute.Total),
 	)
 
+	feesPaid := make([]actiontypes.FeePaid, 0, len(feesToDistribute.Values))
+	for _, fee := range feesToDistribute.Values {
+		feesPaid = append(feesPaid, actiontypes.FeePaid{
+			Recipient: fee.Recipient.String(),
+			Amount:    fee.Amount[0],
+		})
+	}
+
 	if err = c.eventService.EventManager(ctx).Emit(
 		ctx,
 		&actiontypes.EventFeeAction{
 			FeesInfo: attr.GetFeesInfo(),
+			FeesPaid: feesPaid,
 		},
 	); err != nil {
 		return errorsmod.Wrap(err, "failed to emit fee action event")
@@ -159,13 +169,16 @@ func (c *FeeController) ValidateAttributes(attr *actiontypes.FeeAttributes) erro
 }
 
 // ComputeFeesToDistribute computes the fee to distribute based on the
-// action fees information and the amount and denom to transfer.
+// action fees information and the amount and denom to transfer. If
+// the maximum total fee is positive, an error is returned when the
+// sum of the computed fees exceeds it.
 //
 // CONTRACT: the inputs have already been validated.
 func (c *FeeController) ComputeFeesToDistribute(
 	transferAmount math.Int,
 	transferDenom string,
 	feesInfo []*actiontypes.FeeInfo,
+	maxTotalFee math.Int,
 ) (*actiontypes.FeesToDistribute, error) {
 	fees := actiontypes.NewFeesToDistribute()
 
@@ -183,6 +196,15 @@ func (c *FeeController) ComputeFeesToDistribute(
 			}
 		case *actiontypes.FeeInfo_Amount_:
 			feeAmount, _ = math.NewIntFromString(feeType.Amount.Value)
+		case *actiontypes.FeeInfo_BoundedBasisPoints_:
+			feeAmount, err = ComputeFeeAmount(
+				transferAmount,
+				uint64(feeType.BoundedBasisPoints.Value),
+			)
+			if err != nil {
+				return nil, err
+			}
+			feeAmount = feeType.BoundedBasisPoints.Bound(feeAmount)
 		}
 
 		if feeAmount.IsPositive() {
@@ -195,6 +217,14 @@ func (c *FeeController) ComputeFeesToDistribute(
 		}
 	}
 
+	if !maxTotalFee.IsNil() && maxTotalFee.IsPositive() && fees.Total.GT(maxTotalFee) {
+		return nil, core.ErrInvalidAttributes.Wrapf(
+			"total fees %s exceed the maximum total fee %s",
+			fees.Total,
+			maxTotalFee,
+		)
+	}
+
 	return &fees, nil
 }
 
@@ -150,6 +150,7 @@ func TestComputeFeesToDistribute(t *testing.T) {
 		name               string
 		amount             sdkmath.Int
 		feesInfo           []*actiontypes.FeeInfo
+		maxTotalFee        sdkmath.Int
 		expFeeToDistribute *actiontypes.FeesToDistribute
 		expErr             string
 	}{
@@ -314,6 +315,140 @@ func TestComputeFeesToDistribute(t *testing.T) {
 				Values: []actiontypes.RecipientAmount{},
 			},
 		},
+		{
+			name:   "success - bounded basis points within bounds",
+			amount: sdkmath.NewInt(1_000_000),
+			feesInfo: []*actiontypes.FeeInfo{
+				{
+					Recipient: recipient1.String(),
+					FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+						BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+							Value:     100,
+							MinAmount: "1000",
+							MaxAmount: "50000",
+						},
+					},
+				},
+			},
+			expFeeToDistribute: &actiontypes.FeesToDistribute{
+				Total: sdkmath.NewInt(10_000),
+				Values: []actiontypes.RecipientAmount{
+					{
+						Recipient: recipient1,
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000)),
+					},
+				},
+			},
+		},
+		{
+			name:   "success - bounded basis points raised to the minimum",
+			amount: sdkmath.NewInt(1_000_000),
+			feesInfo: []*actiontypes.FeeInfo{
+				{
+					Recipient: recipient1.String(),
+					FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+						BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+							Value:     1, // 0.01% of 1,000,000 = 100
+							MinAmount: "500",
+						},
+					},
+				},
+			},
+			expFeeToDistribute: &actiontypes.FeesToDistribute{
+				Total: sdkmath.NewInt(500),
+				Values: []actiontypes.RecipientAmount{
+					{
+						Recipient: recipient1,
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin(denom, 500)),
+					},
+				},
+			},
+		},
+		{
+			name:   "success - bounded basis points capped to the maximum",
+			amount: sdkmath.NewInt(1_000_000),
+			feesInfo: []*actiontypes.FeeInfo{
+				{
+					Recipient: recipient1.String(),
+					FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+						BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+							Value:     100, // 1% of 1,000,000 = 10,000
+							MaxAmount: "2500",
+						},
+					},
+				},
+				{
+					Recipient: recipient2.String(),
+					FeeType: &actiontypes.FeeInfo_Amount_{
+						Amount: &actiontypes.FeeInfo_Amount{
+							Value: "300",
+						},
+					},
+				},
+			},
+			expFeeToDistribute: &actiontypes.FeesToDistribute{
+				Total: sdkmath.NewInt(2_800),
+				Values: []actiontypes.RecipientAmount{
+					{
+						Recipient: recipient1,
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin(denom, 2_500)),
+					},
+					{
+						Recipient: recipient2,
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin(denom, 300)),
+					},
+				},
+			},
+		},
+		{
+			name:   "success - total fees equal to the maximum total fee",
+			amount: sdkmath.NewInt(1_000_000),
+			feesInfo: []*actiontypes.FeeInfo{
+				{
+					Recipient: recipient1.String(),
+					FeeType: &actiontypes.FeeInfo_BasisPoints_{
+						BasisPoints: &actiontypes.FeeInfo_BasisPoints{
+							Value: 100,
+						},
+					},
+				},
+			},
+			maxTotalFee: sdkmath.NewInt(10_000),
+			expFeeToDistribute: &actiontypes.FeesToDistribute{
+				Total: sdkmath.NewInt(10_000),
+				Values: []actiontypes.RecipientAmount{
+					{
+						Recipient: recipient1,
+						Amount:    sdk.NewCoins(sdk.NewInt64Coin(denom, 10_000)),
+					},
+				},
+			},
+		},
+		{
+			name:   "error - total fees exceed the maximum total fee",
+			amount: sdkmath.NewInt(1_000_000),
+			feesInfo: []*actiontypes.FeeInfo{
+				{
+					Recipient: recipient1.String(),
+					FeeType: &actiontypes.FeeInfo_BasisPoints_{
+						BasisPoints: &actiontypes.FeeInfo_BasisPoints{
+							Value: 100,
+						},
+					},
+				},
+				{
+					Recipient: recipient2.String(),
+					FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+						BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+							Value:     1,
+							MinAmount: "500",
+						},
+					},
+				},
+			},
+			maxTotalFee: sdkmath.NewInt(10_000),
+			expErr:      "exceed the maximum total fee",
+		},
 		{
 			name:   "error - overflow handling returns zero fee",
 			amount: bigNumber,
@@ -342,7 +477,12 @@ func TestComputeFeesToDistribute(t *testing.T) {
 
 	for _, tC := range testCases {
 		t.Run(tC.name, func(t *testing.T) {
-			result, err := controller.ComputeFeesToDistribute(tC.amount, denom, tC.feesInfo)
+			result, err := controller.ComputeFeesToDistribute(
+				tC.amount,
+				denom,
+				tC.feesInfo,
+				tC.maxTotalFee,
+			)
 
 			if tC.expErr != "" {
 				require.ErrorContains(t, err, tC.expErr)
@@ -0,0 +1,242 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/controller"
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var _ types.ActionController = &IntegratorFeeController{}
+
+// IntegratorFeeController is the controller to execute the
+// payment of the fee of a registered integrator.
+type IntegratorFeeController struct {
+	*controller.BaseController[core.ActionID]
+
+	logger           log.Logger
+	eventService     event.Service
+	BankKeeper       actiontypes.BankKeeperFee
+	IntegratorKeeper actiontypes.IntegratorKeeper
+}
+
+// NewIntegratorFeeController returns a new validated instance of
+// the integrator fee controller.
+func NewIntegratorFeeController(
+	logger log.Logger,
+	eventService event.Service,
+	bankKeeper actiontypes.BankKeeperFee,
+	integratorKeeper actiontypes.IntegratorKeeper,
+) (*IntegratorFeeController, error) {
+	if logger == nil {
+		return nil, core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+
+	id := core.ACTION_INTEGRATOR_FEE
+	baseController, err := controller.NewBase(id)
+	if err != nil {
+		return nil, err
+	}
+
+	integratorFeeController := IntegratorFeeController{
+		logger:           logger.With(core.ActionControllerName, baseController.Name()),
+		eventService:     eventService,
+		BaseController:   baseController,
+		BankKeeper:       bankKeeper,
+		IntegratorKeeper: integratorKeeper,
+	}
+
+	return &integratorFeeController, integratorFeeController.Validate()
+}
+
+// Validate performs basic validation for the integrator fee controller.
+func (c *IntegratorFeeController) Validate() error {
+	if c.logger == nil {
+		return core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+	if c.eventService == nil {
+		return core.ErrNilPointer.Wrap("event service cannot be nil")
+	}
+	if c.BaseController == nil {
+		return core.ErrNilPointer.Wrap("base controller cannot be nil")
+	}
+	if c.BankKeeper == nil {
+		return core.ErrNilPointer.Wrap("bank keeper cannot be nil")
+	}
+	if c.IntegratorKeeper == nil {
+		return core.ErrNilPointer.Wrap("integrator keeper cannot be nil")
+	}
+
+	return nil
+}
+
+// HandlePacket process an integrator fee action packet. The fee is
+// split between the integrator and the treasury, and the transfer is
+// added to the integrator stats.
+func (c *IntegratorFeeController) HandlePacket(
+	ctx context.Context,
+	packet *types.ActionPacket,
+) error {
+	attr, err := c.GetAttributes(packet.Action)
+	if err != nil {
+		return err
+	}
+
+	integrator, found, err := c.IntegratorKeeper.GetIntegrator(ctx, attr.IntegratorId)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting integrator")
+	}
+	if !found {
+		return core.ErrInvalidAttributes.Wrapf("integrator %s is not registered", attr.IntegratorId)
+	}
+
+	basisPoints := integrator.BasisPoints
+	if attr.BasisPoints > 0 {
+		basisPoints = attr.BasisPoints
+	}
+
+	transferAttr := packet.TransferAttributes
+	amount := transferAttr.DestinationAmount()
+	denom := transferAttr.DestinationDenom()
+
+	feeAmount, err := ComputeFeeAmount(amount, uint64(basisPoints))
+	if err != nil {
+		return errorsmod.Wrap(err, "error computing integrator fee")
+	}
+	if feeAmount.GTE(amount) {
+		return core.ErrInvalidAttributes.Wrap("integrator fee equal or exceed transfer amount")
+	}
+
+	integratorAmount, treasuryAmount, err := integrator.SplitFee(feeAmount)
+	if err != nil {
+		return errorsmod.Wrap(err, "error splitting integrator fee")
+	}
+
+	integratorFee := sdk.NewCoin(denom, integratorAmount)
+	treasuryFee := sdk.NewCoin(denom, treasuryAmount)
+
+	if err = c.executeAction(ctx, integrator, integratorFee, treasuryFee); err != nil {
+		return errorsmod.Wrap(err, "integrator fee controller execution error")
+	}
+
+	if err = c.IntegratorKeeper.AddIntegratorStats(
+		ctx,
+		integrator.Id,
+		denom,
+		amount,
+		feeAmount,
+	); err != nil {
+		return errorsmod.Wrap(err, "error updating integrator stats")
+	}
+
+	transferAttr.SetDestinationAmount(amount.Sub(feeAmount))
+
+	if err = c.eventService.EventManager(ctx).Emit(
+		ctx,
+		&actiontypes.EventIntegratorFeeAction{
+			IntegratorId:  integrator.Id,
+			IntegratorFee: integratorFee,
+			TreasuryFee:   treasuryFee,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to emit integrator fee action event")
+	}
+
+	return nil
+}
+
+// GetAttributes returns the integrator fee attributes concrete type
+// from an integrator fee action.
+func (c *IntegratorFeeController) GetAttributes(
+	action *core.Action,
+) (*actiontypes.IntegratorFeeAttributes, error) {
+	attr, err := c.extractAttributes(action)
+	if err != nil {
+		return nil, core.ErrInvalidAttributes.Wrap(err.Error())
+	}
+	if err = attr.Validate(); err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	return attr, nil
+}
+
+// executeAction is the core controller logic which sends the
+// integrator and treasury shares of the fee.
+func (c *IntegratorFeeController) executeAction(
+	ctx context.Context,
+	integrator actiontypes.Integrator,
+	integratorFee sdk.Coin,
+	treasuryFee sdk.Coin,
+) error {
+	if integratorFee.IsPositive() {
+		payout, _ := sdk.AccAddressFromBech32(integrator.PayoutAddress)
+		err := c.BankKeeper.SendCoins(ctx, core.ModuleAddress, payout, sdk.NewCoins(integratorFee))
+		if err != nil {
+			return err
+		}
+	}
+
+	if treasuryFee.IsPositive() {
+		treasury, _ := sdk.AccAddressFromBech32(integrator.TreasuryAddress)
+		err := c.BankKeeper.SendCoins(ctx, core.ModuleAddress, treasury, sdk.NewCoins(treasuryFee))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// extractAttributes extract the integrator fee attributes. Return an
+// error in case of invalid attributes.
+func (c *IntegratorFeeController) extractAttributes(
+	action *core.Action,
+) (*actiontypes.IntegratorFeeAttributes, error) {
+	if action == nil {
+		return nil, core.ErrNilPointer.Wrap("received nil integrator fee attributes")
+	}
+	attr, err := action.CachedAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	integratorFeeAttr, ok := attr.(*actiontypes.IntegratorFeeAttributes)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf(
+			"expected %T, got %T",
+			&actiontypes.IntegratorFeeAttributes{},
+			attr,
+		)
+	}
+
+	return integratorFeeAttr, nil
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	controllers "github.com/noble-assets/orbiter/v2/controller/action"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/testutil/testdata"
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestNewIntegratorFeeController(t *testing.T) {
+	e, deps := mocks.NewExecutorComponent(t)
+
+	_, err := controllers.NewIntegratorFeeController(deps.Logger, deps.EventService, nil, e)
+	require.ErrorContains(t, err, "bank keeper cannot be nil")
+
+	_, err = controllers.NewIntegratorFeeController(
+		deps.Logger,
+		deps.EventService,
+		mocks.NewBankKeeper(),
+		nil,
+	)
+	require.ErrorContains(t, err, "integrator keeper cannot be nil")
+
+	controller, err := controllers.NewIntegratorFeeController(
+		deps.Logger,
+		deps.EventService,
+		mocks.NewBankKeeper(),
+		e,
+	)
+	require.NoError(t, err)
+	require.Equal(t, core.ACTION_INTEGRATOR_FEE, controller.ID())
+}
+
+func TestIntegratorFeeHandlePacket(t *testing.T) {
+	testutil.SetSDKConfig()
+	payout := testutil.NewNobleAddress()
+	treasury := testutil.NewNobleAddress()
+
+	newAction := func(id string, basisPoints uint32) *core.Action {
+		attr, err := actiontypes.NewIntegratorFeeAttributes(id, basisPoints)
+		require.NoError(t, err)
+		action, err := actiontypes.NewIntegratorFeeAction(attr)
+		require.NoError(t, err)
+
+		return action
+	}
+
+	testCases := []struct {
+		name        string
+		integrator  actiontypes.Integrator
+		action      *core.Action
+		expPayout   int64
+		expTreasury int64
+		expErr      string
+	}{
+		{
+			name: "success - default fee without treasury share",
+			integrator: actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: payout,
+				BasisPoints:   100,
+			},
+			action:    newAction("frontend", 0),
+			expPayout: 10_000,
+		},
+		{
+			name: "success - overridden fee with treasury share",
+			integrator: actiontypes.Integrator{
+				Id:              "frontend",
+				PayoutAddress:   payout,
+				BasisPoints:     100,
+				TreasuryShare:   2_500,
+				TreasuryAddress: treasury,
+			},
+			action:      newAction("frontend", 50),
+			expPayout:   3_750,
+			expTreasury: 1_250,
+		},
+		{
+			name: "success - zero fee tracks the volume",
+			integrator: actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: payout,
+			},
+			action: newAction("frontend", 0),
+		},
+		{
+			name: "error - integrator not registered",
+			integrator: actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: payout,
+				BasisPoints:   100,
+			},
+			action: newAction("unknown", 0),
+			expErr: "integrator unknown is not registered",
+		},
+		{
+			name: "error - invalid attributes",
+			integrator: actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: payout,
+				BasisPoints:   100,
+			},
+			action: func() *core.Action {
+				action, err := core.NewAction(
+					core.ACTION_INTEGRATOR_FEE,
+					&testdata.TestActionAttr{Whatever: "works"},
+				)
+				require.NoError(t, err)
+
+				return action
+			}(),
+			expErr: "expected *action.IntegratorFeeAttributes",
+		},
+		{
+			name: "error - fee equal to the transfer amount",
+			integrator: actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: payout,
+				BasisPoints:   actiontypes.BPSNormalizer,
+			},
+			action: newAction("frontend", 0),
+			expErr: "integrator fee equal or exceed transfer amount",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			e, deps := mocks.NewExecutorComponent(t)
+			require.NoError(t, e.SetIntegrator(deps.SdkCtx, tC.integrator))
+
+			m := mocks.NewMocks()
+			m.BankKeeper.Balances[core.ModuleAddress.String()] = sdk.NewCoins(
+				sdk.NewInt64Coin("uusdc", 1_000_000),
+			)
+
+			controller, err := controllers.NewIntegratorFeeController(
+				deps.Logger,
+				deps.EventService,
+				m.BankKeeper,
+				e,
+			)
+			require.NoError(t, err)
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_CCTP,
+				"1",
+				"uusdc",
+				sdkmath.NewInt(1_000_000),
+			)
+			require.NoError(t, err)
+
+			packet, err := types.NewActionPacket(transferAttr, tC.action)
+			require.NoError(t, err)
+
+			err = controller.HandlePacket(deps.SdkCtx, packet)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Equal(t, sdkmath.NewInt(1_000_000), transferAttr.DestinationAmount())
+
+				_, found, err := e.GetIntegratorStats(deps.SdkCtx, tC.integrator.Id, "uusdc")
+				require.NoError(t, err)
+				require.False(t, found)
+
+				return
+			}
+			require.NoError(t, err)
+
+			expFee := tC.expPayout + tC.expTreasury
+			require.Equal(t, sdkmath.NewInt(1_000_000-expFee), transferAttr.DestinationAmount())
+			require.Equal(t, tC.expPayout, m.BankKeeper.Balances[payout].AmountOf("uusdc").Int64())
+			require.Equal(
+				t,
+				tC.expTreasury,
+				m.BankKeeper.Balances[treasury].AmountOf("uusdc").Int64(),
+			)
+
+			stats, found, err := e.GetIntegratorStats(deps.SdkCtx, tC.integrator.Id, "uusdc")
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, sdkmath.NewInt(1_000_000), stats.Volume)
+			require.Equal(t, sdkmath.NewInt(expFee), stats.Fees)
+			require.Equal(t, uint64(1), stats.Transfers)
+
+			events := deps.SdkCtx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Contains(t, events[0].Type, "EventIntegratorFeeAction")
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/controller"
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var _ types.ActionController = &RouteFeeController{}
+
+// RouteFeeController is the controller to execute
+// the collection of the fee defined for a route.
+type RouteFeeController struct {
+	*controller.BaseController[core.ActionID]
+
+	logger       log.Logger
+	eventService event.Service
+	BankKeeper   actiontypes.BankKeeperFee
+}
+
+// NewRouteFeeController returns a new validated instance of
+// the route fee controller.
+func NewRouteFeeController(
+	logger log.Logger,
+	eventService event.Service,
+	bankKeeper actiontypes.BankKeeperFee,
+) (*RouteFeeController, error) {
+	if logger == nil {
+		return nil, core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+
+	id := core.ACTION_ROUTE_FEE
+	baseController, err := controller.NewBase(id)
+	if err != nil {
+		return nil, err
+	}
+
+	routeFeeController := RouteFeeController{
+		logger:         logger.With(core.ActionControllerName, baseController.Name()),
+		eventService:   eventService,
+		BaseController: baseController,
+		BankKeeper:     bankKeeper,
+	}
+
+	return &routeFeeController, routeFeeController.Validate()
+}
+
+// Validate performs basic validation for the route fee controller.
+func (c *RouteFeeController) Validate() error {
+	if c.logger == nil {
+		return core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+	if c.eventService == nil {
+		return core.ErrNilPointer.Wrap("event service cannot be nil")
+	}
+	if c.BaseController == nil {
+		return core.ErrNilPointer.Wrap("base controller cannot be nil")
+	}
+	if c.BankKeeper == nil {
+		return core.ErrNilPointer.Wrap("bank keeper cannot be nil")
+	}
+
+	return nil
+}
+
+// HandlePacket process a route fee collection action packet.
+func (c *RouteFeeController) HandlePacket(
+	ctx context.Context,
+	packet *types.ActionPacket,
+) error {
+	attr, err := c.GetAttributes(packet.Action)
+	if err != nil {
+		return err
+	}
+
+	transferAttr := packet.TransferAttributes
+
+	feeAmount, err := attr.ComputeFee(transferAttr.DestinationAmount())
+	if err != nil {
+		return errorsmod.Wrap(err, "error computing route fee")
+	}
+	if !feeAmount.IsPositive() {
+		return nil
+	}
+	if feeAmount.GTE(transferAttr.DestinationAmount()) {
+		return core.ErrInvalidAttributes.Wrap("route fee equal or exceed transfer amount")
+	}
+
+	fee := sdk.NewCoin(transferAttr.DestinationDenom(), feeAmount)
+	recipient, _ := sdk.AccAddressFromBech32(attr.Recipient)
+	err = c.BankKeeper.SendCoins(ctx, core.ModuleAddress, recipient, sdk.NewCoins(fee))
+	if err != nil {
+		return errorsmod.Wrap(err, "route fee controller execution error")
+	}
+
+	transferAttr.SetDestinationAmount(transferAttr.DestinationAmount().Sub(feeAmount))
+
+	if err = c.eventService.EventManager(ctx).Emit(
+		ctx,
+		&actiontypes.EventRouteFeeAction{
+			Fee:        fee,
+			Attributes: attr,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to emit route fee action event")
+	}
+
+	return nil
+}
+
+// GetAttributes returns the route fee attributes concrete type from
+// a route fee action.
+func (c *RouteFeeController) GetAttributes(
+	action *core.Action,
+) (*actiontypes.RouteFeeAttributes, error) {
+	attr, err := c.extractAttributes(action)
+	if err != nil {
+		return nil, core.ErrInvalidAttributes.Wrap(err.Error())
+	}
+	if err = attr.Validate(); err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	return attr, nil
+}
+
+// extractAttributes extract the route fee attributes. Return an error
+// in case of invalid attributes.
+func (c *RouteFeeController) extractAttributes(
+	action *core.Action,
+) (*actiontypes.RouteFeeAttributes, error) {
+	if action == nil {
+		return nil, core.ErrNilPointer.Wrap("received nil route fee attributes")
+	}
+	attr, err := action.CachedAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	routeFeeAttr, ok := attr.(*actiontypes.RouteFeeAttributes)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf(
+			"expected %T, got %T",
+			&actiontypes.RouteFeeAttributes{},
+			attr,
+		)
+	}
+
+	return routeFeeAttr, nil
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	controllers "github.com/noble-assets/orbiter/v2/controller/action"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/testutil/testdata"
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestNewRouteFeeController(t *testing.T) {
+	deps := mocks.NewDependencies(t)
+
+	_, err := controllers.NewRouteFeeController(deps.Logger, deps.EventService, nil)
+	require.ErrorContains(t, err, "bank keeper cannot be nil")
+
+	_, err = controllers.NewRouteFeeController(nil, deps.EventService, mocks.NewBankKeeper())
+	require.ErrorContains(t, err, "logger cannot be nil")
+
+	controller, err := controllers.NewRouteFeeController(
+		deps.Logger,
+		deps.EventService,
+		mocks.NewBankKeeper(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, core.ACTION_ROUTE_FEE, controller.ID())
+}
+
+func TestRouteFeeHandlePacket(t *testing.T) {
+	testutil.SetSDKConfig()
+	recipient := testutil.NewNobleAddress()
+
+	testCases := []struct {
+		name   string
+		action func() *core.Action
+		expFee int64
+		expErr string
+	}{
+		{
+			name: "success - fee collected",
+			action: func() *core.Action {
+				attr, err := actiontypes.NewRouteFeeAttributes(
+					100,
+					sdkmath.ZeroInt(),
+					sdkmath.ZeroInt(),
+					recipient,
+				)
+				require.NoError(t, err)
+				action, err := actiontypes.NewRouteFeeAction(attr)
+				require.NoError(t, err)
+
+				return action
+			},
+			expFee: 10_000,
+		},
+		{
+			name: "success - fee capped to the maximum",
+			action: func() *core.Action {
+				attr, err := actiontypes.NewRouteFeeAttributes(
+					100,
+					sdkmath.ZeroInt(),
+					sdkmath.NewInt(500),
+					recipient,
+				)
+				require.NoError(t, err)
+				action, err := actiontypes.NewRouteFeeAction(attr)
+				require.NoError(t, err)
+
+				return action
+			},
+			expFee: 500,
+		},
+		{
+			name: "success - fee raised to the minimum",
+			action: func() *core.Action {
+				attr, err := actiontypes.NewRouteFeeAttributes(
+					1,
+					sdkmath.NewInt(2_000),
+					sdkmath.ZeroInt(),
+					recipient,
+				)
+				require.NoError(t, err)
+				action, err := actiontypes.NewRouteFeeAction(attr)
+				require.NoError(t, err)
+
+				return action
+			},
+			expFee: 2_000,
+		},
+		{
+			name: "error - invalid attributes",
+			action: func() *core.Action {
+				action, err := core.NewAction(
+					core.ACTION_ROUTE_FEE,
+					&testdata.TestActionAttr{Whatever: "works"},
+				)
+				require.NoError(t, err)
+
+				return action
+			},
+			expErr: "expected *action.RouteFeeAttributes",
+		},
+		{
+			name: "error - fee equal to the transfer amount",
+			action: func() *core.Action {
+				attr, err := actiontypes.NewRouteFeeAttributes(
+					0,
+					sdkmath.NewInt(1_000_000),
+					sdkmath.ZeroInt(),
+					recipient,
+				)
+				require.NoError(t, err)
+				action, err := actiontypes.NewRouteFeeAction(attr)
+				require.NoError(t, err)
+
+				return action
+			},
+			expErr: "route fee equal or exceed transfer amount",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			deps := mocks.NewDependencies(t)
+			m := mocks.NewMocks()
+			m.BankKeeper.Balances[core.ModuleAddress.String()] = sdk.NewCoins(
+				sdk.NewInt64Coin("uusdc", 1_000_000),
+			)
+
+			controller, err := controllers.NewRouteFeeController(
+				deps.Logger,
+				deps.EventService,
+				m.BankKeeper,
+			)
+			require.NoError(t, err)
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_CCTP,
+				"1",
+				"uusdc",
+				sdkmath.NewInt(1_000_000),
+			)
+			require.NoError(t, err)
+
+			packet, err := types.NewActionPacket(transferAttr, tC.action())
+			require.NoError(t, err)
+
+			err = controller.HandlePacket(deps.SdkCtx, packet)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Equal(t, sdkmath.NewInt(1_000_000), transferAttr.DestinationAmount())
+
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, sdkmath.NewInt(1_000_000-tC.expFee), transferAttr.DestinationAmount())
+			require.Equal(
+				t,
+				sdk.NewCoins(sdk.NewInt64Coin("uusdc", tC.expFee)),
+				m.BankKeeper.Balances[recipient],
+			)
+
+			events := deps.SdkCtx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Contains(t, events[0].Type, "EventRouteFeeAction")
+		})
+	}
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action
+
+import (
+	"context"
+
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/controller"
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var _ types.ActionController = &SwapController{}
+
+// SwapController is the controller to execute
+// swap action.
+type SwapController struct {
+	*controller.BaseController[core.ActionID]
+
+	logger       log.Logger
+	eventService event.Service
+	SwapKeeper   actiontypes.SwapKeeper
+}
+
+// NewSwapController returns a new validated instance of
+// the swap controller.
+func NewSwapController(
+	logger log.Logger,
+	eventService event.Service,
+	swapKeeper actiontypes.SwapKeeper,
+) (*SwapController, error) {
+	if logger == nil {
+		return nil, core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+
+	id := core.ACTION_SWAP
+	baseController, err := controller.NewBase(id)
+	if err != nil {
+		return nil, err
+	}
+
+	swapController := SwapController{
+		logger:         logger.With(core.ActionControllerName, baseController.Name()),
+		eventService:   eventService,
+		BaseController: baseController,
+		SwapKeeper:     swapKeeper,
+	}
+
+	return &swapController, swapController.Validate()
+}
+
+// Validate performs basic validation for the swap controller.
+func (c *SwapController) Validate() error {
+	if c.logger == nil {
+		return core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+	if c.eventService == nil {
+		return core.ErrNilPointer.Wrap("event service cannot be nil")
+	}
+	if c.BaseController == nil {
+		return core.ErrNilPointer.Wrap("base controller cannot be nil")
+	}
+	if c.SwapKeeper == nil {
+		return core.ErrNilPointer.Wrap("swap keeper cannot be nil")
+	}
+
+	return nil
+}
+
+// HandlePacket process a swap action packet.
+func (c *SwapController) HandlePacket(
+	ctx context.Context,
+	packet *types.ActionPacket,
+) error {
+	attr, err := c.GetAttributes(packet.Action)
+	if err != nil {
+		return err
+	}
+
+	transferAttr := packet.TransferAttributes
+
+	coinIn := sdk.NewCoin(transferAttr.DestinationDenom(), transferAttr.DestinationAmount())
+	if coinIn.Denom == attr.DenomOut {
+		return core.ErrInvalidAttributes.Wrapf(
+			"cannot swap %s into the same denom",
+			coinIn.Denom,
+		)
+	}
+
+	coinOut, err := c.SwapKeeper.Swap(
+		ctx,
+		core.ModuleAddress,
+		coinIn,
+		attr.Routes,
+		attr.MinCoinOut(),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "swap controller execution error")
+	}
+
+	// NOTE: the swap module already enforces the minimum output, the
+	// check protects the forwarding against an unexpected result.
+	if coinOut.Denom != attr.DenomOut || coinOut.Amount.LT(attr.MinAmountOut) {
+		return core.ErrValidation.Wrapf(
+			"swap output %s is lower than the minimum %s",
+			coinOut,
+			attr.MinCoinOut(),
+		)
+	}
+
+	transferAttr.SetDestinationDenom(coinOut.Denom)
+	transferAttr.SetDestinationAmount(coinOut.Amount)
+
+	if err = c.eventService.EventManager(ctx).Emit(
+		ctx,
+		&actiontypes.EventSwapAction{
+			CoinIn:  coinIn,
+			CoinOut: coinOut,
+			Routes:  attr.Routes,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to emit swap action event")
+	}
+
+	return nil
+}
+
+// GetAttributes returns the swap attributes concrete type from
+// a swap action.
+func (c *SwapController) GetAttributes(
+	action *core.Action,
+) (*actiontypes.SwapAttributes, error) {
+	attr, err := c.extractAttributes(action)
+	if err != nil {
+		return nil, core.ErrInvalidAttributes.Wrap(err.Error())
+	}
+	err = c.ValidateAttributes(attr)
+	if err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	return attr, nil
+}
+
+// ValidateAttributes returns an error if the provided swap attributes are not valid.
+func (c *SwapController) ValidateAttributes(attr *actiontypes.SwapAttributes) error {
+	return attr.Validate()
+}
+
+// extractAttributes extract the swap attributes. Return an error in case
+// of invalid attributes.
+func (c *SwapController) extractAttributes(
+	action *core.Action,
+) (*actiontypes.SwapAttributes, error) {
+	if action == nil {
+		return nil, core.ErrNilPointer.Wrap("received nil swap attributes")
+	}
+	attr, err := action.CachedAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	swapAttr, ok := attr.(*actiontypes.SwapAttributes)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf(
+			"expected %T, got %T",
+			&actiontypes.SwapAttributes{},
+			attr,
+		)
+	}
+
+	return swapAttr, nil
+}
@@ -0,0 +1,201 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	controllers "github.com/noble-assets/orbiter/v2/controller/action"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/testutil/testdata"
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestNewSwapController(t *testing.T) {
+	deps := mocks.NewDependencies(t)
+
+	_, err := controllers.NewSwapController(deps.Logger, deps.EventService, nil)
+	require.ErrorContains(t, err, "swap keeper cannot be nil")
+
+	_, err = controllers.NewSwapController(nil, deps.EventService, &mocks.SwapKeeper{})
+	require.ErrorContains(t, err, "logger cannot be nil")
+
+	controller, err := controllers.NewSwapController(
+		deps.Logger,
+		deps.EventService,
+		&mocks.SwapKeeper{},
+	)
+	require.NoError(t, err)
+	require.Equal(t, core.ACTION_SWAP, controller.ID())
+}
+
+func TestSwapHandlePacket(t *testing.T) {
+	routes := []*actiontypes.SwapRoute{{PoolId: 0, DenomTo: "uusdn"}}
+
+	testCases := []struct {
+		name       string
+		ctx        func(context.Context) context.Context
+		action     func() *core.Action
+		denomIn    string
+		amountOut  int64
+		expCoinOut sdk.Coin
+		expErr     string
+	}{
+		{
+			name: "success - swap executed",
+			action: func() *core.Action {
+				action, err := actiontypes.NewSwapAction(routes, "uusdn", sdkmath.NewInt(990_000))
+				require.NoError(t, err)
+
+				return action
+			},
+			denomIn:    "uusdc",
+			amountOut:  995_000,
+			expCoinOut: sdk.NewInt64Coin("uusdn", 995_000),
+		},
+		{
+			name: "error - invalid attributes",
+			action: func() *core.Action {
+				action, err := core.NewAction(
+					core.ACTION_SWAP,
+					&testdata.TestActionAttr{Whatever: "works"},
+				)
+				require.NoError(t, err)
+
+				return action
+			},
+			denomIn: "uusdc",
+			expErr:  "expected *action.SwapAttributes",
+		},
+		{
+			name: "error - missing minimum output amount",
+			action: func() *core.Action {
+				action, err := core.NewAction(
+					core.ACTION_SWAP,
+					&actiontypes.SwapAttributes{
+						Routes:       routes,
+						DenomOut:     "uusdn",
+						MinAmountOut: sdkmath.ZeroInt(),
+					},
+				)
+				require.NoError(t, err)
+
+				return action
+			},
+			denomIn: "uusdc",
+			expErr:  "minimum output amount must be positive",
+		},
+		{
+			name: "error - same input and output denom",
+			action: func() *core.Action {
+				action, err := actiontypes.NewSwapAction(routes, "uusdn", sdkmath.NewInt(990_000))
+				require.NoError(t, err)
+
+				return action
+			},
+			denomIn: "uusdn",
+			expErr:  "cannot swap uusdn into the same denom",
+		},
+		{
+			name: "error - output lower than the minimum",
+			action: func() *core.Action {
+				action, err := actiontypes.NewSwapAction(routes, "uusdn", sdkmath.NewInt(990_000))
+				require.NoError(t, err)
+
+				return action
+			},
+			denomIn:   "uusdc",
+			amountOut: 989_999,
+			expErr:    "swap output is lower than the minimum",
+		},
+		{
+			name: "error - swap failure",
+			ctx: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, mocks.FailingContextKey, true)
+			},
+			action: func() *core.Action {
+				action, err := actiontypes.NewSwapAction(routes, "uusdn", sdkmath.NewInt(990_000))
+				require.NoError(t, err)
+
+				return action
+			},
+			denomIn: "uusdc",
+			expErr:  "error executing swap",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			deps := mocks.NewDependencies(t)
+			m := mocks.NewMocks()
+			m.SwapKeeper.AmountOut = sdkmath.NewInt(tC.amountOut)
+
+			controller, err := controllers.NewSwapController(
+				deps.Logger,
+				deps.EventService,
+				m.SwapKeeper,
+			)
+			require.NoError(t, err)
+
+			ctx := context.Context(deps.SdkCtx)
+			if tC.ctx != nil {
+				ctx = tC.ctx(ctx)
+			}
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_CCTP,
+				"1",
+				tC.denomIn,
+				sdkmath.NewInt(1_000_000),
+			)
+			require.NoError(t, err)
+
+			packet, err := types.NewActionPacket(transferAttr, tC.action())
+			require.NoError(t, err)
+
+			err = controller.HandlePacket(ctx, packet)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Equal(t, tC.denomIn, transferAttr.DestinationDenom())
+
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, []sdk.Coin{sdk.NewInt64Coin(tC.denomIn, 1_000_000)}, m.SwapKeeper.Swapped)
+			require.Equal(t, tC.expCoinOut.Denom, transferAttr.DestinationDenom())
+			require.Equal(t, tC.expCoinOut.Amount, transferAttr.DestinationAmount())
+			require.Equal(t, "uusdc", transferAttr.SourceDenom())
+
+			events := deps.SdkCtx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Contains(t, events[0].Type, "EventSwapAction")
+		})
+	}
+}
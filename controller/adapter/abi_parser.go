@@ -0,0 +1,553 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter
+
+import (
+	"bytes"
+	"encoding/hex"
+	stdmath "math"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+const (
+	// ABIFeeTypeBasisPoints identifies an ABI encoded fee expressed in basis points.
+	ABIFeeTypeBasisPoints uint8 = 0
+	// ABIFeeTypeAmount identifies an ABI encoded fee expressed as a fixed amount.
+	ABIFeeTypeAmount uint8 = 1
+)
+
+// ABIPayload is the Go representation of the Solidity struct
+//
+//	struct OrbiterPayload {
+//	    Action[] preActions;
+//	    uint32 protocolId;
+//	    bytes forwardingAttributes;
+//	    bytes passthroughPayload;
+//	    string fallbackRecipient;
+//	}
+//
+// where the forwarding attributes are the ABI encoding of the
+// protocol specific attributes struct. An empty fallback recipient
+// means that no fallback is used.
+type ABIPayload struct {
+	PreActions           []ABIAction
+	ProtocolID           uint32 `abi:"protocolId"`
+	ForwardingAttributes []byte
+	PassthroughPayload   []byte
+	FallbackRecipient    string
+}
+
+// ABIAction is the Go representation of the Solidity struct
+//
+//	struct Action {
+//	    uint32 id;
+//	    bytes attributes;
+//	}
+//
+// where the attributes are the ABI encoding of the action specific
+// attributes struct.
+type ABIAction struct {
+	ID         uint32 `abi:"id"`
+	Attributes []byte
+}
+
+// ABIFeeInfo is the Go representation of the Solidity struct
+//
+//	struct FeeInfo {
+//	    string recipient;
+//	    uint8 feeType;
+//	    uint256 value;
+//	}
+//
+// The fee attributes are encoded as FeeInfo[].
+type ABIFeeInfo struct {
+	Recipient string
+	FeeType   uint8
+	Value     *big.Int
+}
+
+// ABIDeadlineAttributes is the Go representation of the Solidity struct
+//
+//	struct DeadlineAttributes {
+//	    uint64 height;
+//	    uint64 timestamp;
+//	}
+//
+// where the timestamp is expressed in Unix seconds. A zero value
+// disables the associated check.
+type ABIDeadlineAttributes struct {
+	Height    uint64
+	Timestamp uint64
+}
+
+// ABICCTPAttributes is the Go representation of the Solidity struct
+//
+//	struct CCTPAttributes {
+//	    uint32 destinationDomain;
+//	    bytes32 mintRecipient;
+//	    bytes32 destinationCaller;
+//	}
+//
+// A zero destination caller allows any caller on the destination domain.
+type ABICCTPAttributes struct {
+	DestinationDomain uint32
+	MintRecipient     [32]byte
+	DestinationCaller [32]byte
+}
+
+// ABIHyperlaneAttributes is the Go representation of the Solidity struct
+//
+//	struct HyperlaneAttributes {
+//	    bytes32 tokenId;
+//	    uint32 destinationDomain;
+//	    bytes32 recipient;
+//	    bytes32 customHookId;
+//	    bytes customHookMetadata;
+//	    uint256 gasLimit;
+//	    string maxFeeDenom;
+//	    uint256 maxFeeAmount;
+//	}
+//
+// A zero custom hook ID means that no custom hook is used.
+type ABIHyperlaneAttributes struct {
+	TokenID            [32]byte `abi:"tokenId"`
+	DestinationDomain  uint32
+	Recipient          [32]byte
+	CustomHookID       [32]byte `abi:"customHookId"`
+	CustomHookMetadata []byte
+	GasLimit           *big.Int
+	MaxFeeDenom        string
+	MaxFeeAmount       *big.Int
+}
+
+// ABIIBCAttributes is the Go representation of the Solidity struct
+//
+//	struct IBCAttributes {
+//	    string channelId;
+//	    string receiver;
+//	    uint64 timeoutSeconds;
+//	    string refundRecipient;
+//	}
+//
+// A zero timeout means that the default timeout is used.
+type ABIIBCAttributes struct {
+	ChannelID       string `abi:"channelId"`
+	Receiver        string
+	TimeoutSeconds  uint64
+	RefundRecipient string
+}
+
+// ABIInternalAttributes is the Go representation of the Solidity struct
+//
+//	struct InternalAttributes {
+//	    string recipient;
+//	}
+type ABIInternalAttributes struct {
+	Recipient string
+}
+
+var (
+	abiPayloadArgs = mustNewABITupleArgs([]abi.ArgumentMarshaling{
+		{
+			Name: "preActions", Type: "tuple[]", Components: []abi.ArgumentMarshaling{
+				{Name: "id", Type: "uint32"},
+				{Name: "attributes", Type: "bytes"},
+			},
+		},
+		{Name: "protocolId", Type: "uint32"},
+		{Name: "forwardingAttributes", Type: "bytes"},
+		{Name: "passthroughPayload", Type: "bytes"},
+		{Name: "fallbackRecipient", Type: "string"},
+	})
+
+	abiFeeAttributesArgs = mustNewABIArgs("tuple[]", []abi.ArgumentMarshaling{
+		{Name: "recipient", Type: "string"},
+		{Name: "feeType", Type: "uint8"},
+		{Name: "value", Type: "uint256"},
+	})
+
+	abiDeadlineAttributesArgs = mustNewABITupleArgs([]abi.ArgumentMarshaling{
+		{Name: "height", Type: "uint64"},
+		{Name: "timestamp", Type: "uint64"},
+	})
+
+	abiCCTPAttributesArgs = mustNewABITupleArgs([]abi.ArgumentMarshaling{
+		{Name: "destinationDomain", Type: "uint32"},
+		{Name: "mintRecipient", Type: "bytes32"},
+		{Name: "destinationCaller", Type: "bytes32"},
+	})
+
+	abiHyperlaneAttributesArgs = mustNewABITupleArgs([]abi.ArgumentMarshaling{
+		{Name: "tokenId", Type: "bytes32"},
+		{Name: "destinationDomain", Type: "uint32"},
+		{Name: "recipient", Type: "bytes32"},
+		{Name: "customHookId", Type: "bytes32"},
+		{Name: "customHookMetadata", Type: "bytes"},
+		{Name: "gasLimit", Type: "uint256"},
+		{Name: "maxFeeDenom", Type: "string"},
+		{Name: "maxFeeAmount", Type: "uint256"},
+	})
+
+	abiIBCAttributesArgs = mustNewABITupleArgs([]abi.ArgumentMarshaling{
+		{Name: "channelId", Type: "string"},
+		{Name: "receiver", Type: "string"},
+		{Name: "timeoutSeconds", Type: "uint64"},
+		{Name: "refundRecipient", Type: "string"},
+	})
+
+	abiInternalAttributesArgs = mustNewABITupleArgs([]abi.ArgumentMarshaling{
+		{Name: "recipient", Type: "string"},
+	})
+)
+
+func mustNewABITupleArgs(components []abi.ArgumentMarshaling) abi.Arguments {
+	return mustNewABIArgs("tuple", components)
+}
+
+func mustNewABIArgs(typ string, components []abi.ArgumentMarshaling) abi.Arguments {
+	t, err := abi.NewType(typ, "", components)
+	if err != nil {
+		panic(err)
+	}
+
+	return abi.Arguments{{Type: t}}
+}
+
+// EncodeABIPayload returns the ABI encoding of the payload, equivalent to
+// the Solidity abi.encode(payload).
+func EncodeABIPayload(payload ABIPayload) ([]byte, error) {
+	return abiPayloadArgs.Pack(payload)
+}
+
+// EncodeABIFeeAttributes returns the ABI encoding of the fee attributes,
+// equivalent to the Solidity abi.encode(feesInfo).
+func EncodeABIFeeAttributes(feesInfo []ABIFeeInfo) ([]byte, error) {
+	return abiFeeAttributesArgs.Pack(feesInfo)
+}
+
+// EncodeABIDeadlineAttributes returns the ABI encoding of the deadline
+// attributes, equivalent to the Solidity abi.encode(attributes).
+func EncodeABIDeadlineAttributes(attr ABIDeadlineAttributes) ([]byte, error) {
+	return abiDeadlineAttributesArgs.Pack(attr)
+}
+
+// EncodeABICCTPAttributes returns the ABI encoding of the CCTP attributes,
+// equivalent to the Solidity abi.encode(attributes).
+func EncodeABICCTPAttributes(attr ABICCTPAttributes) ([]byte, error) {
+	return abiCCTPAttributesArgs.Pack(attr)
+}
+
+// EncodeABIHyperlaneAttributes returns the ABI encoding of the Hyperlane
+// attributes, equivalent to the Solidity abi.encode(attributes).
+func EncodeABIHyperlaneAttributes(attr ABIHyperlaneAttributes) ([]byte, error) {
+	return abiHyperlaneAttributesArgs.Pack(attr)
+}
+
+// EncodeABIIBCAttributes returns the ABI encoding of the IBC attributes,
+// equivalent to the Solidity abi.encode(attributes).
+func EncodeABIIBCAttributes(attr ABIIBCAttributes) ([]byte, error) {
+	return abiIBCAttributesArgs.Pack(attr)
+}
+
+// EncodeABIInternalAttributes returns the ABI encoding of the internal
+// attributes, equivalent to the Solidity abi.encode(attributes).
+func EncodeABIInternalAttributes(attr ABIInternalAttributes) ([]byte, error) {
+	return abiInternalAttributesArgs.Pack(attr)
+}
+
+var _ types.PayloadParser = &ABIParser{}
+
+// ABIParser is an utility type capable of parsing the
+// Solidity ABI representation of the orbiter payload into
+// the data transfer type.
+type ABIParser struct{}
+
+// NewABIParser returns a reference to an ABIParser instance.
+func NewABIParser() (*ABIParser, error) {
+	return &ABIParser{}, nil
+}
+
+// ParsePayload implements types.PayloadParser.
+func (p *ABIParser) ParsePayload(payloadBz []byte) (*core.Payload, error) {
+	return p.Parse(payloadBz)
+}
+
+// Parse returns the orbiter payload from its ABI encoding or an error.
+func (p *ABIParser) Parse(payloadBz []byte) (*core.Payload, error) {
+	abiPayload, err := unpackABI[ABIPayload](abiPayloadArgs, payloadBz)
+	if err != nil {
+		return nil, core.ErrParsingPayload.Wrapf("invalid ABI payload: %s", err.Error())
+	}
+
+	preActions := make([]*core.Action, len(abiPayload.PreActions))
+	for i, abiAction := range abiPayload.PreActions {
+		action, err := p.parseAction(abiAction)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid ABI action at index %d", i)
+		}
+		preActions[i] = action
+	}
+
+	forwarding, err := p.parseForwarding(
+		core.ProtocolID(abiPayload.ProtocolID),
+		abiPayload.ForwardingAttributes,
+		abiPayload.PassthroughPayload,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid ABI forwarding")
+	}
+
+	if abiPayload.FallbackRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(abiPayload.FallbackRecipient); err != nil {
+			return nil, core.ErrParsingPayload.Wrapf(
+				"invalid ABI fallback recipient: %s",
+				err.Error(),
+			)
+		}
+	}
+
+	return &core.Payload{
+		PreActions:        preActions,
+		Forwarding:        forwarding,
+		FallbackRecipient: abiPayload.FallbackRecipient,
+	}, nil
+}
+
+func (p *ABIParser) parseAction(abiAction ABIAction) (*core.Action, error) {
+	id := core.ActionID(abiAction.ID)
+
+	switch id {
+	case core.ACTION_FEE:
+		abiFeesInfo, err := unpackABI[[]ABIFeeInfo](abiFeeAttributesArgs, abiAction.Attributes)
+		if err != nil {
+			return nil, core.ErrParsingPayload.Wrapf("invalid ABI fee attributes: %s", err.Error())
+		}
+
+		feesInfo := make([]*actiontypes.FeeInfo, len(abiFeesInfo))
+		for i, abiFeeInfo := range abiFeesInfo {
+			feeInfo, err := parseABIFeeInfo(abiFeeInfo)
+			if err != nil {
+				return nil, err
+			}
+			feesInfo[i] = feeInfo
+		}
+
+		attr, err := actiontypes.NewFeeAttributes(feesInfo...)
+		if err != nil {
+			return nil, errorsmod.Wrap(core.ErrInvalidAttributes, err.Error())
+		}
+
+		return core.NewAction(id, attr)
+	case core.ACTION_DEADLINE:
+		abiAttr, err := unpackABI[ABIDeadlineAttributes](
+			abiDeadlineAttributesArgs,
+			abiAction.Attributes,
+		)
+		if err != nil {
+			return nil, core.ErrParsingPayload.Wrapf(
+				"invalid ABI deadline attributes: %s",
+				err.Error(),
+			)
+		}
+
+		var deadlineTime *time.Time
+		if abiAttr.Timestamp > 0 {
+			if abiAttr.Timestamp > stdmath.MaxInt64 {
+				return nil, core.ErrInvalidAttributes.Wrapf(
+					"deadline timestamp %d out of range",
+					abiAttr.Timestamp,
+				)
+			}
+			t := time.Unix(int64(abiAttr.Timestamp), 0).UTC()
+			deadlineTime = &t
+		}
+
+		attr, err := actiontypes.NewDeadlineAttributes(abiAttr.Height, deadlineTime)
+		if err != nil {
+			return nil, errorsmod.Wrap(core.ErrInvalidAttributes, err.Error())
+		}
+
+		return core.NewAction(id, attr)
+	default:
+		return nil, core.ErrIDNotSupported.Wrapf("ABI encoding of action %s", id)
+	}
+}
+
+func parseABIFeeInfo(abiFeeInfo ABIFeeInfo) (*actiontypes.FeeInfo, error) {
+	switch abiFeeInfo.FeeType {
+	case ABIFeeTypeBasisPoints:
+		if !abiFeeInfo.Value.IsUint64() || abiFeeInfo.Value.Uint64() > actiontypes.BPSNormalizer {
+			return nil, core.ErrInvalidAttributes.Wrapf(
+				"fee basis points %s out of range",
+				abiFeeInfo.Value,
+			)
+		}
+		feeType, err := actiontypes.NewFeeBasisPoints(uint32(abiFeeInfo.Value.Uint64()))
+		if err != nil {
+			return nil, errorsmod.Wrap(core.ErrInvalidAttributes, err.Error())
+		}
+
+		return &actiontypes.FeeInfo{Recipient: abiFeeInfo.Recipient, FeeType: feeType}, nil
+	case ABIFeeTypeAmount:
+		feeType, err := actiontypes.NewFeeAmount(abiFeeInfo.Value.String())
+		if err != nil {
+			return nil, errorsmod.Wrap(core.ErrInvalidAttributes, err.Error())
+		}
+
+		return &actiontypes.FeeInfo{Recipient: abiFeeInfo.Recipient, FeeType: feeType}, nil
+	default:
+		return nil, core.ErrInvalidAttributes.Wrapf(
+			"fee type %d is not supported",
+			abiFeeInfo.FeeType,
+		)
+	}
+}
+
+func (p *ABIParser) parseForwarding(
+	protocolID core.ProtocolID,
+	attributesBz []byte,
+	passthroughPayload []byte,
+) (*core.Forwarding, error) {
+	var attr core.ForwardingAttributes
+
+	switch protocolID {
+	case core.PROTOCOL_CCTP:
+		abiAttr, err := unpackABI[ABICCTPAttributes](abiCCTPAttributesArgs, attributesBz)
+		if err != nil {
+			return nil, core.ErrParsingPayload.Wrapf("invalid ABI CCTP attributes: %s", err.Error())
+		}
+
+		cctpAttr, err := forwardingtypes.NewCCTPAttributes(
+			abiAttr.DestinationDomain,
+			abiAttr.MintRecipient[:],
+			nilIfZero(abiAttr.DestinationCaller[:]),
+		)
+		if err != nil {
+			return nil, errorsmod.Wrap(core.ErrInvalidAttributes, err.Error())
+		}
+		attr = cctpAttr
+	case core.PROTOCOL_HYPERLANE:
+		abiAttr, err := unpackABI[ABIHyperlaneAttributes](abiHyperlaneAttributesArgs, attributesBz)
+		if err != nil {
+			return nil, core.ErrParsingPayload.Wrapf(
+				"invalid ABI Hyperlane attributes: %s",
+				err.Error(),
+			)
+		}
+
+		hookMetadata := ""
+		if len(abiAttr.CustomHookMetadata) != 0 {
+			hookMetadata = forwardingtypes.HypHookMetadataPrefix +
+				hex.EncodeToString(abiAttr.CustomHookMetadata)
+		}
+
+		hypAttr, err := forwardingtypes.NewHyperlaneAttributes(
+			abiAttr.TokenID[:],
+			abiAttr.DestinationDomain,
+			abiAttr.Recipient[:],
+			nilIfZero(abiAttr.CustomHookID[:]),
+			hookMetadata,
+			math.NewIntFromBigInt(abiAttr.GasLimit),
+			sdk.Coin{
+				Denom:  abiAttr.MaxFeeDenom,
+				Amount: math.NewIntFromBigInt(abiAttr.MaxFeeAmount),
+			},
+		)
+		if err != nil {
+			return nil, errorsmod.Wrap(core.ErrInvalidAttributes, err.Error())
+		}
+		attr = hypAttr
+	case core.PROTOCOL_IBC:
+		abiAttr, err := unpackABI[ABIIBCAttributes](abiIBCAttributesArgs, attributesBz)
+		if err != nil {
+			return nil, core.ErrParsingPayload.Wrapf("invalid ABI IBC attributes: %s", err.Error())
+		}
+
+		ibcAttr, err := forwardingtypes.NewIBCAttributes(
+			abiAttr.ChannelID,
+			abiAttr.Receiver,
+			abiAttr.TimeoutSeconds,
+			abiAttr.RefundRecipient,
+		)
+		if err != nil {
+			return nil, errorsmod.Wrap(core.ErrInvalidAttributes, err.Error())
+		}
+		attr = ibcAttr
+	case core.PROTOCOL_INTERNAL:
+		abiAttr, err := unpackABI[ABIInternalAttributes](abiInternalAttributesArgs, attributesBz)
+		if err != nil {
+			return nil, core.ErrParsingPayload.Wrapf(
+				"invalid ABI internal attributes: %s",
+				err.Error(),
+			)
+		}
+
+		internalAttr, err := forwardingtypes.NewInternalAttributes(abiAttr.Recipient)
+		if err != nil {
+			return nil, errorsmod.Wrap(core.ErrInvalidAttributes, err.Error())
+		}
+		attr = internalAttr
+	default:
+		return nil, core.ErrIDNotSupported.Wrapf("ABI encoding of protocol %s", protocolID)
+	}
+
+	return core.NewForwarding(protocolID, attr, passthroughPayload)
+}
+
+// unpackABI decodes the ABI encoded bytes into the Go type associated
+// with the single argument of the arguments.
+func unpackABI[T any](args abi.Arguments, bz []byte) (T, error) {
+	// The ABI library copies a single argument into the first field
+	// of a destination struct.
+	var dest struct{ Value T }
+
+	values, err := args.Unpack(bz)
+	if err != nil {
+		return dest.Value, err
+	}
+
+	if err := args.Copy(&dest, values); err != nil {
+		return dest.Value, err
+	}
+
+	return dest.Value, nil
+}
+
+// nilIfZero returns nil if all the bytes are zero, the bytes otherwise.
+func nilIfZero(bz []byte) []byte {
+	if bytes.Equal(bz, make([]byte, len(bz))) {
+		return nil
+	}
+
+	return bz
+}
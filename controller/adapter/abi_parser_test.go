@@ -0,0 +1,403 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	adapterctrl "github.com/noble-assets/orbiter/v2/controller/adapter"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestABIParser_Parse(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	recipient := testutil.NewNobleAddress()
+	mintRecipient := [32]byte(testutil.RandomBytes(32))
+	tokenID := [32]byte(testutil.RandomBytes(32))
+	hypRecipient := [32]byte(testutil.RandomBytes(32))
+
+	cctpAttr, err := adapterctrl.EncodeABICCTPAttributes(adapterctrl.ABICCTPAttributes{
+		DestinationDomain: 0,
+		MintRecipient:     mintRecipient,
+	})
+	require.NoError(t, err)
+
+	feeAttr, err := adapterctrl.EncodeABIFeeAttributes([]adapterctrl.ABIFeeInfo{
+		{Recipient: recipient, FeeType: adapterctrl.ABIFeeTypeBasisPoints, Value: big.NewInt(100)},
+		{Recipient: recipient, FeeType: adapterctrl.ABIFeeTypeAmount, Value: big.NewInt(1_000)},
+	})
+	require.NoError(t, err)
+
+	encode := func(payload adapterctrl.ABIPayload) []byte {
+		bz, err := adapterctrl.EncodeABIPayload(payload)
+		require.NoError(t, err)
+
+		return bz
+	}
+
+	testCases := []struct {
+		name       string
+		payload    func() []byte
+		expPayload func() *core.Payload
+		expErr     string
+	}{
+		{
+			name:    "error - when bytes are not ABI encoded",
+			payload: func() []byte { return []byte(testutil.CreateValidOrbiterPayload()) },
+			expErr:  "invalid ABI payload",
+		},
+		{
+			name: "error - when protocol is not supported",
+			payload: func() []byte {
+				return encode(adapterctrl.ABIPayload{
+					ProtocolID:           uint32(core.PROTOCOL_UNSUPPORTED),
+					ForwardingAttributes: cctpAttr,
+				})
+			},
+			expErr: "ABI encoding of protocol PROTOCOL_UNSUPPORTED",
+		},
+		{
+			name: "error - when forwarding attributes do not match the protocol",
+			payload: func() []byte {
+				return encode(adapterctrl.ABIPayload{
+					ProtocolID:           uint32(core.PROTOCOL_INTERNAL),
+					ForwardingAttributes: []byte{0x01},
+				})
+			},
+			expErr: "invalid ABI internal attributes",
+		},
+		{
+			name: "error - when CCTP attributes are not valid",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABICCTPAttributes(adapterctrl.ABICCTPAttributes{
+					DestinationDomain: forwardingtypes.CCTPNobleDomain,
+					MintRecipient:     mintRecipient,
+				})
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					ProtocolID:           uint32(core.PROTOCOL_CCTP),
+					ForwardingAttributes: attr,
+				})
+			},
+			expErr: "destination domain cannot be Noble",
+		},
+		{
+			name: "error - when internal recipient is not valid",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIInternalAttributes(
+					adapterctrl.ABIInternalAttributes{Recipient: "noble1invalid"},
+				)
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					ProtocolID:           uint32(core.PROTOCOL_INTERNAL),
+					ForwardingAttributes: attr,
+				})
+			},
+			expErr: "invalid recipient address",
+		},
+		{
+			name: "error - when fallback recipient is not valid",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIInternalAttributes(
+					adapterctrl.ABIInternalAttributes{Recipient: recipient},
+				)
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					ProtocolID:           uint32(core.PROTOCOL_INTERNAL),
+					ForwardingAttributes: attr,
+					FallbackRecipient:    "invalid",
+				})
+			},
+			expErr: "invalid ABI fallback recipient",
+		},
+		{
+			name: "error - when action is not supported",
+			payload: func() []byte {
+				return encode(adapterctrl.ABIPayload{
+					PreActions: []adapterctrl.ABIAction{
+						{ID: uint32(core.ACTION_SWAP), Attributes: feeAttr},
+					},
+					ProtocolID:           uint32(core.PROTOCOL_CCTP),
+					ForwardingAttributes: cctpAttr,
+				})
+			},
+			expErr: "ABI encoding of action ACTION_SWAP",
+		},
+		{
+			name: "error - when fee type is not supported",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIFeeAttributes([]adapterctrl.ABIFeeInfo{
+					{Recipient: recipient, FeeType: 2, Value: big.NewInt(1)},
+				})
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					PreActions: []adapterctrl.ABIAction{
+						{ID: uint32(core.ACTION_FEE), Attributes: attr},
+					},
+					ProtocolID:           uint32(core.PROTOCOL_CCTP),
+					ForwardingAttributes: cctpAttr,
+				})
+			},
+			expErr: "fee type 2 is not supported",
+		},
+		{
+			name: "error - when deadline is empty",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIDeadlineAttributes(
+					adapterctrl.ABIDeadlineAttributes{},
+				)
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					PreActions: []adapterctrl.ABIAction{
+						{ID: uint32(core.ACTION_DEADLINE), Attributes: attr},
+					},
+					ProtocolID:           uint32(core.PROTOCOL_CCTP),
+					ForwardingAttributes: cctpAttr,
+				})
+			},
+			expErr: "deadline must specify a block height or a block time",
+		},
+		{
+			name: "error - when fee basis points are out of range",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIFeeAttributes([]adapterctrl.ABIFeeInfo{
+					{
+						Recipient: recipient,
+						FeeType:   adapterctrl.ABIFeeTypeBasisPoints,
+						Value:     new(big.Int).Lsh(big.NewInt(1), 64),
+					},
+				})
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					PreActions: []adapterctrl.ABIAction{
+						{ID: uint32(core.ACTION_FEE), Attributes: attr},
+					},
+					ProtocolID:           uint32(core.PROTOCOL_CCTP),
+					ForwardingAttributes: cctpAttr,
+				})
+			},
+			expErr: "out of range",
+		},
+		{
+			name: "success - CCTP forwarding with fee action",
+			payload: func() []byte {
+				return encode(adapterctrl.ABIPayload{
+					PreActions: []adapterctrl.ABIAction{
+						{ID: uint32(core.ACTION_FEE), Attributes: feeAttr},
+					},
+					ProtocolID:           uint32(core.PROTOCOL_CCTP),
+					ForwardingAttributes: cctpAttr,
+					PassthroughPayload:   []byte("passthrough"),
+				})
+			},
+			expPayload: func() *core.Payload {
+				bps, err := actiontypes.NewFeeBasisPoints(100)
+				require.NoError(t, err)
+				amount, err := actiontypes.NewFeeAmount("1000")
+				require.NoError(t, err)
+				action, err := actiontypes.NewFeeAction(
+					&actiontypes.FeeInfo{Recipient: recipient, FeeType: bps},
+					&actiontypes.FeeInfo{Recipient: recipient, FeeType: amount},
+				)
+				require.NoError(t, err)
+				forwarding, err := forwardingtypes.NewCCTPForwarding(
+					0, mintRecipient[:], nil, []byte("passthrough"),
+				)
+				require.NoError(t, err)
+
+				return &core.Payload{PreActions: []*core.Action{action}, Forwarding: forwarding}
+			},
+		},
+		{
+			name: "success - CCTP forwarding with deadline action",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIDeadlineAttributes(
+					adapterctrl.ABIDeadlineAttributes{Height: 100, Timestamp: 1_700_000_000},
+				)
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					PreActions: []adapterctrl.ABIAction{
+						{ID: uint32(core.ACTION_DEADLINE), Attributes: attr},
+					},
+					ProtocolID:           uint32(core.PROTOCOL_CCTP),
+					ForwardingAttributes: cctpAttr,
+				})
+			},
+			expPayload: func() *core.Payload {
+				deadline := time.Unix(1_700_000_000, 0).UTC()
+				attr, err := actiontypes.NewDeadlineAttributes(100, &deadline)
+				require.NoError(t, err)
+				action, err := actiontypes.NewDeadlineAction(attr)
+				require.NoError(t, err)
+				forwarding, err := forwardingtypes.NewCCTPForwarding(
+					0, mintRecipient[:], nil, []byte{},
+				)
+				require.NoError(t, err)
+
+				return &core.Payload{PreActions: []*core.Action{action}, Forwarding: forwarding}
+			},
+		},
+		{
+			name: "success - Hyperlane forwarding",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIHyperlaneAttributes(
+					adapterctrl.ABIHyperlaneAttributes{
+						TokenID:            tokenID,
+						DestinationDomain:  1,
+						Recipient:          hypRecipient,
+						CustomHookMetadata: []byte{0xca, 0xfe},
+						GasLimit:           big.NewInt(200_000),
+						MaxFeeDenom:        "uusdc",
+						MaxFeeAmount:       big.NewInt(10),
+					},
+				)
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					ProtocolID:           uint32(core.PROTOCOL_HYPERLANE),
+					ForwardingAttributes: attr,
+				})
+			},
+			expPayload: func() *core.Payload {
+				attr, err := forwardingtypes.NewHyperlaneAttributes(
+					tokenID[:],
+					1,
+					hypRecipient[:],
+					nil,
+					"0xcafe",
+					math.NewInt(200_000),
+					sdk.NewInt64Coin("uusdc", 10),
+				)
+				require.NoError(t, err)
+				forwarding, err := core.NewForwarding(core.PROTOCOL_HYPERLANE, attr, []byte{})
+				require.NoError(t, err)
+
+				return &core.Payload{PreActions: []*core.Action{}, Forwarding: forwarding}
+			},
+		},
+		{
+			name: "success - IBC forwarding",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIIBCAttributes(adapterctrl.ABIIBCAttributes{
+					ChannelID:       "channel-1",
+					Receiver:        "cosmos1receiver",
+					RefundRecipient: recipient,
+				})
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					ProtocolID:           uint32(core.PROTOCOL_IBC),
+					ForwardingAttributes: attr,
+				})
+			},
+			expPayload: func() *core.Payload {
+				forwarding, err := forwardingtypes.NewIBCForwarding(
+					"channel-1",
+					"cosmos1receiver",
+					0,
+					recipient,
+					[]byte{},
+				)
+				require.NoError(t, err)
+
+				return &core.Payload{PreActions: []*core.Action{}, Forwarding: forwarding}
+			},
+		},
+		{
+			name: "success - internal forwarding",
+			payload: func() []byte {
+				attr, err := adapterctrl.EncodeABIInternalAttributes(
+					adapterctrl.ABIInternalAttributes{Recipient: recipient},
+				)
+				require.NoError(t, err)
+
+				return encode(adapterctrl.ABIPayload{
+					ProtocolID:           uint32(core.PROTOCOL_INTERNAL),
+					ForwardingAttributes: attr,
+				})
+			},
+			expPayload: func() *core.Payload {
+				forwarding, err := forwardingtypes.NewInternalForwarding(recipient)
+				require.NoError(t, err)
+
+				return &core.Payload{PreActions: []*core.Action{}, Forwarding: forwarding}
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			parser, err := adapterctrl.NewABIParser()
+			require.NoError(t, err)
+
+			payload, err := parser.Parse(tC.payload())
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, payload)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, payload.Validate())
+
+				expPayload := tC.expPayload()
+				require.Equal(t, expPayload.Forwarding.ProtocolId, payload.Forwarding.ProtocolId)
+				require.Equal(
+					t,
+					expPayload.Forwarding.PassthroughPayload,
+					payload.Forwarding.PassthroughPayload,
+				)
+				expAttr, err := expPayload.Forwarding.CachedAttributes()
+				require.NoError(t, err)
+				attr, err := payload.Forwarding.CachedAttributes()
+				require.NoError(t, err)
+				require.Equal(t, expAttr, attr)
+
+				require.Len(t, payload.PreActions, len(expPayload.PreActions))
+				for idx, action := range expPayload.PreActions {
+					require.Equal(t, action.Id, payload.PreActions[idx].Id)
+					expAttr, err := action.CachedAttributes()
+					require.NoError(t, err)
+					attr, err := payload.PreActions[idx].CachedAttributes()
+					require.NoError(t, err)
+					require.Equal(t, expAttr, attr)
+				}
+			}
+		})
+	}
+}
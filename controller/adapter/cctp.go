@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/controller"
+	"github.com/noble-assets/orbiter/v2/types"
+	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// CCTPTransferNonceLen is the length in bytes of the transfer nonce
+// prepended to the orbiter payload in the CCTP payload message body.
+const CCTPTransferNonceLen = forwardingtypes.CCTPTransferNonceLen
+
+var _ types.AdapterController = &CCTPAdapter{}
+
+// CCTPAdapter is the type component in charge of adapting a CCTP
+// transfer, and the associated general message containing the orbiter
+// payload, to the common payload type handled by the module.
+type CCTPAdapter struct {
+	*controller.BaseController[core.ProtocolID]
+
+	logger log.Logger
+	parser *CCTPParser
+}
+
+// NewCCTPAdapter returns a reference to a new CCTPAdapter instance
+// parsing the orbiter payloads with the given encoding.
+func NewCCTPAdapter(
+	cdc codec.Codec,
+	logger log.Logger,
+	encoding PayloadEncoding,
+) (*CCTPAdapter, error) {
+	if logger == nil {
+		return nil, core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+
+	id := core.PROTOCOL_CCTP
+	baseController, err := controller.NewBase(id)
+	if err != nil {
+		return nil, err
+	}
+
+	parser, err := NewCCTPParser(cdc, encoding)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error during instantiation of CCTP adapter")
+	}
+
+	return &CCTPAdapter{
+		logger:         logger.With(core.AdapterControllerName, baseController.Name()),
+		BaseController: baseController,
+		parser:         parser,
+	}, nil
+}
+
+// ParsePacket parses the transfer message and the payload message of a CCTP
+// cross-chain packet. The two messages are linked via the transfer nonce
+// contained in the payload message body, which must be equal to the nonce of
+// the transfer message.
+func (a *CCTPAdapter) ParsePacket(
+	ccPacket adaptertypes.CrossChainPacket,
+) (*types.ParsedData, error) {
+	cctpPacket, ok := ccPacket.(*adaptertypes.CCTPCrossChainPacket)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf(
+			"expected %T, got %T",
+			&adaptertypes.CCTPCrossChainPacket{},
+			ccPacket,
+		)
+	}
+
+	transferMsg, err := new(cctptypes.Message).Parse(cctpPacket.Packet())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid transfer message")
+	}
+
+	if !bytes.Equal(transferMsg.Recipient, cctptypes.PaddedModuleAddress) {
+		return nil, core.ErrNoOrbiterPacket.Wrap("transfer message is not a burn message")
+	}
+
+	burnMsg, err := new(cctptypes.BurnMessage).Parse(transferMsg.MessageBody)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid burn message")
+	}
+
+	if !bytes.Equal(burnMsg.MintRecipient, core.PaddedModuleAddress) {
+		return nil, core.ErrNoOrbiterPacket.Wrap("mint recipient is not Orbiter module")
+	}
+
+	if !burnMsg.Amount.IsPositive() {
+		return nil, core.ErrValidation.Wrapf("invalid burn amount: %s", burnMsg.Amount)
+	}
+
+	payloadMsg, err := new(cctptypes.Message).Parse(cctpPacket.PayloadMessage())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid payload message")
+	}
+
+	if err := validateCCTPMessagesPairing(transferMsg, burnMsg, payloadMsg); err != nil {
+		return nil, err
+	}
+
+	transferNonce, payloadBz, err := ParseCCTPPayloadMessageBody(payloadMsg.MessageBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if transferNonce != transferMsg.Nonce {
+		return nil, core.ErrValidation.Wrapf(
+			"payload message refers to transfer nonce %d, got %d",
+			transferNonce,
+			transferMsg.Nonce,
+		)
+	}
+
+	payload, err := a.parser.ParsePayload(payloadBz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ParsedData{
+		Coin:    sdk.NewCoin(cctpPacket.MintDenom(), burnMsg.Amount),
+		Payload: *payload,
+	}, nil
+}
+
+// validateCCTPMessagesPairing returns an error if the payload message
+// has not been sent from the same source as the transfer message or it is
+// not intended for the Orbiter module.
+func validateCCTPMessagesPairing(
+	transferMsg *cctptypes.Message,
+	burnMsg *cctptypes.BurnMessage,
+	payloadMsg *cctptypes.Message,
+) error {
+	if payloadMsg.SourceDomain != transferMsg.SourceDomain {
+		return core.ErrValidation.Wrapf(
+			"payload message source domain %d is different from transfer message source domain %d",
+			payloadMsg.SourceDomain,
+			transferMsg.SourceDomain,
+		)
+	}
+
+	if !bytes.Equal(payloadMsg.Sender, burnMsg.MessageSender) {
+		return core.ErrValidation.Wrap(
+			"payload message sender is different from burn message sender",
+		)
+	}
+
+	if !bytes.Equal(payloadMsg.Recipient, core.PaddedModuleAddress) {
+		return core.ErrValidation.Wrap("payload message recipient is not Orbiter module")
+	}
+
+	return nil
+}
+
+// ParseCCTPPayloadMessageBody splits the body of a CCTP payload message
+// into the nonce of the associated transfer message and the orbiter payload.
+// The body is expected to be abi.encodePacked(transferNonce, orbiterPayload).
+func ParseCCTPPayloadMessageBody(body []byte) (uint64, []byte, error) {
+	if len(body) <= CCTPTransferNonceLen {
+		return 0, nil, core.ErrParsingPayload.Wrapf(
+			"payload message body must be longer than %d bytes, got %d",
+			CCTPTransferNonceLen,
+			len(body),
+		)
+	}
+
+	transferNonce := binary.BigEndian.Uint64(body[:CCTPTransferNonceLen])
+
+	return transferNonce, body[CCTPTransferNonceLen:], nil
+}
+
+var _ types.PayloadParser = &CCTPParser{}
+
+// CCTPParser parses the orbiter payload contained in a CCTP message.
+//
+// Since the ABI layout does not cover every payload feature, the binary
+// envelope is always accepted next to the configured encoding, and it is
+// recognized by its magic prefix.
+type CCTPParser struct {
+	encoding       PayloadEncoding
+	parser         types.PayloadParser
+	envelopeParser *BinaryParser
+}
+
+// NewCCTPParser returns a new instance of a CCTP parser decoding
+// payloads with the given encoding.
+func NewCCTPParser(cdc codec.Codec, encoding PayloadEncoding) (*CCTPParser, error) {
+	if cdc == nil {
+		return nil, core.ErrNilPointer.Wrap("codec cannot be nil")
+	}
+
+	parser, err := NewPayloadParser(cdc, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	envelopeParser, err := NewBinaryParser(cdc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CCTPParser{
+		encoding:       encoding,
+		parser:         parser,
+		envelopeParser: envelopeParser,
+	}, nil
+}
+
+// Encoding returns the payload encoding handled by the parser.
+func (p *CCTPParser) Encoding() PayloadEncoding {
+	return p.encoding
+}
+
+// ParsePayload parses the orbiter payload sent via the CCTP general message
+// passing. Payloads starting with the binary envelope magic prefix are
+// parsed as a binary envelope regardless of the configured encoding.
+func (p *CCTPParser) ParsePayload(payloadBz []byte) (*core.Payload, error) {
+	parser := p.parser
+	if core.IsPayloadEnvelope(payloadBz) {
+		parser = p.envelopeParser
+	}
+
+	payload, err := parser.ParsePayload(payloadBz)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := payload.Validate(); err != nil {
+		return payload, err
+	}
+
+	return payload, nil
+}
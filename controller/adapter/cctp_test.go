@@ -0,0 +1,343 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	adapterctrl "github.com/noble-assets/orbiter/v2/controller/adapter"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/testdata"
+	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestCCTPParsePacket(t *testing.T) {
+	gateway := testutil.RandomBytes(32)
+	amount := sdkmath.NewInt(1_000_000)
+
+	testCases := []struct {
+		name            string
+		transferMessage func() []byte
+		payloadMessage  func() []byte
+		expErr          string
+	}{
+		{
+			name: "error - transfer message is not a burn message",
+			transferMessage: func() []byte {
+				return newCCTPPayloadMessage(t, 0, 1, gateway, 1, testutil.CreateValidOrbiterPayload())
+			},
+			payloadMessage: func() []byte {
+				return newCCTPPayloadMessage(t, 0, 2, gateway, 1, testutil.CreateValidOrbiterPayload())
+			},
+			expErr: "not a burn message",
+		},
+		{
+			name: "error - mint recipient is not the orbiter module",
+			transferMessage: func() []byte {
+				return newCCTPTransferMessage(t, 0, 1, testutil.RandomBytes(32), gateway, amount)
+			},
+			payloadMessage: func() []byte {
+				return newCCTPPayloadMessage(t, 0, 2, gateway, 1, testutil.CreateValidOrbiterPayload())
+			},
+			expErr: "mint recipient is not Orbiter module",
+		},
+		{
+			name: "error - different source domains",
+			transferMessage: func() []byte {
+				return newCCTPTransferMessage(t, 0, 1, core.PaddedModuleAddress, gateway, amount)
+			},
+			payloadMessage: func() []byte {
+				return newCCTPPayloadMessage(t, 1, 2, gateway, 1, testutil.CreateValidOrbiterPayload())
+			},
+			expErr: "source domain",
+		},
+		{
+			name: "error - different senders",
+			transferMessage: func() []byte {
+				return newCCTPTransferMessage(t, 0, 1, core.PaddedModuleAddress, gateway, amount)
+			},
+			payloadMessage: func() []byte {
+				return newCCTPPayloadMessage(
+					t, 0, 2, testutil.RandomBytes(32), 1, testutil.CreateValidOrbiterPayload(),
+				)
+			},
+			expErr: "sender is different",
+		},
+		{
+			name: "error - transfer nonce does not match",
+			transferMessage: func() []byte {
+				return newCCTPTransferMessage(t, 0, 1, core.PaddedModuleAddress, gateway, amount)
+			},
+			payloadMessage: func() []byte {
+				return newCCTPPayloadMessage(t, 0, 2, gateway, 3, testutil.CreateValidOrbiterPayload())
+			},
+			expErr: "refers to transfer nonce 3",
+		},
+		{
+			name: "error - payload message body without payload",
+			transferMessage: func() []byte {
+				return newCCTPTransferMessage(t, 0, 1, core.PaddedModuleAddress, gateway, amount)
+			},
+			payloadMessage: func() []byte {
+				return newCCTPPayloadMessage(t, 0, 2, gateway, 1, "")
+			},
+			expErr: "must be longer than 8 bytes",
+		},
+		{
+			name: "error - invalid payload",
+			transferMessage: func() []byte {
+				return newCCTPTransferMessage(t, 0, 1, core.PaddedModuleAddress, gateway, amount)
+			},
+			payloadMessage: func() []byte {
+				return newCCTPPayloadMessage(t, 0, 2, gateway, 1, "not json payload")
+			},
+			expErr: "not a valid json",
+		},
+		{
+			name: "success - valid transfer and payload messages",
+			transferMessage: func() []byte {
+				return newCCTPTransferMessage(t, 0, 1, core.PaddedModuleAddress, gateway, amount)
+			},
+			payloadMessage: func() []byte {
+				return newCCTPPayloadMessage(t, 0, 2, gateway, 1, testutil.CreateValidOrbiterPayload())
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			encCfg := testutil.MakeTestEncodingConfig("noble")
+			encCfg.InterfaceRegistry.RegisterImplementations(
+				(*core.ForwardingAttributes)(nil),
+				&testdata.TestForwardingAttr{},
+			)
+
+			adapter, err := adapterctrl.NewCCTPAdapter(
+				encCfg.Codec,
+				log.NewNopLogger(),
+				adapterctrl.PayloadEncodingJSON,
+			)
+			require.NoError(t, err)
+
+			ccPacket, err := adaptertypes.NewCCTPCrossChainPacket(
+				"uusdc",
+				tC.transferMessage(),
+				tC.payloadMessage(),
+			)
+			require.NoError(t, err)
+
+			parsedData, err := adapter.ParsePacket(ccPacket)
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, parsedData)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, parsedData)
+				require.Equal(t, "uusdc", parsedData.Coin.Denom)
+				require.Equal(t, amount, parsedData.Coin.Amount)
+				require.Equal(t, core.PROTOCOL_CCTP, parsedData.Payload.Forwarding.ProtocolId)
+				require.Equal(
+					t,
+					"/testpb.TestForwardingAttr",
+					parsedData.Payload.Forwarding.Attributes.TypeUrl,
+				)
+			}
+		})
+	}
+}
+
+func TestCCTPParsePacketInvalidType(t *testing.T) {
+	encCfg := testutil.MakeTestEncodingConfig("noble")
+	adapter, err := adapterctrl.NewCCTPAdapter(
+		encCfg.Codec,
+		log.NewNopLogger(),
+		adapterctrl.PayloadEncodingJSON,
+	)
+	require.NoError(t, err)
+
+	ccPacket, err := adaptertypes.NewIBCCrossChainPacket(
+		"transfer",
+		"channel-1",
+		"transfer",
+		"channel-0",
+		[]byte{},
+	)
+	require.NoError(t, err)
+
+	parsedData, err := adapter.ParsePacket(ccPacket)
+	require.ErrorContains(t, err, "expected")
+	require.Nil(t, parsedData)
+}
+
+func TestCCTPParserParsePayload(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	recipient := testutil.NewNobleAddress()
+	_, envelope := testutil.CreatePayloadEnvelope(t)
+
+	internalAttr, err := adapterctrl.EncodeABIInternalAttributes(
+		adapterctrl.ABIInternalAttributes{Recipient: recipient},
+	)
+	require.NoError(t, err)
+	abiPayload, err := adapterctrl.EncodeABIPayload(adapterctrl.ABIPayload{
+		ProtocolID:           uint32(core.PROTOCOL_INTERNAL),
+		ForwardingAttributes: internalAttr,
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		payload     []byte
+		expProtocol core.ProtocolID
+		expErr      string
+	}{
+		{
+			name:    "error - JSON payload is not accepted",
+			payload: []byte(testutil.CreateValidOrbiterPayload()),
+			expErr:  "invalid ABI payload",
+		},
+		{
+			name:    "error - envelope with unsupported version",
+			payload: append(append([]byte{}, core.PayloadEnvelopeMagic...), 100),
+			expErr:  "payload envelope version 100 is not supported",
+		},
+		{
+			name:        "success - ABI payload",
+			payload:     abiPayload,
+			expProtocol: core.PROTOCOL_INTERNAL,
+		},
+		{
+			name:        "success - binary envelope",
+			payload:     envelope,
+			expProtocol: core.PROTOCOL_IBC,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			encCfg := testutil.MakeTestEncodingConfig("noble")
+			encCfg.InterfaceRegistry.RegisterImplementations(
+				(*core.ForwardingAttributes)(nil),
+				&testdata.TestForwardingAttr{},
+			)
+
+			parser, err := adapterctrl.NewCCTPParser(encCfg.Codec, adapterctrl.PayloadEncodingABI)
+			require.NoError(t, err)
+
+			payload, err := parser.ParsePayload(tC.payload)
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tC.expProtocol, payload.Forwarding.ProtocolId)
+			}
+		})
+	}
+}
+
+func TestParseCCTPPayloadMessageBody(t *testing.T) {
+	body := binary.BigEndian.AppendUint64(nil, 42)
+	body = append(body, []byte("payload")...)
+
+	nonce, payload, err := adapterctrl.ParseCCTPPayloadMessageBody(body)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), nonce)
+	require.Equal(t, []byte("payload"), payload)
+
+	_, _, err = adapterctrl.ParseCCTPPayloadMessageBody(body[:8])
+	require.ErrorIs(t, err, core.ErrParsingPayload)
+}
+
+// newCCTPTransferMessage returns a CCTP message containing a burn message,
+// as created by the token messenger on the source domain.
+func newCCTPTransferMessage(
+	t *testing.T,
+	sourceDomain uint32,
+	nonce uint64,
+	mintRecipient, messageSender []byte,
+	amount sdkmath.Int,
+) []byte {
+	t.Helper()
+
+	burnMsg := cctptypes.BurnMessage{
+		BurnToken:     testutil.RandomBytes(32),
+		MintRecipient: mintRecipient,
+		Amount:        amount,
+		MessageSender: messageSender,
+	}
+	burnMsgBz, err := burnMsg.Bytes()
+	require.NoError(t, err)
+
+	msg := cctptypes.Message{
+		SourceDomain:      sourceDomain,
+		DestinationDomain: cctptypes.NobleDomainId,
+		Nonce:             nonce,
+		Sender:            testutil.RandomBytes(32),
+		Recipient:         cctptypes.PaddedModuleAddress,
+		DestinationCaller: testutil.RandomBytes(32),
+		MessageBody:       burnMsgBz,
+	}
+	msgBz, err := msg.Bytes()
+	require.NoError(t, err)
+
+	return msgBz
+}
+
+// newCCTPPayloadMessage returns a CCTP message containing the orbiter
+// payload, as created by the OrbiterGatewayCCTP contract.
+func newCCTPPayloadMessage(
+	t *testing.T,
+	sourceDomain uint32,
+	nonce uint64,
+	sender []byte,
+	transferNonce uint64,
+	payload string,
+) []byte {
+	t.Helper()
+
+	body := binary.BigEndian.AppendUint64(nil, transferNonce)
+	body = append(body, []byte(payload)...)
+
+	msg := cctptypes.Message{
+		SourceDomain:      sourceDomain,
+		DestinationDomain: cctptypes.NobleDomainId,
+		Nonce:             nonce,
+		Sender:            sender,
+		Recipient:         core.PaddedModuleAddress,
+		DestinationCaller: testutil.RandomBytes(32),
+		MessageBody:       body,
+	}
+	msgBz, err := msg.Bytes()
+	require.NoError(t, err)
+
+	return msgBz
+}
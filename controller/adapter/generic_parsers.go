@@ -22,6 +22,8 @@ package adapter
 
 import (
 	"encoding/json"
+	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
@@ -29,6 +31,52 @@ import (
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
+// PayloadEncoding identifies the encoding used to represent the orbiter
+// payload in the bytes received from a bridge protocol.
+type PayloadEncoding uint8
+
+const (
+	// PayloadEncodingJSON is the JSON representation of the payload wrapper.
+	PayloadEncodingJSON PayloadEncoding = iota
+	// PayloadEncodingBinary is the versioned binary envelope of the payload
+	// wrapper.
+	PayloadEncodingBinary
+	// PayloadEncodingABI is the Solidity ABI representation of the payload.
+	PayloadEncodingABI
+)
+
+// String implements fmt.Stringer.
+func (e PayloadEncoding) String() string {
+	switch e {
+	case PayloadEncodingJSON:
+		return "json"
+	case PayloadEncodingBinary:
+		return "binary"
+	case PayloadEncodingABI:
+		return "abi"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(e))
+	}
+}
+
+// NewPayloadParser returns the parser associated with the payload encoding.
+// The returned parser only decodes the payload, leaving its validation to
+// the caller.
+func NewPayloadParser(cdc codec.Codec, encoding PayloadEncoding) (types.PayloadParser, error) {
+	switch encoding {
+	case PayloadEncodingJSON:
+		return NewJSONParser(cdc)
+	case PayloadEncodingBinary:
+		return NewBinaryParser(cdc)
+	case PayloadEncodingABI:
+		return NewABIParser()
+	default:
+		return nil, core.ErrParsingPayload.Wrapf("payload encoding %s is not supported", encoding)
+	}
+}
+
+var _ types.PayloadParser = &JSONParser{}
+
 // JSONParser is an utility type capable of parsing
 // a JSON representation of the orbiter payload into
 // the data transfer type.
@@ -47,6 +95,11 @@ func NewJSONParser(cdc codec.Codec) (*JSONParser, error) {
 	}, nil
 }
 
+// ParsePayload implements types.PayloadParser.
+func (p *JSONParser) ParsePayload(payloadBz []byte) (*core.Payload, error) {
+	return p.Parse(string(payloadBz))
+}
+
 // Parse returns the orbiter payload from a JSON formatted
 // string or an error.
 func (p *JSONParser) Parse(jsonString string) (*core.Payload, error) {
@@ -81,3 +134,93 @@ func (p *JSONParser) Parse(jsonString string) (*core.Payload, error) {
 
 	return pw.Orbiter, nil
 }
+
+// ParseOrbiterKey returns the orbiter payload contained in the orbiter
+// root level key of a JSON formatted string, along with the other root
+// level keys. Differently from Parse, other keys are not rejected and are
+// left untouched for other consumers of the JSON string.
+func (p *JSONParser) ParseOrbiterKey(jsonString string) (*core.Payload, []string, error) {
+	var jsonData map[string]json.RawMessage
+	err := json.Unmarshal([]byte(jsonString), &jsonData)
+	if err != nil {
+		return nil, nil, core.ErrParsingPayload.Wrapf("not a valid json string: %s", err.Error())
+	}
+
+	orbiterData, found := jsonData[core.OrbiterPrefix]
+	if !found || string(orbiterData) == "null" {
+		return nil, nil, core.ErrParsingPayload.Wrapf(
+			"json does not contain orbiter prefix: %s",
+			core.OrbiterPrefix,
+		)
+	}
+
+	var coexistingKeys []string
+	for key := range jsonData {
+		if key != core.OrbiterPrefix {
+			coexistingKeys = append(coexistingKeys, key)
+		}
+	}
+	sort.Strings(coexistingKeys)
+
+	payload := core.Payload{}
+	err = types.UnmarshalJSON(p.cdc, orbiterData, &payload)
+	if err != nil {
+		return nil, nil, core.ErrParsingPayload.Wrapf(
+			"failed to cast json string into Payload: %s",
+			err.Error(),
+		)
+	}
+
+	return &payload, coexistingKeys, nil
+}
+
+var _ types.PayloadParser = &BinaryParser{}
+
+// BinaryParser is an utility type capable of parsing the
+// versioned binary envelope of the orbiter payload into
+// the data transfer type.
+type BinaryParser struct {
+	cdc codec.Codec
+}
+
+// NewBinaryParser returns a reference to a BinaryParser instance.
+func NewBinaryParser(cdc codec.Codec) (*BinaryParser, error) {
+	if cdc == nil {
+		return nil, core.ErrNilPointer.Wrap("codec cannot be nil for binary parser")
+	}
+
+	return &BinaryParser{
+		cdc: cdc,
+	}, nil
+}
+
+// ParsePayload implements types.PayloadParser.
+func (p *BinaryParser) ParsePayload(payloadBz []byte) (*core.Payload, error) {
+	return p.Parse(payloadBz)
+}
+
+// Parse returns the orbiter payload from a binary envelope or an error.
+func (p *BinaryParser) Parse(envelope []byte) (*core.Payload, error) {
+	version, body, err := core.ParsePayloadEnvelope(envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case core.PayloadEnvelopeV1:
+		pw := core.PayloadWrapper{}
+		if err := p.cdc.Unmarshal(body, &pw); err != nil {
+			return nil, core.ErrParsingPayload.Wrapf(
+				"failed to cast bytes into Payload: %s",
+				err.Error(),
+			)
+		}
+
+		return pw.Orbiter, nil
+	default:
+		return nil, core.ErrParsingPayload.Wrapf(
+			"payload envelope version %d is not supported",
+			version,
+		)
+	}
+}
@@ -22,6 +22,7 @@ package adapter_test
 
 import (
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -187,3 +188,182 @@ func TestJSONParser_Parse(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONParser_ParseOrbiterKey(t *testing.T) {
+	testCases := []struct {
+		name              string
+		orbiterPayload    string
+		expCoexistingKeys []string
+		expErr            string
+	}{
+		{
+			name:           "error - when string is not valid JSON",
+			orbiterPayload: "invalid json string",
+			expErr:         "not a valid json",
+		},
+		{
+			name:           "error - when string does not contain orbiter prefix",
+			orbiterPayload: `{"other_field": "value"}`,
+			expErr:         "json does not contain orbiter prefix",
+		},
+		{
+			name:           "error - when orbiter prefix exists but is null",
+			orbiterPayload: fmt.Sprintf(`{"%s": null, "wasm": {}}`, core.OrbiterPrefix),
+			expErr:         "json does not contain orbiter prefix",
+		},
+		{
+			name:           "error - when orbiter prefix is not a map",
+			orbiterPayload: fmt.Sprintf(`{"%s": "string_value", "wasm": {}}`, core.OrbiterPrefix),
+			expErr:         "failed to cast json string into Payload",
+		},
+		{
+			name:           "success - only orbiter key",
+			orbiterPayload: testutil.CreateValidOrbiterPayload(),
+		},
+		{
+			name: "success - orbiter key with coexisting keys",
+			orbiterPayload: fmt.Sprintf(
+				`{"wasm": {"contract": "noble1"}, "%s": %s, "forward": {"receiver": "osmo1"}}`,
+				core.OrbiterPrefix,
+				`{"forwarding": {"protocol_id": 2, "attributes": `+
+					`{"@type": "/testpb.TestForwardingAttr", "planet": "earth"}}}`,
+			),
+			expCoexistingKeys: []string{"forward", "wasm"},
+		},
+	}
+
+	for _, tC := range testCases {
+		encCfg := testutil.MakeTestEncodingConfig("noble")
+		encCfg.InterfaceRegistry.RegisterImplementations(
+			(*core.ForwardingAttributes)(nil),
+			&testdata.TestForwardingAttr{},
+		)
+
+		parser, err := adapterctrl.NewJSONParser(encCfg.Codec)
+		require.NoError(t, err)
+
+		t.Run(tC.name, func(t *testing.T) {
+			payload, coexistingKeys, err := parser.ParseOrbiterKey(tC.orbiterPayload)
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, payload)
+				require.Nil(t, coexistingKeys)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, payload)
+				require.Equal(t, core.PROTOCOL_CCTP, payload.Forwarding.ProtocolId)
+				require.Equal(t, tC.expCoexistingKeys, coexistingKeys)
+			}
+		})
+	}
+}
+
+func TestBinaryParser_Parse(t *testing.T) {
+	validPayload, validEnvelope := testutil.CreatePayloadEnvelope(t)
+
+	testCases := []struct {
+		name       string
+		envelope   func() []byte
+		expPayload *core.Payload
+		expErr     string
+	}{
+		{
+			name:     "error - when bytes are empty",
+			envelope: func() []byte { return nil },
+			expErr:   "magic prefix not found",
+		},
+		{
+			name: "error - when bytes are a JSON payload",
+			envelope: func() []byte {
+				return []byte(testutil.CreateValidOrbiterPayload())
+			},
+			expErr: "magic prefix not found",
+		},
+		{
+			name:     "error - when version is missing",
+			envelope: func() []byte { return core.PayloadEnvelopeMagic },
+			expErr:   "version not found",
+		},
+		{
+			name: "error - when version is not supported",
+			envelope: func() []byte {
+				bz := slices.Clone(validEnvelope)
+				bz[len(core.PayloadEnvelopeMagic)] = 2
+
+				return bz
+			},
+			expErr: "version 2 is not supported",
+		},
+		{
+			name: "error - when body is not a valid proto",
+			envelope: func() []byte {
+				bz := slices.Clone(core.PayloadEnvelopeMagic)
+
+				return append(bz, core.PayloadEnvelopeV1, 0xff, 0xff)
+			},
+			expErr: "failed to cast bytes into Payload",
+		},
+		{
+			name: "error - when body is empty",
+			envelope: func() []byte {
+				bz := slices.Clone(core.PayloadEnvelopeMagic)
+
+				return append(bz, core.PayloadEnvelopeV1)
+			},
+			expErr: "payload is not set",
+		},
+		{
+			name:       "success - valid envelope",
+			envelope:   func() []byte { return validEnvelope },
+			expPayload: validPayload,
+		},
+	}
+
+	for _, tC := range testCases {
+		encCfg := testutil.MakeTestEncodingConfig("noble")
+		encCfg.InterfaceRegistry.RegisterImplementations(
+			(*core.ForwardingAttributes)(nil),
+			&testdata.TestForwardingAttr{},
+		)
+
+		parser, err := adapterctrl.NewBinaryParser(encCfg.Codec)
+		require.NoError(t, err)
+
+		t.Run(tC.name, func(t *testing.T) {
+			payload, err := parser.Parse(tC.envelope())
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, payload)
+			} else {
+				require.NoError(t, err)
+				expAttr, err := tC.expPayload.Forwarding.CachedAttributes()
+				require.NoError(t, err)
+				attr, err := payload.Forwarding.CachedAttributes()
+				require.NoError(t, err)
+				require.Equal(t, expAttr, attr)
+				require.Equal(
+					t,
+					tC.expPayload.Forwarding.PassthroughPayload,
+					payload.Forwarding.PassthroughPayload,
+				)
+			}
+		})
+	}
+}
+
+func TestNewPayloadParser(t *testing.T) {
+	encCfg := testutil.MakeTestEncodingConfig("noble")
+
+	parser, err := adapterctrl.NewPayloadParser(encCfg.Codec, adapterctrl.PayloadEncodingJSON)
+	require.NoError(t, err)
+	require.IsType(t, &adapterctrl.JSONParser{}, parser)
+
+	parser, err = adapterctrl.NewPayloadParser(encCfg.Codec, adapterctrl.PayloadEncodingBinary)
+	require.NoError(t, err)
+	require.IsType(t, &adapterctrl.BinaryParser{}, parser)
+
+	_, err = adapterctrl.NewPayloadParser(encCfg.Codec, adapterctrl.PayloadEncoding(100))
+	require.ErrorContains(t, err, "payload encoding unknown(100) is not supported")
+}
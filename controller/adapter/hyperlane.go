@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter
+
+import (
+	"bytes"
+
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/controller"
+	"github.com/noble-assets/orbiter/v2/types"
+	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
+)
+
+// HyperlaneMessageIDLen is the length in bytes of the transfer message ID
+// prepended to the orbiter payload in the Hyperlane payload message body.
+const HyperlaneMessageIDLen = hyperlaneutil.HEX_ADDRESS_LENGTH
+
+var _ types.AdapterController = &HyperlaneAdapter{}
+
+// HyperlaneAdapter is the type component in charge of adapting a
+// Hyperlane warp transfer, and the associated message containing the
+// orbiter payload, to the common payload type handled by the module.
+type HyperlaneAdapter struct {
+	*controller.BaseController[core.ProtocolID]
+
+	logger log.Logger
+	parser *HyperlaneParser
+}
+
+// NewHyperlaneAdapter returns a reference to a new HyperlaneAdapter instance
+// parsing the orbiter payloads with the given encoding.
+func NewHyperlaneAdapter(
+	cdc codec.Codec,
+	logger log.Logger,
+	encoding PayloadEncoding,
+) (*HyperlaneAdapter, error) {
+	if logger == nil {
+		return nil, core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+
+	id := core.PROTOCOL_HYPERLANE
+	baseController, err := controller.NewBase(id)
+	if err != nil {
+		return nil, err
+	}
+
+	parser, err := NewHyperlaneParser(cdc, encoding)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error during instantiation of Hyperlane adapter")
+	}
+
+	return &HyperlaneAdapter{
+		logger:         logger.With(core.AdapterControllerName, baseController.Name()),
+		BaseController: baseController,
+		parser:         parser,
+	}, nil
+}
+
+// ParsePacket parses the warp transfer message and the payload message of
+// a Hyperlane cross-chain packet. The two messages are linked via the
+// transfer message ID contained in the payload message body.
+func (a *HyperlaneAdapter) ParsePacket(
+	ccPacket adaptertypes.CrossChainPacket,
+) (*types.ParsedData, error) {
+	hypPacket, ok := ccPacket.(*adaptertypes.HyperlaneCrossChainPacket)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf(
+			"expected %T, got %T",
+			&adaptertypes.HyperlaneCrossChainPacket{},
+			ccPacket,
+		)
+	}
+
+	transferMsg, err := hyperlaneutil.ParseHyperlaneMessage(hypPacket.Packet())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid transfer message")
+	}
+
+	warpPayload, err := warptypes.ParseWarpPayload(transferMsg.Body)
+	if err != nil {
+		return nil, core.ErrNoOrbiterPacket.Wrapf("transfer message is not a warp transfer: %s", err)
+	}
+
+	if !bytes.Equal(warpPayload.GetCosmosAccount(), core.ModuleAddress) {
+		return nil, core.ErrNoOrbiterPacket.Wrap("warp recipient is not Orbiter module")
+	}
+
+	amount := sdkmath.NewIntFromBigInt(warpPayload.Amount())
+	if !amount.IsPositive() {
+		return nil, core.ErrValidation.Wrapf("invalid transfer amount: %s", amount)
+	}
+
+	payloadMsg, err := hyperlaneutil.ParseHyperlaneMessage(hypPacket.PayloadMessage())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid payload message")
+	}
+
+	if err := validateHyperlaneMessagesPairing(transferMsg, payloadMsg); err != nil {
+		return nil, err
+	}
+
+	transferID, payloadBz, err := ParseHyperlanePayloadMessageBody(payloadMsg.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if transferID != transferMsg.Id() {
+		return nil, core.ErrValidation.Wrapf(
+			"payload message refers to transfer message %s, got %s",
+			transferID,
+			transferMsg.Id(),
+		)
+	}
+
+	payload, err := a.parser.ParsePayload(payloadBz)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ParsedData{
+		Coin:    sdk.NewCoin(hypPacket.Denom(), amount),
+		Payload: *payload,
+	}, nil
+}
+
+// validateHyperlaneMessagesPairing returns an error if the payload message
+// has not been sent from the same origin and sender as the transfer message,
+// or it is not intended for the Orbiter application.
+func validateHyperlaneMessagesPairing(
+	transferMsg hyperlaneutil.HyperlaneMessage,
+	payloadMsg hyperlaneutil.HyperlaneMessage,
+) error {
+	if payloadMsg.Origin != transferMsg.Origin {
+		return core.ErrValidation.Wrapf(
+			"payload message origin %d is different from transfer message origin %d",
+			payloadMsg.Origin,
+			transferMsg.Origin,
+		)
+	}
+
+	// NOTE: the warp module accepts transfer messages only from the enrolled
+	// remote router, so requiring the same sender guarantees that the payload
+	// has been created along with the transfer.
+	if payloadMsg.Sender != transferMsg.Sender {
+		return core.ErrValidation.Wrap(
+			"payload message sender is different from transfer message sender",
+		)
+	}
+
+	if payloadMsg.Recipient != entrypointtypes.HyperlaneAppAddress {
+		return core.ErrValidation.Wrap("payload message recipient is not Orbiter application")
+	}
+
+	return nil
+}
+
+// ParseHyperlanePayloadMessageBody splits the body of a Hyperlane payload
+// message into the ID of the associated transfer message and the orbiter
+// payload.
+func ParseHyperlanePayloadMessageBody(body []byte) (hyperlaneutil.HexAddress, []byte, error) {
+	if len(body) <= HyperlaneMessageIDLen {
+		return hyperlaneutil.HexAddress{}, nil, core.ErrParsingPayload.Wrapf(
+			"payload message body must be longer than %d bytes, got %d",
+			HyperlaneMessageIDLen,
+			len(body),
+		)
+	}
+
+	transferID := hyperlaneutil.HexAddress(body[:HyperlaneMessageIDLen])
+
+	return transferID, body[HyperlaneMessageIDLen:], nil
+}
+
+var _ types.PayloadParser = &HyperlaneParser{}
+
+// HyperlaneParser parses the orbiter payload contained in a Hyperlane message.
+type HyperlaneParser struct {
+	encoding PayloadEncoding
+	parser   types.PayloadParser
+}
+
+// NewHyperlaneParser returns a new instance of a Hyperlane parser decoding
+// payloads with the given encoding.
+func NewHyperlaneParser(cdc codec.Codec, encoding PayloadEncoding) (*HyperlaneParser, error) {
+	if cdc == nil {
+		return nil, core.ErrNilPointer.Wrap("codec cannot be nil")
+	}
+
+	parser, err := NewPayloadParser(cdc, encoding)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HyperlaneParser{
+		encoding: encoding,
+		parser:   parser,
+	}, nil
+}
+
+// Encoding returns the payload encoding handled by the parser.
+func (p *HyperlaneParser) Encoding() PayloadEncoding {
+	return p.encoding
+}
+
+// ParsePayload parses the orbiter payload sent via a Hyperlane message.
+func (p *HyperlaneParser) ParsePayload(payloadBz []byte) (*core.Payload, error) {
+	payload, err := p.parser.ParsePayload(payloadBz)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := payload.Validate(); err != nil {
+		return payload, err
+	}
+
+	return payload, nil
+}
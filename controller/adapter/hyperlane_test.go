@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter_test
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	adapterctrl "github.com/noble-assets/orbiter/v2/controller/adapter"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/testdata"
+	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
+)
+
+func TestHyperlaneParsePacket(t *testing.T) {
+	router := hyperlaneutil.HexAddress(testutil.RandomBytes(32))
+	amount := sdkmath.NewInt(1_000_000)
+
+	transferMsg := newHyperlaneTransferMessage(t, 1, router, core.ModuleAddress, amount)
+
+	testCases := []struct {
+		name            string
+		transferMessage func() []byte
+		payloadMessage  func() []byte
+		expErr          string
+	}{
+		{
+			name: "error - transfer message is not a warp transfer",
+			transferMessage: func() []byte {
+				return newHyperlanePayloadMessage(
+					1, router, transferMsg.Id(), testutil.CreateValidOrbiterPayload(),
+				).Bytes()
+			},
+			payloadMessage: func() []byte {
+				return newHyperlanePayloadMessage(
+					1, router, transferMsg.Id(), testutil.CreateValidOrbiterPayload(),
+				).Bytes()
+			},
+			expErr: "not a warp transfer",
+		},
+		{
+			name: "error - warp recipient is not the orbiter module",
+			transferMessage: func() []byte {
+				return newHyperlaneTransferMessage(
+					t, 1, router, testutil.AddressBytes(), amount,
+				).Bytes()
+			},
+			payloadMessage: func() []byte {
+				return newHyperlanePayloadMessage(
+					1, router, transferMsg.Id(), testutil.CreateValidOrbiterPayload(),
+				).Bytes()
+			},
+			expErr: "warp recipient is not Orbiter module",
+		},
+		{
+			name:            "error - different origins",
+			transferMessage: transferMsg.Bytes,
+			payloadMessage: func() []byte {
+				return newHyperlanePayloadMessage(
+					2, router, transferMsg.Id(), testutil.CreateValidOrbiterPayload(),
+				).Bytes()
+			},
+			expErr: "origin",
+		},
+		{
+			name:            "error - different senders",
+			transferMessage: transferMsg.Bytes,
+			payloadMessage: func() []byte {
+				return newHyperlanePayloadMessage(
+					1,
+					hyperlaneutil.HexAddress(testutil.RandomBytes(32)),
+					transferMsg.Id(),
+					testutil.CreateValidOrbiterPayload(),
+				).Bytes()
+			},
+			expErr: "sender is different",
+		},
+		{
+			name:            "error - payload message recipient is not the orbiter application",
+			transferMessage: transferMsg.Bytes,
+			payloadMessage: func() []byte {
+				msg := newHyperlanePayloadMessage(
+					1, router, transferMsg.Id(), testutil.CreateValidOrbiterPayload(),
+				)
+				msg.Recipient = hyperlaneutil.HexAddress(testutil.RandomBytes(32))
+
+				return msg.Bytes()
+			},
+			expErr: "recipient is not Orbiter application",
+		},
+		{
+			name:            "error - transfer message ID does not match",
+			transferMessage: transferMsg.Bytes,
+			payloadMessage: func() []byte {
+				return newHyperlanePayloadMessage(
+					1,
+					router,
+					hyperlaneutil.HexAddress(testutil.RandomBytes(32)),
+					testutil.CreateValidOrbiterPayload(),
+				).Bytes()
+			},
+			expErr: "refers to transfer message",
+		},
+		{
+			name:            "error - invalid payload",
+			transferMessage: transferMsg.Bytes,
+			payloadMessage: func() []byte {
+				return newHyperlanePayloadMessage(
+					1, router, transferMsg.Id(), "not json payload",
+				).Bytes()
+			},
+			expErr: "not a valid json",
+		},
+		{
+			name:            "success - valid transfer and payload messages",
+			transferMessage: transferMsg.Bytes,
+			payloadMessage: func() []byte {
+				return newHyperlanePayloadMessage(
+					1, router, transferMsg.Id(), testutil.CreateValidOrbiterPayload(),
+				).Bytes()
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			encCfg := testutil.MakeTestEncodingConfig("noble")
+			encCfg.InterfaceRegistry.RegisterImplementations(
+				(*core.ForwardingAttributes)(nil),
+				&testdata.TestForwardingAttr{},
+			)
+
+			adapter, err := adapterctrl.NewHyperlaneAdapter(
+				encCfg.Codec,
+				log.NewNopLogger(),
+				adapterctrl.PayloadEncodingJSON,
+			)
+			require.NoError(t, err)
+
+			ccPacket, err := adaptertypes.NewHyperlaneCrossChainPacket(
+				"uusdn",
+				tC.transferMessage(),
+				tC.payloadMessage(),
+			)
+			require.NoError(t, err)
+
+			parsedData, err := adapter.ParsePacket(ccPacket)
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, parsedData)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, parsedData)
+				require.Equal(t, "uusdn", parsedData.Coin.Denom)
+				require.Equal(t, amount, parsedData.Coin.Amount)
+				require.Equal(t, core.PROTOCOL_CCTP, parsedData.Payload.Forwarding.ProtocolId)
+			}
+		})
+	}
+}
+
+func TestParseHyperlanePayloadMessageBody(t *testing.T) {
+	id := hyperlaneutil.HexAddress(testutil.RandomBytes(32))
+	body := slices.Concat(id.Bytes(), []byte("payload"))
+
+	transferID, payload, err := adapterctrl.ParseHyperlanePayloadMessageBody(body)
+	require.NoError(t, err)
+	require.Equal(t, id, transferID)
+	require.Equal(t, []byte("payload"), payload)
+
+	_, _, err = adapterctrl.ParseHyperlanePayloadMessageBody(id.Bytes())
+	require.ErrorIs(t, err, core.ErrParsingPayload)
+}
+
+// newHyperlaneTransferMessage returns a Hyperlane message containing a
+// warp transfer, as created by the remote warp router.
+func newHyperlaneTransferMessage(
+	t *testing.T,
+	origin uint32,
+	sender hyperlaneutil.HexAddress,
+	recipient []byte,
+	amount sdkmath.Int,
+) hyperlaneutil.HyperlaneMessage {
+	t.Helper()
+
+	warpPayload, err := warptypes.NewWarpPayload(recipient, *big.NewInt(amount.Int64()))
+	require.NoError(t, err)
+
+	return hyperlaneutil.HyperlaneMessage{
+		Version:     3,
+		Nonce:       1,
+		Origin:      origin,
+		Sender:      sender,
+		Destination: 1,
+		Recipient:   hyperlaneutil.HexAddress(testutil.RandomBytes(32)),
+		Body:        warpPayload.Bytes(),
+	}
+}
+
+// newHyperlanePayloadMessage returns a Hyperlane message containing the
+// orbiter payload, linked to the transfer message with the given ID.
+func newHyperlanePayloadMessage(
+	origin uint32,
+	sender hyperlaneutil.HexAddress,
+	transferID hyperlaneutil.HexAddress,
+	payload string,
+) hyperlaneutil.HyperlaneMessage {
+	return hyperlaneutil.HyperlaneMessage{
+		Version:     3,
+		Nonce:       2,
+		Origin:      origin,
+		Sender:      sender,
+		Destination: 1,
+		Recipient:   entrypointtypes.HyperlaneAppAddress,
+		Body:        slices.Concat(transferID.Bytes(), []byte(payload)),
+	}
+}
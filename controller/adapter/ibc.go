@@ -94,7 +94,7 @@ func (a *IBCAdapter) ParsePacket(
 		return nil, core.ErrNoOrbiterPacket.Wrap("receiver is not Orbiter module")
 	}
 
-	payload, err := a.parser.ParsePayload([]byte(packet.GetMemo()))
+	payload, coexistingKeys, err := a.parser.ParseMemo(packet.GetMemo())
 	if err != nil {
 		return nil, err
 	}
@@ -106,18 +106,21 @@ func (a *IBCAdapter) ParsePacket(
 
 	// In IBC the denom specified in the packet is the sending chain representation. We have to
 	// convert the denom into the Noble representation.
-	denom, err := RecoverNativeDenom(
+	denom, err := RecoverNobleDenom(
 		packet.Denom,
 		ibcPacket.SourcePort(),
 		ibcPacket.SourceChannel(),
+		ibcPacket.DestinationPort(),
+		ibcPacket.DestinationChannel(),
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	return &types.ParsedData{
-		Coin:    sdk.NewCoin(denom, amount),
-		Payload: *payload,
+		Coin:           sdk.NewCoin(denom, amount),
+		Payload:        *payload,
+		CoexistingKeys: coexistingKeys,
 	}, nil
 }
 
@@ -163,6 +166,24 @@ func (p *IBCParser) ParsePayload(memoBz []byte) (*core.Payload, error) {
 	return payload, nil
 }
 
+// ParseMemo parses the ICS20 memo to retrieve the orbiter payload from the
+// orbiter key. It returns:
+// - Payload: the parsed payload.
+// - []string: the other root level keys of the memo, left to other middlewares.
+// - error: an error, if one occurred during parsing.
+func (p *IBCParser) ParseMemo(memo string) (*core.Payload, []string, error) {
+	payload, coexistingKeys, err := p.ParseOrbiterKey(memo)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := payload.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	return payload, coexistingKeys, nil
+}
+
 // GetICS20PacketData returns the unmarshalled ICS-20 packet data.
 // It returns an error if the data cannot be unmarshalled.
 func GetICS20PacketData(data []byte) (transfertypes.FungibleTokenPacketData, error) {
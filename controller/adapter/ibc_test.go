@@ -197,6 +197,8 @@ func TestParsePacket(t *testing.T) {
 				p, err := adaptertypes.NewIBCCrossChainPacket(
 					"nontransfer",
 					"channel-1",
+					"transfer",
+					"channel-0",
 					[]byte(`{"some": "other packet type"}`),
 				)
 				require.NoError(t, err)
@@ -217,6 +219,8 @@ func TestParsePacket(t *testing.T) {
 				p, err := adaptertypes.NewIBCCrossChainPacket(
 					"nontransfer",
 					"channel-1",
+					"transfer",
+					"channel-0",
 					data,
 				)
 				require.NoError(t, err)
@@ -237,6 +241,8 @@ func TestParsePacket(t *testing.T) {
 				p, err := adaptertypes.NewIBCCrossChainPacket(
 					"nontransfer",
 					"channel-1",
+					"transfer",
+					"channel-0",
 					data,
 				)
 				require.NoError(t, err)
@@ -246,7 +252,7 @@ func TestParsePacket(t *testing.T) {
 			expErr: "not a valid json",
 		},
 		{
-			name: "error - denom is not native (multi hop)",
+			name: "success - denom is a voucher of the source chain (multi hop)",
 			setup: func(reg codectypes.InterfaceRegistry) {
 				reg.RegisterImplementations(
 					(*core.ForwardingAttributes)(nil),
@@ -269,16 +275,35 @@ func TestParsePacket(t *testing.T) {
 				p, err := adaptertypes.NewIBCCrossChainPacket(
 					"transfer",
 					"channel-1",
+					"transfer",
+					"channel-0",
 					data.GetBytes(),
 				)
 				require.NoError(t, err)
 
 				return p
 			},
-			expErr: "coin is native of source",
+			expParsedData: &types.ParsedData{
+				Coin: sdk.Coin{
+					Denom:  transfertypes.ParseDenomTrace("transfer/channel-0/transfer/channel-2/uosmo").IBCDenom(),
+					Amount: sdkmath.NewIntFromUint64(1_000_000),
+				},
+				Payload: core.Payload{
+					Forwarding: &core.Forwarding{
+						ProtocolId: core.PROTOCOL_CCTP,
+						Attributes: &codectypes.Any{TypeUrl: "/testpb.TestForwardingAttr"},
+					},
+					PreActions: []*core.Action{
+						{
+							Id:         core.ACTION_FEE,
+							Attributes: &codectypes.Any{TypeUrl: "/testpb.TestActionAttr"},
+						},
+					},
+				},
+			},
 		},
 		{
-			name: "error - denom is not native (native on source)",
+			name: "success - denom is native of the source chain",
 			setup: func(reg codectypes.InterfaceRegistry) {
 				reg.RegisterImplementations(
 					(*core.ForwardingAttributes)(nil),
@@ -301,13 +326,32 @@ func TestParsePacket(t *testing.T) {
 				p, err := adaptertypes.NewIBCCrossChainPacket(
 					"transfer",
 					"channel-1",
+					"transfer",
+					"channel-0",
 					data.GetBytes(),
 				)
 				require.NoError(t, err)
 
 				return p
 			},
-			expErr: "coin is native of source",
+			expParsedData: &types.ParsedData{
+				Coin: sdk.Coin{
+					Denom:  transfertypes.ParseDenomTrace("transfer/channel-0/uosmo").IBCDenom(),
+					Amount: sdkmath.NewIntFromUint64(1_000_000),
+				},
+				Payload: core.Payload{
+					Forwarding: &core.Forwarding{
+						ProtocolId: core.PROTOCOL_CCTP,
+						Attributes: &codectypes.Any{TypeUrl: "/testpb.TestForwardingAttr"},
+					},
+					PreActions: []*core.Action{
+						{
+							Id:         core.ACTION_FEE,
+							Attributes: &codectypes.Any{TypeUrl: "/testpb.TestActionAttr"},
+						},
+					},
+				},
+			},
 		},
 		{
 			name: "success - valid orbiter payload with actions",
@@ -333,6 +377,8 @@ func TestParsePacket(t *testing.T) {
 				p, err := adaptertypes.NewIBCCrossChainPacket(
 					"transfer",
 					"channel-1",
+					"transfer",
+					"channel-0",
 					data.GetBytes(),
 				)
 				require.NoError(t, err)
@@ -358,6 +404,61 @@ func TestParsePacket(t *testing.T) {
 				},
 			},
 		},
+
+		{
+			name: "success - valid orbiter payload with coexisting keys",
+			setup: func(reg codectypes.InterfaceRegistry) {
+				reg.RegisterImplementations(
+					(*core.ForwardingAttributes)(nil),
+					&testdata.TestForwardingAttr{},
+				)
+				reg.RegisterImplementations(
+					(*core.ActionAttributes)(nil),
+					&testdata.TestActionAttr{},
+				)
+			},
+			ccPacket: func() adaptertypes.CrossChainPacket {
+				memo := map[string]json.RawMessage{}
+				err := json.Unmarshal([]byte(testutil.CreateValidOrbiterPayload()), &memo)
+				require.NoError(t, err)
+				memo["wasm"] = json.RawMessage(`{"contract": "noble1contract"}`)
+				memo["forward"] = json.RawMessage(`{"receiver": "osmo1receiver"}`)
+				memoBz, err := json.Marshal(memo)
+				require.NoError(t, err)
+
+				data := transfertypes.NewFungibleTokenPacketData(
+					"transfer/channel-1/uusdc",
+					"1000000",
+					sender,
+					core.ModuleAddress.String(),
+					string(memoBz),
+				)
+
+				p, err := adaptertypes.NewIBCCrossChainPacket(
+					"transfer",
+					"channel-1",
+					"transfer",
+					"channel-0",
+					data.GetBytes(),
+				)
+				require.NoError(t, err)
+
+				return p
+			},
+			expParsedData: &types.ParsedData{
+				Coin: sdk.Coin{
+					Denom:  "uusdc",
+					Amount: sdkmath.NewIntFromUint64(1_000_000),
+				},
+				Payload: core.Payload{
+					Forwarding: &core.Forwarding{
+						ProtocolId: core.PROTOCOL_CCTP,
+						Attributes: &codectypes.Any{TypeUrl: "/testpb.TestForwardingAttr"},
+					},
+				},
+				CoexistingKeys: []string{"forward", "wasm"},
+			},
+		},
 	}
 
 	for _, tC := range testCases {
@@ -398,6 +499,8 @@ func TestParsePacket(t *testing.T) {
 						require.Equal(t, expPayload.PreActions[0].Attributes.TypeUrl, payload.PreActions[0].Attributes.TypeUrl)
 					}
 
+					require.Equal(t, tC.expParsedData.CoexistingKeys, parsedData.CoexistingKeys)
+
 					expCoin := tC.expParsedData.Coin
 					coin := parsedData.Coin
 					require.Equal(t, expCoin.String(), coin.String())
@@ -21,16 +21,37 @@
 package adapter
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
-func RecoverNativeDenom(denom, sourcePort, sourceChannel string) (string, error) {
+// RecoverNobleDenom returns the Noble representation of the denom contained in an
+// ICS20 packet received by Noble:
+//   - If Noble is the source of the coin, the prefix added by the sending chain is
+//     removed. The result is the Noble native denom or, for a voucher that Noble
+//     received from another chain, the ibc/<hash> of the remaining trace.
+//   - If the sending chain is the source of the coin, the prefix of the Noble
+//     receiving port and channel is added, and the result is the ibc/<hash> of
+//     the full trace.
+func RecoverNobleDenom(
+	denom string,
+	sourcePort, sourceChannel string,
+	destinationPort, destinationChannel string,
+) (string, error) {
+	if denom == "" {
+		return "", fmt.Errorf("denom cannot be empty")
+	}
+
 	if transfertypes.SenderChainIsSource(sourcePort, sourceChannel, denom) {
-		return "", errors.New("coin is native of source chain")
+		prefixedDenom := transfertypes.GetPrefixedDenom(
+			destinationPort,
+			destinationChannel,
+			denom,
+		)
+
+		return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom(), nil
 	}
 
 	voucherPrefix := transfertypes.GetDenomPrefix(sourcePort, sourceChannel)
@@ -46,12 +67,7 @@ func RecoverNativeDenom(denom, sourcePort, sourceChannel string) (string, error)
 	}
 	unprefixedDenom := strings.TrimPrefix(denom, voucherPrefix)
 
-	// The denomination used to send the coins is either the native denom or the hash of the path
-	// if the denomination is not native.
-	denomTrace := transfertypes.ParseDenomTrace(unprefixedDenom)
-	if !denomTrace.IsNativeDenom() {
-		return "", errors.New("orbiter supports only native coins")
-	}
-
-	return unprefixedDenom, nil
+	// The denomination is either the native denom or the path of a voucher that Noble
+	// received from another chain before sending it to the source chain.
+	return transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom(), nil
 }
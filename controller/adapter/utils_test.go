@@ -25,10 +25,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
 	"github.com/noble-assets/orbiter/v2/controller/adapter"
 )
 
-func TestRecoverNativeDenom(t *testing.T) {
+func TestRecoverNobleDenom(t *testing.T) {
 	testCases := []struct {
 		name          string
 		denom         string
@@ -38,18 +40,34 @@ func TestRecoverNativeDenom(t *testing.T) {
 		expDenom      string
 	}{
 		{
-			name:          "error - coin is native to source chain",
-			denom:         "transfer/channel-2/transfer/channel-3/uusdc",
+			name:          "error - empty denom",
+			denom:         "",
+			sourcePort:    "transfer",
+			sourceChannel: "channel-1",
+			expErr:        "denom cannot be empty",
+		},
+		{
+			name:          "success - coin is native to source chain",
+			denom:         "uatom",
+			sourcePort:    "transfer",
+			sourceChannel: "channel-1",
+			expDenom:      transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(),
+		},
+		{
+			name:          "success - coin is a voucher on source chain",
+			denom:         "transfer/channel-2/transfer/channel-3/uatom",
 			sourcePort:    "transfer",
 			sourceChannel: "channel-1",
-			expErr:        "coin is native of source chain",
+			expDenom: transfertypes.ParseDenomTrace(
+				"transfer/channel-0/transfer/channel-2/transfer/channel-3/uatom",
+			).IBCDenom(),
 		},
 		{
-			name:          "error - denom has multi-hop IBC path",
-			denom:         "transfer/channel-1/transfer/channel-2/uusdc",
+			name:          "success - denom has multi-hop IBC path",
+			denom:         "transfer/channel-1/transfer/channel-2/uatom",
 			sourcePort:    "transfer",
 			sourceChannel: "channel-1",
-			expErr:        "orbiter supports only native coins",
+			expDenom:      transfertypes.ParseDenomTrace("transfer/channel-2/uatom").IBCDenom(),
 		},
 		{
 			name:          "success - native denom",
@@ -62,7 +80,13 @@ func TestRecoverNativeDenom(t *testing.T) {
 
 	for _, tC := range testCases {
 		t.Run(tC.name, func(t *testing.T) {
-			denom, err := adapter.RecoverNativeDenom(tC.denom, tC.sourcePort, tC.sourceChannel)
+			denom, err := adapter.RecoverNobleDenom(
+				tC.denom,
+				tC.sourcePort,
+				tC.sourceChannel,
+				"transfer",
+				"channel-0",
+			)
 
 			if tC.expErr != "" {
 				require.ErrorContains(t, err, tC.expErr)
@@ -22,9 +22,11 @@ package forwarding
 
 import (
 	"context"
+	"errors"
 
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 
+	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -42,14 +44,16 @@ var _ types.ForwardingController = &CCTPController{}
 type CCTPController struct {
 	*controller.BaseController[core.ProtocolID]
 
-	logger  log.Logger
-	handler *cctpHandler
+	logger       log.Logger
+	eventService event.Service
+	handler      *cctpHandler
 }
 
 // NewCCTPController returns a validated instance of the
 // Cross-Chain Transfer Protocol controller.
 func NewCCTPController(
 	logger log.Logger,
+	eventService event.Service,
 	msgServer forwardingtypes.CCTPMsgServer,
 ) (*CCTPController, error) {
 	if logger == nil {
@@ -69,6 +73,7 @@ func NewCCTPController(
 
 	c := &CCTPController{
 		logger:         logger.With(core.ForwardingControllerName, baseController.Name()),
+		eventService:   eventService,
 		BaseController: baseController,
 		handler:        handler,
 	}
@@ -86,6 +91,9 @@ func (c *CCTPController) Validate() error {
 	if c.logger == nil {
 		return core.ErrNilPointer.Wrap("logger")
 	}
+	if c.eventService == nil {
+		return core.ErrNilPointer.Wrap("event service")
+	}
 	if c.BaseController == nil {
 		return core.ErrNilPointer.Wrap("base controller")
 	}
@@ -113,7 +121,15 @@ func (c *CCTPController) HandlePacket(ctx context.Context, packet *types.Forward
 		return core.ErrValidation.Wrap(err.Error())
 	}
 
-	err = c.executeForwarding(ctx, packet.TransferAttributes, attr)
+	if denom := packet.TransferAttributes.DestinationDenom(); forwardingtypes.IsIBCVoucher(denom) {
+		return core.ErrValidation.Wrapf("CCTP cannot forward the IBC voucher %s", denom)
+	}
+
+	if err := validatePassthroughPayload(attr, packet.Forwarding.PassthroughPayload); err != nil {
+		return core.ErrValidation.Wrap(err.Error())
+	}
+
+	err = c.executeForwarding(ctx, packet.TransferAttributes, attr, packet.Forwarding)
 	if err != nil {
 		return errorsmod.Wrap(err, "CCTP controller execution error")
 	}
@@ -153,14 +169,36 @@ func (c *CCTPController) ValidateAttributes(attr *forwardingtypes.CCTPAttributes
 	return attr.Validate()
 }
 
+// validatePassthroughPayload returns an error if the passthrough payload
+// cannot be delivered with the provided CCTP attributes. The payload is sent
+// with a general message which must be received by the same destination caller
+// of the burn message.
+func validatePassthroughPayload(
+	attr *forwardingtypes.CCTPAttributes,
+	passthroughPayload []byte,
+) error {
+	if len(passthroughPayload) == 0 {
+		return nil
+	}
+
+	if len(attr.DestinationCaller) == 0 {
+		return errors.New("destination caller is required to deliver the passthrough payload")
+	}
+
+	return nil
+}
+
 // executeForwarding is the core controller logic which performs
 // the state transition calling into the CCTP server to
-// initiate a cross-chain transfer.
+// initiate a cross-chain transfer. When the forwarding has a passthrough
+// payload, it is sent to the destination domain with a general message.
 func (c *CCTPController) executeForwarding(
 	ctx context.Context,
 	transferAttr *core.TransferAttributes,
 	cctpAttr *forwardingtypes.CCTPAttributes,
+	forwarding *core.Forwarding,
 ) error {
+	var transferNonce uint64
 	if len(cctpAttr.DestinationCaller) == 0 {
 		msg := cctptypes.MsgDepositForBurn{
 			From:              core.ModuleAddress.String(),
@@ -170,10 +208,11 @@ func (c *CCTPController) executeForwarding(
 			BurnToken:         transferAttr.DestinationDenom(),
 		}
 
-		_, err := c.handler.DepositForBurn(ctx, &msg)
+		resp, err := c.handler.DepositForBurn(ctx, &msg)
 		if err != nil {
 			return err
 		}
+		transferNonce = resp.Nonce
 	} else {
 		msg := cctptypes.MsgDepositForBurnWithCaller{
 			From:              core.ModuleAddress.String(),
@@ -184,10 +223,53 @@ func (c *CCTPController) executeForwarding(
 			DestinationCaller: cctpAttr.DestinationCaller,
 		}
 
-		_, err := c.handler.DepositForBurnWithCaller(ctx, &msg)
+		resp, err := c.handler.DepositForBurnWithCaller(ctx, &msg)
 		if err != nil {
 			return err
 		}
+		transferNonce = resp.Nonce
+	}
+
+	if len(forwarding.PassthroughPayload) == 0 {
+		return nil
+	}
+
+	return c.sendPayloadMessage(ctx, cctpAttr, transferNonce, forwarding.PassthroughPayload)
+}
+
+// sendPayloadMessage sends the passthrough payload to the destination domain
+// with a general message linked to the burn message via the transfer nonce. The
+// message body mirrors the format used by the inbound gateway contract:
+// abi.encodePacked(transferNonce, passthroughPayload).
+func (c *CCTPController) sendPayloadMessage(
+	ctx context.Context,
+	cctpAttr *forwardingtypes.CCTPAttributes,
+	transferNonce uint64,
+	passthroughPayload []byte,
+) error {
+	body := forwardingtypes.NewCCTPPayloadMessageBody(transferNonce, passthroughPayload)
+	msg := cctptypes.MsgSendMessageWithCaller{
+		From:              core.ModuleAddress.String(),
+		DestinationDomain: cctpAttr.DestinationDomain,
+		Recipient:         cctpAttr.MintRecipient,
+		MessageBody:       body,
+		DestinationCaller: cctpAttr.DestinationCaller,
+	}
+
+	resp, err := c.handler.SendMessageWithCaller(ctx, &msg)
+	if err != nil {
+		return errorsmod.Wrap(err, "error sending passthrough payload message")
+	}
+
+	if err := c.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardingtypes.EventCCTPPayloadMessageSent{
+			DestinationDomain: cctpAttr.DestinationDomain,
+			TransferNonce:     transferNonce,
+			PayloadNonce:      resp.Nonce,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting CCTP payload message sent event")
 	}
 
 	return nil
@@ -22,12 +22,15 @@ package forwarding_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/noble-assets/orbiter/v2/controller/forwarding"
 	"github.com/noble-assets/orbiter/v2/testutil/mocks"
@@ -38,25 +41,36 @@ import (
 )
 
 func TestNewCCTPController(t *testing.T) {
+	deps := mocks.NewDependencies(t)
+
 	testCases := []struct {
-		name      string
-		logger    log.Logger
-		msgServer forwardingtypes.CCTPMsgServer
-		expError  string
+		name         string
+		logger       log.Logger
+		eventService event.Service
+		msgServer    forwardingtypes.CCTPMsgServer
+		expError     string
 	}{
 		{
-			name:      "success - valid controller creation",
-			logger:    log.NewNopLogger(),
-			msgServer: &mocks.CCTPMsgServer{},
+			name:         "success - valid controller creation",
+			logger:       log.NewNopLogger(),
+			eventService: deps.EventService,
+			msgServer:    &mocks.CCTPMsgServer{},
 		},
 		{
 			name:     "error - nil logger",
 			expError: "logger cannot be nil",
 		},
 		{
-			name:     "error - when no CCTP server is provided",
-			logger:   log.NewNopLogger(),
-			expError: core.ErrNilPointer.Error(),
+			name:         "error - when no CCTP server is provided",
+			logger:       log.NewNopLogger(),
+			eventService: deps.EventService,
+			expError:     core.ErrNilPointer.Error(),
+		},
+		{
+			name:      "error - when no event service is provided",
+			logger:    log.NewNopLogger(),
+			msgServer: &mocks.CCTPMsgServer{},
+			expError:  "event service",
 		},
 	}
 
@@ -64,6 +78,7 @@ func TestNewCCTPController(t *testing.T) {
 		t.Run(tC.name, func(t *testing.T) {
 			controller, err := forwarding.NewCCTPController(
 				tC.logger,
+				tC.eventService,
 				tC.msgServer,
 			)
 
@@ -114,6 +129,32 @@ func TestHandlePacket_CCTP(t *testing.T) {
 			packet:   func() *types.ForwardingPacket { return nil },
 			expError: "CCTP controller received nil packet",
 		},
+		{
+			name: "error - when the denom is an IBC voucher",
+			packet: func() *types.ForwardingPacket {
+				forwarding, err := forwardingtypes.NewCCTPForwarding(
+					1,
+					[]byte("recipient"),
+					[]byte("caller"),
+					[]byte(""),
+				)
+				require.NoError(t, err)
+
+				voucherAttr, err := core.NewTransferAttributes(
+					core.PROTOCOL_IBC,
+					"channel-01",
+					"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+					math.NewInt(1_000_000),
+				)
+				require.NoError(t, err)
+
+				return &types.ForwardingPacket{
+					Forwarding:         forwarding,
+					TransferAttributes: voucherAttr,
+				}
+			},
+			expError: "CCTP cannot forward the IBC voucher",
+		},
 		{
 			name: "error - CCTP server returns an error",
 			setup: func() context.Context {
@@ -140,6 +181,7 @@ func TestHandlePacket_CCTP(t *testing.T) {
 	logger := log.NewNopLogger()
 	controller, err := forwarding.NewCCTPController(
 		logger,
+		mocks.NewDependencies(t).EventService,
 		&mocks.CCTPMsgServer{},
 	)
 	require.NoError(t, err)
@@ -162,6 +204,131 @@ func TestHandlePacket_CCTP(t *testing.T) {
 	}
 }
 
+func TestHandlePacket_CCTPPassthroughPayload(t *testing.T) {
+	transferAttr, err := core.NewTransferAttributes(
+		core.PROTOCOL_IBC,
+		"channel-01",
+		"uusdc",
+		math.NewInt(1_000_000),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name              string
+		failing           bool
+		destinationCaller []byte
+		expError          string
+	}{
+		{
+			name:              "success - payload message sent with the transfer nonce",
+			destinationCaller: []byte("caller"),
+		},
+		{
+			name:     "error - no destination caller",
+			expError: "destination caller is required to deliver the passthrough payload",
+		},
+		{
+			name:              "error - CCTP server returns an error",
+			failing:           true,
+			destinationCaller: []byte("caller"),
+			expError:          "CCTP controller execution error",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			deps := mocks.NewDependencies(t)
+			ctx := deps.SdkCtx.WithValue(mocks.FailingContextKey, tC.failing)
+
+			controller, err := forwarding.NewCCTPController(
+				log.NewNopLogger(),
+				deps.EventService,
+				&mocks.CCTPMsgServer{},
+			)
+			require.NoError(t, err)
+
+			fwd, err := forwardingtypes.NewCCTPForwarding(
+				1,
+				[]byte("recipient"),
+				tC.destinationCaller,
+				[]byte("payload"),
+			)
+			require.NoError(t, err)
+
+			err = controller.HandlePacket(ctx, &types.ForwardingPacket{
+				Forwarding:         fwd,
+				TransferAttributes: transferAttr,
+			})
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Equal(
+				t,
+				proto.MessageName(&forwardingtypes.EventCCTPPayloadMessageSent{}),
+				events[0].Type,
+			)
+			for _, attr := range events[0].Attributes {
+				switch attr.Key {
+				case "transfer_nonce":
+					require.Contains(t, attr.Value, fmt.Sprint(mocks.CCTPTransferNonce))
+				case "payload_nonce":
+					require.Contains(t, attr.Value, fmt.Sprint(mocks.CCTPPayloadNonce))
+				}
+			}
+		})
+	}
+}
+
+func TestCCTPAttributesValidate(t *testing.T) {
+	testCases := []struct {
+		name              string
+		destinationDomain uint32
+		mintRecipient     []byte
+		expError          string
+	}{
+		{
+			name:              "success - valid attributes",
+			destinationDomain: 1,
+			mintRecipient:     []byte("recipient"),
+		},
+		{
+			name:              "error - destination domain is Noble",
+			destinationDomain: forwardingtypes.CCTPNobleDomain,
+			mintRecipient:     []byte("recipient"),
+			expError:          "destination domain cannot be Noble",
+		},
+		{
+			name:              "error - empty mint recipient",
+			destinationDomain: 1,
+			expError:          "mint recipient cannot be empty",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			attr := forwardingtypes.CCTPAttributes{
+				DestinationDomain: tC.destinationDomain,
+				MintRecipient:     tC.mintRecipient,
+			}
+
+			err := attr.Validate()
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestExtractAttributes_CCTP(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -212,7 +379,11 @@ func TestExtractAttributes_CCTP(t *testing.T) {
 		},
 	}
 
-	controller, err := forwarding.NewCCTPController(log.NewNopLogger(), &mocks.CCTPMsgServer{})
+	controller, err := forwarding.NewCCTPController(
+		log.NewNopLogger(),
+		mocks.NewDependencies(t).EventService,
+		&mocks.CCTPMsgServer{},
+	)
 	require.NoError(t, err)
 
 	for _, tC := range testCases {
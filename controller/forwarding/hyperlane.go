@@ -23,18 +23,23 @@ package forwarding
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 
+	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/noble-assets/orbiter/v2/controller"
 	"github.com/noble-assets/orbiter/v2/types"
 	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
 	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
 )
 
 var _ types.ForwardingController = &HyperlaneController{}
@@ -43,15 +48,19 @@ var _ types.ForwardingController = &HyperlaneController{}
 type HyperlaneController struct {
 	*controller.BaseController[core.ProtocolID]
 
-	logger  log.Logger
-	handler forwardingtypes.HyperlaneHandler
+	logger       log.Logger
+	eventService event.Service
+	handler      forwardingtypes.HyperlaneHandler
+	resolver     forwardingtypes.HyperlaneTokenRouteResolver
 }
 
 // NewHyperlaneController returns a validated instance of the Hyperlane
 // controller.
 func NewHyperlaneController(
 	logger log.Logger,
+	eventService event.Service,
 	handler forwardingtypes.HyperlaneHandler,
+	resolver forwardingtypes.HyperlaneTokenRouteResolver,
 ) (*HyperlaneController, error) {
 	if logger == nil {
 		return nil, core.ErrNilPointer.Wrap("logger cannot be nil")
@@ -64,7 +73,9 @@ func NewHyperlaneController(
 	c := &HyperlaneController{
 		BaseController: b,
 		logger:         logger.With(core.ForwardingControllerName, b.Name()),
+		eventService:   eventService,
 		handler:        handler,
+		resolver:       resolver,
 	}
 
 	if err := c.Validate(); err != nil {
@@ -79,12 +90,20 @@ func (c *HyperlaneController) Validate() error {
 	if c.logger == nil {
 		return core.ErrNilPointer.Wrap("logger is required for the Hyperlane controller")
 	}
+	if c.eventService == nil {
+		return core.ErrNilPointer.Wrap("event service is required for the Hyperlane controller")
+	}
 	if c.BaseController == nil {
 		return core.ErrNilPointer.Wrap("base controller is required for the Hyperlane controller")
 	}
 	if c.handler == nil {
 		return core.ErrNilPointer.Wrap("handler is required for the Hyperlane controller")
 	}
+	if c.resolver == nil {
+		return core.ErrNilPointer.Wrap(
+			"token route resolver is required for the Hyperlane controller",
+		)
+	}
 
 	return nil
 }
@@ -105,6 +124,11 @@ func (c *HyperlaneController) HandlePacket(
 		return errorsmod.Wrap(err, "error extracting Hyperlane forwarding attributes")
 	}
 
+	attr, err = c.resolveTokenID(ctx, packet.TransferAttributes, attr)
+	if err != nil {
+		return core.ErrValidation.Wrapf("invalid Hyperlane forwarding: %s", err.Error())
+	}
+
 	c.logger.Debug(
 		"forwarding attributes",
 		"token_id",
@@ -120,6 +144,12 @@ func (c *HyperlaneController) HandlePacket(
 		return core.ErrValidation.Wrapf("invalid Hyperlane forwarding: %s", err.Error())
 	}
 
+	if attr.AutoFee && len(packet.Forwarding.PassthroughPayload) != 0 {
+		return core.ErrValidation.Wrap(
+			"Hyperlane auto fee is not supported with a passthrough payload",
+		)
+	}
+
 	err = c.executeForwarding(
 		ctx,
 		packet.TransferAttributes,
@@ -155,6 +185,45 @@ func (c *HyperlaneController) ExtractAttributes(
 	return hypAttr, nil
 }
 
+// resolveTokenID returns the attributes to use for the forwarding. When the
+// token ID is not specified, a copy of the attributes with the token ID
+// registered for the destination denom and domain is returned.
+func (c *HyperlaneController) resolveTokenID(
+	ctx context.Context,
+	transferAttr *core.TransferAttributes,
+	hypAttr *forwardingtypes.HypAttributes,
+) (*forwardingtypes.HypAttributes, error) {
+	if len(hypAttr.TokenId) != 0 {
+		return hypAttr, nil
+	}
+
+	if transferAttr == nil {
+		return nil, core.ErrNilPointer.Wrap("transfer attributes are not set")
+	}
+
+	denom := transferAttr.DestinationDenom()
+	tokenID, found, err := c.resolver.GetHyperlaneTokenRoute(
+		ctx,
+		denom,
+		hypAttr.DestinationDomain,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error resolving Hyperlane token route")
+	}
+	if !found {
+		return nil, fmt.Errorf(
+			"no Hyperlane token route registered for %s to domain %d",
+			denom,
+			hypAttr.DestinationDomain,
+		)
+	}
+
+	resolved := *hypAttr
+	resolved.TokenId = tokenID
+
+	return &resolved, nil
+}
+
 // ValidateForwarding checks whether the attributes received for execute a forwarding are
 // valid or not.
 func (c *HyperlaneController) ValidateForwarding(
@@ -179,7 +248,15 @@ func (c *HyperlaneController) ValidateForwarding(
 		return errorsmod.Wrap(err, "invalid Hyperlane forwarding")
 	}
 
-	if resp.Token.OriginDenom != transferAttr.DestinationDenom() {
+	denom := transferAttr.DestinationDenom()
+	if resp.Token.OriginDenom != denom && forwardingtypes.IsIBCVoucher(denom) {
+		return fmt.Errorf(
+			"invalid forwarding token %s for the IBC voucher %s",
+			tokenID, denom,
+		)
+	}
+
+	if resp.Token.OriginDenom != denom {
 		return fmt.Errorf(
 			"invalid forwarding token, wanted %s, got %s",
 			resp.Token.OriginDenom, transferAttr.DestinationDenom(),
@@ -189,12 +266,14 @@ func (c *HyperlaneController) ValidateForwarding(
 	return nil
 }
 
-// executeForwarding initiates an Hyperlane cross-chain transfer.
+// executeForwarding initiates an Hyperlane cross-chain transfer. When the
+// forwarding has a passthrough payload, it is dispatched to the recipient
+// with a message linked to the warp transfer.
 func (c *HyperlaneController) executeForwarding(
 	ctx context.Context,
 	transferAttr *core.TransferAttributes,
 	hypAttr *forwardingtypes.HypAttributes,
-	_ []byte,
+	passthroughPayload []byte,
 ) error {
 	// If the len of bytes is zero, we pass nil in the msg construction.
 	var hookAddrPtr *hyperlaneutil.HexAddress
@@ -203,20 +282,186 @@ func (c *HyperlaneController) executeForwarding(
 		hookAddrPtr = &h
 	}
 
-	_, err := c.handler.RemoteTransfer(ctx, &warptypes.MsgRemoteTransfer{
+	amount := transferAttr.DestinationAmount()
+	maxFee := hypAttr.GetMaxFee()
+
+	var fee sdk.Coin
+	deducted := false
+	if hypAttr.AutoFee {
+		var err error
+		fee, err = c.quoteFee(ctx, transferAttr, hypAttr)
+		if err != nil {
+			return err
+		}
+
+		// The quoted fee is left in the module account to pay the hooks
+		// when the denom is the same of the transfer.
+		if fee.Denom == transferAttr.DestinationDenom() {
+			amount = amount.Sub(fee.Amount)
+			if !amount.IsPositive() {
+				return fmt.Errorf(
+					"quoted fee %s is not lower than the transfer amount %s",
+					fee, transferAttr.DestinationAmount(),
+				)
+			}
+			deducted = fee.IsPositive()
+		}
+		maxFee = fee
+	}
+
+	resp, err := c.handler.RemoteTransfer(ctx, &warptypes.MsgRemoteTransfer{
 		Sender:             core.ModuleAddress.String(),
 		TokenId:            hyperlaneutil.HexAddress(hypAttr.GetTokenId()),
 		DestinationDomain:  hypAttr.DestinationDomain,
 		Recipient:          hyperlaneutil.HexAddress(hypAttr.GetRecipient()),
-		Amount:             transferAttr.DestinationAmount(),
+		Amount:             amount,
 		CustomHookId:       hookAddrPtr,
 		GasLimit:           hypAttr.GasLimit,
-		MaxFee:             hypAttr.GetMaxFee(),
+		MaxFee:             maxFee,
 		CustomHookMetadata: hypAttr.GetCustomHookMetadata(),
 	})
 	if err != nil {
 		return errorsmod.Wrap(err, "error executing Hyperlane forwarding")
 	}
 
+	if fee.IsValid() && fee.IsPositive() {
+		transferAttr.SetDestinationAmount(amount)
+		transferAttr.AddForwardingFee(fee)
+
+		if err := c.eventService.EventManager(ctx).Emit(
+			ctx,
+			&forwardingtypes.EventHyperlaneFeePaid{
+				DestinationDomain: hypAttr.DestinationDomain,
+				TransferMessageId: resp.MessageId.String(),
+				Fee:               fee,
+				Deducted:          deducted,
+			},
+		); err != nil {
+			return errorsmod.Wrap(err, "error emitting Hyperlane fee paid event")
+		}
+	}
+
+	if len(passthroughPayload) == 0 {
+		return nil
+	}
+
+	return c.dispatchPayloadMessage(ctx, hypAttr, hookAddrPtr, resp.MessageId, passthroughPayload)
+}
+
+// quoteFee returns the post dispatch hooks fee quoted by the warp query server
+// for the Hyperlane forwarding. Returns an error if the quote exceeds the max
+// fee of the attributes, when set.
+func (c *HyperlaneController) quoteFee(
+	ctx context.Context,
+	transferAttr *core.TransferAttributes,
+	hypAttr *forwardingtypes.HypAttributes,
+) (sdk.Coin, error) {
+	req := warptypes.QueryQuoteRemoteTransferRequest{
+		Id:                 hyperlaneutil.HexAddress(hypAttr.GetTokenId()).String(),
+		DestinationDomain:  strconv.FormatUint(uint64(hypAttr.DestinationDomain), 10),
+		CustomHookMetadata: hypAttr.GetCustomHookMetadata(),
+	}
+	if len(hypAttr.CustomHookId) != 0 {
+		req.CustomHookId = hyperlaneutil.HexAddress(hypAttr.CustomHookId).String()
+	}
+
+	resp, err := c.handler.QuoteRemoteTransfer(ctx, &req)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "error quoting Hyperlane fee")
+	}
+
+	var fee sdk.Coin
+	switch len(resp.GasPayment) {
+	case 0:
+		fee = sdk.NewCoin(transferAttr.DestinationDenom(), math.ZeroInt())
+	case 1:
+		fee = resp.GasPayment[0]
+	default:
+		return sdk.Coin{}, fmt.Errorf(
+			"quoted Hyperlane fee must have a single denom, got %s",
+			resp.GasPayment,
+		)
+	}
+
+	maxFee := hypAttr.GetMaxFee()
+	if maxFee.Amount.IsNil() || !maxFee.IsPositive() || !fee.IsPositive() {
+		return fee, nil
+	}
+	if fee.Denom != maxFee.Denom || fee.Amount.GT(maxFee.Amount) {
+		return sdk.Coin{}, fmt.Errorf("quoted fee %s exceeds the max fee %s", fee, maxFee)
+	}
+
+	return fee, nil
+}
+
+// dispatchPayloadMessage dispatches the passthrough payload to the recipient
+// via the mailbox of the forwarded token. The message body is the concatenation
+// of the 32 bytes ID of the transfer message and the payload, the same format
+// used for the inbound payload messages.
+func (c *HyperlaneController) dispatchPayloadMessage(
+	ctx context.Context,
+	hypAttr *forwardingtypes.HypAttributes,
+	hookAddrPtr *hyperlaneutil.HexAddress,
+	transferMessageID hyperlaneutil.HexAddress,
+	passthroughPayload []byte,
+) error {
+	tokenResp, err := c.handler.Token(ctx, &warptypes.QueryTokenRequest{
+		Id: hyperlaneutil.HexAddress(hypAttr.GetTokenId()).String(),
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "error querying Hyperlane token")
+	}
+
+	mailboxID, err := hyperlaneutil.DecodeHexAddress(tokenResp.Token.OriginMailbox)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid Hyperlane token mailbox")
+	}
+
+	hookMetadata, err := hyperlaneutil.DecodeEthHex(hypAttr.GetCustomHookMetadata())
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid Hyperlane custom hook metadata")
+	}
+
+	// NOTE: the max fee is optional, and an unset coin
+	// cannot be used to build the fee coins.
+	var maxFee sdk.Coins
+	if fee := hypAttr.GetMaxFee(); !fee.Amount.IsNil() && fee.IsPositive() {
+		maxFee = sdk.NewCoins(fee)
+	}
+
+	body := make([]byte, 0, len(transferMessageID)+len(passthroughPayload))
+	body = append(body, transferMessageID.Bytes()...)
+	body = append(body, passthroughPayload...)
+
+	payloadMessageID, err := c.handler.DispatchMessage(
+		ctx,
+		mailboxID,
+		entrypointtypes.HyperlaneAppAddress,
+		maxFee,
+		hypAttr.DestinationDomain,
+		hyperlaneutil.HexAddress(hypAttr.GetRecipient()),
+		body,
+		hyperlaneutil.StandardHookMetadata{
+			GasLimit:           hypAttr.GasLimit,
+			Address:            core.ModuleAddress,
+			CustomHookMetadata: hookMetadata,
+		},
+		hookAddrPtr,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "error dispatching passthrough payload message")
+	}
+
+	if err := c.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardingtypes.EventHyperlanePayloadMessageSent{
+			DestinationDomain: hypAttr.DestinationDomain,
+			TransferMessageId: transferMessageID.String(),
+			PayloadMessageId:  payloadMessageID.String(),
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting Hyperlane payload message sent event")
+	}
+
 	return nil
 }
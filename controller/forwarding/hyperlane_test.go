@@ -22,15 +22,18 @@ package forwarding_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/noble-assets/orbiter/v2/controller/forwarding"
 	"github.com/noble-assets/orbiter/v2/testutil"
@@ -41,25 +44,47 @@ import (
 )
 
 func TestNewHyperlaneController(t *testing.T) {
+	deps := mocks.NewDependencies(t)
+
 	testCases := []struct {
-		name     string
-		logger   log.Logger
-		handler  forwardingtypes.HyperlaneHandler
-		expError string
+		name         string
+		logger       log.Logger
+		eventService event.Service
+		handler      forwardingtypes.HyperlaneHandler
+		resolver     forwardingtypes.HyperlaneTokenRouteResolver
+		expError     string
 	}{
 		{
-			name:    "success - valid controller creation",
-			logger:  log.NewNopLogger(),
-			handler: &mocks.HyperlaneHandler{},
+			name:         "success - valid controller creation",
+			logger:       log.NewNopLogger(),
+			eventService: deps.EventService,
+			handler:      &mocks.HyperlaneHandler{},
+			resolver:     mocks.HyperlaneTokenRouteResolver{},
 		},
 		{
 			name:     "error - nil logger",
 			expError: "logger cannot be nil",
 		},
 		{
-			name:     "error - when no Hyperlane handler is provided",
+			name:         "error - when no Hyperlane handler is provided",
+			logger:       log.NewNopLogger(),
+			eventService: deps.EventService,
+			resolver:     mocks.HyperlaneTokenRouteResolver{},
+			expError:     "handler",
+		},
+		{
+			name:     "error - when no event service is provided",
 			logger:   log.NewNopLogger(),
-			expError: "handler",
+			handler:  &mocks.HyperlaneHandler{},
+			resolver: mocks.HyperlaneTokenRouteResolver{},
+			expError: "event service",
+		},
+		{
+			name:         "error - when no token route resolver is provided",
+			logger:       log.NewNopLogger(),
+			eventService: deps.EventService,
+			handler:      &mocks.HyperlaneHandler{},
+			expError:     "token route resolver",
 		},
 	}
 
@@ -67,7 +92,9 @@ func TestNewHyperlaneController(t *testing.T) {
 		t.Run(tC.name, func(t *testing.T) {
 			controller, err := forwarding.NewHyperlaneController(
 				tC.logger,
+				tC.eventService,
 				tC.handler,
+				tC.resolver,
 			)
 
 			if tC.expError != "" {
@@ -144,7 +171,9 @@ func TestExtractAttributes_Hyperlane(t *testing.T) {
 		t.Run(tC.name, func(t *testing.T) {
 			controller, err := forwarding.NewHyperlaneController(
 				log.NewNopLogger(),
+				mocks.NewDependencies(t).EventService,
 				mocks.HyperlaneHandler{},
+				mocks.HyperlaneTokenRouteResolver{},
 			)
 			require.NoError(t, err)
 
@@ -225,6 +254,25 @@ func TestValidateForwarding_Hyperlane(t *testing.T) {
 			hypAttr:      &validHypAttr,
 			expError:     "invalid forwarding token",
 		},
+		{
+			name: "error - when the destination denom is an unsupported IBC voucher",
+			setup: func(m *mocks.HyperlaneHandler) {
+				m.Tokens[hypToken.Id] = hypToken
+			},
+			transferAttr: func() *core.TransferAttributes {
+				attr, err := core.NewTransferAttributes(
+					core.PROTOCOL_IBC,
+					"channel-0",
+					"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+					math.NewInt(1),
+				)
+				require.NoError(t, err)
+
+				return attr
+			}(),
+			hypAttr:  &validHypAttr,
+			expError: "for the IBC voucher",
+		},
 		{
 			name:         "error - when hyperlane attributes are nil",
 			setup:        func(m *mocks.HyperlaneHandler) {},
@@ -258,7 +306,9 @@ func TestValidateForwarding_Hyperlane(t *testing.T) {
 
 			controller, err := forwarding.NewHyperlaneController(
 				log.NewNopLogger(),
+				mocks.NewDependencies(t).EventService,
 				handler,
+				mocks.HyperlaneTokenRouteResolver{},
 			)
 			require.NoError(t, err)
 
@@ -384,7 +434,9 @@ func TestHandlePacket_Hyperlane(t *testing.T) {
 
 			controller, err := forwarding.NewHyperlaneController(
 				log.NewNopLogger(),
+				mocks.NewDependencies(t).EventService,
 				handler,
+				mocks.HyperlaneTokenRouteResolver{},
 			)
 			require.NoError(t, err)
 
@@ -398,3 +450,357 @@ func TestHandlePacket_Hyperlane(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlePacket_HyperlanePassthroughPayload(t *testing.T) {
+	usdnID := make([]byte, 32)
+	copy(usdnID, "usdn id")
+
+	tokenID := hyperlaneutil.HexAddress(usdnID)
+	mailboxID := hyperlaneutil.CreateMockHexAddress("mailbox", 1)
+
+	validTransfer, err := core.NewTransferAttributes(
+		core.PROTOCOL_IBC,
+		"channel-0",
+		"usdn",
+		math.NewInt(1),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		failing       bool
+		originMailbox string
+		maxFee        sdk.Coin
+		expError      string
+	}{
+		{
+			name:          "success - payload message dispatched after the transfer",
+			originMailbox: mailboxID.String(),
+			maxFee:        sdk.NewCoin("usdn", math.ZeroInt()),
+		},
+		{
+			name:          "success - payload message dispatched without a max fee",
+			originMailbox: mailboxID.String(),
+		},
+		{
+			name:          "error - invalid token mailbox",
+			originMailbox: "invalid",
+			expError:      "invalid Hyperlane token mailbox",
+		},
+		{
+			name:          "error - when the dispatch fails",
+			failing:       true,
+			originMailbox: mailboxID.String(),
+			expError:      "Hyperlane controller execution error",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			deps := mocks.NewDependencies(t)
+			ctx := deps.SdkCtx.WithValue(mocks.FailingContextKey, tC.failing)
+
+			handler := mocks.HyperlaneHandler{Tokens: map[string]warptypes.WrappedHypToken{
+				tokenID.String(): {
+					Id:            tokenID.String(),
+					OriginMailbox: tC.originMailbox,
+					OriginDenom:   "usdn",
+				},
+			}}
+
+			controller, err := forwarding.NewHyperlaneController(
+				log.NewNopLogger(),
+				deps.EventService,
+				handler,
+				mocks.HyperlaneTokenRouteResolver{},
+			)
+			require.NoError(t, err)
+
+			fwd, err := forwardingtypes.NewHyperlaneForwarding(
+				usdnID,
+				0,
+				make([]byte, 32),
+				nil,
+				"",
+				math.ZeroInt(),
+				sdk.NewCoin("usdn", math.ZeroInt()),
+				[]byte("payload"),
+			)
+			require.NoError(t, err)
+
+			// NOTE: the max fee is set on the cached attributes, since the
+			// encoding of the attributes replaces an unset amount with zero.
+			cached, err := fwd.CachedAttributes()
+			require.NoError(t, err)
+			hypAttr, ok := cached.(*forwardingtypes.HypAttributes)
+			require.True(t, ok)
+			hypAttr.MaxFee = tC.maxFee
+
+			err = controller.HandlePacket(ctx, &types.ForwardingPacket{
+				TransferAttributes: validTransfer,
+				Forwarding:         fwd,
+			})
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Equal(
+				t,
+				proto.MessageName(&forwardingtypes.EventHyperlanePayloadMessageSent{}),
+				events[0].Type,
+			)
+			for _, attr := range events[0].Attributes {
+				switch attr.Key {
+				case "transfer_message_id":
+					require.Contains(t, attr.Value, mocks.HyperlaneTransferMessageID.String())
+				case "payload_message_id":
+					require.Contains(t, attr.Value, mocks.HyperlanePayloadMessageID.String())
+				}
+			}
+		})
+	}
+}
+
+func TestHandlePacket_HyperlaneAutoFee(t *testing.T) {
+	usdnID := make([]byte, 32)
+	copy(usdnID, "usdn id")
+
+	tokenID := hyperlaneutil.HexAddress(usdnID)
+
+	testCases := []struct {
+		name               string
+		gasPayment         sdk.Coins
+		maxFee             sdk.Coin
+		passthroughPayload []byte
+		expAmount          math.Int
+		expFees            sdk.Coins
+		expDeducted        bool
+		expError           string
+	}{
+		{
+			name:        "success - fee deducted from the transfer with the same denom",
+			gasPayment:  sdk.NewCoins(sdk.NewInt64Coin("usdn", 10)),
+			maxFee:      sdk.NewInt64Coin("usdn", 0),
+			expAmount:   math.NewInt(90),
+			expFees:     sdk.NewCoins(sdk.NewInt64Coin("usdn", 10)),
+			expDeducted: true,
+		},
+		{
+			name:       "success - fee with a different denom paid by the module",
+			gasPayment: sdk.NewCoins(sdk.NewInt64Coin("unoble", 10)),
+			maxFee:     sdk.NewInt64Coin("unoble", 10),
+			expAmount:  math.NewInt(100),
+			expFees:    sdk.NewCoins(sdk.NewInt64Coin("unoble", 10)),
+		},
+		{
+			name:      "success - no fee quoted",
+			maxFee:    sdk.NewInt64Coin("usdn", 0),
+			expAmount: math.NewInt(100),
+		},
+		{
+			name:       "error - quoted fee exceeds the max fee",
+			gasPayment: sdk.NewCoins(sdk.NewInt64Coin("usdn", 11)),
+			maxFee:     sdk.NewInt64Coin("usdn", 10),
+			expError:   "exceeds the max fee",
+		},
+		{
+			name:       "error - quoted fee not lower than the transfer amount",
+			gasPayment: sdk.NewCoins(sdk.NewInt64Coin("usdn", 100)),
+			maxFee:     sdk.NewInt64Coin("usdn", 0),
+			expError:   "is not lower than the transfer amount",
+		},
+		{
+			name: "error - quoted fee with multiple denoms",
+			gasPayment: sdk.NewCoins(
+				sdk.NewInt64Coin("unoble", 10),
+				sdk.NewInt64Coin("usdn", 10),
+			),
+			maxFee:   sdk.NewInt64Coin("usdn", 0),
+			expError: "must have a single denom",
+		},
+		{
+			name:               "error - auto fee with a passthrough payload",
+			maxFee:             sdk.NewInt64Coin("usdn", 0),
+			passthroughPayload: []byte("payload"),
+			expError:           "not supported with a passthrough payload",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			deps := mocks.NewDependencies(t)
+			ctx := deps.SdkCtx
+
+			handler := mocks.HyperlaneHandler{
+				Tokens: map[string]warptypes.WrappedHypToken{
+					tokenID.String(): {Id: tokenID.String(), OriginDenom: "usdn"},
+				},
+				GasPayment: tC.gasPayment,
+			}
+
+			controller, err := forwarding.NewHyperlaneController(
+				log.NewNopLogger(),
+				deps.EventService,
+				handler,
+				mocks.HyperlaneTokenRouteResolver{},
+			)
+			require.NoError(t, err)
+
+			attr, err := forwardingtypes.NewHyperlaneAttributes(
+				usdnID,
+				0,
+				make([]byte, 32),
+				nil,
+				"",
+				math.ZeroInt(),
+				tC.maxFee,
+			)
+			require.NoError(t, err)
+			attr.AutoFee = true
+
+			fwd, err := core.NewForwarding(core.PROTOCOL_HYPERLANE, attr, tC.passthroughPayload)
+			require.NoError(t, err)
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_IBC,
+				"channel-0",
+				"usdn",
+				math.NewInt(100),
+			)
+			require.NoError(t, err)
+
+			err = controller.HandlePacket(ctx, &types.ForwardingPacket{
+				TransferAttributes: transferAttr,
+				Forwarding:         fwd,
+			})
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+				require.Equal(t, math.NewInt(100), transferAttr.DestinationAmount())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.expAmount, transferAttr.DestinationAmount())
+			require.Equal(t, tC.expFees, transferAttr.ForwardingFees())
+
+			events := ctx.EventManager().Events()
+			if tC.expFees.IsZero() {
+				require.Empty(t, events)
+
+				return
+			}
+			require.Len(t, events, 1)
+			require.Equal(
+				t,
+				proto.MessageName(&forwardingtypes.EventHyperlaneFeePaid{}),
+				events[0].Type,
+			)
+			for _, a := range events[0].Attributes {
+				if a.Key == "deducted" {
+					require.Equal(t, fmt.Sprint(tC.expDeducted), a.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestHandlePacket_HyperlaneTokenRoute(t *testing.T) {
+	usdnID := make([]byte, 32)
+	copy(usdnID, "usdn id")
+
+	tokenID := hyperlaneutil.HexAddress(usdnID)
+
+	validTransfer, err := core.NewTransferAttributes(
+		core.PROTOCOL_IBC,
+		"channel-0",
+		"usdn",
+		math.NewInt(1),
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		failing  bool
+		resolver mocks.HyperlaneTokenRouteResolver
+		expError string
+	}{
+		{
+			name:     "success - token ID resolved from the registered route",
+			resolver: mocks.HyperlaneTokenRouteResolver{"usdn/1": usdnID},
+		},
+		{
+			name:     "error - no route registered for the destination domain",
+			resolver: mocks.HyperlaneTokenRouteResolver{"usdn/2": usdnID},
+			expError: "no Hyperlane token route registered for usdn to domain 1",
+		},
+		{
+			name:     "error - no route registered for the denom",
+			resolver: mocks.HyperlaneTokenRouteResolver{"uusdc/1": usdnID},
+			expError: "no Hyperlane token route registered for usdn to domain 1",
+		},
+		{
+			name:     "error - when the resolver fails",
+			failing:  true,
+			resolver: mocks.HyperlaneTokenRouteResolver{"usdn/1": usdnID},
+			expError: "error resolving Hyperlane token route",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			deps := mocks.NewDependencies(t)
+			ctx := deps.SdkCtx.WithValue(mocks.FailingContextKey, tC.failing)
+
+			handler := mocks.HyperlaneHandler{Tokens: map[string]warptypes.WrappedHypToken{
+				tokenID.String(): {
+					Id:          tokenID.String(),
+					OriginDenom: "usdn",
+				},
+			}}
+
+			controller, err := forwarding.NewHyperlaneController(
+				log.NewNopLogger(),
+				deps.EventService,
+				handler,
+				tC.resolver,
+			)
+			require.NoError(t, err)
+
+			fwd, err := forwardingtypes.NewHyperlaneForwarding(
+				nil,
+				1,
+				make([]byte, 32),
+				nil,
+				"",
+				math.ZeroInt(),
+				sdk.NewCoin("usdn", math.ZeroInt()),
+				nil,
+			)
+			require.NoError(t, err)
+
+			err = controller.HandlePacket(ctx, &types.ForwardingPacket{
+				TransferAttributes: validTransfer,
+				Forwarding:         fwd,
+			})
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+
+			// The resolved token ID must not be written in the packet attributes.
+			hypAttr, err := controller.ExtractAttributes(fwd)
+			require.NoError(t, err)
+			require.Empty(t, hypAttr.TokenId)
+		})
+	}
+}
@@ -0,0 +1,227 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarding
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/noble-assets/orbiter/v2/controller"
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var _ types.ForwardingController = &IBCController{}
+
+// IBCController is the forwarding controller for the IBC protocol. Outgoing
+// transfers are sent from the module account and tracked until they are
+// acknowledged or timed out.
+type IBCController struct {
+	*controller.BaseController[core.ProtocolID]
+
+	logger     log.Logger
+	handler    forwardingtypes.IBCHandler
+	bankKeeper forwardingtypes.IBCBankKeeper
+	tracker    types.InFlightPacketTracker
+}
+
+// NewIBCController returns a validated instance of the IBC controller.
+func NewIBCController(
+	logger log.Logger,
+	handler forwardingtypes.IBCHandler,
+	bankKeeper forwardingtypes.IBCBankKeeper,
+	tracker types.InFlightPacketTracker,
+) (*IBCController, error) {
+	if logger == nil {
+		return nil, core.ErrNilPointer.Wrap("logger cannot be nil")
+	}
+
+	b, err := controller.NewBase(core.PROTOCOL_IBC)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error creating base controller for IBC controller")
+	}
+	c := &IBCController{
+		BaseController: b,
+		logger:         logger.With(core.ForwardingControllerName, b.Name()),
+		handler:        handler,
+		bankKeeper:     bankKeeper,
+		tracker:        tracker,
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Validate returns an error if any of the IBC controller's field is not valid.
+func (c *IBCController) Validate() error {
+	if c.logger == nil {
+		return core.ErrNilPointer.Wrap("logger is required for the IBC controller")
+	}
+	if c.BaseController == nil {
+		return core.ErrNilPointer.Wrap("base controller is required for the IBC controller")
+	}
+	if c.handler == nil {
+		return core.ErrNilPointer.Wrap("handler is required for the IBC controller")
+	}
+	if c.bankKeeper == nil {
+		return core.ErrNilPointer.Wrap("bank keeper is required for the IBC controller")
+	}
+	if c.tracker == nil {
+		return core.ErrNilPointer.Wrap("tracker is required for the IBC controller")
+	}
+
+	return nil
+}
+
+// HandlePacket implements types.ForwardingController.
+func (c *IBCController) HandlePacket(
+	ctx context.Context,
+	packet *types.ForwardingPacket,
+) error {
+	c.logger.Debug("handling IBC packet")
+
+	if packet == nil {
+		return core.ErrNilPointer.Wrap("IBC controller received nil packet")
+	}
+
+	attr, err := c.ExtractAttributes(packet.Forwarding)
+	if err != nil {
+		return errorsmod.Wrap(err, "error extracting IBC forwarding attributes")
+	}
+
+	if err := c.ValidateForwarding(ctx, packet.TransferAttributes, attr); err != nil {
+		return core.ErrValidation.Wrapf("invalid IBC forwarding: %s", err.Error())
+	}
+
+	if err := c.executeForwarding(
+		ctx,
+		packet.TransferAttributes,
+		attr,
+		packet.Forwarding.PassthroughPayload,
+	); err != nil {
+		return errorsmod.Wrap(err, "IBC controller execution error")
+	}
+
+	return nil
+}
+
+// ExtractAttributes returns the IBC attributes from the forwarding or an error.
+func (c *IBCController) ExtractAttributes(
+	forwarding *core.Forwarding,
+) (*forwardingtypes.IBCAttributes, error) {
+	attr, err := forwarding.CachedAttributes()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error extracting cached attributes")
+	}
+
+	ibcAttr, ok := attr.(*forwardingtypes.IBCAttributes)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf(
+			"expected %T, got %T",
+			&forwardingtypes.IBCAttributes{},
+			attr,
+		)
+	}
+
+	return ibcAttr, nil
+}
+
+// ValidateForwarding checks whether the forwarding attributes are valid or not.
+func (c *IBCController) ValidateForwarding(
+	_ context.Context,
+	transferAttr *core.TransferAttributes,
+	ibcAttr *forwardingtypes.IBCAttributes,
+) error {
+	if err := transferAttr.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid transfer attributes")
+	}
+
+	if err := ibcAttr.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid IBC forwarding attributes")
+	}
+
+	// NOTE: a blocked refund recipient would make the refund fail
+	// when the packet is acknowledged with an error or timed out.
+	refundRecipient := sdk.MustAccAddressFromBech32(ibcAttr.RefundRecipient)
+	if c.bankKeeper.BlockedAddr(refundRecipient) {
+		return errors.New("refund recipient is not allowed to receive funds")
+	}
+
+	return nil
+}
+
+// executeForwarding sends the ICS-20 transfer from the module account and
+// tracks the packet as in-flight. The passthrough payload is used as the
+// transfer memo.
+func (c *IBCController) executeForwarding(
+	ctx context.Context,
+	transferAttr *core.TransferAttributes,
+	ibcAttr *forwardingtypes.IBCAttributes,
+	passthroughPayload []byte,
+) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeout := sdkCtx.BlockTime().Add(ibcAttr.Timeout())
+
+	coin := sdk.NewCoin(transferAttr.DestinationDenom(), transferAttr.DestinationAmount())
+
+	resp, err := c.handler.Transfer(ctx, &transfertypes.MsgTransfer{
+		SourcePort:       transfertypes.PortID,
+		SourceChannel:    ibcAttr.ChannelId,
+		Token:            coin,
+		Sender:           core.ModuleAddress.String(),
+		Receiver:         ibcAttr.Receiver,
+		TimeoutHeight:    clienttypes.ZeroHeight(),
+		TimeoutTimestamp: uint64(timeout.UnixNano()), //nolint:gosec
+		Memo:             string(passthroughPayload),
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "error executing IBC transfer")
+	}
+
+	inFlight, err := forwardertypes.NewInFlightPacket(
+		ibcAttr.ChannelId,
+		resp.Sequence,
+		coin,
+		ibcAttr.Receiver,
+		ibcAttr.RefundRecipient,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating in-flight packet")
+	}
+
+	if err := c.tracker.SetInFlightPacket(ctx, inFlight); err != nil {
+		return errorsmod.Wrap(err, "error tracking in-flight packet")
+	}
+
+	return nil
+}
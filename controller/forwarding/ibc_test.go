@@ -0,0 +1,252 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarding_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestNewIBCController(t *testing.T) {
+	f, _ := mocks.NewForwarderComponent(t)
+
+	bk := mocks.NewBankKeeper()
+
+	testCases := []struct {
+		name       string
+		logger     log.Logger
+		handler    forwardingtypes.IBCHandler
+		bankKeeper forwardingtypes.IBCBankKeeper
+		tracker    types.InFlightPacketTracker
+		expError   string
+	}{
+		{
+			name:       "success - valid controller creation",
+			logger:     log.NewNopLogger(),
+			handler:    mocks.NewIBCHandler(),
+			bankKeeper: bk,
+			tracker:    f,
+		},
+		{
+			name:       "error - nil logger",
+			handler:    mocks.NewIBCHandler(),
+			bankKeeper: bk,
+			tracker:    f,
+			expError:   "logger cannot be nil",
+		},
+		{
+			name:       "error - when no handler is provided",
+			logger:     log.NewNopLogger(),
+			bankKeeper: bk,
+			tracker:    f,
+			expError:   "handler is required",
+		},
+		{
+			name:     "error - when no bank keeper is provided",
+			logger:   log.NewNopLogger(),
+			handler:  mocks.NewIBCHandler(),
+			tracker:  f,
+			expError: "bank keeper is required",
+		},
+		{
+			name:       "error - when no tracker is provided",
+			logger:     log.NewNopLogger(),
+			handler:    mocks.NewIBCHandler(),
+			bankKeeper: bk,
+			expError:   "tracker is required",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			controller, err := forwarding.NewIBCController(
+				tC.logger,
+				tC.handler,
+				tC.bankKeeper,
+				tC.tracker,
+			)
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+				require.Nil(t, controller)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, controller)
+			}
+		})
+	}
+}
+
+func TestHandlePacket_IBC(t *testing.T) {
+	refundRecipient := testutil.NewNobleAddress()
+	receiver := "cosmos1receiver"
+	memo := []byte(`{"wasm":{}}`)
+
+	validForwarding, err := forwardingtypes.NewIBCForwarding(
+		"channel-1",
+		receiver,
+		60,
+		refundRecipient,
+		memo,
+	)
+	require.NoError(t, err)
+
+	validTransfer, err := core.NewTransferAttributes(
+		core.PROTOCOL_CCTP,
+		"0",
+		"uusdc",
+		math.NewInt(100),
+	)
+	require.NoError(t, err)
+
+	internalForwarding, err := forwardingtypes.NewInternalForwarding(refundRecipient)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		setup    func(context.Context) context.Context
+		blocked  bool
+		packet   func() *types.ForwardingPacket
+		expError string
+	}{
+		{
+			name:     "error - when the forwarding packet is nil",
+			packet:   func() *types.ForwardingPacket { return nil },
+			expError: "IBC controller received nil packet",
+		},
+		{
+			name: "error - when forwarding is not an IBC forwarding",
+			packet: func() *types.ForwardingPacket {
+				return &types.ForwardingPacket{
+					TransferAttributes: validTransfer,
+					Forwarding:         internalForwarding,
+				}
+			},
+			expError: "error extracting IBC forwarding attributes",
+		},
+		{
+			name: "error - when transfer attributes are default values",
+			packet: func() *types.ForwardingPacket {
+				return &types.ForwardingPacket{
+					TransferAttributes: &core.TransferAttributes{},
+					Forwarding:         validForwarding,
+				}
+			},
+			expError: "invalid IBC forwarding",
+		},
+		{
+			name:    "error - when the refund recipient is blocked",
+			blocked: true,
+			packet: func() *types.ForwardingPacket {
+				return &types.ForwardingPacket{
+					TransferAttributes: validTransfer,
+					Forwarding:         validForwarding,
+				}
+			},
+			expError: "refund recipient is not allowed to receive funds",
+		},
+		{
+			name: "error - when the transfer fails",
+			setup: func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, mocks.FailingContextKey, true)
+			},
+			packet: func() *types.ForwardingPacket {
+				return &types.ForwardingPacket{
+					TransferAttributes: validTransfer,
+					Forwarding:         validForwarding,
+				}
+			},
+			expError: "error executing IBC transfer",
+		},
+		{
+			name: "success",
+			packet: func() *types.ForwardingPacket {
+				return &types.ForwardingPacket{
+					TransferAttributes: validTransfer,
+					Forwarding:         validForwarding,
+				}
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			f, deps := mocks.NewForwarderComponent(t)
+			handler := mocks.NewIBCHandler()
+
+			ctx := context.Context(deps.SdkCtx)
+			if tC.setup != nil {
+				ctx = tC.setup(ctx)
+			}
+
+			bk := mocks.NewBankKeeper()
+			bk.Blocked[refundRecipient] = tC.blocked
+
+			controller, err := forwarding.NewIBCController(log.NewNopLogger(), handler, bk, f)
+			require.NoError(t, err)
+
+			err = controller.HandlePacket(ctx, tC.packet())
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+				require.Empty(t, handler.Transfers)
+			} else {
+				require.NoError(t, err)
+				require.Len(t, handler.Transfers, 1)
+
+				msg := handler.Transfers[0]
+				expCoin := sdk.NewCoin(
+					validTransfer.DestinationDenom(),
+					validTransfer.DestinationAmount(),
+				)
+				require.Equal(t, core.ModuleAddress.String(), msg.Sender)
+				require.Equal(t, "channel-1", msg.SourceChannel)
+				require.Equal(t, receiver, msg.Receiver)
+				require.Equal(t, expCoin, msg.Token)
+				require.Equal(t, string(memo), msg.Memo)
+				require.Equal(
+					t,
+					uint64(deps.SdkCtx.BlockTime().Unix()+60),
+					msg.TimeoutTimestamp/1e9,
+				)
+
+				packet, found, err := f.GetInFlightPacket(deps.SdkCtx, "channel-1", 1)
+				require.NoError(t, err)
+				require.True(t, found)
+				require.Equal(t, expCoin, packet.Coin)
+				require.Equal(t, refundRecipient, packet.RefundRecipient)
+			}
+		})
+	}
+}
@@ -21,8 +21,10 @@
 package orbiter
 
 import (
+	hyperlanekeeper "github.com/bcp-innovations/hyperlane-cosmos/x/core/keeper"
 	warpkeeper "github.com/bcp-innovations/hyperlane-cosmos/x/warp/keeper"
 	cctpkeeper "github.com/circlefin/noble-cctp/x/cctp/keeper"
+	transferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
@@ -39,9 +41,12 @@ import (
 	actionctrl "github.com/noble-assets/orbiter/v2/controller/action"
 	adapterctrl "github.com/noble-assets/orbiter/v2/controller/adapter"
 	forwardingctrl "github.com/noble-assets/orbiter/v2/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/entrypoint"
 	"github.com/noble-assets/orbiter/v2/keeper"
 	"github.com/noble-assets/orbiter/v2/types"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
 	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
 )
 
 func init() {
@@ -99,20 +104,27 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 type ComponentsInputs struct {
 	Orbiters *keeper.Keeper
 
-	BankKeeper bankkeeper.Keeper
-	CCTPKeeper *cctpkeeper.Keeper
-	WarpKeeper warpkeeper.Keeper
+	BankKeeper      bankkeeper.Keeper
+	CCTPKeeper      *cctpkeeper.Keeper
+	HyperlaneKeeper *hyperlanekeeper.Keeper
+	WarpKeeper      warpkeeper.Keeper
+	TransferKeeper  transferkeeper.Keeper
+	// SwapKeeper is the optional adapter of the Noble swap module. The
+	// swap action controller is registered only when it is set.
+	SwapKeeper actiontypes.SwapKeeper
 }
 
 func InjectComponents(in ComponentsInputs) {
 	InjectActionControllers(in)
 	InjectForwardingControllers(in)
 	InjectAdapterControllers(in)
+	InjectEntrypoints(in)
 }
 
 func InjectForwardingControllers(in ComponentsInputs) {
 	cctp, err := forwardingctrl.NewCCTPController(
 		in.Orbiters.Forwarder().Logger(),
+		in.Orbiters.Forwarder().EventService(),
 		cctpkeeper.NewMsgServerImpl(in.CCTPKeeper),
 	)
 	if err != nil {
@@ -121,10 +133,13 @@ func InjectForwardingControllers(in ComponentsInputs) {
 
 	hyperlane, err := forwardingctrl.NewHyperlaneController(
 		in.Orbiters.Forwarder().Logger(),
+		in.Orbiters.Forwarder().EventService(),
 		forwardingtypes.NewHyperlaneHandler(
 			warpkeeper.NewMsgServerImpl(in.WarpKeeper),
 			warpkeeper.NewQueryServerImpl(in.WarpKeeper),
+			in.HyperlaneKeeper,
 		),
+		in.Orbiters.Forwarder(),
 	)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "error creating Hyperlane controller"))
@@ -138,7 +153,17 @@ func InjectForwardingControllers(in ComponentsInputs) {
 		panic(errorsmod.Wrap(err, "error creating internal controller"))
 	}
 
-	if err := in.Orbiters.SetForwardingControllers(cctp, hyperlane, internal); err != nil {
+	ibc, err := forwardingctrl.NewIBCController(
+		in.Orbiters.Forwarder().Logger(),
+		in.TransferKeeper,
+		in.BankKeeper,
+		in.Orbiters.Forwarder(),
+	)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error creating IBC controller"))
+	}
+
+	if err := in.Orbiters.SetForwardingControllers(cctp, hyperlane, internal, ibc); err != nil {
 		panic(errorsmod.Wrap(err, "error setting forwarder controllers"))
 	}
 }
@@ -153,7 +178,44 @@ func InjectActionControllers(in ComponentsInputs) {
 		panic(errorsmod.Wrap(err, "error creating fee controller"))
 	}
 
-	if err := in.Orbiters.SetActionControllers(fee); err != nil {
+	routeFee, err := actionctrl.NewRouteFeeController(
+		in.Orbiters.Executor().Logger(),
+		in.Orbiters.Executor().EventService(),
+		in.BankKeeper,
+	)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error creating route fee controller"))
+	}
+
+	integratorFee, err := actionctrl.NewIntegratorFeeController(
+		in.Orbiters.Executor().Logger(),
+		in.Orbiters.Executor().EventService(),
+		in.BankKeeper,
+		in.Orbiters.Executor(),
+	)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error creating integrator fee controller"))
+	}
+
+	deadline, err := actionctrl.NewDeadlineController(in.Orbiters.Executor().Logger())
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error creating deadline controller"))
+	}
+
+	controllers := []types.ActionController{fee, routeFee, integratorFee, deadline}
+	if in.SwapKeeper != nil {
+		swap, err := actionctrl.NewSwapController(
+			in.Orbiters.Executor().Logger(),
+			in.Orbiters.Executor().EventService(),
+			in.SwapKeeper,
+		)
+		if err != nil {
+			panic(errorsmod.Wrap(err, "error creating swap controller"))
+		}
+		controllers = append(controllers, swap)
+	}
+
+	if err := in.Orbiters.SetActionControllers(controllers...); err != nil {
 		panic(errorsmod.Wrap(err, "error setting action controllers"))
 	}
 }
@@ -167,7 +229,57 @@ func InjectAdapterControllers(in ComponentsInputs) {
 		panic(errorsmod.Wrap(err, "error creating IBC adapter"))
 	}
 
-	if err := in.Orbiters.SetAdapterControllers(ibc); err != nil {
+	cctp, err := adapterctrl.NewCCTPAdapter(
+		in.Orbiters.Codec(),
+		in.Orbiters.Adapter().Logger(),
+		adapterctrl.PayloadEncodingABI,
+	)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error creating CCTP adapter"))
+	}
+
+	hyperlane, err := adapterctrl.NewHyperlaneAdapter(
+		in.Orbiters.Codec(),
+		in.Orbiters.Adapter().Logger(),
+		adapterctrl.PayloadEncodingBinary,
+	)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error creating Hyperlane adapter"))
+	}
+
+	if err := in.Orbiters.SetAdapterControllers(ibc, cctp, hyperlane); err != nil {
 		panic(errorsmod.Wrap(err, "error setting adapter controllers"))
 	}
 }
+
+func InjectEntrypoints(in ComponentsInputs) {
+	cctp, err := entrypoint.NewCCTPEntrypoint(
+		in.Orbiters.Adapter(),
+		cctpkeeper.NewMsgServerImpl(in.CCTPKeeper),
+		in.CCTPKeeper,
+	)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error creating CCTP entrypoint"))
+	}
+
+	if err := in.Orbiters.SetCCTPEntrypoint(cctp); err != nil {
+		panic(errorsmod.Wrap(err, "error setting CCTP entrypoint"))
+	}
+
+	hyperlane, err := entrypoint.NewHyperlaneEntrypoint(
+		in.Orbiters.Adapter(),
+		in.HyperlaneKeeper,
+		warpkeeper.NewQueryServerImpl(in.WarpKeeper),
+	)
+	if err != nil {
+		panic(errorsmod.Wrap(err, "error creating Hyperlane entrypoint"))
+	}
+
+	// The entrypoint is registered as a Hyperlane application to receive
+	// the messages containing the orbiter payload.
+	in.HyperlaneKeeper.AppRouter().RegisterModule(entrypointtypes.HyperlaneAppModuleType, hyperlane)
+
+	if err := in.Orbiters.SetHyperlaneEntrypoint(hyperlane); err != nil {
+		panic(errorsmod.Wrap(err, "error setting Hyperlane entrypoint"))
+	}
+}
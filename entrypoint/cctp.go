@@ -0,0 +1,168 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package entrypoint
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
+)
+
+var _ entrypointtypes.CCTPEntrypoint = &CCTPEntrypoint{}
+
+// CCTPEntrypoint is the entrypoint used to receive a CCTP transfer along
+// with the general message containing the orbiter payload, as sent by the
+// OrbiterGatewayCCTP contract. Both messages are received in the same
+// transaction to guarantee that the minted funds are always processed with
+// the associated payload.
+type CCTPEntrypoint struct {
+	payloadAdapter types.PayloadAdapter
+	cctpServer     entrypointtypes.CCTPMessageReceiver
+	tokenPairs     entrypointtypes.CCTPTokenPairProvider
+}
+
+// NewCCTPEntrypoint returns a reference to a new CCTPEntrypoint instance.
+func NewCCTPEntrypoint(
+	payloadAdapter types.PayloadAdapter,
+	cctpServer entrypointtypes.CCTPMessageReceiver,
+	tokenPairs entrypointtypes.CCTPTokenPairProvider,
+) (*CCTPEntrypoint, error) {
+	if payloadAdapter == nil {
+		return nil, core.ErrNilPointer.Wrap("payload adapter is not set")
+	}
+	if cctpServer == nil {
+		return nil, core.ErrNilPointer.Wrap("CCTP server is not set")
+	}
+	if tokenPairs == nil {
+		return nil, core.ErrNilPointer.Wrap("CCTP token pairs provider is not set")
+	}
+
+	return &CCTPEntrypoint{
+		payloadAdapter: payloadAdapter,
+		cctpServer:     cctpServer,
+		tokenPairs:     tokenPairs,
+	}, nil
+}
+
+// ReceiveCCTPMessages implements entrypointtypes.CCTPEntrypoint.
+func (e *CCTPEntrypoint) ReceiveCCTPMessages(
+	ctx context.Context,
+	msg *entrypointtypes.MsgReceiveCCTPMessages,
+) (*entrypointtypes.MsgReceiveCCTPMessagesResponse, error) {
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	ccID, ccPacket, err := e.newCrossChainPacket(ctx, msg.TransferMessage, msg.PayloadMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	orbiterPacket, err := e.payloadAdapter.AdaptPacket(ctx, ccID, ccPacket)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error adapting CCTP packet")
+	}
+
+	if err := e.payloadAdapter.BeforeTransferHook(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	if _, err := e.cctpServer.ReceiveMessage(ctx, &cctptypes.MsgReceiveMessage{
+		From:        msg.Signer,
+		Message:     msg.TransferMessage,
+		Attestation: msg.TransferAttestation,
+	}); err != nil {
+		return nil, errorsmod.Wrap(err, "error receiving CCTP transfer message")
+	}
+
+	if _, err := e.cctpServer.ReceiveMessage(ctx, &cctptypes.MsgReceiveMessage{
+		From:        msg.Signer,
+		Message:     msg.PayloadMessage,
+		Attestation: msg.PayloadAttestation,
+	}); err != nil {
+		return nil, errorsmod.Wrap(err, "error receiving CCTP payload message")
+	}
+
+	if err := e.payloadAdapter.AfterTransferHook(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	if err := e.payloadAdapter.ProcessPayload(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	return &entrypointtypes.MsgReceiveCCTPMessagesResponse{}, nil
+}
+
+// newCrossChainPacket returns the cross-chain ID of the source domain and the
+// cross-chain packet built from the CCTP messages. The Noble denom minted by
+// the transfer is retrieved from the CCTP token pairs.
+func (e *CCTPEntrypoint) newCrossChainPacket(
+	ctx context.Context,
+	transferMessage, payloadMessage []byte,
+) (core.CrossChainID, *adaptertypes.CCTPCrossChainPacket, error) {
+	transferMsg, err := new(cctptypes.Message).Parse(transferMessage)
+	if err != nil {
+		return core.CrossChainID{}, nil, errorsmod.Wrap(err, "invalid transfer message")
+	}
+
+	burnMsg, err := new(cctptypes.BurnMessage).Parse(transferMsg.MessageBody)
+	if err != nil {
+		return core.CrossChainID{}, nil, errorsmod.Wrap(err, "invalid burn message")
+	}
+
+	tokenPair, found := e.tokenPairs.GetTokenPair(ctx, transferMsg.SourceDomain, burnMsg.BurnToken)
+	if !found {
+		return core.CrossChainID{}, nil, sdkerrors.ErrNotFound.Wrapf(
+			"token pair not found for source domain %d",
+			transferMsg.SourceDomain,
+		)
+	}
+
+	ccID, err := core.NewCrossChainID(
+		core.PROTOCOL_CCTP,
+		strconv.FormatUint(uint64(transferMsg.SourceDomain), 10),
+	)
+	if err != nil {
+		return core.CrossChainID{}, nil, err
+	}
+
+	ccPacket, err := adaptertypes.NewCCTPCrossChainPacket(
+		strings.ToLower(tokenPair.LocalToken),
+		transferMessage,
+		payloadMessage,
+	)
+	if err != nil {
+		return core.CrossChainID{}, nil, err
+	}
+
+	return ccID, ccPacket, nil
+}
@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package entrypoint
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	hyperlanetypes "github.com/bcp-innovations/hyperlane-cosmos/x/core/types"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
+)
+
+var (
+	_ entrypointtypes.HyperlaneEntrypoint = &HyperlaneEntrypoint{}
+	_ hyperlaneutil.HyperlaneApp          = &HyperlaneEntrypoint{}
+)
+
+// hyperlanePayloadMessageKey is the context key used to store the ID of
+// the payload message under processing.
+type hyperlanePayloadMessageKey struct{}
+
+// HyperlaneEntrypoint is the entrypoint used to receive a Hyperlane warp
+// transfer along with the message containing the orbiter payload. Both
+// messages are received in the same transaction to guarantee that the funds
+// are always processed with the associated payload.
+//
+// The entrypoint is also the Hyperlane application receiving the payload
+// messages, which are accepted only when delivered via the entrypoint.
+type HyperlaneEntrypoint struct {
+	payloadAdapter types.PayloadAdapter
+	processor      entrypointtypes.HyperlaneMessageProcessor
+	tokens         entrypointtypes.HyperlaneTokenProvider
+}
+
+// NewHyperlaneEntrypoint returns a reference to a new HyperlaneEntrypoint instance.
+func NewHyperlaneEntrypoint(
+	payloadAdapter types.PayloadAdapter,
+	processor entrypointtypes.HyperlaneMessageProcessor,
+	tokens entrypointtypes.HyperlaneTokenProvider,
+) (*HyperlaneEntrypoint, error) {
+	if payloadAdapter == nil {
+		return nil, core.ErrNilPointer.Wrap("payload adapter is not set")
+	}
+	if processor == nil {
+		return nil, core.ErrNilPointer.Wrap("Hyperlane message processor is not set")
+	}
+	if tokens == nil {
+		return nil, core.ErrNilPointer.Wrap("Hyperlane token provider is not set")
+	}
+
+	return &HyperlaneEntrypoint{
+		payloadAdapter: payloadAdapter,
+		processor:      processor,
+		tokens:         tokens,
+	}, nil
+}
+
+// ReceiveHyperlaneMessages implements entrypointtypes.HyperlaneEntrypoint.
+func (e *HyperlaneEntrypoint) ReceiveHyperlaneMessages(
+	ctx context.Context,
+	msg *entrypointtypes.MsgReceiveHyperlaneMessages,
+) (*entrypointtypes.MsgReceiveHyperlaneMessagesResponse, error) {
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	mailboxID, err := hyperlaneutil.DecodeHexAddress(msg.MailboxId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid mailbox ID: %s", err)
+	}
+
+	payloadMsg, err := hyperlaneutil.ParseHyperlaneMessage(msg.PayloadMessage)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid payload message")
+	}
+
+	ccID, ccPacket, err := e.newCrossChainPacket(ctx, msg.TransferMessage, msg.PayloadMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	orbiterPacket, err := e.payloadAdapter.AdaptPacket(ctx, ccID, ccPacket)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error adapting Hyperlane packet")
+	}
+
+	if err := e.payloadAdapter.BeforeTransferHook(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := e.processor.ProcessMessage(
+		sdkCtx,
+		mailboxID,
+		msg.TransferMessage,
+		msg.TransferMetadata,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "error receiving Hyperlane transfer message")
+	}
+
+	if err := e.processor.ProcessMessage(
+		sdkCtx.WithValue(hyperlanePayloadMessageKey{}, payloadMsg.Id()),
+		mailboxID,
+		msg.PayloadMessage,
+		msg.PayloadMetadata,
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "error receiving Hyperlane payload message")
+	}
+
+	if err := e.payloadAdapter.AfterTransferHook(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	if err := e.payloadAdapter.ProcessPayload(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	return &entrypointtypes.MsgReceiveHyperlaneMessagesResponse{}, nil
+}
+
+// newCrossChainPacket returns the cross-chain ID of the origin domain and the
+// cross-chain packet built from the Hyperlane messages. The Noble denom of the
+// transfer is retrieved from the warp token receiving the transfer message.
+func (e *HyperlaneEntrypoint) newCrossChainPacket(
+	ctx context.Context,
+	transferMessage, payloadMessage []byte,
+) (core.CrossChainID, *adaptertypes.HyperlaneCrossChainPacket, error) {
+	transferMsg, err := hyperlaneutil.ParseHyperlaneMessage(transferMessage)
+	if err != nil {
+		return core.CrossChainID{}, nil, errorsmod.Wrap(err, "invalid transfer message")
+	}
+
+	resp, err := e.tokens.Token(ctx, &warptypes.QueryTokenRequest{
+		Id: transferMsg.Recipient.String(),
+	})
+	if err != nil {
+		return core.CrossChainID{}, nil, errorsmod.Wrap(err, "error retrieving warp token")
+	}
+	if resp == nil || resp.Token == nil {
+		return core.CrossChainID{}, nil, core.ErrNilPointer.Wrap("warp token response")
+	}
+
+	ccID, err := core.NewCrossChainID(
+		core.PROTOCOL_HYPERLANE,
+		strconv.FormatUint(uint64(transferMsg.Origin), 10),
+	)
+	if err != nil {
+		return core.CrossChainID{}, nil, err
+	}
+
+	ccPacket, err := adaptertypes.NewHyperlaneCrossChainPacket(
+		resp.Token.OriginDenom,
+		transferMessage,
+		payloadMessage,
+	)
+	if err != nil {
+		return core.CrossChainID{}, nil, err
+	}
+
+	return ccID, ccPacket, nil
+}
+
+// ====================================================================================================
+// HyperlaneApp interface
+// ====================================================================================================
+
+// Exists implements hyperlaneutil.HyperlaneApp.
+func (e *HyperlaneEntrypoint) Exists(_ context.Context, recipient hyperlaneutil.HexAddress) (bool, error) {
+	return recipient == entrypointtypes.HyperlaneAppAddress, nil
+}
+
+// ReceiverIsmId implements hyperlaneutil.HyperlaneApp. The Orbiter application
+// does not define a custom ISM, so the mailbox default one is used.
+func (e *HyperlaneEntrypoint) ReceiverIsmId(
+	_ context.Context,
+	_ hyperlaneutil.HexAddress,
+) (*hyperlaneutil.HexAddress, error) {
+	return nil, hyperlanetypes.ErrNoReceiverISM
+}
+
+// Handle implements hyperlaneutil.HyperlaneApp. The payload message is only
+// accepted when received along with the associated transfer via the entrypoint,
+// which is then in charge of processing the payload.
+func (e *HyperlaneEntrypoint) Handle(
+	ctx context.Context,
+	_ hyperlaneutil.HexAddress,
+	message hyperlaneutil.HyperlaneMessage,
+) error {
+	if message.Recipient != entrypointtypes.HyperlaneAppAddress {
+		return errors.New("message recipient is not Orbiter application")
+	}
+
+	expectedID, ok := ctx.Value(hyperlanePayloadMessageKey{}).(hyperlaneutil.HexAddress)
+	if !ok || expectedID != message.Id() {
+		return errors.New("payload messages must be received via the Orbiter entrypoint")
+	}
+
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -41,13 +42,15 @@ type IBCMiddleware struct {
 	porttypes.IBCModule
 	porttypes.ICS4Wrapper
 
-	payloadAdapter types.PayloadAdapter
+	payloadAdapter  types.PayloadAdapter
+	inFlightHandler types.InFlightPacketHandler
 }
 
 func NewIBCMiddleware(
 	app porttypes.IBCModule,
 	ics4Wrapper porttypes.ICS4Wrapper,
 	payloadAdapter types.PayloadAdapter,
+	inFlightHandler types.InFlightPacketHandler,
 ) IBCMiddleware {
 	if app == nil {
 		panic(core.ErrNilPointer.Wrap("IBC module is not set"))
@@ -61,10 +64,15 @@ func NewIBCMiddleware(
 		panic(core.ErrNilPointer.Wrap("payload adapter is not set"))
 	}
 
+	if inFlightHandler == nil {
+		panic(core.ErrNilPointer.Wrap("in-flight packet handler is not set"))
+	}
+
 	return IBCMiddleware{
-		IBCModule:      app,
-		ICS4Wrapper:    ics4Wrapper,
-		payloadAdapter: payloadAdapter,
+		IBCModule:       app,
+		ICS4Wrapper:     ics4Wrapper,
+		payloadAdapter:  payloadAdapter,
+		inFlightHandler: inFlightHandler,
 	}
 }
 
@@ -88,6 +96,8 @@ func (i IBCMiddleware) OnRecvPacket(
 	ccPacket, err := adaptertypes.NewIBCCrossChainPacket(
 		packet.GetSourcePort(),
 		packet.GetSourceChannel(),
+		packet.GetDestPort(),
+		packet.GetDestChannel(),
 		packet.GetData(),
 	)
 	if err != nil {
@@ -126,6 +136,103 @@ func (i IBCMiddleware) OnRecvPacket(
 	return ack
 }
 
+// OnAcknowledgementPacket implements types.Middleware. When the packet is an
+// outgoing Orbiter transfer, the in-flight packet is completed on success or
+// refunded on error, after the underlying app handled the acknowledgement.
+func (i IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	err := i.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err != nil {
+		return err
+	}
+
+	if !isOrbiterTransfer(packet) {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrap(err, "cannot unmarshal ICS-20 transfer packet acknowledgement")
+	}
+
+	if ack.Success() {
+		err = i.inFlightHandler.CompleteInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	} else {
+		err = i.inFlightHandler.RefundInFlightPacket(
+			ctx,
+			packet.SourceChannel,
+			packet.Sequence,
+			ack.GetError(),
+		)
+	}
+	logInFlightError(ctx, packet, err)
+
+	return nil
+}
+
+// OnTimeoutPacket implements types.Middleware. When the packet is an outgoing
+// Orbiter transfer, the in-flight packet is refunded after the underlying app
+// handled the timeout.
+func (i IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := i.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	if !isOrbiterTransfer(packet) {
+		return nil
+	}
+
+	err := i.inFlightHandler.RefundInFlightPacket(
+		ctx,
+		packet.SourceChannel,
+		packet.Sequence,
+		"packet timed out",
+	)
+	logInFlightError(ctx, packet, err)
+
+	return nil
+}
+
+// isOrbiterTransfer returns true if the packet is an ICS-20
+// transfer sent by the Orbiter module account.
+func isOrbiterTransfer(packet channeltypes.Packet) bool {
+	if packet.SourcePort != transfertypes.PortID {
+		return false
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return false
+	}
+
+	return data.Sender == core.ModuleAddress.String()
+}
+
+// logInFlightError logs the error raised while finalizing an in-flight packet.
+// The error is not returned to not block the packet lifecycle. Funds failing
+// the refund are held in the refunds account and the packet stays queryable
+// until they are claimed.
+func logInFlightError(ctx sdk.Context, packet channeltypes.Packet, err error) {
+	if err == nil {
+		return
+	}
+
+	ctx.Logger().Error(
+		"error finalizing orbiter in-flight packet",
+		"channel", packet.SourceChannel,
+		"sequence", packet.Sequence,
+		"err", err.Error(),
+	)
+}
+
 func newErrorAcknowledgement(err error) channeltypes.Acknowledgement {
 	return channeltypes.Acknowledgement{
 		Response: &channeltypes.Acknowledgement_Error{
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+)
+
+// EndBlock executes the orbiter logic at the end of each block.
+func (k *Keeper) EndBlock(ctx context.Context) error {
+	// NOTE: errors are logged and not returned to avoid halting the chain.
+	if err := k.adapter.ProcessEscrowedPackets(ctx); err != nil {
+		k.logger.Error("error processing escrowed packets", "err", err.Error())
+	}
+
+	if err := k.forwarder.ProcessDeferredPackets(ctx); err != nil {
+		k.logger.Error("error processing deferred packets", "err", err.Error())
+	}
+
+	// NOTE: batches are processed last to include the packets
+	// batched while processing the escrowed packets.
+	if err := k.forwarder.ProcessForwardingBatches(ctx); err != nil {
+		k.logger.Error("error processing forwarding batches", "err", err.Error())
+	}
+
+	return nil
+}
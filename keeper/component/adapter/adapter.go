@@ -50,7 +50,9 @@ type Adapter struct {
 	router     AdapterRouter
 	bankKeeper types.BankKeeperAdapter
 	dispatcher types.PayloadDispatcher
-	params     collections.Item[adaptertypes.Params]
+	// escrow holds the packets whose dispatch hit a paused route or action.
+	escrow types.PacketEscrow
+	params collections.Item[adaptertypes.Params]
 }
 
 func New(
@@ -60,6 +62,7 @@ func New(
 	eventService event.Service,
 	bankKeeper types.BankKeeperAdapter,
 	dispatcher types.PayloadDispatcher,
+	escrow types.PacketEscrow,
 ) (*Adapter, error) {
 	if cdc == nil {
 		return nil, core.ErrNilPointer.Wrap("codec cannot be nil")
@@ -77,6 +80,7 @@ func New(
 		router:       router.New[core.ProtocolID, types.AdapterController](),
 		bankKeeper:   bankKeeper,
 		dispatcher:   dispatcher,
+		escrow:       escrow,
 		params: collections.NewItem(
 			sb,
 			core.AdapterParamsPrefix,
@@ -102,6 +106,9 @@ func (a *Adapter) Validate() error {
 	if a.dispatcher == nil {
 		return core.ErrNilPointer.Wrap("dispatcher cannot be nil")
 	}
+	if a.escrow == nil {
+		return core.ErrNilPointer.Wrap("escrow cannot be nil")
+	}
 	if a.router == nil {
 		return core.ErrNilPointer.Wrap("router cannot be nil")
 	}
@@ -160,6 +167,10 @@ func (a *Adapter) AdaptPacket(
 		return nil, core.ErrNilPointer.Wrap("parsed packet")
 	}
 
+	if err := a.CheckCoexistingKeys(ctx, parsedPacket.CoexistingKeys); err != nil {
+		return nil, err
+	}
+
 	// NOTE: in case of an IBC transfer, the Denom set here is the representation
 	// of the denom on the source chain, not on Noble. But since this is the real
 	// source denom, we set the Noble denom as the destination later on.
@@ -187,7 +198,7 @@ func (a *Adapter) BeforeTransferHook(
 	if err := a.commonBeforeTransferHook(
 		ctx,
 		packet.TransferAttributes.DestinationDenom(),
-		packet.Payload.Forwarding.PassthroughPayload,
+		packet.Payload.Forwardings(),
 	); err != nil {
 		return errorsmod.Wrap(err, "generic before transfer hook failed")
 	}
@@ -208,9 +219,13 @@ func (a *Adapter) ProcessPayload(
 	ctx context.Context,
 	packet *types.OrbiterPacket,
 ) error {
-	if err := a.dispatcher.DispatchPayload(ctx, packet.TransferAttributes, packet.Payload); err != nil {
+	dispatched, err := a.dispatchPayload(ctx, packet)
+	if err != nil {
 		return errorsmod.Wrap(err, "failed to dispatch payload")
 	}
+	if !dispatched {
+		return nil
+	}
 
 	if err := a.eventService.EventManager(ctx).Emit(
 		ctx,
@@ -224,6 +239,96 @@ func (a *Adapter) ProcessPayload(
 	return nil
 }
 
+// dispatchPayload dispatches the packet payload and returns true if the
+// dispatch succeeded. The dispatch is executed in a cached context and, in
+// case of failure, all the state changes are discarded. If the failure is
+// caused by a paused route or action and the escrow mode is enabled, the
+// packet is held in escrow. Otherwise, when the payload specifies a fallback
+// recipient, the received funds are sent to it instead of returning an error.
+func (a *Adapter) dispatchPayload(
+	ctx context.Context,
+	packet *types.OrbiterPacket,
+) (bool, error) {
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	dispatchErr := a.dispatcher.DispatchPayload(
+		cacheCtx,
+		packet.TransferAttributes,
+		packet.Payload,
+	)
+	if dispatchErr == nil {
+		write()
+
+		return true, nil
+	}
+
+	if errors.Is(dispatchErr, core.ErrPaused) {
+		escrowed, err := a.escrow.EscrowPacket(ctx, packet, dispatchErr.Error())
+		if err != nil {
+			return false, errorsmod.Wrap(err, "failed to escrow packet")
+		}
+		if escrowed {
+			a.logger.Info("payload dispatch paused, packet escrowed", "err", dispatchErr.Error())
+
+			return false, nil
+		}
+	}
+
+	if packet.Payload.FallbackRecipient == "" {
+		return false, dispatchErr
+	}
+
+	a.logger.Error(
+		"payload dispatch failed, sending funds to fallback recipient",
+		"fallback_recipient", packet.Payload.FallbackRecipient,
+		"err", dispatchErr.Error(),
+	)
+
+	return false, a.sendToFallbackRecipient(ctx, packet, dispatchErr)
+}
+
+// sendToFallbackRecipient sends the funds received with the packet
+// to the payload fallback recipient.
+func (a *Adapter) sendToFallbackRecipient(
+	ctx context.Context,
+	packet *types.OrbiterPacket,
+	reason error,
+) error {
+	recipient, err := sdk.AccAddressFromBech32(packet.Payload.FallbackRecipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid fallback recipient address")
+	}
+
+	// NOTE: the source coin is used since the destination coin
+	// could have been mutated by the discarded actions.
+	coin := sdk.NewCoin(
+		packet.TransferAttributes.SourceDenom(),
+		packet.TransferAttributes.SourceAmount(),
+	)
+	if err := a.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		core.ModuleName,
+		recipient,
+		sdk.NewCoins(coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to send funds to fallback recipient")
+	}
+
+	if err := a.eventService.EventManager(ctx).Emit(
+		ctx,
+		&adaptertypes.EventPayloadFallback{
+			Payload:           packet.Payload,
+			FallbackRecipient: packet.Payload.FallbackRecipient,
+			Coin:              coin,
+			Reason:            reason.Error(),
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to emit payload fallback event")
+	}
+
+	return nil
+}
+
 // CheckPassthroughPayloadSize checks that the passthrough payload
 // size is not higher than the maximum allowed.
 func (a *Adapter) CheckPassthroughPayloadSize(
@@ -249,16 +354,43 @@ func (a *Adapter) CheckPassthroughPayloadSize(
 	return nil
 }
 
+// CheckCoexistingKeys checks that all the root level keys of the packet
+// metadata not consumed by the Orbiter are allowed by the params.
+func (a *Adapter) CheckCoexistingKeys(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	// If we obtain an error, we assume no coexisting keys are allowed.
+	params, err := a.GetParams(ctx)
+	if err != nil {
+		a.logger.Error("getting params returned an error", "err", err.Error())
+	}
+
+	for _, key := range keys {
+		if !params.IsCoexistingKeyAllowed(key) {
+			return core.ErrParsingPayload.Wrapf(
+				"metadata key %s is not allowed alongside the orbiter key",
+				key,
+			)
+		}
+	}
+
+	return nil
+}
+
 // commonBeforeTransferHook groups all the logic that must be executed
 // before completing the cross-chain transfer, regardless the incoming
 // protocol used.
 func (a *Adapter) commonBeforeTransferHook(
 	ctx context.Context,
 	denom string,
-	passthroughPayload []byte,
+	forwardings []*core.Forwarding,
 ) error {
-	if err := a.CheckPassthroughPayloadSize(ctx, passthroughPayload); err != nil {
-		return err
+	for _, forwarding := range forwardings {
+		if err := a.CheckPassthroughPayloadSize(ctx, forwarding.PassthroughPayload); err != nil {
+			return err
+		}
 	}
 
 	if err := a.clearOrbiterBalance(ctx, denom); err != nil {
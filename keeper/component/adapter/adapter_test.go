@@ -25,8 +25,17 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
 	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/types"
 	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
 func TestCheckPassthroughPayloadSize(t *testing.T) {
@@ -74,3 +83,186 @@ func TestCheckPassthroughPayloadSize(t *testing.T) {
 	// ASSERT: Works with equal bytes size
 	require.NoError(t, err)
 }
+
+func TestCheckCoexistingKeys(t *testing.T) {
+	// ARRANGE
+	adapter, deps := mocks.NewAdapterComponent(t)
+	ctx := deps.SdkCtx
+
+	// ACT: No error when there are no coexisting keys
+	err := adapter.CheckCoexistingKeys(ctx, nil)
+
+	// ASSERT
+	require.NoError(t, err)
+
+	// ACT: Error when params is not set
+	err = adapter.CheckCoexistingKeys(ctx, []string{"wasm"})
+
+	// ASSERT
+	require.ErrorContains(t, err, "metadata key wasm is not allowed")
+
+	// ARRANGE
+	err = adapter.SetParams(ctx, adaptertypes.Params{
+		AllowedCoexistingKeys: []string{"wasm"},
+	})
+	require.NoError(t, err)
+
+	// ACT
+	err = adapter.CheckCoexistingKeys(ctx, []string{"wasm"})
+
+	// ASSERT
+	require.NoError(t, err)
+
+	// ACT: Error when one of the keys is not allowed
+	err = adapter.CheckCoexistingKeys(ctx, []string{"forward", "wasm"})
+
+	// ASSERT
+	require.ErrorContains(t, err, "metadata key forward is not allowed")
+}
+
+func TestProcessPayload(t *testing.T) {
+	fallbackRecipient := testutil.NewNobleAddress()
+
+	forwarding, err := forwardingtypes.NewInternalForwarding(testutil.NewNobleAddress())
+	require.NoError(t, err)
+
+	transferAttr, err := core.NewTransferAttributes(
+		core.PROTOCOL_IBC,
+		"channel-0",
+		"uusdc",
+		math.NewInt(100),
+	)
+	require.NoError(t, err)
+	coin := sdk.NewCoin(transferAttr.SourceDenom(), transferAttr.SourceAmount())
+
+	testCases := []struct {
+		name          string
+		setup         func(*mocks.BankKeeper)
+		payload       *core.Payload
+		escrowEnabled bool
+		paused        bool
+		expError      string
+		expFallback   bool
+		expEscrow     bool
+	}{
+		{
+			name:    "success - payload dispatched",
+			payload: &core.Payload{Forwarding: forwarding},
+		},
+		{
+			name:     "error - dispatch fails without fallback recipient",
+			payload:  &core.Payload{},
+			expError: "failed to dispatch payload",
+		},
+		{
+			name: "success - payload dispatched with fallback recipient",
+			payload: &core.Payload{
+				Forwarding:        forwarding,
+				FallbackRecipient: fallbackRecipient,
+			},
+		},
+		{
+			name: "success - dispatch fails and funds are sent to fallback recipient",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			payload:     &core.Payload{FallbackRecipient: fallbackRecipient},
+			expFallback: true,
+		},
+		{
+			name:     "error - dispatch fails and fallback transfer fails",
+			payload:  &core.Payload{FallbackRecipient: fallbackRecipient},
+			expError: "failed to send funds to fallback recipient",
+		},
+		{
+			name: "success - paused dispatch with escrow disabled is sent to fallback",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			payload: &core.Payload{
+				Forwarding:        forwarding,
+				FallbackRecipient: fallbackRecipient,
+			},
+			paused:      true,
+			expFallback: true,
+		},
+		{
+			name: "success - paused dispatch is escrowed",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			payload: &core.Payload{
+				Forwarding:        forwarding,
+				FallbackRecipient: fallbackRecipient,
+			},
+			escrowEnabled: true,
+			paused:        true,
+			expEscrow:     true,
+		},
+		{
+			name: "success - paused dispatch without fallback recipient is escrowed",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			payload:       &core.Payload{Forwarding: forwarding},
+			escrowEnabled: true,
+			paused:        true,
+			expEscrow:     true,
+		},
+		{
+			name: "error - paused dispatch without fallback recipient and escrow disabled",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			payload:  &core.Payload{Forwarding: forwarding},
+			paused:   true,
+			expError: "forwarding is paused",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			bk := mocks.NewBankKeeper()
+			if tC.setup != nil {
+				tC.setup(bk)
+			}
+
+			adapter, escrow, deps := mocks.NewAdapterComponentWithEscrow(t, bk)
+			types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+			ctx := deps.SdkCtx.WithValue(mocks.PausedContextKey, tC.paused)
+			require.NoError(t, escrow.SetParams(ctx, forwardertypes.Params{
+				EscrowEnabled:       tC.escrowEnabled,
+				EscrowMaxAgeSeconds: 60,
+			}))
+
+			err := adapter.ProcessPayload(ctx, &types.OrbiterPacket{
+				TransferAttributes: transferAttr,
+				Payload:            tC.payload,
+			})
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events)
+			lastEvent := events[len(events)-1]
+
+			var expEvent proto.Message = &adaptertypes.EventPayloadProcessed{}
+			if tC.expFallback {
+				expEvent = &adaptertypes.EventPayloadFallback{}
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[fallbackRecipient])
+			} else {
+				require.Empty(t, bk.Balances[fallbackRecipient])
+			}
+			if tC.expEscrow {
+				expEvent = &forwardertypes.EventPacketEscrowed{}
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.EscrowName])
+			}
+			require.Equal(t, proto.MessageName(expEvent), lastEvent.Type)
+		})
+	}
+}
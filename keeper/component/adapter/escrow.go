@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// ProcessEscrowedPackets retries the dispatch of the next escrowed packets.
+// Packets still hitting a paused route or action are kept in escrow, while
+// packets held for longer than the maximum age, or failing for any other
+// reason, are sent to the payload fallback recipient. The funds of the
+// packets without a fallback recipient are held in the refunds account.
+func (a *Adapter) ProcessEscrowedPackets(ctx context.Context) error {
+	packets, err := a.escrow.GetNextEscrowedPackets(ctx, core.MaxEscrowedPacketsPerBlock)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get escrowed packets")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, p := range packets {
+		// NOTE: each packet is processed in a cached context to avoid
+		// partial state changes in case of failure.
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := a.processEscrowedPacket(cacheCtx, p); err != nil {
+			a.logger.Error(
+				"failed to process escrowed packet",
+				"id", p.Id,
+				"err", err.Error(),
+			)
+
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// processEscrowedPacket retries the dispatch of an escrowed packet or falls
+// back when the packet is expired or failing.
+func (a *Adapter) processEscrowedPacket(
+	ctx sdk.Context,
+	escrowedPacket forwardertypes.EscrowedPacket,
+) error {
+	transferAttr, err := core.NewTransferAttributes(
+		escrowedPacket.SourceId.ProtocolId,
+		escrowedPacket.SourceId.CounterpartyId,
+		escrowedPacket.Coin.Denom,
+		escrowedPacket.Coin.Amount,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating transfer attributes")
+	}
+
+	packet := &types.OrbiterPacket{
+		TransferAttributes: transferAttr,
+		Payload:            escrowedPacket.Payload,
+	}
+
+	expired, err := a.escrow.IsEscrowedPacketExpired(ctx, escrowedPacket)
+	if err != nil {
+		return errorsmod.Wrap(err, "error checking escrowed packet expiration")
+	}
+	if expired {
+		return a.fallbackEscrowedPacket(
+			ctx,
+			escrowedPacket.Id,
+			packet,
+			errors.New("escrow max age reached"),
+		)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	dispatchErr := a.retryEscrowedPacket(cacheCtx, escrowedPacket.Id, packet)
+	if dispatchErr == nil {
+		write()
+
+		return a.eventService.EventManager(ctx).Emit(
+			ctx,
+			&adaptertypes.EventPayloadProcessed{
+				Payload: packet.Payload,
+			},
+		)
+	}
+
+	if errors.Is(dispatchErr, core.ErrPaused) {
+		a.logger.Debug(
+			"escrowed packet dispatch still paused",
+			"id", escrowedPacket.Id,
+			"err", dispatchErr.Error(),
+		)
+
+		return nil
+	}
+
+	return a.fallbackEscrowedPacket(ctx, escrowedPacket.Id, packet, dispatchErr)
+}
+
+// fallbackEscrowedPacket releases the escrowed packet funds and sends them
+// to the payload fallback recipient. When the payload does not specify a
+// fallback recipient, the funds are held in the refunds account instead.
+func (a *Adapter) fallbackEscrowedPacket(
+	ctx context.Context,
+	id uint64,
+	packet *types.OrbiterPacket,
+	reason error,
+) error {
+	if packet.Payload.FallbackRecipient == "" {
+		return a.escrow.HoldEscrowedPacket(ctx, id, reason.Error())
+	}
+
+	if err := a.escrow.ReleaseEscrowedPacket(ctx, id); err != nil {
+		return err
+	}
+
+	return a.sendToFallbackRecipient(ctx, packet, reason)
+}
+
+// retryEscrowedPacket releases the escrowed funds to the module account
+// and dispatches the packet payload.
+func (a *Adapter) retryEscrowedPacket(
+	ctx context.Context,
+	id uint64,
+	packet *types.OrbiterPacket,
+) error {
+	if err := a.BeforeTransferHook(ctx, packet); err != nil {
+		return err
+	}
+
+	if err := a.escrow.ReleaseEscrowedPacket(ctx, id); err != nil {
+		return err
+	}
+
+	return a.dispatcher.DispatchPayload(ctx, packet.TransferAttributes, packet.Payload)
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/types"
+	adaptertypes "github.com/noble-assets/orbiter/v2/types/component/adapter"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestProcessEscrowedPackets(t *testing.T) {
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+	maxAge := 60 * time.Second
+
+	testCases := []struct {
+		name               string
+		passthroughPayload []byte
+		noFallback         bool
+		paused             bool
+		blockTimeDelta     time.Duration
+		expEscrowed        bool
+		expFallback        bool
+		expHeld            bool
+		expEvent           proto.Message
+	}{
+		{
+			name:        "success - paused packet is kept in escrow",
+			paused:      true,
+			expEscrowed: true,
+		},
+		{
+			name:     "success - unpaused packet is dispatched",
+			expEvent: &adaptertypes.EventPayloadProcessed{},
+		},
+		{
+			name:               "success - failing packet is sent to fallback recipient",
+			passthroughPayload: []byte("passthrough"),
+			expFallback:        true,
+			expEvent:           &adaptertypes.EventPayloadFallback{},
+		},
+		{
+			name:           "success - expired packet is sent to fallback recipient",
+			paused:         true,
+			blockTimeDelta: maxAge,
+			expFallback:    true,
+			expEvent:       &adaptertypes.EventPayloadFallback{},
+		},
+		{
+			name:           "success - expired packet without fallback recipient is held",
+			noFallback:     true,
+			paused:         true,
+			blockTimeDelta: maxAge,
+			expHeld:        true,
+			expEvent:       &forwardertypes.EventEscrowedPacketHeld{},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			testutil.SetSDKConfig()
+
+			fallbackRecipient := testutil.NewNobleAddress()
+			if tC.noFallback {
+				fallbackRecipient = ""
+			}
+			forwarding, err := forwardingtypes.NewInternalForwarding(testutil.NewNobleAddress())
+			require.NoError(t, err)
+			forwarding.PassthroughPayload = tC.passthroughPayload
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_IBC,
+				"channel-0",
+				coin.Denom,
+				coin.Amount,
+			)
+			require.NoError(t, err)
+
+			bk := mocks.NewBankKeeper()
+			bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+
+			adapter, escrow, deps := mocks.NewAdapterComponentWithEscrow(t, bk)
+			types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+			require.NoError(t, adapter.SetParams(deps.SdkCtx, adaptertypes.Params{}))
+			require.NoError(t, escrow.SetParams(deps.SdkCtx, forwardertypes.Params{
+				EscrowEnabled:       true,
+				EscrowMaxAgeSeconds: uint64(maxAge.Seconds()),
+			}))
+
+			// ARRANGE: escrow the packet hitting a paused route.
+			pausedCtx := deps.SdkCtx.WithValue(mocks.PausedContextKey, true)
+			err = adapter.ProcessPayload(pausedCtx, &types.OrbiterPacket{
+				TransferAttributes: transferAttr,
+				Payload: &core.Payload{
+					Forwarding:        forwarding,
+					FallbackRecipient: fallbackRecipient,
+				},
+			})
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.EscrowName])
+
+			// ACT
+			ctx := deps.SdkCtx.
+				WithValue(mocks.PausedContextKey, tC.paused).
+				WithBlockTime(deps.SdkCtx.BlockTime().Add(tC.blockTimeDelta)).
+				WithEventManager(sdk.NewEventManager())
+			require.NoError(t, adapter.ProcessEscrowedPackets(ctx))
+
+			// ASSERT
+			_, found, err := escrow.GetEscrowedPacket(ctx, 0)
+			require.NoError(t, err)
+			require.Equal(t, tC.expEscrowed, found)
+
+			// NOTE: balances are not asserted for kept packets since the
+			// mocked bank keeper is not reverted with the cached context.
+			if tC.expEscrowed {
+				require.Empty(t, ctx.EventManager().Events())
+
+				return
+			}
+			require.True(t, bk.Balances[core.EscrowName].IsZero())
+
+			heldPackets, err := escrow.GetAllHeldEscrowedPackets(ctx)
+			require.NoError(t, err)
+			if tC.expHeld {
+				require.Len(t, heldPackets, 1)
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.RefundsName])
+			} else {
+				require.Empty(t, heldPackets)
+			}
+
+			if tC.expFallback {
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[fallbackRecipient])
+			} else {
+				require.Empty(t, bk.Balances[fallbackRecipient])
+			}
+
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events)
+			require.Equal(t, proto.MessageName(tC.expEvent), events[len(events)-1].Type)
+		})
+	}
+}
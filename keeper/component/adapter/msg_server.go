@@ -48,6 +48,10 @@ func (s msgServer) UpdateParams(
 		return nil, err
 	}
 
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
 	if err := s.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -22,11 +22,17 @@ package dispatcher
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/noble-assets/orbiter/v2/types"
 	dispatchertypes "github.com/noble-assets/orbiter/v2/types/component/dispatcher"
@@ -38,11 +44,21 @@ var _ types.PayloadDispatcher = &Dispatcher{}
 // Dispatcher is a component used to orchestrate the dispatch of an incoming orbiter
 // packet. The dispatcher keeps track of the statistics associated with the handled dispatches.
 type Dispatcher struct {
-	logger log.Logger
+	logger       log.Logger
+	eventService event.Service
 
 	// Packet elements handlers
 	ForwardingHandler types.PacketHandler[*types.ForwardingPacket]
 	ActionHandler     types.PacketHandler[*types.ActionPacket]
+	// PacketDeferrer holds the forwarding packets deferred to a later block.
+	PacketDeferrer types.PacketDeferrer
+	// PacketBatcher aggregates the batched forwarding packets of the block.
+	PacketBatcher types.PacketBatcher
+	// RouteFeeHandler applies the fees defined by the authority per route.
+	RouteFeeHandler types.RouteFeeHandler
+	// AmountLimitValidator checks the amount forwarded to a route by all
+	// the legs of a payload against the limit of the route.
+	AmountLimitValidator types.AmountLimitValidator
 	// Stats
 	dispatchedAmounts *collections.IndexedMap[DispatchedAmountsKey, dispatchertypes.AmountDispatched, DispatchedAmountsIndexes]
 	dispatchedCounts  *collections.IndexedMap[DispatchedCountsKey, uint64, DispatchedCountsIndexes]
@@ -53,8 +69,13 @@ func New(
 	cdc codec.BinaryCodec,
 	sb *collections.SchemaBuilder,
 	logger log.Logger,
+	eventService event.Service,
 	forwardingHandler types.PacketHandler[*types.ForwardingPacket],
 	actionHandler types.PacketHandler[*types.ActionPacket],
+	packetDeferrer types.PacketDeferrer,
+	packetBatcher types.PacketBatcher,
+	routeFeeHandler types.RouteFeeHandler,
+	amountLimitValidator types.AmountLimitValidator,
 ) (*Dispatcher, error) {
 	if cdc == nil {
 		return nil, core.ErrNilPointer.Wrap("codec cannot be nil")
@@ -67,9 +88,14 @@ func New(
 	}
 
 	d := Dispatcher{
-		logger:            logger.With(core.ComponentPrefix, core.DispatcherName),
-		ForwardingHandler: forwardingHandler,
-		ActionHandler:     actionHandler,
+		logger:               logger.With(core.ComponentPrefix, core.DispatcherName),
+		eventService:         eventService,
+		ForwardingHandler:    forwardingHandler,
+		ActionHandler:        actionHandler,
+		PacketDeferrer:       packetDeferrer,
+		PacketBatcher:        packetBatcher,
+		RouteFeeHandler:      routeFeeHandler,
+		AmountLimitValidator: amountLimitValidator,
 		dispatchedAmounts: collections.NewIndexedMap(
 			sb,
 			core.DispatchedAmountsPrefix,
@@ -106,12 +132,27 @@ func (d *Dispatcher) Validate() error {
 	if d.logger == nil {
 		return core.ErrNilPointer.Wrap("logger is not set")
 	}
+	if d.eventService == nil {
+		return core.ErrNilPointer.Wrap("event service is not set")
+	}
 	if d.ForwardingHandler == nil {
 		return core.ErrNilPointer.Wrap("forwarding handler is not set")
 	}
 	if d.ActionHandler == nil {
 		return core.ErrNilPointer.Wrap("action handler is not set")
 	}
+	if d.PacketDeferrer == nil {
+		return core.ErrNilPointer.Wrap("packet deferrer is not set")
+	}
+	if d.PacketBatcher == nil {
+		return core.ErrNilPointer.Wrap("packet batcher is not set")
+	}
+	if d.RouteFeeHandler == nil {
+		return core.ErrNilPointer.Wrap("route fee handler is not set")
+	}
+	if d.AmountLimitValidator == nil {
+		return core.ErrNilPointer.Wrap("amount limit validator is not set")
+	}
 
 	return nil
 }
@@ -131,15 +172,36 @@ func (d *Dispatcher) DispatchPayload(
 		return core.ErrValidation.Wrap(err.Error())
 	}
 
-	if err := d.dispatchActions(ctx, transferAttr, payload.PreActions); err != nil {
-		return errorsmod.Wrap(err, "actions dispatch failed")
+	if len(payload.ForwardingLegs) != 0 {
+		legAmounts, err := d.dispatchRouteFees(ctx, transferAttr, payload.ForwardingLegs)
+		if err != nil {
+			return errorsmod.Wrap(err, "route fees dispatch failed")
+		}
+
+		if err := d.dispatchActions(ctx, transferAttr, payload.PreActions); err != nil {
+			return errorsmod.Wrap(err, "actions dispatch failed")
+		}
+
+		err = d.dispatchForwardingLegs(
+			ctx,
+			transferAttr,
+			payload.ForwardingLegs,
+			legAmounts,
+			payload.FallbackRecipient,
+		)
+		if err != nil {
+			return errorsmod.Wrap(err, "forwarding legs dispatch failed")
+		}
+
+		return nil
 	}
 
-	if err := d.dispatchForwarding(ctx, transferAttr, payload.Forwarding); err != nil {
+	forwarding, err := d.dispatchForwardingWithFallbacks(ctx, transferAttr, payload)
+	if err != nil {
 		return errorsmod.Wrap(err, "forwarding dispatch failed")
 	}
 
-	if err := d.UpdateStats(ctx, transferAttr, payload.Forwarding); err != nil {
+	if err := d.UpdateStats(ctx, transferAttr, forwarding); err != nil {
 		// NOTE: we don't want to interrupt a dispatch in case the stats are not updated.
 		d.logger.Error("Error updating Orbiter statistics", "error", err)
 	}
@@ -152,6 +214,40 @@ func (d *Dispatcher) ValidatePayload(payload *core.Payload) error {
 	return payload.Validate()
 }
 
+// dispatchRouteFees applies the fees defined by the authority for the
+// routes of the forwarding legs, before the user actions are dispatched.
+// The fee of the route of each leg is subtracted from the leg share of
+// the transfer, and the leg amounts net of their fees are returned.
+func (d *Dispatcher) dispatchRouteFees(
+	ctx context.Context,
+	transferAttr *core.TransferAttributes,
+	legs []*core.ForwardingLeg,
+) ([]math.Int, error) {
+	amounts, _, err := core.SplitAmount(transferAttr.DestinationAmount(), legs)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error splitting transfer amount")
+	}
+
+	for i, leg := range legs {
+		destinationID, err := destinationCrossChainID(leg.Forwarding)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid forwarding leg %d", i)
+		}
+
+		legAttr := transferAttr.Clone()
+		legAttr.SetDestinationAmount(amounts[i])
+		if err := d.RouteFeeHandler.HandleRouteFee(ctx, legAttr, destinationID); err != nil {
+			return nil, errorsmod.Wrapf(err, "error applying route fee of forwarding leg %d", i)
+		}
+
+		fee := amounts[i].Sub(legAttr.DestinationAmount())
+		transferAttr.SetDestinationAmount(transferAttr.DestinationAmount().Sub(fee))
+		amounts[i] = legAttr.DestinationAmount()
+	}
+
+	return amounts, nil
+}
+
 // dispatchActions iterates through all the actions, creates
 // the action packets and dispatch them for execution.
 func (d *Dispatcher) dispatchActions(
@@ -189,12 +285,301 @@ func (d *Dispatcher) dispatchActions(
 	return nil
 }
 
+// dispatchForwardingLegs splits the transfer attributes across the legs
+// using the leg amounts net of their route fees, and dispatches the
+// forwarding of each leg, in order. The amount sent by all the legs to
+// the same route is checked against the route limit before dispatching.
+// The statistics are updated for every leg with the leg share of the transfer.
+func (d *Dispatcher) dispatchForwardingLegs(
+	ctx context.Context,
+	transferAttr *core.TransferAttributes,
+	legs []*core.ForwardingLeg,
+	legAmounts []math.Int,
+	fallbackRecipient string,
+) error {
+	amounts, dustIndex, err := scaleLegAmounts(transferAttr.DestinationAmount(), legs, legAmounts)
+	if err != nil {
+		return errorsmod.Wrap(err, "error splitting transfer amount")
+	}
+
+	legsAttr, err := transferAttr.Split(amounts, dustIndex)
+	if err != nil {
+		return errorsmod.Wrap(err, "error splitting transfer attributes")
+	}
+
+	if err := d.validateLegsAmountLimits(ctx, legsAttr, legs); err != nil {
+		return err
+	}
+
+	d.logger.Debug("started forwarding legs dispatching", "num_legs", len(legs))
+
+	// The amount of the legs not yet dispatched is held by the module
+	// while the previous legs are forwarded.
+	reserved := transferAttr.DestinationAmount()
+	for i, leg := range legs {
+		reserved = reserved.Sub(amounts[i])
+
+		err := d.dispatchForwarding(ctx, legsAttr[i], leg.Forwarding, reserved, fallbackRecipient)
+		if err != nil {
+			return errorsmod.Wrapf(err, "error dispatching forwarding leg %d", i)
+		}
+
+		if err := d.UpdateStats(ctx, legsAttr[i], leg.Forwarding); err != nil {
+			// NOTE: we don't want to interrupt a dispatch in case the stats are not updated.
+			d.logger.Error("Error updating Orbiter statistics", "leg", i, "error", err)
+		}
+	}
+
+	d.logger.Debug("completed forwarding legs dispatching")
+
+	return nil
+}
+
+// validateLegsAmountLimits sums the amounts of the legs forwarded to the
+// same destination and denom, and returns an error if any of the totals
+// is not within the amount limit of its route. The limit is enforced on
+// each leg when forwarded, but legs sharing a route could otherwise be
+// used to send more than the limit with a single transfer.
+func (d *Dispatcher) validateLegsAmountLimits(
+	ctx context.Context,
+	legsAttr []*core.TransferAttributes,
+	legs []*core.ForwardingLeg,
+) error {
+	type routeAmount struct {
+		destinationID core.CrossChainID
+		denom         string
+		amount        math.Int
+	}
+
+	// NOTE: the totals are kept in the order of the legs to check
+	// the routes deterministically.
+	totals := make([]*routeAmount, 0, len(legs))
+	for i, leg := range legs {
+		destinationID, err := destinationCrossChainID(leg.Forwarding)
+		if err != nil {
+			return errorsmod.Wrapf(err, "invalid forwarding leg %d", i)
+		}
+		denom := legsAttr[i].DestinationDenom()
+
+		idx := slices.IndexFunc(totals, func(r *routeAmount) bool {
+			return r.destinationID.ID() == destinationID.ID() && r.denom == denom
+		})
+		if idx == -1 {
+			totals = append(totals, &routeAmount{
+				destinationID: destinationID,
+				denom:         denom,
+				amount:        math.ZeroInt(),
+			})
+			idx = len(totals) - 1
+		}
+		totals[idx].amount = totals[idx].amount.Add(legsAttr[i].DestinationAmount())
+	}
+
+	for _, t := range totals {
+		err := d.AmountLimitValidator.ValidateAmountLimit(ctx, t.destinationID, t.denom, t.amount)
+		if err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"invalid amount forwarded by the legs to %s",
+				t.destinationID.ID(),
+			)
+		}
+	}
+
+	return nil
+}
+
+// scaleLegAmounts returns the leg amounts scaled to the total, and the
+// index of the leg receiving the rounding dust. The amounts are returned
+// unchanged when they sum to the total, which is the case unless the
+// pre-actions changed the transfer amount.
+func scaleLegAmounts(
+	total math.Int,
+	legs []*core.ForwardingLeg,
+	legAmounts []math.Int,
+) ([]math.Int, int, error) {
+	dustIndex := 0
+	for i, leg := range legs {
+		if leg.Remainder {
+			dustIndex = i
+		}
+	}
+
+	sum := math.ZeroInt()
+	for _, a := range legAmounts {
+		sum = sum.Add(a)
+	}
+	if !sum.IsPositive() {
+		return nil, 0, errors.New("forwarding legs amount must be positive")
+	}
+
+	amounts := make([]math.Int, len(legAmounts))
+	allocated := math.ZeroInt()
+	for i, a := range legAmounts {
+		amounts[i] = a.Mul(total).Quo(sum)
+		allocated = allocated.Add(amounts[i])
+	}
+	amounts[dustIndex] = amounts[dustIndex].Add(total.Sub(allocated))
+
+	for i, a := range amounts {
+		if !a.IsPositive() {
+			return nil, 0, fmt.Errorf("forwarding leg %d amount must be positive", i)
+		}
+	}
+
+	return amounts, dustIndex, nil
+}
+
+// dispatchForwardingWithFallbacks dispatches the payload forwarding and, when
+// it fails, the fallback forwardings in order. Each forwarding is dispatched
+// in a cached context and only the state changes of the first one succeeding
+// are committed, including the route fee and the actions, so that the fee of
+// the route actually used is charged. Returns the forwarding which has been
+// dispatched.
+func (d *Dispatcher) dispatchForwardingWithFallbacks(
+	ctx context.Context,
+	transferAttr *core.TransferAttributes,
+	payload *core.Payload,
+) (*core.Forwarding, error) {
+	if len(payload.FallbackForwardings) == 0 {
+		err := d.dispatchRouteFeeActionsAndForwarding(
+			ctx,
+			transferAttr,
+			payload.PreActions,
+			payload.Forwarding,
+			payload.FallbackRecipient,
+		)
+
+		return payload.Forwarding, err
+	}
+
+	forwardings := payload.Forwardings()
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	failures := make([]dispatchertypes.ForwardingFailure, 0, len(forwardings))
+	errs := make([]error, 0, len(forwardings))
+	for i, forwarding := range forwardings {
+		cachedCtx, write := sdkCtx.CacheContext()
+		// The route fee and the actions mutate the attributes, so each
+		// forwarding starts from a copy of them.
+		attr := transferAttr.Clone()
+
+		err := d.dispatchRouteFeeActionsAndForwarding(
+			cachedCtx,
+			attr,
+			payload.PreActions,
+			forwarding,
+			"",
+		)
+		if err != nil {
+			d.logger.Info("forwarding alternative failed", "index", i, "error", err)
+
+			failures = append(failures, newForwardingFailure(i, forwarding, err))
+			errs = append(errs, errorsmod.Wrapf(err, "forwarding alternative %d failed", i))
+
+			continue
+		}
+
+		write()
+		*transferAttr = *attr
+
+		if i > 0 {
+			event := dispatchertypes.EventFallbackForwardingUsed{
+				Index:          uint32(i),
+				ProtocolId:     forwarding.ProtocolID(),
+				CounterpartyId: counterpartyID(forwarding),
+				Failures:       failures,
+			}
+			if err := d.eventService.EventManager(ctx).Emit(ctx, &event); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to emit fallback forwarding event")
+			}
+		}
+
+		return forwarding, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+// dispatchRouteFeeActionsAndForwarding applies the fee defined by the
+// authority for the route of the forwarding, dispatches the actions,
+// and then the forwarding.
+func (d *Dispatcher) dispatchRouteFeeActionsAndForwarding(
+	ctx context.Context,
+	transferAttr *core.TransferAttributes,
+	actions []*core.Action,
+	forwarding *core.Forwarding,
+	fallbackRecipient string,
+) error {
+	destinationID, err := destinationCrossChainID(forwarding)
+	if err != nil {
+		return err
+	}
+
+	if err := d.RouteFeeHandler.HandleRouteFee(ctx, transferAttr, destinationID); err != nil {
+		return errorsmod.Wrap(err, "route fees dispatch failed")
+	}
+
+	if err := d.dispatchActions(ctx, transferAttr, actions); err != nil {
+		return errorsmod.Wrap(err, "actions dispatch failed")
+	}
+
+	return d.dispatchForwarding(ctx, transferAttr, forwarding, math.ZeroInt(), fallbackRecipient)
+}
+
+// newForwardingFailure returns the failure of the forwarding
+// at the given index in the dispatch order.
+func newForwardingFailure(
+	index int,
+	forwarding *core.Forwarding,
+	err error,
+) dispatchertypes.ForwardingFailure {
+	return dispatchertypes.ForwardingFailure{
+		Index:          uint32(index),
+		ProtocolId:     forwarding.ProtocolID(),
+		CounterpartyId: counterpartyID(forwarding),
+		Reason:         err.Error(),
+	}
+}
+
+// counterpartyID returns the destination counterparty identifier of the
+// forwarding, or an empty string if the attributes cannot be unpacked.
+func counterpartyID(forwarding *core.Forwarding) string {
+	attr, err := forwarding.CachedAttributes()
+	if err != nil {
+		return ""
+	}
+
+	return attr.CounterpartyID()
+}
+
+// destinationCrossChainID returns the destination cross-chain ID of the forwarding.
+func destinationCrossChainID(forwarding *core.Forwarding) (core.CrossChainID, error) {
+	attr, err := forwarding.CachedAttributes()
+	if err != nil {
+		return core.CrossChainID{}, err
+	}
+
+	destinationID, err := core.NewCrossChainID(forwarding.ProtocolID(), attr.CounterpartyID())
+	if err != nil {
+		return core.CrossChainID{}, errorsmod.Wrap(err, "failed to create destination cross-chain ID")
+	}
+
+	return destinationID, nil
+}
+
 // dispatchForwarding creates the forwarding packet and dispatch
-// it for execution.
+// it for execution. The reserved amount is the amount held by the
+// module for the forwarding legs not yet dispatched. If the forwarding
+// is deferred or batched, the packet is held in the deferred queue or in
+// the forwarding batch of the block instead, and the fallback recipient
+// receives the funds if the execution fails.
 func (d *Dispatcher) dispatchForwarding(
 	ctx context.Context,
 	transferAttr *core.TransferAttributes,
 	forwarding *core.Forwarding,
+	reservedAmount math.Int,
+	fallbackRecipient string,
 ) error {
 	protocolID := forwarding.ProtocolID()
 
@@ -209,6 +594,36 @@ func (d *Dispatcher) dispatchForwarding(
 			protocolID,
 		)
 	}
+	packet.ReservedAmount = reservedAmount
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if forwarding.IsDeferred(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		d.logger.Debug("deferring forwarding", "id", protocolID)
+
+		if err := d.PacketDeferrer.DeferPacket(ctx, packet, fallbackRecipient); err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"error deferring forwarding packet for protocol ID %s",
+				protocolID,
+			)
+		}
+
+		return nil
+	}
+
+	if forwarding.Batch {
+		d.logger.Debug("batching forwarding", "id", protocolID)
+
+		if err := d.PacketBatcher.BatchPacket(ctx, packet, fallbackRecipient); err != nil {
+			return errorsmod.Wrapf(
+				err,
+				"error batching forwarding packet for protocol ID %s",
+				protocolID,
+			)
+		}
+
+		return nil
+	}
 
 	d.logger.Debug(
 		"dispatching forwarding",
@@ -21,19 +21,27 @@
 package dispatcher_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/noble-assets/orbiter/v2/keeper/component/dispatcher"
 	"github.com/noble-assets/orbiter/v2/testutil"
 	"github.com/noble-assets/orbiter/v2/testutil/mocks"
 	"github.com/noble-assets/orbiter/v2/testutil/testdata"
 	"github.com/noble-assets/orbiter/v2/types"
+	dispatchertypes "github.com/noble-assets/orbiter/v2/types/component/dispatcher"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -41,64 +49,158 @@ func TestNew(t *testing.T) {
 	deps := mocks.NewDependencies(t)
 
 	testCases := []struct {
-		name              string
-		codec             codec.Codec
-		logger            log.Logger
-		sb                *collections.SchemaBuilder
-		ForwardingHandler types.PacketHandler[*types.ForwardingPacket]
-		ActionHandler     types.PacketHandler[*types.ActionPacket]
-		expError          string
+		name                 string
+		codec                codec.Codec
+		logger               log.Logger
+		eventService         event.Service
+		sb                   *collections.SchemaBuilder
+		ForwardingHandler    types.PacketHandler[*types.ForwardingPacket]
+		ActionHandler        types.PacketHandler[*types.ActionPacket]
+		PacketDeferrer       types.PacketDeferrer
+		PacketBatcher        types.PacketBatcher
+		RouteFeeHandler      types.RouteFeeHandler
+		AmountLimitValidator types.AmountLimitValidator
+		expError             string
 	}{
 		{
-			name:              "success - passing all correct inputs",
-			codec:             deps.EncCfg.Codec,
-			sb:                collections.NewSchemaBuilder(deps.StoreService),
-			logger:            deps.Logger,
-			ForwardingHandler: &mocks.ForwardingHandler{},
-			ActionHandler:     &mocks.ActionsHandler{},
-			expError:          "",
+			name:                 "success - passing all correct inputs",
+			codec:                deps.EncCfg.Codec,
+			sb:                   collections.NewSchemaBuilder(deps.StoreService),
+			logger:               deps.Logger,
+			eventService:         deps.EventService,
+			ForwardingHandler:    &mocks.ForwardingHandler{},
+			ActionHandler:        &mocks.ActionsHandler{},
+			PacketDeferrer:       &mocks.PacketDeferrer{},
+			PacketBatcher:        &mocks.PacketBatcher{},
+			RouteFeeHandler:      &mocks.RouteFeeHandler{},
+			AmountLimitValidator: &mocks.AmountLimitValidator{},
+			expError:             "",
 		},
 		{
-			name:              "error - nil codec",
-			sb:                collections.NewSchemaBuilder(deps.StoreService),
-			logger:            deps.Logger,
-			ForwardingHandler: &mocks.ForwardingHandler{},
-			ActionHandler:     &mocks.ActionsHandler{},
-			expError:          "codec cannot be nil",
+			name:                 "error - nil codec",
+			sb:                   collections.NewSchemaBuilder(deps.StoreService),
+			logger:               deps.Logger,
+			eventService:         deps.EventService,
+			ForwardingHandler:    &mocks.ForwardingHandler{},
+			ActionHandler:        &mocks.ActionsHandler{},
+			PacketDeferrer:       &mocks.PacketDeferrer{},
+			PacketBatcher:        &mocks.PacketBatcher{},
+			RouteFeeHandler:      &mocks.RouteFeeHandler{},
+			AmountLimitValidator: &mocks.AmountLimitValidator{},
+			expError:             "codec cannot be nil",
 		},
 		{
-			name:              "error - nil schema builder",
+			name:                 "error - nil schema builder",
+			codec:                deps.EncCfg.Codec,
+			logger:               deps.Logger,
+			eventService:         deps.EventService,
+			ForwardingHandler:    &mocks.ForwardingHandler{},
+			ActionHandler:        &mocks.ActionsHandler{},
+			PacketDeferrer:       &mocks.PacketDeferrer{},
+			PacketBatcher:        &mocks.PacketBatcher{},
+			RouteFeeHandler:      &mocks.RouteFeeHandler{},
+			AmountLimitValidator: &mocks.AmountLimitValidator{},
+			expError:             "schema builder cannot be nil",
+		},
+		{
+			name:                 "error - nil logger",
+			codec:                deps.EncCfg.Codec,
+			sb:                   collections.NewSchemaBuilder(deps.StoreService),
+			eventService:         deps.EventService,
+			ForwardingHandler:    &mocks.ForwardingHandler{},
+			ActionHandler:        &mocks.ActionsHandler{},
+			PacketDeferrer:       &mocks.PacketDeferrer{},
+			PacketBatcher:        &mocks.PacketBatcher{},
+			RouteFeeHandler:      &mocks.RouteFeeHandler{},
+			AmountLimitValidator: &mocks.AmountLimitValidator{},
+			expError:             "logger cannot be nil",
+		},
+		{
+			name:                 "error - nil event service",
+			codec:                deps.EncCfg.Codec,
+			sb:                   collections.NewSchemaBuilder(deps.StoreService),
+			logger:               deps.Logger,
+			ForwardingHandler:    &mocks.ForwardingHandler{},
+			ActionHandler:        &mocks.ActionsHandler{},
+			PacketDeferrer:       &mocks.PacketDeferrer{},
+			PacketBatcher:        &mocks.PacketBatcher{},
+			RouteFeeHandler:      &mocks.RouteFeeHandler{},
+			AmountLimitValidator: &mocks.AmountLimitValidator{},
+			expError:             "event service is not set",
+		},
+		{
+			name:                 "error - nil forwarding handler",
+			codec:                deps.EncCfg.Codec,
+			sb:                   collections.NewSchemaBuilder(deps.StoreService),
+			logger:               deps.Logger,
+			eventService:         deps.EventService,
+			ForwardingHandler:    nil,
+			ActionHandler:        &mocks.ActionsHandler{},
+			PacketDeferrer:       &mocks.PacketDeferrer{},
+			PacketBatcher:        &mocks.PacketBatcher{},
+			RouteFeeHandler:      &mocks.RouteFeeHandler{},
+			AmountLimitValidator: &mocks.AmountLimitValidator{},
+			expError:             "forwarding handler is not set",
+		},
+		{
+			name:                 "error - nil actions handler",
+			codec:                deps.EncCfg.Codec,
+			sb:                   collections.NewSchemaBuilder(deps.StoreService),
+			logger:               deps.Logger,
+			eventService:         deps.EventService,
+			ForwardingHandler:    &mocks.ForwardingHandler{},
+			ActionHandler:        nil,
+			PacketDeferrer:       &mocks.PacketDeferrer{},
+			PacketBatcher:        &mocks.PacketBatcher{},
+			RouteFeeHandler:      &mocks.RouteFeeHandler{},
+			AmountLimitValidator: &mocks.AmountLimitValidator{},
+			expError:             "action handler is not set",
+		},
+		{
+			name:              "error - nil packet deferrer",
 			codec:             deps.EncCfg.Codec,
+			sb:                collections.NewSchemaBuilder(deps.StoreService),
 			logger:            deps.Logger,
+			eventService:      deps.EventService,
 			ForwardingHandler: &mocks.ForwardingHandler{},
 			ActionHandler:     &mocks.ActionsHandler{},
-			expError:          "schema builder cannot be nil",
+			expError:          "packet deferrer is not set",
 		},
 		{
-			name:              "error - nil logger",
+			name:              "error - nil packet batcher",
 			codec:             deps.EncCfg.Codec,
 			sb:                collections.NewSchemaBuilder(deps.StoreService),
+			logger:            deps.Logger,
+			eventService:      deps.EventService,
 			ForwardingHandler: &mocks.ForwardingHandler{},
 			ActionHandler:     &mocks.ActionsHandler{},
-			expError:          "logger cannot be nil",
+			PacketDeferrer:    &mocks.PacketDeferrer{},
+			expError:          "packet batcher is not set",
 		},
 		{
-			name:              "error - nil forwarding handler",
+			name:              "error - nil route fee handler",
 			codec:             deps.EncCfg.Codec,
 			sb:                collections.NewSchemaBuilder(deps.StoreService),
 			logger:            deps.Logger,
-			ForwardingHandler: nil,
+			eventService:      deps.EventService,
+			ForwardingHandler: &mocks.ForwardingHandler{},
 			ActionHandler:     &mocks.ActionsHandler{},
-			expError:          "forwarding handler is not set",
+			PacketDeferrer:    &mocks.PacketDeferrer{},
+			PacketBatcher:     &mocks.PacketBatcher{},
+			expError:          "route fee handler is not set",
 		},
 		{
-			name:              "error - nil actions handler",
+			name:              "error - nil amount limit validator",
 			codec:             deps.EncCfg.Codec,
 			sb:                collections.NewSchemaBuilder(deps.StoreService),
 			logger:            deps.Logger,
+			eventService:      deps.EventService,
 			ForwardingHandler: &mocks.ForwardingHandler{},
-			ActionHandler:     nil,
-			expError:          "action handler is not set",
+			ActionHandler:     &mocks.ActionsHandler{},
+			PacketDeferrer:    &mocks.PacketDeferrer{},
+			PacketBatcher:     &mocks.PacketBatcher{},
+			RouteFeeHandler:   &mocks.RouteFeeHandler{},
+			expError:          "amount limit validator is not set",
 		},
 	}
 
@@ -108,8 +210,13 @@ func TestNew(t *testing.T) {
 				tC.codec,
 				tC.sb,
 				tC.logger,
+				tC.eventService,
 				tC.ForwardingHandler,
 				tC.ActionHandler,
+				tC.PacketDeferrer,
+				tC.PacketBatcher,
+				tC.RouteFeeHandler,
+				tC.AmountLimitValidator,
 			)
 
 			if tC.expError != "" {
@@ -187,3 +294,534 @@ func TestValidatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestDispatchPayload_ForwardingLegs(t *testing.T) {
+	newForwarding := func(planet string) *core.Forwarding {
+		f, err := core.NewForwarding(
+			core.PROTOCOL_CCTP,
+			&testdata.TestForwardingAttr{Planet: planet},
+			nil,
+		)
+		require.NoError(t, err)
+
+		return f
+	}
+
+	testCases := []struct {
+		name       string
+		legs       func() []*core.ForwardingLeg
+		maxAmounts map[string]sdkmath.Int
+		failing    bool
+		expError   string
+		expAmounts []int64
+		expReserve []int64
+	}{
+		{
+			name: "success - basis points legs with dust to the first leg",
+			legs: func() []*core.ForwardingLeg {
+				return []*core.ForwardingLeg{
+					{Forwarding: newForwarding("1"), Bps: 3_333},
+					{Forwarding: newForwarding("2"), Bps: 6_667},
+				}
+			},
+			expAmounts: []int64{34, 67},
+			expReserve: []int64{67, 0},
+		},
+		{
+			name: "success - fixed amount and remainder legs",
+			legs: func() []*core.ForwardingLeg {
+				return []*core.ForwardingLeg{
+					{Forwarding: newForwarding("1"), Remainder: true},
+					{Forwarding: newForwarding("2"), Amount: sdkmath.NewInt(40)},
+					{Forwarding: newForwarding("3"), Bps: 5_000},
+				}
+			},
+			expAmounts: []int64{11, 40, 50},
+			expReserve: []int64{90, 50, 0},
+		},
+		{
+			name: "error - legs exceed the transfer amount",
+			legs: func() []*core.ForwardingLeg {
+				return []*core.ForwardingLeg{
+					{Forwarding: newForwarding("1"), Remainder: true},
+					{Forwarding: newForwarding("2"), Amount: sdkmath.NewInt(200)},
+				}
+			},
+			expError: "legs amount 200 exceeds the transfer amount 101",
+		},
+		{
+			name: "error - forwarding leg dispatch fails",
+			legs: func() []*core.ForwardingLeg {
+				return []*core.ForwardingLeg{
+					{Forwarding: newForwarding("1"), Bps: 5_000},
+					{Forwarding: newForwarding("2"), Bps: 5_000},
+				}
+			},
+			failing:  true,
+			expError: "error dispatching forwarding leg 0",
+		},
+		{
+			name: "success - legs to different routes within the limit of each route",
+			legs: func() []*core.ForwardingLeg {
+				return []*core.ForwardingLeg{
+					{Forwarding: newForwarding("1"), Bps: 5_000},
+					{Forwarding: newForwarding("2"), Bps: 5_000},
+				}
+			},
+			maxAmounts: map[string]sdkmath.Int{
+				"2:1": sdkmath.NewInt(60),
+				"2:2": sdkmath.NewInt(60),
+			},
+			expAmounts: []int64{51, 50},
+			expReserve: []int64{50, 0},
+		},
+		{
+			name: "error - legs to the same route above the limit of the route",
+			legs: func() []*core.ForwardingLeg {
+				return []*core.ForwardingLeg{
+					{Forwarding: newForwarding("1"), Bps: 5_000},
+					{Forwarding: newForwarding("1"), Bps: 5_000},
+				}
+			},
+			maxAmounts: map[string]sdkmath.Int{"2:1": sdkmath.NewInt(60)},
+			expError:   "invalid amount forwarded by the legs to 2:1",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			d, deps := mocks.NewDispatcherComponent(t)
+			ctx := deps.SdkCtx.WithValue(mocks.FailingContextKey, tC.failing)
+			d.AmountLimitValidator = &mocks.AmountLimitValidator{MaxAmounts: tC.maxAmounts}
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_IBC,
+				"channel-1",
+				"uusdc",
+				sdkmath.NewInt(101),
+			)
+			require.NoError(t, err)
+
+			legs := tC.legs()
+			err = d.DispatchPayload(ctx, transferAttr, &core.Payload{ForwardingLegs: legs})
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+
+			handler, ok := d.ForwardingHandler.(*mocks.ForwardingHandler)
+			require.True(t, ok)
+			require.Len(t, handler.Packets, len(legs))
+
+			sourceID := core.CrossChainID{
+				ProtocolId:     core.PROTOCOL_IBC,
+				CounterpartyId: "channel-1",
+			}
+			incoming := sdkmath.ZeroInt()
+			for i, packet := range handler.Packets {
+				require.Equal(t, legs[i].Forwarding, packet.Forwarding)
+				require.Equal(t, tC.expAmounts[i], packet.TransferAttributes.DestinationAmount().Int64())
+				require.Equal(t, tC.expReserve[i], packet.ReservedAmount.Int64())
+
+				destID := core.CrossChainID{
+					ProtocolId:     core.PROTOCOL_CCTP,
+					CounterpartyId: fmt.Sprintf("%d", i+1),
+				}
+				amount := d.GetDispatchedAmount(ctx, &sourceID, &destID, "uusdc")
+				require.Equal(t, tC.expAmounts[i], amount.AmountDispatched.Outgoing.Int64())
+				incoming = incoming.Add(amount.AmountDispatched.Incoming)
+
+				counts := d.GetDispatchedCounts(ctx, &sourceID, &destID)
+				require.Equal(t, uint64(1), counts.Count)
+			}
+			require.Equal(t, int64(101), incoming.Int64())
+		})
+	}
+}
+
+func TestDispatchPayload_FallbackForwardings(t *testing.T) {
+	newForwarding := func(protocolID core.ProtocolID, planet string) *core.Forwarding {
+		f, err := core.NewForwarding(protocolID, &testdata.TestForwardingAttr{Planet: planet}, nil)
+		require.NoError(t, err)
+
+		return f
+	}
+
+	testCases := []struct {
+		name             string
+		failingProtocols []core.ProtocolID
+		expError         string
+		expProtocolID    core.ProtocolID
+		expFailures      int
+	}{
+		{
+			name:          "success - primary forwarding is used",
+			expProtocolID: core.PROTOCOL_CCTP,
+		},
+		{
+			name:             "success - first fallback forwarding is used",
+			failingProtocols: []core.ProtocolID{core.PROTOCOL_CCTP},
+			expProtocolID:    core.PROTOCOL_HYPERLANE,
+			expFailures:      1,
+		},
+		{
+			name: "success - last fallback forwarding is used",
+			failingProtocols: []core.ProtocolID{
+				core.PROTOCOL_CCTP,
+				core.PROTOCOL_HYPERLANE,
+			},
+			expProtocolID: core.PROTOCOL_INTERNAL,
+			expFailures:   2,
+		},
+		{
+			name: "error - all forwardings fail",
+			failingProtocols: []core.ProtocolID{
+				core.PROTOCOL_CCTP,
+				core.PROTOCOL_HYPERLANE,
+				core.PROTOCOL_INTERNAL,
+			},
+			expError: "forwarding alternative 2 failed",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			d, deps := mocks.NewDispatcherComponent(t)
+			ctx := deps.SdkCtx
+
+			handler, ok := d.ForwardingHandler.(*mocks.ForwardingHandler)
+			require.True(t, ok)
+			handler.FailingProtocols = tC.failingProtocols
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_IBC,
+				"channel-1",
+				"uusdc",
+				sdkmath.NewInt(100),
+			)
+			require.NoError(t, err)
+
+			payload := &core.Payload{
+				Forwarding: newForwarding(core.PROTOCOL_CCTP, "6"),
+				FallbackForwardings: []*core.Forwarding{
+					newForwarding(core.PROTOCOL_HYPERLANE, "8453"),
+					newForwarding(core.PROTOCOL_INTERNAL, "noble"),
+				},
+			}
+
+			err = d.DispatchPayload(ctx, transferAttr, payload)
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+				require.Empty(t, handler.Packets)
+				require.Empty(t, ctx.EventManager().Events())
+
+				return
+			}
+			require.NoError(t, err)
+
+			require.Len(t, handler.Packets, 1)
+			require.Equal(t, tC.expProtocolID, handler.Packets[0].Forwarding.ProtocolID())
+
+			sourceID := core.CrossChainID{
+				ProtocolId:     core.PROTOCOL_IBC,
+				CounterpartyId: "channel-1",
+			}
+			attr, err := handler.Packets[0].Forwarding.CachedAttributes()
+			require.NoError(t, err)
+			destID := core.CrossChainID{
+				ProtocolId:     tC.expProtocolID,
+				CounterpartyId: attr.CounterpartyID(),
+			}
+			counts := d.GetDispatchedCounts(ctx, &sourceID, &destID)
+			require.Equal(t, uint64(1), counts.Count)
+
+			events := ctx.EventManager().Events()
+			if tC.expFailures == 0 {
+				require.Empty(t, events)
+
+				return
+			}
+			require.Len(t, events, 1)
+			require.Equal(
+				t,
+				proto.MessageName(&dispatchertypes.EventFallbackForwardingUsed{}),
+				events[0].Type,
+			)
+
+			msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+			require.NoError(t, err)
+			event, ok := msg.(*dispatchertypes.EventFallbackForwardingUsed)
+			require.True(t, ok)
+			require.Equal(t, uint32(tC.expFailures), event.Index)
+			require.Equal(t, tC.expProtocolID, event.ProtocolId)
+			require.Len(t, event.Failures, tC.expFailures)
+			for i, failure := range event.Failures {
+				require.Equal(t, uint32(i), failure.Index)
+				require.Equal(t, tC.failingProtocols[i], failure.ProtocolId)
+				require.Contains(t, failure.Reason, tC.failingProtocols[i].String())
+			}
+		})
+	}
+}
+
+func TestDispatchPayload_DeferredForwarding(t *testing.T) {
+	testutil.SetSDKConfig()
+	fallbackRecipient := testutil.NewNobleAddress()
+
+	testCases := []struct {
+		name        string
+		height      int64
+		expDeferred bool
+	}{
+		{
+			name:        "success - forwarding deferred",
+			height:      9,
+			expDeferred: true,
+		},
+		{
+			name:   "success - forwarding executed when the deferral is reached",
+			height: 10,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			d, deps := mocks.NewDispatcherComponent(t)
+			ctx := deps.SdkCtx.WithBlockHeight(tC.height)
+
+			forwarding, err := core.NewForwarding(
+				core.PROTOCOL_CCTP,
+				&testdata.TestForwardingAttr{Planet: "1"},
+				nil,
+			)
+			require.NoError(t, err)
+			forwarding.DeferredUntil = &core.Deferral{Height: 10}
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_IBC,
+				"channel-1",
+				"uusdc",
+				sdkmath.NewInt(100),
+			)
+			require.NoError(t, err)
+
+			payload := &core.Payload{
+				Forwarding:        forwarding,
+				FallbackRecipient: fallbackRecipient,
+			}
+			require.NoError(t, d.DispatchPayload(ctx, transferAttr, payload))
+
+			handler, ok := d.ForwardingHandler.(*mocks.ForwardingHandler)
+			require.True(t, ok)
+			deferrer, ok := d.PacketDeferrer.(*mocks.PacketDeferrer)
+			require.True(t, ok)
+
+			if !tC.expDeferred {
+				require.Len(t, handler.Packets, 1)
+				require.Empty(t, deferrer.Packets)
+
+				return
+			}
+			require.Empty(t, handler.Packets)
+			require.Len(t, deferrer.Packets, 1)
+			require.Equal(t, forwarding, deferrer.Packets[0].Forwarding)
+			require.Equal(t, []string{fallbackRecipient}, deferrer.FallbackRecipients)
+		})
+	}
+}
+
+func TestDispatchPayload_BatchedForwarding(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	testCases := []struct {
+		name       string
+		batch      bool
+		expBatched bool
+	}{
+		{
+			name:       "success - forwarding batched",
+			batch:      true,
+			expBatched: true,
+		},
+		{
+			name: "success - forwarding not batched is dispatched",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			d, deps := mocks.NewDispatcherComponent(t)
+			ctx := deps.SdkCtx
+
+			forwarding, err := core.NewForwarding(
+				core.PROTOCOL_CCTP,
+				&testdata.TestForwardingAttr{Planet: "1"},
+				nil,
+			)
+			require.NoError(t, err)
+			forwarding.Batch = tC.batch
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_IBC,
+				"channel-1",
+				"uusdc",
+				sdkmath.NewInt(100),
+			)
+			require.NoError(t, err)
+
+			payload := &core.Payload{
+				Forwarding:        forwarding,
+				FallbackRecipient: testutil.NewNobleAddress(),
+			}
+			require.NoError(t, d.DispatchPayload(ctx, transferAttr, payload))
+
+			handler, ok := d.ForwardingHandler.(*mocks.ForwardingHandler)
+			require.True(t, ok)
+			batcher, ok := d.PacketBatcher.(*mocks.PacketBatcher)
+			require.True(t, ok)
+
+			if !tC.expBatched {
+				require.Len(t, handler.Packets, 1)
+				require.Empty(t, batcher.Packets)
+
+				return
+			}
+			require.Empty(t, handler.Packets)
+			require.Len(t, batcher.Packets, 1)
+			require.Equal(t, forwarding, batcher.Packets[0].Forwarding)
+			require.Equal(t, sdkmath.NewInt(100), batcher.Packets[0].TransferAttributes.DestinationAmount())
+		})
+	}
+}
+
+func TestDispatchPayload_RouteFees(t *testing.T) {
+	newForwarding := func(planet string) *core.Forwarding {
+		f, err := core.NewForwarding(
+			core.PROTOCOL_CCTP,
+			&testdata.TestForwardingAttr{Planet: planet},
+			nil,
+		)
+		require.NoError(t, err)
+
+		return f
+	}
+	newFallbackPayload := func() *core.Payload {
+		fallback, err := core.NewForwarding(
+			core.PROTOCOL_HYPERLANE,
+			&testdata.TestForwardingAttr{Planet: "1"},
+			nil,
+		)
+		require.NoError(t, err)
+
+		return &core.Payload{
+			Forwarding:          newForwarding("1"),
+			FallbackForwardings: []*core.Forwarding{fallback},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		payload          func() *core.Payload
+		fees             map[string]sdkmath.Int
+		failingProtocols []core.ProtocolID
+		expAmounts       []int64
+		expCollected     []sdkmath.Int
+	}{
+		{
+			name: "success - route fee applied to the forwarding",
+			payload: func() *core.Payload {
+				return &core.Payload{Forwarding: newForwarding("1")}
+			},
+			fees:         map[string]sdkmath.Int{"2:1": sdkmath.NewInt(5)},
+			expAmounts:   []int64{95},
+			expCollected: []sdkmath.Int{sdkmath.NewInt(5)},
+		},
+		{
+			name: "success - no route fee for the destination",
+			payload: func() *core.Payload {
+				return &core.Payload{Forwarding: newForwarding("1")}
+			},
+			fees:       map[string]sdkmath.Int{"2:2": sdkmath.NewInt(5)},
+			expAmounts: []int64{100},
+		},
+		{
+			name:             "success - route fee of the fallback forwarding used is applied",
+			payload:          newFallbackPayload,
+			fees:             map[string]sdkmath.Int{"3:1": sdkmath.NewInt(10)},
+			failingProtocols: []core.ProtocolID{core.PROTOCOL_CCTP},
+			expAmounts:       []int64{90},
+			expCollected:     []sdkmath.Int{sdkmath.NewInt(10)},
+		},
+		{
+			name:    "success - route fee of the failed primary forwarding is not applied",
+			payload: newFallbackPayload,
+			fees: map[string]sdkmath.Int{
+				"2:1": sdkmath.NewInt(5),
+				"3:1": sdkmath.NewInt(10),
+			},
+			failingProtocols: []core.ProtocolID{core.PROTOCOL_CCTP},
+			expAmounts:       []int64{90},
+			// NOTE: the mock handler records the fee of the primary forwarding,
+			// which is discarded with its cached context by the executor.
+			expCollected: []sdkmath.Int{sdkmath.NewInt(5), sdkmath.NewInt(10)},
+		},
+		{
+			name: "success - route fee applied only to the leg of the route",
+			payload: func() *core.Payload {
+				return &core.Payload{
+					ForwardingLegs: []*core.ForwardingLeg{
+						{Forwarding: newForwarding("1"), Bps: 5_000},
+						{Forwarding: newForwarding("2"), Bps: 5_000},
+					},
+				}
+			},
+			fees:         map[string]sdkmath.Int{"2:2": sdkmath.NewInt(10)},
+			expAmounts:   []int64{50, 40},
+			expCollected: []sdkmath.Int{sdkmath.NewInt(10)},
+		},
+		{
+			name: "success - route fee applied to the remainder leg",
+			payload: func() *core.Payload {
+				return &core.Payload{
+					ForwardingLegs: []*core.ForwardingLeg{
+						{Forwarding: newForwarding("1"), Remainder: true},
+						{Forwarding: newForwarding("2"), Amount: sdkmath.NewInt(30)},
+					},
+				}
+			},
+			fees:         map[string]sdkmath.Int{"2:1": sdkmath.NewInt(7)},
+			expAmounts:   []int64{63, 30},
+			expCollected: []sdkmath.Int{sdkmath.NewInt(7)},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			d, deps := mocks.NewDispatcherComponent(t)
+			routeFeeHandler, ok := d.RouteFeeHandler.(*mocks.RouteFeeHandler)
+			require.True(t, ok)
+			routeFeeHandler.Fees = tC.fees
+			handler, ok := d.ForwardingHandler.(*mocks.ForwardingHandler)
+			require.True(t, ok)
+			handler.FailingProtocols = tC.failingProtocols
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_IBC,
+				"channel-1",
+				"uusdc",
+				sdkmath.NewInt(100),
+			)
+			require.NoError(t, err)
+
+			require.NoError(t, d.DispatchPayload(deps.SdkCtx, transferAttr, tC.payload()))
+
+			require.Len(t, handler.Packets, len(tC.expAmounts))
+			for i, packet := range handler.Packets {
+				require.Equal(t, tC.expAmounts[i], packet.TransferAttributes.DestinationAmount().Int64())
+			}
+			require.Equal(t, tC.expCollected, routeFeeHandler.Collected)
+		})
+	}
+}
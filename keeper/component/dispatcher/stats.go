@@ -102,6 +102,10 @@ func (d *Dispatcher) updateDispatchedAmount(
 	if newAmount.Outgoing.IsPositive() {
 		amount.Outgoing = amount.Outgoing.Add(newAmount.Outgoing)
 	}
+	if fees := newAmount.GetFees(); fees.IsPositive() {
+		totalFees := amount.GetFees().Add(fees)
+		amount.Fees = &totalFees
+	}
 
 	return d.SetDispatchedAmount(ctx, sourceID, destID, denom, amount)
 }
@@ -146,8 +150,8 @@ func (d *Dispatcher) BuildDenomDispatchedAmounts(
 	sourceDenom, sourceAmount := attr.SourceDenom(), attr.SourceAmount()
 	destDenom, destAmount := attr.DestinationDenom(), attr.DestinationAmount()
 
-	// We can have at maximum two entries, and at least one.
-	ddas := make([]denomDispatchedAmount, 1, 2)
+	// Without forwarding fees, we can have at maximum two entries, and at least one.
+	ddas := make([]denomDispatchedAmount, 1, 2+len(attr.ForwardingFees()))
 	ddas[0] = denomDispatchedAmount{
 		Denom: sourceDenom,
 		AmountDispatched: dispatchertypes.AmountDispatched{
@@ -174,5 +178,29 @@ func (d *Dispatcher) BuildDenomDispatchedAmounts(
 		})
 	}
 
+	// Forwarding fees are added to the entry with the same denom, or
+	// appended as a new entry if the fee is paid in a different denom.
+	for _, fee := range attr.ForwardingFees() {
+		found := false
+		for i := range ddas {
+			if ddas[i].Denom == fee.Denom {
+				ddas[i].AmountDispatched.Fees = &fee.Amount
+				found = true
+
+				break
+			}
+		}
+		if !found {
+			ddas = append(ddas, denomDispatchedAmount{
+				Denom: fee.Denom,
+				AmountDispatched: dispatchertypes.AmountDispatched{
+					Incoming: sdkmath.ZeroInt(),
+					Outgoing: sdkmath.ZeroInt(),
+					Fees:     &fee.Amount,
+				},
+			})
+		}
+	}
+
 	return ddas, nil
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/noble-assets/orbiter/v2/keeper/component/dispatcher"
 	"github.com/noble-assets/orbiter/v2/testutil/mocks"
@@ -339,6 +340,45 @@ func TestBuildDenomDispatchedAmounts(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "forwarding fee with the same denom",
+			transferAttributes: func() *core.TransferAttributes {
+				ta, err := core.NewTransferAttributes(1, "channel-1", "uusdc", sdkmath.NewInt(100))
+				require.NoError(t, err)
+				ta.SetDestinationAmount(sdkmath.NewInt(90))
+				ta.AddForwardingFee(sdk.NewInt64Coin("uusdc", 10))
+
+				return ta
+			},
+			expAmounts: map[string]dispatchertypes.AmountDispatched{
+				"uusdc": {
+					Incoming: sdkmath.NewInt(100),
+					Outgoing: sdkmath.NewInt(90),
+					Fees:     ptr(sdkmath.NewInt(10)),
+				},
+			},
+		},
+		{
+			name: "forwarding fee with a different denom",
+			transferAttributes: func() *core.TransferAttributes {
+				ta, err := core.NewTransferAttributes(1, "channel-1", "uusdc", sdkmath.NewInt(100))
+				require.NoError(t, err)
+				ta.AddForwardingFee(sdk.NewInt64Coin("unoble", 10))
+
+				return ta
+			},
+			expAmounts: map[string]dispatchertypes.AmountDispatched{
+				"uusdc": {
+					Incoming: sdkmath.NewInt(100),
+					Outgoing: sdkmath.NewInt(100),
+				},
+				"unoble": {
+					Incoming: sdkmath.ZeroInt(),
+					Outgoing: sdkmath.ZeroInt(),
+					Fees:     ptr(sdkmath.NewInt(10)),
+				},
+			},
+		},
 	}
 
 	for _, tC := range testCases {
@@ -365,8 +405,13 @@ func TestBuildDenomDispatchedAmounts(t *testing.T) {
 					require.True(t, exists)
 					require.Equal(t, expectedAmount.Incoming, actualAmount.Incoming)
 					require.Equal(t, expectedAmount.Outgoing, actualAmount.Outgoing)
+					require.Equal(t, expectedAmount.GetFees(), actualAmount.GetFees())
 				}
 			}
 		})
 	}
 }
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -24,6 +24,10 @@ import (
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 )
 
+// routeArgs are the positional arguments identifying a route.
+const routeArgs = "[source_protocol_id] [source_counterparty_id] " +
+	"[destination_protocol_id] [destination_counterparty_id] [denom]"
+
 func TxCommandOptions() []*autocliv1.RpcCommandOptions {
 	return []*autocliv1.RpcCommandOptions{
 		{
@@ -46,6 +50,57 @@ will allow the action to be executed again.`,
 				{ProtoField: "action_id"},
 			},
 		},
+		{
+			RpcMethod: "SetRouteFee",
+			Use:       "set-route-fee " + routeArgs + " [fee]",
+			Short:     "Set the fee of a route",
+			Long: `Set the fee applied to the transfers of a denom from a source to a destination. 
+The fee is expressed in basis points, raised to the minimum amount and capped to the maximum 
+amount when set. This message can only be sent by the authority of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "source_protocol_id"},
+				{ProtoField: "source_counterparty_id"},
+				{ProtoField: "destination_protocol_id"},
+				{ProtoField: "destination_counterparty_id"},
+				{ProtoField: "denom"},
+				{ProtoField: "fee"},
+			},
+		},
+		{
+			RpcMethod: "RemoveRouteFee",
+			Use:       "remove-route-fee " + routeArgs,
+			Short:     "Remove the fee of a route",
+			Long: `Remove the fee applied to the transfers of a denom from a source to a 
+destination. This message can only be sent by the authority of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "source_protocol_id"},
+				{ProtoField: "source_counterparty_id"},
+				{ProtoField: "destination_protocol_id"},
+				{ProtoField: "destination_counterparty_id"},
+				{ProtoField: "denom"},
+			},
+		},
+		{
+			RpcMethod: "SetIntegrator",
+			Use:       "set-integrator [integrator]",
+			Short:     "Register or update an integrator",
+			Long: `Register or update an integrator with its identifier, payout address, default fee 
+in basis points and optional treasury share. This message can only be sent by the authority 
+of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "integrator"},
+			},
+		},
+		{
+			RpcMethod: "RemoveIntegrator",
+			Use:       "remove-integrator [integrator_id]",
+			Short:     "Remove a registered integrator",
+			Long: `Remove a registered integrator. The stats of the integrator are preserved. This 
+message can only be sent by the authority of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "integrator_id"},
+			},
+		},
 	}
 }
 
@@ -64,5 +119,60 @@ func QueryCommandOptions() []*autocliv1.RpcCommandOptions {
 			Use:       "paused-actions",
 			Short:     "Get all paused action IDs",
 		},
+		{
+			RpcMethod: "RouteFees",
+			Use:       "route-fees",
+			Short:     "Get all the route fees",
+		},
+		{
+			RpcMethod: "RouteFee",
+			Use:       "route-fee " + routeArgs,
+			Short:     "Get the fee of a route",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "source_protocol_id"},
+				{ProtoField: "source_counterparty_id"},
+				{ProtoField: "destination_protocol_id"},
+				{ProtoField: "destination_counterparty_id"},
+				{ProtoField: "denom"},
+			},
+		},
+		{
+			RpcMethod: "RouteFeesCollected",
+			Use:       "route-fees-collected",
+			Short:     "Get the total fees collected for all the routes",
+		},
+		{
+			RpcMethod: "RouteFeeCollected",
+			Use:       "route-fee-collected " + routeArgs,
+			Short:     "Get the total fee collected for a route",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "source_protocol_id"},
+				{ProtoField: "source_counterparty_id"},
+				{ProtoField: "destination_protocol_id"},
+				{ProtoField: "destination_counterparty_id"},
+				{ProtoField: "denom"},
+			},
+		},
+		{
+			RpcMethod: "Integrators",
+			Use:       "integrators",
+			Short:     "Get all the registered integrators",
+		},
+		{
+			RpcMethod: "Integrator",
+			Use:       "integrator [integrator_id]",
+			Short:     "Get a registered integrator",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "integrator_id"},
+			},
+		},
+		{
+			RpcMethod: "IntegratorStats",
+			Use:       "integrator-stats [integrator_id]",
+			Short:     "Get the volume stats of an integrator per denom",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "integrator_id"},
+			},
+		},
 	}
 }
@@ -23,7 +23,6 @@ package executor
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/event"
@@ -33,13 +32,18 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/noble-assets/orbiter/v2/types"
+	executortypes "github.com/noble-assets/orbiter/v2/types/component/executor"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
 	"github.com/noble-assets/orbiter/v2/types/core"
 	"github.com/noble-assets/orbiter/v2/types/router"
 )
 
 type ActionRouter = *router.Router[core.ActionID, types.ActionController]
 
-var _ types.Executor = &Executor{}
+var (
+	_ types.Executor               = &Executor{}
+	_ actiontypes.IntegratorKeeper = &Executor{}
+)
 
 type Executor struct {
 	logger       log.Logger
@@ -48,6 +52,14 @@ type Executor struct {
 	router ActionRouter
 	// PausedActions keeps track of the ids of paused actions.
 	PausedActions collections.KeySet[int32]
+	// routeFees stores the fees defined by the authority per route.
+	routeFees collections.Map[RouteFeesKey, executortypes.RouteFee]
+	// routeFeesCollected stores the total fees collected per route.
+	routeFeesCollected collections.Map[RouteFeesKey, executortypes.RouteFeeCollected]
+	// integrators stores the integrators registered by the authority.
+	integrators collections.Map[string, actiontypes.Integrator]
+	// integratorsStats stores the volume stats per integrator and denom.
+	integratorsStats collections.Map[IntegratorsStatsKey, executortypes.IntegratorStats]
 }
 
 // New returns a validated instance of an executor component.
@@ -77,6 +89,42 @@ func New(
 			core.PausedActionsName,
 			collections.Int32Key,
 		),
+		routeFees: collections.NewMap(
+			sb,
+			core.RouteFeesPrefix,
+			core.RouteFeesName,
+			collections.TripleKeyCodec(
+				collections.StringKey,
+				collections.StringKey,
+				collections.StringKey,
+			),
+			codec.CollValue[executortypes.RouteFee](cdc),
+		),
+		routeFeesCollected: collections.NewMap(
+			sb,
+			core.RouteFeesCollectedPrefix,
+			core.RouteFeesCollectedName,
+			collections.TripleKeyCodec(
+				collections.StringKey,
+				collections.StringKey,
+				collections.StringKey,
+			),
+			codec.CollValue[executortypes.RouteFeeCollected](cdc),
+		),
+		integrators: collections.NewMap(
+			sb,
+			core.IntegratorsPrefix,
+			core.IntegratorsName,
+			collections.StringKey,
+			codec.CollValue[actiontypes.Integrator](cdc),
+		),
+		integratorsStats: collections.NewMap(
+			sb,
+			core.IntegratorsStatsPrefix,
+			core.IntegratorsStatsName,
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[executortypes.IntegratorStats](cdc),
+		),
 	}
 
 	return &executor, executor.Validate()
@@ -147,6 +195,12 @@ func (e *Executor) HandlePacket(
 	packet *types.ActionPacket,
 ) error {
 	if err := e.validatePacket(ctx, packet); err != nil {
+		// NOTE: the paused error is propagated to allow the
+		// packet to be escrowed.
+		if errors.Is(err, core.ErrPaused) {
+			return err
+		}
+
 		return core.ErrValidation.Wrap(err.Error())
 	}
 
@@ -159,6 +213,55 @@ func (e *Executor) HandlePacket(
 	return controller.HandlePacket(ctx, packet)
 }
 
+// HandleRouteFee applies the fee defined for the route of the transfer
+// towards the destination, if any. The fee is collected by the route fee
+// action controller, and the paused state of the action is respected like
+// for any other action. The fee collected is added to the route total.
+func (e *Executor) HandleRouteFee(
+	ctx context.Context,
+	transferAttr *core.TransferAttributes,
+	destinationID core.CrossChainID,
+) error {
+	sourceID, err := core.NewCrossChainID(
+		transferAttr.SourceProtocolID(),
+		transferAttr.SourceCounterpartyID(),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create source cross-chain ID")
+	}
+
+	denom := transferAttr.DestinationDenom()
+	routeFee, found, err := e.GetRouteFee(ctx, sourceID, destinationID, denom)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting route fee")
+	}
+	if !found {
+		return nil
+	}
+
+	action, err := actiontypes.NewRouteFeeAction(&routeFee.Fee)
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating route fee action")
+	}
+
+	packet, err := types.NewActionPacket(transferAttr, action)
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating route fee action packet")
+	}
+
+	amountBefore := transferAttr.DestinationAmount()
+	if err := e.HandlePacket(ctx, packet); err != nil {
+		return err
+	}
+
+	collected := amountBefore.Sub(transferAttr.DestinationAmount())
+	if !collected.IsPositive() {
+		return nil
+	}
+
+	return e.AddRouteFeeCollected(ctx, sourceID, destinationID, denom, collected)
+}
+
 func (e *Executor) validatePacket(ctx context.Context, packet *types.ActionPacket) error {
 	err := packet.Validate()
 	if err != nil {
@@ -184,7 +287,7 @@ func (e *Executor) validateController(
 		return err
 	}
 	if isPaused {
-		return fmt.Errorf("action ID %s is paused", id)
+		return core.ErrPaused.Wrapf("action ID %s is paused", id)
 	}
 
 	return nil
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actionctrl "github.com/noble-assets/orbiter/v2/controller/action"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	executortypes "github.com/noble-assets/orbiter/v2/types/component/executor"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestHandleRouteFee(t *testing.T) {
+	testutil.SetSDKConfig()
+	recipient := testutil.NewNobleAddress()
+
+	sourceID := core.CrossChainID{ProtocolId: core.PROTOCOL_IBC, CounterpartyId: "channel-1"}
+	destinationID := core.CrossChainID{ProtocolId: core.PROTOCOL_CCTP, CounterpartyId: "0"}
+	otherID := core.CrossChainID{ProtocolId: core.PROTOCOL_CCTP, CounterpartyId: "1"}
+
+	testCases := []struct {
+		name          string
+		destinationID core.CrossChainID
+		paused        bool
+		expAmount     int64
+		expCollected  int64
+		expErr        string
+	}{
+		{
+			name:          "success - route fee collected",
+			destinationID: destinationID,
+			expAmount:     990,
+			expCollected:  10,
+		},
+		{
+			name:          "success - no route fee for the route",
+			destinationID: otherID,
+			expAmount:     1_000,
+		},
+		{
+			name:          "error - route fee action paused",
+			destinationID: destinationID,
+			paused:        true,
+			expErr:        core.ErrPaused.Error(),
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			e, deps := mocks.NewExecutorComponent(t)
+			ctx := deps.SdkCtx
+
+			bankKeeper := mocks.NewBankKeeper()
+			bankKeeper.Balances[core.ModuleAddress.String()] = sdk.NewCoins(
+				sdk.NewInt64Coin("uusdc", 1_000),
+			)
+			controller, err := actionctrl.NewRouteFeeController(
+				deps.Logger,
+				deps.EventService,
+				bankKeeper,
+			)
+			require.NoError(t, err)
+			router := e.Router()
+			require.NoError(t, router.AddRoute(controller))
+			require.NoError(t, e.SetRouter(router))
+
+			fee, err := actiontypes.NewRouteFeeAttributes(
+				100,
+				math.ZeroInt(),
+				math.ZeroInt(),
+				recipient,
+			)
+			require.NoError(t, err)
+			routeFee, err := executortypes.NewRouteFee(sourceID, destinationID, "uusdc", *fee)
+			require.NoError(t, err)
+			require.NoError(t, e.SetRouteFee(ctx, routeFee))
+
+			if tC.paused {
+				require.NoError(t, e.Pause(ctx, core.ACTION_ROUTE_FEE))
+			}
+
+			transferAttr, err := core.NewTransferAttributes(
+				sourceID.ProtocolId,
+				sourceID.CounterpartyId,
+				"uusdc",
+				math.NewInt(1_000),
+			)
+			require.NoError(t, err)
+
+			err = e.HandleRouteFee(ctx, transferAttr, tC.destinationID)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Equal(t, int64(1_000), transferAttr.DestinationAmount().Int64())
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.expAmount, transferAttr.DestinationAmount().Int64())
+
+			collected, found, err := e.GetRouteFeeCollected(
+				ctx,
+				sourceID,
+				tC.destinationID,
+				"uusdc",
+			)
+			require.NoError(t, err)
+			if tC.expCollected == 0 {
+				require.False(t, found)
+
+				return
+			}
+			require.True(t, found)
+			require.Equal(t, tC.expCollected, collected.Amount.Int64())
+			require.Equal(
+				t,
+				sdk.NewCoins(sdk.NewInt64Coin("uusdc", tC.expCollected)),
+				bankKeeper.Balances[recipient],
+			)
+
+			// A second transfer on the route accumulates the collected fee.
+			bankKeeper.Balances[core.ModuleAddress.String()] = sdk.NewCoins(
+				sdk.NewInt64Coin("uusdc", 1_000),
+			)
+			transferAttr.SetDestinationAmount(math.NewInt(1_000))
+			require.NoError(t, e.HandleRouteFee(ctx, transferAttr, tC.destinationID))
+
+			collected, _, err = e.GetRouteFeeCollected(ctx, sourceID, tC.destinationID, "uusdc")
+			require.NoError(t, err)
+			require.Equal(t, 2*tC.expCollected, collected.Amount.Int64())
+		})
+	}
+}
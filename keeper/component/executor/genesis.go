@@ -41,6 +41,30 @@ func (e *Executor) InitGenesis(ctx context.Context, g *executortypes.GenesisStat
 		}
 	}
 
+	for _, f := range g.RouteFees {
+		if err := e.SetRouteFee(ctx, f); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis route fee")
+		}
+	}
+
+	for _, c := range g.RouteFeesCollected {
+		if err := e.SetRouteFeeCollected(ctx, c); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis route fee collected")
+		}
+	}
+
+	for _, i := range g.Integrators {
+		if err := e.SetIntegrator(ctx, i); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis integrator")
+		}
+	}
+
+	for _, s := range g.IntegratorsStats {
+		if err := e.SetIntegratorStats(ctx, s); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis integrator stats")
+		}
+	}
+
 	return nil
 }
 
@@ -51,7 +75,31 @@ func (e *Executor) ExportGenesis(ctx context.Context) *executortypes.GenesisStat
 		e.logger.Error("error exporting paused actions", "err", err.Error())
 	}
 
+	routeFees, err := e.GetAllRouteFees(ctx)
+	if err != nil {
+		e.logger.Error("error exporting route fees", "err", err.Error())
+	}
+
+	routeFeesCollected, err := e.GetAllRouteFeesCollected(ctx)
+	if err != nil {
+		e.logger.Error("error exporting route fees collected", "err", err.Error())
+	}
+
+	integrators, err := e.GetAllIntegrators(ctx)
+	if err != nil {
+		e.logger.Error("error exporting integrators", "err", err.Error())
+	}
+
+	integratorsStats, err := e.GetAllIntegratorsStats(ctx)
+	if err != nil {
+		e.logger.Error("error exporting integrators stats", "err", err.Error())
+	}
+
 	return &executortypes.GenesisState{
-		PausedActionIds: paused,
+		PausedActionIds:    paused,
+		RouteFees:          routeFees,
+		RouteFeesCollected: routeFeesCollected,
+		Integrators:        integrators,
+		IntegratorsStats:   integratorsStats,
 	}
 }
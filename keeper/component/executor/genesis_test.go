@@ -25,8 +25,12 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
 	"github.com/noble-assets/orbiter/v2/testutil/mocks"
 	executortypes "github.com/noble-assets/orbiter/v2/types/component/executor"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -87,3 +91,74 @@ func TestExportGenesis(t *testing.T) {
 	genState := e.ExportGenesis(deps.SdkCtx)
 	require.Equal(t, expGenState.String(), genState.String())
 }
+
+func TestGenesis_RouteFees(t *testing.T) {
+	testutil.SetSDKConfig()
+	e, deps := mocks.NewExecutorComponent(t)
+
+	sourceID := core.CrossChainID{ProtocolId: core.PROTOCOL_IBC, CounterpartyId: "channel-0"}
+	destinationID := core.CrossChainID{ProtocolId: core.PROTOCOL_CCTP, CounterpartyId: "0"}
+	routeFee, err := executortypes.NewRouteFee(
+		sourceID,
+		destinationID,
+		"uusdc",
+		actiontypes.RouteFeeAttributes{
+			BasisPoints: 10,
+			MinAmount:   math.ZeroInt(),
+			MaxAmount:   math.ZeroInt(),
+			Recipient:   testutil.NewNobleAddress(),
+		},
+	)
+	require.NoError(t, err)
+	collected, err := executortypes.NewRouteFeeCollected(
+		sourceID,
+		destinationID,
+		"uusdc",
+		math.NewInt(100),
+	)
+	require.NoError(t, err)
+
+	genState := executortypes.GenesisState{
+		PausedActionIds:    []core.ActionID{},
+		RouteFees:          []executortypes.RouteFee{routeFee},
+		RouteFeesCollected: []executortypes.RouteFeeCollected{collected},
+	}
+	require.NoError(t, e.InitGenesis(deps.SdkCtx, &genState))
+
+	exported := e.ExportGenesis(deps.SdkCtx)
+	require.Equal(t, genState.String(), exported.String())
+}
+
+func TestGenesis_Integrators(t *testing.T) {
+	testutil.SetSDKConfig()
+	e, deps := mocks.NewExecutorComponent(t)
+
+	integrator, err := actiontypes.NewIntegrator(
+		"frontend",
+		testutil.NewNobleAddress(),
+		10,
+		1_000,
+		testutil.NewNobleAddress(),
+	)
+	require.NoError(t, err)
+	stats, err := executortypes.NewIntegratorStats(
+		"frontend",
+		"uusdc",
+		math.NewInt(1_000),
+		math.NewInt(1),
+		1,
+	)
+	require.NoError(t, err)
+
+	genState := executortypes.GenesisState{
+		PausedActionIds:    []core.ActionID{},
+		RouteFees:          []executortypes.RouteFee{},
+		RouteFeesCollected: []executortypes.RouteFeeCollected{},
+		Integrators:        []actiontypes.Integrator{integrator},
+		IntegratorsStats:   []executortypes.IntegratorStats{stats},
+	}
+	require.NoError(t, e.InitGenesis(deps.SdkCtx, &genState))
+
+	exported := e.ExportGenesis(deps.SdkCtx)
+	require.Equal(t, genState.String(), exported.String())
+}
@@ -23,8 +23,11 @@ package executor
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/noble-assets/orbiter/v2/types"
 	executortypes "github.com/noble-assets/orbiter/v2/types/component/executor"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -104,3 +107,171 @@ func (s msgServer) UnpauseAction(
 
 	return &executortypes.MsgUnpauseActionResponse{}, nil
 }
+
+// SetRouteFee implements executor.MsgServer.
+func (s msgServer) SetRouteFee(
+	ctx context.Context,
+	msg *executortypes.MsgSetRouteFee,
+) (*executortypes.MsgSetRouteFeeResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	sourceID, destinationID, err := newRouteCrossChainIDs(
+		msg.SourceProtocolId,
+		msg.SourceCounterpartyId,
+		msg.DestinationProtocolId,
+		msg.DestinationCounterpartyId,
+	)
+	if err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	routeFee, err := executortypes.NewRouteFee(sourceID, destinationID, msg.Denom, msg.Fee)
+	if err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	if err := s.Executor.SetRouteFee(ctx, routeFee); err != nil {
+		return nil, errorsmod.Wrap(err, "error setting route fee")
+	}
+
+	if err := s.eventService.EventManager(ctx).Emit(
+		ctx,
+		&executortypes.EventRouteFeeSet{RouteFee: routeFee},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit event")
+	}
+
+	return &executortypes.MsgSetRouteFeeResponse{}, nil
+}
+
+// RemoveRouteFee implements executor.MsgServer.
+func (s msgServer) RemoveRouteFee(
+	ctx context.Context,
+	msg *executortypes.MsgRemoveRouteFee,
+) (*executortypes.MsgRemoveRouteFeeResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	sourceID, destinationID, err := newRouteCrossChainIDs(
+		msg.SourceProtocolId,
+		msg.SourceCounterpartyId,
+		msg.DestinationProtocolId,
+		msg.DestinationCounterpartyId,
+	)
+	if err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	if err := s.Executor.RemoveRouteFee(ctx, sourceID, destinationID, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(err, "error removing route fee")
+	}
+
+	if err := s.eventService.EventManager(ctx).Emit(
+		ctx,
+		&executortypes.EventRouteFeeRemoved{
+			SourceId:      sourceID,
+			DestinationId: destinationID,
+			Denom:         msg.Denom,
+		},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit event")
+	}
+
+	return &executortypes.MsgRemoveRouteFeeResponse{}, nil
+}
+
+// SetIntegrator implements executor.MsgServer.
+func (s msgServer) SetIntegrator(
+	ctx context.Context,
+	msg *executortypes.MsgSetIntegrator,
+) (*executortypes.MsgSetIntegratorResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Integrator.Validate(); err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	if err := s.Executor.SetIntegrator(ctx, msg.Integrator); err != nil {
+		return nil, errorsmod.Wrap(err, "error setting integrator")
+	}
+
+	if err := s.eventService.EventManager(ctx).Emit(
+		ctx,
+		&executortypes.EventIntegratorSet{Integrator: msg.Integrator},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit event")
+	}
+
+	return &executortypes.MsgSetIntegratorResponse{}, nil
+}
+
+// RemoveIntegrator implements executor.MsgServer.
+func (s msgServer) RemoveIntegrator(
+	ctx context.Context,
+	msg *executortypes.MsgRemoveIntegrator,
+) (*executortypes.MsgRemoveIntegratorResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := actiontypes.ValidateIntegratorID(msg.IntegratorId); err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	if err := s.Executor.RemoveIntegrator(ctx, msg.IntegratorId); err != nil {
+		return nil, errorsmod.Wrap(err, "error removing integrator")
+	}
+
+	if err := s.eventService.EventManager(ctx).Emit(
+		ctx,
+		&executortypes.EventIntegratorRemoved{IntegratorId: msg.IntegratorId},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit event")
+	}
+
+	return &executortypes.MsgRemoveIntegratorResponse{}, nil
+}
+
+// newRouteCrossChainIDs returns the validated source and destination
+// cross-chain IDs of a route from their protocol ID strings and
+// counterparty IDs.
+func newRouteCrossChainIDs(
+	sourceProtocolID string,
+	sourceCounterpartyID string,
+	destinationProtocolID string,
+	destinationCounterpartyID string,
+) (core.CrossChainID, core.CrossChainID, error) {
+	sourceProtocol, err := core.NewProtocolIDFromString(sourceProtocolID)
+	if err != nil {
+		return core.CrossChainID{}, core.CrossChainID{}, errorsmod.Wrap(
+			err,
+			"invalid source protocol ID",
+		)
+	}
+	sourceID, err := core.NewCrossChainID(sourceProtocol, sourceCounterpartyID)
+	if err != nil {
+		return core.CrossChainID{}, core.CrossChainID{}, errorsmod.Wrap(err, "invalid source ID")
+	}
+
+	destinationProtocol, err := core.NewProtocolIDFromString(destinationProtocolID)
+	if err != nil {
+		return core.CrossChainID{}, core.CrossChainID{}, errorsmod.Wrap(
+			err,
+			"invalid destination protocol ID",
+		)
+	}
+	destinationID, err := core.NewCrossChainID(destinationProtocol, destinationCounterpartyID)
+	if err != nil {
+		return core.CrossChainID{}, core.CrossChainID{}, errorsmod.Wrap(
+			err,
+			"invalid destination ID",
+		)
+	}
+
+	return sourceID, destinationID, nil
+}
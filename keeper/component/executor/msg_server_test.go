@@ -26,10 +26,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/noble-assets/orbiter/v2/keeper/component/executor"
 	"github.com/noble-assets/orbiter/v2/testutil"
 	mockorbiter "github.com/noble-assets/orbiter/v2/testutil/mocks/orbiter"
 	executortypes "github.com/noble-assets/orbiter/v2/types/component/executor"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -184,3 +187,336 @@ func TestMsgServerUnpauseAction(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgServerSetRouteFee(t *testing.T) {
+	testutil.SetSDKConfig()
+	fee := actiontypes.RouteFeeAttributes{
+		BasisPoints: 10,
+		MinAmount:   math.ZeroInt(),
+		MaxAmount:   math.NewInt(1_000),
+		Recipient:   testutil.NewNobleAddress(),
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *executortypes.MsgSetRouteFee
+		expErr string
+	}{
+		{
+			name: "error - unauthorized signer",
+			msg: &executortypes.MsgSetRouteFee{
+				Signer:                    "noble1invalid",
+				SourceProtocolId:          core.PROTOCOL_IBC.String(),
+				SourceCounterpartyId:      "channel-0",
+				DestinationProtocolId:     core.PROTOCOL_CCTP.String(),
+				DestinationCounterpartyId: "0",
+				Denom:                     "uusdc",
+				Fee:                       fee,
+			},
+			expErr: core.ErrUnauthorized.Error(),
+		},
+		{
+			name: "error - invalid source protocol ID",
+			msg: &executortypes.MsgSetRouteFee{
+				Signer:                    testutil.Authority,
+				SourceProtocolId:          "PROTOCOL_UNKNOWN",
+				SourceCounterpartyId:      "channel-0",
+				DestinationProtocolId:     core.PROTOCOL_CCTP.String(),
+				DestinationCounterpartyId: "0",
+				Denom:                     "uusdc",
+				Fee:                       fee,
+			},
+			expErr: "invalid source protocol ID",
+		},
+		{
+			name: "error - invalid destination ID",
+			msg: &executortypes.MsgSetRouteFee{
+				Signer:                    testutil.Authority,
+				SourceProtocolId:          core.PROTOCOL_IBC.String(),
+				SourceCounterpartyId:      "channel-0",
+				DestinationProtocolId:     core.PROTOCOL_CCTP.String(),
+				DestinationCounterpartyId: "channel-0",
+				Denom:                     "uusdc",
+				Fee:                       fee,
+			},
+			expErr: "invalid destination ID",
+		},
+		{
+			name: "error - invalid fee",
+			msg: &executortypes.MsgSetRouteFee{
+				Signer:                    testutil.Authority,
+				SourceProtocolId:          core.PROTOCOL_IBC.String(),
+				SourceCounterpartyId:      "channel-0",
+				DestinationProtocolId:     core.PROTOCOL_CCTP.String(),
+				DestinationCounterpartyId: "0",
+				Denom:                     "uusdc",
+				Fee: actiontypes.RouteFeeAttributes{
+					MinAmount: math.ZeroInt(),
+					MaxAmount: math.ZeroInt(),
+					Recipient: fee.Recipient,
+				},
+			},
+			expErr: "route fee basis points or minimum amount must be set",
+		},
+		{
+			name: "success - valid route fee",
+			msg: &executortypes.MsgSetRouteFee{
+				Signer:                    testutil.Authority,
+				SourceProtocolId:          core.PROTOCOL_IBC.String(),
+				SourceCounterpartyId:      "channel-0",
+				DestinationProtocolId:     core.PROTOCOL_CCTP.String(),
+				DestinationCounterpartyId: "0",
+				Denom:                     "uusdc",
+				Fee:                       fee,
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			ctx, _, k := mockorbiter.OrbiterKeeper(t)
+			msgServer := executor.NewMsgServer(k.Executor(), k)
+
+			resp, err := msgServer.SetRouteFee(ctx, tC.msg)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, resp)
+
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			sourceID := core.CrossChainID{ProtocolId: core.PROTOCOL_IBC, CounterpartyId: "channel-0"}
+			destinationID := core.CrossChainID{ProtocolId: core.PROTOCOL_CCTP, CounterpartyId: "0"}
+			routeFee, found, err := k.Executor().GetRouteFee(ctx, sourceID, destinationID, "uusdc")
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, fee, routeFee.Fee)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Contains(t, events[0].Type, "EventRouteFeeSet")
+		})
+	}
+}
+
+func TestMsgServerRemoveRouteFee(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	sourceID := core.CrossChainID{ProtocolId: core.PROTOCOL_IBC, CounterpartyId: "channel-0"}
+	destinationID := core.CrossChainID{ProtocolId: core.PROTOCOL_CCTP, CounterpartyId: "0"}
+	msg := &executortypes.MsgRemoveRouteFee{
+		Signer:                    testutil.Authority,
+		SourceProtocolId:          core.PROTOCOL_IBC.String(),
+		SourceCounterpartyId:      "channel-0",
+		DestinationProtocolId:     core.PROTOCOL_CCTP.String(),
+		DestinationCounterpartyId: "0",
+		Denom:                     "uusdc",
+	}
+
+	testCases := []struct {
+		name   string
+		setup  func(t *testing.T, ctx context.Context, e *executor.Executor)
+		expErr string
+	}{
+		{
+			name:   "error - route fee not set",
+			expErr: "route fee for 1:channel-0/2:0/uusdc is not set",
+		},
+		{
+			name: "success - route fee removed",
+			setup: func(t *testing.T, ctx context.Context, e *executor.Executor) {
+				t.Helper()
+
+				routeFee, err := executortypes.NewRouteFee(
+					sourceID,
+					destinationID,
+					"uusdc",
+					actiontypes.RouteFeeAttributes{
+						BasisPoints: 10,
+						MinAmount:   math.ZeroInt(),
+						MaxAmount:   math.ZeroInt(),
+						Recipient:   testutil.NewNobleAddress(),
+					},
+				)
+				require.NoError(t, err)
+				require.NoError(t, e.SetRouteFee(ctx, routeFee))
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			ctx, _, k := mockorbiter.OrbiterKeeper(t)
+			msgServer := executor.NewMsgServer(k.Executor(), k)
+
+			if tC.setup != nil {
+				tC.setup(t, ctx, k.Executor())
+			}
+
+			resp, err := msgServer.RemoveRouteFee(ctx, msg)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, resp)
+
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			_, found, err := k.Executor().GetRouteFee(ctx, sourceID, destinationID, "uusdc")
+			require.NoError(t, err)
+			require.False(t, found)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Contains(t, events[0].Type, "EventRouteFeeRemoved")
+		})
+	}
+}
+
+func TestMsgServerSetIntegrator(t *testing.T) {
+	testutil.SetSDKConfig()
+	integrator := actiontypes.Integrator{
+		Id:            "frontend",
+		PayoutAddress: testutil.NewNobleAddress(),
+		BasisPoints:   10,
+	}
+
+	testCases := []struct {
+		name   string
+		msg    *executortypes.MsgSetIntegrator
+		expErr string
+	}{
+		{
+			name: "error - unauthorized signer",
+			msg: &executortypes.MsgSetIntegrator{
+				Signer:     "noble1invalid",
+				Integrator: integrator,
+			},
+			expErr: core.ErrUnauthorized.Error(),
+		},
+		{
+			name: "error - invalid integrator",
+			msg: &executortypes.MsgSetIntegrator{
+				Signer: testutil.Authority,
+				Integrator: actiontypes.Integrator{
+					Id:            "frontend",
+					PayoutAddress: integrator.PayoutAddress,
+					TreasuryShare: 1_000,
+				},
+			},
+			expErr: "invalid treasury address",
+		},
+		{
+			name: "success - valid integrator",
+			msg: &executortypes.MsgSetIntegrator{
+				Signer:     testutil.Authority,
+				Integrator: integrator,
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			ctx, _, k := mockorbiter.OrbiterKeeper(t)
+			msgServer := executor.NewMsgServer(k.Executor(), k)
+
+			resp, err := msgServer.SetIntegrator(ctx, tC.msg)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, resp)
+
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			stored, found, err := k.Executor().GetIntegrator(ctx, integrator.Id)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, integrator, stored)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Contains(t, events[0].Type, "EventIntegratorSet")
+		})
+	}
+}
+
+func TestMsgServerRemoveIntegrator(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	msg := &executortypes.MsgRemoveIntegrator{
+		Signer:       testutil.Authority,
+		IntegratorId: "frontend",
+	}
+
+	testCases := []struct {
+		name   string
+		setup  func(t *testing.T, ctx context.Context, e *executor.Executor)
+		expErr string
+	}{
+		{
+			name:   "error - integrator not set",
+			expErr: "integrator frontend is not set",
+		},
+		{
+			name: "success - integrator removed and stats preserved",
+			setup: func(t *testing.T, ctx context.Context, e *executor.Executor) {
+				t.Helper()
+
+				integrator, err := actiontypes.NewIntegrator(
+					"frontend",
+					testutil.NewNobleAddress(),
+					10,
+					0,
+					"",
+				)
+				require.NoError(t, err)
+				require.NoError(t, e.SetIntegrator(ctx, integrator))
+				require.NoError(t, e.AddIntegratorStats(
+					ctx,
+					"frontend",
+					"uusdc",
+					math.NewInt(1_000),
+					math.NewInt(1),
+				))
+			},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			ctx, _, k := mockorbiter.OrbiterKeeper(t)
+			msgServer := executor.NewMsgServer(k.Executor(), k)
+
+			if tC.setup != nil {
+				tC.setup(t, ctx, k.Executor())
+			}
+
+			resp, err := msgServer.RemoveIntegrator(ctx, msg)
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+				require.Nil(t, resp)
+
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+
+			_, found, err := k.Executor().GetIntegrator(ctx, "frontend")
+			require.NoError(t, err)
+			require.False(t, found)
+
+			stats, err := k.Executor().GetIntegratorStatsByID(ctx, "frontend")
+			require.NoError(t, err)
+			require.Len(t, stats, 1)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			require.Contains(t, events[0].Type, "EventIntegratorRemoved")
+		})
+	}
+}
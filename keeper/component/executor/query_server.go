@@ -29,6 +29,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	executortypes "github.com/noble-assets/orbiter/v2/types/component/executor"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -84,3 +85,184 @@ func (s queryServer) PausedActions(
 		ActionIds: paused,
 	}, nil
 }
+
+// RouteFees implements executor.QueryServer.
+func (s queryServer) RouteFees(
+	ctx context.Context,
+	req *executortypes.QueryRouteFeesRequest,
+) (*executortypes.QueryRouteFeesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	fees, pageResp, err := s.GetPaginatedRouteFees(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &executortypes.QueryRouteFeesResponse{
+		RouteFees:  fees,
+		Pagination: pageResp,
+	}, nil
+}
+
+// RouteFee implements executor.QueryServer.
+func (s queryServer) RouteFee(
+	ctx context.Context,
+	req *executortypes.QueryRouteFeeRequest,
+) (*executortypes.QueryRouteFeeResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	sourceID, destinationID, err := newRouteCrossChainIDs(
+		req.SourceProtocolId,
+		req.SourceCounterpartyId,
+		req.DestinationProtocolId,
+		req.DestinationCounterpartyId,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	fee, found, err := s.GetRouteFee(ctx, sourceID, destinationID, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"route fee for %s not found",
+			executortypes.RouteKey(sourceID, destinationID, req.Denom),
+		)
+	}
+
+	return &executortypes.QueryRouteFeeResponse{
+		RouteFee: fee,
+	}, nil
+}
+
+// RouteFeesCollected implements executor.QueryServer.
+func (s queryServer) RouteFeesCollected(
+	ctx context.Context,
+	req *executortypes.QueryRouteFeesCollectedRequest,
+) (*executortypes.QueryRouteFeesCollectedResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	collected, pageResp, err := s.GetPaginatedRouteFeesCollected(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &executortypes.QueryRouteFeesCollectedResponse{
+		Collected:  collected,
+		Pagination: pageResp,
+	}, nil
+}
+
+// RouteFeeCollected implements executor.QueryServer.
+func (s queryServer) RouteFeeCollected(
+	ctx context.Context,
+	req *executortypes.QueryRouteFeeCollectedRequest,
+) (*executortypes.QueryRouteFeeCollectedResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	sourceID, destinationID, err := newRouteCrossChainIDs(
+		req.SourceProtocolId,
+		req.SourceCounterpartyId,
+		req.DestinationProtocolId,
+		req.DestinationCounterpartyId,
+	)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	collected, found, err := s.GetRouteFeeCollected(ctx, sourceID, destinationID, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"route fee collected for %s not found",
+			executortypes.RouteKey(sourceID, destinationID, req.Denom),
+		)
+	}
+
+	return &executortypes.QueryRouteFeeCollectedResponse{
+		Collected: collected,
+	}, nil
+}
+
+// Integrators implements executor.QueryServer.
+func (s queryServer) Integrators(
+	ctx context.Context,
+	req *executortypes.QueryIntegratorsRequest,
+) (*executortypes.QueryIntegratorsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	integrators, pageResp, err := s.GetPaginatedIntegrators(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &executortypes.QueryIntegratorsResponse{
+		Integrators: integrators,
+		Pagination:  pageResp,
+	}, nil
+}
+
+// Integrator implements executor.QueryServer.
+func (s queryServer) Integrator(
+	ctx context.Context,
+	req *executortypes.QueryIntegratorRequest,
+) (*executortypes.QueryIntegratorResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	if err := actiontypes.ValidateIntegratorID(req.IntegratorId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	integrator, found, err := s.GetIntegrator(ctx, req.IntegratorId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "integrator %s not found", req.IntegratorId)
+	}
+
+	return &executortypes.QueryIntegratorResponse{
+		Integrator: integrator,
+	}, nil
+}
+
+// IntegratorStats implements executor.QueryServer.
+func (s queryServer) IntegratorStats(
+	ctx context.Context,
+	req *executortypes.QueryIntegratorStatsRequest,
+) (*executortypes.QueryIntegratorStatsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	if err := actiontypes.ValidateIntegratorID(req.IntegratorId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stats, err := s.GetIntegratorStatsByID(ctx, req.IntegratorId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &executortypes.QueryIntegratorStatsResponse{
+		Stats: stats,
+	}, nil
+}
@@ -22,9 +22,15 @@ package executor
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	executortypes "github.com/noble-assets/orbiter/v2/types/component/executor"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -93,3 +99,428 @@ func (e *Executor) GetPausedActions(
 
 	return paused, nil
 }
+
+// ====================================================================================================
+// Route fees
+// ====================================================================================================
+
+// RouteFeesKey is defined as:
+// (source cross-chain ID, destination cross-chain ID, denom).
+type RouteFeesKey = collections.Triple[string, string, string]
+
+// GetRouteFee returns the fee of the route and a boolean to indicate
+// whether the fee has been found.
+func (e *Executor) GetRouteFee(
+	ctx context.Context,
+	sourceID core.CrossChainID,
+	destinationID core.CrossChainID,
+	denom string,
+) (executortypes.RouteFee, bool, error) {
+	fee, err := e.routeFees.Get(ctx, routeKey(sourceID, destinationID, denom))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return executortypes.RouteFee{}, false, nil
+		}
+
+		return executortypes.RouteFee{}, false, err
+	}
+
+	return fee, true, nil
+}
+
+// SetRouteFee stores a validated route fee, overwriting the
+// existing fee of the route.
+func (e *Executor) SetRouteFee(ctx context.Context, fee executortypes.RouteFee) error {
+	if err := fee.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid route fee")
+	}
+
+	return e.routeFees.Set(ctx, routeKey(fee.SourceId, fee.DestinationId, fee.Denom), fee)
+}
+
+// RemoveRouteFee removes the fee of the route. Returns an error
+// if the fee does not exist.
+func (e *Executor) RemoveRouteFee(
+	ctx context.Context,
+	sourceID core.CrossChainID,
+	destinationID core.CrossChainID,
+	denom string,
+) error {
+	key := routeKey(sourceID, destinationID, denom)
+
+	found, err := e.routeFees.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return core.ErrAlreadySet.Wrapf(
+			"route fee for %s is not set",
+			executortypes.RouteKey(sourceID, destinationID, denom),
+		)
+	}
+
+	return e.routeFees.Remove(ctx, key)
+}
+
+// GetAllRouteFees returns a slice of all the route fees.
+func (e *Executor) GetAllRouteFees(ctx context.Context) ([]executortypes.RouteFee, error) {
+	fees := make([]executortypes.RouteFee, 0)
+
+	err := e.routeFees.Walk(
+		ctx,
+		nil,
+		func(
+			_ RouteFeesKey,
+			f executortypes.RouteFee,
+		) (bool, error) {
+			fees = append(fees, f)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return fees, nil
+}
+
+func (e *Executor) GetPaginatedRouteFees(
+	ctx context.Context,
+	pagination *query.PageRequest,
+) ([]executortypes.RouteFee, *query.PageResponse, error) {
+	fees, pageRes, err := query.CollectionPaginate(
+		ctx,
+		e.routeFees,
+		pagination,
+		func(
+			_ RouteFeesKey,
+			f executortypes.RouteFee,
+		) (executortypes.RouteFee, error) {
+			return f, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error paginating route fees")
+	}
+
+	return fees, pageRes, nil
+}
+
+// GetRouteFeeCollected returns the total fee collected for the route
+// and a boolean to indicate whether any fee has been collected.
+func (e *Executor) GetRouteFeeCollected(
+	ctx context.Context,
+	sourceID core.CrossChainID,
+	destinationID core.CrossChainID,
+	denom string,
+) (executortypes.RouteFeeCollected, bool, error) {
+	collected, err := e.routeFeesCollected.Get(ctx, routeKey(sourceID, destinationID, denom))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return executortypes.RouteFeeCollected{}, false, nil
+		}
+
+		return executortypes.RouteFeeCollected{}, false, err
+	}
+
+	return collected, true, nil
+}
+
+// SetRouteFeeCollected stores a validated total fee collected
+// for a route, overwriting the existing one.
+func (e *Executor) SetRouteFeeCollected(
+	ctx context.Context,
+	collected executortypes.RouteFeeCollected,
+) error {
+	if err := collected.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid route fee collected")
+	}
+
+	return e.routeFeesCollected.Set(
+		ctx,
+		routeKey(collected.SourceId, collected.DestinationId, collected.Denom),
+		collected,
+	)
+}
+
+// AddRouteFeeCollected adds the amount to the total fee collected for the route.
+func (e *Executor) AddRouteFeeCollected(
+	ctx context.Context,
+	sourceID core.CrossChainID,
+	destinationID core.CrossChainID,
+	denom string,
+	amount math.Int,
+) error {
+	collected, found, err := e.GetRouteFeeCollected(ctx, sourceID, destinationID, denom)
+	if err != nil {
+		return err
+	}
+	if found {
+		amount = amount.Add(collected.Amount)
+	}
+
+	collected, err = executortypes.NewRouteFeeCollected(sourceID, destinationID, denom, amount)
+	if err != nil {
+		return err
+	}
+
+	return e.SetRouteFeeCollected(ctx, collected)
+}
+
+// GetAllRouteFeesCollected returns a slice of the total fees collected for all the routes.
+func (e *Executor) GetAllRouteFeesCollected(
+	ctx context.Context,
+) ([]executortypes.RouteFeeCollected, error) {
+	collected := make([]executortypes.RouteFeeCollected, 0)
+
+	err := e.routeFeesCollected.Walk(
+		ctx,
+		nil,
+		func(
+			_ RouteFeesKey,
+			c executortypes.RouteFeeCollected,
+		) (bool, error) {
+			collected = append(collected, c)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return collected, nil
+}
+
+func (e *Executor) GetPaginatedRouteFeesCollected(
+	ctx context.Context,
+	pagination *query.PageRequest,
+) ([]executortypes.RouteFeeCollected, *query.PageResponse, error) {
+	collected, pageRes, err := query.CollectionPaginate(
+		ctx,
+		e.routeFeesCollected,
+		pagination,
+		func(
+			_ RouteFeesKey,
+			c executortypes.RouteFeeCollected,
+		) (executortypes.RouteFeeCollected, error) {
+			return c, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error paginating route fees collected")
+	}
+
+	return collected, pageRes, nil
+}
+
+func routeKey(
+	sourceID core.CrossChainID,
+	destinationID core.CrossChainID,
+	denom string,
+) RouteFeesKey {
+	return collections.Join3(sourceID.ID(), destinationID.ID(), denom)
+}
+
+// ====================================================================================================
+// Integrators
+// ====================================================================================================
+
+// IntegratorsStatsKey is defined as: (integrator ID, denom).
+type IntegratorsStatsKey = collections.Pair[string, string]
+
+// GetIntegrator returns the registered integrator and a boolean to
+// indicate whether the integrator has been found.
+func (e *Executor) GetIntegrator(
+	ctx context.Context,
+	id string,
+) (actiontypes.Integrator, bool, error) {
+	integrator, err := e.integrators.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return actiontypes.Integrator{}, false, nil
+		}
+
+		return actiontypes.Integrator{}, false, err
+	}
+
+	return integrator, true, nil
+}
+
+// SetIntegrator stores a validated integrator, overwriting the
+// existing integrator with the same identifier.
+func (e *Executor) SetIntegrator(ctx context.Context, integrator actiontypes.Integrator) error {
+	if err := integrator.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid integrator")
+	}
+
+	return e.integrators.Set(ctx, integrator.Id, integrator)
+}
+
+// RemoveIntegrator removes the registered integrator. Returns an error
+// if the integrator does not exist. The integrator stats are preserved.
+func (e *Executor) RemoveIntegrator(ctx context.Context, id string) error {
+	found, err := e.integrators.Has(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return core.ErrAlreadySet.Wrapf("integrator %s is not set", id)
+	}
+
+	return e.integrators.Remove(ctx, id)
+}
+
+// GetAllIntegrators returns a slice of all the registered integrators.
+func (e *Executor) GetAllIntegrators(ctx context.Context) ([]actiontypes.Integrator, error) {
+	integrators := make([]actiontypes.Integrator, 0)
+
+	err := e.integrators.Walk(
+		ctx,
+		nil,
+		func(_ string, i actiontypes.Integrator) (bool, error) {
+			integrators = append(integrators, i)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return integrators, nil
+}
+
+func (e *Executor) GetPaginatedIntegrators(
+	ctx context.Context,
+	pagination *query.PageRequest,
+) ([]actiontypes.Integrator, *query.PageResponse, error) {
+	integrators, pageRes, err := query.CollectionPaginate(
+		ctx,
+		e.integrators,
+		pagination,
+		func(_ string, i actiontypes.Integrator) (actiontypes.Integrator, error) {
+			return i, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error paginating integrators")
+	}
+
+	return integrators, pageRes, nil
+}
+
+// GetIntegratorStats returns the stats of the integrator for the denom
+// and a boolean to indicate whether any transfer has been tracked.
+func (e *Executor) GetIntegratorStats(
+	ctx context.Context,
+	id string,
+	denom string,
+) (executortypes.IntegratorStats, bool, error) {
+	stats, err := e.integratorsStats.Get(ctx, collections.Join(id, denom))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return executortypes.IntegratorStats{}, false, nil
+		}
+
+		return executortypes.IntegratorStats{}, false, err
+	}
+
+	return stats, true, nil
+}
+
+// SetIntegratorStats stores validated integrator stats, overwriting
+// the existing ones.
+func (e *Executor) SetIntegratorStats(
+	ctx context.Context,
+	stats executortypes.IntegratorStats,
+) error {
+	if err := stats.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid integrator stats")
+	}
+
+	return e.integratorsStats.Set(ctx, collections.Join(stats.IntegratorId, stats.Denom), stats)
+}
+
+// AddIntegratorStats adds a transfer with its volume and fee to the
+// stats of the integrator for the denom.
+func (e *Executor) AddIntegratorStats(
+	ctx context.Context,
+	id string,
+	denom string,
+	volume math.Int,
+	fee math.Int,
+) error {
+	stats, found, err := e.GetIntegratorStats(ctx, id, denom)
+	if err != nil {
+		return err
+	}
+	if !found {
+		stats = executortypes.IntegratorStats{
+			Volume: math.ZeroInt(),
+			Fees:   math.ZeroInt(),
+		}
+	}
+
+	stats, err = executortypes.NewIntegratorStats(
+		id,
+		denom,
+		stats.Volume.Add(volume),
+		stats.Fees.Add(fee),
+		stats.Transfers+1,
+	)
+	if err != nil {
+		return err
+	}
+
+	return e.SetIntegratorStats(ctx, stats)
+}
+
+// GetIntegratorStatsByID returns the stats of the integrator for all
+// the denoms.
+func (e *Executor) GetIntegratorStatsByID(
+	ctx context.Context,
+	id string,
+) ([]executortypes.IntegratorStats, error) {
+	stats := make([]executortypes.IntegratorStats, 0)
+
+	err := e.integratorsStats.Walk(
+		ctx,
+		collections.NewPrefixedPairRange[string, string](id),
+		func(_ IntegratorsStatsKey, s executortypes.IntegratorStats) (bool, error) {
+			stats = append(stats, s)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetAllIntegratorsStats returns a slice of the stats of all the integrators.
+func (e *Executor) GetAllIntegratorsStats(
+	ctx context.Context,
+) ([]executortypes.IntegratorStats, error) {
+	stats := make([]executortypes.IntegratorStats, 0)
+
+	err := e.integratorsStats.Walk(
+		ctx,
+		nil,
+		func(_ IntegratorsStatsKey, s executortypes.IntegratorStats) (bool, error) {
+			stats = append(stats, s)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
@@ -84,6 +84,104 @@ destination caller and mint recipient.`,
 				{ProtoField: "new_mint_recipient"},
 			},
 		},
+		{
+			RpcMethod: "SetHyperlaneTokenRoute",
+			Use:       "set-hyperlane-token-route [denom] [destination_domain] [token_id]",
+			Short:     "Set the Hyperlane warp token used for a denom and destination domain",
+			Long: `Set the Hyperlane warp token used to forward a denom to a destination 
+domain when the forwarding attributes do not specify the token ID. This message can only 
+be sent by the authority of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "denom"},
+				{ProtoField: "destination_domain"},
+				{ProtoField: "token_id"},
+			},
+		},
+		{
+			RpcMethod: "RemoveHyperlaneTokenRoute",
+			Use:       "remove-hyperlane-token-route [denom] [destination_domain]",
+			Short:     "Remove the Hyperlane warp token used for a denom and destination domain",
+			Long: `Remove the Hyperlane warp token used to forward a denom to a destination 
+domain. This message can only be sent by the authority of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "denom"},
+				{ProtoField: "destination_domain"},
+			},
+		},
+		{
+			RpcMethod: "SetAmountLimit",
+			Use:       "set-amount-limit [protocol_id] [counterparty_id] [denom] [min_amount] [max_amount]",
+			Short:     "Set the amount limit of a denom and destination",
+			Long: `Set the minimum and maximum amount of a denom forwarded with a single 
+transfer to a destination. A zero amount disables the corresponding limit. This message 
+can only be sent by the authority of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "protocol_id"},
+				{ProtoField: "counterparty_id"},
+				{ProtoField: "denom"},
+				{ProtoField: "min_amount"},
+				{ProtoField: "max_amount"},
+			},
+		},
+		{
+			RpcMethod: "RemoveAmountLimit",
+			Use:       "remove-amount-limit [protocol_id] [counterparty_id] [denom]",
+			Short:     "Remove the amount limit of a denom and destination",
+			Long: `Remove the minimum and maximum amount of a denom forwarded with a single 
+transfer to a destination. This message can only be sent by the authority of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "protocol_id"},
+				{ProtoField: "counterparty_id"},
+				{ProtoField: "denom"},
+			},
+		},
+		{
+			RpcMethod: "ClaimInFlightRefund",
+			Use:       "claim-in-flight-refund [channel_id] [sequence]",
+			Short:     "Claim the held funds of an in-flight packet whose refund failed",
+			Long: `Send the funds of a failed or timed out IBC packet, held because the refund 
+to the refund recipient failed. The message can be sent by the refund recipient of the 
+packet or by the authority of the module. The funds are sent to the refund recipient 
+unless a different recipient is specified.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "channel_id"},
+				{ProtoField: "sequence"},
+			},
+		},
+		{
+			RpcMethod: "ClaimHeldBatchEntry",
+			Use:       "claim-held-batch-entry [id]",
+			Short:     "Claim the held funds of a batch entry whose fallback transfer failed",
+			Long: `Send the funds of a batch entry, held because the batch transfer failed and
+the funds could not be sent to the fallback recipient of the entry. The message can be sent
+by the fallback recipient of the entry or by the authority of the module. The funds are sent
+to the fallback recipient unless a different recipient is specified.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "id"},
+			},
+		},
+		{
+			RpcMethod: "ClaimHeldEscrowedPacket",
+			Use:       "claim-held-escrowed-packet [id] [recipient]",
+			Short:     "Claim the held funds of an escrowed packet without a fallback recipient",
+			Long: `Send the funds of an escrowed packet without a fallback recipient, held because
+the packet expired or failed to be dispatched. This message can only be sent by the authority
+of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "id"},
+				{ProtoField: "recipient"},
+			},
+		},
+		{
+			RpcMethod: "UpdateParams",
+			Use:       "update-params [params]",
+			Short:     "Update the component's parameters",
+			Long: `Update the component's parameters. This message can only be sent 
+by the authority of the module.`,
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "params"},
+			},
+		},
 	}
 }
 
@@ -119,5 +217,79 @@ func QueryCommandOptions() []*autocliv1.RpcCommandOptions {
 				{ProtoField: "protocol_id"},
 			},
 		},
+		{
+			RpcMethod: "InFlightPackets",
+			Use:       "in-flight-packets",
+			Short:     "Get all the outgoing IBC packets not yet acknowledged",
+		},
+		{
+			RpcMethod: "InFlightPacket",
+			Use:       "in-flight-packet [channel_id] [sequence]",
+			Short:     "Get an outgoing IBC packet not yet acknowledged",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "channel_id"},
+				{ProtoField: "sequence"},
+			},
+		},
+		{
+			RpcMethod: "Params",
+			Use:       "params",
+			Short:     "Get the component's parameters",
+		},
+		{
+			RpcMethod: "EscrowedPackets",
+			Use:       "escrowed-packets",
+			Short:     "Get all the packets held in the escrow queue",
+		},
+		{
+			RpcMethod: "EscrowedPacket",
+			Use:       "escrowed-packet [id]",
+			Short:     "Get a packet held in the escrow queue",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "id"},
+			},
+		},
+		{
+			RpcMethod: "DeferredPackets",
+			Use:       "deferred-packets",
+			Short:     "Get all the packets held in the deferred queue",
+		},
+		{
+			RpcMethod: "DeferredPacket",
+			Use:       "deferred-packet [id]",
+			Short:     "Get a packet held in the deferred queue",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "id"},
+			},
+		},
+		{
+			RpcMethod: "HyperlaneTokenRoutes",
+			Use:       "hyperlane-token-routes",
+			Short:     "Get all the Hyperlane warp token routes",
+		},
+		{
+			RpcMethod: "HyperlaneTokenRoute",
+			Use:       "hyperlane-token-route [denom] [destination_domain]",
+			Short:     "Get the Hyperlane warp token route of a denom and destination domain",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "denom"},
+				{ProtoField: "destination_domain"},
+			},
+		},
+		{
+			RpcMethod: "AmountLimits",
+			Use:       "amount-limits",
+			Short:     "Get all the amount limits",
+		},
+		{
+			RpcMethod: "AmountLimit",
+			Use:       "amount-limit [protocol_id] [counterparty_id] [denom]",
+			Short:     "Get the amount limit of a denom and destination",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+				{ProtoField: "protocol_id"},
+				{ProtoField: "counterparty_id"},
+				{ProtoField: "denom"},
+			},
+		},
 	}
 }
@@ -0,0 +1,371 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var _ types.PacketBatcher = &Forwarder{}
+
+// BatchPacket implements types.PacketBatcher.
+func (f *Forwarder) BatchPacket(
+	ctx context.Context,
+	packet *types.ForwardingPacket,
+	fallbackRecipient string,
+) error {
+	if err := f.validatePacket(ctx, packet); err != nil {
+		return wrapValidationError(err)
+	}
+	if !packet.Forwarding.Batch {
+		return core.ErrValidation.Wrap("forwarding is not batched")
+	}
+
+	attr := packet.TransferAttributes
+	key, err := forwardertypes.NewBatchKey(packet.Forwarding, attr.DestinationDenom())
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating batch key")
+	}
+
+	sourceID, err := core.NewCrossChainID(attr.SourceProtocolID(), attr.SourceCounterpartyID())
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid source cross-chain ID")
+	}
+
+	coin := sdk.NewCoin(attr.DestinationDenom(), attr.DestinationAmount())
+	entry := forwardertypes.BatchEntry{
+		SourceId:          sourceID,
+		Coin:              coin,
+		FallbackRecipient: fallbackRecipient,
+	}
+	if err := entry.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid batch entry")
+	}
+	if err := f.validateFallbackRecipient(fallbackRecipient); err != nil {
+		return err
+	}
+
+	batch, found, err := f.GetForwardingBatch(ctx, key)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting forwarding batch")
+	}
+	if !found {
+		batch = forwardertypes.ForwardingBatch{
+			Key:        key,
+			Forwarding: packet.Forwarding,
+			Coin:       sdk.NewCoin(coin.Denom, math.ZeroInt()),
+		}
+	}
+	batch.Coin = batch.Coin.Add(coin)
+	batch.Entries = append(batch.Entries, entry)
+
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.ModuleName,
+		core.BatchName,
+		sdk.NewCoins(coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error sending funds to the batch account")
+	}
+
+	if err := f.setForwardingBatch(ctx, batch); err != nil {
+		return errorsmod.Wrap(err, "error setting forwarding batch")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventForwardingBatched{
+			Key:      key,
+			SourceId: sourceID,
+			Coin:     coin,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting forwarding batched event")
+	}
+
+	return nil
+}
+
+// ProcessForwardingBatches sends the aggregated amount of each forwarding
+// batch of the block with a single transfer. If the transfer of a batch
+// fails, the funds of each entry are sent to its fallback recipient.
+func (f *Forwarder) ProcessForwardingBatches(ctx context.Context) error {
+	batches, err := f.GetAllForwardingBatches(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get forwarding batches")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, b := range batches {
+		// NOTE: each batch is processed in a cached context to avoid
+		// partial state changes in case of failure.
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := f.processForwardingBatch(cacheCtx, b); err != nil {
+			f.logger.Error(
+				"failed to process forwarding batch",
+				"key", b.Key,
+				"err", err.Error(),
+			)
+
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// processForwardingBatch removes the batch from the store and sends its
+// aggregated amount. If the transfer fails, the funds of each entry are
+// sent to its fallback recipient.
+func (f *Forwarder) processForwardingBatch(
+	ctx sdk.Context,
+	batch forwardertypes.ForwardingBatch,
+) error {
+	if err := f.removeForwardingBatch(ctx, batch.Key); err != nil {
+		return errorsmod.Wrap(err, "error removing forwarding batch")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.BatchName,
+		core.ModuleName,
+		sdk.NewCoins(batch.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error releasing batch funds")
+	}
+
+	packet, err := newForwardingPacketFromBatch(batch)
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating forwarding packet")
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	forwardingErr := f.handleBatchPacket(cacheCtx, packet)
+	if forwardingErr == nil {
+		write()
+
+		attr, err := batch.Forwarding.CachedAttributes()
+		if err != nil {
+			return err
+		}
+
+		return f.eventService.EventManager(ctx).Emit(
+			ctx,
+			&forwardertypes.EventForwardingBatchSent{
+				Key:            batch.Key,
+				ProtocolId:     batch.Forwarding.ProtocolID(),
+				CounterpartyId: attr.CounterpartyID(),
+				Coin:           batch.Coin,
+				Entries:        batch.Entries,
+			},
+		)
+	}
+
+	f.logger.Error(
+		"forwarding batch transfer failed, sending funds to fallback recipients",
+		"key", batch.Key,
+		"err", forwardingErr.Error(),
+	)
+
+	for _, e := range batch.Entries {
+		// NOTE: each entry is sent in a cached context, so that an entry
+		// failing to be sent is held without affecting the other entries.
+		cacheCtx, write := ctx.CacheContext()
+		sendErr := f.sendBatchEntryToFallbackRecipient(cacheCtx, e)
+		if sendErr == nil {
+			write()
+
+			continue
+		}
+
+		f.logger.Error(
+			"failed to send batch entry to fallback recipient, holding funds",
+			"key", batch.Key,
+			"fallback_recipient", e.FallbackRecipient,
+			"err", sendErr.Error(),
+		)
+
+		if err := f.holdBatchEntry(ctx, batch.Key, e, sendErr); err != nil {
+			return errorsmod.Wrap(err, "error holding batch entry")
+		}
+	}
+
+	return f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventForwardingBatchFailed{
+			Key:     batch.Key,
+			Coin:    batch.Coin,
+			Entries: batch.Entries,
+			Reason:  forwardingErr.Error(),
+		},
+	)
+}
+
+// sendBatchEntryToFallbackRecipient sends the funds of the
+// batch entry to its fallback recipient.
+func (f *Forwarder) sendBatchEntryToFallbackRecipient(
+	ctx context.Context,
+	entry forwardertypes.BatchEntry,
+) error {
+	recipient, err := sdk.AccAddressFromBech32(entry.FallbackRecipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid fallback recipient address")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		core.ModuleName,
+		recipient,
+		sdk.NewCoins(entry.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to send funds to fallback recipient")
+	}
+
+	return nil
+}
+
+// holdBatchEntry moves the funds of a batch entry that could not be sent
+// to its fallback recipient to the refunds account and stores the entry.
+func (f *Forwarder) holdBatchEntry(
+	ctx context.Context,
+	batchKey string,
+	entry forwardertypes.BatchEntry,
+	sendErr error,
+) error {
+	id, err := f.heldBatchEntriesSeq.Next(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting held batch entry ID")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.ModuleName,
+		core.RefundsName,
+		sdk.NewCoins(entry.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error sending funds to the refunds account")
+	}
+
+	held := forwardertypes.HeldBatchEntry{
+		Id:       id,
+		BatchKey: batchKey,
+		Entry:    entry,
+	}
+	if err := f.setHeldBatchEntry(ctx, held); err != nil {
+		return errorsmod.Wrap(err, "error setting held batch entry")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventBatchEntryHeld{
+			Id:       id,
+			BatchKey: batchKey,
+			Entry:    entry,
+			Error:    sendErr.Error(),
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting batch entry held event")
+	}
+
+	return nil
+}
+
+// ClaimHeldBatchEntry sends the held funds of a batch entry
+// to the recipient and removes the held entry.
+func (f *Forwarder) ClaimHeldBatchEntry(
+	ctx context.Context,
+	id uint64,
+	recipient string,
+) error {
+	held, found, err := f.GetHeldBatchEntry(ctx, id)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting held batch entry")
+	}
+	if !found {
+		return core.ErrValidation.Wrapf("held batch entry %d not found", id)
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		core.RefundsName,
+		recipientAddr,
+		sdk.NewCoins(held.Entry.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error sending held batch entry funds")
+	}
+
+	if err := f.removeHeldBatchEntry(ctx, id); err != nil {
+		return errorsmod.Wrap(err, "error removing held batch entry")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventHeldBatchEntryClaimed{
+			Id:        id,
+			Coin:      held.Entry.Coin,
+			Recipient: recipient,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting held batch entry claimed event")
+	}
+
+	return nil
+}
+
+// newForwardingPacketFromBatch returns the forwarding packet sending the
+// aggregated amount of the batch. The source of the first entry is used
+// as the source of the aggregated transfer.
+func newForwardingPacketFromBatch(
+	batch forwardertypes.ForwardingBatch,
+) (*types.ForwardingPacket, error) {
+	if len(batch.Entries) == 0 {
+		return nil, errors.New("forwarding batch has no entries")
+	}
+
+	sourceID := batch.Entries[0].SourceId
+	transferAttr, err := core.NewTransferAttributes(
+		sourceID.ProtocolId,
+		sourceID.CounterpartyId,
+		batch.Coin.Denom,
+		batch.Coin.Amount,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error creating transfer attributes")
+	}
+
+	return types.NewForwardingPacket(transferAttr, batch.Forwarding)
+}
@@ -0,0 +1,269 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/keeper/component/forwarder"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func newBatchedForwarding(t *testing.T) *core.Forwarding {
+	t.Helper()
+
+	forwarding, err := forwardingtypes.NewCCTPForwarding(
+		0,
+		testutil.RandomBytes(32),
+		testutil.RandomBytes(32),
+		nil,
+	)
+	require.NoError(t, err)
+	forwarding.Batch = true
+
+	return forwarding
+}
+
+func TestBatchPacket(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	// NOTE: the mock bank keeper tracks the module balance queried
+	// by the forwarder under the module address, which must match
+	// the amount of each batched packet.
+	bk := mocks.NewBankKeeper()
+	bk.Balances[core.ModuleName] = sdk.NewCoins(coin.Add(coin))
+	bk.Balances[core.ModuleAddress.String()] = sdk.NewCoins(coin)
+
+	f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+	types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+	ctx := deps.SdkCtx
+
+	packet := testutil.CreateForwardingPacket(t, "channel-0", coin, newBatchedForwarding(t))
+	other := testutil.CreateForwardingPacket(t, "channel-1", coin, packet.Forwarding)
+
+	fallbackRecipients := []string{testutil.NewNobleAddress(), testutil.NewNobleAddress()}
+	require.NoError(t, f.BatchPacket(ctx, packet, fallbackRecipients[0]))
+	require.NoError(t, f.BatchPacket(ctx, other, fallbackRecipients[1]))
+
+	batches, err := f.GetAllForwardingBatches(ctx)
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+	require.Equal(t, coin.Add(coin), batches[0].Coin)
+	require.Len(t, batches[0].Entries, 2)
+	require.Equal(t, "channel-0", batches[0].Entries[0].SourceId.CounterpartyId)
+	require.Equal(t, fallbackRecipients[1], batches[0].Entries[1].FallbackRecipient)
+	require.Equal(t, sdk.NewCoins(coin.Add(coin)), bk.Balances[core.BatchName])
+	require.True(t, bk.Balances[core.ModuleName].IsZero())
+
+	// A different recipient is aggregated into a different batch.
+	bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+	packet = testutil.CreateForwardingPacket(t, "channel-0", coin, newBatchedForwarding(t))
+	require.NoError(t, f.BatchPacket(ctx, packet, fallbackRecipients[0]))
+
+	batches, err = f.GetAllForwardingBatches(ctx)
+	require.NoError(t, err)
+	require.Len(t, batches, 2)
+
+	packet = testutil.CreateForwardingPacket(t, "channel-0", coin, newBatchedForwarding(t))
+	err = f.BatchPacket(ctx, packet, "")
+	require.ErrorContains(t, err, "invalid batch entry")
+
+	blocked := testutil.NewNobleAddress()
+	bk.Blocked[blocked] = true
+	err = f.BatchPacket(ctx, packet, blocked)
+	require.ErrorContains(t, err, "fallback recipient is not allowed to receive funds")
+}
+
+func TestProcessForwardingBatches(t *testing.T) {
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	testCases := []struct {
+		name        string
+		setup       func(*forwarder.Forwarder)
+		maxAmount   math.Int
+		blockFirst  bool
+		expFallback bool
+		expEvent    string
+	}{
+		{
+			name: "success - batch sent",
+			setup: func(f *forwarder.Forwarder) {
+				controller := mocks.NewForwardingController(core.PROTOCOL_CCTP)
+				require.NoError(t, f.Router().AddRoute(controller))
+			},
+			expEvent: "noble.orbiter.component.forwarder.v1.EventForwardingBatchSent",
+		},
+		{
+			name: "success - aggregated amount above the entries maximum is sent",
+			setup: func(f *forwarder.Forwarder) {
+				controller := mocks.NewForwardingController(core.PROTOCOL_CCTP)
+				require.NoError(t, f.Router().AddRoute(controller))
+			},
+			maxAmount: coin.Amount.AddRaw(50),
+			expEvent:  "noble.orbiter.component.forwarder.v1.EventForwardingBatchSent",
+		},
+		{
+			name:        "success - transfer failure sends funds to fallback recipients",
+			expFallback: true,
+			expEvent:    "noble.orbiter.component.forwarder.v1.EventForwardingBatchFailed",
+		},
+		{
+			name:        "success - entry of a blocked fallback recipient is held",
+			blockFirst:  true,
+			expFallback: true,
+			expEvent:    "noble.orbiter.component.forwarder.v1.EventForwardingBatchFailed",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			testutil.SetSDKConfig()
+
+			bk := mocks.NewBankKeeper()
+			bk.Balances[core.ModuleName] = sdk.NewCoins(coin.Add(coin))
+			bk.Balances[core.ModuleAddress.String()] = sdk.NewCoins(coin)
+
+			f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+			types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+			ctx := deps.SdkCtx
+
+			fallbackRecipients := []string{
+				testutil.NewNobleAddress(),
+				testutil.NewNobleAddress(),
+			}
+			packet := testutil.CreateForwardingPacket(t, "channel-0", coin, newBatchedForwarding(t))
+
+			// NOTE: the limit is enforced on each batched entry,
+			// but not on the aggregated amount of the batch.
+			if !tC.maxAmount.IsNil() {
+				attr, err := packet.Forwarding.CachedAttributes()
+				require.NoError(t, err)
+				require.NoError(t, f.SetAmountLimit(ctx, forwardertypes.AmountLimit{
+					DestinationId: core.CrossChainID{
+						ProtocolId:     core.PROTOCOL_CCTP,
+						CounterpartyId: attr.CounterpartyID(),
+					},
+					Denom:     coin.Denom,
+					MinAmount: math.ZeroInt(),
+					MaxAmount: tC.maxAmount,
+				}))
+			}
+			require.NoError(t, f.BatchPacket(ctx, packet, fallbackRecipients[0]))
+			require.NoError(t, f.BatchPacket(ctx, packet, fallbackRecipients[1]))
+
+			// NOTE: the batch is sent with the aggregated amount.
+			bk.Balances[core.ModuleAddress.String()] = sdk.NewCoins(coin.Add(coin))
+			if tC.setup != nil {
+				tC.setup(f)
+			}
+			if tC.blockFirst {
+				bk.Blocked[fallbackRecipients[0]] = true
+			}
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			require.NoError(t, f.ProcessForwardingBatches(ctx))
+
+			batches, err := f.GetAllForwardingBatches(ctx)
+			require.NoError(t, err)
+			require.Empty(t, batches)
+			require.True(t, bk.Balances[core.BatchName].IsZero())
+
+			held, err := f.GetAllHeldBatchEntries(ctx)
+			require.NoError(t, err)
+
+			switch {
+			case tC.blockFirst:
+				require.Empty(t, bk.Balances[fallbackRecipients[0]])
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[fallbackRecipients[1]])
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.RefundsName])
+				require.True(t, bk.Balances[core.ModuleName].IsZero())
+				require.Len(t, held, 1)
+				require.Equal(t, fallbackRecipients[0], held[0].Entry.FallbackRecipient)
+			case tC.expFallback:
+				for _, r := range fallbackRecipients {
+					require.Equal(t, sdk.NewCoins(coin), bk.Balances[r])
+				}
+				require.True(t, bk.Balances[core.ModuleName].IsZero())
+				require.Empty(t, held)
+			default:
+				for _, r := range fallbackRecipients {
+					require.Empty(t, bk.Balances[r])
+				}
+				require.Equal(t, sdk.NewCoins(coin.Add(coin)), bk.Balances[core.ModuleName])
+			}
+
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events)
+			require.Equal(t, tC.expEvent, events[len(events)-1].Type)
+		})
+	}
+}
+
+func TestClaimHeldBatchEntry(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	bk := mocks.NewBankKeeper()
+	bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+	bk.Balances[core.ModuleAddress.String()] = sdk.NewCoins(coin)
+
+	f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+	types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+	ctx := deps.SdkCtx
+
+	fallbackRecipient := testutil.NewNobleAddress()
+	packet := testutil.CreateForwardingPacket(t, "channel-0", coin, newBatchedForwarding(t))
+	require.NoError(t, f.BatchPacket(ctx, packet, fallbackRecipient))
+
+	// NOTE: no controller is registered, so the batch transfer fails
+	// and the funds of the blocked fallback recipient are held.
+	bk.Blocked[fallbackRecipient] = true
+	require.NoError(t, f.ProcessForwardingBatches(ctx))
+
+	held, err := f.GetAllHeldBatchEntries(ctx)
+	require.NoError(t, err)
+	require.Len(t, held, 1)
+
+	recipient := testutil.NewNobleAddress()
+	err = f.ClaimHeldBatchEntry(ctx, held[0].Id+1, recipient)
+	require.ErrorContains(t, err, "not found")
+
+	require.NoError(t, f.ClaimHeldBatchEntry(ctx, held[0].Id, recipient))
+	require.Equal(t, sdk.NewCoins(coin), bk.Balances[recipient])
+	require.True(t, bk.Balances[core.RefundsName].IsZero())
+
+	_, found, err := f.GetHeldBatchEntry(ctx, held[0].Id)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,269 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder
+
+import (
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var _ types.PacketDeferrer = &Forwarder{}
+
+// DeferPacket implements types.PacketDeferrer.
+func (f *Forwarder) DeferPacket(
+	ctx context.Context,
+	packet *types.ForwardingPacket,
+	fallbackRecipient string,
+) error {
+	if err := packet.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid forwarding packet")
+	}
+
+	id, err := f.deferredPacketsSeq.Next(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting deferred packet ID")
+	}
+
+	attr := packet.TransferAttributes
+	sourceID, err := core.NewCrossChainID(attr.SourceProtocolID(), attr.SourceCounterpartyID())
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid source cross-chain ID")
+	}
+
+	coin := sdk.NewCoin(attr.DestinationDenom(), attr.DestinationAmount())
+	deferredPacket, err := forwardertypes.NewDeferredPacket(
+		id,
+		sourceID,
+		sdk.NewCoin(attr.SourceDenom(), attr.SourceAmount()),
+		coin,
+		packet.Forwarding,
+		fallbackRecipient,
+		sdk.UnwrapSDKContext(ctx).BlockTime(),
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating deferred packet")
+	}
+	if err := f.validateFallbackRecipient(fallbackRecipient); err != nil {
+		return err
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.ModuleName,
+		core.DeferredName,
+		sdk.NewCoins(coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error sending funds to the deferred account")
+	}
+
+	if err := f.setDeferredPacket(ctx, deferredPacket); err != nil {
+		return errorsmod.Wrap(err, "error setting deferred packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventPacketDeferred{
+			Id:            id,
+			Coin:          coin,
+			DeferredUntil: *packet.Forwarding.DeferredUntil,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting packet deferred event")
+	}
+
+	return nil
+}
+
+// ProcessDeferredPackets executes the forwarding of the next deferred
+// packets whose deferral is reached. Packets hitting a paused protocol or
+// cross-chain ID are kept in the queue until the escrow max age, while
+// packets failing for any other reason are sent to their fallback
+// recipient.
+func (f *Forwarder) ProcessDeferredPackets(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	packets, err := f.GetNextReadyDeferredPackets(
+		ctx,
+		sdkCtx.BlockHeight(),
+		sdkCtx.BlockTime(),
+		core.MaxDeferredPacketsPerBlock,
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get deferred packets")
+	}
+
+	for _, p := range packets {
+		// NOTE: each packet is processed in a cached context to avoid
+		// partial state changes in case of failure.
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := f.processDeferredPacket(cacheCtx, p); err != nil {
+			if errors.Is(err, core.ErrPaused) {
+				f.logger.Debug(
+					"deferred packet execution paused",
+					"id", p.Id,
+					"err", err.Error(),
+				)
+
+				continue
+			}
+
+			f.logger.Error(
+				"failed to process deferred packet",
+				"id", p.Id,
+				"err", err.Error(),
+			)
+
+			continue
+		}
+		write()
+	}
+
+	return nil
+}
+
+// processDeferredPacket removes the packet from the deferred queue and
+// executes its forwarding. If the forwarding fails, the funds are sent to
+// the fallback recipient. The paused error is returned to keep the packet
+// in the queue, unless the packet has been ready for longer than the
+// escrow max age.
+func (f *Forwarder) processDeferredPacket(
+	ctx sdk.Context,
+	deferredPacket forwardertypes.DeferredPacket,
+) error {
+	if err := f.removeDeferredPacket(ctx, deferredPacket); err != nil {
+		return errorsmod.Wrap(err, "error removing deferred packet")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.DeferredName,
+		core.ModuleName,
+		sdk.NewCoins(deferredPacket.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error releasing deferred funds")
+	}
+
+	packet, err := newForwardingPacketFromDeferred(deferredPacket)
+	if err != nil {
+		return errorsmod.Wrap(err, "error creating forwarding packet")
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+
+	forwardingErr := f.HandlePacket(cacheCtx, packet)
+	if forwardingErr == nil {
+		write()
+
+		return f.eventService.EventManager(ctx).Emit(
+			ctx,
+			&forwardertypes.EventDeferredPacketExecuted{
+				Id:   deferredPacket.Id,
+				Coin: deferredPacket.Coin,
+			},
+		)
+	}
+
+	if errors.Is(forwardingErr, core.ErrPaused) {
+		params, err := f.GetParams(ctx)
+		if err != nil {
+			return errorsmod.Wrap(err, "error getting params")
+		}
+
+		if !deferredPacket.IsExpired(ctx.BlockTime(), params.EscrowMaxAge()) {
+			return forwardingErr
+		}
+
+		forwardingErr = errorsmod.Wrap(forwardingErr, "deferred packet max age reached")
+	}
+
+	f.logger.Error(
+		"deferred packet forwarding failed, sending funds to fallback recipient",
+		"id", deferredPacket.Id,
+		"fallback_recipient", deferredPacket.FallbackRecipient,
+		"err", forwardingErr.Error(),
+	)
+
+	return f.sendDeferredToFallbackRecipient(ctx, deferredPacket, forwardingErr)
+}
+
+// sendDeferredToFallbackRecipient sends the funds of the deferred
+// packet to its fallback recipient.
+func (f *Forwarder) sendDeferredToFallbackRecipient(
+	ctx context.Context,
+	packet forwardertypes.DeferredPacket,
+	reason error,
+) error {
+	recipient, err := sdk.AccAddressFromBech32(packet.FallbackRecipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid fallback recipient address")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		core.ModuleName,
+		recipient,
+		sdk.NewCoins(packet.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to send funds to fallback recipient")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventDeferredPacketFallback{
+			Id:                packet.Id,
+			FallbackRecipient: packet.FallbackRecipient,
+			Coin:              packet.Coin,
+			Reason:            reason.Error(),
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "failed to emit deferred packet fallback event")
+	}
+
+	return nil
+}
+
+// newForwardingPacketFromDeferred returns the forwarding packet of a
+// deferred packet, restoring the transfer attributes resulting from
+// the payload actions.
+func newForwardingPacketFromDeferred(
+	p forwardertypes.DeferredPacket,
+) (*types.ForwardingPacket, error) {
+	transferAttr, err := core.NewTransferAttributes(
+		p.SourceId.ProtocolId,
+		p.SourceId.CounterpartyId,
+		p.SourceCoin.Denom,
+		p.SourceCoin.Amount,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error creating transfer attributes")
+	}
+	transferAttr.SetDestinationDenom(p.Coin.Denom)
+	transferAttr.SetDestinationAmount(p.Coin.Amount)
+
+	return types.NewForwardingPacket(transferAttr, p.Forwarding)
+}
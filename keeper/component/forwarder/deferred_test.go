@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/keeper/component/forwarder"
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func newDeferredForwarding(t *testing.T, deferral *core.Deferral) *core.Forwarding {
+	t.Helper()
+
+	forwarding, err := forwardingtypes.NewInternalForwarding(testutil.NewNobleAddress())
+	require.NoError(t, err)
+	forwarding.DeferredUntil = deferral
+
+	return forwarding
+}
+
+func TestDeferPacket(t *testing.T) {
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+	blocked := testutil.NewNobleAddress()
+
+	testCases := []struct {
+		name              string
+		setup             func(*mocks.BankKeeper)
+		deferral          *core.Deferral
+		fallbackRecipient string
+		expError          string
+	}{
+		{
+			name: "success - packet deferred",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			deferral:          &core.Deferral{Height: 10},
+			fallbackRecipient: testutil.NewNobleAddress(),
+		},
+		{
+			name:              "error - module account has not enough funds",
+			deferral:          &core.Deferral{Height: 10},
+			fallbackRecipient: testutil.NewNobleAddress(),
+			expError:          "error sending funds to the deferred account",
+		},
+		{
+			name: "error - invalid fallback recipient",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			deferral: &core.Deferral{Height: 10},
+			expError: "invalid fallback recipient address",
+		},
+		{
+			name: "error - blocked fallback recipient",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+				bk.Blocked[blocked] = true
+			},
+			deferral:          &core.Deferral{Height: 10},
+			fallbackRecipient: blocked,
+			expError:          "fallback recipient is not allowed to receive funds",
+		},
+		{
+			name: "error - forwarding is not deferred",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			fallbackRecipient: testutil.NewNobleAddress(),
+			expError:          "deferred forwarding must specify a deferral",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			testutil.SetSDKConfig()
+
+			bk := mocks.NewBankKeeper()
+			if tC.setup != nil {
+				tC.setup(bk)
+			}
+
+			f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+			types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+			ctx := deps.SdkCtx
+
+			forwarding := newDeferredForwarding(t, tC.deferral)
+			packet := testutil.CreateForwardingPacket(t, "channel-0", coin, forwarding)
+
+			err := f.DeferPacket(ctx, packet, tC.fallbackRecipient)
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+
+			packets, err := f.GetAllDeferredPackets(ctx)
+			require.NoError(t, err)
+			require.Len(t, packets, 1)
+			require.Equal(t, coin, packets[0].Coin)
+			require.Equal(t, coin, packets[0].SourceCoin)
+			require.Equal(t, tC.fallbackRecipient, packets[0].FallbackRecipient)
+			require.Equal(t, tC.deferral, packets[0].Forwarding.DeferredUntil)
+			require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.DeferredName])
+			require.True(t, bk.Balances[core.ModuleName].IsZero())
+		})
+	}
+}
+
+func TestProcessDeferredPackets(t *testing.T) {
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	testCases := []struct {
+		name        string
+		height      int64
+		setup       func(*forwarder.Forwarder, sdk.Context, *mocks.BankKeeper)
+		elapsed     time.Duration
+		expDeferred bool
+		expFallback bool
+		expEvent    string
+	}{
+		{
+			name:        "success - deferral not reached",
+			height:      9,
+			expDeferred: true,
+		},
+		{
+			name:   "success - forwarding executed",
+			height: 10,
+			setup: func(f *forwarder.Forwarder, _ sdk.Context, bk *mocks.BankKeeper) {
+				// NOTE: the mock bank keeper tracks the module balance
+				// queried by the forwarder under the module address.
+				bk.Balances[core.ModuleAddress.String()] = sdk.NewCoins(coin)
+
+				controller := mocks.NewForwardingController(core.PROTOCOL_INTERNAL)
+				require.NoError(t, f.Router().AddRoute(controller))
+			},
+			expEvent: "noble.orbiter.component.forwarder.v1.EventDeferredPacketExecuted",
+		},
+		{
+			name:        "success - forwarding failure sends funds to fallback recipient",
+			height:      10,
+			expFallback: true,
+			expEvent:    "noble.orbiter.component.forwarder.v1.EventDeferredPacketFallback",
+		},
+		{
+			name:   "success - paused protocol keeps the packet deferred",
+			height: 10,
+			setup: func(f *forwarder.Forwarder, ctx sdk.Context, _ *mocks.BankKeeper) {
+				require.NoError(t, f.Pause(ctx, core.PROTOCOL_INTERNAL, nil))
+			},
+			expDeferred: true,
+		},
+		{
+			name:   "success - paused protocol keeps the packet deferred before max age",
+			height: 10,
+			setup: func(f *forwarder.Forwarder, ctx sdk.Context, _ *mocks.BankKeeper) {
+				require.NoError(t, f.SetParams(ctx, forwardertypes.Params{EscrowMaxAgeSeconds: 60}))
+				require.NoError(t, f.Pause(ctx, core.PROTOCOL_INTERNAL, nil))
+			},
+			elapsed:     59 * time.Second,
+			expDeferred: true,
+		},
+		{
+			name:   "success - paused protocol sends funds to fallback recipient after max age",
+			height: 10,
+			setup: func(f *forwarder.Forwarder, ctx sdk.Context, _ *mocks.BankKeeper) {
+				require.NoError(t, f.SetParams(ctx, forwardertypes.Params{EscrowMaxAgeSeconds: 60}))
+				require.NoError(t, f.Pause(ctx, core.PROTOCOL_INTERNAL, nil))
+			},
+			elapsed:     60 * time.Second,
+			expFallback: true,
+			expEvent:    "noble.orbiter.component.forwarder.v1.EventDeferredPacketFallback",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			testutil.SetSDKConfig()
+
+			bk := mocks.NewBankKeeper()
+			bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+
+			f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+			types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+			ctx := deps.SdkCtx.WithBlockHeight(1)
+
+			fallbackRecipient := testutil.NewNobleAddress()
+			forwarding := newDeferredForwarding(t, &core.Deferral{Height: 10})
+			packet := testutil.CreateForwardingPacket(t, "channel-0", coin, forwarding)
+			require.NoError(t, f.DeferPacket(ctx, packet, fallbackRecipient))
+
+			ctx = ctx.WithBlockHeight(tC.height).WithEventManager(sdk.NewEventManager())
+			if tC.setup != nil {
+				tC.setup(f, ctx, bk)
+			}
+
+			if tC.elapsed > 0 {
+				// NOTE: a first execution marks the packet as ready. The mock
+				// bank keeper balances changed in the discarded cached context
+				// are restored manually.
+				require.NoError(t, f.ProcessDeferredPackets(ctx))
+				bk.Balances[core.DeferredName] = sdk.NewCoins(coin)
+				bk.Balances[core.ModuleName] = sdk.NewCoins()
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(tC.elapsed))
+			}
+
+			require.NoError(t, f.ProcessDeferredPackets(ctx))
+
+			_, found, err := f.GetDeferredPacket(ctx, 0)
+			require.NoError(t, err)
+			require.Equal(t, tC.expDeferred, found)
+
+			// NOTE: the mock bank keeper balances are not reverted with the
+			// discarded cached context, so they are checked only for the
+			// packets removed from the queue.
+			if tC.expDeferred {
+				return
+			}
+			require.True(t, bk.Balances[core.DeferredName].IsZero())
+
+			if tC.expFallback {
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[fallbackRecipient])
+				require.True(t, bk.Balances[core.ModuleName].IsZero())
+			} else {
+				require.Empty(t, bk.Balances[fallbackRecipient])
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.ModuleName])
+			}
+
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events)
+			require.Equal(t, tC.expEvent, events[len(events)-1].Type)
+		})
+	}
+}
+
+func TestGetNextReadyDeferredPackets(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	bk := mocks.NewBankKeeper()
+	bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+
+	f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+	types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+	ctx := deps.SdkCtx
+
+	now := ctx.BlockTime()
+	later := now.Add(time.Hour)
+	deferrals := []*core.Deferral{
+		{Height: 20},
+		{Height: 10},
+		{Time: &later},
+		{Height: 10, Time: &later},
+	}
+	for _, d := range deferrals {
+		amount := coin.SubAmount(math.NewInt(75))
+		packet := testutil.CreateForwardingPacket(t, "channel-0", amount, newDeferredForwarding(t, d))
+		require.NoError(t, f.DeferPacket(ctx, packet, testutil.NewNobleAddress()))
+	}
+
+	requireIDs := func(packets []forwardertypes.DeferredPacket, expIDs ...uint64) {
+		t.Helper()
+
+		ids := make([]uint64, 0, len(packets))
+		for _, p := range packets {
+			require.NotNil(t, p.ReadyAt)
+			ids = append(ids, p.Id)
+		}
+		require.Equal(t, expIDs, ids)
+	}
+
+	packets, err := f.GetNextReadyDeferredPackets(ctx, 15, now, 10)
+	require.NoError(t, err)
+	requireIDs(packets, 1)
+
+	packets, err = f.GetNextReadyDeferredPackets(ctx, 20, later, 10)
+	require.NoError(t, err)
+	requireIDs(packets, 0, 1, 2, 3)
+
+	// NOTE: the cursor moves after the returned packets and
+	// wraps around to the oldest ready packets.
+	packets, err = f.GetNextReadyDeferredPackets(ctx, 20, later, 3)
+	require.NoError(t, err)
+	requireIDs(packets, 0, 1, 2)
+
+	packets, err = f.GetNextReadyDeferredPackets(ctx, 20, later, 3)
+	require.NoError(t, err)
+	requireIDs(packets, 3, 0, 1)
+
+	all, err := f.GetAllDeferredPackets(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 4)
+}
@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var _ types.PacketEscrow = &Forwarder{}
+
+// EscrowPacket implements types.PacketEscrow.
+func (f *Forwarder) EscrowPacket(
+	ctx context.Context,
+	packet *types.OrbiterPacket,
+	reason string,
+) (bool, error) {
+	// If we obtain an error, we assume the escrow mode is disabled.
+	params, err := f.GetParams(ctx)
+	if err != nil {
+		f.logger.Error("getting params returned an error", "err", err.Error())
+	}
+	if !params.EscrowEnabled {
+		return false, nil
+	}
+
+	id, err := f.escrowedPacketsSeq.Next(ctx)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "error getting escrowed packet ID")
+	}
+
+	sourceID, err := core.NewCrossChainID(
+		packet.TransferAttributes.SourceProtocolID(),
+		packet.TransferAttributes.SourceCounterpartyID(),
+	)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "invalid source cross-chain ID")
+	}
+
+	// NOTE: the source coin is used since the destination coin
+	// could have been mutated by the discarded actions.
+	coin := sdk.NewCoin(
+		packet.TransferAttributes.SourceDenom(),
+		packet.TransferAttributes.SourceAmount(),
+	)
+
+	escrowedPacket, err := forwardertypes.NewEscrowedPacket(
+		id,
+		sourceID,
+		coin,
+		packet.Payload,
+		sdk.UnwrapSDKContext(ctx).BlockTime(),
+		reason,
+	)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "error creating escrowed packet")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.ModuleName,
+		core.EscrowName,
+		sdk.NewCoins(coin),
+	); err != nil {
+		return false, errorsmod.Wrap(err, "error sending funds to escrow")
+	}
+
+	if err := f.setEscrowedPacket(ctx, escrowedPacket); err != nil {
+		return false, errorsmod.Wrap(err, "error setting escrowed packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventPacketEscrowed{
+			Id:       id,
+			SourceId: sourceID,
+			Coin:     coin,
+			Reason:   reason,
+		},
+	); err != nil {
+		return false, errorsmod.Wrap(err, "error emitting packet escrowed event")
+	}
+
+	return true, nil
+}
+
+// IsEscrowedPacketExpired implements types.PacketEscrow.
+func (f *Forwarder) IsEscrowedPacketExpired(
+	ctx context.Context,
+	packet forwardertypes.EscrowedPacket,
+) (bool, error) {
+	params, err := f.GetParams(ctx)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "error getting params")
+	}
+
+	return packet.IsExpired(
+		sdk.UnwrapSDKContext(ctx).BlockTime(),
+		params.EscrowMaxAge(),
+	), nil
+}
+
+// ReleaseEscrowedPacket implements types.PacketEscrow.
+func (f *Forwarder) ReleaseEscrowedPacket(ctx context.Context, id uint64) error {
+	packet, found, err := f.GetEscrowedPacket(ctx, id)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting escrowed packet")
+	}
+	if !found {
+		return core.ErrValidation.Wrapf("escrowed packet %d not found", id)
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.EscrowName,
+		core.ModuleName,
+		sdk.NewCoins(packet.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error releasing escrowed funds")
+	}
+
+	if err := f.removeEscrowedPacket(ctx, id); err != nil {
+		return errorsmod.Wrap(err, "error removing escrowed packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventEscrowedPacketReleased{
+			Id:   id,
+			Coin: packet.Coin,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting escrowed packet released event")
+	}
+
+	return nil
+}
+
+// HoldEscrowedPacket implements types.PacketEscrow.
+func (f *Forwarder) HoldEscrowedPacket(ctx context.Context, id uint64, reason string) error {
+	packet, found, err := f.GetEscrowedPacket(ctx, id)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting escrowed packet")
+	}
+	if !found {
+		return core.ErrValidation.Wrapf("escrowed packet %d not found", id)
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.EscrowName,
+		core.RefundsName,
+		sdk.NewCoins(packet.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error sending escrowed funds to the refunds account")
+	}
+
+	if err := f.removeEscrowedPacket(ctx, id); err != nil {
+		return errorsmod.Wrap(err, "error removing escrowed packet")
+	}
+	if err := f.setHeldEscrowedPacket(ctx, packet); err != nil {
+		return errorsmod.Wrap(err, "error setting held escrowed packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventEscrowedPacketHeld{
+			Id:     id,
+			Coin:   packet.Coin,
+			Reason: reason,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting escrowed packet held event")
+	}
+
+	return nil
+}
+
+// ClaimHeldEscrowedPacket sends the held funds of an escrowed
+// packet to the recipient and removes the held packet.
+func (f *Forwarder) ClaimHeldEscrowedPacket(
+	ctx context.Context,
+	id uint64,
+	recipient string,
+) error {
+	packet, found, err := f.GetHeldEscrowedPacket(ctx, id)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting held escrowed packet")
+	}
+	if !found {
+		return core.ErrValidation.Wrapf("held escrowed packet %d not found", id)
+	}
+
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid recipient address")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		core.RefundsName,
+		recipientAddr,
+		sdk.NewCoins(packet.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error sending held escrowed packet funds")
+	}
+
+	if err := f.removeHeldEscrowedPacket(ctx, id); err != nil {
+		return errorsmod.Wrap(err, "error removing held escrowed packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventHeldEscrowedPacketClaimed{
+			Id:        id,
+			Coin:      packet.Coin,
+			Recipient: recipient,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting held escrowed packet claimed event")
+	}
+
+	return nil
+}
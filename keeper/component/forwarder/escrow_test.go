@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func newEscrowTestPacket(t *testing.T, coin sdk.Coin) *types.OrbiterPacket {
+	t.Helper()
+
+	forwarding, err := forwardingtypes.NewInternalForwarding(testutil.NewNobleAddress())
+	require.NoError(t, err)
+
+	packet := testutil.CreateForwardingPacket(t, "channel-0", coin, forwarding)
+
+	return &types.OrbiterPacket{
+		TransferAttributes: packet.TransferAttributes,
+		Payload: &core.Payload{
+			Forwarding:        packet.Forwarding,
+			FallbackRecipient: testutil.NewNobleAddress(),
+		},
+	}
+}
+
+func TestEscrowPacket(t *testing.T) {
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		setup     func(*mocks.BankKeeper)
+		params    forwardertypes.Params
+		expError  string
+		expEscrow bool
+	}{
+		{
+			name:   "success - escrow disabled",
+			params: forwardertypes.DefaultParams(),
+		},
+		{
+			name:     "error - module account has not enough funds",
+			params:   forwardertypes.Params{EscrowEnabled: true, EscrowMaxAgeSeconds: 60},
+			expError: "error sending funds to escrow",
+		},
+		{
+			name: "success - packet escrowed",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			params:    forwardertypes.Params{EscrowEnabled: true, EscrowMaxAgeSeconds: 60},
+			expEscrow: true,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			testutil.SetSDKConfig()
+
+			bk := mocks.NewBankKeeper()
+			if tC.setup != nil {
+				tC.setup(bk)
+			}
+
+			f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+			types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+			ctx := deps.SdkCtx
+			require.NoError(t, f.SetParams(ctx, tC.params))
+
+			packet := newEscrowTestPacket(t, coin)
+
+			escrowed, err := f.EscrowPacket(ctx, packet, "route is paused")
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.expEscrow, escrowed)
+
+			packets, err := f.GetAllEscrowedPackets(ctx)
+			require.NoError(t, err)
+
+			if !tC.expEscrow {
+				require.Empty(t, packets)
+
+				return
+			}
+
+			require.Len(t, packets, 1)
+			require.Equal(t, coin, packets[0].Coin)
+			require.Equal(t, packet.Payload.FallbackRecipient, packets[0].Payload.FallbackRecipient)
+			require.Equal(t, core.PROTOCOL_IBC, packets[0].SourceId.ProtocolId)
+			require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.EscrowName])
+			require.True(t, bk.Balances[core.ModuleName].IsZero())
+		})
+	}
+}
+
+func TestReleaseEscrowedPacket(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	bk := mocks.NewBankKeeper()
+	bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+
+	f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+	types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+	ctx := deps.SdkCtx
+	require.NoError(t, f.SetParams(ctx, forwardertypes.Params{
+		EscrowEnabled:       true,
+		EscrowMaxAgeSeconds: 60,
+	}))
+
+	err := f.ReleaseEscrowedPacket(ctx, 0)
+	require.ErrorContains(t, err, "escrowed packet 0 not found")
+
+	escrowed, err := f.EscrowPacket(ctx, newEscrowTestPacket(t, coin), "route is paused")
+	require.NoError(t, err)
+	require.True(t, escrowed)
+
+	require.NoError(t, f.ReleaseEscrowedPacket(ctx, 0))
+
+	_, found, err := f.GetEscrowedPacket(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.ModuleName])
+	require.True(t, bk.Balances[core.EscrowName].IsZero())
+}
+
+func TestClaimHeldEscrowedPacket(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+	recipient := testutil.NewNobleAddress()
+
+	bk := mocks.NewBankKeeper()
+	bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+
+	f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+	types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+	ctx := deps.SdkCtx
+	require.NoError(t, f.SetParams(ctx, forwardertypes.Params{
+		EscrowEnabled:       true,
+		EscrowMaxAgeSeconds: 60,
+	}))
+
+	packet := newEscrowTestPacket(t, coin)
+	packet.Payload.FallbackRecipient = ""
+
+	escrowed, err := f.EscrowPacket(ctx, packet, "route is paused")
+	require.NoError(t, err)
+	require.True(t, escrowed)
+
+	err = f.ClaimHeldEscrowedPacket(ctx, 0, recipient)
+	require.ErrorContains(t, err, "held escrowed packet 0 not found")
+
+	require.NoError(t, f.HoldEscrowedPacket(ctx, 0, "escrow max age reached"))
+
+	_, found, err := f.GetEscrowedPacket(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.True(t, bk.Balances[core.EscrowName].IsZero())
+	require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.RefundsName])
+
+	err = f.ClaimHeldEscrowedPacket(ctx, 0, "invalid")
+	require.ErrorContains(t, err, "invalid recipient address")
+
+	require.NoError(t, f.ClaimHeldEscrowedPacket(ctx, 0, recipient))
+
+	_, found, err = f.GetHeldEscrowedPacket(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, found)
+	require.True(t, bk.Balances[core.RefundsName].IsZero())
+	require.Equal(t, sdk.NewCoins(coin), bk.Balances[recipient])
+}
+
+func TestIsEscrowedPacketExpired(t *testing.T) {
+	escrowedAt := time.Unix(1_000, 0)
+
+	testCases := []struct {
+		name       string
+		blockTime  time.Time
+		expExpired bool
+	}{
+		{
+			name:      "success - packet not expired",
+			blockTime: escrowedAt.Add(59 * time.Second),
+		},
+		{
+			name:       "success - packet expired at max age",
+			blockTime:  escrowedAt.Add(60 * time.Second),
+			expExpired: true,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			f, deps := mocks.NewForwarderComponent(t)
+			ctx := deps.SdkCtx.WithBlockTime(tC.blockTime)
+			require.NoError(t, f.SetParams(ctx, forwardertypes.Params{
+				EscrowEnabled:       true,
+				EscrowMaxAgeSeconds: 60,
+			}))
+
+			expired, err := f.IsEscrowedPacketExpired(
+				ctx,
+				forwardertypes.EscrowedPacket{EscrowedAt: escrowedAt},
+			)
+			require.NoError(t, err)
+			require.Equal(t, tC.expExpired, expired)
+		})
+	}
+}
+
+func TestGetNextEscrowedPackets(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	bk := mocks.NewBankKeeper()
+	bk.Balances[core.ModuleName] = sdk.NewCoins(coin.AddAmount(coin.Amount.MulRaw(4)))
+
+	f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+	types.RegisterInterfaces(deps.EncCfg.InterfaceRegistry)
+	ctx := deps.SdkCtx
+	require.NoError(t, f.SetParams(ctx, forwardertypes.Params{
+		EscrowEnabled:       true,
+		EscrowMaxAgeSeconds: 60,
+	}))
+
+	for i := 0; i < 5; i++ {
+		escrowed, err := f.EscrowPacket(ctx, newEscrowTestPacket(t, coin), "route is paused")
+		require.NoError(t, err)
+		require.True(t, escrowed)
+	}
+
+	packetIDs := func(limit int) []uint64 {
+		packets, err := f.GetNextEscrowedPackets(ctx, limit)
+		require.NoError(t, err)
+
+		ids := make([]uint64, 0, len(packets))
+		for _, p := range packets {
+			ids = append(ids, p.Id)
+		}
+
+		return ids
+	}
+
+	// Packets kept in escrow do not prevent the retry of the following ones.
+	require.Equal(t, []uint64{0, 1}, packetIDs(2))
+	require.Equal(t, []uint64{2, 3}, packetIDs(2))
+	require.Equal(t, []uint64{4, 0}, packetIDs(2))
+
+	// Removed packets are skipped.
+	require.NoError(t, f.ReleaseEscrowedPacket(ctx, 2))
+	require.Equal(t, []uint64{1, 3}, packetIDs(2))
+
+	// When all the packets are returned, the next call starts from the oldest.
+	require.Equal(t, []uint64{4, 0, 1, 3}, packetIDs(10))
+	require.Equal(t, []uint64{0, 1, 3, 4}, packetIDs(10))
+}
@@ -24,15 +24,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/event"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
 	"github.com/noble-assets/orbiter/v2/types/core"
 	router "github.com/noble-assets/orbiter/v2/types/router"
 )
@@ -55,6 +59,53 @@ type Forwarder struct {
 	pausedProtocols collections.KeySet[int32]
 	// pausedCrossChains keeps track of the paused protocol id and counterparty id combinations.
 	pausedCrossChains collections.KeySet[collections.Pair[int32, string]]
+	// inFlightPackets keeps track of the outgoing IBC packets indexed by
+	// channel ID and sequence.
+	inFlightPackets collections.Map[collections.Pair[string, uint64], forwardertypes.InFlightPacket]
+	// params are the forwarder component parameters.
+	params collections.Item[forwardertypes.Params]
+	// escrowedPacketsSeq is the sequence used to assign escrowed packets IDs.
+	escrowedPacketsSeq collections.Sequence
+	// escrowedPackets keeps track of the packets held in escrow indexed by ID.
+	escrowedPackets collections.Map[uint64, forwardertypes.EscrowedPacket]
+	// escrowCursor is the ID of the next escrowed packet to retry.
+	escrowCursor collections.Item[uint64]
+	// hypTokenRoutes keeps track of the Hyperlane warp token IDs indexed by
+	// denom and destination domain.
+	hypTokenRoutes collections.Map[collections.Pair[string, uint32], []byte]
+	// deferredPacketsSeq is the sequence used to assign deferred packets IDs.
+	deferredPacketsSeq collections.Sequence
+	// deferredPackets keeps track of the packets held in the deferred queue indexed by ID.
+	deferredPackets collections.Map[uint64, forwardertypes.DeferredPacket]
+	// deferredByHeight indexes the deferred packets waiting
+	// for a block height by height and ID.
+	deferredByHeight collections.KeySet[collections.Pair[uint64, uint64]]
+	// deferredByTime indexes the deferred packets waiting
+	// for a block time by time and ID.
+	deferredByTime collections.KeySet[collections.Pair[time.Time, uint64]]
+	// readyDeferred keeps track of the IDs of the deferred
+	// packets whose deferral is reached.
+	readyDeferred collections.KeySet[uint64]
+	// deferredCursor is the ID of the next ready deferred packet to execute.
+	deferredCursor collections.Item[uint64]
+	// forwardingBatches keeps track of the forwarding batches of the
+	// current block indexed by batch key.
+	forwardingBatches collections.Map[string, forwardertypes.ForwardingBatch]
+	// heldBatchEntriesSeq is the sequence used to assign the held batch entry IDs.
+	heldBatchEntriesSeq collections.Sequence
+	// heldBatchEntries keeps track of the batch entries whose funds are
+	// held in the refunds account indexed by ID.
+	heldBatchEntries collections.Map[uint64, forwardertypes.HeldBatchEntry]
+	// heldEscrowedPackets keeps track of the escrowed packets without a
+	// fallback recipient whose funds are held in the refunds account
+	// indexed by ID.
+	heldEscrowedPackets collections.Map[uint64, forwardertypes.EscrowedPacket]
+	// amountLimits keeps track of the amount limits indexed by destination
+	// protocol ID, counterparty ID, and denom.
+	amountLimits collections.Map[
+		collections.Triple[int32, string, string],
+		forwardertypes.AmountLimit,
+	]
 }
 
 // New returns a validated instance of a forwarding component.
@@ -86,6 +137,117 @@ func New(
 			core.PausedProtocolsName,
 			collections.Int32Key,
 		),
+		inFlightPackets: collections.NewMap(
+			sb,
+			core.InFlightPacketsPrefix,
+			core.InFlightPacketsName,
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[forwardertypes.InFlightPacket](cdc),
+		),
+		params: collections.NewItem(
+			sb,
+			core.ForwarderParamsPrefix,
+			core.ForwarderParamsName,
+			codec.CollValue[forwardertypes.Params](cdc),
+		),
+		escrowedPacketsSeq: collections.NewSequence(
+			sb,
+			core.EscrowedPacketsSeqPrefix,
+			core.EscrowedPacketsSeqName,
+		),
+		escrowedPackets: collections.NewMap(
+			sb,
+			core.EscrowedPacketsPrefix,
+			core.EscrowedPacketsName,
+			collections.Uint64Key,
+			codec.CollValue[forwardertypes.EscrowedPacket](cdc),
+		),
+		escrowCursor: collections.NewItem(
+			sb,
+			core.EscrowCursorPrefix,
+			core.EscrowCursorName,
+			collections.Uint64Value,
+		),
+		hypTokenRoutes: collections.NewMap(
+			sb,
+			core.HypTokenRoutesPrefix,
+			core.HypTokenRoutesName,
+			collections.PairKeyCodec(collections.StringKey, collections.Uint32Key),
+			collections.BytesValue,
+		),
+		deferredPacketsSeq: collections.NewSequence(
+			sb,
+			core.DeferredPacketsSeqPrefix,
+			core.DeferredPacketsSeqName,
+		),
+		deferredPackets: collections.NewMap(
+			sb,
+			core.DeferredPacketsPrefix,
+			core.DeferredPacketsName,
+			collections.Uint64Key,
+			codec.CollValue[forwardertypes.DeferredPacket](cdc),
+		),
+		deferredByHeight: collections.NewKeySet(
+			sb,
+			core.DeferredByHeightPrefix,
+			core.DeferredByHeightName,
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		deferredByTime: collections.NewKeySet(
+			sb,
+			core.DeferredByTimePrefix,
+			core.DeferredByTimeName,
+			collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key),
+		),
+		readyDeferred: collections.NewKeySet(
+			sb,
+			core.ReadyDeferredPrefix,
+			core.ReadyDeferredName,
+			collections.Uint64Key,
+		),
+		deferredCursor: collections.NewItem(
+			sb,
+			core.DeferredCursorPrefix,
+			core.DeferredCursorName,
+			collections.Uint64Value,
+		),
+		forwardingBatches: collections.NewMap(
+			sb,
+			core.ForwardingBatchesPrefix,
+			core.ForwardingBatchesName,
+			collections.StringKey,
+			codec.CollValue[forwardertypes.ForwardingBatch](cdc),
+		),
+		heldBatchEntriesSeq: collections.NewSequence(
+			sb,
+			core.HeldBatchEntriesSeqPrefix,
+			core.HeldBatchEntriesSeqName,
+		),
+		heldBatchEntries: collections.NewMap(
+			sb,
+			core.HeldBatchEntriesPrefix,
+			core.HeldBatchEntriesName,
+			collections.Uint64Key,
+			codec.CollValue[forwardertypes.HeldBatchEntry](cdc),
+		),
+		heldEscrowedPackets: collections.NewMap(
+			sb,
+			core.HeldEscrowedPacketsPrefix,
+			core.HeldEscrowedPacketsName,
+			collections.Uint64Key,
+			codec.CollValue[forwardertypes.EscrowedPacket](cdc),
+		),
+		amountLimits: collections.NewMap(
+			sb,
+			core.AmountLimitsPrefix,
+			core.AmountLimitsName,
+			collections.TripleKeyCodec(
+				collections.Int32Key,
+				collections.StringKey,
+				collections.StringKey,
+			),
+			codec.CollValue[forwardertypes.AmountLimit](cdc),
+		),
 	}
 
 	return &f, f.Validate()
@@ -112,6 +274,10 @@ func (f *Forwarder) Logger() log.Logger {
 	return f.logger
 }
 
+func (f *Forwarder) EventService() event.Service {
+	return f.eventService
+}
+
 func (f *Forwarder) Router() *ForwardingRouter {
 	return f.router
 }
@@ -181,9 +347,32 @@ func (f *Forwarder) HandlePacket(
 	packet *types.ForwardingPacket,
 ) error {
 	if err := f.validatePacket(ctx, packet); err != nil {
-		return core.ErrValidation.Wrap(err.Error())
+		return wrapValidationError(err)
 	}
 
+	return f.routePacket(ctx, packet)
+}
+
+// handleBatchPacket executes the forwarding of the packet aggregating the
+// entries of a batch. The amount limit is not validated on the aggregated
+// amount, since it is already enforced on each entry when batched.
+func (f *Forwarder) handleBatchPacket(
+	ctx context.Context,
+	packet *types.ForwardingPacket,
+) error {
+	if err := f.validatePacketForwarding(ctx, packet); err != nil {
+		return wrapValidationError(err)
+	}
+
+	return f.routePacket(ctx, packet)
+}
+
+// routePacket sends the packet to the controller
+// registered for its forwarding protocol.
+func (f *Forwarder) routePacket(
+	ctx context.Context,
+	packet *types.ForwardingPacket,
+) error {
 	controller, found := f.router.Route(packet.Forwarding.ProtocolID())
 	if !found {
 		return sdkerrors.ErrNotFound.Wrapf(
@@ -207,9 +396,36 @@ func (f *Forwarder) ValidateForwarding(
 	return f.validateCrossChain(ctx, protocolID, counterpartyID)
 }
 
+// validatePacket returns an error if the packet cannot be forwarded,
+// including when its amount is not within the limit of the destination.
 func (f *Forwarder) validatePacket(
 	ctx context.Context,
 	packet *types.ForwardingPacket,
+) error {
+	if err := f.validatePacketForwarding(ctx, packet); err != nil {
+		return err
+	}
+
+	attr, err := packet.Forwarding.CachedAttributes()
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting attributes from forwarding packet")
+	}
+
+	return f.validateAmountLimit(
+		ctx,
+		packet.Forwarding.ProtocolID(),
+		attr.CounterpartyID(),
+		packet.TransferAttributes.DestinationDenom(),
+		packet.TransferAttributes.DestinationAmount(),
+	)
+}
+
+// validatePacketForwarding returns an error if the packet is not valid,
+// if its forwarding route is not available, or if the module balance
+// does not match the packet amount.
+func (f *Forwarder) validatePacketForwarding(
+	ctx context.Context,
+	packet *types.ForwardingPacket,
 ) error {
 	err := packet.Validate()
 	if err != nil {
@@ -234,6 +450,57 @@ func (f *Forwarder) validatePacket(
 	return f.validateInitialConditions(ctx, packet)
 }
 
+// wrapValidationError wraps the error returned by the packet validation
+// into a validation error. The paused error is propagated to allow the
+// packet to be escrowed, and the amount limit errors are propagated to
+// preserve their codes.
+func wrapValidationError(err error) error {
+	if errors.Is(err, core.ErrPaused) ||
+		errors.Is(err, core.ErrAmountBelowMinimum) ||
+		errors.Is(err, core.ErrAmountAboveMaximum) {
+		return err
+	}
+
+	return core.ErrValidation.Wrap(err.Error())
+}
+
+// ValidateAmountLimit returns an error if the amount forwarded to the
+// destination is not within the limit set for it and the denom, if any.
+func (f *Forwarder) ValidateAmountLimit(
+	ctx context.Context,
+	destinationID core.CrossChainID,
+	denom string,
+	amount math.Int,
+) error {
+	return f.validateAmountLimit(
+		ctx,
+		destinationID.ProtocolId,
+		destinationID.CounterpartyId,
+		denom,
+		amount,
+	)
+}
+
+// validateAmountLimit returns an error if the amount forwarded is not
+// within the limit set for the destination and denom, if any.
+func (f *Forwarder) validateAmountLimit(
+	ctx context.Context,
+	protocolID core.ProtocolID,
+	counterpartyID string,
+	denom string,
+	amount math.Int,
+) error {
+	limit, found, err := f.GetAmountLimit(ctx, protocolID, counterpartyID, denom)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting amount limit")
+	}
+	if !found {
+		return nil
+	}
+
+	return limit.CheckAmount(amount)
+}
+
 func (f *Forwarder) validateProtocol(
 	ctx context.Context,
 	protocolID core.ProtocolID,
@@ -243,7 +510,7 @@ func (f *Forwarder) validateProtocol(
 		return err
 	}
 	if isPaused {
-		return fmt.Errorf(
+		return core.ErrPaused.Wrapf(
 			"controller is paused for protocol %v",
 			protocolID,
 		)
@@ -271,7 +538,7 @@ func (f *Forwarder) validateCrossChain(
 		return err
 	}
 	if isPaused {
-		return fmt.Errorf(
+		return core.ErrPaused.Wrapf(
 			"forwarding is paused for protocol %v and counterparty %s",
 			protocolID,
 			counterpartyID,
@@ -281,6 +548,20 @@ func (f *Forwarder) validateCrossChain(
 	return nil
 }
 
+// validateFallbackRecipient returns an error if the fallback recipient
+// is not a valid address or is not allowed to receive funds.
+func (f *Forwarder) validateFallbackRecipient(recipient string) error {
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid fallback recipient address")
+	}
+	if f.bankKeeper.BlockedAddr(addr) {
+		return core.ErrValidation.Wrap("fallback recipient is not allowed to receive funds")
+	}
+
+	return nil
+}
+
 func (f *Forwarder) validateInitialConditions(
 	ctx context.Context,
 	packet *types.ForwardingPacket,
@@ -291,9 +572,13 @@ func (f *Forwarder) validateInitialConditions(
 		packet.TransferAttributes.DestinationDenom(),
 	)
 
-	if !balance.Amount.Equal(packet.TransferAttributes.DestinationAmount()) {
-		return fmt.Errorf("amount mismatch: expected %s, got %s",
-			packet.TransferAttributes.DestinationAmount(), balance.Amount)
+	expected := packet.TransferAttributes.DestinationAmount()
+	if !packet.ReservedAmount.IsNil() {
+		expected = expected.Add(packet.ReservedAmount)
+	}
+
+	if !balance.Amount.Equal(expected) {
+		return fmt.Errorf("amount mismatch: expected %s, got %s", expected, balance.Amount)
 	}
 
 	return nil
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestHandlePacket_AmountLimits(t *testing.T) {
+	testCases := []struct {
+		name     string
+		amount   int64
+		limit    *forwardertypes.AmountLimit
+		expError error
+	}{
+		{
+			name:   "success - no limit set",
+			amount: 1,
+		},
+		{
+			name:   "success - amount within the limit",
+			amount: 50,
+			limit: &forwardertypes.AmountLimit{
+				MinAmount: math.NewInt(10),
+				MaxAmount: math.NewInt(100),
+			},
+		},
+		{
+			name:   "success - amount equal to the bounds",
+			amount: 100,
+			limit: &forwardertypes.AmountLimit{
+				MinAmount: math.NewInt(100),
+				MaxAmount: math.NewInt(100),
+			},
+		},
+		{
+			name:   "success - zero maximum disables the bound",
+			amount: 1_000_000,
+			limit: &forwardertypes.AmountLimit{
+				MinAmount: math.NewInt(10),
+				MaxAmount: math.ZeroInt(),
+			},
+		},
+		{
+			name:   "error - amount below the minimum",
+			amount: 9,
+			limit: &forwardertypes.AmountLimit{
+				MinAmount: math.NewInt(10),
+				MaxAmount: math.ZeroInt(),
+			},
+			expError: core.ErrAmountBelowMinimum,
+		},
+		{
+			name:   "error - amount above the maximum",
+			amount: 101,
+			limit: &forwardertypes.AmountLimit{
+				MinAmount: math.ZeroInt(),
+				MaxAmount: math.NewInt(100),
+			},
+			expError: core.ErrAmountAboveMaximum,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			testutil.SetSDKConfig()
+
+			coin := sdk.NewCoin("uusdc", math.NewInt(tC.amount))
+
+			// NOTE: the mock bank keeper tracks the module balance
+			// queried by the forwarder under the module address.
+			bk := mocks.NewBankKeeper()
+			bk.Balances[core.ModuleAddress.String()] = sdk.NewCoins(coin)
+
+			f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+			ctx := deps.SdkCtx
+
+			controller := mocks.NewForwardingController(core.PROTOCOL_INTERNAL)
+			require.NoError(t, f.Router().AddRoute(controller))
+
+			forwarding, err := forwardingtypes.NewInternalForwarding(testutil.NewNobleAddress())
+			require.NoError(t, err)
+			attr, err := forwarding.CachedAttributes()
+			require.NoError(t, err)
+
+			if tC.limit != nil {
+				tC.limit.DestinationId = core.CrossChainID{
+					ProtocolId:     core.PROTOCOL_INTERNAL,
+					CounterpartyId: attr.CounterpartyID(),
+				}
+				tC.limit.Denom = coin.Denom
+				require.NoError(t, f.SetAmountLimit(ctx, *tC.limit))
+			}
+
+			transferAttr, err := core.NewTransferAttributes(
+				core.PROTOCOL_IBC,
+				"channel-0",
+				coin.Denom,
+				coin.Amount,
+			)
+			require.NoError(t, err)
+
+			packet, err := types.NewForwardingPacket(transferAttr, forwarding)
+			require.NoError(t, err)
+
+			err = f.HandlePacket(ctx, packet)
+			if tC.expError != nil {
+				require.ErrorIs(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
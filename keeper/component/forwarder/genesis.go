@@ -46,6 +46,64 @@ func (f *Forwarder) InitGenesis(ctx context.Context, g *forwardertypes.GenesisSt
 		}
 	}
 
+	for _, p := range g.InFlightPackets {
+		if err := f.setInFlightPacket(ctx, p); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis in-flight packet")
+		}
+	}
+
+	if err := f.SetParams(ctx, g.Params); err != nil {
+		return errorsmod.Wrap(err, "error setting genesis params")
+	}
+
+	for _, p := range g.EscrowedPackets {
+		if err := f.setEscrowedPacket(ctx, p); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis escrowed packet")
+		}
+	}
+
+	if err := f.escrowedPacketsSeq.Set(ctx, g.EscrowedPacketsSequence); err != nil {
+		return errorsmod.Wrap(err, "error setting genesis escrowed packets sequence")
+	}
+
+	for _, r := range g.HyperlaneTokenRoutes {
+		if err := f.SetHyperlaneTokenRoute(ctx, r); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis Hyperlane token route")
+		}
+	}
+
+	for _, p := range g.DeferredPackets {
+		if err := f.setDeferredPacket(ctx, p); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis deferred packet")
+		}
+	}
+
+	if err := f.deferredPacketsSeq.Set(ctx, g.DeferredPacketsSequence); err != nil {
+		return errorsmod.Wrap(err, "error setting genesis deferred packets sequence")
+	}
+
+	for _, l := range g.AmountLimits {
+		if err := f.SetAmountLimit(ctx, l); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis amount limit")
+		}
+	}
+
+	for _, e := range g.HeldBatchEntries {
+		if err := f.setHeldBatchEntry(ctx, e); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis held batch entry")
+		}
+	}
+
+	if err := f.heldBatchEntriesSeq.Set(ctx, g.HeldBatchEntriesSequence); err != nil {
+		return errorsmod.Wrap(err, "error setting genesis held batch entries sequence")
+	}
+
+	for _, p := range g.HeldEscrowedPackets {
+		if err := f.setHeldEscrowedPacket(ctx, p); err != nil {
+			return errorsmod.Wrap(err, "error setting genesis held escrowed packet")
+		}
+	}
+
 	return nil
 }
 
@@ -61,8 +119,74 @@ func (f *Forwarder) ExportGenesis(ctx context.Context) *forwardertypes.GenesisSt
 		f.logger.Error("error exporting paused cross chains", "err", err.Error())
 	}
 
+	inFlightPackets, err := f.GetAllInFlightPackets(ctx)
+	if err != nil {
+		f.logger.Error("error exporting in-flight packets", "err", err.Error())
+	}
+
+	params, err := f.GetParams(ctx)
+	if err != nil {
+		f.logger.Error("error exporting params", "err", err.Error())
+	}
+
+	escrowedPackets, err := f.GetAllEscrowedPackets(ctx)
+	if err != nil {
+		f.logger.Error("error exporting escrowed packets", "err", err.Error())
+	}
+
+	escrowedPacketsSeq, err := f.escrowedPacketsSeq.Peek(ctx)
+	if err != nil {
+		f.logger.Error("error exporting escrowed packets sequence", "err", err.Error())
+	}
+
+	hypTokenRoutes, err := f.GetAllHyperlaneTokenRoutes(ctx)
+	if err != nil {
+		f.logger.Error("error exporting Hyperlane token routes", "err", err.Error())
+	}
+
+	deferredPackets, err := f.GetAllDeferredPackets(ctx)
+	if err != nil {
+		f.logger.Error("error exporting deferred packets", "err", err.Error())
+	}
+
+	deferredPacketsSeq, err := f.deferredPacketsSeq.Peek(ctx)
+	if err != nil {
+		f.logger.Error("error exporting deferred packets sequence", "err", err.Error())
+	}
+
+	amountLimits, err := f.GetAllAmountLimits(ctx)
+	if err != nil {
+		f.logger.Error("error exporting amount limits", "err", err.Error())
+	}
+
+	heldBatchEntries, err := f.GetAllHeldBatchEntries(ctx)
+	if err != nil {
+		f.logger.Error("error exporting held batch entries", "err", err.Error())
+	}
+
+	heldBatchEntriesSeq, err := f.heldBatchEntriesSeq.Peek(ctx)
+	if err != nil {
+		f.logger.Error("error exporting held batch entries sequence", "err", err.Error())
+	}
+
+	heldEscrowedPackets, err := f.GetAllHeldEscrowedPackets(ctx)
+	if err != nil {
+		f.logger.Error("error exporting held escrowed packets", "err", err.Error())
+	}
+
 	return &forwardertypes.GenesisState{
-		PausedProtocolIds:   pausedProtocols,
-		PausedCrossChainIds: pausedCrossChainIDs,
+		PausedProtocolIds:        pausedProtocols,
+		PausedCrossChainIds:      pausedCrossChainIDs,
+		InFlightPackets:          inFlightPackets,
+		Params:                   params,
+		EscrowedPackets:          escrowedPackets,
+		EscrowedPacketsSequence:  escrowedPacketsSeq,
+		HyperlaneTokenRoutes:     hypTokenRoutes,
+		DeferredPackets:          deferredPackets,
+		DeferredPacketsSequence:  deferredPacketsSeq,
+		AmountLimits:             amountLimits,
+		HeldBatchEntries:         heldBatchEntries,
+		HeldBatchEntriesSequence: heldBatchEntriesSeq,
+		HeldEscrowedPackets:      heldEscrowedPackets,
 	}
 }
@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+var (
+	_ types.InFlightPacketTracker = &Forwarder{}
+	_ types.InFlightPacketHandler = &Forwarder{}
+)
+
+// SetInFlightPacket implements types.InFlightPacketTracker.
+func (f *Forwarder) SetInFlightPacket(
+	ctx context.Context,
+	packet forwardertypes.InFlightPacket,
+) error {
+	if err := f.setInFlightPacket(ctx, packet); err != nil {
+		return errorsmod.Wrap(err, "error setting in-flight packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventInFlightPacketSent{
+			ChannelId: packet.ChannelId,
+			Sequence:  packet.Sequence,
+			Coin:      packet.Coin,
+			Receiver:  packet.Receiver,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting in-flight packet sent event")
+	}
+
+	return nil
+}
+
+// CompleteInFlightPacket implements types.InFlightPacketHandler.
+func (f *Forwarder) CompleteInFlightPacket(
+	ctx context.Context,
+	channelID string,
+	sequence uint64,
+) error {
+	_, found, err := f.GetInFlightPacket(ctx, channelID, sequence)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting in-flight packet")
+	}
+	if !found {
+		f.logger.Debug(
+			"completed packet is not tracked",
+			"channel", channelID,
+			"sequence", sequence,
+		)
+
+		return nil
+	}
+
+	if err := f.removeInFlightPacket(ctx, channelID, sequence); err != nil {
+		return errorsmod.Wrap(err, "error removing in-flight packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventInFlightPacketCompleted{
+			ChannelId: channelID,
+			Sequence:  sequence,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting in-flight packet completed event")
+	}
+
+	return nil
+}
+
+// RefundInFlightPacket implements types.InFlightPacketHandler. If the funds
+// cannot be sent to the refund recipient, they are moved to the refunds
+// account and the packet is kept to allow claiming them.
+func (f *Forwarder) RefundInFlightPacket(
+	ctx context.Context,
+	channelID string,
+	sequence uint64,
+	reason string,
+) error {
+	packet, found, err := f.GetInFlightPacket(ctx, channelID, sequence)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting in-flight packet")
+	}
+	if !found {
+		f.logger.Debug(
+			"refunded packet is not tracked",
+			"channel", channelID,
+			"sequence", sequence,
+		)
+
+		return nil
+	}
+	if packet.RefundFailed {
+		return core.ErrValidation.Wrapf(
+			"refund of in-flight packet %s/%d already failed",
+			channelID,
+			sequence,
+		)
+	}
+
+	// NOTE: the refund and the removal of the packet are executed in a cache
+	// context to not keep tracking the packet if the funds are refunded.
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	refundErr := f.refundInFlightPacket(
+		cacheCtx,
+		packet,
+		core.ModuleName,
+		packet.RefundRecipient,
+		reason,
+	)
+	if refundErr == nil {
+		write()
+
+		return nil
+	}
+
+	f.logger.Error(
+		"error refunding in-flight packet, holding funds in the refunds account",
+		"channel", channelID,
+		"sequence", sequence,
+		"err", refundErr.Error(),
+	)
+
+	if err := f.holdInFlightPacket(ctx, packet, reason, refundErr); err != nil {
+		return errorsmod.Wrapf(err, "error refunding in-flight packet: %s", refundErr.Error())
+	}
+
+	return nil
+}
+
+// ClaimInFlightRefund sends the held funds of an in-flight packet
+// whose refund failed to the recipient and removes the packet.
+func (f *Forwarder) ClaimInFlightRefund(
+	ctx context.Context,
+	channelID string,
+	sequence uint64,
+	recipient string,
+) error {
+	packet, found, err := f.GetInFlightPacket(ctx, channelID, sequence)
+	if err != nil {
+		return errorsmod.Wrap(err, "error getting in-flight packet")
+	}
+	if !found {
+		return core.ErrValidation.Wrapf("in-flight packet %s/%d not found", channelID, sequence)
+	}
+	if !packet.RefundFailed {
+		return core.ErrValidation.Wrapf(
+			"refund of in-flight packet %s/%d has not failed",
+			channelID,
+			sequence,
+		)
+	}
+
+	return f.refundInFlightPacket(ctx, packet, core.RefundsName, recipient, "refund claimed")
+}
+
+// refundInFlightPacket sends the funds of the in-flight packet from the
+// sender module to the recipient and removes the packet.
+func (f *Forwarder) refundInFlightPacket(
+	ctx context.Context,
+	packet forwardertypes.InFlightPacket,
+	senderModule string,
+	recipient string,
+	reason string,
+) error {
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid refund recipient address")
+	}
+
+	if err := f.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		senderModule,
+		recipientAddr,
+		sdk.NewCoins(packet.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error refunding in-flight packet")
+	}
+
+	if err := f.removeInFlightPacket(ctx, packet.ChannelId, packet.Sequence); err != nil {
+		return errorsmod.Wrap(err, "error removing in-flight packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventInFlightPacketRefunded{
+			ChannelId:       packet.ChannelId,
+			Sequence:        packet.Sequence,
+			Coin:            packet.Coin,
+			RefundRecipient: recipient,
+			Reason:          reason,
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting in-flight packet refunded event")
+	}
+
+	return nil
+}
+
+// holdInFlightPacket moves the funds of an in-flight packet whose refund
+// failed to the refunds account and marks the packet as refund failed.
+func (f *Forwarder) holdInFlightPacket(
+	ctx context.Context,
+	packet forwardertypes.InFlightPacket,
+	reason string,
+	refundErr error,
+) error {
+	if err := f.bankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		core.ModuleName,
+		core.RefundsName,
+		sdk.NewCoins(packet.Coin),
+	); err != nil {
+		return errorsmod.Wrap(err, "error sending funds to the refunds account")
+	}
+
+	packet.RefundFailed = true
+	if err := f.updateInFlightPacket(ctx, packet); err != nil {
+		return errorsmod.Wrap(err, "error updating in-flight packet")
+	}
+
+	if err := f.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventInFlightPacketRefundFailed{
+			ChannelId:       packet.ChannelId,
+			Sequence:        packet.Sequence,
+			Coin:            packet.Coin,
+			RefundRecipient: packet.RefundRecipient,
+			Reason:          reason,
+			Error:           refundErr.Error(),
+		},
+	); err != nil {
+		return errorsmod.Wrap(err, "error emitting in-flight packet refund failed event")
+	}
+
+	return nil
+}
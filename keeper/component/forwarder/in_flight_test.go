@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestCompleteInFlightPacket(t *testing.T) {
+	testCases := []struct {
+		name  string
+		setup bool
+	}{
+		{
+			name: "success - untracked packet is ignored",
+		},
+		{
+			name:  "success - tracked packet is removed",
+			setup: true,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			f, deps := mocks.NewForwarderComponent(t)
+			ctx := deps.SdkCtx
+
+			if tC.setup {
+				packet, err := forwardertypes.NewInFlightPacket(
+					"channel-1",
+					1,
+					sdk.NewCoin("uusdc", math.NewInt(100)),
+					"cosmos1receiver",
+					testutil.NewNobleAddress(),
+				)
+				require.NoError(t, err)
+				require.NoError(t, f.SetInFlightPacket(ctx, packet))
+			}
+
+			err := f.CompleteInFlightPacket(ctx, "channel-1", 1)
+			require.NoError(t, err)
+
+			_, found, err := f.GetInFlightPacket(ctx, "channel-1", 1)
+			require.NoError(t, err)
+			require.False(t, found)
+		})
+	}
+}
+
+func TestRefundInFlightPacket(t *testing.T) {
+	refundRecipient := testutil.NewNobleAddress()
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		setup     func(*mocks.BankKeeper)
+		track     bool
+		expError  string
+		expRefund bool
+		expHeld   bool
+	}{
+		{
+			name: "success - untracked packet is ignored",
+		},
+		{
+			name:     "error - module account has not enough funds",
+			track:    true,
+			expError: "error refunding in-flight packet",
+		},
+		{
+			name: "success - funds are sent to the refund recipient",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			},
+			track:     true,
+			expRefund: true,
+		},
+		{
+			name: "success - funds are held when the refund recipient is blocked",
+			setup: func(bk *mocks.BankKeeper) {
+				bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+				bk.Blocked[refundRecipient] = true
+			},
+			track:   true,
+			expHeld: true,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			bk := mocks.NewBankKeeper()
+			if tC.setup != nil {
+				tC.setup(bk)
+			}
+
+			f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+			ctx := deps.SdkCtx
+
+			if tC.track {
+				packet, err := forwardertypes.NewInFlightPacket(
+					"channel-1",
+					1,
+					coin,
+					"cosmos1receiver",
+					refundRecipient,
+				)
+				require.NoError(t, err)
+				require.NoError(t, f.SetInFlightPacket(ctx, packet))
+			}
+
+			err := f.RefundInFlightPacket(ctx, "channel-1", 1, "packet timed out")
+
+			packet, found, getErr := f.GetInFlightPacket(ctx, "channel-1", 1)
+			require.NoError(t, getErr)
+
+			switch {
+			case tC.expError != "":
+				require.ErrorContains(t, err, tC.expError)
+				require.True(t, found)
+				require.False(t, packet.RefundFailed)
+			case tC.expHeld:
+				require.NoError(t, err)
+				require.True(t, found)
+				require.True(t, packet.RefundFailed)
+				require.True(t, bk.Balances[core.ModuleName].IsZero())
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[core.RefundsName])
+
+				err = f.RefundInFlightPacket(ctx, "channel-1", 1, "packet timed out")
+				require.ErrorContains(t, err, "already failed")
+			default:
+				require.NoError(t, err)
+				require.False(t, found)
+			}
+
+			if tC.expRefund {
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[refundRecipient])
+			}
+		})
+	}
+}
+
+func TestClaimInFlightRefund(t *testing.T) {
+	refundRecipient := testutil.NewNobleAddress()
+	recipient := testutil.NewNobleAddress()
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		track     bool
+		held      bool
+		recipient string
+		expError  string
+	}{
+		{
+			name:      "error - packet is not tracked",
+			recipient: recipient,
+			expError:  "not found",
+		},
+		{
+			name:      "error - refund of the packet has not failed",
+			track:     true,
+			recipient: recipient,
+			expError:  "has not failed",
+		},
+		{
+			name:      "error - recipient is blocked",
+			track:     true,
+			held:      true,
+			recipient: refundRecipient,
+			expError:  "error refunding in-flight packet",
+		},
+		{
+			name:      "success - held funds are sent to the recipient",
+			track:     true,
+			held:      true,
+			recipient: recipient,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			bk := mocks.NewBankKeeper()
+			bk.Balances[core.ModuleName] = sdk.NewCoins(coin)
+			bk.Blocked[refundRecipient] = true
+
+			f, deps := mocks.NewForwarderComponentWithBankKeeper(t, bk)
+			ctx := deps.SdkCtx
+
+			if tC.track {
+				packet, err := forwardertypes.NewInFlightPacket(
+					"channel-1",
+					1,
+					coin,
+					"cosmos1receiver",
+					refundRecipient,
+				)
+				require.NoError(t, err)
+				require.NoError(t, f.SetInFlightPacket(ctx, packet))
+			}
+			if tC.held {
+				err := f.RefundInFlightPacket(ctx, "channel-1", 1, "packet timed out")
+				require.NoError(t, err)
+			}
+
+			err := f.ClaimInFlightRefund(ctx, "channel-1", 1, tC.recipient)
+
+			_, found, getErr := f.GetInFlightPacket(ctx, "channel-1", 1)
+			require.NoError(t, getErr)
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+			} else {
+				require.NoError(t, err)
+				require.False(t, found)
+				require.True(t, bk.Balances[core.RefundsName].IsZero())
+				require.Equal(t, sdk.NewCoins(coin), bk.Balances[tC.recipient])
+			}
+		})
+	}
+}
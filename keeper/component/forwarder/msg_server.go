@@ -24,8 +24,10 @@ import (
 	"context"
 	"errors"
 
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/noble-assets/orbiter/v2/controller/forwarding"
@@ -221,3 +223,256 @@ func (s msgServer) ReplaceDepositForBurn(
 
 	return &forwardertypes.MsgReplaceDepositForBurnResponse{}, nil
 }
+
+// SetHyperlaneTokenRoute implements forwarder.MsgServer.
+func (s msgServer) SetHyperlaneTokenRoute(
+	ctx context.Context,
+	msg *forwardertypes.MsgSetHyperlaneTokenRoute,
+) (*forwardertypes.MsgSetHyperlaneTokenRouteResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	route, err := forwardertypes.NewHyperlaneTokenRoute(
+		msg.Denom,
+		msg.DestinationDomain,
+		msg.TokenId,
+	)
+	if err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	if err := s.Forwarder.SetHyperlaneTokenRoute(ctx, route); err != nil {
+		return nil, errorsmod.Wrap(err, "error setting Hyperlane token route")
+	}
+
+	if err := s.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventHyperlaneTokenRouteSet{
+			Denom:             route.Denom,
+			DestinationDomain: route.DestinationDomain,
+			TokenId:           hyperlaneutil.HexAddress(route.TokenId).String(),
+		},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit event")
+	}
+
+	return &forwardertypes.MsgSetHyperlaneTokenRouteResponse{}, nil
+}
+
+// RemoveHyperlaneTokenRoute implements forwarder.MsgServer.
+func (s msgServer) RemoveHyperlaneTokenRoute(
+	ctx context.Context,
+	msg *forwardertypes.MsgRemoveHyperlaneTokenRoute,
+) (*forwardertypes.MsgRemoveHyperlaneTokenRouteResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	err := s.Forwarder.RemoveHyperlaneTokenRoute(ctx, msg.Denom, msg.DestinationDomain)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error removing Hyperlane token route")
+	}
+
+	if err := s.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventHyperlaneTokenRouteRemoved{
+			Denom:             msg.Denom,
+			DestinationDomain: msg.DestinationDomain,
+		},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit event")
+	}
+
+	return &forwardertypes.MsgRemoveHyperlaneTokenRouteResponse{}, nil
+}
+
+// SetAmountLimit implements forwarder.MsgServer.
+func (s msgServer) SetAmountLimit(
+	ctx context.Context,
+	msg *forwardertypes.MsgSetAmountLimit,
+) (*forwardertypes.MsgSetAmountLimitResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	destinationID, err := newCrossChainIDFromStrings(msg.ProtocolId, msg.CounterpartyId)
+	if err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	limit, err := forwardertypes.NewAmountLimit(
+		destinationID,
+		msg.Denom,
+		msg.MinAmount,
+		msg.MaxAmount,
+	)
+	if err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	if err := s.Forwarder.SetAmountLimit(ctx, limit); err != nil {
+		return nil, errorsmod.Wrap(err, "error setting amount limit")
+	}
+
+	if err := s.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventAmountLimitSet{Limit: limit},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit event")
+	}
+
+	return &forwardertypes.MsgSetAmountLimitResponse{}, nil
+}
+
+// RemoveAmountLimit implements forwarder.MsgServer.
+func (s msgServer) RemoveAmountLimit(
+	ctx context.Context,
+	msg *forwardertypes.MsgRemoveAmountLimit,
+) (*forwardertypes.MsgRemoveAmountLimitResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	destinationID, err := newCrossChainIDFromStrings(msg.ProtocolId, msg.CounterpartyId)
+	if err != nil {
+		return nil, core.ErrValidation.Wrap(err.Error())
+	}
+
+	if err := s.Forwarder.RemoveAmountLimit(ctx, destinationID, msg.Denom); err != nil {
+		return nil, errorsmod.Wrap(err, "error removing amount limit")
+	}
+
+	if err := s.eventService.EventManager(ctx).Emit(
+		ctx,
+		&forwardertypes.EventAmountLimitRemoved{
+			DestinationId: destinationID,
+			Denom:         msg.Denom,
+		},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit event")
+	}
+
+	return &forwardertypes.MsgRemoveAmountLimitResponse{}, nil
+}
+
+// ClaimInFlightRefund implements forwarder.MsgServer.
+func (s msgServer) ClaimInFlightRefund(
+	ctx context.Context,
+	msg *forwardertypes.MsgClaimInFlightRefund,
+) (*forwardertypes.MsgClaimInFlightRefundResponse, error) {
+	packet, found, err := s.GetInFlightPacket(ctx, msg.ChannelId, msg.Sequence)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting in-flight packet")
+	}
+	if !found {
+		return nil, core.ErrValidation.Wrapf(
+			"in-flight packet %s/%d not found",
+			msg.ChannelId,
+			msg.Sequence,
+		)
+	}
+
+	// NOTE: the authority can claim the funds when the
+	// refund recipient is not able to receive them.
+	if msg.Signer != packet.RefundRecipient {
+		if err := s.RequireAuthority(msg.Signer); err != nil {
+			return nil, err
+		}
+	}
+
+	recipient := msg.Recipient
+	if recipient == "" {
+		recipient = packet.RefundRecipient
+	}
+
+	err = s.Forwarder.ClaimInFlightRefund(ctx, msg.ChannelId, msg.Sequence, recipient)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error claiming in-flight refund")
+	}
+
+	return &forwardertypes.MsgClaimInFlightRefundResponse{}, nil
+}
+
+// ClaimHeldBatchEntry implements forwarder.MsgServer.
+func (s msgServer) ClaimHeldBatchEntry(
+	ctx context.Context,
+	msg *forwardertypes.MsgClaimHeldBatchEntry,
+) (*forwardertypes.MsgClaimHeldBatchEntryResponse, error) {
+	held, found, err := s.GetHeldBatchEntry(ctx, msg.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error getting held batch entry")
+	}
+	if !found {
+		return nil, core.ErrValidation.Wrapf("held batch entry %d not found", msg.Id)
+	}
+
+	// NOTE: the authority can claim the funds when the
+	// fallback recipient is not able to receive them.
+	if msg.Signer != held.Entry.FallbackRecipient {
+		if err := s.RequireAuthority(msg.Signer); err != nil {
+			return nil, err
+		}
+	}
+
+	recipient := msg.Recipient
+	if recipient == "" {
+		recipient = held.Entry.FallbackRecipient
+	}
+
+	if err := s.Forwarder.ClaimHeldBatchEntry(ctx, msg.Id, recipient); err != nil {
+		return nil, errorsmod.Wrap(err, "error claiming held batch entry")
+	}
+
+	return &forwardertypes.MsgClaimHeldBatchEntryResponse{}, nil
+}
+
+// ClaimHeldEscrowedPacket implements forwarder.MsgServer.
+func (s msgServer) ClaimHeldEscrowedPacket(
+	ctx context.Context,
+	msg *forwardertypes.MsgClaimHeldEscrowedPacket,
+) (*forwardertypes.MsgClaimHeldEscrowedPacketResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := s.Forwarder.ClaimHeldEscrowedPacket(ctx, msg.Id, msg.Recipient); err != nil {
+		return nil, errorsmod.Wrap(err, "error claiming held escrowed packet")
+	}
+
+	return &forwardertypes.MsgClaimHeldEscrowedPacketResponse{}, nil
+}
+
+// newCrossChainIDFromStrings returns a validated cross-chain ID from
+// the protocol ID string and the counterparty ID.
+func newCrossChainIDFromStrings(
+	protocolID string,
+	counterpartyID string,
+) (core.CrossChainID, error) {
+	id, err := core.NewProtocolIDFromString(protocolID)
+	if err != nil {
+		return core.CrossChainID{}, errorsmod.Wrap(err, "invalid protocol ID")
+	}
+
+	return core.NewCrossChainID(id, counterpartyID)
+}
+
+// UpdateParams implements forwarder.MsgServer.
+func (s msgServer) UpdateParams(
+	ctx context.Context,
+	msg *forwardertypes.MsgUpdateParams,
+) (*forwardertypes.MsgUpdateParamsResponse, error) {
+	if err := s.RequireAuthority(msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &forwardertypes.MsgUpdateParamsResponse{}, nil
+}
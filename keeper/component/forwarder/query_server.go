@@ -141,3 +141,257 @@ func (s queryServer) PausedCrossChains(
 		Pagination:      pageResp,
 	}, nil
 }
+
+// InFlightPackets implements forwarder.QueryServer.
+func (s queryServer) InFlightPackets(
+	ctx context.Context,
+	req *forwardertypes.QueryInFlightPacketsRequest,
+) (*forwardertypes.QueryInFlightPacketsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	packets, pageResp, err := s.GetPaginatedInFlightPackets(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &forwardertypes.QueryInFlightPacketsResponse{
+		Packets:    packets,
+		Pagination: pageResp,
+	}, nil
+}
+
+// InFlightPacket implements forwarder.QueryServer.
+func (s queryServer) InFlightPacket(
+	ctx context.Context,
+	req *forwardertypes.QueryInFlightPacketRequest,
+) (*forwardertypes.QueryInFlightPacketResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	packet, found, err := s.GetInFlightPacket(ctx, req.ChannelId, req.Sequence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"in-flight packet %s/%d not found",
+			req.ChannelId,
+			req.Sequence,
+		)
+	}
+
+	return &forwardertypes.QueryInFlightPacketResponse{
+		Packet: packet,
+	}, nil
+}
+
+// Params implements forwarder.QueryServer.
+func (s queryServer) Params(
+	ctx context.Context,
+	req *forwardertypes.QueryParamsRequest,
+) (*forwardertypes.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	params, err := s.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &forwardertypes.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// EscrowedPackets implements forwarder.QueryServer.
+func (s queryServer) EscrowedPackets(
+	ctx context.Context,
+	req *forwardertypes.QueryEscrowedPacketsRequest,
+) (*forwardertypes.QueryEscrowedPacketsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	packets, pageResp, err := s.GetPaginatedEscrowedPackets(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &forwardertypes.QueryEscrowedPacketsResponse{
+		Packets:    packets,
+		Pagination: pageResp,
+	}, nil
+}
+
+// EscrowedPacket implements forwarder.QueryServer.
+func (s queryServer) EscrowedPacket(
+	ctx context.Context,
+	req *forwardertypes.QueryEscrowedPacketRequest,
+) (*forwardertypes.QueryEscrowedPacketResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	packet, found, err := s.GetEscrowedPacket(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "escrowed packet %d not found", req.Id)
+	}
+
+	return &forwardertypes.QueryEscrowedPacketResponse{
+		Packet: packet,
+	}, nil
+}
+
+// DeferredPackets implements forwarder.QueryServer.
+func (s queryServer) DeferredPackets(
+	ctx context.Context,
+	req *forwardertypes.QueryDeferredPacketsRequest,
+) (*forwardertypes.QueryDeferredPacketsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	packets, pageResp, err := s.GetPaginatedDeferredPackets(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &forwardertypes.QueryDeferredPacketsResponse{
+		Packets:    packets,
+		Pagination: pageResp,
+	}, nil
+}
+
+// DeferredPacket implements forwarder.QueryServer.
+func (s queryServer) DeferredPacket(
+	ctx context.Context,
+	req *forwardertypes.QueryDeferredPacketRequest,
+) (*forwardertypes.QueryDeferredPacketResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	packet, found, err := s.GetDeferredPacket(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "deferred packet %d not found", req.Id)
+	}
+
+	return &forwardertypes.QueryDeferredPacketResponse{
+		Packet: packet,
+	}, nil
+}
+
+// HyperlaneTokenRoutes implements forwarder.QueryServer.
+func (s queryServer) HyperlaneTokenRoutes(
+	ctx context.Context,
+	req *forwardertypes.QueryHyperlaneTokenRoutesRequest,
+) (*forwardertypes.QueryHyperlaneTokenRoutesResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	routes, pageResp, err := s.GetPaginatedHyperlaneTokenRoutes(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &forwardertypes.QueryHyperlaneTokenRoutesResponse{
+		Routes:     routes,
+		Pagination: pageResp,
+	}, nil
+}
+
+// HyperlaneTokenRoute implements forwarder.QueryServer.
+func (s queryServer) HyperlaneTokenRoute(
+	ctx context.Context,
+	req *forwardertypes.QueryHyperlaneTokenRouteRequest,
+) (*forwardertypes.QueryHyperlaneTokenRouteResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	tokenID, found, err := s.GetHyperlaneTokenRoute(ctx, req.Denom, req.DestinationDomain)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"Hyperlane token route for %s to domain %d not found",
+			req.Denom,
+			req.DestinationDomain,
+		)
+	}
+
+	return &forwardertypes.QueryHyperlaneTokenRouteResponse{
+		Route: forwardertypes.HyperlaneTokenRoute{
+			Denom:             req.Denom,
+			DestinationDomain: req.DestinationDomain,
+			TokenId:           tokenID,
+		},
+	}, nil
+}
+
+// AmountLimits implements forwarder.QueryServer.
+func (s queryServer) AmountLimits(
+	ctx context.Context,
+	req *forwardertypes.QueryAmountLimitsRequest,
+) (*forwardertypes.QueryAmountLimitsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	limits, pageResp, err := s.GetPaginatedAmountLimits(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &forwardertypes.QueryAmountLimitsResponse{
+		Limits:     limits,
+		Pagination: pageResp,
+	}, nil
+}
+
+// AmountLimit implements forwarder.QueryServer.
+func (s queryServer) AmountLimit(
+	ctx context.Context,
+	req *forwardertypes.QueryAmountLimitRequest,
+) (*forwardertypes.QueryAmountLimitResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	protocolID, err := core.NewProtocolIDFromString(req.ProtocolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit, found, err := s.GetAmountLimit(ctx, protocolID, req.CounterpartyId, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"amount limit for %s to %s counterparty %s not found",
+			req.Denom,
+			protocolID,
+			req.CounterpartyId,
+		)
+	}
+
+	return &forwardertypes.QueryAmountLimitResponse{
+		Limit: limit,
+	}, nil
+}
@@ -22,11 +22,14 @@ package forwarder
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -207,3 +210,991 @@ func (f *Forwarder) GetPaginatedPausedCrossChains(
 
 	return counterparties, pageRes, nil
 }
+
+// ====================================================================================================
+// In-flight packets
+// ====================================================================================================
+
+func (f *Forwarder) GetInFlightPacket(
+	ctx context.Context,
+	channelID string,
+	sequence uint64,
+) (forwardertypes.InFlightPacket, bool, error) {
+	packet, err := f.inFlightPackets.Get(ctx, collections.Join(channelID, sequence))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return forwardertypes.InFlightPacket{}, false, nil
+		}
+
+		return forwardertypes.InFlightPacket{}, false, err
+	}
+
+	return packet, true, nil
+}
+
+// setInFlightPacket stores a validated in-flight packet.
+func (f *Forwarder) setInFlightPacket(
+	ctx context.Context,
+	packet forwardertypes.InFlightPacket,
+) error {
+	if err := packet.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid in-flight packet")
+	}
+
+	key := collections.Join(packet.ChannelId, packet.Sequence)
+
+	found, err := f.inFlightPackets.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return core.ErrAlreadySet.Wrapf(
+			"in-flight packet %s/%d already exists",
+			packet.ChannelId,
+			packet.Sequence,
+		)
+	}
+
+	return f.inFlightPackets.Set(ctx, key, packet)
+}
+
+// updateInFlightPacket overwrites a tracked in-flight packet.
+func (f *Forwarder) updateInFlightPacket(
+	ctx context.Context,
+	packet forwardertypes.InFlightPacket,
+) error {
+	if err := packet.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid in-flight packet")
+	}
+
+	return f.inFlightPackets.Set(ctx, collections.Join(packet.ChannelId, packet.Sequence), packet)
+}
+
+func (f *Forwarder) removeInFlightPacket(
+	ctx context.Context,
+	channelID string,
+	sequence uint64,
+) error {
+	return f.inFlightPackets.Remove(ctx, collections.Join(channelID, sequence))
+}
+
+// GetAllInFlightPackets returns a slice of all the in-flight packets.
+func (f *Forwarder) GetAllInFlightPackets(
+	ctx context.Context,
+) ([]forwardertypes.InFlightPacket, error) {
+	packets := make([]forwardertypes.InFlightPacket, 0)
+
+	err := f.inFlightPackets.Walk(
+		ctx,
+		nil,
+		func(_ collections.Pair[string, uint64], p forwardertypes.InFlightPacket) (bool, error) {
+			packets = append(packets, p)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return packets, nil
+}
+
+func (f *Forwarder) GetPaginatedInFlightPackets(
+	ctx context.Context,
+	pagination *query.PageRequest,
+) ([]forwardertypes.InFlightPacket, *query.PageResponse, error) {
+	packets, pageRes, err := query.CollectionPaginate(
+		ctx,
+		f.inFlightPackets,
+		pagination,
+		func(
+			_ collections.Pair[string, uint64],
+			p forwardertypes.InFlightPacket,
+		) (forwardertypes.InFlightPacket, error) {
+			return p, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error paginating in-flight packets")
+	}
+
+	return packets, pageRes, nil
+}
+
+// ====================================================================================================
+// Params
+// ====================================================================================================
+
+// GetParams returns the forwarder params from state.
+func (f *Forwarder) GetParams(ctx context.Context) (forwardertypes.Params, error) {
+	return f.params.Get(ctx)
+}
+
+// SetParams stores validated forwarder params.
+func (f *Forwarder) SetParams(ctx context.Context, params forwardertypes.Params) error {
+	if err := params.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid forwarder params")
+	}
+
+	return f.params.Set(ctx, params)
+}
+
+// ====================================================================================================
+// Escrowed packets
+// ====================================================================================================
+
+// GetEscrowedPacket returns the escrowed packet associated with the ID
+// and a boolean to indicate whether the packet has been found.
+func (f *Forwarder) GetEscrowedPacket(
+	ctx context.Context,
+	id uint64,
+) (forwardertypes.EscrowedPacket, bool, error) {
+	packet, err := f.escrowedPackets.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return forwardertypes.EscrowedPacket{}, false, nil
+		}
+
+		return forwardertypes.EscrowedPacket{}, false, err
+	}
+
+	return packet, true, nil
+}
+
+// setEscrowedPacket stores a validated escrowed packet.
+func (f *Forwarder) setEscrowedPacket(
+	ctx context.Context,
+	packet forwardertypes.EscrowedPacket,
+) error {
+	if err := packet.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid escrowed packet")
+	}
+
+	found, err := f.escrowedPackets.Has(ctx, packet.Id)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return core.ErrAlreadySet.Wrapf("escrowed packet %d already exists", packet.Id)
+	}
+
+	return f.escrowedPackets.Set(ctx, packet.Id, packet)
+}
+
+func (f *Forwarder) removeEscrowedPacket(ctx context.Context, id uint64) error {
+	return f.escrowedPackets.Remove(ctx, id)
+}
+
+// GetNextEscrowedPackets returns up to limit escrowed packets starting from
+// the escrow cursor, wrapping around to the oldest packets. The cursor is
+// moved after the returned packets, so that packets still hitting a paused
+// route do not prevent the retry of the following ones.
+func (f *Forwarder) GetNextEscrowedPackets(
+	ctx context.Context,
+	limit int,
+) ([]forwardertypes.EscrowedPacket, error) {
+	cursor, err := f.escrowCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(err, "error getting escrow cursor")
+	}
+
+	packets := make([]forwardertypes.EscrowedPacket, 0)
+	collect := func(_ uint64, p forwardertypes.EscrowedPacket) (bool, error) {
+		packets = append(packets, p)
+
+		return len(packets) >= limit, nil
+	}
+
+	err = f.escrowedPackets.Walk(ctx, new(collections.Range[uint64]).StartInclusive(cursor), collect)
+	if err != nil {
+		return nil, err
+	}
+	if len(packets) < limit && cursor > 0 {
+		err = f.escrowedPackets.Walk(ctx, new(collections.Range[uint64]).EndExclusive(cursor), collect)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE: when all the packets are returned, the next
+	// call starts again from the oldest packet.
+	var next uint64
+	if len(packets) >= limit {
+		next = packets[len(packets)-1].Id + 1
+	}
+
+	if err := f.escrowCursor.Set(ctx, next); err != nil {
+		return nil, errorsmod.Wrap(err, "error setting escrow cursor")
+	}
+
+	return packets, nil
+}
+
+// GetAllEscrowedPackets returns a slice of all the escrowed packets.
+func (f *Forwarder) GetAllEscrowedPackets(
+	ctx context.Context,
+) ([]forwardertypes.EscrowedPacket, error) {
+	packets := make([]forwardertypes.EscrowedPacket, 0)
+
+	err := f.escrowedPackets.Walk(
+		ctx,
+		nil,
+		func(_ uint64, p forwardertypes.EscrowedPacket) (bool, error) {
+			packets = append(packets, p)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return packets, nil
+}
+
+func (f *Forwarder) GetPaginatedEscrowedPackets(
+	ctx context.Context,
+	pagination *query.PageRequest,
+) ([]forwardertypes.EscrowedPacket, *query.PageResponse, error) {
+	packets, pageRes, err := query.CollectionPaginate(
+		ctx,
+		f.escrowedPackets,
+		pagination,
+		func(_ uint64, p forwardertypes.EscrowedPacket) (forwardertypes.EscrowedPacket, error) {
+			return p, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error paginating escrowed packets")
+	}
+
+	return packets, pageRes, nil
+}
+
+// GetHeldEscrowedPacket returns the held escrowed packet associated with
+// the ID and a boolean to indicate whether the packet has been found.
+func (f *Forwarder) GetHeldEscrowedPacket(
+	ctx context.Context,
+	id uint64,
+) (forwardertypes.EscrowedPacket, bool, error) {
+	packet, err := f.heldEscrowedPackets.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return forwardertypes.EscrowedPacket{}, false, nil
+		}
+
+		return forwardertypes.EscrowedPacket{}, false, err
+	}
+
+	return packet, true, nil
+}
+
+// setHeldEscrowedPacket stores a validated held escrowed packet.
+func (f *Forwarder) setHeldEscrowedPacket(
+	ctx context.Context,
+	packet forwardertypes.EscrowedPacket,
+) error {
+	if err := packet.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid held escrowed packet")
+	}
+
+	found, err := f.heldEscrowedPackets.Has(ctx, packet.Id)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return core.ErrAlreadySet.Wrapf("held escrowed packet %d already exists", packet.Id)
+	}
+
+	return f.heldEscrowedPackets.Set(ctx, packet.Id, packet)
+}
+
+func (f *Forwarder) removeHeldEscrowedPacket(ctx context.Context, id uint64) error {
+	return f.heldEscrowedPackets.Remove(ctx, id)
+}
+
+// GetAllHeldEscrowedPackets returns a slice of all the held escrowed packets.
+func (f *Forwarder) GetAllHeldEscrowedPackets(
+	ctx context.Context,
+) ([]forwardertypes.EscrowedPacket, error) {
+	packets := make([]forwardertypes.EscrowedPacket, 0)
+
+	err := f.heldEscrowedPackets.Walk(
+		ctx,
+		nil,
+		func(_ uint64, p forwardertypes.EscrowedPacket) (bool, error) {
+			packets = append(packets, p)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return packets, nil
+}
+
+// ====================================================================================================
+// Deferred packets
+// ====================================================================================================
+
+// GetDeferredPacket returns the deferred packet associated with the ID
+// and a boolean to indicate whether the packet has been found.
+func (f *Forwarder) GetDeferredPacket(
+	ctx context.Context,
+	id uint64,
+) (forwardertypes.DeferredPacket, bool, error) {
+	packet, err := f.deferredPackets.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return forwardertypes.DeferredPacket{}, false, nil
+		}
+
+		return forwardertypes.DeferredPacket{}, false, err
+	}
+
+	return packet, true, nil
+}
+
+// setDeferredPacket stores a validated deferred packet.
+func (f *Forwarder) setDeferredPacket(
+	ctx context.Context,
+	packet forwardertypes.DeferredPacket,
+) error {
+	if err := packet.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid deferred packet")
+	}
+
+	found, err := f.deferredPackets.Has(ctx, packet.Id)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return core.ErrAlreadySet.Wrapf("deferred packet %d already exists", packet.Id)
+	}
+
+	if err := f.deferredPackets.Set(ctx, packet.Id, packet); err != nil {
+		return err
+	}
+
+	return f.indexDeferredPacket(ctx, packet)
+}
+
+// indexDeferredPacket adds the packet ID to the ready set if the deferral
+// of the packet is reached, and to the height or time index otherwise.
+func (f *Forwarder) indexDeferredPacket(
+	ctx context.Context,
+	packet forwardertypes.DeferredPacket,
+) error {
+	deferral := packet.Forwarding.DeferredUntil
+
+	switch {
+	case packet.ReadyAt != nil:
+		return f.readyDeferred.Set(ctx, packet.Id)
+	case deferral.Height > 0:
+		return f.deferredByHeight.Set(ctx, collections.Join(deferral.Height, packet.Id))
+	default:
+		return f.deferredByTime.Set(ctx, collections.Join(*deferral.Time, packet.Id))
+	}
+}
+
+// removeDeferredPacket removes the packet from the deferred queue and
+// from all the indexes.
+func (f *Forwarder) removeDeferredPacket(
+	ctx context.Context,
+	packet forwardertypes.DeferredPacket,
+) error {
+	if err := f.deferredPackets.Remove(ctx, packet.Id); err != nil {
+		return err
+	}
+
+	deferral := packet.Forwarding.DeferredUntil
+	heightKey := collections.Join(deferral.Height, packet.Id)
+	if err := f.deferredByHeight.Remove(ctx, heightKey); err != nil {
+		return err
+	}
+	if deferral.Time != nil {
+		if err := f.deferredByTime.Remove(ctx, collections.Join(*deferral.Time, packet.Id)); err != nil {
+			return err
+		}
+	}
+
+	return f.readyDeferred.Remove(ctx, packet.Id)
+}
+
+// promoteDeferredPackets moves up to limit packets from the height index
+// and up to limit packets from the time index once the given block height
+// and time are reached. Packets with a reached height but a pending time
+// are moved to the time index, while the others are marked as ready.
+func (f *Forwarder) promoteDeferredPackets(
+	ctx context.Context,
+	height int64,
+	t time.Time,
+	limit int,
+) error {
+	if height < 0 {
+		return nil
+	}
+
+	heightKeys := make([]collections.Pair[uint64, uint64], 0)
+	err := f.deferredByHeight.Walk(
+		ctx,
+		collections.NewPrefixUntilPairRange[uint64, uint64](uint64(height)),
+		func(key collections.Pair[uint64, uint64]) (bool, error) {
+			heightKeys = append(heightKeys, key)
+
+			return len(heightKeys) >= limit, nil
+		},
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "error walking deferred packets by height")
+	}
+
+	for _, key := range heightKeys {
+		if err := f.deferredByHeight.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		packet, err := f.deferredPackets.Get(ctx, key.K2())
+		if err != nil {
+			return errorsmod.Wrapf(err, "error getting deferred packet %d", key.K2())
+		}
+
+		deferral := packet.Forwarding.DeferredUntil
+		if deferral.Time != nil && t.Before(*deferral.Time) {
+			if err := f.deferredByTime.Set(ctx, collections.Join(*deferral.Time, packet.Id)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := f.markDeferredPacketReady(ctx, packet, t); err != nil {
+			return err
+		}
+	}
+
+	timeKeys := make([]collections.Pair[time.Time, uint64], 0)
+	err = f.deferredByTime.Walk(
+		ctx,
+		collections.NewPrefixUntilPairRange[time.Time, uint64](t),
+		func(key collections.Pair[time.Time, uint64]) (bool, error) {
+			timeKeys = append(timeKeys, key)
+
+			return len(timeKeys) >= limit, nil
+		},
+	)
+	if err != nil {
+		return errorsmod.Wrap(err, "error walking deferred packets by time")
+	}
+
+	for _, key := range timeKeys {
+		if err := f.deferredByTime.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		packet, err := f.deferredPackets.Get(ctx, key.K2())
+		if err != nil {
+			return errorsmod.Wrapf(err, "error getting deferred packet %d", key.K2())
+		}
+
+		if err := f.markDeferredPacketReady(ctx, packet, t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// markDeferredPacketReady stores the time at which the deferral of the
+// packet has been reached and adds it to the ready set.
+func (f *Forwarder) markDeferredPacketReady(
+	ctx context.Context,
+	packet forwardertypes.DeferredPacket,
+	t time.Time,
+) error {
+	packet.ReadyAt = &t
+	if err := f.deferredPackets.Set(ctx, packet.Id, packet); err != nil {
+		return err
+	}
+
+	return f.readyDeferred.Set(ctx, packet.Id)
+}
+
+// GetNextReadyDeferredPackets marks as ready the deferred packets whose
+// deferral is reached at the given block height and time, and returns up
+// to limit ready packets starting from the deferred cursor, wrapping
+// around to the oldest packets. The cursor is moved after the returned
+// packets, so that packets hitting a paused route do not prevent the
+// execution of the following ones.
+func (f *Forwarder) GetNextReadyDeferredPackets(
+	ctx context.Context,
+	height int64,
+	t time.Time,
+	limit int,
+) ([]forwardertypes.DeferredPacket, error) {
+	if err := f.promoteDeferredPackets(ctx, height, t, limit); err != nil {
+		return nil, errorsmod.Wrap(err, "error promoting deferred packets")
+	}
+
+	cursor, err := f.deferredCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(err, "error getting deferred cursor")
+	}
+
+	ids := make([]uint64, 0)
+	collect := func(id uint64) (bool, error) {
+		ids = append(ids, id)
+
+		return len(ids) >= limit, nil
+	}
+
+	err = f.readyDeferred.Walk(ctx, new(collections.Range[uint64]).StartInclusive(cursor), collect)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) < limit && cursor > 0 {
+		err = f.readyDeferred.Walk(ctx, new(collections.Range[uint64]).EndExclusive(cursor), collect)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	packets := make([]forwardertypes.DeferredPacket, 0, len(ids))
+	for _, id := range ids {
+		packet, err := f.deferredPackets.Get(ctx, id)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "error getting deferred packet %d", id)
+		}
+		packets = append(packets, packet)
+	}
+
+	// NOTE: when all the ready packets are returned, the
+	// next call starts again from the oldest packet.
+	var next uint64
+	if len(ids) >= limit {
+		next = ids[len(ids)-1] + 1
+	}
+
+	if err := f.deferredCursor.Set(ctx, next); err != nil {
+		return nil, errorsmod.Wrap(err, "error setting deferred cursor")
+	}
+
+	return packets, nil
+}
+
+// GetAllDeferredPackets returns a slice of all the deferred packets.
+func (f *Forwarder) GetAllDeferredPackets(
+	ctx context.Context,
+) ([]forwardertypes.DeferredPacket, error) {
+	packets := make([]forwardertypes.DeferredPacket, 0)
+
+	err := f.deferredPackets.Walk(
+		ctx,
+		nil,
+		func(_ uint64, p forwardertypes.DeferredPacket) (bool, error) {
+			packets = append(packets, p)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return packets, nil
+}
+
+func (f *Forwarder) GetPaginatedDeferredPackets(
+	ctx context.Context,
+	pagination *query.PageRequest,
+) ([]forwardertypes.DeferredPacket, *query.PageResponse, error) {
+	packets, pageRes, err := query.CollectionPaginate(
+		ctx,
+		f.deferredPackets,
+		pagination,
+		func(_ uint64, p forwardertypes.DeferredPacket) (forwardertypes.DeferredPacket, error) {
+			return p, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error paginating deferred packets")
+	}
+
+	return packets, pageRes, nil
+}
+
+// ====================================================================================================
+// Forwarding batches
+// ====================================================================================================
+
+// GetForwardingBatch returns the forwarding batch associated with the key
+// and a boolean to indicate whether the batch has been found.
+func (f *Forwarder) GetForwardingBatch(
+	ctx context.Context,
+	key string,
+) (forwardertypes.ForwardingBatch, bool, error) {
+	batch, err := f.forwardingBatches.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return forwardertypes.ForwardingBatch{}, false, nil
+		}
+
+		return forwardertypes.ForwardingBatch{}, false, err
+	}
+
+	return batch, true, nil
+}
+
+// setForwardingBatch stores a validated forwarding batch.
+func (f *Forwarder) setForwardingBatch(
+	ctx context.Context,
+	batch forwardertypes.ForwardingBatch,
+) error {
+	if err := batch.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid forwarding batch")
+	}
+
+	return f.forwardingBatches.Set(ctx, batch.Key, batch)
+}
+
+func (f *Forwarder) removeForwardingBatch(ctx context.Context, key string) error {
+	return f.forwardingBatches.Remove(ctx, key)
+}
+
+// GetAllForwardingBatches returns a slice of all the forwarding batches.
+func (f *Forwarder) GetAllForwardingBatches(
+	ctx context.Context,
+) ([]forwardertypes.ForwardingBatch, error) {
+	batches := make([]forwardertypes.ForwardingBatch, 0)
+
+	err := f.forwardingBatches.Walk(
+		ctx,
+		nil,
+		func(_ string, b forwardertypes.ForwardingBatch) (bool, error) {
+			batches = append(batches, b)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return batches, nil
+}
+
+// GetHeldBatchEntry returns the held batch entry associated with the ID
+// and a boolean to indicate whether the entry has been found.
+func (f *Forwarder) GetHeldBatchEntry(
+	ctx context.Context,
+	id uint64,
+) (forwardertypes.HeldBatchEntry, bool, error) {
+	entry, err := f.heldBatchEntries.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return forwardertypes.HeldBatchEntry{}, false, nil
+		}
+
+		return forwardertypes.HeldBatchEntry{}, false, err
+	}
+
+	return entry, true, nil
+}
+
+// setHeldBatchEntry stores a validated held batch entry.
+func (f *Forwarder) setHeldBatchEntry(
+	ctx context.Context,
+	entry forwardertypes.HeldBatchEntry,
+) error {
+	if err := entry.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid held batch entry")
+	}
+
+	found, err := f.heldBatchEntries.Has(ctx, entry.Id)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return core.ErrAlreadySet.Wrapf("held batch entry %d already exists", entry.Id)
+	}
+
+	return f.heldBatchEntries.Set(ctx, entry.Id, entry)
+}
+
+func (f *Forwarder) removeHeldBatchEntry(ctx context.Context, id uint64) error {
+	return f.heldBatchEntries.Remove(ctx, id)
+}
+
+// GetAllHeldBatchEntries returns a slice of all the held batch entries.
+func (f *Forwarder) GetAllHeldBatchEntries(
+	ctx context.Context,
+) ([]forwardertypes.HeldBatchEntry, error) {
+	entries := make([]forwardertypes.HeldBatchEntry, 0)
+
+	err := f.heldBatchEntries.Walk(
+		ctx,
+		nil,
+		func(_ uint64, e forwardertypes.HeldBatchEntry) (bool, error) {
+			entries = append(entries, e)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// ====================================================================================================
+// Hyperlane token routes
+// ====================================================================================================
+
+// GetHyperlaneTokenRoute returns the Hyperlane warp token ID registered for
+// the denom and destination domain and a boolean to indicate whether the
+// route has been found.
+func (f *Forwarder) GetHyperlaneTokenRoute(
+	ctx context.Context,
+	denom string,
+	destinationDomain uint32,
+) ([]byte, bool, error) {
+	tokenID, err := f.hypTokenRoutes.Get(ctx, collections.Join(denom, destinationDomain))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return tokenID, true, nil
+}
+
+// SetHyperlaneTokenRoute stores a validated Hyperlane token route,
+// overwriting the token ID of an existing route.
+func (f *Forwarder) SetHyperlaneTokenRoute(
+	ctx context.Context,
+	route forwardertypes.HyperlaneTokenRoute,
+) error {
+	if err := route.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid Hyperlane token route")
+	}
+
+	return f.hypTokenRoutes.Set(
+		ctx,
+		collections.Join(route.Denom, route.DestinationDomain),
+		route.TokenId,
+	)
+}
+
+// RemoveHyperlaneTokenRoute removes the Hyperlane token route of the denom
+// and destination domain. Returns an error if the route does not exist.
+func (f *Forwarder) RemoveHyperlaneTokenRoute(
+	ctx context.Context,
+	denom string,
+	destinationDomain uint32,
+) error {
+	key := collections.Join(denom, destinationDomain)
+
+	found, err := f.hypTokenRoutes.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return core.ErrAlreadySet.Wrapf(
+			"Hyperlane token route for %s to domain %d is not set",
+			denom,
+			destinationDomain,
+		)
+	}
+
+	return f.hypTokenRoutes.Remove(ctx, key)
+}
+
+// GetAllHyperlaneTokenRoutes returns a slice of all the Hyperlane token routes.
+func (f *Forwarder) GetAllHyperlaneTokenRoutes(
+	ctx context.Context,
+) ([]forwardertypes.HyperlaneTokenRoute, error) {
+	routes := make([]forwardertypes.HyperlaneTokenRoute, 0)
+
+	err := f.hypTokenRoutes.Walk(
+		ctx,
+		nil,
+		func(key collections.Pair[string, uint32], tokenID []byte) (bool, error) {
+			routes = append(routes, newHyperlaneTokenRoute(key, tokenID))
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return routes, nil
+}
+
+func (f *Forwarder) GetPaginatedHyperlaneTokenRoutes(
+	ctx context.Context,
+	pagination *query.PageRequest,
+) ([]forwardertypes.HyperlaneTokenRoute, *query.PageResponse, error) {
+	routes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		f.hypTokenRoutes,
+		pagination,
+		func(
+			key collections.Pair[string, uint32],
+			tokenID []byte,
+		) (forwardertypes.HyperlaneTokenRoute, error) {
+			return newHyperlaneTokenRoute(key, tokenID), nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error paginating Hyperlane token routes")
+	}
+
+	return routes, pageRes, nil
+}
+
+func newHyperlaneTokenRoute(
+	key collections.Pair[string, uint32],
+	tokenID []byte,
+) forwardertypes.HyperlaneTokenRoute {
+	return forwardertypes.HyperlaneTokenRoute{
+		Denom:             key.K1(),
+		DestinationDomain: key.K2(),
+		TokenId:           tokenID,
+	}
+}
+
+// ====================================================================================================
+// Amount limits
+// ====================================================================================================
+
+// GetAmountLimit returns the amount limit of the destination and denom
+// and a boolean to indicate whether the limit has been found.
+func (f *Forwarder) GetAmountLimit(
+	ctx context.Context,
+	protocolID core.ProtocolID,
+	counterpartyID string,
+	denom string,
+) (forwardertypes.AmountLimit, bool, error) {
+	limit, err := f.amountLimits.Get(
+		ctx,
+		collections.Join3(int32(protocolID), counterpartyID, denom),
+	)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return forwardertypes.AmountLimit{}, false, nil
+		}
+
+		return forwardertypes.AmountLimit{}, false, err
+	}
+
+	return limit, true, nil
+}
+
+// SetAmountLimit stores a validated amount limit, overwriting the
+// existing limit of the destination and denom.
+func (f *Forwarder) SetAmountLimit(
+	ctx context.Context,
+	limit forwardertypes.AmountLimit,
+) error {
+	if err := limit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid amount limit")
+	}
+
+	return f.amountLimits.Set(ctx, amountLimitKey(limit.DestinationId, limit.Denom), limit)
+}
+
+// RemoveAmountLimit removes the amount limit of the destination and
+// denom. Returns an error if the limit does not exist.
+func (f *Forwarder) RemoveAmountLimit(
+	ctx context.Context,
+	destinationID core.CrossChainID,
+	denom string,
+) error {
+	key := amountLimitKey(destinationID, denom)
+
+	found, err := f.amountLimits.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return core.ErrAlreadySet.Wrapf(
+			"amount limit for %s to %s is not set",
+			denom,
+			destinationID.ID(),
+		)
+	}
+
+	return f.amountLimits.Remove(ctx, key)
+}
+
+// GetAllAmountLimits returns a slice of all the amount limits.
+func (f *Forwarder) GetAllAmountLimits(
+	ctx context.Context,
+) ([]forwardertypes.AmountLimit, error) {
+	limits := make([]forwardertypes.AmountLimit, 0)
+
+	err := f.amountLimits.Walk(
+		ctx,
+		nil,
+		func(
+			_ collections.Triple[int32, string, string],
+			l forwardertypes.AmountLimit,
+		) (bool, error) {
+			limits = append(limits, l)
+
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+func (f *Forwarder) GetPaginatedAmountLimits(
+	ctx context.Context,
+	pagination *query.PageRequest,
+) ([]forwardertypes.AmountLimit, *query.PageResponse, error) {
+	limits, pageRes, err := query.CollectionPaginate(
+		ctx,
+		f.amountLimits,
+		pagination,
+		func(
+			_ collections.Triple[int32, string, string],
+			l forwardertypes.AmountLimit,
+		) (forwardertypes.AmountLimit, error) {
+			return l, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error paginating amount limits")
+	}
+
+	return limits, pageRes, nil
+}
+
+func amountLimitKey(
+	destinationID core.CrossChainID,
+	denom string,
+) collections.Triple[int32, string, string] {
+	return collections.Join3(
+		int32(destinationID.ProtocolId),
+		destinationID.CounterpartyId,
+		denom,
+	)
+}
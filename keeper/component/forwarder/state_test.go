@@ -26,10 +26,13 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/noble-assets/orbiter/v2/keeper/component/forwarder"
 	"github.com/noble-assets/orbiter/v2/testutil/mocks"
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -105,6 +108,137 @@ func TestGetPaginatedPausedCrossChains(t *testing.T) {
 	}
 }
 
+func TestHyperlaneTokenRoutes(t *testing.T) {
+	f, deps := mocks.NewForwarderComponent(t)
+	ctx := deps.SdkCtx
+
+	tokenID := make([]byte, forwardingtypes.HypTokenIDLen)
+	copy(tokenID, "usdn id")
+	newTokenID := make([]byte, forwardingtypes.HypTokenIDLen)
+	copy(newTokenID, "new usdn id")
+
+	// ACT: invalid routes are not stored.
+	err := f.SetHyperlaneTokenRoute(ctx, forwardertypes.HyperlaneTokenRoute{
+		Denom:             "usdn",
+		DestinationDomain: 1,
+		TokenId:           []byte("usdn id"),
+	})
+	require.ErrorContains(t, err, "invalid Hyperlane token route")
+
+	_, found, err := f.GetHyperlaneTokenRoute(ctx, "usdn", 1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// ACT: set the routes and overwrite the token of an existing one.
+	routes := []forwardertypes.HyperlaneTokenRoute{
+		{Denom: "usdn", DestinationDomain: 1, TokenId: tokenID},
+		{Denom: "usdn", DestinationDomain: 2, TokenId: tokenID},
+		{Denom: "usdn", DestinationDomain: 1, TokenId: newTokenID},
+	}
+	for _, r := range routes {
+		require.NoError(t, f.SetHyperlaneTokenRoute(ctx, r))
+	}
+
+	got, found, err := f.GetHyperlaneTokenRoute(ctx, "usdn", 1)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, newTokenID, got)
+
+	_, found, err = f.GetHyperlaneTokenRoute(ctx, "uusdc", 1)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	paginated, pageResp, err := f.GetPaginatedHyperlaneTokenRoutes(
+		ctx,
+		&query.PageRequest{Limit: 1, CountTotal: true},
+	)
+	require.NoError(t, err)
+	require.Len(t, paginated, 1)
+	require.Equal(t, uint64(2), pageResp.Total)
+	require.Equal(t, uint32(1), paginated[0].DestinationDomain)
+
+	// ACT: remove a route.
+	require.NoError(t, f.RemoveHyperlaneTokenRoute(ctx, "usdn", 1))
+	err = f.RemoveHyperlaneTokenRoute(ctx, "usdn", 1)
+	require.ErrorContains(t, err, "is not set")
+
+	all, err := f.GetAllHyperlaneTokenRoutes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []forwardertypes.HyperlaneTokenRoute{routes[1]}, all)
+}
+
+func TestAmountLimits(t *testing.T) {
+	f, deps := mocks.NewForwarderComponent(t)
+	ctx := deps.SdkCtx
+
+	cctpID := core.CrossChainID{ProtocolId: core.PROTOCOL_CCTP, CounterpartyId: "0"}
+	ibcID := core.CrossChainID{ProtocolId: core.PROTOCOL_IBC, CounterpartyId: "channel-0"}
+
+	// ACT: invalid limits are not stored.
+	err := f.SetAmountLimit(ctx, forwardertypes.AmountLimit{
+		DestinationId: cctpID,
+		Denom:         "uusdc",
+		MinAmount:     math.NewInt(10),
+		MaxAmount:     math.NewInt(1),
+	})
+	require.ErrorContains(t, err, "invalid amount limit")
+
+	_, found, err := f.GetAmountLimit(ctx, core.PROTOCOL_CCTP, "0", "uusdc")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// ACT: set the limits and overwrite an existing one.
+	limits := []forwardertypes.AmountLimit{
+		{
+			DestinationId: cctpID,
+			Denom:         "uusdc",
+			MinAmount:     math.NewInt(10),
+			MaxAmount:     math.ZeroInt(),
+		},
+		{
+			DestinationId: ibcID,
+			Denom:         "uusdc",
+			MinAmount:     math.ZeroInt(),
+			MaxAmount:     math.NewInt(100),
+		},
+		{
+			DestinationId: cctpID,
+			Denom:         "uusdc",
+			MinAmount:     math.NewInt(10),
+			MaxAmount:     math.NewInt(100),
+		},
+	}
+	for _, l := range limits {
+		require.NoError(t, f.SetAmountLimit(ctx, l))
+	}
+
+	got, found, err := f.GetAmountLimit(ctx, core.PROTOCOL_CCTP, "0", "uusdc")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, limits[2], got)
+
+	_, found, err = f.GetAmountLimit(ctx, core.PROTOCOL_CCTP, "0", "usdn")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	paginated, pageResp, err := f.GetPaginatedAmountLimits(
+		ctx,
+		&query.PageRequest{Limit: 1, CountTotal: true},
+	)
+	require.NoError(t, err)
+	require.Len(t, paginated, 1)
+	require.Equal(t, uint64(2), pageResp.Total)
+
+	// ACT: remove a limit.
+	require.NoError(t, f.RemoveAmountLimit(ctx, cctpID, "uusdc"))
+	err = f.RemoveAmountLimit(ctx, cctpID, "uusdc")
+	require.ErrorContains(t, err, "is not set")
+
+	all, err := f.GetAllAmountLimits(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []forwardertypes.AmountLimit{limits[1]}, all)
+}
+
 func createPausedCrossChainsEntries(t *testing.T, ctx context.Context, f *forwarder.Forwarder) {
 	t.Helper()
 
@@ -37,6 +37,7 @@ import (
 	forwardercomp "github.com/noble-assets/orbiter/v2/keeper/component/forwarder"
 	"github.com/noble-assets/orbiter/v2/types"
 	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
 )
 
 var _ types.Authorizer = &Keeper{}
@@ -50,11 +51,18 @@ type Keeper struct {
 	// authority represents the module manager.
 	authority string
 
+	bankKeeper types.BankKeeperEntrypoint
+
 	// Each component manages its own state.
 	executor   *executorcomp.Executor
 	forwarder  *forwardercomp.Forwarder
 	dispatcher *dispatchercomp.Dispatcher
 	adapter    *adaptercomp.Adapter
+
+	// Entrypoints handling the transfers with an orbiter payload
+	// received via messages.
+	cctpEntrypoint      entrypointtypes.CCTPEntrypoint
+	hyperlaneEntrypoint entrypointtypes.HyperlaneEntrypoint
 }
 
 // NewKeeper returns a reference to a validated instance of the keeper.
@@ -79,6 +87,7 @@ func NewKeeper(
 		eventService: eventService,
 		logger:       logger.With("module", core.ModuleName),
 		authority:    authority,
+		bankKeeper:   bankKeeper,
 	}
 
 	if err := k.setComponents(k.cdc, k.logger, k.eventService, sb, bankKeeper); err != nil {
@@ -218,6 +227,36 @@ func (k *Keeper) SetAdapterControllers(controllers ...types.AdapterController) e
 	return nil
 }
 
+// SetCCTPEntrypoint sets the handler of the CCTP transfers with an
+// orbiter payload. The entrypoint can be set only once.
+func (k *Keeper) SetCCTPEntrypoint(entrypoint entrypointtypes.CCTPEntrypoint) error {
+	if entrypoint == nil {
+		return core.ErrNilPointer.Wrap("CCTP entrypoint cannot be nil")
+	}
+	if k.cctpEntrypoint != nil {
+		return core.ErrAlreadySet.Wrap("CCTP entrypoint")
+	}
+
+	k.cctpEntrypoint = entrypoint
+
+	return nil
+}
+
+// SetHyperlaneEntrypoint sets the handler of the Hyperlane transfers with
+// an orbiter payload. The entrypoint can be set only once.
+func (k *Keeper) SetHyperlaneEntrypoint(entrypoint entrypointtypes.HyperlaneEntrypoint) error {
+	if entrypoint == nil {
+		return core.ErrNilPointer.Wrap("Hyperlane entrypoint cannot be nil")
+	}
+	if k.hyperlaneEntrypoint != nil {
+		return core.ErrAlreadySet.Wrap("Hyperlane entrypoint")
+	}
+
+	k.hyperlaneEntrypoint = entrypoint
+
+	return nil
+}
+
 // RequireAuthority returns an error is the signer is not the
 // keeper authority.
 func (k *Keeper) RequireAuthority(signer string) error {
@@ -246,12 +285,31 @@ func (k *Keeper) setComponents(
 		return errorsmod.Wrap(err, "error creating a new forwarding component")
 	}
 
-	dispatcher, err := dispatchercomp.New(cdc, sb, logger, forwarder, executor)
+	dispatcher, err := dispatchercomp.New(
+		cdc,
+		sb,
+		logger,
+		eventService,
+		forwarder,
+		executor,
+		forwarder,
+		forwarder,
+		executor,
+		forwarder,
+	)
 	if err != nil {
 		return errorsmod.Wrap(err, "error creating a new dispatcher component")
 	}
 
-	adapter, err := adaptercomp.New(cdc, sb, logger, eventService, bankKeeper, dispatcher)
+	adapter, err := adaptercomp.New(
+		cdc,
+		sb,
+		logger,
+		eventService,
+		bankKeeper,
+		dispatcher,
+		forwarder,
+	)
 	if err != nil {
 		return errorsmod.Wrap(err, "error creating a new adapter component")
 	}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
+)
+
+var _ entrypointtypes.MsgServer = &entrypointMsgServer{}
+
+// entrypointMsgServer routes the entrypoints messages to the
+// handlers set in the keeper. The handlers are set after the
+// services registration, since they depend on external modules.
+type entrypointMsgServer struct {
+	*Keeper
+}
+
+func NewEntrypointMsgServer(k *Keeper) entrypointtypes.MsgServer {
+	return &entrypointMsgServer{Keeper: k}
+}
+
+func (s *entrypointMsgServer) ReceiveCCTPMessages(
+	ctx context.Context,
+	msg *entrypointtypes.MsgReceiveCCTPMessages,
+) (*entrypointtypes.MsgReceiveCCTPMessagesResponse, error) {
+	if s.cctpEntrypoint == nil {
+		return nil, core.ErrNilPointer.Wrap("CCTP entrypoint is not set")
+	}
+
+	return s.cctpEntrypoint.ReceiveCCTPMessages(ctx, msg)
+}
+
+func (s *entrypointMsgServer) ReceiveHyperlaneMessages(
+	ctx context.Context,
+	msg *entrypointtypes.MsgReceiveHyperlaneMessages,
+) (*entrypointtypes.MsgReceiveHyperlaneMessagesResponse, error) {
+	if s.hyperlaneEntrypoint == nil {
+		return nil, core.ErrNilPointer.Wrap("Hyperlane entrypoint is not set")
+	}
+
+	return s.hyperlaneEntrypoint.ReceiveHyperlaneMessages(ctx, msg)
+}
+
+func (s *entrypointMsgServer) Orbit(
+	ctx context.Context,
+	msg *entrypointtypes.MsgOrbit,
+) (*entrypointtypes.MsgOrbitResponse, error) {
+	return s.Keeper.Orbit(ctx, msg)
+}
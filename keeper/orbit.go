@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/noble-assets/orbiter/v2/types"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
+)
+
+// Orbit dispatches a payload using funds already held on Noble by the sender.
+// The funds are sent to the module account and processed as an incoming transfer
+// with an internal source cross-chain ID.
+func (k *Keeper) Orbit(
+	ctx context.Context,
+	msg *entrypointtypes.MsgOrbit,
+) (*entrypointtypes.MsgOrbitResponse, error) {
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := msg.Coin.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap(err.Error())
+	}
+	if !msg.Coin.IsPositive() {
+		return nil, sdkerrors.ErrInvalidCoins.Wrap("coin amount must be positive")
+	}
+
+	if err := msg.Payload.Validate(); err != nil {
+		return nil, core.ErrValidation.Wrapf("invalid payload: %s", err.Error())
+	}
+
+	transferAttr, err := core.NewTransferAttributes(
+		core.PROTOCOL_INTERNAL,
+		forwardingtypes.CounterpartyID,
+		msg.Coin.Denom,
+		msg.Coin.Amount,
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error creating transfer attributes")
+	}
+
+	orbiterPacket := &types.OrbiterPacket{
+		TransferAttributes: transferAttr,
+		Payload:            msg.Payload,
+	}
+
+	if err := k.adapter.BeforeTransferHook(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		sender,
+		core.ModuleName,
+		sdk.NewCoins(msg.Coin),
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "error sending funds to the module account")
+	}
+
+	if err := k.adapter.AfterTransferHook(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	if err := k.adapter.ProcessPayload(ctx, orbiterPacket); err != nil {
+		return nil, err
+	}
+
+	return &entrypointtypes.MsgOrbitResponse{}, nil
+}
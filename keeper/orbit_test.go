@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	mockorbiter "github.com/noble-assets/orbiter/v2/testutil/mocks/orbiter"
+	orbitertypes "github.com/noble-assets/orbiter/v2/types"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
+)
+
+func TestOrbit(t *testing.T) {
+	coin := sdk.NewCoin("uusdc", math.NewInt(100))
+
+	testCases := []struct {
+		name          string
+		senderBalance sdk.Coins
+		setMsg        func(*entrypointtypes.MsgOrbit)
+		expError      string
+	}{
+		{
+			name:          "success - funds are moved to the module and the payload is processed",
+			senderBalance: sdk.NewCoins(coin),
+			setMsg:        func(*entrypointtypes.MsgOrbit) {},
+		},
+		{
+			name:          "error - nil message",
+			senderBalance: sdk.NewCoins(coin),
+			setMsg:        nil,
+			expError:      "invalid request",
+		},
+		{
+			name:          "error - invalid sender address",
+			senderBalance: sdk.NewCoins(coin),
+			setMsg: func(msg *entrypointtypes.MsgOrbit) {
+				msg.Sender = "invalid"
+			},
+			expError: "invalid sender address",
+		},
+		{
+			name:          "error - zero coin amount",
+			senderBalance: sdk.NewCoins(coin),
+			setMsg: func(msg *entrypointtypes.MsgOrbit) {
+				msg.Coin = sdk.NewCoin(coin.Denom, math.ZeroInt())
+			},
+			expError: "coin amount must be positive",
+		},
+		{
+			name:          "error - nil payload",
+			senderBalance: sdk.NewCoins(coin),
+			setMsg: func(msg *entrypointtypes.MsgOrbit) {
+				msg.Payload = nil
+			},
+			expError: "invalid payload",
+		},
+		{
+			name:          "error - sender does not hold enough funds",
+			senderBalance: sdk.NewCoins(sdk.NewCoin(coin.Denom, math.NewInt(1))),
+			setMsg:        func(*entrypointtypes.MsgOrbit) {},
+			expError:      "error sending funds to the module account",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			ctx, m, k := mockorbiter.OrbiterKeeper(t)
+			k.InitGenesis(ctx, *orbitertypes.DefaultGenesisState())
+
+			sender := testutil.NewNobleAddress()
+			fallbackRecipient := testutil.NewNobleAddress()
+			m.BankKeeper.Balances[sender] = tC.senderBalance
+
+			forwarding, err := forwardingtypes.NewInternalForwarding(testutil.NewNobleAddress())
+			require.NoError(t, err)
+
+			var msg *entrypointtypes.MsgOrbit
+			if tC.setMsg != nil {
+				msg = &entrypointtypes.MsgOrbit{
+					Sender: sender,
+					Coin:   coin,
+					Payload: &core.Payload{
+						Forwarding:        forwarding,
+						FallbackRecipient: fallbackRecipient,
+					},
+				}
+				tC.setMsg(msg)
+			}
+
+			_, err = k.Orbit(ctx, msg)
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+				require.Equal(t, tC.senderBalance, m.BankKeeper.Balances[sender])
+
+				return
+			}
+			require.NoError(t, err)
+
+			// NOTE: no forwarding controllers are registered on the mocked keeper,
+			// so the payload dispatch fails and the funds reach the fallback recipient
+			// through the module account.
+			require.True(t, m.BankKeeper.Balances[sender].IsZero())
+			require.Equal(t, sdk.NewCoins(coin), m.BankKeeper.Balances[fallbackRecipient])
+		})
+	}
+}
@@ -32,15 +32,17 @@ import (
 	dispatchertypes "github.com/noble-assets/orbiter/v2/types/component/dispatcher"
 	executortypes "github.com/noble-assets/orbiter/v2/types/component/executor"
 	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+	entrypointtypes "github.com/noble-assets/orbiter/v2/types/entrypoint"
 )
 
 // RegisterMsgServers registers the gRPC message servers for all Orbiter components
-// (Forwarder, Executor, and Adapter) with the module configurator.
+// (Forwarder, Executor, and Adapter) and entrypoints with the module configurator.
 func RegisterMsgServers(cfg module.Configurator, k *Keeper) {
 	ms := cfg.MsgServer()
 	forwardertypes.RegisterMsgServer(ms, forwarder.NewMsgServer(k.forwarder, k))
 	executortypes.RegisterMsgServer(ms, executor.NewMsgServer(k.executor, k))
 	adaptertypes.RegisterMsgServer(ms, adapter.NewMsgServer(k.adapter, k))
+	entrypointtypes.RegisterMsgServer(ms, NewEntrypointMsgServer(k))
 }
 
 // RegisterQueryServers registers the gRPC query servers for all Orbiter components
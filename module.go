@@ -53,6 +53,7 @@ var (
 	_ module.HasConsensusVersion = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 type AppModuleBasic struct{}
@@ -150,3 +151,7 @@ func (m AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawM
 
 	return cdc.MustMarshalJSON(genesis)
 }
+
+func (m AppModule) EndBlock(ctx context.Context) error {
+	return m.keeper.EndBlock(ctx)
+}
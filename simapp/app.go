@@ -172,12 +172,14 @@ func NewSimApp(
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
-	app.RegisterOrbiterControllers()
-
 	if err := app.RegisterIBCModules(); err != nil {
 		return nil, err
 	}
 
+	// NOTE: the Orbiter controllers must be registered after the IBC
+	// modules since the IBC forwarding controller requires the transfer keeper.
+	app.RegisterOrbiterControllers()
+
 	// When initializing the upgrade keeper via dependency injection, the
 	// initial module version map is created using only the modules that are
 	// wired through dependency injection. As a result, any "legacy" modules
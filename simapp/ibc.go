@@ -95,6 +95,7 @@ func (app *SimApp) RegisterIBCModules() error {
 		transferStack,
 		app.IBCKeeper.ChannelKeeper,
 		app.OrbiterKeeper.Adapter(),
+		app.OrbiterKeeper.Forwarder(),
 	)
 	transferStack = blockibc.NewIBCMiddleware(transferStack, app.FTFKeeper)
 
@@ -6,10 +6,12 @@ import (
 
 func (app *SimApp) RegisterOrbiterControllers() {
 	in := orbiter.ComponentsInputs{
-		Orbiters:   app.OrbiterKeeper,
-		BankKeeper: app.BankKeeper,
-		CCTPKeeper: app.CCTPKeeper,
-		WarpKeeper: app.WarpKeeper,
+		Orbiters:        app.OrbiterKeeper,
+		BankKeeper:      app.BankKeeper,
+		CCTPKeeper:      app.CCTPKeeper,
+		HyperlaneKeeper: app.HyperlaneKeeper,
+		WarpKeeper:      app.WarpKeeper,
+		TransferKeeper:  app.TransferKeeper,
 	}
 
 	orbiter.InjectComponents(in)
@@ -29,11 +29,34 @@ import (
 
 	"github.com/noble-assets/orbiter/v2/keeper/component/adapter"
 	"github.com/noble-assets/orbiter/v2/keeper/component/dispatcher"
+	"github.com/noble-assets/orbiter/v2/keeper/component/forwarder"
 )
 
 func NewAdapterComponent(tb testing.TB) (*adapter.Adapter, *Dependencies) {
 	tb.Helper()
 
+	return NewAdapterComponentWithBankKeeper(tb, &BankKeeper{})
+}
+
+func NewAdapterComponentWithBankKeeper(
+	tb testing.TB,
+	bankKeeper *BankKeeper,
+) (*adapter.Adapter, *Dependencies) {
+	tb.Helper()
+
+	a, _, deps := NewAdapterComponentWithEscrow(tb, bankKeeper)
+
+	return a, deps
+}
+
+// NewAdapterComponentWithEscrow returns an adapter component along with
+// the forwarder component used as packet escrow.
+func NewAdapterComponentWithEscrow(
+	tb testing.TB,
+	bankKeeper *BankKeeper,
+) (*adapter.Adapter, *forwarder.Forwarder, *Dependencies) {
+	tb.Helper()
+
 	deps := NewDependencies(tb)
 
 	sb := collections.NewSchemaBuilder(deps.StoreService)
@@ -42,8 +65,22 @@ func NewAdapterComponent(tb testing.TB) (*adapter.Adapter, *Dependencies) {
 		deps.EncCfg.Codec,
 		sb,
 		deps.Logger,
+		deps.EventService,
 		&ForwardingHandler{},
 		&ActionsHandler{},
+		&PacketDeferrer{},
+		&PacketBatcher{},
+		&RouteFeeHandler{},
+		&AmountLimitValidator{},
+	)
+	require.NoError(tb, err)
+
+	f, err := forwarder.New(
+		deps.EncCfg.Codec,
+		sb,
+		deps.Logger,
+		deps.EventService,
+		bankKeeper,
 	)
 	require.NoError(tb, err)
 
@@ -52,13 +89,14 @@ func NewAdapterComponent(tb testing.TB) (*adapter.Adapter, *Dependencies) {
 		sb,
 		deps.Logger,
 		deps.EventService,
-		&BankKeeper{},
+		bankKeeper,
 		d,
+		f,
 	)
 	require.NoError(tb, err)
 
 	_, err = sb.Build()
 	require.NoError(tb, err)
 
-	return a, &deps
+	return a, f, &deps
 }
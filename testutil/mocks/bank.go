@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/noble-assets/orbiter/v2/types"
@@ -33,23 +34,30 @@ var _ types.BankKeeper = (*BankKeeper)(nil)
 
 type BankKeeper struct {
 	Balances map[string]sdk.Coins
+	// Blocked contains the addresses not allowed to receive funds.
+	Blocked map[string]bool
 }
 
 func NewBankKeeper() *BankKeeper {
 	return &BankKeeper{
 		Balances: make(map[string]sdk.Coins),
+		Blocked:  make(map[string]bool),
 	}
 }
 
+func (k BankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return k.Blocked[addr.String()]
+}
+
 func (k BankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	coins, ok := k.Balances[addr.String()]
 	if !ok {
-		return sdk.Coin{}
+		return sdk.NewCoin(denom, math.ZeroInt())
 	}
 
 	found, coin := coins.Find(denom)
 	if !found {
-		return sdk.Coin{}
+		return sdk.NewCoin(denom, math.ZeroInt())
 	}
 
 	return coin
@@ -120,3 +128,69 @@ func (k BankKeeper) SendCoinsFromModuleToModule(
 
 	return nil
 }
+
+func (k BankKeeper) SendCoinsFromModuleToAccount(
+	ctx context.Context,
+	senderModule string,
+	recipientAddr sdk.AccAddress,
+	amt sdk.Coins,
+) error {
+	if CheckIfFailing(ctx) {
+		return errors.New("error sending coins")
+	}
+
+	if k.BlockedAddr(recipientAddr) {
+		return errors.New("recipient is not allowed to receive funds")
+	}
+
+	fromCoins, found := k.Balances[senderModule]
+	if !found {
+		return errors.New("from account not found")
+	}
+
+	fromFinalCoins, negativeAmt := fromCoins.SafeSub(amt...)
+	if negativeAmt {
+		return errors.New("error during coins deduction")
+	}
+
+	toCoins, found := k.Balances[recipientAddr.String()]
+	if !found {
+		toCoins = sdk.Coins{}
+	}
+
+	k.Balances[senderModule] = fromFinalCoins
+	k.Balances[recipientAddr.String()] = toCoins.Add(amt...)
+
+	return nil
+}
+
+func (k BankKeeper) SendCoinsFromAccountToModule(
+	ctx context.Context,
+	senderAddr sdk.AccAddress,
+	recipientModule string,
+	amt sdk.Coins,
+) error {
+	if CheckIfFailing(ctx) {
+		return errors.New("error sending coins")
+	}
+
+	fromCoins, found := k.Balances[senderAddr.String()]
+	if !found {
+		return errors.New("from account not found")
+	}
+
+	fromFinalCoins, negativeAmt := fromCoins.SafeSub(amt...)
+	if negativeAmt {
+		return errors.New("error during coins deduction")
+	}
+
+	toCoins, found := k.Balances[recipientModule]
+	if !found {
+		toCoins = sdk.Coins{}
+	}
+
+	k.Balances[senderAddr.String()] = fromFinalCoins
+	k.Balances[recipientModule] = toCoins.Add(amt...)
+
+	return nil
+}
@@ -22,6 +22,7 @@ package mocks
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
@@ -29,6 +30,13 @@ import (
 	"github.com/noble-assets/orbiter/v2/types/controller/forwarding"
 )
 
+const (
+	// CCTPTransferNonce is the nonce returned by the mocked burn messages.
+	CCTPTransferNonce uint64 = 1
+	// CCTPPayloadNonce is the nonce returned by the mocked general messages.
+	CCTPPayloadNonce uint64 = 2
+)
+
 var _ forwarding.CCTPMsgServer = CCTPMsgServer{}
 
 type CCTPMsgServer struct{}
@@ -41,7 +49,7 @@ func (c CCTPMsgServer) DepositForBurn(
 		return nil, errors.New("error calling deposit for burn api")
 	}
 
-	return &cctptypes.MsgDepositForBurnResponse{}, nil
+	return &cctptypes.MsgDepositForBurnResponse{Nonce: CCTPTransferNonce}, nil
 }
 
 func (c CCTPMsgServer) DepositForBurnWithCaller(
@@ -52,7 +60,7 @@ func (c CCTPMsgServer) DepositForBurnWithCaller(
 		return nil, errors.New("error calling deposit for burn with caller api")
 	}
 
-	return &cctptypes.MsgDepositForBurnWithCallerResponse{}, nil
+	return &cctptypes.MsgDepositForBurnWithCallerResponse{Nonce: CCTPTransferNonce}, nil
 }
 
 // ReplaceDepositForBurn implements forwarding.CCTPMsgServer.
@@ -66,3 +74,20 @@ func (c CCTPMsgServer) ReplaceDepositForBurn(
 
 	return &cctptypes.MsgReplaceDepositForBurnResponse{}, nil
 }
+
+// SendMessageWithCaller implements forwarding.CCTPMsgServer.
+func (c CCTPMsgServer) SendMessageWithCaller(
+	ctx context.Context,
+	msg *cctptypes.MsgSendMessageWithCaller,
+) (*cctptypes.MsgSendMessageWithCallerResponse, error) {
+	if CheckIfFailing(ctx) {
+		return nil, errors.New("error calling send message with caller api")
+	}
+
+	if len(msg.MessageBody) <= forwarding.CCTPTransferNonceLen ||
+		binary.BigEndian.Uint64(msg.MessageBody) != CCTPTransferNonce {
+		return nil, errors.New("message body is not linked to the transfer nonce")
+	}
+
+	return &cctptypes.MsgSendMessageWithCallerResponse{Nonce: CCTPPayloadNonce}, nil
+}
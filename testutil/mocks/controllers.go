@@ -35,6 +35,10 @@ type ForwardingController struct {
 	id core.ProtocolID
 }
 
+func NewForwardingController(id core.ProtocolID) *ForwardingController {
+	return &ForwardingController{id: id}
+}
+
 func (o *ForwardingController) ID() core.ProtocolID {
 	return o.id
 }
@@ -23,24 +23,57 @@ package mocks
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 
 	"github.com/noble-assets/orbiter/v2/keeper/component/dispatcher"
 	"github.com/noble-assets/orbiter/v2/types"
+	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
 var (
 	_ types.PacketHandler[*types.ActionPacket]     = &ActionsHandler{}
 	_ types.PacketHandler[*types.ForwardingPacket] = &ForwardingHandler{}
+	_ types.PacketDeferrer                         = &PacketDeferrer{}
+	_ types.PacketBatcher                          = &PacketBatcher{}
+	_ types.RouteFeeHandler                        = &RouteFeeHandler{}
+	_ types.AmountLimitValidator                   = &AmountLimitValidator{}
 )
 
 type (
 	ActionsHandler    struct{}
-	ForwardingHandler struct{}
+	ForwardingHandler struct {
+		// Packets are the forwarding packets handled successfully.
+		Packets []*types.ForwardingPacket
+		// FailingProtocols are the protocols whose packets fail to be handled.
+		FailingProtocols []core.ProtocolID
+	}
+	PacketDeferrer struct {
+		// Packets are the forwarding packets deferred.
+		Packets []*types.ForwardingPacket
+		// FallbackRecipients are the fallback recipients of the deferred packets.
+		FallbackRecipients []string
+	}
+	PacketBatcher struct {
+		// Packets are the forwarding packets batched.
+		Packets []*types.ForwardingPacket
+	}
+	RouteFeeHandler struct {
+		// Fees are the fees collected per destination cross-chain ID.
+		Fees map[string]math.Int
+		// Collected are the fees collected.
+		Collected []math.Int
+	}
+	AmountLimitValidator struct {
+		// MaxAmounts are the maximum amounts per destination cross-chain ID.
+		MaxAmounts map[string]math.Int
+	}
 )
 
 func (o *ForwardingHandler) HandlePacket(
@@ -50,6 +83,40 @@ func (o *ForwardingHandler) HandlePacket(
 	if CheckIfFailing(ctx) {
 		return errors.New("error dispatching the forwarding packet")
 	}
+	if slices.Contains(o.FailingProtocols, packet.Forwarding.ProtocolID()) {
+		return fmt.Errorf("error dispatching the %s forwarding packet", packet.Forwarding.ProtocolID())
+	}
+	if CheckIfPaused(ctx) {
+		return core.ErrPaused.Wrap("forwarding is paused")
+	}
+	o.Packets = append(o.Packets, packet)
+
+	return nil
+}
+
+func (d *PacketDeferrer) DeferPacket(
+	ctx context.Context,
+	packet *types.ForwardingPacket,
+	fallbackRecipient string,
+) error {
+	if CheckIfFailing(ctx) {
+		return errors.New("error deferring the forwarding packet")
+	}
+	d.Packets = append(d.Packets, packet)
+	d.FallbackRecipients = append(d.FallbackRecipients, fallbackRecipient)
+
+	return nil
+}
+
+func (b *PacketBatcher) BatchPacket(
+	ctx context.Context,
+	packet *types.ForwardingPacket,
+	_ string,
+) error {
+	if CheckIfFailing(ctx) {
+		return errors.New("error batching the forwarding packet")
+	}
+	b.Packets = append(b.Packets, packet)
 
 	return nil
 }
@@ -65,6 +132,35 @@ func (a *ActionsHandler) HandlePacket(
 	return nil
 }
 
+func (h *RouteFeeHandler) HandleRouteFee(
+	_ context.Context,
+	transferAttr *core.TransferAttributes,
+	destinationID core.CrossChainID,
+) error {
+	fee, found := h.Fees[destinationID.ID()]
+	if !found {
+		return nil
+	}
+	transferAttr.SetDestinationAmount(transferAttr.DestinationAmount().Sub(fee))
+	h.Collected = append(h.Collected, fee)
+
+	return nil
+}
+
+func (v *AmountLimitValidator) ValidateAmountLimit(
+	_ context.Context,
+	destinationID core.CrossChainID,
+	_ string,
+	amount math.Int,
+) error {
+	maxAmount, found := v.MaxAmounts[destinationID.ID()]
+	if !found || amount.LTE(maxAmount) {
+		return nil
+	}
+
+	return core.ErrAmountAboveMaximum.Wrapf("amount %s is greater than %s", amount, maxAmount)
+}
+
 func NewDispatcherComponent(tb testing.TB) (*dispatcher.Dispatcher, *Dependencies) {
 	tb.Helper()
 
@@ -75,8 +171,13 @@ func NewDispatcherComponent(tb testing.TB) (*dispatcher.Dispatcher, *Dependencie
 		deps.EncCfg.Codec,
 		sb,
 		deps.Logger,
+		deps.EventService,
 		&ForwardingHandler{},
 		&ActionsHandler{},
+		&PacketDeferrer{},
+		&PacketBatcher{},
+		&RouteFeeHandler{},
+		&AmountLimitValidator{},
 	)
 	require.NoError(tb, err)
 	_, err = sb.Build()
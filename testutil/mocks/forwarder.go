@@ -33,6 +33,15 @@ import (
 func NewForwarderComponent(tb testing.TB) (*forwarder.Forwarder, *Dependencies) {
 	tb.Helper()
 
+	return NewForwarderComponentWithBankKeeper(tb, NewBankKeeper())
+}
+
+func NewForwarderComponentWithBankKeeper(
+	tb testing.TB,
+	bankKeeper *BankKeeper,
+) (*forwarder.Forwarder, *Dependencies) {
+	tb.Helper()
+
 	deps := NewDependencies(tb)
 
 	sb := collections.NewSchemaBuilder(deps.StoreService)
@@ -42,7 +51,7 @@ func NewForwarderComponent(tb testing.TB) (*forwarder.Forwarder, *Dependencies)
 		sb,
 		deps.Logger,
 		deps.EventService,
-		NewBankKeeper(),
+		bankKeeper,
 	)
 	require.NoError(tb, err)
 	_, err = sb.Build()
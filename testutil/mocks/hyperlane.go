@@ -21,19 +21,32 @@
 package mocks
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 
 	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/noble-assets/orbiter/v2/types/controller/forwarding"
 )
 
+var (
+	// HyperlaneTransferMessageID is the message ID returned by the mocked remote transfers.
+	HyperlaneTransferMessageID = hyperlaneutil.CreateMockHexAddress("transfer", 1)
+	// HyperlanePayloadMessageID is the message ID returned by the mocked dispatched messages.
+	HyperlanePayloadMessageID = hyperlaneutil.CreateMockHexAddress("payload", 1)
+)
+
 var _ forwarding.HyperlaneHandler = HyperlaneHandler{}
 
 type HyperlaneHandler struct {
 	Tokens map[string]warptypes.WrappedHypToken
+	// GasPayment is the fee returned by the remote transfer quotes.
+	GasPayment sdk.Coins
 }
 
 // RemoteTransfer implements forwarding.HyperlaneHandler.
@@ -46,7 +59,7 @@ func (h HyperlaneHandler) RemoteTransfer(
 	}
 
 	return &warptypes.MsgRemoteTransferResponse{
-		MessageId: hyperlaneutil.HexAddress{},
+		MessageId: HyperlaneTransferMessageID,
 	}, nil
 }
 
@@ -64,3 +77,61 @@ func (h HyperlaneHandler) Token(
 		Token: &t,
 	}, nil
 }
+
+// QuoteRemoteTransfer implements forwarding.HyperlaneHandler.
+func (h HyperlaneHandler) QuoteRemoteTransfer(
+	ctx context.Context,
+	request *warptypes.QueryQuoteRemoteTransferRequest,
+) (*warptypes.QueryQuoteRemoteTransferResponse, error) {
+	if _, found := h.Tokens[request.Id]; !found {
+		return nil, errors.New("token does not exist")
+	}
+
+	return &warptypes.QueryQuoteRemoteTransferResponse{
+		GasPayment: h.GasPayment,
+	}, nil
+}
+
+// DispatchMessage implements forwarding.HyperlaneHandler.
+func (h HyperlaneHandler) DispatchMessage(
+	ctx context.Context,
+	originMailboxID hyperlaneutil.HexAddress,
+	sender hyperlaneutil.HexAddress,
+	maxFee sdk.Coins,
+	destinationDomain uint32,
+	recipient hyperlaneutil.HexAddress,
+	body []byte,
+	metadata hyperlaneutil.StandardHookMetadata,
+	postDispatchHookID *hyperlaneutil.HexAddress,
+) (hyperlaneutil.HexAddress, error) {
+	if CheckIfFailing(ctx) {
+		return hyperlaneutil.HexAddress{}, errors.New("error dispatching message")
+	}
+
+	if !bytes.HasPrefix(body, HyperlaneTransferMessageID.Bytes()) {
+		return hyperlaneutil.HexAddress{}, errors.New("message body is not linked to the transfer")
+	}
+
+	return HyperlanePayloadMessageID, nil
+}
+
+var _ forwarding.HyperlaneTokenRouteResolver = HyperlaneTokenRouteResolver{}
+
+// HyperlaneTokenRouteResolver is a mocked resolver of the Hyperlane token routes
+// indexed by "denom/destination_domain".
+type HyperlaneTokenRouteResolver map[string][]byte
+
+// GetHyperlaneTokenRoute implements forwarding.HyperlaneTokenRouteResolver.
+func (r HyperlaneTokenRouteResolver) GetHyperlaneTokenRoute(
+	ctx context.Context,
+	denom string,
+	destinationDomain uint32,
+) ([]byte, bool, error) {
+	if CheckIfFailing(ctx) {
+		return nil, false, errors.New("error getting Hyperlane token route")
+	}
+
+	tokenID, found := r[fmt.Sprintf("%s/%d", denom, destinationDomain)]
+
+	return tokenID, found, nil
+}
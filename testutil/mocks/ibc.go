@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mocks
+
+import (
+	"context"
+	"errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+)
+
+var _ forwarding.IBCHandler = &IBCHandler{}
+
+type IBCHandler struct {
+	// Sequence is the sequence assigned to the next transfer.
+	Sequence uint64
+	// Transfers contains all the transfers executed.
+	Transfers []*transfertypes.MsgTransfer
+}
+
+func NewIBCHandler() *IBCHandler {
+	return &IBCHandler{
+		Sequence: 1,
+	}
+}
+
+// Transfer implements forwarding.IBCHandler.
+func (h *IBCHandler) Transfer(
+	ctx context.Context,
+	msg *transfertypes.MsgTransfer,
+) (*transfertypes.MsgTransferResponse, error) {
+	if CheckIfFailing(ctx) {
+		return nil, errors.New("error executing IBC transfer")
+	}
+
+	h.Transfers = append(h.Transfers, msg)
+
+	sequence := h.Sequence
+	h.Sequence++
+
+	return &transfertypes.MsgTransferResponse{
+		Sequence: sequence,
+	}, nil
+}
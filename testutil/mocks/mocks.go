@@ -23,6 +23,7 @@ package mocks
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/noble-assets/orbiter/v2/testutil"
@@ -30,7 +31,10 @@ import (
 
 type contextKey string
 
-const FailingContextKey contextKey = "failing"
+const (
+	FailingContextKey contextKey = "failing"
+	PausedContextKey  contextKey = "paused"
+)
 
 func init() {
 	testutil.Authority = testutil.NewNobleAddress()
@@ -42,6 +46,8 @@ type Mocks struct {
 	BankKeeper *BankKeeper
 	// Circle
 	CCTPMsgServer *CCTPMsgServer
+	// Noble
+	SwapKeeper *SwapKeeper
 }
 
 func NewMocks() Mocks {
@@ -54,6 +60,8 @@ func NewMocks() Mocks {
 		BankKeeper: &bk,
 		// Circle
 		CCTPMsgServer: &CCTPMsgServer{},
+		// Noble
+		SwapKeeper: &SwapKeeper{AmountOut: math.ZeroInt()},
 	}
 
 	return mocks
@@ -67,3 +75,12 @@ func CheckIfFailing(ctx context.Context) bool {
 
 	return true
 }
+
+func CheckIfPaused(ctx context.Context) bool {
+	isPaused := ctx.Value(PausedContextKey)
+	if isPaused == nil || isPaused == false {
+		return false
+	}
+
+	return true
+}
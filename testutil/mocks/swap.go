@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package mocks
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+)
+
+var _ actiontypes.SwapKeeper = &SwapKeeper{}
+
+type SwapKeeper struct {
+	// AmountOut is the amount returned by the mocked swaps.
+	AmountOut math.Int
+	// Swapped are the coins swapped.
+	Swapped []sdk.Coin
+}
+
+// Swap implements actiontypes.SwapKeeper.
+func (k *SwapKeeper) Swap(
+	ctx context.Context,
+	_ sdk.AccAddress,
+	coin sdk.Coin,
+	routes []*actiontypes.SwapRoute,
+	minCoinOut sdk.Coin,
+) (sdk.Coin, error) {
+	if CheckIfFailing(ctx) {
+		return sdk.Coin{}, errors.New("error executing swap")
+	}
+
+	coinOut := sdk.NewCoin(routes[len(routes)-1].DenomTo, k.AmountOut)
+	if coinOut.IsLT(minCoinOut) {
+		return sdk.Coin{}, errors.New("swap output is lower than the minimum")
+	}
+	k.Swapped = append(k.Swapped, coin)
+
+	return coinOut, nil
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	"github.com/noble-assets/orbiter/v2/testutil/testdata"
@@ -109,3 +110,47 @@ func CreatePayloadWithAction(t *testing.T) (*core.Payload, string) {
 
 	return payloadWrapper.Orbiter, string(bz)
 }
+
+// CreatePayloadEnvelope returns the orbiter payload used in the tests along
+// with its binary envelope.
+func CreatePayloadEnvelope(t *testing.T) (*core.Payload, []byte) {
+	t.Helper()
+
+	forwardingAttributes := testdata.TestForwardingAttr{Planet: "venus"}
+	forwarding, err := core.NewForwarding(
+		core.PROTOCOL_IBC,
+		&forwardingAttributes,
+		[]byte("payload"),
+	)
+	require.NoError(t, err)
+	payloadWrapper, err := core.NewPayloadWrapper(forwarding)
+	require.NoError(t, err)
+	bz, err := core.NewPayloadEnvelope(payloadWrapper)
+	require.NoError(t, err)
+
+	return payloadWrapper.Orbiter, bz
+}
+
+// CreateForwardingPacket returns a forwarding packet of the coin received
+// from the IBC source channel, forwarded with the given forwarding.
+func CreateForwardingPacket(
+	t *testing.T,
+	sourceChannel string,
+	coin sdk.Coin,
+	forwarding *core.Forwarding,
+) *types.ForwardingPacket {
+	t.Helper()
+
+	transferAttr, err := core.NewTransferAttributes(
+		core.PROTOCOL_IBC,
+		sourceChannel,
+		coin.Denom,
+		coin.Amount,
+	)
+	require.NoError(t, err)
+
+	packet, err := types.NewForwardingPacket(transferAttr, forwarding)
+	require.NoError(t, err)
+
+	return packet
+}
@@ -27,10 +27,12 @@ import (
 	"github.com/noble-assets/orbiter/v2/types/component"
 	"github.com/noble-assets/orbiter/v2/types/controller"
 	"github.com/noble-assets/orbiter/v2/types/core"
+	"github.com/noble-assets/orbiter/v2/types/entrypoint"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	component.RegisterLegacyAminoCodec(cdc)
+	entrypoint.RegisterLegacyAminoCodec(cdc)
 }
 
 // RegisterInterfaces is used to register in the chain codec
@@ -49,4 +51,5 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 
 	component.RegisterInterfaces(registry)
 	controller.RegisterInterfaces(registry)
+	entrypoint.RegisterInterfaces(registry)
 }
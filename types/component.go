@@ -24,6 +24,7 @@ import (
 	"context"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/noble-assets/orbiter/v2/types/core"
 	"github.com/noble-assets/orbiter/v2/types/router"
@@ -43,6 +44,11 @@ type Forwarder interface {
 	Loggable
 	PacketHandler[*ForwardingPacket]
 	router.RouterProvider[core.ProtocolID, ForwardingController]
+	InFlightPacketTracker
+	InFlightPacketHandler
+	PacketEscrow
+	PacketDeferrer
+	PacketBatcher
 	Pause(context.Context, core.ProtocolID, []string) error
 	Unpause(context.Context, core.ProtocolID, []string) error
 }
@@ -57,6 +63,27 @@ type Executor interface {
 	Unpause(context.Context, core.ActionID) error
 }
 
+// RouteFeeHandler defines the behavior expected to apply the
+// fee defined by the authority for the route of a transfer.
+type RouteFeeHandler interface {
+	HandleRouteFee(
+		ctx context.Context,
+		transferAttr *core.TransferAttributes,
+		destinationID core.CrossChainID,
+	) error
+}
+
+// AmountLimitValidator defines the behavior expected to check the
+// amount forwarded to a destination against the limit of its route.
+type AmountLimitValidator interface {
+	ValidateAmountLimit(
+		ctx context.Context,
+		destinationID core.CrossChainID,
+		denom string,
+		amount math.Int,
+	) error
+}
+
 // Dispatcher defines the behavior a components must
 // have to dispatch packets.
 type Dispatcher interface {
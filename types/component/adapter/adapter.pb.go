@@ -28,6 +28,11 @@ type Params struct {
 	// max_passthrough_payload_size is the maximum number of bytes
 	// of the passthrough payload that can be sent in a forwarding.
 	MaxPassthroughPayloadSize uint32 `protobuf:"varint,1,opt,name=max_passthrough_payload_size,json=maxPassthroughPayloadSize,proto3" json:"max_passthrough_payload_size,omitempty"`
+	// allowed_coexisting_keys is the list of root level keys of a JSON
+	// payload allowed alongside the orbiter key. These keys are not
+	// consumed by the module and are left to other payload consumers.
+	// If empty, only the orbiter key is allowed.
+	AllowedCoexistingKeys []string `protobuf:"bytes,2,rep,name=allowed_coexisting_keys,json=allowedCoexistingKeys,proto3" json:"allowed_coexisting_keys,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +75,13 @@ func (m *Params) GetMaxPassthroughPayloadSize() uint32 {
 	return 0
 }
 
+func (m *Params) GetAllowedCoexistingKeys() []string {
+	if m != nil {
+		return m.AllowedCoexistingKeys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "noble.orbiter.component.adapter.v1.Params")
 }
@@ -79,22 +91,24 @@ func init() {
 }
 
 var fileDescriptor_10a20b3dc41c6a78 = []byte{
-	// 229 bytes of a gzipped FileDescriptorProto
+	// 270 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc8, 0xcb, 0x4f, 0xca,
 	0x49, 0xd5, 0xcf, 0x2f, 0x4a, 0xca, 0x2c, 0x49, 0x2d, 0xd2, 0x4f, 0xce, 0xcf, 0x2d, 0xc8, 0xcf,
 	0x4b, 0xcd, 0x2b, 0xd1, 0x4f, 0x4c, 0x49, 0x2c, 0x00, 0x89, 0x94, 0x19, 0xc2, 0x98, 0x7a, 0x05,
 	0x45, 0xf9, 0x25, 0xf9, 0x42, 0x4a, 0x60, 0x1d, 0x7a, 0x50, 0x1d, 0x7a, 0x70, 0x1d, 0x7a, 0x30,
-	0x65, 0x65, 0x86, 0x52, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0xa2, 0x4d, 0x29,
-	0x80, 0x8b, 0x2d, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0xc8, 0x8d, 0x4b, 0x26, 0x37, 0xb1, 0x22,
-	0xbe, 0x20, 0xb1, 0xb8, 0xb8, 0x24, 0xa3, 0x28, 0xbf, 0x34, 0x3d, 0x23, 0xbe, 0x20, 0xb1, 0x32,
-	0x27, 0x3f, 0x31, 0x25, 0xbe, 0x38, 0xb3, 0x2a, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd7, 0x89,
-	0x75, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x92, 0xb9, 0x89, 0x15, 0x01, 0x08, 0x95, 0x01, 0x10,
-	0x85, 0xc1, 0x99, 0x55, 0xa9, 0x4e, 0x21, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x65, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0x04, 0x72, 0x9b, 0x3e, 0xd8, 0xb5, 0xba, 0x89,
-	0xc5, 0xc5, 0xa9, 0x25, 0xc5, 0x70, 0x6f, 0x96, 0x19, 0xe9, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x63,
-	0xfa, 0x37, 0x89, 0x0d, 0xec, 0x5c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xce, 0xce, 0x5b,
-	0x5c, 0x19, 0x01, 0x00, 0x00,
+	0x65, 0x65, 0x86, 0x52, 0x82, 0x89, 0xb9, 0x99, 0x79, 0xf9, 0xfa, 0x60, 0x12, 0xa2, 0x4d, 0xa9,
+	0x83, 0x91, 0x8b, 0x2d, 0x20, 0xb1, 0x28, 0x31, 0xb7, 0x58, 0xc8, 0x8d, 0x4b, 0x26, 0x37, 0xb1,
+	0x22, 0xbe, 0x20, 0xb1, 0xb8, 0xb8, 0x24, 0xa3, 0x28, 0xbf, 0x34, 0x3d, 0x23, 0xbe, 0x20, 0xb1,
+	0x32, 0x27, 0x3f, 0x31, 0x25, 0xbe, 0x38, 0xb3, 0x2a, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd7,
+	0x89, 0x75, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x92, 0xb9, 0x89, 0x15, 0x01, 0x08, 0x95, 0x01,
+	0x10, 0x85, 0xc1, 0x99, 0x55, 0xa9, 0x42, 0x66, 0x5c, 0xe2, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xa9,
+	0x29, 0xf1, 0xc9, 0xf9, 0xa9, 0x15, 0x99, 0xc5, 0x25, 0x99, 0x79, 0xe9, 0xf1, 0xd9, 0xa9, 0x95,
+	0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x41, 0xa2, 0x50, 0x69, 0x67, 0xb8, 0xac, 0x77, 0x6a,
+	0x65, 0xb1, 0x53, 0xc8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7,
+	0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa5,
+	0x67, 0x96, 0x64, 0x94, 0x26, 0x81, 0x3c, 0xa5, 0x0f, 0xf6, 0xa6, 0x6e, 0x62, 0x71, 0x71, 0x6a,
+	0x49, 0x31, 0x3c, 0x7c, 0xca, 0x8c, 0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x31, 0x03, 0x2a, 0x89,
+	0x0d, 0xec, 0x4f, 0x63, 0xc0, 0x00, 0x89, 0x35, 0x58, 0x30, 0x52, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -117,6 +131,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedCoexistingKeys) > 0 {
+		for iNdEx := len(m.AllowedCoexistingKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCoexistingKeys[iNdEx])
+			copy(dAtA[i:], m.AllowedCoexistingKeys[iNdEx])
+			i = encodeVarintAdapter(dAtA, i, uint64(len(m.AllowedCoexistingKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.MaxPassthroughPayloadSize != 0 {
 		i = encodeVarintAdapter(dAtA, i, uint64(m.MaxPassthroughPayloadSize))
 		i--
@@ -145,6 +168,12 @@ func (m *Params) Size() (n int) {
 	if m.MaxPassthroughPayloadSize != 0 {
 		n += 1 + sovAdapter(uint64(m.MaxPassthroughPayloadSize))
 	}
+	if len(m.AllowedCoexistingKeys) > 0 {
+		for _, s := range m.AllowedCoexistingKeys {
+			l = len(s)
+			n += 1 + l + sovAdapter(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -202,6 +231,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCoexistingKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdapter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdapter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdapter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCoexistingKeys = append(m.AllowedCoexistingKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdapter(dAtA[iNdEx:])
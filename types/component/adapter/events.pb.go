@@ -5,6 +5,9 @@ package adapter
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	core "github.com/noble-assets/orbiter/v2/types/core"
 	io "io"
@@ -67,8 +70,79 @@ func (m *EventPayloadProcessed) GetPayload() *core.Payload {
 	return nil
 }
 
+// EventPayloadFallback is emitted when the dispatch of a payload
+// failed and the transferred funds are sent to the fallback recipient.
+type EventPayloadFallback struct {
+	Payload           *core.Payload `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	FallbackRecipient string        `protobuf:"bytes,2,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+	Coin              types.Coin    `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	Reason            string        `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventPayloadFallback) Reset()         { *m = EventPayloadFallback{} }
+func (m *EventPayloadFallback) String() string { return proto.CompactTextString(m) }
+func (*EventPayloadFallback) ProtoMessage()    {}
+func (*EventPayloadFallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f0659953bfa9e89d, []int{1}
+}
+func (m *EventPayloadFallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPayloadFallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPayloadFallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPayloadFallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPayloadFallback.Merge(m, src)
+}
+func (m *EventPayloadFallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPayloadFallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPayloadFallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPayloadFallback proto.InternalMessageInfo
+
+func (m *EventPayloadFallback) GetPayload() *core.Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *EventPayloadFallback) GetFallbackRecipient() string {
+	if m != nil {
+		return m.FallbackRecipient
+	}
+	return ""
+}
+
+func (m *EventPayloadFallback) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *EventPayloadFallback) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventPayloadProcessed)(nil), "noble.orbiter.component.adapter.v1.EventPayloadProcessed")
+	proto.RegisterType((*EventPayloadFallback)(nil), "noble.orbiter.component.adapter.v1.EventPayloadFallback")
 }
 
 func init() {
@@ -76,21 +150,30 @@ func init() {
 }
 
 var fileDescriptor_f0659953bfa9e89d = []byte{
-	// 217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcf, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0xcf, 0x2f, 0x4a, 0xca, 0x2c, 0x49, 0x2d, 0xd2, 0x4f, 0xce, 0xcf, 0x2d, 0xc8, 0xcf,
-	0x4b, 0xcd, 0x2b, 0xd1, 0x4f, 0x4c, 0x49, 0x2c, 0x00, 0x89, 0x94, 0x19, 0xea, 0xa7, 0x96, 0xa5,
-	0xe6, 0x95, 0x14, 0xeb, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x29, 0x81, 0x35, 0xe8, 0x41, 0x35,
-	0xe8, 0xc1, 0x35, 0xe8, 0x41, 0x35, 0xe8, 0x95, 0x19, 0x4a, 0x29, 0xa3, 0x1b, 0x5a, 0x94, 0x0a,
-	0x32, 0x07, 0xa6, 0x07, 0x6c, 0x90, 0x52, 0x20, 0x97, 0xa8, 0x2b, 0xc8, 0xe0, 0x80, 0xc4, 0xca,
-	0x9c, 0xfc, 0xc4, 0x94, 0x80, 0xa2, 0xfc, 0xe4, 0xd4, 0xe2, 0xe2, 0xd4, 0x14, 0x21, 0x0b, 0x2e,
-	0xf6, 0x02, 0x88, 0x98, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x9c, 0x1e, 0xba, 0x9d, 0x45,
-	0xa9, 0x7a, 0x65, 0x86, 0x7a, 0x50, 0x9d, 0x41, 0x30, 0xe5, 0x4e, 0x21, 0x27, 0x1e, 0xc9, 0x31,
-	0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb,
-	0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0x04, 0x72, 0x2e,
-	0xc4, 0xc7, 0xba, 0x89, 0xc5, 0xc5, 0xa9, 0x25, 0xc5, 0x70, 0x37, 0x96, 0x19, 0xe9, 0x97, 0x54,
-	0x16, 0xa4, 0x16, 0x63, 0x86, 0x40, 0x12, 0x1b, 0xd8, 0xbd, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x99, 0x10, 0x3d, 0x2b, 0x2b, 0x01, 0x00, 0x00,
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xbf, 0x4e, 0xe3, 0x40,
+	0x10, 0xc6, 0xbd, 0x77, 0x51, 0x4e, 0xf1, 0x55, 0xb1, 0x72, 0x27, 0x93, 0x62, 0x89, 0x42, 0x13,
+	0x21, 0x65, 0x57, 0x0e, 0x4d, 0x44, 0x19, 0x04, 0x75, 0xb0, 0xa8, 0x68, 0xd0, 0xda, 0x19, 0x82,
+	0x85, 0xb3, 0x63, 0xed, 0x2e, 0x96, 0xf2, 0x16, 0x3c, 0x06, 0x25, 0x8f, 0x91, 0x32, 0x74, 0x54,
+	0x08, 0x25, 0x05, 0xaf, 0x81, 0x6c, 0xaf, 0x23, 0x14, 0x3a, 0x1a, 0x6b, 0xe7, 0xcf, 0xef, 0xf3,
+	0xe8, 0x9b, 0x71, 0xb9, 0xc4, 0x28, 0x05, 0x8e, 0x2a, 0x4a, 0x0c, 0x28, 0x1e, 0xe3, 0x22, 0x43,
+	0x09, 0xd2, 0x70, 0x31, 0x13, 0x59, 0x91, 0xc9, 0x03, 0x0e, 0x39, 0x48, 0xa3, 0x59, 0xa6, 0xd0,
+	0xa0, 0xd7, 0x2f, 0x01, 0x66, 0x01, 0xb6, 0x03, 0x98, 0x05, 0x58, 0x1e, 0x74, 0xdb, 0x62, 0x91,
+	0x48, 0xe4, 0xe5, 0xb7, 0xc2, 0xba, 0x34, 0x46, 0xbd, 0x40, 0xcd, 0x23, 0xa1, 0x81, 0xe7, 0x41,
+	0x04, 0x46, 0x04, 0x3c, 0xc6, 0x44, 0xda, 0x7a, 0x67, 0x8e, 0x73, 0x2c, 0x9f, 0xbc, 0x78, 0xd9,
+	0xec, 0xd1, 0xfe, 0x74, 0xaa, 0x80, 0xeb, 0xb8, 0x6a, 0xea, 0x5f, 0xba, 0xff, 0xce, 0x8b, 0x09,
+	0xa7, 0x62, 0x99, 0xa2, 0x98, 0x4d, 0x15, 0xc6, 0xa0, 0x35, 0xcc, 0xbc, 0xb1, 0xfb, 0x27, 0xab,
+	0x72, 0x3e, 0xe9, 0x91, 0xc1, 0xdf, 0x11, 0x65, 0xfb, 0xc3, 0x2b, 0x60, 0x79, 0xc0, 0x2c, 0x19,
+	0xd6, 0xed, 0xfd, 0x17, 0xe2, 0x76, 0xbe, 0x6a, 0x5e, 0x88, 0x34, 0x8d, 0x44, 0x7c, 0xff, 0x73,
+	0x49, 0x6f, 0xe8, 0x7a, 0xb7, 0x56, 0xe5, 0x46, 0x41, 0x9c, 0x64, 0x09, 0x48, 0xe3, 0xff, 0xea,
+	0x91, 0x41, 0x2b, 0x6c, 0xd7, 0x95, 0xb0, 0x2e, 0x78, 0x63, 0xb7, 0x51, 0xb8, 0xe3, 0xff, 0x2e,
+	0xff, 0x72, 0xc0, 0x2a, 0xfb, 0x58, 0x61, 0x1f, 0xb3, 0xf6, 0xb1, 0x33, 0x4c, 0xe4, 0xa4, 0xb5,
+	0x7a, 0x3b, 0x74, 0x9e, 0x3e, 0x9e, 0x8f, 0x49, 0x58, 0x12, 0xde, 0x7f, 0xb7, 0xa9, 0x40, 0x68,
+	0x94, 0x7e, 0xa3, 0x14, 0xb7, 0xd1, 0xe4, 0x6a, 0xb5, 0xa1, 0x64, 0xbd, 0xa1, 0xe4, 0x7d, 0x43,
+	0xc9, 0xe3, 0x96, 0x3a, 0xeb, 0x2d, 0x75, 0x5e, 0xb7, 0xd4, 0xb9, 0x3e, 0x9d, 0x27, 0xe6, 0xee,
+	0x21, 0x2a, 0x76, 0x59, 0x9d, 0xc3, 0x50, 0x68, 0x0d, 0x46, 0xef, 0x7c, 0xcf, 0x47, 0xdc, 0x2c,
+	0x33, 0xd0, 0xdf, 0xcf, 0x23, 0x6a, 0x96, 0x3b, 0x38, 0xf9, 0x1c, 0x00, 0x11, 0x5a, 0xdd, 0x76,
+	0x48, 0x02, 0x00, 0x00,
 }
 
 func (m *EventPayloadProcessed) Marshal() (dAtA []byte, err error) {
@@ -128,6 +211,65 @@ func (m *EventPayloadProcessed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPayloadFallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPayloadFallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPayloadFallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -152,6 +294,29 @@ func (m *EventPayloadProcessed) Size() (n int) {
 	return n
 }
 
+func (m *EventPayloadFallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -244,6 +409,189 @@ func (m *EventPayloadProcessed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPayloadFallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPayloadFallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPayloadFallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &core.Payload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -40,5 +40,5 @@ func (g *GenesisState) Validate() error {
 		return core.ErrNilPointer.Wrap("adapter genesis state")
 	}
 
-	return nil
+	return g.Params.Validate()
 }
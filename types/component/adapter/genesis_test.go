@@ -44,6 +44,33 @@ func TestValidate(t *testing.T) {
 				Params: Params{MaxPassthroughPayloadSize: 1024},
 			},
 		},
+		{
+			name: "success - valid genesis state with allowed coexisting keys",
+			genState: &GenesisState{
+				Params: Params{AllowedCoexistingKeys: []string{"wasm", "forward"}},
+			},
+		},
+		{
+			name: "error - empty allowed coexisting key",
+			genState: &GenesisState{
+				Params: Params{AllowedCoexistingKeys: []string{"wasm", " "}},
+			},
+			expErr: "allowed coexisting key cannot be empty",
+		},
+		{
+			name: "error - orbiter key as allowed coexisting key",
+			genState: &GenesisState{
+				Params: Params{AllowedCoexistingKeys: []string{core.OrbiterPrefix}},
+			},
+			expErr: "cannot be the orbiter key",
+		},
+		{
+			name: "error - duplicate allowed coexisting key",
+			genState: &GenesisState{
+				Params: Params{AllowedCoexistingKeys: []string{"wasm", "wasm"}},
+			},
+			expErr: "duplicate allowed coexisting key",
+		},
 		{
 			name:     "error - nil genesis state",
 			genState: nil,
@@ -30,31 +30,39 @@ type CrossChainPacket interface {
 }
 
 // IBCCrossChainPacket represents a cross-chain packet received via IBC with routing metadata.
-// It encapsulates the packet data along with source port and channel information required
-// for IBC packet processing and acknowledgment handling.
+// It encapsulates the packet data along with source and destination port and channel
+// information required for IBC packet processing and acknowledgment handling.
 type IBCCrossChainPacket struct {
-	sourcePort    string
-	sourceChannel string
-	data          []byte
+	sourcePort         string
+	sourceChannel      string
+	destinationPort    string
+	destinationChannel string
+	data               []byte
 }
 
 // NewIBCCrossChainPacket creates a new IBCCrossChainPacket with the provided routing information.
 // The data slice is defensively copied to prevent external mutation after construction.
 func NewIBCCrossChainPacket(
 	sourcePort, sourceChannel string,
+	destinationPort, destinationChannel string,
 	data []byte,
 ) (*IBCCrossChainPacket, error) {
 	if sourcePort == "" || sourceChannel == "" {
 		return nil, fmt.Errorf("source port and channel must not be empty")
 	}
+	if destinationPort == "" || destinationChannel == "" {
+		return nil, fmt.Errorf("destination port and channel must not be empty")
+	}
 
 	dataCopy := make([]byte, len(data))
 	copy(dataCopy, data)
 
 	return &IBCCrossChainPacket{
-		sourcePort:    sourcePort,
-		sourceChannel: sourceChannel,
-		data:          dataCopy,
+		sourcePort:         sourcePort,
+		sourceChannel:      sourceChannel,
+		destinationPort:    destinationPort,
+		destinationChannel: destinationChannel,
+		data:               dataCopy,
 	}, nil
 }
 
@@ -72,3 +80,121 @@ func (i *IBCCrossChainPacket) SourcePort() string {
 func (i *IBCCrossChainPacket) SourceChannel() string {
 	return i.sourceChannel
 }
+
+// DestinationPort returns the IBC port identifier on Noble receiving the packet.
+func (i *IBCCrossChainPacket) DestinationPort() string {
+	return i.destinationPort
+}
+
+// DestinationChannel returns the IBC channel identifier on Noble receiving the packet.
+func (i *IBCCrossChainPacket) DestinationChannel() string {
+	return i.destinationChannel
+}
+
+var _ CrossChainPacket = (*CCTPCrossChainPacket)(nil)
+
+// CCTPCrossChainPacket represents a cross-chain transfer received via CCTP. It is composed by
+// the message containing the burn message and by the general message containing the orbiter
+// payload, which have to be received in the same transaction.
+type CCTPCrossChainPacket struct {
+	mintDenom       string
+	transferMessage []byte
+	payloadMessage  []byte
+}
+
+// NewCCTPCrossChainPacket creates a new CCTPCrossChainPacket with the provided messages and
+// the Noble denom minted by the transfer. The messages are defensively copied to prevent
+// external mutation after construction.
+func NewCCTPCrossChainPacket(
+	mintDenom string,
+	transferMessage, payloadMessage []byte,
+) (*CCTPCrossChainPacket, error) {
+	if mintDenom == "" {
+		return nil, fmt.Errorf("mint denom must not be empty")
+	}
+
+	if len(transferMessage) == 0 || len(payloadMessage) == 0 {
+		return nil, fmt.Errorf("transfer and payload messages must not be empty")
+	}
+
+	transferCopy := make([]byte, len(transferMessage))
+	copy(transferCopy, transferMessage)
+
+	payloadCopy := make([]byte, len(payloadMessage))
+	copy(payloadCopy, payloadMessage)
+
+	return &CCTPCrossChainPacket{
+		mintDenom:       mintDenom,
+		transferMessage: transferCopy,
+		payloadMessage:  payloadCopy,
+	}, nil
+}
+
+// Packet returns the raw bytes of the CCTP transfer message.
+func (c *CCTPCrossChainPacket) Packet() []byte {
+	return c.transferMessage
+}
+
+// PayloadMessage returns the raw bytes of the CCTP message containing the orbiter payload.
+func (c *CCTPCrossChainPacket) PayloadMessage() []byte {
+	return c.payloadMessage
+}
+
+// MintDenom returns the Noble denom minted by the transfer message.
+func (c *CCTPCrossChainPacket) MintDenom() string {
+	return c.mintDenom
+}
+
+var _ CrossChainPacket = (*HyperlaneCrossChainPacket)(nil)
+
+// HyperlaneCrossChainPacket represents a cross-chain transfer received via Hyperlane. It is
+// composed by the warp transfer message and by the message containing the orbiter payload,
+// which have to be received in the same transaction.
+type HyperlaneCrossChainPacket struct {
+	denom           string
+	transferMessage []byte
+	payloadMessage  []byte
+}
+
+// NewHyperlaneCrossChainPacket creates a new HyperlaneCrossChainPacket with the provided
+// messages and the Noble denom of the transferred warp token. The messages are defensively
+// copied to prevent external mutation after construction.
+func NewHyperlaneCrossChainPacket(
+	denom string,
+	transferMessage, payloadMessage []byte,
+) (*HyperlaneCrossChainPacket, error) {
+	if denom == "" {
+		return nil, fmt.Errorf("denom must not be empty")
+	}
+
+	if len(transferMessage) == 0 || len(payloadMessage) == 0 {
+		return nil, fmt.Errorf("transfer and payload messages must not be empty")
+	}
+
+	transferCopy := make([]byte, len(transferMessage))
+	copy(transferCopy, transferMessage)
+
+	payloadCopy := make([]byte, len(payloadMessage))
+	copy(payloadCopy, payloadMessage)
+
+	return &HyperlaneCrossChainPacket{
+		denom:           denom,
+		transferMessage: transferCopy,
+		payloadMessage:  payloadCopy,
+	}, nil
+}
+
+// Packet returns the raw bytes of the Hyperlane warp transfer message.
+func (h *HyperlaneCrossChainPacket) Packet() []byte {
+	return h.transferMessage
+}
+
+// PayloadMessage returns the raw bytes of the Hyperlane message containing the orbiter payload.
+func (h *HyperlaneCrossChainPacket) PayloadMessage() []byte {
+	return h.payloadMessage
+}
+
+// Denom returns the Noble denom of the transferred warp token.
+func (h *HyperlaneCrossChainPacket) Denom() string {
+	return h.denom
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package adapter
+
+import (
+	"strings"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// Validate returns an error if any of the params fields are not valid.
+func (p *Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.AllowedCoexistingKeys))
+	for _, key := range p.AllowedCoexistingKeys {
+		if strings.TrimSpace(key) == "" {
+			return core.ErrValidation.Wrap("allowed coexisting key cannot be empty")
+		}
+		if key == core.OrbiterPrefix {
+			return core.ErrValidation.Wrapf(
+				"allowed coexisting key cannot be the orbiter key %s",
+				core.OrbiterPrefix,
+			)
+		}
+		if _, found := seen[key]; found {
+			return core.ErrValidation.Wrapf("duplicate allowed coexisting key %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsCoexistingKeyAllowed returns true if the key can be present in the
+// packet metadata alongside the orbiter key.
+func (p *Params) IsCoexistingKeyAllowed(key string) bool {
+	for _, allowed := range p.AllowedCoexistingKeys {
+		if allowed == key {
+			return true
+		}
+	}
+
+	return false
+}
@@ -24,12 +24,23 @@ import (
 	"errors"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
 func (a *AmountDispatched) IsPositive() bool {
-	return a.Incoming.IsPositive() || a.Outgoing.IsPositive()
+	return a.Incoming.IsPositive() || a.Outgoing.IsPositive() || a.GetFees().IsPositive()
+}
+
+// GetFees returns the fees dispatched, defaulting to zero
+// when no fee has been paid.
+func (a *AmountDispatched) GetFees() math.Int {
+	if a.Fees == nil || a.Fees.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return *a.Fees
 }
 
 func (a DispatchedAmountEntry) Validate() error {
@@ -53,13 +64,14 @@ func (a DispatchedAmountEntry) Validate() error {
 		return errorsmod.Wrap(err, "invalid destination cross-chain ID")
 	}
 
-	if a.AmountDispatched.Incoming.IsNegative() || a.AmountDispatched.Outgoing.IsNegative() {
+	if a.AmountDispatched.Incoming.IsNegative() || a.AmountDispatched.Outgoing.IsNegative() ||
+		a.AmountDispatched.GetFees().IsNegative() {
 		return errors.New("cannot set negative amounts")
 	}
 
-	if !a.AmountDispatched.Incoming.IsPositive() && !a.AmountDispatched.Outgoing.IsPositive() {
+	if !a.AmountDispatched.IsPositive() {
 		return errors.New(
-			"cannot set incoming and outgoing amounts equal to zero",
+			"cannot set incoming, outgoing, and fees amounts equal to zero",
 		)
 	}
 
@@ -35,6 +35,10 @@ type AmountDispatched struct {
 	Incoming cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=incoming,proto3,customtype=cosmossdk.io/math.Int" json:"incoming"`
 	// outgoing represents the total outgoing amount dispatched.
 	Outgoing cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=outgoing,proto3,customtype=cosmossdk.io/math.Int" json:"outgoing"`
+	// fees represents the total amount paid to the bridge protocols
+	// to execute the forwardings, like the Hyperlane interchain gas.
+	// It is not set if no fee has been paid.
+	Fees *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees,omitempty"`
 }
 
 func (m *AmountDispatched) Reset()         { *m = AmountDispatched{} }
@@ -213,35 +217,36 @@ func init() {
 }
 
 var fileDescriptor_f4f0cd5655613ed7 = []byte{
-	// 443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0xcf, 0x8a, 0xd4, 0x30,
-	0x1c, 0x9e, 0x8c, 0xb3, 0xe2, 0x46, 0x94, 0xdd, 0xb2, 0x0b, 0xe3, 0x1e, 0xba, 0x32, 0x22, 0x88,
-	0xb0, 0x89, 0xb3, 0x82, 0x9e, 0x3c, 0x38, 0xb3, 0x22, 0x05, 0x2f, 0xce, 0x45, 0xf0, 0x32, 0x64,
-	0x9a, 0xd0, 0x46, 0x6d, 0x7e, 0x25, 0x49, 0x0b, 0xfb, 0x16, 0x3e, 0x86, 0x27, 0xf1, 0xa0, 0xaf,
-	0x20, 0x7b, 0x5c, 0x3c, 0x89, 0x87, 0x45, 0x66, 0x0e, 0xbe, 0x86, 0x24, 0xe9, 0xd4, 0xa1, 0x78,
-	0x50, 0x6f, 0x5e, 0x4a, 0xbf, 0x5f, 0xfa, 0xfd, 0xe9, 0x97, 0x04, 0x3f, 0x50, 0xb0, 0x78, 0x23,
-	0x28, 0xe8, 0x85, 0xb4, 0x42, 0xd3, 0x14, 0x8a, 0x12, 0x94, 0x50, 0x96, 0x72, 0x69, 0x4a, 0x66,
-	0xd3, 0x5c, 0x68, 0x5a, 0x8f, 0x37, 0x10, 0x29, 0x35, 0x58, 0x88, 0x6e, 0x7b, 0x1e, 0x69, 0x78,
-	0xa4, 0xe5, 0x91, 0x8d, 0x2f, 0xeb, 0xf1, 0xc1, 0x2e, 0x2b, 0xa4, 0x02, 0xea, 0x9f, 0x81, 0x79,
-	0x70, 0x23, 0x05, 0x53, 0x80, 0x99, 0x7b, 0x44, 0x03, 0x68, 0x96, 0xf6, 0x32, 0xc8, 0x20, 0xcc,
-	0xdd, 0x5b, 0x33, 0x8d, 0xbb, 0x11, 0xb5, 0x70, 0x91, 0x24, 0x0f, 0xeb, 0xa3, 0xf7, 0x08, 0xef,
-	0x3c, 0x2e, 0xa0, 0x52, 0xf6, 0x64, 0xed, 0xcd, 0xa3, 0x67, 0xf8, 0x8a, 0x54, 0x29, 0x14, 0x52,
-	0x65, 0x43, 0x74, 0x13, 0xdd, 0xd9, 0x9e, 0xdc, 0x3b, 0xbb, 0x38, 0xec, 0x7d, 0xbb, 0x38, 0xdc,
-	0x0f, 0x96, 0x86, 0xbf, 0x26, 0x12, 0x68, 0xc1, 0x6c, 0x4e, 0x12, 0x65, 0xbf, 0x7c, 0x3c, 0xc2,
-	0x4d, 0x96, 0x44, 0xd9, 0x77, 0x3f, 0x3e, 0xdc, 0x45, 0xb3, 0x56, 0xc1, 0xa9, 0x41, 0x65, 0x33,
-	0x70, 0x6a, 0xfd, 0x7f, 0x55, 0x5b, 0x2b, 0x8c, 0x3e, 0xf5, 0xf1, 0xfe, 0xaf, 0xa8, 0x21, 0xfa,
-	0x13, 0x65, 0xf5, 0x69, 0xf4, 0x14, 0x6f, 0x1b, 0xa8, 0x74, 0x2a, 0xe6, 0x92, 0xfb, 0xd8, 0x57,
-	0x8f, 0x6f, 0x91, 0x6e, 0xd3, 0x5a, 0x90, 0x7a, 0x4c, 0xa6, 0x1a, 0x8c, 0x99, 0xe6, 0x4c, 0xaa,
-	0xe4, 0x64, 0xb2, 0xd5, 0x58, 0x04, 0x72, 0xc2, 0xa3, 0xe7, 0xf8, 0x3a, 0x17, 0xc6, 0x4a, 0xc5,
-	0xac, 0x04, 0xe5, 0xd4, 0xfa, 0x7f, 0xad, 0x76, 0x6d, 0x43, 0x21, 0xe1, 0xd1, 0x1e, 0xde, 0xe2,
-	0x42, 0x41, 0x31, 0xbc, 0xe4, 0x0a, 0x98, 0x05, 0x10, 0xbd, 0xc2, 0xbb, 0xcc, 0xff, 0xc0, 0xbc,
-	0xdd, 0x78, 0x3e, 0x1c, 0x78, 0xaf, 0x87, 0xe4, 0x8f, 0xce, 0x08, 0xe9, 0xee, 0xdd, 0x64, 0xe0,
-	0xba, 0x9d, 0xed, 0xb0, 0xce, 0x7c, 0xf4, 0x19, 0xe1, 0x68, 0x0d, 0xa7, 0xff, 0x4d, 0x69, 0xa9,
-	0x4b, 0xea, 0x4b, 0x1b, 0xcc, 0x02, 0x98, 0xbc, 0x38, 0x5b, 0xc6, 0xe8, 0x7c, 0x19, 0xa3, 0xef,
-	0xcb, 0x18, 0xbd, 0x5d, 0xc5, 0xbd, 0xf3, 0x55, 0xdc, 0xfb, 0xba, 0x8a, 0x7b, 0x2f, 0x1f, 0x65,
-	0xd2, 0xe6, 0xd5, 0xc2, 0x75, 0x45, 0xbd, 0xe9, 0x11, 0x33, 0x46, 0x58, 0xd3, 0x9e, 0xfe, 0xfa,
-	0x98, 0xda, 0xd3, 0x52, 0x98, 0xdf, 0xde, 0xd4, 0xc5, 0x65, 0x7f, 0x23, 0xee, 0xff, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xac, 0x88, 0x2c, 0x5b, 0xd6, 0x03, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x53, 0x41, 0x8b, 0x13, 0x31,
+	0x14, 0x6e, 0x6a, 0x57, 0xdc, 0x88, 0xb2, 0x1b, 0x76, 0xa1, 0xee, 0x61, 0x2a, 0x15, 0x41, 0x94,
+	0x4d, 0xec, 0x0a, 0x7a, 0x12, 0xb1, 0x5d, 0x91, 0x01, 0x2f, 0xce, 0x45, 0xf0, 0x52, 0xd2, 0x49,
+	0x9c, 0x46, 0x9d, 0xbc, 0x92, 0xa4, 0x85, 0xfd, 0x17, 0xfe, 0x0c, 0x8f, 0x1e, 0xf4, 0x2f, 0x48,
+	0x8f, 0x8b, 0x27, 0xf1, 0x50, 0xa4, 0x3d, 0x78, 0xf4, 0x2f, 0x48, 0x92, 0x69, 0x2d, 0xc5, 0x43,
+	0xf5, 0xe6, 0x65, 0x98, 0xef, 0x25, 0xdf, 0xf7, 0xde, 0xf7, 0x5e, 0x1e, 0xbe, 0xaf, 0x61, 0xf0,
+	0x56, 0x32, 0x30, 0x03, 0xe5, 0xa4, 0x61, 0x39, 0x94, 0x23, 0xd0, 0x52, 0x3b, 0x26, 0x94, 0x1d,
+	0x71, 0x97, 0x0f, 0xa5, 0x61, 0x93, 0xce, 0x1a, 0xa2, 0x23, 0x03, 0x0e, 0xc8, 0xcd, 0xc0, 0xa3,
+	0x15, 0x8f, 0xae, 0x78, 0x74, 0xed, 0xe6, 0xa4, 0x73, 0xb4, 0xcf, 0x4b, 0xa5, 0x81, 0x85, 0x6f,
+	0x64, 0x1e, 0x5d, 0xcb, 0xc1, 0x96, 0x60, 0xfb, 0x01, 0xb1, 0x08, 0xaa, 0xa3, 0x83, 0x02, 0x0a,
+	0x88, 0x71, 0xff, 0x57, 0x45, 0x93, 0xcd, 0x12, 0x8d, 0xf4, 0x25, 0x29, 0x11, 0xcf, 0xdb, 0x3f,
+	0x11, 0xde, 0x7b, 0x5c, 0xc2, 0x58, 0xbb, 0xd3, 0x65, 0x6e, 0x41, 0x9e, 0xe1, 0x4b, 0x4a, 0xe7,
+	0x50, 0x2a, 0x5d, 0x34, 0xd1, 0x75, 0x74, 0x6b, 0xb7, 0x7b, 0x77, 0x3a, 0x6b, 0xd5, 0xbe, 0xcd,
+	0x5a, 0x87, 0x31, 0xa5, 0x15, 0x6f, 0xa8, 0x02, 0x56, 0x72, 0x37, 0xa4, 0xa9, 0x76, 0x5f, 0x3e,
+	0x1e, 0xe3, 0xaa, 0x96, 0x54, 0xbb, 0xf7, 0x3f, 0x3e, 0xdc, 0x46, 0xd9, 0x4a, 0xc1, 0xab, 0xc1,
+	0xd8, 0x15, 0xe0, 0xd5, 0xea, 0xff, 0xaa, 0xb6, 0x54, 0x20, 0x8f, 0x70, 0xe3, 0x95, 0x94, 0xb6,
+	0x79, 0x21, 0x28, 0xdd, 0x99, 0xce, 0x5a, 0x68, 0x4b, 0xa5, 0x2c, 0x10, 0xdb, 0x9f, 0xea, 0xf8,
+	0xf0, 0xb7, 0xd7, 0xe8, 0xfd, 0x89, 0x76, 0xe6, 0x8c, 0x3c, 0xc5, 0xbb, 0x16, 0xc6, 0x26, 0x97,
+	0x7d, 0x25, 0x82, 0xef, 0xcb, 0x27, 0x37, 0xe8, 0xe6, 0xa8, 0x8c, 0xa4, 0x93, 0x0e, 0xed, 0x19,
+	0xb0, 0xb6, 0x37, 0xe4, 0x4a, 0xa7, 0xa7, 0xdd, 0x9d, 0xaa, 0xc6, 0x48, 0x4e, 0x05, 0x79, 0x8e,
+	0xaf, 0x0a, 0x69, 0x9d, 0xd2, 0xdc, 0x29, 0xd0, 0x5e, 0xad, 0xfe, 0xd7, 0x6a, 0x57, 0xd6, 0x14,
+	0x52, 0x41, 0x0e, 0xf0, 0x8e, 0x90, 0x1a, 0xca, 0xe8, 0x3b, 0x8b, 0x80, 0xbc, 0xc6, 0xfb, 0x3c,
+	0x18, 0xe8, 0xaf, 0x5e, 0x8e, 0x68, 0x36, 0x42, 0xae, 0x07, 0x74, 0xab, 0x47, 0x46, 0x37, 0x87,
+	0xdf, 0x6d, 0xf8, 0xe1, 0x64, 0x7b, 0x7c, 0x23, 0xde, 0xfe, 0x8c, 0x30, 0x59, 0xc2, 0xde, 0x7f,
+	0xd3, 0xb4, 0xdc, 0x57, 0x1a, 0x9a, 0xd6, 0xc8, 0x22, 0xe8, 0xbe, 0x98, 0xce, 0x13, 0x74, 0x3e,
+	0x4f, 0xd0, 0xf7, 0x79, 0x82, 0xde, 0x2d, 0x92, 0xda, 0xf9, 0x22, 0xa9, 0x7d, 0x5d, 0x24, 0xb5,
+	0x97, 0x0f, 0x0b, 0xe5, 0x86, 0xe3, 0x81, 0xef, 0x15, 0x0b, 0x49, 0x8f, 0xb9, 0xb5, 0xd2, 0xd9,
+	0xd5, 0xfa, 0x4c, 0x4e, 0x98, 0x3b, 0x1b, 0x49, 0xfb, 0xc7, 0x55, 0x1f, 0x5c, 0x0c, 0x2b, 0x75,
+	0xef, 0xd7, 0x00, 0x81, 0x44, 0xbc, 0x89, 0x17, 0x04, 0x00, 0x00,
 }
 
 func (m *AmountDispatched) Marshal() (dAtA []byte, err error) {
@@ -264,6 +269,18 @@ func (m *AmountDispatched) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Fees != nil {
+		{
+			size := m.Fees.Size()
+			i -= size
+			if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDispatcher(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.Outgoing.Size()
 		i -= size
@@ -424,6 +441,10 @@ func (m *AmountDispatched) Size() (n int) {
 	n += 1 + l + sovDispatcher(uint64(l))
 	l = m.Outgoing.Size()
 	n += 1 + l + sovDispatcher(uint64(l))
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovDispatcher(uint64(l))
+	}
 	return n
 }
 
@@ -573,6 +594,42 @@ func (m *AmountDispatched) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispatcher
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispatcher
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Fees = &v
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDispatcher(dAtA[iNdEx:])
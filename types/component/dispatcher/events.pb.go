@@ -0,0 +1,766 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/component/dispatcher/v1/events.proto
+
+package dispatcher
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	core "github.com/noble-assets/orbiter/v2/types/core"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ForwardingFailure represents a forwarding of a payload
+// whose dispatch failed.
+type ForwardingFailure struct {
+	// index is the position of the forwarding in the dispatch order, where 0
+	// is the payload forwarding and i is the i-th fallback forwarding.
+	Index          uint32          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ProtocolId     core.ProtocolID `protobuf:"varint,2,opt,name=protocol_id,json=protocolId,proto3,enum=noble.orbiter.core.v1.ProtocolID" json:"protocol_id,omitempty"`
+	CounterpartyId string          `protobuf:"bytes,3,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// reason is the error returned by the forwarding dispatch.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ForwardingFailure) Reset()         { *m = ForwardingFailure{} }
+func (m *ForwardingFailure) String() string { return proto.CompactTextString(m) }
+func (*ForwardingFailure) ProtoMessage()    {}
+func (*ForwardingFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d78fc22d99840a2, []int{0}
+}
+func (m *ForwardingFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardingFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardingFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardingFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingFailure.Merge(m, src)
+}
+func (m *ForwardingFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardingFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingFailure proto.InternalMessageInfo
+
+func (m *ForwardingFailure) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ForwardingFailure) GetProtocolId() core.ProtocolID {
+	if m != nil {
+		return m.ProtocolId
+	}
+	return core.PROTOCOL_UNSUPPORTED
+}
+
+func (m *ForwardingFailure) GetCounterpartyId() string {
+	if m != nil {
+		return m.CounterpartyId
+	}
+	return ""
+}
+
+func (m *ForwardingFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventFallbackForwardingUsed is emitted when the payload forwarding fails
+// and one of the fallback forwardings is dispatched instead.
+type EventFallbackForwardingUsed struct {
+	// index is the position of the forwarding used in the dispatch order,
+	// where i is the i-th fallback forwarding.
+	Index          uint32          `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	ProtocolId     core.ProtocolID `protobuf:"varint,2,opt,name=protocol_id,json=protocolId,proto3,enum=noble.orbiter.core.v1.ProtocolID" json:"protocol_id,omitempty"`
+	CounterpartyId string          `protobuf:"bytes,3,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// failures are the forwardings tried before the one used.
+	Failures []ForwardingFailure `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures"`
+}
+
+func (m *EventFallbackForwardingUsed) Reset()         { *m = EventFallbackForwardingUsed{} }
+func (m *EventFallbackForwardingUsed) String() string { return proto.CompactTextString(m) }
+func (*EventFallbackForwardingUsed) ProtoMessage()    {}
+func (*EventFallbackForwardingUsed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d78fc22d99840a2, []int{1}
+}
+func (m *EventFallbackForwardingUsed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFallbackForwardingUsed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFallbackForwardingUsed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFallbackForwardingUsed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFallbackForwardingUsed.Merge(m, src)
+}
+func (m *EventFallbackForwardingUsed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFallbackForwardingUsed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFallbackForwardingUsed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFallbackForwardingUsed proto.InternalMessageInfo
+
+func (m *EventFallbackForwardingUsed) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *EventFallbackForwardingUsed) GetProtocolId() core.ProtocolID {
+	if m != nil {
+		return m.ProtocolId
+	}
+	return core.PROTOCOL_UNSUPPORTED
+}
+
+func (m *EventFallbackForwardingUsed) GetCounterpartyId() string {
+	if m != nil {
+		return m.CounterpartyId
+	}
+	return ""
+}
+
+func (m *EventFallbackForwardingUsed) GetFailures() []ForwardingFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ForwardingFailure)(nil), "noble.orbiter.component.dispatcher.v1.ForwardingFailure")
+	proto.RegisterType((*EventFallbackForwardingUsed)(nil), "noble.orbiter.component.dispatcher.v1.EventFallbackForwardingUsed")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/component/dispatcher/v1/events.proto", fileDescriptor_2d78fc22d99840a2)
+}
+
+var fileDescriptor_2d78fc22d99840a2 = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0x6d, 0x2d, 0x76, 0x8a, 0x95, 0x86, 0x22, 0xa1, 0x42, 0x8c, 0x05, 0x31, 0x08,
+	0xce, 0xd0, 0x78, 0xf1, 0xe2, 0xa5, 0x68, 0xa1, 0x37, 0x09, 0x88, 0xe0, 0xa5, 0x4c, 0x32, 0x63,
+	0x3a, 0x98, 0xce, 0x84, 0x99, 0x69, 0xb4, 0x47, 0xff, 0x03, 0xff, 0x0c, 0x4f, 0xe2, 0x9f, 0xd1,
+	0x63, 0x8f, 0x7b, 0x5a, 0x96, 0xf6, 0xb0, 0xff, 0xc6, 0x92, 0x1f, 0xdb, 0x2e, 0xdd, 0x3d, 0xec,
+	0x71, 0x2f, 0xe1, 0xfd, 0xc8, 0xe7, 0xbd, 0xef, 0xbc, 0xf7, 0x60, 0x20, 0x64, 0x94, 0x32, 0x2c,
+	0x55, 0xc4, 0x0d, 0x53, 0x38, 0x96, 0xcb, 0x4c, 0x0a, 0x26, 0x0c, 0xa6, 0x5c, 0x67, 0xc4, 0xc4,
+	0x0b, 0xa6, 0x70, 0x3e, 0xc6, 0x2c, 0x67, 0xc2, 0x68, 0x94, 0x29, 0x69, 0xa4, 0xfd, 0xaa, 0x64,
+	0x50, 0xcd, 0xa0, 0x03, 0x83, 0x8e, 0x0c, 0xca, 0xc7, 0xc3, 0x3e, 0x59, 0x72, 0x21, 0x71, 0xf9,
+	0xad, 0xc8, 0xe1, 0x20, 0x91, 0x89, 0x2c, 0x4d, 0x5c, 0x58, 0x75, 0xd4, 0x3d, 0xd5, 0xa0, 0x58,
+	0xd1, 0x93, 0xd3, 0x2a, 0x3f, 0xfa, 0x07, 0x60, 0x7f, 0x2a, 0xd5, 0x4f, 0xa2, 0x28, 0x17, 0xc9,
+	0x94, 0xf0, 0x74, 0xa5, 0x98, 0x3d, 0x80, 0x8f, 0xb8, 0xa0, 0xec, 0x97, 0x03, 0x3c, 0xe0, 0x3f,
+	0x09, 0x2b, 0xc7, 0x9e, 0xc0, 0x6e, 0x09, 0xc5, 0x32, 0x9d, 0x73, 0xea, 0x34, 0x3c, 0xe0, 0xf7,
+	0x82, 0x97, 0xe8, 0x54, 0xb1, 0x62, 0x28, 0x1f, 0xa3, 0xcf, 0xf5, 0x9f, 0xb3, 0x8f, 0x21, 0xbc,
+	0xa6, 0x66, 0xd4, 0x7e, 0x0d, 0x9f, 0xc6, 0x72, 0x25, 0x0c, 0x53, 0x19, 0x51, 0x66, 0x5d, 0xd4,
+	0x69, 0x7a, 0xc0, 0xef, 0x84, 0xbd, 0x9b, 0xe1, 0x19, 0xb5, 0x9f, 0xc1, 0xb6, 0x62, 0x44, 0x4b,
+	0xe1, 0xb4, 0xca, 0x7c, 0xed, 0x8d, 0x7e, 0x37, 0xe0, 0xf3, 0x4f, 0xc5, 0xc4, 0xa6, 0x24, 0x4d,
+	0x23, 0x12, 0xff, 0x38, 0xaa, 0xff, 0xa2, 0x19, 0x7d, 0x08, 0xd2, 0xe7, 0xf0, 0xf1, 0xf7, 0x6a,
+	0x90, 0xda, 0x69, 0x79, 0x4d, 0xbf, 0x1b, 0xbc, 0x47, 0xf7, 0x5a, 0x2b, 0xba, 0xb5, 0x89, 0x49,
+	0x67, 0x73, 0xfe, 0xc2, 0xfa, 0x7b, 0xf9, 0xff, 0x0d, 0x08, 0x0f, 0x45, 0x27, 0x5f, 0x37, 0x3b,
+	0x17, 0x6c, 0x77, 0x2e, 0xb8, 0xd8, 0xb9, 0xe0, 0xcf, 0xde, 0xb5, 0xb6, 0x7b, 0xd7, 0x3a, 0xdb,
+	0xbb, 0xd6, 0xb7, 0x0f, 0x09, 0x37, 0x8b, 0x55, 0x54, 0x34, 0xc0, 0x65, 0xcb, 0xb7, 0x44, 0x6b,
+	0x66, 0xf4, 0xe1, 0x00, 0xf2, 0x00, 0x9b, 0x75, 0xc6, 0xf4, 0x9d, 0xd7, 0x18, 0xb5, 0xcb, 0xe7,
+	0xbe, 0xbb, 0x1a, 0x00, 0xc9, 0xe7, 0xea, 0x08, 0xba, 0x02, 0x00, 0x00,
+}
+
+func (m *ForwardingFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardingFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardingFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CounterpartyId) > 0 {
+		i -= len(m.CounterpartyId)
+		copy(dAtA[i:], m.CounterpartyId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CounterpartyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProtocolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProtocolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFallbackForwardingUsed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFallbackForwardingUsed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFallbackForwardingUsed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CounterpartyId) > 0 {
+		i -= len(m.CounterpartyId)
+		copy(dAtA[i:], m.CounterpartyId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CounterpartyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProtocolId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProtocolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForwardingFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovEvents(uint64(m.Index))
+	}
+	if m.ProtocolId != 0 {
+		n += 1 + sovEvents(uint64(m.ProtocolId))
+	}
+	l = len(m.CounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFallbackForwardingUsed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovEvents(uint64(m.Index))
+	}
+	if m.ProtocolId != 0 {
+		n += 1 + sovEvents(uint64(m.ProtocolId))
+	}
+	l = len(m.CounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForwardingFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardingFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardingFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			m.ProtocolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolId |= core.ProtocolID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFallbackForwardingUsed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFallbackForwardingUsed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFallbackForwardingUsed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			m.ProtocolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolId |= core.ProtocolID(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, ForwardingFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -30,12 +30,20 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPauseAction{}, "orbiter/executor/PauseAction", nil)
 	cdc.RegisterConcrete(&MsgUnpauseAction{}, "orbiter/executor/UnpauseAction", nil)
+	cdc.RegisterConcrete(&MsgSetRouteFee{}, "orbiter/executor/SetRouteFee", nil)
+	cdc.RegisterConcrete(&MsgRemoveRouteFee{}, "orbiter/executor/RemoveRouteFee", nil)
+	cdc.RegisterConcrete(&MsgSetIntegrator{}, "orbiter/executor/SetIntegrator", nil)
+	cdc.RegisterConcrete(&MsgRemoveIntegrator{}, "orbiter/executor/RemoveIntegrator", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPauseAction{},
 		&MsgUnpauseAction{},
+		&MsgSetRouteFee{},
+		&MsgRemoveRouteFee{},
+		&MsgSetIntegrator{},
+		&MsgRemoveIntegrator{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -5,7 +5,9 @@ package executor
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	action "github.com/noble-assets/orbiter/v2/types/controller/action"
 	core "github.com/noble-assets/orbiter/v2/types/core"
 	io "io"
 	math "math"
@@ -111,9 +113,209 @@ func (m *EventUnpaused) GetActionId() core.ActionID {
 	return core.ACTION_UNSUPPORTED
 }
 
+// EventRouteFeeSet is emitted when the fee of a route is set.
+type EventRouteFeeSet struct {
+	RouteFee RouteFee `protobuf:"bytes,1,opt,name=route_fee,json=routeFee,proto3" json:"route_fee"`
+}
+
+func (m *EventRouteFeeSet) Reset()         { *m = EventRouteFeeSet{} }
+func (m *EventRouteFeeSet) String() string { return proto.CompactTextString(m) }
+func (*EventRouteFeeSet) ProtoMessage()    {}
+func (*EventRouteFeeSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ccdeaf0e1b3f5b8, []int{2}
+}
+func (m *EventRouteFeeSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRouteFeeSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRouteFeeSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRouteFeeSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRouteFeeSet.Merge(m, src)
+}
+func (m *EventRouteFeeSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRouteFeeSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRouteFeeSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRouteFeeSet proto.InternalMessageInfo
+
+func (m *EventRouteFeeSet) GetRouteFee() RouteFee {
+	if m != nil {
+		return m.RouteFee
+	}
+	return RouteFee{}
+}
+
+// EventRouteFeeRemoved is emitted when the fee of a route is removed.
+type EventRouteFeeRemoved struct {
+	SourceId      core.CrossChainID `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id"`
+	DestinationId core.CrossChainID `protobuf:"bytes,2,opt,name=destination_id,json=destinationId,proto3" json:"destination_id"`
+	Denom         string            `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *EventRouteFeeRemoved) Reset()         { *m = EventRouteFeeRemoved{} }
+func (m *EventRouteFeeRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventRouteFeeRemoved) ProtoMessage()    {}
+func (*EventRouteFeeRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ccdeaf0e1b3f5b8, []int{3}
+}
+func (m *EventRouteFeeRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRouteFeeRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRouteFeeRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRouteFeeRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRouteFeeRemoved.Merge(m, src)
+}
+func (m *EventRouteFeeRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRouteFeeRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRouteFeeRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRouteFeeRemoved proto.InternalMessageInfo
+
+func (m *EventRouteFeeRemoved) GetSourceId() core.CrossChainID {
+	if m != nil {
+		return m.SourceId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *EventRouteFeeRemoved) GetDestinationId() core.CrossChainID {
+	if m != nil {
+		return m.DestinationId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *EventRouteFeeRemoved) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// EventIntegratorSet is emitted when an integrator is registered or updated.
+type EventIntegratorSet struct {
+	Integrator action.Integrator `protobuf:"bytes,1,opt,name=integrator,proto3" json:"integrator"`
+}
+
+func (m *EventIntegratorSet) Reset()         { *m = EventIntegratorSet{} }
+func (m *EventIntegratorSet) String() string { return proto.CompactTextString(m) }
+func (*EventIntegratorSet) ProtoMessage()    {}
+func (*EventIntegratorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ccdeaf0e1b3f5b8, []int{4}
+}
+func (m *EventIntegratorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIntegratorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIntegratorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventIntegratorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIntegratorSet.Merge(m, src)
+}
+func (m *EventIntegratorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIntegratorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIntegratorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIntegratorSet proto.InternalMessageInfo
+
+func (m *EventIntegratorSet) GetIntegrator() action.Integrator {
+	if m != nil {
+		return m.Integrator
+	}
+	return action.Integrator{}
+}
+
+// EventIntegratorRemoved is emitted when an integrator is removed.
+type EventIntegratorRemoved struct {
+	IntegratorId string `protobuf:"bytes,1,opt,name=integrator_id,json=integratorId,proto3" json:"integrator_id,omitempty"`
+}
+
+func (m *EventIntegratorRemoved) Reset()         { *m = EventIntegratorRemoved{} }
+func (m *EventIntegratorRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventIntegratorRemoved) ProtoMessage()    {}
+func (*EventIntegratorRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ccdeaf0e1b3f5b8, []int{5}
+}
+func (m *EventIntegratorRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIntegratorRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIntegratorRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventIntegratorRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIntegratorRemoved.Merge(m, src)
+}
+func (m *EventIntegratorRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIntegratorRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIntegratorRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIntegratorRemoved proto.InternalMessageInfo
+
+func (m *EventIntegratorRemoved) GetIntegratorId() string {
+	if m != nil {
+		return m.IntegratorId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventPaused)(nil), "noble.orbiter.component.executor.v1.EventPaused")
 	proto.RegisterType((*EventUnpaused)(nil), "noble.orbiter.component.executor.v1.EventUnpaused")
+	proto.RegisterType((*EventRouteFeeSet)(nil), "noble.orbiter.component.executor.v1.EventRouteFeeSet")
+	proto.RegisterType((*EventRouteFeeRemoved)(nil), "noble.orbiter.component.executor.v1.EventRouteFeeRemoved")
+	proto.RegisterType((*EventIntegratorSet)(nil), "noble.orbiter.component.executor.v1.EventIntegratorSet")
+	proto.RegisterType((*EventIntegratorRemoved)(nil), "noble.orbiter.component.executor.v1.EventIntegratorRemoved")
 }
 
 func init() {
@@ -121,22 +323,36 @@ func init() {
 }
 
 var fileDescriptor_5ccdeaf0e1b3f5b8 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc8, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0xcf, 0x2f, 0x4a, 0xca, 0x2c, 0x49, 0x2d, 0xd2, 0x4f, 0xce, 0xcf, 0x2d, 0xc8, 0xcf,
-	0x4b, 0xcd, 0x2b, 0xd1, 0x4f, 0xad, 0x48, 0x4d, 0x2e, 0x2d, 0xc9, 0x2f, 0xd2, 0x2f, 0x33, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x06, 0xeb,
-	0xd0, 0x83, 0xea, 0xd0, 0x83, 0xeb, 0xd0, 0x83, 0xe9, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0x43, 0x37,
-	0xb6, 0x28, 0x15, 0x64, 0x50, 0x66, 0x0a, 0xc4, 0x10, 0x25, 0x6f, 0x2e, 0x6e, 0x57, 0x90, 0xa1,
-	0x01, 0x89, 0xa5, 0xc5, 0xa9, 0x29, 0x42, 0x36, 0x5c, 0x9c, 0x89, 0xc9, 0x25, 0x99, 0xf9, 0x79,
-	0xf1, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0xf2, 0x7a, 0xe8, 0xf6, 0x14, 0xa5,
-	0xea, 0x95, 0x19, 0xea, 0x39, 0x82, 0xd5, 0x79, 0xba, 0x04, 0x71, 0x40, 0x74, 0x78, 0xa6, 0x28,
-	0xf9, 0x72, 0xf1, 0x82, 0x0d, 0x0b, 0xcd, 0x2b, 0xa0, 0x82, 0x71, 0x4e, 0xa1, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9d, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0x04, 0xf2,
-	0xb3, 0x3e, 0xd8, 0x38, 0xdd, 0xc4, 0xe2, 0xe2, 0xd4, 0x92, 0x62, 0xb8, 0x3f, 0xcb, 0x8c, 0xf4,
-	0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0xb1, 0x84, 0x63, 0x12, 0x1b, 0xd8, 0xe7, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa3, 0x7e, 0x94, 0xec, 0x72, 0x01, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0xc0, 0x1b, 0xfe, 0x69, 0x71, 0xe9, 0x84, 0xa2, 0x0a, 0x4d, 0x3b, 0x64, 0x53, 0x7a, 0xd9,
+	0x65, 0x36, 0xcd, 0x8e, 0xc0, 0x81, 0x6d, 0x4c, 0x8a, 0x10, 0x52, 0x15, 0xd8, 0x85, 0xcb, 0x94,
+	0xd6, 0x8f, 0x2e, 0xa8, 0xf5, 0xab, 0x6c, 0xc7, 0x82, 0x6f, 0xc1, 0xa7, 0x42, 0x3b, 0xee, 0xc8,
+	0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0xd8, 0x75, 0x33, 0x02, 0x48, 0x43, 0xda, 0x2d, 0x4e, 0xde, 0xef,
+	0xf7, 0xfe, 0xf8, 0x85, 0x3c, 0x13, 0x38, 0x9e, 0x01, 0x43, 0x39, 0x2e, 0x35, 0x48, 0x36, 0xc1,
+	0xf9, 0x02, 0x05, 0x08, 0xcd, 0xe0, 0x33, 0x4c, 0x2a, 0x8d, 0x92, 0x99, 0x21, 0x03, 0x03, 0x42,
+	0x2b, 0xba, 0x90, 0xa8, 0x31, 0x1a, 0x58, 0x82, 0xae, 0x09, 0xba, 0x21, 0xa8, 0x27, 0xa8, 0x19,
+	0xee, 0xf6, 0xa7, 0x38, 0x45, 0x1b, 0xcf, 0xea, 0x27, 0x87, 0xee, 0xa6, 0xb7, 0x4a, 0xe6, 0x35,
+	0x8e, 0x39, 0x6a, 0x33, 0x42, 0x4b, 0x9c, 0xcd, 0x40, 0xb2, 0x62, 0xa2, 0x4b, 0x14, 0xcc, 0xa4,
+	0xac, 0x14, 0x1a, 0xa6, 0xb2, 0x68, 0xa0, 0xb8, 0x0d, 0x49, 0xa8, 0xd5, 0x25, 0x77, 0xdf, 0x93,
+	0x37, 0xa4, 0xfb, 0xba, 0xee, 0x69, 0x54, 0x54, 0x0a, 0x78, 0xf4, 0x82, 0x84, 0x4e, 0x76, 0x51,
+	0xf2, 0x9d, 0x60, 0x3f, 0x38, 0xd8, 0x4e, 0xf7, 0x68, 0xbb, 0x4d, 0x09, 0xd4, 0x0c, 0xe9, 0x2b,
+	0x1b, 0x97, 0x9d, 0xe6, 0x5b, 0x8e, 0xc8, 0x78, 0xf2, 0x96, 0xf4, 0xac, 0xec, 0x5c, 0x2c, 0xee,
+	0x42, 0xc7, 0xc9, 0x13, 0xab, 0xcb, 0xb1, 0xd2, 0x70, 0x06, 0xf0, 0x0e, 0x74, 0x34, 0x22, 0xa1,
+	0xac, 0x8f, 0x17, 0x1f, 0x01, 0xac, 0xb1, 0x9b, 0x1e, 0xd2, 0x5b, 0xdc, 0x03, 0xf5, 0x92, 0xe3,
+	0x07, 0x57, 0x3f, 0xf6, 0x3a, 0xf9, 0x96, 0x5c, 0x9f, 0x93, 0x6f, 0x01, 0xe9, 0xff, 0x96, 0x26,
+	0x87, 0x39, 0x1a, 0xe0, 0xd1, 0x19, 0x09, 0x15, 0x56, 0x72, 0x02, 0xbe, 0xf8, 0x6e, 0x3a, 0xf8,
+	0x47, 0xf1, 0x27, 0x12, 0x95, 0x3a, 0xb9, 0x2c, 0x4a, 0x91, 0x9d, 0xfa, 0x04, 0x8e, 0xcd, 0x78,
+	0x34, 0x22, 0xdb, 0x1c, 0x94, 0x2e, 0x45, 0xe1, 0x27, 0x71, 0xef, 0x7f, 0x65, 0xbd, 0x1b, 0x82,
+	0x8c, 0x47, 0x7d, 0xf2, 0x90, 0x83, 0xc0, 0xf9, 0xce, 0xfd, 0xfd, 0xe0, 0x20, 0xcc, 0xdd, 0x21,
+	0xf9, 0x44, 0x22, 0xdb, 0x47, 0xb6, 0xd9, 0x81, 0x7a, 0x60, 0xef, 0x09, 0x69, 0x96, 0x62, 0xdd,
+	0x06, 0xfd, 0x23, 0xb3, 0x5f, 0x25, 0xea, 0x86, 0x4f, 0x4d, 0x4a, 0x1b, 0xcd, 0xba, 0x88, 0x1b,
+	0x9e, 0xe4, 0x25, 0x79, 0xda, 0xca, 0xe5, 0xa7, 0x36, 0x20, 0xbd, 0x26, 0xce, 0x4f, 0x2e, 0xcc,
+	0x1f, 0x37, 0x2f, 0x33, 0x7e, 0x7c, 0x7e, 0xb5, 0x8c, 0x83, 0xeb, 0x65, 0x1c, 0xfc, 0x5c, 0xc6,
+	0xc1, 0xd7, 0x55, 0xdc, 0xb9, 0x5e, 0xc5, 0x9d, 0xef, 0xab, 0xb8, 0xf3, 0xe1, 0xf9, 0xb4, 0xd4,
+	0x97, 0xd5, 0xb8, 0xbe, 0x44, 0x66, 0x8b, 0x3c, 0x2c, 0x94, 0x02, 0xad, 0x36, 0x1b, 0x6c, 0x52,
+	0xa6, 0xbf, 0x2c, 0x40, 0xfd, 0xe5, 0x9f, 0x19, 0x3f, 0xb2, 0x3b, 0x7d, 0xf4, 0x6b, 0x00, 0x99,
+	0x13, 0x6c, 0xeb, 0xcb, 0x03, 0x00, 0x00,
 }
 
 func (m *EventPaused) Marshal() (dAtA []byte, err error) {
@@ -195,6 +411,152 @@ func (m *EventUnpaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRouteFeeSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRouteFeeSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRouteFeeSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RouteFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRouteFeeRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRouteFeeRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRouteFeeRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.DestinationId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SourceId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIntegratorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIntegratorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIntegratorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Integrator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIntegratorRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIntegratorRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIntegratorRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntegratorId) > 0 {
+		i -= len(m.IntegratorId)
+		copy(dAtA[i:], m.IntegratorId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.IntegratorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -230,6 +592,58 @@ func (m *EventUnpaused) Size() (n int) {
 	return n
 }
 
+func (m *EventRouteFeeSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RouteFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventRouteFeeRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SourceId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.DestinationId.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventIntegratorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Integrator.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventIntegratorRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntegratorId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -374,6 +788,402 @@ func (m *EventUnpaused) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRouteFeeSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRouteFeeSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRouteFeeSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouteFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRouteFeeRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRouteFeeRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRouteFeeRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DestinationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIntegratorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIntegratorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIntegratorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Integrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIntegratorRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIntegratorRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIntegratorRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegratorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package executor
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// NewRouteFee returns a validated route fee.
+func NewRouteFee(
+	sourceID core.CrossChainID,
+	destinationID core.CrossChainID,
+	denom string,
+	fee actiontypes.RouteFeeAttributes,
+) (RouteFee, error) {
+	f := RouteFee{
+		SourceId:      sourceID,
+		DestinationId: destinationID,
+		Denom:         denom,
+		Fee:           fee,
+	}
+
+	return f, f.Validate()
+}
+
+// Validate returns an error if any of the route fee fields is not valid.
+func (f *RouteFee) Validate() error {
+	if f == nil {
+		return core.ErrNilPointer.Wrap("route fee")
+	}
+
+	if err := validateRoute(f.SourceId, f.DestinationId, f.Denom); err != nil {
+		return err
+	}
+	if err := f.Fee.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid fee")
+	}
+
+	return nil
+}
+
+// NewRouteFeeCollected returns a validated route fee collected.
+func NewRouteFeeCollected(
+	sourceID core.CrossChainID,
+	destinationID core.CrossChainID,
+	denom string,
+	amount math.Int,
+) (RouteFeeCollected, error) {
+	c := RouteFeeCollected{
+		SourceId:      sourceID,
+		DestinationId: destinationID,
+		Denom:         denom,
+		Amount:        amount,
+	}
+
+	return c, c.Validate()
+}
+
+// Validate returns an error if any of the route fee collected fields is not valid.
+func (c *RouteFeeCollected) Validate() error {
+	if c == nil {
+		return core.ErrNilPointer.Wrap("route fee collected")
+	}
+
+	if err := validateRoute(c.SourceId, c.DestinationId, c.Denom); err != nil {
+		return err
+	}
+	if c.Amount.IsNil() || !c.Amount.IsPositive() {
+		return errors.New("collected amount must be positive")
+	}
+
+	return nil
+}
+
+// NewIntegratorStats returns validated integrator stats.
+func NewIntegratorStats(
+	integratorID string,
+	denom string,
+	volume math.Int,
+	fees math.Int,
+	transfers uint64,
+) (IntegratorStats, error) {
+	s := IntegratorStats{
+		IntegratorId: integratorID,
+		Denom:        denom,
+		Volume:       volume,
+		Fees:         fees,
+		Transfers:    transfers,
+	}
+
+	return s, s.Validate()
+}
+
+// Validate returns an error if any of the integrator stats fields is not valid.
+func (s *IntegratorStats) Validate() error {
+	if s == nil {
+		return core.ErrNilPointer.Wrap("integrator stats")
+	}
+
+	if err := actiontypes.ValidateIntegratorID(s.IntegratorId); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return errorsmod.Wrap(err, "invalid denom")
+	}
+	if s.Volume.IsNil() || s.Volume.IsNegative() {
+		return errors.New("volume must be non-negative")
+	}
+	if s.Fees.IsNil() || s.Fees.IsNegative() {
+		return errors.New("fees must be non-negative")
+	}
+	if s.Transfers == 0 {
+		return errors.New("transfers must be positive")
+	}
+
+	return nil
+}
+
+func validateRoute(sourceID, destinationID core.CrossChainID, denom string) error {
+	if err := sourceID.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid source ID")
+	}
+	if err := destinationID.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid destination ID")
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(err, "invalid denom")
+	}
+
+	return nil
+}
@@ -0,0 +1,1194 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/component/executor/v1/executor.proto
+
+package executor
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	action "github.com/noble-assets/orbiter/v2/types/controller/action"
+	core "github.com/noble-assets/orbiter/v2/types/core"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RouteFee represents the fee defined by the authority for the transfers
+// of a denom from a source cross-chain ID to a destination cross-chain ID.
+type RouteFee struct {
+	// source_id is the cross-chain ID of the source of the transfer.
+	SourceId core.CrossChainID `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id"`
+	// destination_id is the cross-chain ID of the destination of the transfer.
+	DestinationId core.CrossChainID `protobuf:"bytes,2,opt,name=destination_id,json=destinationId,proto3" json:"destination_id"`
+	// denom is the Noble denom received from the source.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// fee is the fee applied to the transfers of the route.
+	Fee action.RouteFeeAttributes `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *RouteFee) Reset()         { *m = RouteFee{} }
+func (m *RouteFee) String() string { return proto.CompactTextString(m) }
+func (*RouteFee) ProtoMessage()    {}
+func (*RouteFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a16eb5f9419ea50e, []int{0}
+}
+func (m *RouteFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteFee.Merge(m, src)
+}
+func (m *RouteFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteFee proto.InternalMessageInfo
+
+func (m *RouteFee) GetSourceId() core.CrossChainID {
+	if m != nil {
+		return m.SourceId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *RouteFee) GetDestinationId() core.CrossChainID {
+	if m != nil {
+		return m.DestinationId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *RouteFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RouteFee) GetFee() action.RouteFeeAttributes {
+	if m != nil {
+		return m.Fee
+	}
+	return action.RouteFeeAttributes{}
+}
+
+// RouteFeeCollected represents the total fee collected for a route.
+type RouteFeeCollected struct {
+	// source_id is the cross-chain ID of the source of the transfer.
+	SourceId core.CrossChainID `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id"`
+	// destination_id is the cross-chain ID of the destination of the transfer.
+	DestinationId core.CrossChainID `protobuf:"bytes,2,opt,name=destination_id,json=destinationId,proto3" json:"destination_id"`
+	// denom is the Noble denom received from the source.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the total fee collected.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *RouteFeeCollected) Reset()         { *m = RouteFeeCollected{} }
+func (m *RouteFeeCollected) String() string { return proto.CompactTextString(m) }
+func (*RouteFeeCollected) ProtoMessage()    {}
+func (*RouteFeeCollected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a16eb5f9419ea50e, []int{1}
+}
+func (m *RouteFeeCollected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteFeeCollected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteFeeCollected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteFeeCollected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteFeeCollected.Merge(m, src)
+}
+func (m *RouteFeeCollected) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteFeeCollected) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteFeeCollected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteFeeCollected proto.InternalMessageInfo
+
+func (m *RouteFeeCollected) GetSourceId() core.CrossChainID {
+	if m != nil {
+		return m.SourceId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *RouteFeeCollected) GetDestinationId() core.CrossChainID {
+	if m != nil {
+		return m.DestinationId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *RouteFeeCollected) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// IntegratorStats represents the volume transferred with the fee of an
+// integrator for a denom.
+type IntegratorStats struct {
+	// integrator_id is the identifier of the integrator.
+	IntegratorId string `protobuf:"bytes,1,opt,name=integrator_id,json=integratorId,proto3" json:"integrator_id,omitempty"`
+	// denom is the Noble denom transferred.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// volume is the total amount transferred, before the fee.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// fees is the total fee paid, including the treasury share.
+	Fees cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+	// transfers is the number of transfers.
+	Transfers uint64 `protobuf:"varint,5,opt,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (m *IntegratorStats) Reset()         { *m = IntegratorStats{} }
+func (m *IntegratorStats) String() string { return proto.CompactTextString(m) }
+func (*IntegratorStats) ProtoMessage()    {}
+func (*IntegratorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a16eb5f9419ea50e, []int{2}
+}
+func (m *IntegratorStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntegratorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntegratorStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntegratorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegratorStats.Merge(m, src)
+}
+func (m *IntegratorStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntegratorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegratorStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegratorStats proto.InternalMessageInfo
+
+func (m *IntegratorStats) GetIntegratorId() string {
+	if m != nil {
+		return m.IntegratorId
+	}
+	return ""
+}
+
+func (m *IntegratorStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *IntegratorStats) GetTransfers() uint64 {
+	if m != nil {
+		return m.Transfers
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RouteFee)(nil), "noble.orbiter.component.executor.v1.RouteFee")
+	proto.RegisterType((*RouteFeeCollected)(nil), "noble.orbiter.component.executor.v1.RouteFeeCollected")
+	proto.RegisterType((*IntegratorStats)(nil), "noble.orbiter.component.executor.v1.IntegratorStats")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/component/executor/v1/executor.proto", fileDescriptor_a16eb5f9419ea50e)
+}
+
+var fileDescriptor_a16eb5f9419ea50e = []byte{
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x94, 0xbf, 0x6e, 0x13, 0x4f,
+	0x10, 0xc7, 0x7d, 0xb6, 0x13, 0xe5, 0xf6, 0xf7, 0x0b, 0x28, 0xa7, 0x20, 0x99, 0x08, 0x5d, 0x2c,
+	0xbb, 0xb1, 0x90, 0xb2, 0x8b, 0x8d, 0x44, 0x43, 0x85, 0x1d, 0x21, 0x2c, 0x3a, 0x47, 0x69, 0x68,
+	0xac, 0xf3, 0xdd, 0xd8, 0x5e, 0xe1, 0xdb, 0xb1, 0x76, 0xe7, 0x4e, 0xf0, 0x16, 0x74, 0x88, 0x37,
+	0xa0, 0xa4, 0xe0, 0x21, 0x52, 0x46, 0x54, 0x88, 0x22, 0x42, 0x76, 0xc1, 0x3b, 0x50, 0xa1, 0xbb,
+	0xb5, 0x2f, 0x96, 0x43, 0xe5, 0x8e, 0xe6, 0xb4, 0x33, 0xb3, 0x9f, 0xf9, 0xf3, 0xbd, 0xdd, 0x65,
+	0x1d, 0x85, 0xa3, 0x19, 0x08, 0xd4, 0x23, 0x49, 0xa0, 0x45, 0x88, 0xf1, 0x1c, 0x15, 0x28, 0x12,
+	0xf0, 0x0e, 0xc2, 0x84, 0x50, 0x8b, 0xb4, 0x5d, 0xac, 0xf9, 0x5c, 0x23, 0xa1, 0xd7, 0xcc, 0x19,
+	0xbe, 0x62, 0x78, 0xc1, 0xf0, 0x62, 0x5f, 0xda, 0x3e, 0x39, 0x0a, 0x62, 0xa9, 0x50, 0xe4, 0x5f,
+	0xcb, 0x9d, 0x3c, 0x0c, 0xd1, 0xc4, 0x68, 0x86, 0xb9, 0x25, 0xac, 0xb1, 0x0a, 0x1d, 0x4f, 0x70,
+	0x82, 0xd6, 0x9f, 0xad, 0x56, 0xde, 0x3b, 0xcd, 0x29, 0xd2, 0x38, 0x9b, 0x81, 0x16, 0x41, 0x48,
+	0x12, 0x95, 0x48, 0x3b, 0x42, 0x63, 0x42, 0x30, 0x1c, 0x03, 0xac, 0x18, 0x7f, 0x9b, 0xd1, 0x90,
+	0x8d, 0x20, 0x23, 0x1b, 0x6f, 0x7c, 0x2a, 0xb3, 0x83, 0x41, 0xc6, 0xbc, 0x04, 0xf0, 0x5e, 0x33,
+	0xd7, 0x60, 0xa2, 0x43, 0x18, 0xca, 0xa8, 0xe6, 0xd4, 0x9d, 0xd6, 0x7f, 0x9d, 0x26, 0xdf, 0x9e,
+	0x4e, 0x03, 0x4f, 0xdb, 0xbc, 0xa7, 0xd1, 0x98, 0xde, 0x34, 0x90, 0xaa, 0x7f, 0xde, 0x75, 0xaf,
+	0x6e, 0x4e, 0x4b, 0x9f, 0x7f, 0x7d, 0x79, 0xec, 0x0c, 0x0e, 0x6c, 0x82, 0x7e, 0xe4, 0x5d, 0xb2,
+	0x7b, 0x11, 0x18, 0x92, 0x2a, 0xc8, 0x7a, 0xcb, 0x32, 0x96, 0x77, 0xca, 0x78, 0xb8, 0x91, 0xa5,
+	0x1f, 0x79, 0xc7, 0x6c, 0x2f, 0x02, 0x85, 0x71, 0xad, 0x52, 0x77, 0x5a, 0xee, 0xc0, 0x1a, 0xde,
+	0x05, 0xab, 0x8c, 0x01, 0x6a, 0xd5, 0xbc, 0xc2, 0xb3, 0x3b, 0x15, 0xd6, 0x42, 0x71, 0x2b, 0x14,
+	0x4f, 0x3b, 0x7c, 0x3d, 0xf4, 0x0b, 0x22, 0x2d, 0x47, 0x09, 0x81, 0xd9, 0x2c, 0x9a, 0x65, 0x6b,
+	0x7c, 0x2c, 0xb3, 0xa3, 0xf5, 0xb6, 0x5e, 0xc6, 0x87, 0x04, 0xd1, 0x3f, 0x2c, 0xd2, 0x2b, 0xb6,
+	0x1f, 0xc4, 0x98, 0x28, 0xca, 0x75, 0x72, 0xbb, 0x4f, 0x32, 0xfe, 0xc7, 0xcd, 0xe9, 0x03, 0x7b,
+	0xf6, 0x4c, 0xf4, 0x96, 0x4b, 0x14, 0x71, 0x40, 0x53, 0xde, 0x57, 0xf4, 0xed, 0xeb, 0x19, 0xb3,
+	0x81, 0xcc, 0xb2, 0x65, 0x56, 0x7c, 0xe3, 0xb7, 0xc3, 0xee, 0xf7, 0x15, 0xc1, 0x44, 0x07, 0x84,
+	0xfa, 0x82, 0x02, 0x32, 0x5e, 0x93, 0x1d, 0xca, 0xc2, 0xb5, 0xd6, 0xc6, 0x1d, 0xfc, 0x7f, 0xeb,
+	0xdc, 0x6c, 0xac, 0xbc, 0xd5, 0x58, 0x8a, 0xb3, 0x24, 0x86, 0x5a, 0x65, 0xd7, 0xc6, 0x2c, 0xef,
+	0x9d, 0xb3, 0xea, 0x18, 0xc0, 0xec, 0x3c, 0x60, 0x4e, 0x7b, 0x8f, 0x98, 0x4b, 0x3a, 0x50, 0x66,
+	0x0c, 0xda, 0xd4, 0xf6, 0xea, 0x4e, 0xab, 0x3a, 0xb8, 0x75, 0x74, 0x2f, 0xaf, 0x16, 0xbe, 0x73,
+	0xbd, 0xf0, 0x9d, 0x9f, 0x0b, 0xdf, 0xf9, 0xb0, 0xf4, 0x4b, 0xd7, 0x4b, 0xbf, 0xf4, 0x7d, 0xe9,
+	0x97, 0xde, 0x3c, 0x9f, 0x48, 0x9a, 0x26, 0xa3, 0xec, 0x09, 0x10, 0xf9, 0xff, 0x3b, 0x0b, 0x8c,
+	0x01, 0x32, 0xc5, 0xf5, 0x4b, 0x3b, 0x82, 0xde, 0xcf, 0xc1, 0xfc, 0xe5, 0x61, 0x19, 0xed, 0xe7,
+	0x17, 0xf2, 0xe9, 0x9f, 0x01, 0x00, 0x08, 0xfa, 0x65, 0xd4, 0x83, 0x04, 0x00, 0x00,
+}
+
+func (m *RouteFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExecutor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.DestinationId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExecutor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SourceId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExecutor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RouteFeeCollected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteFeeCollected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteFeeCollected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExecutor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.DestinationId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExecutor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SourceId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintExecutor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IntegratorStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntegratorStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntegratorStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfers != 0 {
+		i = encodeVarintExecutor(dAtA, i, uint64(m.Transfers))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExecutor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExecutor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IntegratorId) > 0 {
+		i -= len(m.IntegratorId)
+		copy(dAtA[i:], m.IntegratorId)
+		i = encodeVarintExecutor(dAtA, i, uint64(len(m.IntegratorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExecutor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExecutor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RouteFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SourceId.Size()
+	n += 1 + l + sovExecutor(uint64(l))
+	l = m.DestinationId.Size()
+	n += 1 + l + sovExecutor(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovExecutor(uint64(l))
+	return n
+}
+
+func (m *RouteFeeCollected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SourceId.Size()
+	n += 1 + l + sovExecutor(uint64(l))
+	l = m.DestinationId.Size()
+	n += 1 + l + sovExecutor(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovExecutor(uint64(l))
+	return n
+}
+
+func (m *IntegratorStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntegratorId)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovExecutor(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovExecutor(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovExecutor(uint64(l))
+	if m.Transfers != 0 {
+		n += 1 + sovExecutor(uint64(m.Transfers))
+	}
+	return n
+}
+
+func sovExecutor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExecutor(x uint64) (n int) {
+	return sovExecutor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RouteFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DestinationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RouteFeeCollected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteFeeCollected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteFeeCollected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DestinationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntegratorStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntegratorStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntegratorStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegratorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			m.Transfers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transfers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExecutor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExecutor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExecutor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExecutor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExecutor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExecutor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExecutor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExecutor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExecutor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExecutor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExecutor = fmt.Errorf("proto: unexpected end of group")
+)
@@ -20,13 +20,24 @@
 
 package executor
 
-import core "github.com/noble-assets/orbiter/v2/types/core"
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	core "github.com/noble-assets/orbiter/v2/types/core"
+)
 
 // DefaultGenesisState returns the default values for the adapter
 // component initial state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PausedActionIds: []core.ActionID{},
+		PausedActionIds:    []core.ActionID{},
+		RouteFees:          []RouteFee{},
+		RouteFeesCollected: []RouteFeeCollected{},
+		Integrators:        []actiontypes.Integrator{},
+		IntegratorsStats:   []IntegratorStats{},
 	}
 }
 
@@ -42,5 +53,62 @@ func (g *GenesisState) Validate() error {
 		}
 	}
 
+	seenFees := make(map[string]struct{}, len(g.RouteFees))
+	for _, f := range g.RouteFees {
+		if err := f.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid route fee")
+		}
+
+		key := RouteKey(f.SourceId, f.DestinationId, f.Denom)
+		if _, found := seenFees[key]; found {
+			return core.ErrValidation.Wrapf("duplicate route fee %s", key)
+		}
+		seenFees[key] = struct{}{}
+	}
+
+	seenCollected := make(map[string]struct{}, len(g.RouteFeesCollected))
+	for _, c := range g.RouteFeesCollected {
+		if err := c.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid route fee collected")
+		}
+
+		key := RouteKey(c.SourceId, c.DestinationId, c.Denom)
+		if _, found := seenCollected[key]; found {
+			return core.ErrValidation.Wrapf("duplicate route fee collected %s", key)
+		}
+		seenCollected[key] = struct{}{}
+	}
+
+	seenIntegrators := make(map[string]struct{}, len(g.Integrators))
+	for _, i := range g.Integrators {
+		if err := i.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid integrator")
+		}
+
+		if _, found := seenIntegrators[i.Id]; found {
+			return core.ErrValidation.Wrapf("duplicate integrator %s", i.Id)
+		}
+		seenIntegrators[i.Id] = struct{}{}
+	}
+
+	seenStats := make(map[string]struct{}, len(g.IntegratorsStats))
+	for _, s := range g.IntegratorsStats {
+		if err := s.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid integrator stats")
+		}
+
+		key := fmt.Sprintf("%s/%s", s.IntegratorId, s.Denom)
+		if _, found := seenStats[key]; found {
+			return core.ErrValidation.Wrapf("duplicate integrator stats %s", key)
+		}
+		seenStats[key] = struct{}{}
+	}
+
 	return nil
 }
+
+// RouteKey returns the string identifier of the route of a denom
+// from a source cross-chain ID to a destination cross-chain ID.
+func RouteKey(sourceID, destinationID core.CrossChainID, denom string) string {
+	return fmt.Sprintf("%s/%s/%s", sourceID.ID(), destinationID.ID(), denom)
+}
@@ -6,7 +6,9 @@ package executor
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	action "github.com/noble-assets/orbiter/v2/types/controller/action"
 	core "github.com/noble-assets/orbiter/v2/types/core"
 	io "io"
 	math "math"
@@ -26,7 +28,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the executor component genesis state.
 type GenesisState struct {
-	PausedActionIds []core.ActionID `protobuf:"varint,1,rep,packed,name=paused_action_ids,json=pausedActionIds,proto3,enum=noble.orbiter.core.v1.ActionID" json:"paused_action_ids,omitempty"`
+	PausedActionIds    []core.ActionID     `protobuf:"varint,1,rep,packed,name=paused_action_ids,json=pausedActionIds,proto3,enum=noble.orbiter.core.v1.ActionID" json:"paused_action_ids,omitempty"`
+	RouteFees          []RouteFee          `protobuf:"bytes,2,rep,name=route_fees,json=routeFees,proto3" json:"route_fees"`
+	RouteFeesCollected []RouteFeeCollected `protobuf:"bytes,3,rep,name=route_fees_collected,json=routeFeesCollected,proto3" json:"route_fees_collected"`
+	Integrators        []action.Integrator `protobuf:"bytes,4,rep,name=integrators,proto3" json:"integrators"`
+	IntegratorsStats   []IntegratorStats   `protobuf:"bytes,5,rep,name=integrators_stats,json=integratorsStats,proto3" json:"integrators_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +75,34 @@ func (m *GenesisState) GetPausedActionIds() []core.ActionID {
 	return nil
 }
 
+func (m *GenesisState) GetRouteFees() []RouteFee {
+	if m != nil {
+		return m.RouteFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRouteFeesCollected() []RouteFeeCollected {
+	if m != nil {
+		return m.RouteFeesCollected
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIntegrators() []action.Integrator {
+	if m != nil {
+		return m.Integrators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIntegratorsStats() []IntegratorStats {
+	if m != nil {
+		return m.IntegratorsStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.orbiter.component.executor.v1.GenesisState")
 }
@@ -78,23 +112,34 @@ func init() {
 }
 
 var fileDescriptor_7f332bb8f8a528fc = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcc, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0xcf, 0x2f, 0x4a, 0xca, 0x2c, 0x49, 0x2d, 0xd2, 0x4f, 0xce, 0xcf, 0x2d, 0xc8, 0xcf,
-	0x4b, 0xcd, 0x2b, 0xd1, 0x4f, 0xad, 0x48, 0x4d, 0x2e, 0x2d, 0xc9, 0x2f, 0xd2, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x06,
-	0x6b, 0xd1, 0x83, 0x6a, 0xd1, 0x83, 0x6b, 0xd1, 0x83, 0x69, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c,
-	0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x7d, 0x52, 0x72, 0xe8, 0x56, 0x15, 0xa5, 0x82,
-	0x0c, 0xcf, 0x4c, 0x81, 0xc8, 0x2b, 0x25, 0x71, 0xf1, 0xb8, 0x43, 0x2c, 0x0a, 0x2e, 0x49, 0x2c,
-	0x49, 0x15, 0x0a, 0xe2, 0x12, 0x2c, 0x48, 0x2c, 0x2d, 0x4e, 0x4d, 0x89, 0x4f, 0x4c, 0x2e, 0xc9,
-	0xcc, 0xcf, 0x8b, 0xcf, 0x4c, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x33, 0x92, 0xd7, 0x43,
-	0x77, 0x43, 0x51, 0xaa, 0x5e, 0x99, 0xa1, 0x9e, 0x23, 0x58, 0xa1, 0xa7, 0x8b, 0x13, 0xeb, 0x8a,
-	0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xf8, 0x21, 0x06, 0x40, 0x85, 0x53, 0x8a, 0x9d, 0x42, 0x4f, 0x3c,
-	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
-	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3a, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x09,
-	0xe4, 0x1d, 0x7d, 0xb0, 0xe1, 0xba, 0x89, 0xc5, 0xc5, 0xa9, 0x25, 0xc5, 0x70, 0xf7, 0x96, 0x19,
-	0xe9, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x63, 0x09, 0xa3, 0x24, 0x36, 0xb0, 0x0f, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xbd, 0xc0, 0xe2, 0x43, 0x4e, 0x01, 0x00, 0x00,
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xbf, 0x6e, 0xd4, 0x40,
+	0x10, 0x87, 0xcf, 0x1c, 0x41, 0xca, 0x06, 0x01, 0x67, 0xa5, 0xb0, 0x52, 0x38, 0x11, 0x34, 0x11,
+	0x52, 0x76, 0x75, 0x0e, 0xa2, 0xa1, 0x22, 0x20, 0x50, 0xda, 0x43, 0x08, 0x09, 0x0a, 0xcb, 0x7f,
+	0x06, 0xb3, 0x92, 0xb3, 0x6b, 0xed, 0x8c, 0x2d, 0x78, 0x0b, 0x1e, 0x83, 0x92, 0xc7, 0x48, 0x79,
+	0x25, 0x15, 0x42, 0x77, 0x05, 0x0f, 0x41, 0x83, 0xbc, 0xeb, 0xb3, 0xad, 0x83, 0xe2, 0xd2, 0x58,
+	0xeb, 0x1d, 0x7f, 0xbf, 0xcf, 0x3b, 0x3b, 0x6c, 0xae, 0x74, 0x5a, 0x82, 0xd0, 0x26, 0x95, 0x04,
+	0x46, 0x64, 0xfa, 0xaa, 0xd2, 0x0a, 0x14, 0x09, 0xf8, 0x0c, 0x59, 0x4d, 0xda, 0x88, 0x66, 0x2e,
+	0x0a, 0x50, 0x80, 0x12, 0x79, 0x65, 0x34, 0x69, 0xff, 0x91, 0x45, 0x78, 0x87, 0xf0, 0x1e, 0xe1,
+	0x1b, 0x84, 0x37, 0xf3, 0xa3, 0x59, 0x72, 0x25, 0x95, 0x16, 0xf6, 0xe9, 0xb8, 0xa3, 0xc3, 0x42,
+	0x17, 0xda, 0x2e, 0x45, 0xbb, 0xea, 0x76, 0xa3, 0x5d, 0x7e, 0xa0, 0x4f, 0x76, 0xcc, 0xf9, 0x36,
+	0xa3, 0xc8, 0xe8, 0xb2, 0x04, 0x23, 0x92, 0x8c, 0xa4, 0x56, 0xa2, 0x89, 0x84, 0x54, 0x04, 0x85,
+	0x49, 0x06, 0x28, 0xdc, 0x86, 0x0c, 0xb4, 0xd1, 0x32, 0x77, 0xf5, 0x87, 0x7f, 0xa6, 0xec, 0xee,
+	0x6b, 0x77, 0xd0, 0x37, 0x94, 0x10, 0xf8, 0x0b, 0x36, 0xab, 0x92, 0x1a, 0x21, 0x8f, 0x5d, 0x6a,
+	0x2c, 0x73, 0x0c, 0xbc, 0x93, 0xe9, 0xe9, 0xbd, 0xe8, 0x98, 0x6f, 0xf7, 0xc0, 0x00, 0x6f, 0xe6,
+	0xfc, 0xb9, 0xfd, 0xf0, 0xf2, 0xe5, 0xc5, 0xde, 0xb7, 0xdf, 0xdf, 0x1f, 0x7b, 0x8b, 0xfb, 0x2e,
+	0xa0, 0xdb, 0xce, 0xd1, 0x7f, 0xc7, 0x98, 0xd1, 0x35, 0x41, 0xfc, 0x11, 0x00, 0x83, 0x5b, 0x27,
+	0xd3, 0xd3, 0x83, 0xe8, 0x8c, 0xef, 0xd0, 0x50, 0xbe, 0x68, 0xb1, 0x57, 0x00, 0x17, 0xfb, 0xd7,
+	0x3f, 0x8f, 0x27, 0x2e, 0x7e, 0xdf, 0x74, 0x9b, 0xe8, 0x23, 0x3b, 0x1c, 0x82, 0xe3, 0xac, 0xed,
+	0x46, 0x46, 0x90, 0x07, 0x53, 0xab, 0x78, 0x7a, 0x23, 0xc5, 0x8b, 0x0d, 0x3d, 0x76, 0xf9, 0xbd,
+	0xab, 0x2f, 0xfb, 0x1f, 0xd8, 0xc1, 0xd0, 0x66, 0x0c, 0x6e, 0x5b, 0x17, 0xff, 0xc7, 0xb5, 0xb9,
+	0x1d, 0xee, 0xfa, 0xc8, 0x9b, 0x88, 0x5f, 0xf6, 0xd8, 0xd8, 0x31, 0x4e, 0xf3, 0x4b, 0x36, 0x1b,
+	0xbd, 0xc6, 0x48, 0x09, 0x61, 0xb0, 0x67, 0x15, 0x4f, 0x76, 0x3a, 0xce, 0xe0, 0x68, 0xef, 0x13,
+	0xc7, 0xa2, 0x07, 0xa3, 0x64, 0x57, 0x7c, 0x7b, 0xbd, 0x0a, 0xbd, 0xe5, 0x2a, 0xf4, 0x7e, 0xad,
+	0x42, 0xef, 0xeb, 0x3a, 0x9c, 0x2c, 0xd7, 0xe1, 0xe4, 0xc7, 0x3a, 0x9c, 0xbc, 0x7f, 0x56, 0x48,
+	0xfa, 0x54, 0xa7, 0xad, 0x44, 0x58, 0xed, 0x59, 0x82, 0x08, 0x84, 0xfd, 0x24, 0x35, 0x91, 0xa0,
+	0x2f, 0x15, 0xe0, 0x7f, 0x66, 0x37, 0xbd, 0x63, 0x67, 0xeb, 0xfc, 0xef, 0x00, 0x21, 0xf1, 0xef,
+	0x40, 0x67, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -117,6 +162,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IntegratorsStats) > 0 {
+		for iNdEx := len(m.IntegratorsStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IntegratorsStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Integrators) > 0 {
+		for iNdEx := len(m.Integrators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Integrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RouteFeesCollected) > 0 {
+		for iNdEx := len(m.RouteFeesCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteFeesCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RouteFees) > 0 {
+		for iNdEx := len(m.RouteFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.PausedActionIds) > 0 {
 		dAtA2 := make([]byte, len(m.PausedActionIds)*10)
 		var j1 int
@@ -162,6 +263,30 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	if len(m.RouteFees) > 0 {
+		for _, e := range m.RouteFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RouteFeesCollected) > 0 {
+		for _, e := range m.RouteFeesCollected {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Integrators) > 0 {
+		for _, e := range m.Integrators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IntegratorsStats) > 0 {
+		for _, e := range m.IntegratorsStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +394,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field PausedActionIds", wireType)
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteFees = append(m.RouteFees, RouteFee{})
+			if err := m.RouteFees[len(m.RouteFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteFeesCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteFeesCollected = append(m.RouteFeesCollected, RouteFeeCollected{})
+			if err := m.RouteFeesCollected[len(m.RouteFeesCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integrators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Integrators = append(m.Integrators, action.Integrator{})
+			if err := m.Integrators[len(m.Integrators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorsStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegratorsStats = append(m.IntegratorsStats, IntegratorStats{})
+			if err := m.IntegratorsStats[len(m.IntegratorsStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
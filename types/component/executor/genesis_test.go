@@ -25,10 +25,46 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
 func TestValidate(t *testing.T) {
+	sourceID := core.CrossChainID{ProtocolId: core.PROTOCOL_IBC, CounterpartyId: "channel-0"}
+	destinationID := core.CrossChainID{ProtocolId: core.PROTOCOL_CCTP, CounterpartyId: "0"}
+	routeFee := RouteFee{
+		SourceId:      sourceID,
+		DestinationId: destinationID,
+		Denom:         "uusdc",
+		Fee: actiontypes.RouteFeeAttributes{
+			BasisPoints: 10,
+			MinAmount:   math.ZeroInt(),
+			MaxAmount:   math.ZeroInt(),
+			Recipient:   sdk.AccAddress("recipient").String(),
+		},
+	}
+	collected := RouteFeeCollected{
+		SourceId:      sourceID,
+		DestinationId: destinationID,
+		Denom:         "uusdc",
+		Amount:        math.NewInt(100),
+	}
+	integrator := actiontypes.Integrator{
+		Id:            "frontend",
+		PayoutAddress: sdk.AccAddress("payout").String(),
+		BasisPoints:   10,
+	}
+	stats := IntegratorStats{
+		IntegratorId: "frontend",
+		Denom:        "uusdc",
+		Volume:       math.NewInt(1_000),
+		Fees:         math.NewInt(1),
+		Transfers:    1,
+	}
+
 	testcases := []struct {
 		name     string
 		genState *GenesisState
@@ -56,6 +92,83 @@ func TestValidate(t *testing.T) {
 			},
 			expErr: "ID is not supported",
 		},
+		{
+			name: "success - genesis state with route fees and collected amounts",
+			genState: &GenesisState{
+				RouteFees:          []RouteFee{routeFee},
+				RouteFeesCollected: []RouteFeeCollected{collected},
+			},
+		},
+		{
+			name: "error - duplicate route fee",
+			genState: &GenesisState{
+				RouteFees: []RouteFee{routeFee, routeFee},
+			},
+			expErr: "duplicate route fee 1:channel-0/2:0/uusdc",
+		},
+		{
+			name: "error - invalid route fee source ID",
+			genState: &GenesisState{
+				RouteFees: []RouteFee{{
+					DestinationId: destinationID,
+					Denom:         "uusdc",
+					Fee:           routeFee.Fee,
+				}},
+			},
+			expErr: "invalid source ID",
+		},
+		{
+			name: "error - duplicate route fee collected",
+			genState: &GenesisState{
+				RouteFeesCollected: []RouteFeeCollected{collected, collected},
+			},
+			expErr: "duplicate route fee collected 1:channel-0/2:0/uusdc",
+		},
+		{
+			name: "error - zero route fee collected",
+			genState: &GenesisState{
+				RouteFeesCollected: []RouteFeeCollected{{
+					SourceId:      sourceID,
+					DestinationId: destinationID,
+					Denom:         "uusdc",
+					Amount:        math.ZeroInt(),
+				}},
+			},
+			expErr: "collected amount must be positive",
+		},
+		{
+			name: "success - genesis state with integrators and stats",
+			genState: &GenesisState{
+				Integrators:      []actiontypes.Integrator{integrator},
+				IntegratorsStats: []IntegratorStats{stats},
+			},
+		},
+		{
+			name: "error - duplicate integrator",
+			genState: &GenesisState{
+				Integrators: []actiontypes.Integrator{integrator, integrator},
+			},
+			expErr: "duplicate integrator frontend",
+		},
+		{
+			name: "error - duplicate integrator stats",
+			genState: &GenesisState{
+				IntegratorsStats: []IntegratorStats{stats, stats},
+			},
+			expErr: "duplicate integrator stats frontend/uusdc",
+		},
+		{
+			name: "error - integrator stats without transfers",
+			genState: &GenesisState{
+				IntegratorsStats: []IntegratorStats{{
+					IntegratorId: "frontend",
+					Denom:        "uusdc",
+					Volume:       math.NewInt(1_000),
+					Fees:         math.NewInt(1),
+				}},
+			},
+			expErr: "transfers must be positive",
+		},
 	}
 
 	for _, tc := range testcases {
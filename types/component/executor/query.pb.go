@@ -6,10 +6,12 @@ package executor
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	action "github.com/noble-assets/orbiter/v2/types/controller/action"
 	core "github.com/noble-assets/orbiter/v2/types/core"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -199,361 +201,3630 @@ func (m *QueryIsActionPausedResponse) GetIsPaused() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryPausedActionsRequest)(nil), "noble.orbiter.component.executor.v1.QueryPausedActionsRequest")
-	proto.RegisterType((*QueryPausedActionsResponse)(nil), "noble.orbiter.component.executor.v1.QueryPausedActionsResponse")
-	proto.RegisterType((*QueryIsActionPausedRequest)(nil), "noble.orbiter.component.executor.v1.QueryIsActionPausedRequest")
-	proto.RegisterType((*QueryIsActionPausedResponse)(nil), "noble.orbiter.component.executor.v1.QueryIsActionPausedResponse")
+// QueryRouteFeesRequest is the request type for the Query/RouteFees RPC method.
+type QueryRouteFeesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("noble/orbiter/component/executor/v1/query.proto", fileDescriptor_e59ceddefd6d6461)
+func (m *QueryRouteFeesRequest) Reset()         { *m = QueryRouteFeesRequest{} }
+func (m *QueryRouteFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteFeesRequest) ProtoMessage()    {}
+func (*QueryRouteFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{4}
 }
-
-var fileDescriptor_e59ceddefd6d6461 = []byte{
-	// 440 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x3f, 0x8b, 0xd4, 0x40,
-	0x18, 0xc6, 0x77, 0x3c, 0x4e, 0x36, 0x03, 0x1e, 0x38, 0xd5, 0x99, 0x48, 0x3c, 0x62, 0x73, 0x2a,
-	0xce, 0xb0, 0x2b, 0x2a, 0x2a, 0xf8, 0x67, 0xd1, 0x62, 0x3b, 0x0d, 0xd8, 0xd8, 0x1c, 0x93, 0x64,
-	0x58, 0x07, 0x6e, 0x67, 0x72, 0x79, 0x27, 0xc1, 0x43, 0x6c, 0xac, 0x2c, 0x05, 0xbf, 0x84, 0x85,
-	0x85, 0x1f, 0xe3, 0xca, 0x03, 0x0b, 0x2d, 0x65, 0x57, 0xf0, 0x6b, 0x48, 0x66, 0x72, 0xd1, 0x0b,
-	0x11, 0xf6, 0xb6, 0x49, 0x91, 0x77, 0x7e, 0xcf, 0xfb, 0x3c, 0xef, 0x3b, 0x83, 0x99, 0xd2, 0xc9,
-	0xbe, 0x60, 0xba, 0x48, 0xa4, 0x11, 0x05, 0x4b, 0xf5, 0x3c, 0xd7, 0x4a, 0x28, 0xc3, 0xc4, 0x1b,
-	0x91, 0x96, 0x46, 0x17, 0xac, 0x1a, 0xb1, 0x83, 0x52, 0x14, 0x87, 0x34, 0x2f, 0xb4, 0xd1, 0xe4,
-	0xaa, 0x05, 0x68, 0x03, 0xd0, 0x16, 0xa0, 0x27, 0x00, 0xad, 0x46, 0xfe, 0x45, 0x3e, 0x97, 0x4a,
-	0x33, 0xfb, 0x75, 0x9c, 0x1f, 0xa4, 0x1a, 0xe6, 0x1a, 0x9c, 0x56, 0x47, 0xd4, 0xbf, 0x3c, 0xd3,
-	0x7a, 0xb6, 0x2f, 0x18, 0xcf, 0x25, 0xe3, 0x4a, 0x69, 0xc3, 0x8d, 0xd4, 0x0a, 0x9a, 0x6a, 0xd8,
-	0xf5, 0x58, 0x88, 0x5a, 0x40, 0x66, 0xae, 0x1e, 0x05, 0xf8, 0xd2, 0x8b, 0x5a, 0xec, 0x39, 0x2f,
-	0x41, 0x64, 0x4f, 0x52, 0xcb, 0xc6, 0xe2, 0xa0, 0x14, 0x60, 0xa2, 0x14, 0xfb, 0x7d, 0x45, 0xc8,
-	0xb5, 0x02, 0x41, 0x9e, 0x61, 0xcc, 0xed, 0xaf, 0x3d, 0x99, 0xc1, 0x36, 0xda, 0xd9, 0xd8, 0xdd,
-	0x1a, 0x5f, 0xa1, 0xdd, 0x88, 0x85, 0xa0, 0xd5, 0x88, 0x3a, 0x76, 0xfa, 0x74, 0xb2, 0xf9, 0xf9,
-	0xf7, 0xd7, 0xeb, 0x28, 0xf6, 0x1c, 0x39, 0xcd, 0x20, 0xba, 0xd7, 0x34, 0x99, 0x82, 0x3b, 0xe4,
-	0x9a, 0x35, 0x16, 0x48, 0x80, 0xbd, 0xb6, 0xc9, 0x36, 0xda, 0x41, 0xbb, 0x5e, 0x3c, 0x3c, 0x61,
-	0xa3, 0xfb, 0x38, 0xe8, 0x45, 0x1b, 0x83, 0x01, 0xf6, 0x24, 0xec, 0xe5, 0xf6, 0xa7, 0x65, 0x87,
-	0xf1, 0x50, 0x82, 0x3b, 0x34, 0xfe, 0xb2, 0x81, 0x37, 0x2d, 0x4c, 0x8e, 0x10, 0xbe, 0x70, 0x2a,
-	0x21, 0x79, 0x48, 0x57, 0x58, 0x14, 0xfd, 0xef, 0xdc, 0xfc, 0x47, 0x6b, 0xf3, 0xce, 0x79, 0x74,
-	0xe7, 0x43, 0x3d, 0xa5, 0xf7, 0xdf, 0x7e, 0x7d, 0x3a, 0x77, 0x83, 0x5c, 0xeb, 0xdc, 0xb3, 0x7f,
-	0x6f, 0x97, 0x0b, 0xc7, 0x78, 0x63, 0xfc, 0x3b, 0xc2, 0x5b, 0xa7, 0x87, 0x41, 0xce, 0xe0, 0xa5,
-	0x77, 0x03, 0xfe, 0xe3, 0xf5, 0x05, 0x9a, 0x34, 0x93, 0xbf, 0x69, 0xee, 0x92, 0xdb, 0x2b, 0xa7,
-	0x61, 0x6f, 0xdb, 0xcd, 0xbf, 0x9b, 0xbc, 0x3c, 0x5a, 0x84, 0xe8, 0x78, 0x11, 0xa2, 0x9f, 0x8b,
-	0x10, 0x7d, 0x5c, 0x86, 0x83, 0xe3, 0x65, 0x38, 0xf8, 0xb1, 0x0c, 0x07, 0xaf, 0x1e, 0xcc, 0xa4,
-	0x79, 0x5d, 0x26, 0xb5, 0x2f, 0x27, 0x7d, 0x93, 0x03, 0x08, 0x03, 0x6d, 0x87, 0x6a, 0xcc, 0xcc,
-	0x61, 0x2e, 0xa0, 0xe7, 0x81, 0x26, 0xe7, 0xed, 0x2b, 0xb8, 0xf5, 0x27, 0x00, 0x00, 0xff, 0xff,
-	0x73, 0xb1, 0x8c, 0x9c, 0xcb, 0x03, 0x00, 0x00,
+func (m *QueryRouteFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteFeesRequest.Merge(m, src)
+}
+func (m *QueryRouteFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteFeesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryRouteFeesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	PausedActions(ctx context.Context, in *QueryPausedActionsRequest, opts ...grpc.CallOption) (*QueryPausedActionsResponse, error)
-	IsActionPaused(ctx context.Context, in *QueryIsActionPausedRequest, opts ...grpc.CallOption) (*QueryIsActionPausedResponse, error)
+func (m *QueryRouteFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryRouteFeesResponse is the response type for the Query/RouteFees RPC method.
+type QueryRouteFeesResponse struct {
+	// route_fees is the list of route fees.
+	RouteFees []RouteFee `protobuf:"bytes,1,rep,name=route_fees,json=routeFees,proto3" json:"route_fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRouteFeesResponse) Reset()         { *m = QueryRouteFeesResponse{} }
+func (m *QueryRouteFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteFeesResponse) ProtoMessage()    {}
+func (*QueryRouteFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{5}
 }
-
-func (c *queryClient) PausedActions(ctx context.Context, in *QueryPausedActionsRequest, opts ...grpc.CallOption) (*QueryPausedActionsResponse, error) {
-	out := new(QueryPausedActionsResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/PausedActions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRouteFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRouteFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteFeesResponse.Merge(m, src)
+}
+func (m *QueryRouteFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteFeesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) IsActionPaused(ctx context.Context, in *QueryIsActionPausedRequest, opts ...grpc.CallOption) (*QueryIsActionPausedResponse, error) {
-	out := new(QueryIsActionPausedResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/IsActionPaused", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRouteFeesResponse proto.InternalMessageInfo
+
+func (m *QueryRouteFeesResponse) GetRouteFees() []RouteFee {
+	if m != nil {
+		return m.RouteFees
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	PausedActions(context.Context, *QueryPausedActionsRequest) (*QueryPausedActionsResponse, error)
-	IsActionPaused(context.Context, *QueryIsActionPausedRequest) (*QueryIsActionPausedResponse, error)
+func (m *QueryRouteFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryRouteFeeRequest is the request type for the Query/RouteFee RPC method.
+type QueryRouteFeeRequest struct {
+	// source_protocol_id is the source protocol identifier.
+	SourceProtocolId string `protobuf:"bytes,1,opt,name=source_protocol_id,json=sourceProtocolId,proto3" json:"source_protocol_id,omitempty"`
+	// source_counterparty_id is the source counterparty identifier.
+	SourceCounterpartyId string `protobuf:"bytes,2,opt,name=source_counterparty_id,json=sourceCounterpartyId,proto3" json:"source_counterparty_id,omitempty"`
+	// destination_protocol_id is the destination protocol identifier.
+	DestinationProtocolId string `protobuf:"bytes,3,opt,name=destination_protocol_id,json=destinationProtocolId,proto3" json:"destination_protocol_id,omitempty"`
+	// destination_counterparty_id is the destination counterparty identifier.
+	DestinationCounterpartyId string `protobuf:"bytes,4,opt,name=destination_counterparty_id,json=destinationCounterpartyId,proto3" json:"destination_counterparty_id,omitempty"`
+	// denom is the Noble denom received from the source.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (*UnimplementedQueryServer) PausedActions(ctx context.Context, req *QueryPausedActionsRequest) (*QueryPausedActionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PausedActions not implemented")
+func (m *QueryRouteFeeRequest) Reset()         { *m = QueryRouteFeeRequest{} }
+func (m *QueryRouteFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteFeeRequest) ProtoMessage()    {}
+func (*QueryRouteFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{6}
 }
-func (*UnimplementedQueryServer) IsActionPaused(ctx context.Context, req *QueryIsActionPausedRequest) (*QueryIsActionPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsActionPaused not implemented")
+func (m *QueryRouteFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRouteFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteFeeRequest.Merge(m, src)
+}
+func (m *QueryRouteFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteFeeRequest.DiscardUnknown(m)
 }
 
-func _Query_PausedActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPausedActionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PausedActions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.executor.v1.Query/PausedActions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PausedActions(ctx, req.(*QueryPausedActionsRequest))
+var xxx_messageInfo_QueryRouteFeeRequest proto.InternalMessageInfo
+
+func (m *QueryRouteFeeRequest) GetSourceProtocolId() string {
+	if m != nil {
+		return m.SourceProtocolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_IsActionPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsActionPausedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsActionPaused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.executor.v1.Query/IsActionPaused",
+func (m *QueryRouteFeeRequest) GetSourceCounterpartyId() string {
+	if m != nil {
+		return m.SourceCounterpartyId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsActionPaused(ctx, req.(*QueryIsActionPausedRequest))
+	return ""
+}
+
+func (m *QueryRouteFeeRequest) GetDestinationProtocolId() string {
+	if m != nil {
+		return m.DestinationProtocolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.orbiter.component.executor.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PausedActions",
-			Handler:    _Query_PausedActions_Handler,
-		},
-		{
-			MethodName: "IsActionPaused",
-			Handler:    _Query_IsActionPaused_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/orbiter/component/executor/v1/query.proto",
+func (m *QueryRouteFeeRequest) GetDestinationCounterpartyId() string {
+	if m != nil {
+		return m.DestinationCounterpartyId
+	}
+	return ""
 }
 
-func (m *QueryPausedActionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRouteFeeRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPausedActionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRouteFeeResponse is the response type for the Query/RouteFee RPC method.
+type QueryRouteFeeResponse struct {
+	RouteFee RouteFee `protobuf:"bytes,1,opt,name=route_fee,json=routeFee,proto3" json:"route_fee"`
 }
 
-func (m *QueryPausedActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRouteFeeResponse) Reset()         { *m = QueryRouteFeeResponse{} }
+func (m *QueryRouteFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteFeeResponse) ProtoMessage()    {}
+func (*QueryRouteFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{7}
+}
+func (m *QueryRouteFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRouteFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteFeeResponse.Merge(m, src)
+}
+func (m *QueryRouteFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteFeeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPausedActionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRouteFeeResponse proto.InternalMessageInfo
+
+func (m *QueryRouteFeeResponse) GetRouteFee() RouteFee {
+	if m != nil {
+		return m.RouteFee
 	}
-	return dAtA[:n], nil
+	return RouteFee{}
 }
 
-func (m *QueryPausedActionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRouteFeesCollectedRequest is the request type for the
+// Query/RouteFeesCollected RPC method.
+type QueryRouteFeesCollectedRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPausedActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActionIds) > 0 {
-		dAtA2 := make([]byte, len(m.ActionIds)*10)
-		var j1 int
-		for _, num := range m.ActionIds {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+func (m *QueryRouteFeesCollectedRequest) Reset()         { *m = QueryRouteFeesCollectedRequest{} }
+func (m *QueryRouteFeesCollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteFeesCollectedRequest) ProtoMessage()    {}
+func (*QueryRouteFeesCollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{8}
+}
+func (m *QueryRouteFeesCollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteFeesCollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteFeesCollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintQuery(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRouteFeesCollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteFeesCollectedRequest.Merge(m, src)
+}
+func (m *QueryRouteFeesCollectedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteFeesCollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteFeesCollectedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIsActionPausedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRouteFeesCollectedRequest proto.InternalMessageInfo
+
+func (m *QueryRouteFeesCollectedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIsActionPausedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRouteFeesCollectedResponse is the response type for the
+// Query/RouteFeesCollected RPC method.
+type QueryRouteFeesCollectedResponse struct {
+	// collected is the list of the total fees collected per route.
+	Collected []RouteFeeCollected `protobuf:"bytes,1,rep,name=collected,proto3" json:"collected"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIsActionPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActionId) > 0 {
-		i -= len(m.ActionId)
-		copy(dAtA[i:], m.ActionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ActionId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRouteFeesCollectedResponse) Reset()         { *m = QueryRouteFeesCollectedResponse{} }
+func (m *QueryRouteFeesCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteFeesCollectedResponse) ProtoMessage()    {}
+func (*QueryRouteFeesCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{9}
+}
+func (m *QueryRouteFeesCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteFeesCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteFeesCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRouteFeesCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteFeesCollectedResponse.Merge(m, src)
+}
+func (m *QueryRouteFeesCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteFeesCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteFeesCollectedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIsActionPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRouteFeesCollectedResponse proto.InternalMessageInfo
+
+func (m *QueryRouteFeesCollectedResponse) GetCollected() []RouteFeeCollected {
+	if m != nil {
+		return m.Collected
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIsActionPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRouteFeesCollectedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryIsActionPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsPaused {
-		i--
-		if m.IsPaused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// QueryRouteFeeCollectedRequest is the request type for the
+// Query/RouteFeeCollected RPC method.
+type QueryRouteFeeCollectedRequest struct {
+	// source_protocol_id is the source protocol identifier.
+	SourceProtocolId string `protobuf:"bytes,1,opt,name=source_protocol_id,json=sourceProtocolId,proto3" json:"source_protocol_id,omitempty"`
+	// source_counterparty_id is the source counterparty identifier.
+	SourceCounterpartyId string `protobuf:"bytes,2,opt,name=source_counterparty_id,json=sourceCounterpartyId,proto3" json:"source_counterparty_id,omitempty"`
+	// destination_protocol_id is the destination protocol identifier.
+	DestinationProtocolId string `protobuf:"bytes,3,opt,name=destination_protocol_id,json=destinationProtocolId,proto3" json:"destination_protocol_id,omitempty"`
+	// destination_counterparty_id is the destination counterparty identifier.
+	DestinationCounterpartyId string `protobuf:"bytes,4,opt,name=destination_counterparty_id,json=destinationCounterpartyId,proto3" json:"destination_counterparty_id,omitempty"`
+	// denom is the Noble denom received from the source.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRouteFeeCollectedRequest) Reset()         { *m = QueryRouteFeeCollectedRequest{} }
+func (m *QueryRouteFeeCollectedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteFeeCollectedRequest) ProtoMessage()    {}
+func (*QueryRouteFeeCollectedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{10}
+}
+func (m *QueryRouteFeeCollectedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteFeeCollectedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteFeeCollectedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRouteFeeCollectedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteFeeCollectedRequest.Merge(m, src)
+}
+func (m *QueryRouteFeeCollectedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteFeeCollectedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteFeeCollectedRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryRouteFeeCollectedRequest proto.InternalMessageInfo
+
+func (m *QueryRouteFeeCollectedRequest) GetSourceProtocolId() string {
+	if m != nil {
+		return m.SourceProtocolId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryPausedActionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryRouteFeeCollectedRequest) GetSourceCounterpartyId() string {
+	if m != nil {
+		return m.SourceCounterpartyId
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryPausedActionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRouteFeeCollectedRequest) GetDestinationProtocolId() string {
+	if m != nil {
+		return m.DestinationProtocolId
 	}
-	var l int
-	_ = l
-	if len(m.ActionIds) > 0 {
-		l = 0
-		for _, e := range m.ActionIds {
-			l += sovQuery(uint64(e))
+	return ""
+}
+
+func (m *QueryRouteFeeCollectedRequest) GetDestinationCounterpartyId() string {
+	if m != nil {
+		return m.DestinationCounterpartyId
+	}
+	return ""
+}
+
+func (m *QueryRouteFeeCollectedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryRouteFeeCollectedResponse is the response type for the
+// Query/RouteFeeCollected RPC method.
+type QueryRouteFeeCollectedResponse struct {
+	Collected RouteFeeCollected `protobuf:"bytes,1,opt,name=collected,proto3" json:"collected"`
+}
+
+func (m *QueryRouteFeeCollectedResponse) Reset()         { *m = QueryRouteFeeCollectedResponse{} }
+func (m *QueryRouteFeeCollectedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRouteFeeCollectedResponse) ProtoMessage()    {}
+func (*QueryRouteFeeCollectedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{11}
+}
+func (m *QueryRouteFeeCollectedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRouteFeeCollectedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRouteFeeCollectedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRouteFeeCollectedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRouteFeeCollectedResponse.Merge(m, src)
+}
+func (m *QueryRouteFeeCollectedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRouteFeeCollectedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRouteFeeCollectedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIsActionPausedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRouteFeeCollectedResponse proto.InternalMessageInfo
+
+func (m *QueryRouteFeeCollectedResponse) GetCollected() RouteFeeCollected {
+	if m != nil {
+		return m.Collected
 	}
-	var l int
-	_ = l
-	l = len(m.ActionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return RouteFeeCollected{}
+}
+
+// QueryIntegratorsRequest is the request type for the Query/Integrators RPC method.
+type QueryIntegratorsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegratorsRequest) Reset()         { *m = QueryIntegratorsRequest{} }
+func (m *QueryIntegratorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegratorsRequest) ProtoMessage()    {}
+func (*QueryIntegratorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{12}
+}
+func (m *QueryIntegratorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegratorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegratorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryIntegratorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegratorsRequest.Merge(m, src)
+}
+func (m *QueryIntegratorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegratorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegratorsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIsActionPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryIntegratorsRequest proto.InternalMessageInfo
+
+func (m *QueryIntegratorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if m.IsPaused {
-		n += 2
+	return nil
+}
+
+// QueryIntegratorsResponse is the response type for the Query/Integrators RPC method.
+type QueryIntegratorsResponse struct {
+	// integrators is the list of registered integrators.
+	Integrators []action.Integrator `protobuf:"bytes,1,rep,name=integrators,proto3" json:"integrators"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegratorsResponse) Reset()         { *m = QueryIntegratorsResponse{} }
+func (m *QueryIntegratorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegratorsResponse) ProtoMessage()    {}
+func (*QueryIntegratorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{13}
+}
+func (m *QueryIntegratorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegratorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegratorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegratorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegratorsResponse.Merge(m, src)
+}
+func (m *QueryIntegratorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegratorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegratorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegratorsResponse proto.InternalMessageInfo
+
+func (m *QueryIntegratorsResponse) GetIntegrators() []action.Integrator {
+	if m != nil {
+		return m.Integrators
+	}
+	return nil
+}
+
+func (m *QueryIntegratorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIntegratorRequest is the request type for the Query/Integrator RPC method.
+type QueryIntegratorRequest struct {
+	// integrator_id is the identifier of the integrator.
+	IntegratorId string `protobuf:"bytes,1,opt,name=integrator_id,json=integratorId,proto3" json:"integrator_id,omitempty"`
+}
+
+func (m *QueryIntegratorRequest) Reset()         { *m = QueryIntegratorRequest{} }
+func (m *QueryIntegratorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegratorRequest) ProtoMessage()    {}
+func (*QueryIntegratorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{14}
+}
+func (m *QueryIntegratorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegratorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegratorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegratorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegratorRequest.Merge(m, src)
+}
+func (m *QueryIntegratorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegratorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegratorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegratorRequest proto.InternalMessageInfo
+
+func (m *QueryIntegratorRequest) GetIntegratorId() string {
+	if m != nil {
+		return m.IntegratorId
+	}
+	return ""
+}
+
+// QueryIntegratorResponse is the response type for the Query/Integrator RPC method.
+type QueryIntegratorResponse struct {
+	Integrator action.Integrator `protobuf:"bytes,1,opt,name=integrator,proto3" json:"integrator"`
+}
+
+func (m *QueryIntegratorResponse) Reset()         { *m = QueryIntegratorResponse{} }
+func (m *QueryIntegratorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegratorResponse) ProtoMessage()    {}
+func (*QueryIntegratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{15}
+}
+func (m *QueryIntegratorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegratorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegratorResponse.Merge(m, src)
+}
+func (m *QueryIntegratorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegratorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegratorResponse proto.InternalMessageInfo
+
+func (m *QueryIntegratorResponse) GetIntegrator() action.Integrator {
+	if m != nil {
+		return m.Integrator
+	}
+	return action.Integrator{}
+}
+
+// QueryIntegratorStatsRequest is the request type for the
+// Query/IntegratorStats RPC method.
+type QueryIntegratorStatsRequest struct {
+	// integrator_id is the identifier of the integrator.
+	IntegratorId string `protobuf:"bytes,1,opt,name=integrator_id,json=integratorId,proto3" json:"integrator_id,omitempty"`
+}
+
+func (m *QueryIntegratorStatsRequest) Reset()         { *m = QueryIntegratorStatsRequest{} }
+func (m *QueryIntegratorStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegratorStatsRequest) ProtoMessage()    {}
+func (*QueryIntegratorStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{16}
+}
+func (m *QueryIntegratorStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegratorStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegratorStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegratorStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegratorStatsRequest.Merge(m, src)
+}
+func (m *QueryIntegratorStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegratorStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegratorStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegratorStatsRequest proto.InternalMessageInfo
+
+func (m *QueryIntegratorStatsRequest) GetIntegratorId() string {
+	if m != nil {
+		return m.IntegratorId
+	}
+	return ""
+}
+
+// QueryIntegratorStatsResponse is the response type for the
+// Query/IntegratorStats RPC method.
+type QueryIntegratorStatsResponse struct {
+	// stats is the list of the integrator stats per denom.
+	Stats []IntegratorStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryIntegratorStatsResponse) Reset()         { *m = QueryIntegratorStatsResponse{} }
+func (m *QueryIntegratorStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegratorStatsResponse) ProtoMessage()    {}
+func (*QueryIntegratorStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e59ceddefd6d6461, []int{17}
+}
+func (m *QueryIntegratorStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegratorStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegratorStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegratorStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegratorStatsResponse.Merge(m, src)
+}
+func (m *QueryIntegratorStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegratorStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegratorStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegratorStatsResponse proto.InternalMessageInfo
+
+func (m *QueryIntegratorStatsResponse) GetStats() []IntegratorStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPausedActionsRequest)(nil), "noble.orbiter.component.executor.v1.QueryPausedActionsRequest")
+	proto.RegisterType((*QueryPausedActionsResponse)(nil), "noble.orbiter.component.executor.v1.QueryPausedActionsResponse")
+	proto.RegisterType((*QueryIsActionPausedRequest)(nil), "noble.orbiter.component.executor.v1.QueryIsActionPausedRequest")
+	proto.RegisterType((*QueryIsActionPausedResponse)(nil), "noble.orbiter.component.executor.v1.QueryIsActionPausedResponse")
+	proto.RegisterType((*QueryRouteFeesRequest)(nil), "noble.orbiter.component.executor.v1.QueryRouteFeesRequest")
+	proto.RegisterType((*QueryRouteFeesResponse)(nil), "noble.orbiter.component.executor.v1.QueryRouteFeesResponse")
+	proto.RegisterType((*QueryRouteFeeRequest)(nil), "noble.orbiter.component.executor.v1.QueryRouteFeeRequest")
+	proto.RegisterType((*QueryRouteFeeResponse)(nil), "noble.orbiter.component.executor.v1.QueryRouteFeeResponse")
+	proto.RegisterType((*QueryRouteFeesCollectedRequest)(nil), "noble.orbiter.component.executor.v1.QueryRouteFeesCollectedRequest")
+	proto.RegisterType((*QueryRouteFeesCollectedResponse)(nil), "noble.orbiter.component.executor.v1.QueryRouteFeesCollectedResponse")
+	proto.RegisterType((*QueryRouteFeeCollectedRequest)(nil), "noble.orbiter.component.executor.v1.QueryRouteFeeCollectedRequest")
+	proto.RegisterType((*QueryRouteFeeCollectedResponse)(nil), "noble.orbiter.component.executor.v1.QueryRouteFeeCollectedResponse")
+	proto.RegisterType((*QueryIntegratorsRequest)(nil), "noble.orbiter.component.executor.v1.QueryIntegratorsRequest")
+	proto.RegisterType((*QueryIntegratorsResponse)(nil), "noble.orbiter.component.executor.v1.QueryIntegratorsResponse")
+	proto.RegisterType((*QueryIntegratorRequest)(nil), "noble.orbiter.component.executor.v1.QueryIntegratorRequest")
+	proto.RegisterType((*QueryIntegratorResponse)(nil), "noble.orbiter.component.executor.v1.QueryIntegratorResponse")
+	proto.RegisterType((*QueryIntegratorStatsRequest)(nil), "noble.orbiter.component.executor.v1.QueryIntegratorStatsRequest")
+	proto.RegisterType((*QueryIntegratorStatsResponse)(nil), "noble.orbiter.component.executor.v1.QueryIntegratorStatsResponse")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/component/executor/v1/query.proto", fileDescriptor_e59ceddefd6d6461)
+}
+
+var fileDescriptor_e59ceddefd6d6461 = []byte{
+	// 1169 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xb8, 0x0d, 0x8a, 0x5f, 0x68, 0x69, 0x47, 0x69, 0x9b, 0xda, 0xc5, 0xad, 0xb6, 0xa2,
+	0x0d, 0x85, 0xee, 0xc8, 0x4e, 0x09, 0x34, 0x49, 0x43, 0xeb, 0x94, 0x22, 0xdf, 0x82, 0xa1, 0x42,
+	0x2a, 0x07, 0x6b, 0xbd, 0x3b, 0xb8, 0x2b, 0x25, 0x3b, 0xee, 0xce, 0xd8, 0x22, 0x0a, 0xb9, 0x70,
+	0xea, 0x11, 0x54, 0x89, 0x1b, 0x12, 0xe2, 0x80, 0x38, 0x70, 0x40, 0xe2, 0x82, 0xb8, 0xc0, 0x31,
+	0x52, 0x39, 0x54, 0xe2, 0x00, 0x17, 0x10, 0x24, 0x48, 0xfc, 0x0d, 0xb4, 0x3b, 0xb3, 0x3b, 0xbb,
+	0x6b, 0xa7, 0xd8, 0x9b, 0x88, 0x0b, 0x97, 0xca, 0x9d, 0x99, 0xef, 0xbd, 0xef, 0x7b, 0xef, 0xeb,
+	0xbe, 0xa7, 0x02, 0xf1, 0x58, 0x7b, 0x9d, 0x12, 0xe6, 0xb7, 0x5d, 0x41, 0x7d, 0x62, 0xb3, 0x8d,
+	0x2e, 0xf3, 0xa8, 0x27, 0x08, 0xfd, 0x80, 0xda, 0x3d, 0xc1, 0x7c, 0xd2, 0xaf, 0x92, 0x07, 0x3d,
+	0xea, 0x6f, 0x9a, 0x5d, 0x9f, 0x09, 0x86, 0x2f, 0x86, 0x00, 0x53, 0x01, 0xcc, 0x18, 0x60, 0x46,
+	0x00, 0xb3, 0x5f, 0x2d, 0x9d, 0xb4, 0x36, 0x5c, 0x8f, 0x91, 0xf0, 0x4f, 0x89, 0x2b, 0x5d, 0xb1,
+	0x19, 0xdf, 0x60, 0x9c, 0xb4, 0x2d, 0x4e, 0x65, 0x40, 0xd2, 0xaf, 0xb6, 0xa9, 0xb0, 0xaa, 0xa4,
+	0x6b, 0x75, 0x5c, 0xcf, 0x12, 0x2e, 0xf3, 0xd4, 0xdb, 0xb2, 0x7a, 0x1b, 0x3d, 0x4b, 0x12, 0x28,
+	0xcd, 0x74, 0x58, 0x87, 0x85, 0x3f, 0x49, 0xf0, 0x4b, 0x9d, 0x9e, 0xeb, 0x30, 0xd6, 0x59, 0xa7,
+	0xc4, 0xea, 0xba, 0xc4, 0xf2, 0x3c, 0x26, 0xc2, 0x78, 0x5c, 0xdd, 0xd6, 0x46, 0x51, 0x19, 0x0b,
+	0x90, 0x98, 0xf9, 0x2c, 0xc6, 0x13, 0x3e, 0x5b, 0x5f, 0xa7, 0x3e, 0xb1, 0xec, 0x20, 0x36, 0xe9,
+	0xd7, 0x88, 0xeb, 0x09, 0xda, 0xf1, 0x2d, 0x0d, 0xaa, 0x64, 0x41, 0x3e, 0x0d, 0x42, 0xbb, 0x8e,
+	0xbc, 0x37, 0xca, 0x70, 0xf6, 0xad, 0x40, 0xcb, 0x9a, 0xd5, 0xe3, 0xd4, 0xb9, 0x15, 0x06, 0xe2,
+	0x4d, 0xfa, 0xa0, 0x47, 0xb9, 0x30, 0x6c, 0x28, 0x0d, 0xbb, 0xe4, 0x5d, 0xe6, 0x71, 0x8a, 0xdf,
+	0x00, 0x90, 0x89, 0x5b, 0xae, 0xc3, 0x67, 0xd1, 0x85, 0x23, 0x73, 0xc7, 0x6b, 0xe7, 0xcd, 0x6c,
+	0x37, 0x7c, 0x6a, 0xf6, 0xab, 0xa6, 0xc4, 0x36, 0x6e, 0xd7, 0x27, 0xbf, 0xfa, 0xfb, 0x9b, 0x2b,
+	0xa8, 0x59, 0x94, 0xc8, 0x86, 0xc3, 0x8d, 0xeb, 0x2a, 0x49, 0x83, 0xcb, 0x47, 0x32, 0x99, 0xa2,
+	0x80, 0xcb, 0x50, 0x8c, 0x93, 0xcc, 0xa2, 0x0b, 0x68, 0xae, 0xd8, 0x9c, 0x8a, 0xb0, 0xc6, 0x22,
+	0x94, 0x87, 0x42, 0x15, 0xc1, 0x32, 0x14, 0x5d, 0xde, 0xea, 0x86, 0x87, 0x21, 0x76, 0xaa, 0x39,
+	0xe5, 0x72, 0xf9, 0xc8, 0x68, 0xc1, 0xa9, 0x10, 0xdb, 0x64, 0x3d, 0x41, 0xef, 0x50, 0x1a, 0x89,
+	0xc6, 0x77, 0x00, 0x74, 0xff, 0x43, 0xd8, 0x74, 0xed, 0x92, 0x29, 0x0d, 0x60, 0x06, 0x66, 0x31,
+	0x65, 0xf3, 0x95, 0x59, 0xcc, 0x35, 0xab, 0x43, 0x15, 0xb6, 0x99, 0x40, 0x1a, 0xdf, 0x23, 0x38,
+	0x9d, 0xcd, 0xa0, 0x88, 0xbd, 0x0b, 0xe0, 0x07, 0x87, 0xad, 0xf7, 0x29, 0x95, 0x95, 0x9b, 0xae,
+	0x5d, 0x35, 0x47, 0xf0, 0xb1, 0x19, 0xc5, 0xaa, 0x17, 0x77, 0x7e, 0x3f, 0x3f, 0xa1, 0x6a, 0xe9,
+	0x47, 0x09, 0xf0, 0x9b, 0x29, 0xee, 0x85, 0x90, 0xfb, 0xe5, 0x7f, 0xe5, 0x2e, 0x59, 0xa5, 0xc8,
+	0x3f, 0x2c, 0xc0, 0x4c, 0x8a, 0x7c, 0x54, 0x9d, 0x97, 0x01, 0x73, 0xd6, 0xf3, 0x6d, 0xda, 0x0a,
+	0xfd, 0x63, 0xb3, 0x75, 0xdd, 0x98, 0x13, 0xf2, 0x66, 0x4d, 0x5d, 0x34, 0x1c, 0x7c, 0x0d, 0x4e,
+	0xab, 0xd7, 0x36, 0xeb, 0x79, 0x82, 0xfa, 0x5d, 0xcb, 0x17, 0x9b, 0x01, 0xa2, 0x10, 0x22, 0x66,
+	0xe4, 0xed, 0x6a, 0xe2, 0xb2, 0xe1, 0xe0, 0x05, 0x38, 0xe3, 0x50, 0x2e, 0x14, 0x97, 0x54, 0xa2,
+	0x23, 0x21, 0xec, 0x54, 0xe2, 0x3a, 0x91, 0x6d, 0x05, 0xca, 0x49, 0x5c, 0x36, 0xe5, 0xd1, 0x10,
+	0x7b, 0x36, 0xf1, 0x24, 0x93, 0x77, 0x06, 0x26, 0x1d, 0xea, 0xb1, 0x8d, 0xd9, 0xc9, 0xf0, 0xa5,
+	0xfc, 0x8b, 0xe1, 0x66, 0x8c, 0x12, 0x77, 0x71, 0x0d, 0x8a, 0x71, 0x17, 0x95, 0x4f, 0xc6, 0x6c,
+	0xe2, 0xd1, 0xa0, 0x89, 0xcd, 0xa9, 0xa8, 0x7f, 0xc6, 0x7d, 0xa8, 0xa4, 0x1d, 0xb3, 0x1a, 0xfc,
+	0xfb, 0xb6, 0x05, 0x75, 0x0e, 0xdb, 0x9c, 0x8f, 0x11, 0x9c, 0xdf, 0x37, 0x95, 0xd2, 0xd7, 0x82,
+	0xa2, 0x1d, 0x1d, 0x2a, 0x93, 0x2e, 0x8c, 0xa5, 0x2f, 0x0e, 0x99, 0x72, 0x6b, 0x1c, 0xf3, 0xf0,
+	0xdc, 0xfa, 0x49, 0x01, 0x9e, 0x4f, 0xa9, 0x19, 0xa8, 0xdb, 0xff, 0xcf, 0xb6, 0x1f, 0x42, 0x65,
+	0xbf, 0x92, 0xa8, 0xfe, 0xde, 0x4b, 0xf7, 0x17, 0x1d, 0xa0, 0xbf, 0xd2, 0xc8, 0x3a, 0x9c, 0x61,
+	0xc1, 0x19, 0xf9, 0x65, 0x8e, 0xe7, 0xd1, 0xa1, 0x7f, 0x5f, 0x7f, 0x44, 0x30, 0x3b, 0x98, 0x43,
+	0x69, 0x7b, 0x0f, 0xa6, 0xf5, 0x28, 0x8c, 0x3e, 0xb1, 0xe6, 0x80, 0xba, 0x68, 0x82, 0x9a, 0x72,
+	0xa4, 0x98, 0xfd, 0x9a, 0xa9, 0xa3, 0x25, 0x5d, 0x9b, 0x8c, 0x76, 0x78, 0xbe, 0xbd, 0xa1, 0x26,
+	0x84, 0xce, 0x19, 0x15, 0xe9, 0x22, 0x1c, 0xd3, 0x19, 0xb5, 0x55, 0x9f, 0xd5, 0x87, 0x0d, 0xc7,
+	0x60, 0x03, 0x45, 0x8e, 0xf5, 0xbf, 0x03, 0xa0, 0x9f, 0xaa, 0x22, 0x8f, 0x2b, 0x5f, 0x36, 0x35,
+	0x11, 0xc7, 0xa8, 0x47, 0xf3, 0x36, 0x3e, 0x7a, 0x5b, 0x58, 0x82, 0x8f, 0x45, 0xba, 0x07, 0xe7,
+	0x86, 0xc7, 0x50, 0xcc, 0xef, 0xc2, 0x24, 0x0f, 0x0e, 0x54, 0xcf, 0xae, 0x8d, 0xe4, 0xc8, 0x4c,
+	0xb0, 0x64, 0xe7, 0x64, 0xb4, 0xda, 0xb7, 0x27, 0x60, 0x32, 0xcc, 0x8b, 0x77, 0x10, 0x1c, 0x4b,
+	0x2d, 0x34, 0x78, 0x65, 0xa4, 0x1c, 0xfb, 0xae, 0x49, 0xa5, 0xd7, 0x73, 0xe3, 0xa5, 0x66, 0x63,
+	0xe1, 0x61, 0x40, 0xf5, 0xa3, 0x9f, 0xff, 0x7a, 0x54, 0x78, 0x09, 0xbf, 0x98, 0xd9, 0x80, 0x93,
+	0x1b, 0xa1, 0xdc, 0x65, 0xd4, 0xbe, 0xc7, 0xf1, 0x2f, 0x08, 0x8e, 0xa7, 0x77, 0x1f, 0x3c, 0x06,
+	0x97, 0xa1, 0x0b, 0x57, 0xe9, 0x66, 0xfe, 0x00, 0x4a, 0x4d, 0x5d, 0xab, 0x79, 0x15, 0xbf, 0x32,
+	0xb2, 0x1a, 0xb2, 0x15, 0x2f, 0x7a, 0xdb, 0xf8, 0x3b, 0x04, 0xc5, 0x78, 0x34, 0xe1, 0xc5, 0xd1,
+	0x39, 0x65, 0xd7, 0xb9, 0xd2, 0x52, 0x2e, 0xac, 0x92, 0x52, 0xd3, 0x52, 0x2e, 0xe3, 0x17, 0x9e,
+	0x22, 0x45, 0xaf, 0x73, 0xf8, 0xcb, 0x02, 0x4c, 0x45, 0x91, 0xf0, 0xf5, 0xf1, 0xb3, 0x47, 0xc4,
+	0x17, 0xf3, 0x40, 0x15, 0xef, 0x2f, 0x90, 0x26, 0xfe, 0x29, 0xc2, 0x8f, 0xd0, 0x48, 0xd4, 0xc9,
+	0xd6, 0xe0, 0x8c, 0xdc, 0x8e, 0x0f, 0x33, 0x73, 0x69, 0x9b, 0x6c, 0xed, 0x33, 0xed, 0x32, 0x37,
+	0xc3, 0x70, 0x1e, 0xdb, 0xd8, 0xc6, 0xbf, 0x21, 0xc0, 0x83, 0xeb, 0x07, 0x5e, 0xcd, 0xd1, 0xb0,
+	0xec, 0xbc, 0x2f, 0xdd, 0x3e, 0x58, 0x10, 0x55, 0xc6, 0x65, 0x5d, 0xc5, 0x2a, 0x26, 0x23, 0xd5,
+	0xb0, 0xa5, 0xd7, 0x9b, 0xc7, 0x05, 0x38, 0x39, 0x30, 0x2a, 0x71, 0x7d, 0x7c, 0x66, 0x03, 0xea,
+	0x56, 0x0f, 0x14, 0x43, 0x89, 0xfb, 0x3a, 0xe1, 0x91, 0xcf, 0x11, 0xfe, 0x0c, 0x8d, 0xa9, 0xef,
+	0x3f, 0x76, 0xcb, 0x0f, 0x08, 0xa6, 0x13, 0x93, 0x1e, 0x2f, 0x8f, 0xf1, 0x9d, 0x1a, 0x58, 0x42,
+	0x4a, 0x37, 0x72, 0xa2, 0x55, 0xed, 0xe6, 0x75, 0xe9, 0xe6, 0xf0, 0xa5, 0xa7, 0x14, 0x2e, 0xb9,
+	0x36, 0xfc, 0x84, 0x00, 0x74, 0x30, 0xbc, 0x94, 0x87, 0x42, 0xc4, 0x7f, 0x39, 0x1f, 0x58, 0xd1,
+	0x5f, 0xd5, 0xf4, 0x5f, 0xc3, 0x0b, 0xa3, 0xd1, 0x27, 0x5b, 0xa9, 0xd9, 0xbe, 0x8d, 0xff, 0x44,
+	0xf0, 0x5c, 0x66, 0xee, 0xe2, 0x9b, 0x79, 0x68, 0x25, 0x77, 0x88, 0xd2, 0xad, 0x03, 0x44, 0x50,
+	0xea, 0x1a, 0x5a, 0xdd, 0x0a, 0x5e, 0xce, 0xa7, 0x8e, 0x84, 0x5b, 0x43, 0xfd, 0xee, 0xce, 0x6e,
+	0x05, 0x3d, 0xd9, 0xad, 0xa0, 0x3f, 0x76, 0x2b, 0xe8, 0xe3, 0xbd, 0xca, 0xc4, 0x93, 0xbd, 0xca,
+	0xc4, 0xaf, 0x7b, 0x95, 0x89, 0x7b, 0x4b, 0x1d, 0x57, 0xdc, 0xef, 0xb5, 0x03, 0x7e, 0x32, 0xc3,
+	0x55, 0x8b, 0x73, 0x2a, 0x78, 0x9c, 0xa8, 0x5f, 0x23, 0x62, 0xb3, 0x4b, 0xf9, 0x90, 0xff, 0xdb,
+	0x69, 0x3f, 0x13, 0xfa, 0x7f, 0xfe, 0x9f, 0x01, 0x00, 0x8f, 0x12, 0x43, 0xe9, 0xec, 0x12, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	PausedActions(ctx context.Context, in *QueryPausedActionsRequest, opts ...grpc.CallOption) (*QueryPausedActionsResponse, error)
+	IsActionPaused(ctx context.Context, in *QueryIsActionPausedRequest, opts ...grpc.CallOption) (*QueryIsActionPausedResponse, error)
+	// RouteFees retrieves all the route fees.
+	RouteFees(ctx context.Context, in *QueryRouteFeesRequest, opts ...grpc.CallOption) (*QueryRouteFeesResponse, error)
+	// RouteFee retrieves the fee of a route.
+	RouteFee(ctx context.Context, in *QueryRouteFeeRequest, opts ...grpc.CallOption) (*QueryRouteFeeResponse, error)
+	// RouteFeesCollected retrieves the total fees collected for all the routes.
+	RouteFeesCollected(ctx context.Context, in *QueryRouteFeesCollectedRequest, opts ...grpc.CallOption) (*QueryRouteFeesCollectedResponse, error)
+	// RouteFeeCollected retrieves the total fee collected for a route.
+	RouteFeeCollected(ctx context.Context, in *QueryRouteFeeCollectedRequest, opts ...grpc.CallOption) (*QueryRouteFeeCollectedResponse, error)
+	// Integrators retrieves all the registered integrators.
+	Integrators(ctx context.Context, in *QueryIntegratorsRequest, opts ...grpc.CallOption) (*QueryIntegratorsResponse, error)
+	// Integrator retrieves a registered integrator.
+	Integrator(ctx context.Context, in *QueryIntegratorRequest, opts ...grpc.CallOption) (*QueryIntegratorResponse, error)
+	// IntegratorStats retrieves the volume stats of an integrator per denom.
+	IntegratorStats(ctx context.Context, in *QueryIntegratorStatsRequest, opts ...grpc.CallOption) (*QueryIntegratorStatsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PausedActions(ctx context.Context, in *QueryPausedActionsRequest, opts ...grpc.CallOption) (*QueryPausedActionsResponse, error) {
+	out := new(QueryPausedActionsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/PausedActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsActionPaused(ctx context.Context, in *QueryIsActionPausedRequest, opts ...grpc.CallOption) (*QueryIsActionPausedResponse, error) {
+	out := new(QueryIsActionPausedResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/IsActionPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RouteFees(ctx context.Context, in *QueryRouteFeesRequest, opts ...grpc.CallOption) (*QueryRouteFeesResponse, error) {
+	out := new(QueryRouteFeesResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/RouteFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RouteFee(ctx context.Context, in *QueryRouteFeeRequest, opts ...grpc.CallOption) (*QueryRouteFeeResponse, error) {
+	out := new(QueryRouteFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/RouteFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RouteFeesCollected(ctx context.Context, in *QueryRouteFeesCollectedRequest, opts ...grpc.CallOption) (*QueryRouteFeesCollectedResponse, error) {
+	out := new(QueryRouteFeesCollectedResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/RouteFeesCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RouteFeeCollected(ctx context.Context, in *QueryRouteFeeCollectedRequest, opts ...grpc.CallOption) (*QueryRouteFeeCollectedResponse, error) {
+	out := new(QueryRouteFeeCollectedResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/RouteFeeCollected", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Integrators(ctx context.Context, in *QueryIntegratorsRequest, opts ...grpc.CallOption) (*QueryIntegratorsResponse, error) {
+	out := new(QueryIntegratorsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/Integrators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Integrator(ctx context.Context, in *QueryIntegratorRequest, opts ...grpc.CallOption) (*QueryIntegratorResponse, error) {
+	out := new(QueryIntegratorResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/Integrator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegratorStats(ctx context.Context, in *QueryIntegratorStatsRequest, opts ...grpc.CallOption) (*QueryIntegratorStatsResponse, error) {
+	out := new(QueryIntegratorStatsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Query/IntegratorStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	PausedActions(context.Context, *QueryPausedActionsRequest) (*QueryPausedActionsResponse, error)
+	IsActionPaused(context.Context, *QueryIsActionPausedRequest) (*QueryIsActionPausedResponse, error)
+	// RouteFees retrieves all the route fees.
+	RouteFees(context.Context, *QueryRouteFeesRequest) (*QueryRouteFeesResponse, error)
+	// RouteFee retrieves the fee of a route.
+	RouteFee(context.Context, *QueryRouteFeeRequest) (*QueryRouteFeeResponse, error)
+	// RouteFeesCollected retrieves the total fees collected for all the routes.
+	RouteFeesCollected(context.Context, *QueryRouteFeesCollectedRequest) (*QueryRouteFeesCollectedResponse, error)
+	// RouteFeeCollected retrieves the total fee collected for a route.
+	RouteFeeCollected(context.Context, *QueryRouteFeeCollectedRequest) (*QueryRouteFeeCollectedResponse, error)
+	// Integrators retrieves all the registered integrators.
+	Integrators(context.Context, *QueryIntegratorsRequest) (*QueryIntegratorsResponse, error)
+	// Integrator retrieves a registered integrator.
+	Integrator(context.Context, *QueryIntegratorRequest) (*QueryIntegratorResponse, error)
+	// IntegratorStats retrieves the volume stats of an integrator per denom.
+	IntegratorStats(context.Context, *QueryIntegratorStatsRequest) (*QueryIntegratorStatsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PausedActions(ctx context.Context, req *QueryPausedActionsRequest) (*QueryPausedActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedActions not implemented")
+}
+func (*UnimplementedQueryServer) IsActionPaused(ctx context.Context, req *QueryIsActionPausedRequest) (*QueryIsActionPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsActionPaused not implemented")
+}
+func (*UnimplementedQueryServer) RouteFees(ctx context.Context, req *QueryRouteFeesRequest) (*QueryRouteFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteFees not implemented")
+}
+func (*UnimplementedQueryServer) RouteFee(ctx context.Context, req *QueryRouteFeeRequest) (*QueryRouteFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteFee not implemented")
+}
+func (*UnimplementedQueryServer) RouteFeesCollected(ctx context.Context, req *QueryRouteFeesCollectedRequest) (*QueryRouteFeesCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteFeesCollected not implemented")
+}
+func (*UnimplementedQueryServer) RouteFeeCollected(ctx context.Context, req *QueryRouteFeeCollectedRequest) (*QueryRouteFeeCollectedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RouteFeeCollected not implemented")
+}
+func (*UnimplementedQueryServer) Integrators(ctx context.Context, req *QueryIntegratorsRequest) (*QueryIntegratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integrators not implemented")
+}
+func (*UnimplementedQueryServer) Integrator(ctx context.Context, req *QueryIntegratorRequest) (*QueryIntegratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Integrator not implemented")
+}
+func (*UnimplementedQueryServer) IntegratorStats(ctx context.Context, req *QueryIntegratorStatsRequest) (*QueryIntegratorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegratorStats not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PausedActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/PausedActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedActions(ctx, req.(*QueryPausedActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsActionPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsActionPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsActionPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/IsActionPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsActionPaused(ctx, req.(*QueryIsActionPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RouteFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/RouteFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteFees(ctx, req.(*QueryRouteFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/RouteFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteFee(ctx, req.(*QueryRouteFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RouteFeesCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteFeesCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteFeesCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/RouteFeesCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteFeesCollected(ctx, req.(*QueryRouteFeesCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RouteFeeCollected_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRouteFeeCollectedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RouteFeeCollected(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/RouteFeeCollected",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RouteFeeCollected(ctx, req.(*QueryRouteFeeCollectedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Integrators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Integrators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/Integrators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Integrators(ctx, req.(*QueryIntegratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Integrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Integrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/Integrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Integrator(ctx, req.(*QueryIntegratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegratorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegratorStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegratorStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Query/IntegratorStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegratorStats(ctx, req.(*QueryIntegratorStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.orbiter.component.executor.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PausedActions",
+			Handler:    _Query_PausedActions_Handler,
+		},
+		{
+			MethodName: "IsActionPaused",
+			Handler:    _Query_IsActionPaused_Handler,
+		},
+		{
+			MethodName: "RouteFees",
+			Handler:    _Query_RouteFees_Handler,
+		},
+		{
+			MethodName: "RouteFee",
+			Handler:    _Query_RouteFee_Handler,
+		},
+		{
+			MethodName: "RouteFeesCollected",
+			Handler:    _Query_RouteFeesCollected_Handler,
+		},
+		{
+			MethodName: "RouteFeeCollected",
+			Handler:    _Query_RouteFeeCollected_Handler,
+		},
+		{
+			MethodName: "Integrators",
+			Handler:    _Query_Integrators_Handler,
+		},
+		{
+			MethodName: "Integrator",
+			Handler:    _Query_Integrator_Handler,
+		},
+		{
+			MethodName: "IntegratorStats",
+			Handler:    _Query_IntegratorStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/orbiter/component/executor/v1/query.proto",
+}
+
+func (m *QueryPausedActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActionIds) > 0 {
+		dAtA2 := make([]byte, len(m.ActionIds)*10)
+		var j1 int
+		for _, num := range m.ActionIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsActionPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsActionPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsActionPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ActionId) > 0 {
+		i -= len(m.ActionId)
+		copy(dAtA[i:], m.ActionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ActionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsActionPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsActionPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsActionPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RouteFees) > 0 {
+		for iNdEx := len(m.RouteFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationCounterpartyId) > 0 {
+		i -= len(m.DestinationCounterpartyId)
+		copy(dAtA[i:], m.DestinationCounterpartyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationCounterpartyId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationProtocolId) > 0 {
+		i -= len(m.DestinationProtocolId)
+		copy(dAtA[i:], m.DestinationProtocolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationProtocolId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceCounterpartyId) > 0 {
+		i -= len(m.SourceCounterpartyId)
+		copy(dAtA[i:], m.SourceCounterpartyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceCounterpartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceProtocolId) > 0 {
+		i -= len(m.SourceProtocolId)
+		copy(dAtA[i:], m.SourceProtocolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceProtocolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RouteFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteFeesCollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteFeesCollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteFeesCollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteFeesCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteFeesCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteFeesCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Collected) > 0 {
+		for iNdEx := len(m.Collected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteFeeCollectedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteFeeCollectedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteFeeCollectedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationCounterpartyId) > 0 {
+		i -= len(m.DestinationCounterpartyId)
+		copy(dAtA[i:], m.DestinationCounterpartyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationCounterpartyId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DestinationProtocolId) > 0 {
+		i -= len(m.DestinationProtocolId)
+		copy(dAtA[i:], m.DestinationProtocolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationProtocolId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceCounterpartyId) > 0 {
+		i -= len(m.SourceCounterpartyId)
+		copy(dAtA[i:], m.SourceCounterpartyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceCounterpartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceProtocolId) > 0 {
+		i -= len(m.SourceProtocolId)
+		copy(dAtA[i:], m.SourceProtocolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceProtocolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRouteFeeCollectedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRouteFeeCollectedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRouteFeeCollectedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collected.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegratorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegratorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegratorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegratorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegratorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegratorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Integrators) > 0 {
+		for iNdEx := len(m.Integrators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Integrators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegratorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegratorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegratorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntegratorId) > 0 {
+		i -= len(m.IntegratorId)
+		copy(dAtA[i:], m.IntegratorId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IntegratorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Integrator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegratorStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegratorStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegratorStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntegratorId) > 0 {
+		i -= len(m.IntegratorId)
+		copy(dAtA[i:], m.IntegratorId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IntegratorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegratorStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegratorStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegratorStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPausedActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActionIds) > 0 {
+		l = 0
+		for _, e := range m.ActionIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryIsActionPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsActionPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRouteFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRouteFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RouteFees) > 0 {
+		for _, e := range m.RouteFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRouteFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceProtocolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceCounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationProtocolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationCounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRouteFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RouteFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRouteFeesCollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRouteFeesCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Collected) > 0 {
+		for _, e := range m.Collected {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRouteFeeCollectedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceProtocolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceCounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationProtocolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationCounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRouteFeeCollectedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Collected.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntegratorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegratorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Integrators) > 0 {
+		for _, e := range m.Integrators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegratorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntegratorId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Integrator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntegratorStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntegratorId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegratorStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPausedActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v core.ActionID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= core.ActionID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ActionIds = append(m.ActionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ActionIds) == 0 {
+					m.ActionIds = make([]core.ActionID, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v core.ActionID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= core.ActionID(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ActionIds = append(m.ActionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsActionPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsActionPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsActionPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsActionPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsActionPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsActionPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteFees = append(m.RouteFees, RouteFee{})
+			if err := m.RouteFees[len(m.RouteFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RouteFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteFeesCollectedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteFeesCollectedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteFeesCollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteFeesCollectedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteFeesCollectedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteFeesCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collected = append(m.Collected, RouteFeeCollected{})
+			if err := m.Collected[len(m.Collected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteFeeCollectedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteFeeCollectedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteFeeCollectedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRouteFeeCollectedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRouteFeeCollectedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRouteFeeCollectedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegratorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegratorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegratorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryPausedActionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegratorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -576,12 +3847,82 @@ func (m *QueryPausedActionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausedActionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegratorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausedActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegratorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integrators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Integrators = append(m.Integrators, action.Integrator{})
+			if err := m.Integrators[len(m.Integrators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -603,7 +3944,7 @@ func (m *QueryPausedActionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPausedActionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegratorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -626,81 +3967,127 @@ func (m *QueryPausedActionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausedActionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegratorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausedActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v core.ActionID
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= core.ActionID(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				m.ActionIds = append(m.ActionIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegratorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegratorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegratorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.ActionIds) == 0 {
-					m.ActionIds = make([]core.ActionID, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v core.ActionID
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= core.ActionID(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ActionIds = append(m.ActionIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Integrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -722,7 +4109,7 @@ func (m *QueryPausedActionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsActionPausedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegratorStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -745,15 +4132,15 @@ func (m *QueryIsActionPausedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsActionPausedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegratorStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsActionPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegratorStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -781,7 +4168,7 @@ func (m *QueryIsActionPausedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionId = string(dAtA[iNdEx:postIndex])
+			m.IntegratorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -804,7 +4191,7 @@ func (m *QueryIsActionPausedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsActionPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegratorStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -827,17 +4214,17 @@ func (m *QueryIsActionPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsActionPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegratorStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsActionPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegratorStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -847,12 +4234,26 @@ func (m *QueryIsActionPausedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPaused = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, IntegratorStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
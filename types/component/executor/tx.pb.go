@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	action "github.com/noble-assets/orbiter/v2/types/controller/action"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -187,11 +188,347 @@ func (m *MsgUnpauseActionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnpauseActionResponse proto.InternalMessageInfo
 
+// MsgSetRouteFee sets the fee applied to the transfers of a denom
+// from a source cross-chain ID to a destination cross-chain ID.
+type MsgSetRouteFee struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Bridge protocol of the source.
+	SourceProtocolId string `protobuf:"bytes,2,opt,name=source_protocol_id,json=sourceProtocolId,proto3" json:"source_protocol_id,omitempty"`
+	// Identifier of the source for the protocol.
+	SourceCounterpartyId string `protobuf:"bytes,3,opt,name=source_counterparty_id,json=sourceCounterpartyId,proto3" json:"source_counterparty_id,omitempty"`
+	// Bridge protocol of the destination.
+	DestinationProtocolId string `protobuf:"bytes,4,opt,name=destination_protocol_id,json=destinationProtocolId,proto3" json:"destination_protocol_id,omitempty"`
+	// Identifier of the destination for the protocol.
+	DestinationCounterpartyId string `protobuf:"bytes,5,opt,name=destination_counterparty_id,json=destinationCounterpartyId,proto3" json:"destination_counterparty_id,omitempty"`
+	// Noble denom received from the source.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Fee applied to the transfers of the route.
+	Fee action.RouteFeeAttributes `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MsgSetRouteFee) Reset()         { *m = MsgSetRouteFee{} }
+func (m *MsgSetRouteFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRouteFee) ProtoMessage()    {}
+func (*MsgSetRouteFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_630653b2e43f5e18, []int{4}
+}
+func (m *MsgSetRouteFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRouteFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRouteFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRouteFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRouteFee.Merge(m, src)
+}
+func (m *MsgSetRouteFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRouteFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRouteFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRouteFee proto.InternalMessageInfo
+
+// MsgSetRouteFeeResponse is the response type from a MsgSetRouteFee request.
+type MsgSetRouteFeeResponse struct {
+}
+
+func (m *MsgSetRouteFeeResponse) Reset()         { *m = MsgSetRouteFeeResponse{} }
+func (m *MsgSetRouteFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRouteFeeResponse) ProtoMessage()    {}
+func (*MsgSetRouteFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_630653b2e43f5e18, []int{5}
+}
+func (m *MsgSetRouteFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRouteFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRouteFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRouteFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRouteFeeResponse.Merge(m, src)
+}
+func (m *MsgSetRouteFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRouteFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRouteFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRouteFeeResponse proto.InternalMessageInfo
+
+// MsgRemoveRouteFee removes the fee applied to the transfers of a denom
+// from a source cross-chain ID to a destination cross-chain ID.
+type MsgRemoveRouteFee struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Bridge protocol of the source.
+	SourceProtocolId string `protobuf:"bytes,2,opt,name=source_protocol_id,json=sourceProtocolId,proto3" json:"source_protocol_id,omitempty"`
+	// Identifier of the source for the protocol.
+	SourceCounterpartyId string `protobuf:"bytes,3,opt,name=source_counterparty_id,json=sourceCounterpartyId,proto3" json:"source_counterparty_id,omitempty"`
+	// Bridge protocol of the destination.
+	DestinationProtocolId string `protobuf:"bytes,4,opt,name=destination_protocol_id,json=destinationProtocolId,proto3" json:"destination_protocol_id,omitempty"`
+	// Identifier of the destination for the protocol.
+	DestinationCounterpartyId string `protobuf:"bytes,5,opt,name=destination_counterparty_id,json=destinationCounterpartyId,proto3" json:"destination_counterparty_id,omitempty"`
+	// Noble denom received from the source.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveRouteFee) Reset()         { *m = MsgRemoveRouteFee{} }
+func (m *MsgRemoveRouteFee) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRouteFee) ProtoMessage()    {}
+func (*MsgRemoveRouteFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_630653b2e43f5e18, []int{6}
+}
+func (m *MsgRemoveRouteFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRouteFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRouteFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRouteFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRouteFee.Merge(m, src)
+}
+func (m *MsgRemoveRouteFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRouteFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRouteFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRouteFee proto.InternalMessageInfo
+
+// MsgRemoveRouteFeeResponse is the response type from a MsgRemoveRouteFee request.
+type MsgRemoveRouteFeeResponse struct {
+}
+
+func (m *MsgRemoveRouteFeeResponse) Reset()         { *m = MsgRemoveRouteFeeResponse{} }
+func (m *MsgRemoveRouteFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRouteFeeResponse) ProtoMessage()    {}
+func (*MsgRemoveRouteFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_630653b2e43f5e18, []int{7}
+}
+func (m *MsgRemoveRouteFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRouteFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRouteFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRouteFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRouteFeeResponse.Merge(m, src)
+}
+func (m *MsgRemoveRouteFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRouteFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRouteFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRouteFeeResponse proto.InternalMessageInfo
+
+// MsgSetIntegrator registers or updates an integrator.
+type MsgSetIntegrator struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Integrator to register.
+	Integrator action.Integrator `protobuf:"bytes,2,opt,name=integrator,proto3" json:"integrator"`
+}
+
+func (m *MsgSetIntegrator) Reset()         { *m = MsgSetIntegrator{} }
+func (m *MsgSetIntegrator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIntegrator) ProtoMessage()    {}
+func (*MsgSetIntegrator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_630653b2e43f5e18, []int{8}
+}
+func (m *MsgSetIntegrator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIntegrator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIntegrator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIntegrator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIntegrator.Merge(m, src)
+}
+func (m *MsgSetIntegrator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIntegrator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIntegrator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIntegrator proto.InternalMessageInfo
+
+// MsgSetIntegratorResponse is the response type from a MsgSetIntegrator request.
+type MsgSetIntegratorResponse struct {
+}
+
+func (m *MsgSetIntegratorResponse) Reset()         { *m = MsgSetIntegratorResponse{} }
+func (m *MsgSetIntegratorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetIntegratorResponse) ProtoMessage()    {}
+func (*MsgSetIntegratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_630653b2e43f5e18, []int{9}
+}
+func (m *MsgSetIntegratorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetIntegratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetIntegratorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetIntegratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetIntegratorResponse.Merge(m, src)
+}
+func (m *MsgSetIntegratorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetIntegratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetIntegratorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetIntegratorResponse proto.InternalMessageInfo
+
+// MsgRemoveIntegrator removes a registered integrator.
+type MsgRemoveIntegrator struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Identifier of the integrator to remove.
+	IntegratorId string `protobuf:"bytes,2,opt,name=integrator_id,json=integratorId,proto3" json:"integrator_id,omitempty"`
+}
+
+func (m *MsgRemoveIntegrator) Reset()         { *m = MsgRemoveIntegrator{} }
+func (m *MsgRemoveIntegrator) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveIntegrator) ProtoMessage()    {}
+func (*MsgRemoveIntegrator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_630653b2e43f5e18, []int{10}
+}
+func (m *MsgRemoveIntegrator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveIntegrator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveIntegrator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveIntegrator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveIntegrator.Merge(m, src)
+}
+func (m *MsgRemoveIntegrator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveIntegrator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveIntegrator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveIntegrator proto.InternalMessageInfo
+
+// MsgRemoveIntegratorResponse is the response type from a MsgRemoveIntegrator request.
+type MsgRemoveIntegratorResponse struct {
+}
+
+func (m *MsgRemoveIntegratorResponse) Reset()         { *m = MsgRemoveIntegratorResponse{} }
+func (m *MsgRemoveIntegratorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveIntegratorResponse) ProtoMessage()    {}
+func (*MsgRemoveIntegratorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_630653b2e43f5e18, []int{11}
+}
+func (m *MsgRemoveIntegratorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveIntegratorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveIntegratorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveIntegratorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveIntegratorResponse.Merge(m, src)
+}
+func (m *MsgRemoveIntegratorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveIntegratorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveIntegratorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveIntegratorResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgPauseAction)(nil), "noble.orbiter.component.executor.v1.MsgPauseAction")
 	proto.RegisterType((*MsgPauseActionResponse)(nil), "noble.orbiter.component.executor.v1.MsgPauseActionResponse")
 	proto.RegisterType((*MsgUnpauseAction)(nil), "noble.orbiter.component.executor.v1.MsgUnpauseAction")
 	proto.RegisterType((*MsgUnpauseActionResponse)(nil), "noble.orbiter.component.executor.v1.MsgUnpauseActionResponse")
+	proto.RegisterType((*MsgSetRouteFee)(nil), "noble.orbiter.component.executor.v1.MsgSetRouteFee")
+	proto.RegisterType((*MsgSetRouteFeeResponse)(nil), "noble.orbiter.component.executor.v1.MsgSetRouteFeeResponse")
+	proto.RegisterType((*MsgRemoveRouteFee)(nil), "noble.orbiter.component.executor.v1.MsgRemoveRouteFee")
+	proto.RegisterType((*MsgRemoveRouteFeeResponse)(nil), "noble.orbiter.component.executor.v1.MsgRemoveRouteFeeResponse")
+	proto.RegisterType((*MsgSetIntegrator)(nil), "noble.orbiter.component.executor.v1.MsgSetIntegrator")
+	proto.RegisterType((*MsgSetIntegratorResponse)(nil), "noble.orbiter.component.executor.v1.MsgSetIntegratorResponse")
+	proto.RegisterType((*MsgRemoveIntegrator)(nil), "noble.orbiter.component.executor.v1.MsgRemoveIntegrator")
+	proto.RegisterType((*MsgRemoveIntegratorResponse)(nil), "noble.orbiter.component.executor.v1.MsgRemoveIntegratorResponse")
 }
 
 func init() {
@@ -199,33 +536,58 @@ func init() {
 }
 
 var fileDescriptor_630653b2e43f5e18 = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc9, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0xcf, 0x2f, 0x4a, 0xca, 0x2c, 0x49, 0x2d, 0xd2, 0x4f, 0xce, 0xcf, 0x2d, 0xc8, 0xcf,
-	0x4b, 0xcd, 0x2b, 0xd1, 0x4f, 0xad, 0x48, 0x4d, 0x2e, 0x2d, 0xc9, 0x2f, 0xd2, 0x2f, 0x33, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x06, 0xab, 0xd6, 0x83, 0xaa, 0xd6,
-	0x83, 0xab, 0xd6, 0x83, 0xa9, 0xd6, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7,
-	0x07, 0x93, 0x10, 0x7d, 0x52, 0xe2, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xfa, 0xb9, 0xc5, 0xe9,
-	0x20, 0xf3, 0x72, 0x8b, 0xd3, 0xa1, 0x12, 0x92, 0x10, 0x89, 0x78, 0x30, 0x4f, 0x1f, 0xc2, 0x81,
-	0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x43, 0xc4, 0x41, 0x2c, 0x88, 0xa8, 0xd2, 0x54, 0x46, 0x2e,
-	0x3e, 0xdf, 0xe2, 0xf4, 0x80, 0xc4, 0xd2, 0xe2, 0x54, 0xc7, 0xe4, 0x92, 0xcc, 0xfc, 0x3c, 0x21,
-	0x03, 0x2e, 0xb6, 0xe2, 0xcc, 0xf4, 0xbc, 0xd4, 0x22, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27,
-	0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x46, 0x39, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97,
-	0x14, 0x65, 0xe6, 0xa5, 0x07, 0x41, 0xd5, 0x09, 0x49, 0x73, 0x71, 0x26, 0x82, 0xf5, 0xc6, 0x67,
-	0xa6, 0x48, 0x30, 0x81, 0x34, 0x05, 0x71, 0x40, 0x04, 0x3c, 0x53, 0xac, 0x4c, 0x3a, 0x16, 0xc8,
-	0x33, 0xbc, 0x58, 0x20, 0xcf, 0xd0, 0xf4, 0x7c, 0x83, 0x16, 0x54, 0x47, 0xd7, 0xf3, 0x0d, 0x5a,
-	0x32, 0xb0, 0x30, 0x82, 0x87, 0x0c, 0x92, 0x23, 0x94, 0x24, 0xb8, 0xc4, 0x50, 0x9d, 0x15, 0x94,
-	0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0xaa, 0x34, 0x93, 0x91, 0x4b, 0xc0, 0xb7, 0x38, 0x3d, 0x34,
-	0xaf, 0x80, 0x76, 0x6e, 0x36, 0xc3, 0xe1, 0x66, 0x39, 0x0c, 0x37, 0xa3, 0x38, 0x43, 0x49, 0x8a,
-	0x4b, 0x02, 0xdd, 0x69, 0x30, 0x77, 0x1b, 0x4d, 0x65, 0xe2, 0x62, 0xf6, 0x2d, 0x4e, 0x17, 0xaa,
-	0xe7, 0xe2, 0x46, 0x0e, 0x6d, 0x63, 0x3d, 0x22, 0xd2, 0x80, 0x1e, 0x6a, 0x58, 0x48, 0x59, 0x93,
-	0xa1, 0x09, 0xe6, 0x10, 0xa1, 0x56, 0x46, 0x2e, 0x5e, 0xd4, 0xd0, 0x33, 0x25, 0xd6, 0x38, 0x14,
-	0x6d, 0x52, 0xb6, 0x64, 0x69, 0x83, 0xb9, 0x43, 0x8a, 0xb5, 0xe1, 0xf9, 0x06, 0x2d, 0x46, 0xa7,
-	0xd0, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63,
-	0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4e, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0x02, 0x99, 0xab, 0x0f, 0xb6, 0x49, 0x37, 0xb1, 0xb8, 0x38, 0xb5, 0xa4, 0x18, 0x9e,
-	0xbb, 0xca, 0x8c, 0xf4, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0xb1, 0x64, 0xb3, 0x24, 0x36, 0x70, 0xfa,
-	0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xd3, 0x2a, 0x00, 0xd3, 0x91, 0x03, 0x00, 0x00,
+	// 813 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x31, 0x4f, 0xdb, 0x5a,
+	0x14, 0x8e, 0xc9, 0x23, 0xef, 0x71, 0xf3, 0x40, 0xe0, 0x97, 0x07, 0xc1, 0x69, 0x13, 0x1a, 0x16,
+	0x84, 0xc0, 0x2e, 0x01, 0xd2, 0x16, 0x04, 0x2a, 0x54, 0xaa, 0x94, 0x21, 0x12, 0x4a, 0xc4, 0xd0,
+	0x2e, 0x28, 0x89, 0x0f, 0xae, 0xa5, 0xc4, 0x37, 0xba, 0xf7, 0x26, 0x82, 0x89, 0xaa, 0x52, 0x25,
+	0xd4, 0xa1, 0xea, 0xd2, 0xa1, 0x1b, 0x5b, 0x3b, 0x32, 0x74, 0xea, 0xd2, 0x95, 0x11, 0x75, 0x69,
+	0xa7, 0xaa, 0x82, 0x81, 0x8e, 0xfd, 0x09, 0x95, 0x7d, 0x1d, 0xe7, 0x3a, 0x21, 0x52, 0xe2, 0xaa,
+	0x5b, 0x97, 0x28, 0xbe, 0xe7, 0x7c, 0xf7, 0x7c, 0xe7, 0x7c, 0xc7, 0xe7, 0x18, 0x2d, 0x58, 0xb8,
+	0x5c, 0x05, 0x0d, 0x93, 0xb2, 0xc9, 0x80, 0x68, 0x15, 0x5c, 0xab, 0x63, 0x0b, 0x2c, 0xa6, 0xc1,
+	0x01, 0x54, 0x1a, 0x0c, 0x13, 0xad, 0xb9, 0xa4, 0xb1, 0x03, 0xb5, 0x4e, 0x30, 0xc3, 0xf2, 0xac,
+	0xe3, 0xad, 0xba, 0xde, 0xaa, 0xe7, 0xad, 0xb6, 0xbc, 0xd5, 0xe6, 0x92, 0x32, 0x51, 0xaa, 0x99,
+	0x16, 0xd6, 0x9c, 0x5f, 0x8e, 0x53, 0xa6, 0x2a, 0x98, 0xd6, 0x30, 0xd5, 0x6a, 0xd4, 0xb0, 0xef,
+	0xab, 0x51, 0xc3, 0x35, 0x4c, 0x73, 0xc3, 0x9e, 0xf3, 0xa4, 0xf1, 0x07, 0xd7, 0x14, 0x33, 0xb0,
+	0x81, 0xf9, 0xb9, 0xfd, 0xcf, 0x3d, 0x5d, 0xee, 0xe4, 0x6b, 0x31, 0x82, 0xab, 0x55, 0x20, 0x5a,
+	0xa9, 0xc2, 0x4c, 0x6c, 0x69, 0xcd, 0x8c, 0x66, 0x5a, 0x0c, 0x0c, 0x52, 0xb2, 0xe9, 0x70, 0x50,
+	0xa6, 0x0f, 0x10, 0xc1, 0x0d, 0x06, 0x7b, 0xfb, 0x00, 0x1c, 0x93, 0x7e, 0x2d, 0xa1, 0xb1, 0x3c,
+	0x35, 0x76, 0x4a, 0x0d, 0x0a, 0x5b, 0x8e, 0x97, 0x7c, 0x1b, 0x45, 0xa8, 0x69, 0x58, 0x40, 0xe2,
+	0xd2, 0x8c, 0x34, 0x37, 0xb2, 0x1d, 0xff, 0xf4, 0x7e, 0x31, 0xe6, 0x72, 0xde, 0xd2, 0x75, 0x02,
+	0x94, 0x16, 0x19, 0x31, 0x2d, 0xa3, 0xe0, 0xfa, 0xc9, 0x09, 0x34, 0xc2, 0x23, 0xec, 0x99, 0x7a,
+	0x7c, 0xc8, 0x06, 0x15, 0xfe, 0xe1, 0x07, 0x39, 0x7d, 0x6d, 0xe5, 0xf8, 0x24, 0x15, 0xfa, 0x7e,
+	0x92, 0x0a, 0x3d, 0xbb, 0x3a, 0x9d, 0x77, 0x11, 0x2f, 0xae, 0x4e, 0xe7, 0x6f, 0xb4, 0x78, 0x7a,
+	0x12, 0x08, 0x24, 0xd2, 0x71, 0x34, 0xe9, 0xa7, 0x55, 0x00, 0x5a, 0xc7, 0x16, 0x85, 0xf4, 0x1b,
+	0x09, 0x8d, 0xe7, 0xa9, 0xb1, 0x6b, 0xd5, 0x7f, 0x1f, 0xe7, 0x6c, 0x0f, 0xce, 0xc9, 0x2e, 0xce,
+	0x3e, 0x1a, 0x69, 0x05, 0xc5, 0x3b, 0xa9, 0x79, 0xbc, 0x3f, 0x86, 0x9d, 0x4a, 0x17, 0x81, 0x15,
+	0x6c, 0x0d, 0x1e, 0x02, 0x04, 0x60, 0xbd, 0x80, 0x64, 0x8a, 0x1b, 0xa4, 0x02, 0xbc, 0x95, 0x2a,
+	0xb8, 0xda, 0xa6, 0x3f, 0xce, 0x2d, 0x3b, 0xae, 0x21, 0xa7, 0xcb, 0x2b, 0x68, 0xd2, 0xf5, 0xae,
+	0xe0, 0x86, 0xc5, 0x80, 0xd4, 0x4b, 0x84, 0x1d, 0xda, 0x88, 0xb0, 0x83, 0x88, 0x71, 0xeb, 0x03,
+	0xc1, 0x98, 0xd3, 0xe5, 0x2c, 0x9a, 0xd2, 0x81, 0x32, 0xd3, 0x2a, 0x39, 0xe5, 0x11, 0x03, 0xfd,
+	0xe5, 0xc0, 0xfe, 0x17, 0xcc, 0x42, 0xb4, 0x4d, 0x94, 0x10, 0x71, 0x9d, 0x21, 0x87, 0x1d, 0xec,
+	0xb4, 0xe0, 0xd2, 0x11, 0x37, 0x86, 0x86, 0x75, 0xb0, 0x70, 0x2d, 0x1e, 0x71, 0x3c, 0xf9, 0x83,
+	0x5c, 0x44, 0xe1, 0x7d, 0x80, 0xf8, 0xdf, 0x33, 0xd2, 0x5c, 0x34, 0x93, 0x55, 0x3b, 0xdf, 0xcc,
+	0x56, 0x8b, 0xab, 0x5c, 0x3b, 0xb5, 0x99, 0x51, 0x5b, 0xe5, 0xdd, 0x62, 0x8c, 0x98, 0xe5, 0x06,
+	0x03, 0xba, 0x3d, 0x72, 0xf6, 0x35, 0x15, 0x7a, 0x77, 0x75, 0x3a, 0x2f, 0x15, 0xec, 0xdb, 0x06,
+	0xe8, 0x49, 0x41, 0x2e, 0xb7, 0x27, 0x85, 0x13, 0x4f, 0xdb, 0x1f, 0x43, 0x68, 0x22, 0x4f, 0x8d,
+	0x02, 0xd4, 0x70, 0x13, 0xfe, 0xc8, 0xdb, 0xbf, 0xbc, 0x6b, 0x77, 0x7a, 0x28, 0x91, 0xea, 0x52,
+	0xc2, 0x5f, 0xdc, 0x74, 0x02, 0x4d, 0x77, 0x55, 0xdc, 0xd3, 0xe3, 0x33, 0x9f, 0x11, 0x45, 0x60,
+	0x39, 0x6f, 0x48, 0x06, 0x90, 0xe3, 0x11, 0x42, 0xed, 0x21, 0xeb, 0xc8, 0x10, 0xcd, 0xa8, 0xfd,
+	0xb4, 0x60, 0x3b, 0xaa, 0xd8, 0x7a, 0xc2, 0x65, 0x03, 0x4c, 0x18, 0x5f, 0x12, 0xee, 0x84, 0xf1,
+	0x9d, 0x79, 0x59, 0xbf, 0x95, 0xd0, 0x7f, 0x5e, 0x4d, 0x7e, 0x29, 0xf1, 0x59, 0x34, 0xda, 0xe6,
+	0xda, 0x6e, 0xc1, 0x7f, 0xdb, 0x87, 0x39, 0x7d, 0xed, 0x5e, 0x8f, 0x14, 0x6e, 0xf5, 0x90, 0x4e,
+	0xc8, 0xe2, 0x26, 0x4a, 0x5c, 0x43, 0xb4, 0x95, 0x48, 0xe6, 0x43, 0x04, 0x85, 0xf3, 0xd4, 0x90,
+	0x8f, 0x50, 0x54, 0x5c, 0x4c, 0xcb, 0x6a, 0x1f, 0x7b, 0x59, 0xf5, 0xaf, 0x0d, 0x65, 0x3d, 0x00,
+	0xa8, 0x45, 0x44, 0x7e, 0x2e, 0xa1, 0x51, 0xff, 0xa2, 0x59, 0xed, 0xf7, 0x3a, 0x1f, 0x4c, 0xd9,
+	0x08, 0x04, 0xf3, 0x78, 0x1c, 0xa1, 0xa8, 0xb8, 0x37, 0xfa, 0x2e, 0x84, 0x00, 0x52, 0xd6, 0x03,
+	0x80, 0x3c, 0x02, 0xc7, 0x12, 0x1a, 0xeb, 0x98, 0x6e, 0xd9, 0x7e, 0xef, 0xf3, 0xe3, 0x94, 0xcd,
+	0x60, 0x38, 0x9f, 0x26, 0xfe, 0x17, 0x7b, 0x75, 0x80, 0xcc, 0xda, 0x30, 0x65, 0x23, 0x10, 0xcc,
+	0xe3, 0xf1, 0x52, 0x42, 0xe3, 0x5d, 0xaf, 0xda, 0xdd, 0xc1, 0x92, 0x13, 0xd8, 0xdc, 0x0f, 0x8a,
+	0x6c, 0x11, 0x52, 0x86, 0x9f, 0xda, 0x53, 0x66, 0x7b, 0xf7, 0xec, 0x22, 0x29, 0x9d, 0x5f, 0x24,
+	0xa5, 0x6f, 0x17, 0x49, 0xe9, 0xd5, 0x65, 0x32, 0x74, 0x7e, 0x99, 0x0c, 0x7d, 0xb9, 0x4c, 0x86,
+	0x1e, 0xaf, 0x1b, 0x26, 0x7b, 0xd2, 0x28, 0xdb, 0x57, 0x6b, 0x4e, 0xb0, 0xc5, 0x12, 0xa5, 0xc0,
+	0xa8, 0xf7, 0xc5, 0xd8, 0xcc, 0x68, 0xec, 0xb0, 0x0e, 0xf4, 0x9a, 0xef, 0xe3, 0x72, 0xc4, 0x59,
+	0x14, 0xcb, 0x3f, 0x07, 0x00, 0x5e, 0x9d, 0x97, 0xbe, 0x4a, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -244,6 +606,14 @@ type MsgClient interface {
 	PauseAction(ctx context.Context, in *MsgPauseAction, opts ...grpc.CallOption) (*MsgPauseActionResponse, error)
 	// UnpauseAction resumes a specific action.
 	UnpauseAction(ctx context.Context, in *MsgUnpauseAction, opts ...grpc.CallOption) (*MsgUnpauseActionResponse, error)
+	// SetRouteFee sets the fee applied to the transfers of a route.
+	SetRouteFee(ctx context.Context, in *MsgSetRouteFee, opts ...grpc.CallOption) (*MsgSetRouteFeeResponse, error)
+	// RemoveRouteFee removes the fee applied to the transfers of a route.
+	RemoveRouteFee(ctx context.Context, in *MsgRemoveRouteFee, opts ...grpc.CallOption) (*MsgRemoveRouteFeeResponse, error)
+	// SetIntegrator registers or updates an integrator.
+	SetIntegrator(ctx context.Context, in *MsgSetIntegrator, opts ...grpc.CallOption) (*MsgSetIntegratorResponse, error)
+	// RemoveIntegrator removes a registered integrator.
+	RemoveIntegrator(ctx context.Context, in *MsgRemoveIntegrator, opts ...grpc.CallOption) (*MsgRemoveIntegratorResponse, error)
 }
 
 type msgClient struct {
@@ -272,12 +642,56 @@ func (c *msgClient) UnpauseAction(ctx context.Context, in *MsgUnpauseAction, opt
 	return out, nil
 }
 
+func (c *msgClient) SetRouteFee(ctx context.Context, in *MsgSetRouteFee, opts ...grpc.CallOption) (*MsgSetRouteFeeResponse, error) {
+	out := new(MsgSetRouteFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Msg/SetRouteFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRouteFee(ctx context.Context, in *MsgRemoveRouteFee, opts ...grpc.CallOption) (*MsgRemoveRouteFeeResponse, error) {
+	out := new(MsgRemoveRouteFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Msg/RemoveRouteFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetIntegrator(ctx context.Context, in *MsgSetIntegrator, opts ...grpc.CallOption) (*MsgSetIntegratorResponse, error) {
+	out := new(MsgSetIntegratorResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Msg/SetIntegrator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveIntegrator(ctx context.Context, in *MsgRemoveIntegrator, opts ...grpc.CallOption) (*MsgRemoveIntegratorResponse, error) {
+	out := new(MsgRemoveIntegratorResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.executor.v1.Msg/RemoveIntegrator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// PauseAction pauses a specific action.
 	PauseAction(context.Context, *MsgPauseAction) (*MsgPauseActionResponse, error)
 	// UnpauseAction resumes a specific action.
 	UnpauseAction(context.Context, *MsgUnpauseAction) (*MsgUnpauseActionResponse, error)
+	// SetRouteFee sets the fee applied to the transfers of a route.
+	SetRouteFee(context.Context, *MsgSetRouteFee) (*MsgSetRouteFeeResponse, error)
+	// RemoveRouteFee removes the fee applied to the transfers of a route.
+	RemoveRouteFee(context.Context, *MsgRemoveRouteFee) (*MsgRemoveRouteFeeResponse, error)
+	// SetIntegrator registers or updates an integrator.
+	SetIntegrator(context.Context, *MsgSetIntegrator) (*MsgSetIntegratorResponse, error)
+	// RemoveIntegrator removes a registered integrator.
+	RemoveIntegrator(context.Context, *MsgRemoveIntegrator) (*MsgRemoveIntegratorResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -290,6 +704,18 @@ func (*UnimplementedMsgServer) PauseAction(ctx context.Context, req *MsgPauseAct
 func (*UnimplementedMsgServer) UnpauseAction(ctx context.Context, req *MsgUnpauseAction) (*MsgUnpauseActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnpauseAction not implemented")
 }
+func (*UnimplementedMsgServer) SetRouteFee(ctx context.Context, req *MsgSetRouteFee) (*MsgSetRouteFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRouteFee not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRouteFee(ctx context.Context, req *MsgRemoveRouteFee) (*MsgRemoveRouteFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRouteFee not implemented")
+}
+func (*UnimplementedMsgServer) SetIntegrator(ctx context.Context, req *MsgSetIntegrator) (*MsgSetIntegratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIntegrator not implemented")
+}
+func (*UnimplementedMsgServer) RemoveIntegrator(ctx context.Context, req *MsgRemoveIntegrator) (*MsgRemoveIntegratorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveIntegrator not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -331,6 +757,78 @@ func _Msg_UnpauseAction_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRouteFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Msg/SetRouteFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRouteFee(ctx, req.(*MsgSetRouteFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRouteFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Msg/RemoveRouteFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRouteFee(ctx, req.(*MsgRemoveRouteFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetIntegrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetIntegrator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetIntegrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Msg/SetIntegrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetIntegrator(ctx, req.(*MsgSetIntegrator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveIntegrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveIntegrator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveIntegrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.executor.v1.Msg/RemoveIntegrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveIntegrator(ctx, req.(*MsgRemoveIntegrator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "noble.orbiter.component.executor.v1.Msg",
@@ -344,10 +842,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnpauseAction",
 			Handler:    _Msg_UnpauseAction_Handler,
 		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/orbiter/component/executor/v1/tx.proto",
-}
+		{
+			MethodName: "SetRouteFee",
+			Handler:    _Msg_SetRouteFee_Handler,
+		},
+		{
+			MethodName: "RemoveRouteFee",
+			Handler:    _Msg_RemoveRouteFee_Handler,
+		},
+		{
+			MethodName: "SetIntegrator",
+			Handler:    _Msg_SetIntegrator_Handler,
+		},
+		{
+			MethodName: "RemoveIntegrator",
+			Handler:    _Msg_RemoveIntegrator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/orbiter/component/executor/v1/tx.proto",
+}
 
 func (m *MsgPauseAction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -469,6 +983,315 @@ func (m *MsgUnpauseActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetRouteFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRouteFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRouteFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestinationCounterpartyId) > 0 {
+		i -= len(m.DestinationCounterpartyId)
+		copy(dAtA[i:], m.DestinationCounterpartyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationCounterpartyId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationProtocolId) > 0 {
+		i -= len(m.DestinationProtocolId)
+		copy(dAtA[i:], m.DestinationProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationProtocolId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceCounterpartyId) > 0 {
+		i -= len(m.SourceCounterpartyId)
+		copy(dAtA[i:], m.SourceCounterpartyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceCounterpartyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceProtocolId) > 0 {
+		i -= len(m.SourceProtocolId)
+		copy(dAtA[i:], m.SourceProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceProtocolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRouteFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRouteFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRouteFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRouteFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRouteFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRouteFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DestinationCounterpartyId) > 0 {
+		i -= len(m.DestinationCounterpartyId)
+		copy(dAtA[i:], m.DestinationCounterpartyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationCounterpartyId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DestinationProtocolId) > 0 {
+		i -= len(m.DestinationProtocolId)
+		copy(dAtA[i:], m.DestinationProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationProtocolId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceCounterpartyId) > 0 {
+		i -= len(m.SourceCounterpartyId)
+		copy(dAtA[i:], m.SourceCounterpartyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceCounterpartyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceProtocolId) > 0 {
+		i -= len(m.SourceProtocolId)
+		copy(dAtA[i:], m.SourceProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceProtocolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRouteFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRouteFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRouteFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIntegrator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIntegrator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIntegrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Integrator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetIntegratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetIntegratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetIntegratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveIntegrator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveIntegrator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveIntegrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntegratorId) > 0 {
+		i -= len(m.IntegratorId)
+		copy(dAtA[i:], m.IntegratorId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IntegratorId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveIntegratorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveIntegratorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveIntegratorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -494,51 +1317,1132 @@ func (m *MsgPauseAction) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgPauseActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ActionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRouteFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceCounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationCounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRouteFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRouteFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceCounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationCounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRouteFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetIntegrator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Integrator.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetIntegratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveIntegrator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IntegratorId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveIntegratorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPauseAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRouteFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRouteFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRouteFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRouteFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRouteFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRouteFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRouteFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRouteFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRouteFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgPauseActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUnpauseAction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ActionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRemoveRouteFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRouteFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRouteFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUnpauseActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgPauseAction) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIntegrator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -561,10 +2465,10 @@ func (m *MsgPauseAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIntegrator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIntegrator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -601,9 +2505,9 @@ func (m *MsgPauseAction) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Integrator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -613,23 +2517,24 @@ func (m *MsgPauseAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionId = string(dAtA[iNdEx:postIndex])
+			if err := m.Integrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -652,7 +2557,7 @@ func (m *MsgPauseAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetIntegratorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -675,10 +2580,10 @@ func (m *MsgPauseActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetIntegratorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetIntegratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -702,7 +2607,7 @@ func (m *MsgPauseActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseAction) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveIntegrator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -725,10 +2630,10 @@ func (m *MsgUnpauseAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveIntegrator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveIntegrator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -765,7 +2670,7 @@ func (m *MsgUnpauseAction) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -793,7 +2698,7 @@ func (m *MsgUnpauseAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActionId = string(dAtA[iNdEx:postIndex])
+			m.IntegratorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -816,7 +2721,7 @@ func (m *MsgUnpauseAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveIntegratorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -839,10 +2744,10 @@ func (m *MsgUnpauseActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveIntegratorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveIntegratorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -36,12 +36,32 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 		"orbiter/forwarder/UnpauseCrossChains",
 		nil,
 	)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "orbiter/forwarder/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetAmountLimit{}, "orbiter/forwarder/SetAmountLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveAmountLimit{}, "orbiter/forwarder/RemoveAmountLimit", nil)
+	cdc.RegisterConcrete(&MsgClaimInFlightRefund{}, "orbiter/forwarder/ClaimInFlightRefund", nil)
+	cdc.RegisterConcrete(&MsgClaimHeldBatchEntry{}, "orbiter/forwarder/ClaimHeldBatchEntry", nil)
+	cdc.RegisterConcrete(
+		&MsgClaimHeldEscrowedPacket{},
+		"orbiter/forwarder/ClaimHeldEscrowedPacket",
+		nil,
+	)
 	// Controllers.
 	cdc.RegisterConcrete(
 		&MsgReplaceDepositForBurn{},
 		"orbiter/forwarder/ReplaceDepositForBurn",
 		nil,
 	)
+	cdc.RegisterConcrete(
+		&MsgSetHyperlaneTokenRoute{},
+		"orbiter/forwarder/SetHyperlaneTokenRoute",
+		nil,
+	)
+	cdc.RegisterConcrete(
+		&MsgRemoveHyperlaneTokenRoute{},
+		"orbiter/forwarder/RemoveHyperlaneTokenRoute",
+		nil,
+	)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -50,8 +70,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgUnpauseProtocol{},
 		&MsgPauseCrossChains{},
 		&MsgUnpauseCrossChains{},
+		&MsgUpdateParams{},
+		&MsgSetAmountLimit{},
+		&MsgRemoveAmountLimit{},
+		&MsgClaimInFlightRefund{},
+		&MsgClaimHeldBatchEntry{},
+		&MsgClaimHeldEscrowedPacket{},
 		// Controllers.
 		&MsgReplaceDepositForBurn{},
+		&MsgSetHyperlaneTokenRoute{},
+		&MsgRemoveHyperlaneTokenRoute{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,461 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// NewInFlightPacket returns a validated in-flight packet.
+func NewInFlightPacket(
+	channelID string,
+	sequence uint64,
+	coin sdk.Coin,
+	receiver string,
+	refundRecipient string,
+) (InFlightPacket, error) {
+	p := InFlightPacket{
+		ChannelId:       channelID,
+		Sequence:        sequence,
+		Coin:            coin,
+		Receiver:        receiver,
+		RefundRecipient: refundRecipient,
+	}
+
+	return p, p.Validate()
+}
+
+// Validate returns an error if any of the in-flight packet fields is not valid.
+func (p *InFlightPacket) Validate() error {
+	if p == nil {
+		return core.ErrNilPointer.Wrap("in-flight packet")
+	}
+
+	if !channeltypes.IsValidChannelID(p.ChannelId) {
+		return errors.New("invalid channel ID")
+	}
+	if p.Sequence == 0 {
+		return errors.New("sequence cannot be zero")
+	}
+	if err := p.Coin.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid coin")
+	}
+	if !p.Coin.IsPositive() {
+		return errors.New("coin amount must be positive")
+	}
+	if p.Receiver == "" {
+		return core.ErrEmptyString.Wrap("invalid receiver")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.RefundRecipient); err != nil {
+		return errorsmod.Wrap(err, "invalid refund recipient address")
+	}
+
+	return nil
+}
+
+var _ cdctypes.UnpackInterfacesMessage = &EscrowedPacket{}
+
+// NewEscrowedPacket returns a validated escrowed packet.
+func NewEscrowedPacket(
+	id uint64,
+	sourceID core.CrossChainID,
+	coin sdk.Coin,
+	payload *core.Payload,
+	escrowedAt time.Time,
+	reason string,
+) (EscrowedPacket, error) {
+	p := EscrowedPacket{
+		Id:         id,
+		SourceId:   sourceID,
+		Coin:       coin,
+		Payload:    payload,
+		EscrowedAt: escrowedAt,
+		Reason:     reason,
+	}
+
+	return p, p.Validate()
+}
+
+// Validate returns an error if any of the escrowed packet fields is not valid.
+func (p *EscrowedPacket) Validate() error {
+	if p == nil {
+		return core.ErrNilPointer.Wrap("escrowed packet")
+	}
+
+	if err := p.SourceId.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid source cross-chain ID")
+	}
+	if err := p.Coin.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid coin")
+	}
+	if !p.Coin.IsPositive() {
+		return errors.New("coin amount must be positive")
+	}
+	if err := p.Payload.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid payload")
+	}
+	if p.EscrowedAt.IsZero() {
+		return errors.New("escrow time cannot be zero")
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the packet has been held in escrow
+// for at least maxAge at the given time.
+func (p *EscrowedPacket) IsExpired(now time.Time, maxAge time.Duration) bool {
+	return !now.Before(p.EscrowedAt.Add(maxAge))
+}
+
+// UnpackInterfaces is the method required to correctly unpack
+// the payload attributes.
+func (p *EscrowedPacket) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if p.Payload == nil {
+		return nil
+	}
+
+	return p.Payload.UnpackInterfaces(unpacker)
+}
+
+var (
+	_ cdctypes.UnpackInterfacesMessage = &DeferredPacket{}
+	_ cdctypes.UnpackInterfacesMessage = &ForwardingBatch{}
+)
+
+// NewDeferredPacket returns a validated deferred packet.
+func NewDeferredPacket(
+	id uint64,
+	sourceID core.CrossChainID,
+	sourceCoin sdk.Coin,
+	coin sdk.Coin,
+	forwarding *core.Forwarding,
+	fallbackRecipient string,
+	deferredAt time.Time,
+) (DeferredPacket, error) {
+	p := DeferredPacket{
+		Id:                id,
+		SourceId:          sourceID,
+		SourceCoin:        sourceCoin,
+		Coin:              coin,
+		Forwarding:        forwarding,
+		FallbackRecipient: fallbackRecipient,
+		DeferredAt:        deferredAt,
+	}
+
+	return p, p.Validate()
+}
+
+// Validate returns an error if any of the deferred packet fields is not valid.
+func (p *DeferredPacket) Validate() error {
+	if p == nil {
+		return core.ErrNilPointer.Wrap("deferred packet")
+	}
+
+	if err := p.SourceId.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid source cross-chain ID")
+	}
+	if err := p.SourceCoin.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid source coin")
+	}
+	if !p.SourceCoin.IsPositive() {
+		return errors.New("source coin amount must be positive")
+	}
+	if err := p.Coin.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid coin")
+	}
+	if !p.Coin.IsPositive() {
+		return errors.New("coin amount must be positive")
+	}
+	if err := p.Forwarding.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid forwarding")
+	}
+	if p.Forwarding.DeferredUntil == nil {
+		return core.ErrNilPointer.Wrap("deferred forwarding must specify a deferral")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.FallbackRecipient); err != nil {
+		return errorsmod.Wrap(err, "invalid fallback recipient address")
+	}
+	if p.DeferredAt.IsZero() {
+		return errors.New("deferral time cannot be zero")
+	}
+
+	return nil
+}
+
+// IsReady returns true if the deferral of the packet forwarding
+// is reached at the given block height and time.
+func (p *DeferredPacket) IsReady(height int64, t time.Time) bool {
+	return !p.Forwarding.IsDeferred(height, t)
+}
+
+// IsExpired returns true if the packet has been ready for at
+// least maxAge at the given time without being executed. A zero
+// maxAge disables the expiration.
+func (p *DeferredPacket) IsExpired(now time.Time, maxAge time.Duration) bool {
+	if p.ReadyAt == nil || maxAge == 0 {
+		return false
+	}
+
+	return !now.Before(p.ReadyAt.Add(maxAge))
+}
+
+// UnpackInterfaces is the method required to correctly unpack
+// the forwarding attributes.
+func (p *DeferredPacket) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if p.Forwarding == nil {
+		return nil
+	}
+
+	return p.Forwarding.UnpackInterfaces(unpacker)
+}
+
+// NewBatchKey returns the key of the forwarding batch aggregating the
+// forwardings of the denom with the same protocol and attributes.
+func NewBatchKey(forwarding *core.Forwarding, denom string) (string, error) {
+	if err := forwarding.Validate(); err != nil {
+		return "", errorsmod.Wrap(err, "invalid forwarding")
+	}
+
+	attr, err := forwarding.CachedAttributes()
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(
+		append([]byte(forwarding.Attributes.TypeUrl), forwarding.Attributes.Value...),
+	)
+
+	return fmt.Sprintf(
+		"%d/%s/%s/%x",
+		forwarding.ProtocolId,
+		attr.CounterpartyID(),
+		denom,
+		hash,
+	), nil
+}
+
+// Validate returns an error if any of the batch entry fields is not valid.
+func (e *BatchEntry) Validate() error {
+	if e == nil {
+		return core.ErrNilPointer.Wrap("batch entry")
+	}
+
+	if err := e.SourceId.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid source cross-chain ID")
+	}
+	if err := e.Coin.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid coin")
+	}
+	if !e.Coin.IsPositive() {
+		return errors.New("coin amount must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.FallbackRecipient); err != nil {
+		return errorsmod.Wrap(err, "invalid fallback recipient address")
+	}
+
+	return nil
+}
+
+// Validate returns an error if the held batch entry is not valid.
+func (e *HeldBatchEntry) Validate() error {
+	if e == nil {
+		return core.ErrNilPointer.Wrap("held batch entry")
+	}
+
+	if e.BatchKey == "" {
+		return errors.New("batch key cannot be empty")
+	}
+	if err := e.Entry.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid batch entry")
+	}
+
+	return nil
+}
+
+// Validate returns an error if any of the forwarding batch fields is not
+// valid or the entries do not sum to the batch coin.
+func (b *ForwardingBatch) Validate() error {
+	if b == nil {
+		return core.ErrNilPointer.Wrap("forwarding batch")
+	}
+
+	if b.Key == "" {
+		return core.ErrEmptyString.Wrap("batch key")
+	}
+	if err := b.Forwarding.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid forwarding")
+	}
+	if !b.Forwarding.Batch {
+		return errors.New("forwarding is not batched")
+	}
+	if err := b.Coin.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid coin")
+	}
+	if len(b.Entries) == 0 {
+		return errors.New("forwarding batch must have at least one entry")
+	}
+
+	total := sdk.NewCoin(b.Coin.Denom, math.ZeroInt())
+	for i, e := range b.Entries {
+		if err := e.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid batch entry %d", i)
+		}
+		if e.Coin.Denom != b.Coin.Denom {
+			return fmt.Errorf("batch entry %d denom %s differs from batch denom", i, e.Coin.Denom)
+		}
+		total = total.Add(e.Coin)
+	}
+	if !total.IsEqual(b.Coin) {
+		return fmt.Errorf("batch entries total %s differs from batch coin %s", total, b.Coin)
+	}
+
+	return nil
+}
+
+// UnpackInterfaces is the method required to correctly unpack
+// the forwarding attributes.
+func (b *ForwardingBatch) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if b.Forwarding == nil {
+		return nil
+	}
+
+	return b.Forwarding.UnpackInterfaces(unpacker)
+}
+
+// NewAmountLimit returns a validated amount limit.
+func NewAmountLimit(
+	destinationID core.CrossChainID,
+	denom string,
+	minAmount math.Int,
+	maxAmount math.Int,
+) (AmountLimit, error) {
+	l := AmountLimit{
+		DestinationId: destinationID,
+		Denom:         denom,
+		MinAmount:     minAmount,
+		MaxAmount:     maxAmount,
+	}
+
+	return l, l.Validate()
+}
+
+// Validate returns an error if any of the amount limit fields is not valid.
+func (l *AmountLimit) Validate() error {
+	if l == nil {
+		return core.ErrNilPointer.Wrap("amount limit")
+	}
+
+	if err := l.DestinationId.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid destination ID")
+	}
+	if err := sdk.ValidateDenom(l.Denom); err != nil {
+		return errorsmod.Wrap(err, "invalid denom")
+	}
+	if l.MinAmount.IsNil() || l.MinAmount.IsNegative() {
+		return errors.New("minimum amount must be non-negative")
+	}
+	if l.MaxAmount.IsNil() || l.MaxAmount.IsNegative() {
+		return errors.New("maximum amount must be non-negative")
+	}
+	if l.MinAmount.IsZero() && l.MaxAmount.IsZero() {
+		return errors.New("amount limit must specify a minimum or a maximum amount")
+	}
+	if l.MaxAmount.IsPositive() && l.MinAmount.GT(l.MaxAmount) {
+		return fmt.Errorf(
+			"minimum amount %s cannot be greater than the maximum amount %s",
+			l.MinAmount,
+			l.MaxAmount,
+		)
+	}
+
+	return nil
+}
+
+// CheckAmount returns an error if the amount is not within the limit.
+func (l *AmountLimit) CheckAmount(amount math.Int) error {
+	if l.MinAmount.IsPositive() && amount.LT(l.MinAmount) {
+		return core.ErrAmountBelowMinimum.Wrapf(
+			"amount %s is lower than the minimum %s for %s to %s",
+			amount,
+			l.MinAmount,
+			l.Denom,
+			l.DestinationId.ID(),
+		)
+	}
+	if l.MaxAmount.IsPositive() && amount.GT(l.MaxAmount) {
+		return core.ErrAmountAboveMaximum.Wrapf(
+			"amount %s is greater than the maximum %s for %s to %s",
+			amount,
+			l.MaxAmount,
+			l.Denom,
+			l.DestinationId.ID(),
+		)
+	}
+
+	return nil
+}
+
+// NewHyperlaneTokenRoute returns a validated Hyperlane token route.
+func NewHyperlaneTokenRoute(
+	denom string,
+	destinationDomain uint32,
+	tokenID []byte,
+) (HyperlaneTokenRoute, error) {
+	r := HyperlaneTokenRoute{
+		Denom:             denom,
+		DestinationDomain: destinationDomain,
+		TokenId:           tokenID,
+	}
+
+	return r, r.Validate()
+}
+
+// Validate returns an error if any of the Hyperlane token route fields is not valid.
+func (r *HyperlaneTokenRoute) Validate() error {
+	if r == nil {
+		return core.ErrNilPointer.Wrap("Hyperlane token route")
+	}
+
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return errorsmod.Wrap(err, "invalid denom")
+	}
+	if len(r.TokenId) != forwardingtypes.HypTokenIDLen {
+		return fmt.Errorf(
+			"token ID must be %d bytes, received %d bytes",
+			forwardingtypes.HypTokenIDLen,
+			len(r.TokenId),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,3285 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/component/forwarder/v1/forwarder.proto
+
+package forwarder
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	core "github.com/noble-assets/orbiter/v2/types/core"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket represents an outgoing IBC transfer sent by the
+// Orbiter module which has not been acknowledged or timed out yet.
+type InFlightPacket struct {
+	// channel_id is the Noble channel used to send the packet.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet on the channel.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// coin is the amount transferred with the packet.
+	Coin types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	// receiver is the address receiving the funds on the counterparty chain.
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// refund_recipient is the Noble address receiving the funds
+	// back in case of failure.
+	RefundRecipient string `protobuf:"bytes,5,opt,name=refund_recipient,json=refundRecipient,proto3" json:"refund_recipient,omitempty"`
+	// refund_failed is true when the packet failed or timed out but the
+	// funds could not be sent to the refund recipient. The funds are held
+	// in the refunds account until they are claimed.
+	RefundFailed bool `protobuf:"varint,6,opt,name=refund_failed,json=refundFailed,proto3" json:"refund_failed,omitempty"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *InFlightPacket) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundRecipient() string {
+	if m != nil {
+		return m.RefundRecipient
+	}
+	return ""
+}
+
+func (m *InFlightPacket) GetRefundFailed() bool {
+	if m != nil {
+		return m.RefundFailed
+	}
+	return false
+}
+
+// Params represents the forwarder component parameters.
+type Params struct {
+	// escrow_enabled specifies if packets failing the dispatch because of a
+	// paused protocol, cross-chain ID, or action are held in the escrow queue
+	// instead of being sent to the payload fallback recipient.
+	EscrowEnabled bool `protobuf:"varint,1,opt,name=escrow_enabled,json=escrowEnabled,proto3" json:"escrow_enabled,omitempty"`
+	// escrow_max_age_seconds is the maximum number of seconds a packet can be
+	// held in the escrow queue before being sent to the payload fallback recipient.
+	// It also bounds the time a deferred packet whose execution is paused is kept
+	// in the deferred queue after its deferral is reached, if not zero.
+	EscrowMaxAgeSeconds uint64 `protobuf:"varint,2,opt,name=escrow_max_age_seconds,json=escrowMaxAgeSeconds,proto3" json:"escrow_max_age_seconds,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEscrowEnabled() bool {
+	if m != nil {
+		return m.EscrowEnabled
+	}
+	return false
+}
+
+func (m *Params) GetEscrowMaxAgeSeconds() uint64 {
+	if m != nil {
+		return m.EscrowMaxAgeSeconds
+	}
+	return 0
+}
+
+// EscrowedPacket represents an incoming transfer held by the Orbiter
+// module because its dispatch hit a paused route or action.
+type EscrowedPacket struct {
+	// id is the unique identifier of the escrowed packet.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// source_id is the cross-chain ID of the incoming transfer.
+	SourceId core.CrossChainID `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id"`
+	// coin is the amount received with the transfer and held in escrow.
+	Coin types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	// payload is the Orbiter payload to dispatch once the route is unpaused.
+	Payload *core.Payload `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// escrowed_at is the block time at which the packet has been escrowed.
+	EscrowedAt time.Time `protobuf:"bytes,5,opt,name=escrowed_at,json=escrowedAt,proto3,stdtime" json:"escrowed_at"`
+	// reason is the dispatch error which caused the packet to be escrowed.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EscrowedPacket) Reset()         { *m = EscrowedPacket{} }
+func (m *EscrowedPacket) String() string { return proto.CompactTextString(m) }
+func (*EscrowedPacket) ProtoMessage()    {}
+func (*EscrowedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{2}
+}
+func (m *EscrowedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowedPacket.Merge(m, src)
+}
+func (m *EscrowedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowedPacket proto.InternalMessageInfo
+
+func (m *EscrowedPacket) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EscrowedPacket) GetSourceId() core.CrossChainID {
+	if m != nil {
+		return m.SourceId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *EscrowedPacket) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *EscrowedPacket) GetPayload() *core.Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *EscrowedPacket) GetEscrowedAt() time.Time {
+	if m != nil {
+		return m.EscrowedAt
+	}
+	return time.Time{}
+}
+
+func (m *EscrowedPacket) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// DeferredPacket represents a forwarding packet held by the Orbiter
+// module until its deferral is reached.
+type DeferredPacket struct {
+	// id is the unique identifier of the deferred packet.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// source_id is the cross-chain ID of the incoming transfer.
+	SourceId core.CrossChainID `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id"`
+	// source_coin is the coin received with the incoming transfer.
+	SourceCoin types.Coin `protobuf:"bytes,3,opt,name=source_coin,json=sourceCoin,proto3" json:"source_coin"`
+	// coin is the coin to forward, held by the module until the deferral is reached.
+	Coin types.Coin `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin"`
+	// forwarding is the deferred forwarding.
+	Forwarding *core.Forwarding `protobuf:"bytes,5,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// fallback_recipient is the Noble address receiving the funds
+	// in case the execution of the forwarding fails.
+	FallbackRecipient string `protobuf:"bytes,6,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+	// deferred_at is the block time at which the packet has been deferred.
+	DeferredAt time.Time `protobuf:"bytes,7,opt,name=deferred_at,json=deferredAt,proto3,stdtime" json:"deferred_at"`
+	// ready_at is the block time at which the deferral of the packet has
+	// been reached. It is not set while the packet is waiting its deferral.
+	ReadyAt *time.Time `protobuf:"bytes,8,opt,name=ready_at,json=readyAt,proto3,stdtime" json:"ready_at,omitempty"`
+}
+
+func (m *DeferredPacket) Reset()         { *m = DeferredPacket{} }
+func (m *DeferredPacket) String() string { return proto.CompactTextString(m) }
+func (*DeferredPacket) ProtoMessage()    {}
+func (*DeferredPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{3}
+}
+func (m *DeferredPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeferredPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeferredPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeferredPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeferredPacket.Merge(m, src)
+}
+func (m *DeferredPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeferredPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeferredPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeferredPacket proto.InternalMessageInfo
+
+func (m *DeferredPacket) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DeferredPacket) GetSourceId() core.CrossChainID {
+	if m != nil {
+		return m.SourceId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *DeferredPacket) GetSourceCoin() types.Coin {
+	if m != nil {
+		return m.SourceCoin
+	}
+	return types.Coin{}
+}
+
+func (m *DeferredPacket) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *DeferredPacket) GetForwarding() *core.Forwarding {
+	if m != nil {
+		return m.Forwarding
+	}
+	return nil
+}
+
+func (m *DeferredPacket) GetFallbackRecipient() string {
+	if m != nil {
+		return m.FallbackRecipient
+	}
+	return ""
+}
+
+func (m *DeferredPacket) GetDeferredAt() time.Time {
+	if m != nil {
+		return m.DeferredAt
+	}
+	return time.Time{}
+}
+
+func (m *DeferredPacket) GetReadyAt() *time.Time {
+	if m != nil {
+		return m.ReadyAt
+	}
+	return nil
+}
+
+// BatchEntry represents a forwarding packet aggregated in a forwarding batch.
+type BatchEntry struct {
+	// source_id is the cross-chain ID of the incoming transfer.
+	SourceId core.CrossChainID `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3" json:"source_id"`
+	// coin is the coin forwarded by the entry.
+	Coin types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	// fallback_recipient is the Noble address receiving the entry funds
+	// in case the batch transfer fails.
+	FallbackRecipient string `protobuf:"bytes,3,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+}
+
+func (m *BatchEntry) Reset()         { *m = BatchEntry{} }
+func (m *BatchEntry) String() string { return proto.CompactTextString(m) }
+func (*BatchEntry) ProtoMessage()    {}
+func (*BatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{4}
+}
+func (m *BatchEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchEntry.Merge(m, src)
+}
+func (m *BatchEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchEntry proto.InternalMessageInfo
+
+func (m *BatchEntry) GetSourceId() core.CrossChainID {
+	if m != nil {
+		return m.SourceId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *BatchEntry) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *BatchEntry) GetFallbackRecipient() string {
+	if m != nil {
+		return m.FallbackRecipient
+	}
+	return ""
+}
+
+// ForwardingBatch represents the forwardings with identical protocol,
+// attributes, and denom aggregated during a block and sent with a
+// single transfer at the end of the block.
+type ForwardingBatch struct {
+	// key is the unique identifier of the batch in the block.
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// forwarding is the forwarding shared by the batch entries.
+	Forwarding *core.Forwarding `protobuf:"bytes,2,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// coin is the total coin forwarded by the batch.
+	Coin types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	// entries are the forwarding packets aggregated in the batch.
+	Entries []BatchEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *ForwardingBatch) Reset()         { *m = ForwardingBatch{} }
+func (m *ForwardingBatch) String() string { return proto.CompactTextString(m) }
+func (*ForwardingBatch) ProtoMessage()    {}
+func (*ForwardingBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{5}
+}
+func (m *ForwardingBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardingBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardingBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardingBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingBatch.Merge(m, src)
+}
+func (m *ForwardingBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardingBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingBatch proto.InternalMessageInfo
+
+func (m *ForwardingBatch) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ForwardingBatch) GetForwarding() *core.Forwarding {
+	if m != nil {
+		return m.Forwarding
+	}
+	return nil
+}
+
+func (m *ForwardingBatch) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardingBatch) GetEntries() []BatchEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// HeldBatchEntry represents a batch entry whose funds could not be sent to
+// its fallback recipient after the batch transfer failed. The funds are held
+// in the refunds account until claimed.
+type HeldBatchEntry struct {
+	// id is the unique identifier of the held entry.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// batch_key is the key of the batch the entry was aggregated in.
+	BatchKey string `protobuf:"bytes,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// entry is the batch entry whose funds are held.
+	Entry BatchEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *HeldBatchEntry) Reset()         { *m = HeldBatchEntry{} }
+func (m *HeldBatchEntry) String() string { return proto.CompactTextString(m) }
+func (*HeldBatchEntry) ProtoMessage()    {}
+func (*HeldBatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{6}
+}
+func (m *HeldBatchEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeldBatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeldBatchEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeldBatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldBatchEntry.Merge(m, src)
+}
+func (m *HeldBatchEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeldBatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldBatchEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeldBatchEntry proto.InternalMessageInfo
+
+func (m *HeldBatchEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HeldBatchEntry) GetBatchKey() string {
+	if m != nil {
+		return m.BatchKey
+	}
+	return ""
+}
+
+func (m *HeldBatchEntry) GetEntry() BatchEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return BatchEntry{}
+}
+
+// AmountLimit represents the minimum and maximum amount of a denom that
+// can be forwarded with a single transfer to a destination cross-chain ID.
+type AmountLimit struct {
+	// destination_id is the cross-chain ID of the destination of the transfer.
+	DestinationId core.CrossChainID `protobuf:"bytes,1,opt,name=destination_id,json=destinationId,proto3" json:"destination_id"`
+	// denom is the Noble denom forwarded.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_amount is the minimum amount forwarded with a single transfer.
+	// A zero value disables the minimum.
+	MinAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	// max_amount is the maximum amount forwarded with a single transfer.
+	// A zero value disables the maximum.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+}
+
+func (m *AmountLimit) Reset()         { *m = AmountLimit{} }
+func (m *AmountLimit) String() string { return proto.CompactTextString(m) }
+func (*AmountLimit) ProtoMessage()    {}
+func (*AmountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{7}
+}
+func (m *AmountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AmountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AmountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AmountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AmountLimit.Merge(m, src)
+}
+func (m *AmountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *AmountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_AmountLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AmountLimit proto.InternalMessageInfo
+
+func (m *AmountLimit) GetDestinationId() core.CrossChainID {
+	if m != nil {
+		return m.DestinationId
+	}
+	return core.CrossChainID{}
+}
+
+func (m *AmountLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// HyperlaneTokenRoute represents the Hyperlane warp token used to forward
+// a denom to a destination domain when the forwarding does not specify it.
+type HyperlaneTokenRoute struct {
+	// denom is the Noble denom forwarded.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// destination_domain is the Hyperlane domain of the destination chain.
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// token_id is the 32 bytes ID of the Hyperlane warp token.
+	TokenId []byte `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+}
+
+func (m *HyperlaneTokenRoute) Reset()         { *m = HyperlaneTokenRoute{} }
+func (m *HyperlaneTokenRoute) String() string { return proto.CompactTextString(m) }
+func (*HyperlaneTokenRoute) ProtoMessage()    {}
+func (*HyperlaneTokenRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a900be7828307ce8, []int{8}
+}
+func (m *HyperlaneTokenRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperlaneTokenRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HyperlaneTokenRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HyperlaneTokenRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperlaneTokenRoute.Merge(m, src)
+}
+func (m *HyperlaneTokenRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperlaneTokenRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperlaneTokenRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperlaneTokenRoute proto.InternalMessageInfo
+
+func (m *HyperlaneTokenRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *HyperlaneTokenRoute) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *HyperlaneTokenRoute) GetTokenId() []byte {
+	if m != nil {
+		return m.TokenId
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "noble.orbiter.component.forwarder.v1.InFlightPacket")
+	proto.RegisterType((*Params)(nil), "noble.orbiter.component.forwarder.v1.Params")
+	proto.RegisterType((*EscrowedPacket)(nil), "noble.orbiter.component.forwarder.v1.EscrowedPacket")
+	proto.RegisterType((*DeferredPacket)(nil), "noble.orbiter.component.forwarder.v1.DeferredPacket")
+	proto.RegisterType((*BatchEntry)(nil), "noble.orbiter.component.forwarder.v1.BatchEntry")
+	proto.RegisterType((*ForwardingBatch)(nil), "noble.orbiter.component.forwarder.v1.ForwardingBatch")
+	proto.RegisterType((*HeldBatchEntry)(nil), "noble.orbiter.component.forwarder.v1.HeldBatchEntry")
+	proto.RegisterType((*AmountLimit)(nil), "noble.orbiter.component.forwarder.v1.AmountLimit")
+	proto.RegisterType((*HyperlaneTokenRoute)(nil), "noble.orbiter.component.forwarder.v1.HyperlaneTokenRoute")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/component/forwarder/v1/forwarder.proto", fileDescriptor_a900be7828307ce8)
+}
+
+var fileDescriptor_a900be7828307ce8 = []byte{
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x3a, 0x4e, 0x62, 0x3f, 0x37, 0x6e, 0xbb, 0x2d, 0x95, 0x1b, 0x84, 0x13, 0x1c, 0x0e,
+	0x11, 0x22, 0xbb, 0x4d, 0xe0, 0x50, 0x01, 0x17, 0x3b, 0x7f, 0xa8, 0x5b, 0x10, 0x61, 0xdb, 0x72,
+	0xe0, 0x62, 0x8d, 0x77, 0x9e, 0xed, 0x51, 0x76, 0x67, 0xcc, 0xcc, 0x38, 0x8d, 0xcf, 0x1c, 0xb9,
+	0xe4, 0x03, 0xf0, 0x01, 0x38, 0x72, 0xe8, 0x57, 0x40, 0xea, 0xb1, 0xea, 0x09, 0x21, 0x54, 0x50,
+	0x72, 0xe0, 0x23, 0x70, 0x45, 0xb3, 0x33, 0x1b, 0x9b, 0x28, 0x55, 0x49, 0x8a, 0xb8, 0x58, 0x7e,
+	0xff, 0x7e, 0x6f, 0xdf, 0xef, 0xf7, 0x66, 0x76, 0xe1, 0x23, 0x2e, 0xba, 0x09, 0x86, 0x42, 0x76,
+	0x99, 0x46, 0x19, 0xc6, 0x22, 0x1d, 0x0a, 0x8e, 0x5c, 0x87, 0x3d, 0x21, 0x9f, 0x10, 0x49, 0x51,
+	0x86, 0x07, 0x1b, 0x13, 0x23, 0x18, 0x4a, 0xa1, 0x85, 0xff, 0x5e, 0x56, 0x15, 0xb8, 0xaa, 0xe0,
+	0xb4, 0x2a, 0x98, 0x24, 0x1e, 0x6c, 0x2c, 0x5d, 0x27, 0x29, 0xe3, 0x22, 0xcc, 0x7e, 0x6d, 0xe1,
+	0x52, 0x3d, 0x16, 0x2a, 0x15, 0x2a, 0xec, 0x12, 0x85, 0xe1, 0xc1, 0x46, 0x17, 0x35, 0xd9, 0x08,
+	0x63, 0xc1, 0xb8, 0x8b, 0xdf, 0xb6, 0xf1, 0x4e, 0x66, 0x85, 0xd6, 0x70, 0xa1, 0x9b, 0x7d, 0xd1,
+	0x17, 0xd6, 0x6f, 0xfe, 0x39, 0xef, 0x72, 0x5f, 0x88, 0x7e, 0x82, 0x61, 0x66, 0x75, 0x47, 0xbd,
+	0x50, 0xb3, 0x14, 0x95, 0x26, 0xe9, 0x30, 0xef, 0x78, 0x76, 0x40, 0x69, 0x1a, 0x87, 0x8c, 0xba,
+	0xf8, 0xea, 0xf9, 0xf1, 0x7c, 0xb4, 0x2c, 0xa9, 0xf1, 0x7d, 0x01, 0xaa, 0x6d, 0xbe, 0x9b, 0xb0,
+	0xfe, 0x40, 0xef, 0x91, 0x78, 0x1f, 0xb5, 0xff, 0x0e, 0x40, 0x3c, 0x20, 0x9c, 0x63, 0xd2, 0x61,
+	0xb4, 0xe6, 0xad, 0x78, 0x6b, 0xe5, 0xa8, 0xec, 0x3c, 0x6d, 0xea, 0x2f, 0x41, 0x49, 0xe1, 0xb7,
+	0x23, 0xe4, 0x31, 0xd6, 0x0a, 0x2b, 0xde, 0x5a, 0x31, 0x3a, 0xb5, 0xfd, 0xbb, 0x50, 0x34, 0x23,
+	0xd7, 0x66, 0x57, 0xbc, 0xb5, 0xca, 0xe6, 0xed, 0xc0, 0x8d, 0x69, 0x38, 0x09, 0x1c, 0x27, 0xc1,
+	0x96, 0x60, 0xbc, 0x55, 0x7e, 0xf6, 0x72, 0x79, 0xe6, 0xc7, 0x3f, 0x7f, 0x7a, 0xdf, 0x8b, 0xb2,
+	0x0a, 0x83, 0x2a, 0x31, 0x46, 0x76, 0x80, 0xb2, 0x56, 0xcc, 0x5a, 0x9e, 0xda, 0xfe, 0x16, 0x5c,
+	0x93, 0xd8, 0x1b, 0x71, 0xda, 0x91, 0x18, 0xb3, 0x21, 0x43, 0xae, 0x6b, 0x73, 0x26, 0xa7, 0x55,
+	0x7b, 0xf1, 0x74, 0xfd, 0xa6, 0x6b, 0xd2, 0xa4, 0x54, 0xa2, 0x52, 0x0f, 0xb5, 0x64, 0xbc, 0x1f,
+	0x5d, 0xb5, 0x15, 0x51, 0x5e, 0xe0, 0xaf, 0xc2, 0xa2, 0x03, 0xe9, 0x11, 0x96, 0x20, 0xad, 0xcd,
+	0xaf, 0x78, 0x6b, 0xa5, 0xe8, 0x8a, 0x75, 0xee, 0x66, 0xbe, 0x86, 0x84, 0xf9, 0x3d, 0x22, 0x49,
+	0xaa, 0xfc, 0x0f, 0xa0, 0x8a, 0x2a, 0x96, 0xe2, 0x49, 0x07, 0x39, 0xe9, 0x26, 0x68, 0x89, 0x28,
+	0xb5, 0xe6, 0xec, 0x43, 0x2f, 0xda, 0xe0, 0x8e, 0x8d, 0xf9, 0x1f, 0xc3, 0x2d, 0x97, 0x9d, 0x92,
+	0xc3, 0x0e, 0xe9, 0x63, 0x47, 0x61, 0x2c, 0x38, 0x55, 0x96, 0xa1, 0xbc, 0xea, 0x86, 0x4d, 0xfa,
+	0x82, 0x1c, 0x36, 0xfb, 0xf8, 0xd0, 0x66, 0x34, 0x7e, 0x2e, 0x40, 0x75, 0x27, 0xf3, 0x23, 0x75,
+	0x0a, 0x54, 0xa1, 0xe0, 0x98, 0x2f, 0x46, 0x05, 0x46, 0xfd, 0x07, 0x50, 0x56, 0x62, 0x24, 0x63,
+	0x34, 0x82, 0x14, 0x32, 0x6e, 0x57, 0x83, 0xb3, 0x8b, 0x2a, 0x0d, 0xc5, 0xc1, 0x96, 0x14, 0x4a,
+	0x6d, 0x0d, 0x08, 0xe3, 0xed, 0xed, 0x69, 0x96, 0x4b, 0x16, 0xa0, 0x4d, 0xdf, 0x40, 0xa3, 0xbb,
+	0xb0, 0x30, 0x24, 0xe3, 0x44, 0x10, 0x9a, 0x49, 0x54, 0xd9, 0xac, 0xbf, 0xe2, 0x21, 0xf6, 0x6c,
+	0x56, 0x94, 0xa7, 0xfb, 0xf7, 0xa1, 0x82, 0x6e, 0xc4, 0x0e, 0xb1, 0xe2, 0x55, 0x36, 0x97, 0x02,
+	0xbb, 0xe1, 0x41, 0xbe, 0xe1, 0xc1, 0xa3, 0x7c, 0xc3, 0x5b, 0x8b, 0xa6, 0xf7, 0xd1, 0xef, 0xcb,
+	0x9e, 0xed, 0x0f, 0x79, 0x75, 0x53, 0xfb, 0xb7, 0x60, 0x5e, 0x22, 0x51, 0x82, 0x67, 0x0a, 0x96,
+	0x23, 0x67, 0x35, 0xbe, 0x2b, 0x42, 0x75, 0x1b, 0x7b, 0x28, 0xe5, 0xff, 0xc3, 0xe3, 0x0e, 0x54,
+	0x1c, 0xd8, 0x85, 0xe9, 0x04, 0x5b, 0xb8, 0x65, 0x49, 0xb5, 0x72, 0x14, 0x2f, 0x2c, 0x47, 0x13,
+	0xc0, 0x5d, 0x4a, 0x8c, 0xf7, 0x1d, 0xa7, 0xef, 0xbe, 0x62, 0x9c, 0xdd, 0xd3, 0xc4, 0x68, 0xaa,
+	0xc8, 0xff, 0x0c, 0xfc, 0x1e, 0x49, 0x92, 0x2e, 0x89, 0xf7, 0xa7, 0xce, 0xd6, 0xfc, 0x6b, 0xce,
+	0xd6, 0xf5, 0xbc, 0x66, 0x72, 0xba, 0xee, 0x43, 0x85, 0x3a, 0xee, 0x8d, 0xc0, 0x0b, 0x17, 0x16,
+	0x38, 0xaf, 0x6e, 0x6a, 0xff, 0x13, 0x73, 0x15, 0x10, 0x3a, 0x36, 0x40, 0xa5, 0xd7, 0x02, 0x15,
+	0x0d, 0x48, 0xb4, 0x90, 0x55, 0x34, 0x75, 0xe3, 0x37, 0x0f, 0xa0, 0x45, 0x74, 0x3c, 0xd8, 0xe1,
+	0x5a, 0x8e, 0xff, 0xa9, 0xb8, 0xf7, 0x1f, 0x9d, 0x9c, 0xc2, 0x85, 0xa5, 0x3a, 0x9f, 0xe7, 0xd9,
+	0x0b, 0xf3, 0xdc, 0xf8, 0xcb, 0x83, 0xab, 0x13, 0x2d, 0xb3, 0x41, 0xfd, 0x6b, 0x30, 0xbb, 0x8f,
+	0x63, 0x77, 0x51, 0x9b, 0xbf, 0x67, 0x36, 0xa3, 0x70, 0x99, 0xcd, 0xb8, 0xfc, 0x2d, 0xf1, 0x18,
+	0x16, 0x90, 0x6b, 0xc9, 0x50, 0xd5, 0x8a, 0x2b, 0xb3, 0x6b, 0x95, 0xcd, 0x3b, 0xc1, 0xbf, 0x79,
+	0xa7, 0x06, 0x13, 0xd5, 0xa6, 0x31, 0x73, 0xac, 0xc6, 0x91, 0x07, 0xd5, 0x7b, 0x98, 0xd0, 0x29,
+	0x71, 0xcf, 0x1e, 0xef, 0xb7, 0xa1, 0xdc, 0x35, 0xd1, 0x8e, 0xa1, 0xa3, 0x60, 0x5f, 0x22, 0x99,
+	0xe3, 0x01, 0x8e, 0xfd, 0xaf, 0x60, 0xce, 0x40, 0x8d, 0xdd, 0x44, 0x6f, 0xf4, 0x50, 0x16, 0xa9,
+	0xf1, 0x43, 0x01, 0x2a, 0xcd, 0x54, 0x8c, 0xb8, 0xfe, 0x9c, 0xa5, 0x4c, 0xfb, 0x8f, 0xa1, 0x4a,
+	0x51, 0x69, 0xc6, 0x89, 0x66, 0x82, 0x5f, 0x7e, 0xe3, 0x16, 0xa7, 0x50, 0xda, 0xd4, 0xbf, 0x09,
+	0x73, 0x14, 0xb9, 0x48, 0xdd, 0x48, 0xd6, 0xf0, 0xbf, 0x04, 0x48, 0x19, 0xef, 0x90, 0xac, 0xbf,
+	0x5b, 0xa5, 0x3b, 0x06, 0xe3, 0xd7, 0x97, 0xcb, 0x6f, 0x59, 0xb5, 0x14, 0xdd, 0x0f, 0x98, 0x08,
+	0x53, 0xa2, 0x07, 0x41, 0x9b, 0xeb, 0x17, 0x4f, 0xd7, 0xc1, 0xc9, 0xd8, 0xe6, 0xda, 0xb6, 0x2a,
+	0xa7, 0x8c, 0xdb, 0x11, 0x32, 0x40, 0xf3, 0xf2, 0xb2, 0x80, 0xc5, 0x4b, 0x03, 0x92, 0x43, 0x0b,
+	0xd8, 0x18, 0xc1, 0x8d, 0x7b, 0xe3, 0x21, 0xca, 0x84, 0x70, 0x7c, 0x24, 0xf6, 0x91, 0x47, 0x62,
+	0xa4, 0x71, 0x32, 0x8e, 0x37, 0x3d, 0xce, 0x3a, 0xf8, 0xd3, 0xdc, 0x51, 0x91, 0x12, 0x77, 0xd2,
+	0x16, 0xa3, 0xeb, 0x53, 0x91, 0xed, 0x2c, 0xe0, 0xdf, 0x86, 0x92, 0x36, 0x90, 0x86, 0x64, 0x33,
+	0xfb, 0x95, 0x68, 0x21, 0xb3, 0xdb, 0xb4, 0xf5, 0xf5, 0xb3, 0xe3, 0xba, 0xf7, 0xfc, 0xb8, 0xee,
+	0xfd, 0x71, 0x5c, 0xf7, 0x8e, 0x4e, 0xea, 0x33, 0xcf, 0x4f, 0xea, 0x33, 0xbf, 0x9c, 0xd4, 0x67,
+	0xbe, 0xf9, 0xb4, 0xcf, 0xf4, 0x60, 0xd4, 0x35, 0x5a, 0x87, 0x99, 0x22, 0xeb, 0x44, 0x29, 0xd4,
+	0xea, 0xf4, 0x13, 0xe9, 0x60, 0x33, 0xd4, 0xe3, 0x21, 0xaa, 0xf3, 0x3e, 0x16, 0xbb, 0xf3, 0xd9,
+	0xe5, 0xf3, 0xe1, 0xdf, 0x03, 0x00, 0x37, 0xe6, 0x0e, 0x67, 0x58, 0x0a, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RefundFailed {
+		i--
+		if m.RefundFailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RefundRecipient) > 0 {
+		i -= len(m.RefundRecipient)
+		copy(dAtA[i:], m.RefundRecipient)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.RefundRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintForwarder(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EscrowMaxAgeSeconds != 0 {
+		i = encodeVarintForwarder(dAtA, i, uint64(m.EscrowMaxAgeSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EscrowEnabled {
+		i--
+		if m.EscrowEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EscrowedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EscrowedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EscrowedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintForwarder(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintForwarder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SourceId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintForwarder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeferredPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeferredPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeferredPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReadyAt != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReadyAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReadyAt):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintForwarder(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x42
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DeferredAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeferredAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintForwarder(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Forwarding != nil {
+		{
+			size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintForwarder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.SourceCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SourceId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintForwarder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SourceId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardingBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardingBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintForwarder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Forwarding != nil {
+		{
+			size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintForwarder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldBatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldBatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldBatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BatchKey) > 0 {
+		i -= len(m.BatchKey)
+		copy(dAtA[i:], m.BatchKey)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.BatchKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintForwarder(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AmountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AmountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AmountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.DestinationId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintForwarder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperlaneTokenRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperlaneTokenRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperlaneTokenRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintForwarder(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintForwarder(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForwarder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForwarder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovForwarder(uint64(m.Sequence))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	l = len(m.RefundRecipient)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	if m.RefundFailed {
+		n += 2
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowEnabled {
+		n += 2
+	}
+	if m.EscrowMaxAgeSeconds != 0 {
+		n += 1 + sovForwarder(uint64(m.EscrowMaxAgeSeconds))
+	}
+	return n
+}
+
+func (m *EscrowedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovForwarder(uint64(m.Id))
+	}
+	l = m.SourceId.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EscrowedAt)
+	n += 1 + l + sovForwarder(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	return n
+}
+
+func (m *DeferredPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovForwarder(uint64(m.Id))
+	}
+	l = m.SourceId.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	l = m.SourceCoin.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	if m.Forwarding != nil {
+		l = m.Forwarding.Size()
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeferredAt)
+	n += 1 + l + sovForwarder(uint64(l))
+	if m.ReadyAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReadyAt)
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SourceId.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	l = m.Coin.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardingBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	if m.Forwarding != nil {
+		l = m.Forwarding.Size()
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovForwarder(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HeldBatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovForwarder(uint64(m.Id))
+	}
+	l = len(m.BatchKey)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	return n
+}
+
+func (m *AmountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DestinationId.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovForwarder(uint64(l))
+	return n
+}
+
+func (m *HyperlaneTokenRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovForwarder(uint64(m.DestinationDomain))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovForwarder(uint64(l))
+	}
+	return n
+}
+
+func sovForwarder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForwarder(x uint64) (n int) {
+	return sovForwarder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundFailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundFailed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EscrowEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowMaxAgeSeconds", wireType)
+			}
+			m.EscrowMaxAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowMaxAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &core.Payload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EscrowedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeferredPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeferredPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeferredPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forwarding == nil {
+				m.Forwarding = &core.Forwarding{}
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DeferredAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReadyAt == nil {
+				m.ReadyAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReadyAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardingBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardingBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardingBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forwarding == nil {
+				m.Forwarding = &core.Forwarding{}
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BatchEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldBatchEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldBatchEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldBatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmountLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmountLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DestinationId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HyperlaneTokenRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HyperlaneTokenRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HyperlaneTokenRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = append(m.TokenId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenId == nil {
+				m.TokenId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForwarder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForwarder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForwarder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForwarder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForwarder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForwarder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForwarder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForwarder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForwarder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForwarder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForwarder = fmt.Errorf("proto: unexpected end of group")
+)
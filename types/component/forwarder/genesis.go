@@ -21,7 +21,10 @@
 package forwarder
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	core "github.com/noble-assets/orbiter/v2/types/core"
 )
@@ -29,8 +32,16 @@ import (
 // DefaultGenesisState returns the default values for the component initial state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PausedProtocolIds:   []core.ProtocolID{},
-		PausedCrossChainIds: []*core.CrossChainID{},
+		PausedProtocolIds:    []core.ProtocolID{},
+		PausedCrossChainIds:  []*core.CrossChainID{},
+		InFlightPackets:      []InFlightPacket{},
+		Params:               DefaultParams(),
+		EscrowedPackets:      []EscrowedPacket{},
+		HyperlaneTokenRoutes: []HyperlaneTokenRoute{},
+		DeferredPackets:      []DeferredPacket{},
+		AmountLimits:         []AmountLimit{},
+		HeldBatchEntries:     []HeldBatchEntry{},
+		HeldEscrowedPackets:  []EscrowedPacket{},
 	}
 }
 
@@ -56,5 +67,152 @@ func (g *GenesisState) Validate() error {
 		}
 	}
 
+	seen := make(map[string]struct{}, len(g.InFlightPackets))
+	for _, p := range g.InFlightPackets {
+		if err := p.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid in-flight packet")
+		}
+
+		key := fmt.Sprintf("%s/%d", p.ChannelId, p.Sequence)
+		if _, found := seen[key]; found {
+			return core.ErrValidation.Wrapf("duplicate in-flight packet %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+
+	if err := g.Params.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid params")
+	}
+
+	seenIDs := make(map[uint64]struct{}, len(g.EscrowedPackets))
+	for _, p := range g.EscrowedPackets {
+		if err := p.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid escrowed packet")
+		}
+
+		if _, found := seenIDs[p.Id]; found {
+			return core.ErrValidation.Wrapf("duplicate escrowed packet %d", p.Id)
+		}
+		if p.Id >= g.EscrowedPacketsSequence {
+			return core.ErrValidation.Wrapf(
+				"escrowed packet ID %d must be lower than the sequence %d",
+				p.Id,
+				g.EscrowedPacketsSequence,
+			)
+		}
+		seenIDs[p.Id] = struct{}{}
+	}
+
+	// NOTE: the held escrowed packets keep the ID assigned when escrowed,
+	// so they share the IDs of the escrowed packets.
+	for _, p := range g.HeldEscrowedPackets {
+		if err := p.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid held escrowed packet")
+		}
+
+		if _, found := seenIDs[p.Id]; found {
+			return core.ErrValidation.Wrapf("duplicate escrowed packet %d", p.Id)
+		}
+		if p.Id >= g.EscrowedPacketsSequence {
+			return core.ErrValidation.Wrapf(
+				"held escrowed packet ID %d must be lower than the sequence %d",
+				p.Id,
+				g.EscrowedPacketsSequence,
+			)
+		}
+		seenIDs[p.Id] = struct{}{}
+	}
+
+	seenRoutes := make(map[string]struct{}, len(g.HyperlaneTokenRoutes))
+	for _, r := range g.HyperlaneTokenRoutes {
+		if err := r.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid Hyperlane token route")
+		}
+
+		key := fmt.Sprintf("%s/%d", r.Denom, r.DestinationDomain)
+		if _, found := seenRoutes[key]; found {
+			return core.ErrValidation.Wrapf("duplicate Hyperlane token route %s", key)
+		}
+		seenRoutes[key] = struct{}{}
+	}
+
+	seenDeferredIDs := make(map[uint64]struct{}, len(g.DeferredPackets))
+	for _, p := range g.DeferredPackets {
+		if err := p.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid deferred packet")
+		}
+
+		if _, found := seenDeferredIDs[p.Id]; found {
+			return core.ErrValidation.Wrapf("duplicate deferred packet %d", p.Id)
+		}
+		if p.Id >= g.DeferredPacketsSequence {
+			return core.ErrValidation.Wrapf(
+				"deferred packet ID %d must be lower than the sequence %d",
+				p.Id,
+				g.DeferredPacketsSequence,
+			)
+		}
+		seenDeferredIDs[p.Id] = struct{}{}
+	}
+
+	seenLimits := make(map[string]struct{}, len(g.AmountLimits))
+	for _, l := range g.AmountLimits {
+		if err := l.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid amount limit")
+		}
+
+		key := fmt.Sprintf("%s/%s", l.DestinationId.ID(), l.Denom)
+		if _, found := seenLimits[key]; found {
+			return core.ErrValidation.Wrapf("duplicate amount limit %s", key)
+		}
+		seenLimits[key] = struct{}{}
+	}
+
+	seenHeldIDs := make(map[uint64]struct{}, len(g.HeldBatchEntries))
+	for _, e := range g.HeldBatchEntries {
+		if err := e.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid held batch entry")
+		}
+
+		if _, found := seenHeldIDs[e.Id]; found {
+			return core.ErrValidation.Wrapf("duplicate held batch entry %d", e.Id)
+		}
+		if e.Id >= g.HeldBatchEntriesSequence {
+			return core.ErrValidation.Wrapf(
+				"held batch entry ID %d must be lower than the sequence %d",
+				e.Id,
+				g.HeldBatchEntriesSequence,
+			)
+		}
+		seenHeldIDs[e.Id] = struct{}{}
+	}
+
+	return nil
+}
+
+var _ cdctypes.UnpackInterfacesMessage = &GenesisState{}
+
+// UnpackInterfaces is the method required to correctly unpack
+// the payloads of the escrowed and held escrowed packets and the
+// forwardings of the deferred packets.
+func (g *GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for i := range g.EscrowedPackets {
+		if err := g.EscrowedPackets[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for i := range g.HeldEscrowedPackets {
+		if err := g.HeldEscrowedPackets[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	for i := range g.DeferredPackets {
+		if err := g.DeferredPackets[i].UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ package forwarder
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	core "github.com/noble-assets/orbiter/v2/types/core"
 	io "io"
@@ -28,6 +29,22 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	PausedProtocolIds   []core.ProtocolID    `protobuf:"varint,1,rep,packed,name=paused_protocol_ids,json=pausedProtocolIds,proto3,enum=noble.orbiter.core.v1.ProtocolID" json:"paused_protocol_ids,omitempty"`
 	PausedCrossChainIds []*core.CrossChainID `protobuf:"bytes,2,rep,name=paused_cross_chain_ids,json=pausedCrossChainIds,proto3" json:"paused_cross_chain_ids,omitempty"`
+	InFlightPackets     []InFlightPacket     `protobuf:"bytes,3,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
+	Params              Params               `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	EscrowedPackets     []EscrowedPacket     `protobuf:"bytes,5,rep,name=escrowed_packets,json=escrowedPackets,proto3" json:"escrowed_packets"`
+	// escrowed_packets_sequence is the next ID assigned to an escrowed packet.
+	EscrowedPacketsSequence uint64                `protobuf:"varint,6,opt,name=escrowed_packets_sequence,json=escrowedPacketsSequence,proto3" json:"escrowed_packets_sequence,omitempty"`
+	HyperlaneTokenRoutes    []HyperlaneTokenRoute `protobuf:"bytes,7,rep,name=hyperlane_token_routes,json=hyperlaneTokenRoutes,proto3" json:"hyperlane_token_routes"`
+	DeferredPackets         []DeferredPacket      `protobuf:"bytes,8,rep,name=deferred_packets,json=deferredPackets,proto3" json:"deferred_packets"`
+	// deferred_packets_sequence is the next ID assigned to a deferred packet.
+	DeferredPacketsSequence uint64           `protobuf:"varint,9,opt,name=deferred_packets_sequence,json=deferredPacketsSequence,proto3" json:"deferred_packets_sequence,omitempty"`
+	AmountLimits            []AmountLimit    `protobuf:"bytes,10,rep,name=amount_limits,json=amountLimits,proto3" json:"amount_limits"`
+	HeldBatchEntries        []HeldBatchEntry `protobuf:"bytes,11,rep,name=held_batch_entries,json=heldBatchEntries,proto3" json:"held_batch_entries"`
+	// held_batch_entries_sequence is the next ID assigned to a held batch entry.
+	HeldBatchEntriesSequence uint64 `protobuf:"varint,12,opt,name=held_batch_entries_sequence,json=heldBatchEntriesSequence,proto3" json:"held_batch_entries_sequence,omitempty"`
+	// held_escrowed_packets are the escrowed packets without a fallback
+	// recipient whose funds are held in the refunds account.
+	HeldEscrowedPackets []EscrowedPacket `protobuf:"bytes,13,rep,name=held_escrowed_packets,json=heldEscrowedPackets,proto3" json:"held_escrowed_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +94,83 @@ func (m *GenesisState) GetPausedCrossChainIds() []*core.CrossChainID {
 	return nil
 }
 
+func (m *GenesisState) GetInFlightPackets() []InFlightPacket {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetEscrowedPackets() []EscrowedPacket {
+	if m != nil {
+		return m.EscrowedPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowedPacketsSequence() uint64 {
+	if m != nil {
+		return m.EscrowedPacketsSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHyperlaneTokenRoutes() []HyperlaneTokenRoute {
+	if m != nil {
+		return m.HyperlaneTokenRoutes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeferredPackets() []DeferredPacket {
+	if m != nil {
+		return m.DeferredPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDeferredPacketsSequence() uint64 {
+	if m != nil {
+		return m.DeferredPacketsSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetAmountLimits() []AmountLimit {
+	if m != nil {
+		return m.AmountLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeldBatchEntries() []HeldBatchEntry {
+	if m != nil {
+		return m.HeldBatchEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeldBatchEntriesSequence() uint64 {
+	if m != nil {
+		return m.HeldBatchEntriesSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetHeldEscrowedPackets() []EscrowedPacket {
+	if m != nil {
+		return m.HeldEscrowedPackets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.orbiter.component.forwarder.v1.GenesisState")
 }
@@ -86,26 +180,46 @@ func init() {
 }
 
 var fileDescriptor_3107bd3a211021a2 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xca, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0xcf, 0x2f, 0x4a, 0xca, 0x2c, 0x49, 0x2d, 0xd2, 0x4f, 0xce, 0xcf, 0x2d, 0xc8, 0xcf,
-	0x4b, 0xcd, 0x2b, 0xd1, 0x4f, 0xcb, 0x2f, 0x2a, 0x4f, 0x2c, 0x4a, 0x49, 0x2d, 0xd2, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52,
-	0x01, 0xeb, 0xd1, 0x83, 0xea, 0xd1, 0x83, 0xeb, 0xd1, 0x83, 0xeb, 0xd1, 0x2b, 0x33, 0x94, 0x12,
-	0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x8d, 0x52, 0x72, 0xe8, 0x96, 0x15, 0xa5,
-	0x82, 0x4c, 0xcf, 0x4c, 0x81, 0xc8, 0x2b, 0x9d, 0x64, 0xe4, 0xe2, 0x71, 0x87, 0x58, 0x15, 0x5c,
-	0x92, 0x58, 0x92, 0x2a, 0x14, 0xc1, 0x25, 0x5c, 0x90, 0x58, 0x5a, 0x9c, 0x9a, 0x12, 0x0f, 0x56,
-	0x90, 0x9c, 0x9f, 0x13, 0x9f, 0x99, 0x52, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x67, 0xa4, 0xa8,
-	0x87, 0xee, 0x8e, 0xa2, 0x54, 0xbd, 0x32, 0x43, 0xbd, 0x00, 0xa8, 0x52, 0x4f, 0x17, 0x27, 0xd6,
-	0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x09, 0x42, 0x0c, 0x81, 0x4b, 0xa4, 0x14, 0x0b, 0xc5, 0x73,
-	0x89, 0x41, 0x4d, 0x4e, 0x2e, 0xca, 0x2f, 0x2e, 0x8e, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0x03, 0x1b,
-	0xce, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0x8c, 0xc3, 0x70, 0x67, 0x90, 0x6a, 0x67, 0x90, 0x62,
-	0x84, 0xf1, 0x50, 0x37, 0x22, 0x49, 0xa5, 0x14, 0x3b, 0x85, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x4d, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x12, 0x28, 0xdc, 0xf4, 0xc1,
-	0x96, 0xe8, 0x26, 0x16, 0x17, 0xa7, 0x96, 0x14, 0xc3, 0xc3, 0xa5, 0xcc, 0x48, 0xbf, 0xa4, 0xb2,
-	0x20, 0xb5, 0x18, 0x5b, 0x6c, 0x24, 0xb1, 0x81, 0x43, 0xc2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x39, 0x07, 0xdb, 0xde, 0xb9, 0x01, 0x00, 0x00,
+	// 613 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0x63, 0xfa, 0x03, 0x9d, 0xb6, 0xd0, 0xba, 0xa5, 0x98, 0x22, 0x99, 0xf0, 0xb3, 0x88,
+	0x10, 0xd8, 0x4a, 0xe8, 0x06, 0x04, 0x0b, 0xd2, 0x16, 0x5a, 0x09, 0x89, 0xaa, 0x45, 0x08, 0xb1,
+	0x19, 0x4d, 0xec, 0xdb, 0x78, 0xa8, 0x33, 0x63, 0xe6, 0x4e, 0x52, 0x95, 0xa7, 0xe0, 0x31, 0x58,
+	0xf2, 0x18, 0x5d, 0x76, 0x09, 0x1b, 0x84, 0xda, 0x05, 0xaf, 0x81, 0x3c, 0x36, 0x89, 0xe3, 0x16,
+	0x29, 0x91, 0xd8, 0x44, 0x96, 0xcf, 0xfd, 0xce, 0x3d, 0x39, 0x1e, 0x0d, 0x69, 0x08, 0xd9, 0x8a,
+	0xc1, 0x97, 0xaa, 0xc5, 0x35, 0x28, 0x3f, 0x90, 0x9d, 0x44, 0x0a, 0x10, 0xda, 0xdf, 0x97, 0xea,
+	0x90, 0xa9, 0x10, 0x94, 0xdf, 0xab, 0xfb, 0x6d, 0x10, 0x80, 0x1c, 0xbd, 0x44, 0x49, 0x2d, 0xed,
+	0xfb, 0x86, 0xf1, 0x72, 0xc6, 0xeb, 0x33, 0x5e, 0x9f, 0xf1, 0x7a, 0xf5, 0xd5, 0x45, 0xd6, 0xe1,
+	0x42, 0xfa, 0xe6, 0x37, 0x03, 0x57, 0x97, 0xdb, 0xb2, 0x2d, 0xcd, 0xa3, 0x9f, 0x3e, 0xe5, 0x6f,
+	0xd7, 0x46, 0x8a, 0x30, 0xf0, 0xce, 0x28, 0xb7, 0x4c, 0x29, 0x48, 0xc7, 0x78, 0x98, 0xe9, 0x77,
+	0x7f, 0xcc, 0x90, 0xb9, 0x57, 0x59, 0xec, 0x3d, 0xcd, 0x34, 0xd8, 0xef, 0xc9, 0x52, 0xc2, 0xba,
+	0x08, 0x21, 0x35, 0x03, 0x81, 0x8c, 0x29, 0x0f, 0xd1, 0xb1, 0xaa, 0x13, 0xb5, 0xab, 0x8d, 0x3b,
+	0x5e, 0xf9, 0x3f, 0x29, 0xf0, 0x7a, 0x75, 0x6f, 0x27, 0x1f, 0xdd, 0xde, 0x68, 0x4e, 0x7d, 0xfd,
+	0xfd, 0xed, 0x81, 0xb5, 0xbb, 0x98, 0x99, 0xf4, 0x85, 0x10, 0x6d, 0x4a, 0x56, 0x72, 0xe7, 0x40,
+	0x49, 0x44, 0x1a, 0x44, 0x8c, 0x0b, 0x63, 0x7e, 0xa9, 0x3a, 0x51, 0x9b, 0x6d, 0xdc, 0xfb, 0x87,
+	0xf9, 0x7a, 0x3a, 0xbd, 0x9e, 0x0e, 0x0f, 0xec, 0xf3, 0x8c, 0x05, 0x29, 0x44, 0xfb, 0x80, 0x2c,
+	0x72, 0x41, 0xf7, 0x63, 0xde, 0x8e, 0x34, 0x4d, 0x58, 0x70, 0x00, 0x1a, 0x9d, 0x09, 0xe3, 0xbd,
+	0xe6, 0x8d, 0xf2, 0x31, 0xbc, 0x6d, 0xf1, 0xd2, 0xd0, 0x3b, 0x06, 0x6e, 0xce, 0x1c, 0xff, 0xbc,
+	0x5d, 0xc9, 0x16, 0x5e, 0xe3, 0x43, 0x12, 0xda, 0x6f, 0xc8, 0x74, 0xc2, 0x14, 0xeb, 0xa0, 0x33,
+	0x59, 0xb5, 0x6a, 0xb3, 0x8d, 0x87, 0xa3, 0x6d, 0xd8, 0x31, 0x4c, 0xd1, 0x39, 0xb7, 0xb1, 0x3f,
+	0x92, 0x05, 0xc0, 0x40, 0xc9, 0xc3, 0xb4, 0xfa, 0x3c, 0xfc, 0xd4, 0x38, 0xe1, 0x37, 0x73, 0xfa,
+	0x82, 0xf0, 0x30, 0x24, 0xa1, 0xfd, 0x94, 0xdc, 0x2c, 0xef, 0xa2, 0x08, 0x9f, 0xba, 0x20, 0x02,
+	0x70, 0xa6, 0xab, 0x56, 0x6d, 0x72, 0xf7, 0x46, 0x89, 0xd9, 0xcb, 0x65, 0xfb, 0x33, 0x59, 0x89,
+	0x8e, 0x12, 0x50, 0x31, 0x13, 0x40, 0xb5, 0x3c, 0x00, 0x41, 0x95, 0xec, 0x6a, 0x40, 0xe7, 0xb2,
+	0x49, 0xfb, 0x64, 0xb4, 0xb4, 0x5b, 0x7f, 0x3d, 0xde, 0xa6, 0x16, 0xbb, 0xa9, 0x43, 0x31, 0xf2,
+	0x72, 0x74, 0x5e, 0x37, 0x1d, 0x85, 0xb0, 0x0f, 0x4a, 0x15, 0x3a, 0xba, 0x32, 0x4e, 0x47, 0x1b,
+	0x39, 0x7d, 0x41, 0x47, 0xe1, 0x90, 0x64, 0x3a, 0x2a, 0xef, 0x1a, 0x74, 0x34, 0x93, 0x75, 0x54,
+	0x62, 0xfa, 0x1d, 0x31, 0x32, 0xcf, 0x3a, 0xb2, 0x2b, 0x34, 0x8d, 0x79, 0x87, 0x6b, 0x74, 0x88,
+	0x09, 0x59, 0x1f, 0x2d, 0xe4, 0x0b, 0x83, 0xbe, 0x4e, 0xc9, 0x62, 0xc2, 0x39, 0x36, 0x78, 0x8f,
+	0x76, 0x87, 0xd8, 0x11, 0xc4, 0x21, 0x6d, 0x31, 0x1d, 0x44, 0x14, 0x84, 0x56, 0x1c, 0xd0, 0x99,
+	0x1d, 0xa7, 0x8c, 0x2d, 0x88, 0xc3, 0x66, 0x8a, 0x6f, 0x0a, 0xad, 0x8e, 0x8a, 0xab, 0x16, 0xa2,
+	0xa2, 0xc4, 0x01, 0xed, 0xe7, 0xe4, 0xd6, 0xf9, 0x75, 0x83, 0x3e, 0xe6, 0x4c, 0x1f, 0x4e, 0x19,
+	0xeb, 0x17, 0x82, 0xe4, 0xba, 0xc1, 0xcf, 0x9d, 0xf0, 0xf9, 0xff, 0x73, 0xc2, 0x97, 0x52, 0xf7,
+	0x61, 0x19, 0x9b, 0xef, 0x8e, 0x4f, 0x5d, 0xeb, 0xe4, 0xd4, 0xb5, 0x7e, 0x9d, 0xba, 0xd6, 0x97,
+	0x33, 0xb7, 0x72, 0x72, 0xe6, 0x56, 0xbe, 0x9f, 0xb9, 0x95, 0x0f, 0xcf, 0xda, 0x5c, 0x47, 0xdd,
+	0x56, 0xba, 0xc7, 0x37, 0x9b, 0x1f, 0x31, 0x44, 0xd0, 0xd8, 0xbf, 0x27, 0x7b, 0x0d, 0x5f, 0x1f,
+	0x25, 0x80, 0x17, 0x5d, 0xb3, 0xad, 0x69, 0x73, 0x33, 0x3e, 0xfe, 0x33, 0x00, 0x03, 0x93, 0x36,
+	0x6f, 0x15, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -128,6 +242,129 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HeldEscrowedPackets) > 0 {
+		for iNdEx := len(m.HeldEscrowedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldEscrowedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.HeldBatchEntriesSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HeldBatchEntriesSequence))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.HeldBatchEntries) > 0 {
+		for iNdEx := len(m.HeldBatchEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldBatchEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AmountLimits) > 0 {
+		for iNdEx := len(m.AmountLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.DeferredPacketsSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DeferredPacketsSequence))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DeferredPackets) > 0 {
+		for iNdEx := len(m.DeferredPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeferredPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.HyperlaneTokenRoutes) > 0 {
+		for iNdEx := len(m.HyperlaneTokenRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HyperlaneTokenRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EscrowedPacketsSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EscrowedPacketsSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EscrowedPackets) > 0 {
+		for iNdEx := len(m.EscrowedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PausedCrossChainIds) > 0 {
 		for iNdEx := len(m.PausedCrossChainIds) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -143,20 +380,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PausedProtocolIds) > 0 {
-		dAtA2 := make([]byte, len(m.PausedProtocolIds)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.PausedProtocolIds)*10)
+		var j2 int
 		for _, num := range m.PausedProtocolIds {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -193,6 +430,59 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EscrowedPackets) > 0 {
+		for _, e := range m.EscrowedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.EscrowedPacketsSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.EscrowedPacketsSequence))
+	}
+	if len(m.HyperlaneTokenRoutes) > 0 {
+		for _, e := range m.HyperlaneTokenRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DeferredPackets) > 0 {
+		for _, e := range m.DeferredPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.DeferredPacketsSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.DeferredPacketsSequence))
+	}
+	if len(m.AmountLimits) > 0 {
+		for _, e := range m.AmountLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeldBatchEntries) > 0 {
+		for _, e := range m.HeldBatchEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HeldBatchEntriesSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.HeldBatchEntriesSequence))
+	}
+	if len(m.HeldEscrowedPackets) > 0 {
+		for _, e := range m.HeldEscrowedPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -334,6 +624,334 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedPackets = append(m.EscrowedPackets, EscrowedPacket{})
+			if err := m.EscrowedPackets[len(m.EscrowedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedPacketsSequence", wireType)
+			}
+			m.EscrowedPacketsSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EscrowedPacketsSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperlaneTokenRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HyperlaneTokenRoutes = append(m.HyperlaneTokenRoutes, HyperlaneTokenRoute{})
+			if err := m.HyperlaneTokenRoutes[len(m.HyperlaneTokenRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeferredPackets = append(m.DeferredPackets, DeferredPacket{})
+			if err := m.DeferredPackets[len(m.DeferredPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredPacketsSequence", wireType)
+			}
+			m.DeferredPacketsSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeferredPacketsSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountLimits = append(m.AmountLimits, AmountLimit{})
+			if err := m.AmountLimits[len(m.AmountLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldBatchEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldBatchEntries = append(m.HeldBatchEntries, HeldBatchEntry{})
+			if err := m.HeldBatchEntries[len(m.HeldBatchEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldBatchEntriesSequence", wireType)
+			}
+			m.HeldBatchEntriesSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeldBatchEntriesSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldEscrowedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldEscrowedPackets = append(m.HeldEscrowedPackets, EscrowedPacket{})
+			if err := m.HeldEscrowedPackets[len(m.HeldEscrowedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
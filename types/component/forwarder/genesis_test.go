@@ -22,13 +22,81 @@ package forwarder
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
 func TestValidate(t *testing.T) {
+	inFlightPacket := InFlightPacket{
+		ChannelId:       "channel-1",
+		Sequence:        1,
+		Coin:            sdk.NewCoin("uusdc", math.NewInt(100)),
+		Receiver:        "cosmos1receiver",
+		RefundRecipient: sdk.AccAddress([]byte("refund_recipient")).String(),
+	}
+
+	forwarding, err := forwardingtypes.NewInternalForwarding(
+		sdk.AccAddress([]byte("recipient")).String(),
+	)
+	require.NoError(t, err)
+
+	escrowedPacket := EscrowedPacket{
+		Id:       0,
+		SourceId: core.CrossChainID{ProtocolId: core.PROTOCOL_IBC, CounterpartyId: "channel-1"},
+		Coin:     sdk.NewCoin("uusdc", math.NewInt(100)),
+		Payload: &core.Payload{
+			Forwarding:        forwarding,
+			FallbackRecipient: sdk.AccAddress([]byte("fallback_recipient")).String(),
+		},
+		EscrowedAt: time.Unix(1_000, 0),
+	}
+
+	deferredForwarding, err := forwardingtypes.NewInternalForwarding(
+		sdk.AccAddress([]byte("recipient")).String(),
+	)
+	require.NoError(t, err)
+	deferredForwarding.DeferredUntil = &core.Deferral{Height: 10}
+
+	deferredPacket := DeferredPacket{
+		Id:                0,
+		SourceId:          escrowedPacket.SourceId,
+		SourceCoin:        sdk.NewCoin("uusdc", math.NewInt(100)),
+		Coin:              sdk.NewCoin("uusdc", math.NewInt(90)),
+		Forwarding:        deferredForwarding,
+		FallbackRecipient: sdk.AccAddress([]byte("fallback_recipient")).String(),
+		DeferredAt:        time.Unix(1_000, 0),
+	}
+
+	hypTokenRoute := HyperlaneTokenRoute{
+		Denom:             "usdn",
+		DestinationDomain: 1,
+		TokenId:           make([]byte, forwardingtypes.HypTokenIDLen),
+	}
+
+	amountLimit := AmountLimit{
+		DestinationId: core.CrossChainID{ProtocolId: core.PROTOCOL_CCTP, CounterpartyId: "0"},
+		Denom:         "uusdc",
+		MinAmount:     math.NewInt(10),
+		MaxAmount:     math.NewInt(100),
+	}
+
+	heldBatchEntry := HeldBatchEntry{
+		Id:       0,
+		BatchKey: "batch-key",
+		Entry: BatchEntry{
+			SourceId:          escrowedPacket.SourceId,
+			Coin:              sdk.NewCoin("uusdc", math.NewInt(100)),
+			FallbackRecipient: deferredPacket.FallbackRecipient,
+		},
+	}
+
 	testcases := []struct {
 		name     string
 		genState *GenesisState
@@ -76,6 +144,282 @@ func TestValidate(t *testing.T) {
 			},
 			expErr: "invalid paused cross-chain ID",
 		},
+		{
+			name: "success - valid genesis state with in-flight packets",
+			genState: &GenesisState{
+				InFlightPackets: []InFlightPacket{inFlightPacket},
+			},
+		},
+		{
+			name: "error - invalid in-flight packet (zero sequence)",
+			genState: &GenesisState{
+				InFlightPackets: []InFlightPacket{
+					{
+						ChannelId:       inFlightPacket.ChannelId,
+						Coin:            inFlightPacket.Coin,
+						Receiver:        inFlightPacket.Receiver,
+						RefundRecipient: inFlightPacket.RefundRecipient,
+					},
+				},
+			},
+			expErr: "sequence cannot be zero",
+		},
+		{
+			name: "error - duplicate in-flight packets",
+			genState: &GenesisState{
+				InFlightPackets: []InFlightPacket{inFlightPacket, inFlightPacket},
+			},
+			expErr: "duplicate in-flight packet channel-1/1",
+		},
+		{
+			name: "error - invalid params (zero escrow max age)",
+			genState: &GenesisState{
+				Params: Params{EscrowEnabled: true},
+			},
+			expErr: "escrow max age must be positive",
+		},
+		{
+			name: "success - valid genesis state with escrowed packets",
+			genState: &GenesisState{
+				EscrowedPackets:         []EscrowedPacket{escrowedPacket},
+				EscrowedPacketsSequence: 1,
+			},
+		},
+		{
+			name: "success - escrowed packet without fallback recipient",
+			genState: &GenesisState{
+				EscrowedPackets: []EscrowedPacket{
+					{
+						SourceId:   escrowedPacket.SourceId,
+						Coin:       escrowedPacket.Coin,
+						Payload:    &core.Payload{Forwarding: forwarding},
+						EscrowedAt: escrowedPacket.EscrowedAt,
+					},
+				},
+				EscrowedPacketsSequence: 1,
+			},
+		},
+		{
+			name: "error - duplicate escrowed packets",
+			genState: &GenesisState{
+				EscrowedPackets:         []EscrowedPacket{escrowedPacket, escrowedPacket},
+				EscrowedPacketsSequence: 1,
+			},
+			expErr: "duplicate escrowed packet 0",
+		},
+		{
+			name: "success - valid genesis state with held escrowed packets",
+			genState: &GenesisState{
+				HeldEscrowedPackets:     []EscrowedPacket{escrowedPacket},
+				EscrowedPacketsSequence: 1,
+			},
+		},
+		{
+			name: "error - held escrowed packet sharing the ID of an escrowed packet",
+			genState: &GenesisState{
+				EscrowedPackets:         []EscrowedPacket{escrowedPacket},
+				HeldEscrowedPackets:     []EscrowedPacket{escrowedPacket},
+				EscrowedPacketsSequence: 1,
+			},
+			expErr: "duplicate escrowed packet 0",
+		},
+		{
+			name: "error - held escrowed packet ID not lower than the sequence",
+			genState: &GenesisState{
+				HeldEscrowedPackets: []EscrowedPacket{escrowedPacket},
+			},
+			expErr: "held escrowed packet ID 0 must be lower than the sequence",
+		},
+		{
+			name: "error - escrowed packet ID not lower than the sequence",
+			genState: &GenesisState{
+				EscrowedPackets: []EscrowedPacket{escrowedPacket},
+			},
+			expErr: "must be lower than the sequence",
+		},
+		{
+			name: "success - valid genesis state with Hyperlane token routes",
+			genState: &GenesisState{
+				HyperlaneTokenRoutes: []HyperlaneTokenRoute{
+					hypTokenRoute,
+					{Denom: "uusdc", DestinationDomain: 1, TokenId: hypTokenRoute.TokenId},
+				},
+			},
+		},
+		{
+			name: "error - Hyperlane token route with invalid token ID",
+			genState: &GenesisState{
+				HyperlaneTokenRoutes: []HyperlaneTokenRoute{
+					{Denom: "usdn", DestinationDomain: 1, TokenId: []byte("token")},
+				},
+			},
+			expErr: "token ID must be 32 bytes",
+		},
+		{
+			name: "error - Hyperlane token route with invalid denom",
+			genState: &GenesisState{
+				HyperlaneTokenRoutes: []HyperlaneTokenRoute{
+					{DestinationDomain: 1, TokenId: hypTokenRoute.TokenId},
+				},
+			},
+			expErr: "invalid denom",
+		},
+		{
+			name: "error - duplicate Hyperlane token routes",
+			genState: &GenesisState{
+				HyperlaneTokenRoutes: []HyperlaneTokenRoute{hypTokenRoute, hypTokenRoute},
+			},
+			expErr: "duplicate Hyperlane token route usdn/1",
+		},
+		{
+			name: "success - valid genesis state with amount limits",
+			genState: &GenesisState{
+				AmountLimits: []AmountLimit{
+					amountLimit,
+					{
+						DestinationId: amountLimit.DestinationId,
+						Denom:         "usdn",
+						MinAmount:     math.ZeroInt(),
+						MaxAmount:     math.NewInt(100),
+					},
+				},
+			},
+		},
+		{
+			name: "error - amount limit without bounds",
+			genState: &GenesisState{
+				AmountLimits: []AmountLimit{
+					{
+						DestinationId: amountLimit.DestinationId,
+						Denom:         "uusdc",
+						MinAmount:     math.ZeroInt(),
+						MaxAmount:     math.ZeroInt(),
+					},
+				},
+			},
+			expErr: "amount limit must specify a minimum or a maximum amount",
+		},
+		{
+			name: "error - amount limit with minimum greater than maximum",
+			genState: &GenesisState{
+				AmountLimits: []AmountLimit{
+					{
+						DestinationId: amountLimit.DestinationId,
+						Denom:         "uusdc",
+						MinAmount:     math.NewInt(101),
+						MaxAmount:     math.NewInt(100),
+					},
+				},
+			},
+			expErr: "cannot be greater than the maximum amount",
+		},
+		{
+			name: "error - amount limit with negative minimum",
+			genState: &GenesisState{
+				AmountLimits: []AmountLimit{
+					{
+						DestinationId: amountLimit.DestinationId,
+						Denom:         "uusdc",
+						MinAmount:     math.NewInt(-1),
+						MaxAmount:     math.NewInt(100),
+					},
+				},
+			},
+			expErr: "minimum amount must be non-negative",
+		},
+		{
+			name: "error - amount limit with invalid destination",
+			genState: &GenesisState{
+				AmountLimits: []AmountLimit{
+					{
+						DestinationId: core.CrossChainID{
+							ProtocolId:     core.PROTOCOL_CCTP,
+							CounterpartyId: "channel-0",
+						},
+						Denom:     "uusdc",
+						MinAmount: math.NewInt(10),
+						MaxAmount: math.ZeroInt(),
+					},
+				},
+			},
+			expErr: "invalid destination ID",
+		},
+		{
+			name: "error - duplicate amount limits",
+			genState: &GenesisState{
+				AmountLimits: []AmountLimit{amountLimit, amountLimit},
+			},
+			expErr: "duplicate amount limit 2:0/uusdc",
+		},
+		{
+			name: "success - valid genesis state with deferred packets",
+			genState: &GenesisState{
+				DeferredPackets:         []DeferredPacket{deferredPacket},
+				DeferredPacketsSequence: 1,
+			},
+		},
+		{
+			name: "error - deferred packet without deferral",
+			genState: &GenesisState{
+				DeferredPackets: []DeferredPacket{
+					{
+						SourceId:          deferredPacket.SourceId,
+						SourceCoin:        deferredPacket.SourceCoin,
+						Coin:              deferredPacket.Coin,
+						Forwarding:        forwarding,
+						FallbackRecipient: deferredPacket.FallbackRecipient,
+						DeferredAt:        deferredPacket.DeferredAt,
+					},
+				},
+				DeferredPacketsSequence: 1,
+			},
+			expErr: "deferred forwarding must specify a deferral",
+		},
+		{
+			name: "error - duplicate deferred packets",
+			genState: &GenesisState{
+				DeferredPackets:         []DeferredPacket{deferredPacket, deferredPacket},
+				DeferredPacketsSequence: 1,
+			},
+			expErr: "duplicate deferred packet 0",
+		},
+		{
+			name: "error - deferred packet ID not lower than the sequence",
+			genState: &GenesisState{
+				DeferredPackets: []DeferredPacket{deferredPacket},
+			},
+			expErr: "must be lower than the sequence",
+		},
+		{
+			name: "success - valid genesis state with held batch entries",
+			genState: &GenesisState{
+				HeldBatchEntries:         []HeldBatchEntry{heldBatchEntry},
+				HeldBatchEntriesSequence: 1,
+			},
+		},
+		{
+			name: "error - held batch entry without batch key",
+			genState: &GenesisState{
+				HeldBatchEntries:         []HeldBatchEntry{{Id: 0, Entry: heldBatchEntry.Entry}},
+				HeldBatchEntriesSequence: 1,
+			},
+			expErr: "invalid held batch entry",
+		},
+		{
+			name: "error - duplicate held batch entries",
+			genState: &GenesisState{
+				HeldBatchEntries:         []HeldBatchEntry{heldBatchEntry, heldBatchEntry},
+				HeldBatchEntriesSequence: 1,
+			},
+			expErr: "duplicate held batch entry 0",
+		},
+		{
+			name: "error - held batch entry ID not lower than the sequence",
+			genState: &GenesisState{
+				HeldBatchEntries: []HeldBatchEntry{heldBatchEntry},
+			},
+			expErr: "must be lower than the sequence",
+		},
 	}
 
 	for _, tc := range testcases {
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarder
+
+import (
+	"time"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// DefaultEscrowMaxAge is the default maximum time a packet
+// can be held in the escrow queue.
+const DefaultEscrowMaxAge = 24 * time.Hour
+
+// DefaultParams returns the default forwarder component params.
+func DefaultParams() Params {
+	return Params{
+		EscrowEnabled:       false,
+		EscrowMaxAgeSeconds: uint64(DefaultEscrowMaxAge.Seconds()),
+	}
+}
+
+// Validate returns an error if any of the params fields are not valid.
+func (p *Params) Validate() error {
+	if p.EscrowEnabled && p.EscrowMaxAgeSeconds == 0 {
+		return core.ErrValidation.Wrap("escrow max age must be positive when escrow is enabled")
+	}
+
+	return nil
+}
+
+// EscrowMaxAge returns the maximum time a packet can be held in the escrow queue.
+func (p *Params) EscrowMaxAge() time.Duration {
+	return time.Duration(p.EscrowMaxAgeSeconds) * time.Second //nolint:gosec
+}
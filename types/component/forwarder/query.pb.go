@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	core "github.com/noble-assets/orbiter/v2/types/core"
@@ -416,680 +417,5270 @@ func (m *QueryIsCrossChainPausedResponse) GetIsPaused() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryPausedProtocolsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryPausedProtocolsRequest")
-	proto.RegisterType((*QueryPausedProtocolsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryPausedProtocolsResponse")
-	proto.RegisterType((*QueryPausedCrossChainsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryPausedCrossChainsRequest")
-	proto.RegisterType((*QueryPausedCrossChainsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryPausedCrossChainsResponse")
-	proto.RegisterType((*QueryIsProtocolPausedRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryIsProtocolPausedRequest")
-	proto.RegisterType((*QueryIsProtocolPausedResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryIsProtocolPausedResponse")
-	proto.RegisterType((*QueryIsCrossChainPausedRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryIsCrossChainPausedRequest")
-	proto.RegisterType((*QueryIsCrossChainPausedResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryIsCrossChainPausedResponse")
+// QueryInFlightPacketsRequest is the request type for the Query/InFlightPackets RPC method.
+type QueryInFlightPacketsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("noble/orbiter/component/forwarder/v1/query.proto", fileDescriptor_aaf39c97a61ced58)
+func (m *QueryInFlightPacketsRequest) Reset()         { *m = QueryInFlightPacketsRequest{} }
+func (m *QueryInFlightPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketsRequest) ProtoMessage()    {}
+func (*QueryInFlightPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{8}
 }
-
-var fileDescriptor_aaf39c97a61ced58 = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0x66, 0xf8, 0x85, 0x9f, 0x74, 0x30, 0xfc, 0x99, 0x13, 0x59, 0x60, 0xc1, 0x8d, 0x11, 0x24,
-	0x3a, 0x63, 0xeb, 0x49, 0x43, 0x34, 0x52, 0x94, 0x34, 0x5c, 0x70, 0x0f, 0x1c, 0x34, 0x86, 0xcc,
-	0x76, 0xc7, 0x32, 0x0a, 0x3b, 0xcb, 0xcc, 0xb4, 0x86, 0x10, 0x2e, 0x9e, 0x48, 0xbc, 0x98, 0xe8,
-	0x87, 0xf0, 0xe8, 0xc7, 0x30, 0xf1, 0x42, 0xc2, 0xc5, 0xa3, 0x01, 0x12, 0xbf, 0x81, 0x67, 0xb3,
-	0xbb, 0xd3, 0xee, 0x76, 0x4b, 0xb5, 0xb5, 0x5e, 0x7a, 0x98, 0x79, 0x9f, 0xe7, 0x7d, 0x9e, 0x67,
-	0xde, 0x77, 0x0b, 0xef, 0x04, 0xc2, 0xdb, 0x65, 0x44, 0x48, 0x8f, 0x6b, 0x26, 0x49, 0x55, 0xec,
-	0x85, 0x22, 0x60, 0x81, 0x26, 0x2f, 0x85, 0x7c, 0x43, 0xa5, 0xcf, 0x24, 0x69, 0x14, 0xc9, 0x7e,
-	0x9d, 0xc9, 0x03, 0x1c, 0x4a, 0xa1, 0x05, 0xba, 0x1e, 0x23, 0xb0, 0x41, 0xe0, 0x16, 0x02, 0xb7,
-	0x10, 0xb8, 0x51, 0xb4, 0xa6, 0xe8, 0x1e, 0x0f, 0x04, 0x89, 0x7f, 0x13, 0xa0, 0xb5, 0x5c, 0x15,
-	0x6a, 0x4f, 0x28, 0xe2, 0x51, 0xc5, 0x12, 0x46, 0xd2, 0x28, 0x7a, 0x4c, 0xd3, 0x22, 0x09, 0x69,
-	0x8d, 0x07, 0x54, 0x73, 0x11, 0x98, 0xda, 0x19, 0x53, 0xdb, 0x2c, 0xcb, 0x2a, 0xb0, 0x66, 0x6b,
-	0x42, 0xd4, 0x76, 0x19, 0xa1, 0x21, 0x27, 0x34, 0x08, 0x84, 0x8e, 0x91, 0xca, 0xdc, 0xda, 0x79,
-	0x47, 0x92, 0x45, 0x04, 0xdc, 0x4f, 0xee, 0x9d, 0x39, 0x38, 0xf3, 0x34, 0x22, 0xdb, 0xa4, 0x75,
-	0xc5, 0xfc, 0xcd, 0xe8, 0xac, 0x2a, 0x76, 0x95, 0xcb, 0xf6, 0xeb, 0x4c, 0x69, 0xe7, 0x35, 0x9c,
-	0xbd, 0xfc, 0x5a, 0x85, 0x22, 0x50, 0x0c, 0x6d, 0xc0, 0xab, 0xa1, 0x39, 0xdc, 0xe6, 0xbe, 0x9a,
-	0x06, 0x0b, 0xff, 0x2d, 0x8d, 0x97, 0xae, 0xe1, 0x7c, 0x2a, 0x92, 0xe1, 0x46, 0x11, 0x37, 0xf1,
-	0x95, 0xb5, 0xd5, 0x91, 0x4f, 0x3f, 0x3e, 0x2f, 0x03, 0x77, 0xac, 0x89, 0xae, 0xf8, 0xca, 0x39,
-	0x06, 0x70, 0x2e, 0xd3, 0xad, 0x2c, 0x85, 0x52, 0xe5, 0x1d, 0xca, 0x83, 0xa6, 0x1c, 0x34, 0x0f,
-	0xc7, 0x32, 0xed, 0xa6, 0xc1, 0x02, 0x58, 0x2a, 0xb8, 0x30, 0xe5, 0x40, 0x4f, 0x20, 0x4c, 0xd3,
-	0x9b, 0x1e, 0x5e, 0x00, 0x4b, 0x63, 0xa5, 0x1b, 0x38, 0x89, 0x0f, 0x47, 0x51, 0xe3, 0x24, 0x3a,
-	0x13, 0x35, 0xde, 0xa4, 0x35, 0x66, 0xc8, 0xdd, 0x0c, 0xd2, 0xf9, 0x08, 0xa0, 0xdd, 0x4d, 0x8a,
-	0xb1, 0x7e, 0x13, 0x4e, 0x56, 0x45, 0x3d, 0xd0, 0x4c, 0x86, 0x54, 0xea, 0x83, 0x96, 0xfd, 0x82,
-	0x3b, 0x91, 0x3d, 0xaf, 0xf8, 0x0a, 0xad, 0x5f, 0xa2, 0x6a, 0xf1, 0x8f, 0xaa, 0x92, 0x3e, 0x6d,
-	0xb2, 0x1e, 0x9a, 0xe7, 0xa8, 0xa8, 0x66, 0x94, 0x89, 0xbe, 0x5e, 0xf3, 0x71, 0x56, 0x4c, 0xc2,
-	0x9d, 0x04, 0xc6, 0xd5, 0x0c, 0x2c, 0x70, 0xb5, 0x1d, 0xc6, 0x87, 0x31, 0x7e, 0xd4, 0x1d, 0xe5,
-	0x2a, 0x29, 0x72, 0x5e, 0x99, 0x50, 0x2a, 0x2a, 0x0d, 0xa4, 0x3f, 0x01, 0x68, 0x11, 0x4e, 0xe4,
-	0x52, 0x8b, 0xf3, 0x28, 0xb8, 0xe3, 0xed, 0xa1, 0x39, 0x0f, 0xe0, 0x7c, 0xd7, 0x5e, 0x3d, 0x68,
-	0x2d, 0x7d, 0xbd, 0x02, 0x47, 0x62, 0x02, 0x74, 0x0a, 0xe0, 0x44, 0x6e, 0x7e, 0xd1, 0x23, 0xdc,
-	0xcb, 0xde, 0xe2, 0xdf, 0xac, 0x86, 0xb5, 0x3a, 0x08, 0x45, 0xe2, 0xc0, 0xb9, 0x77, 0x1c, 0x6d,
-	0xc1, 0xdb, 0xd3, 0x8b, 0x0f, 0xc3, 0x18, 0xdd, 0x22, 0xed, 0xbb, 0xda, 0xf6, 0xcd, 0x49, 0x5c,
-	0x92, 0xb0, 0xe5, 0xe0, 0x27, 0x80, 0x53, 0x1d, 0xc3, 0x89, 0xca, 0x7d, 0x8b, 0xea, 0xdc, 0x32,
-	0x6b, 0x6d, 0x30, 0x12, 0xe3, 0x6d, 0x2b, 0xf5, 0xb6, 0x81, 0x2a, 0xfd, 0x78, 0x23, 0x87, 0x99,
-	0x19, 0x3a, 0x22, 0x99, 0xc1, 0xe0, 0x4c, 0xa1, 0x0b, 0x00, 0x27, 0xf3, 0xe3, 0x8b, 0xfa, 0x79,
-	0x8c, 0x2e, 0xcb, 0x63, 0x95, 0x07, 0xe2, 0x30, 0xae, 0xd7, 0x53, 0xd7, 0x2b, 0xe8, 0xfe, 0xdf,
-	0xbb, 0x46, 0xef, 0x86, 0x21, 0xea, 0x9c, 0x7d, 0xb4, 0xd6, 0x97, 0xc8, 0x2e, 0x6b, 0x6a, 0x3d,
-	0x1e, 0x90, 0xc5, 0x98, 0xdd, 0x49, 0xcd, 0xbe, 0x40, 0xcf, 0xff, 0xd9, 0x13, 0x93, 0xc3, 0xdc,
-	0x27, 0xe2, 0x68, 0x75, 0xeb, 0xcb, 0x99, 0x0d, 0x4e, 0xce, 0x6c, 0xf0, 0xfd, 0xcc, 0x06, 0xef,
-	0xcf, 0xed, 0xa1, 0x93, 0x73, 0x7b, 0xe8, 0xdb, 0xb9, 0x3d, 0xf4, 0x6c, 0xa5, 0xc6, 0xf5, 0x4e,
-	0xdd, 0x8b, 0x2c, 0x24, 0x02, 0x6e, 0x53, 0xa5, 0x98, 0x56, 0x2d, 0x1d, 0x8d, 0x12, 0xd1, 0x07,
-	0x21, 0x53, 0x97, 0xfd, 0x9b, 0x7b, 0xff, 0xc7, 0x32, 0xee, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0x48, 0x1e, 0x70, 0xd2, 0xf9, 0x07, 0x00, 0x00,
+func (m *QueryInFlightPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInFlightPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketsRequest.Merge(m, src)
+}
+func (m *QueryInFlightPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryInFlightPacketsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// PausedProtocols retrieves all paused protocol IDs.
-	PausedProtocols(ctx context.Context, in *QueryPausedProtocolsRequest, opts ...grpc.CallOption) (*QueryPausedProtocolsResponse, error)
-	// PausedCrossChains retrieves all paused counterparties for a specific protocol.
-	PausedCrossChains(ctx context.Context, in *QueryPausedCrossChainsRequest, opts ...grpc.CallOption) (*QueryPausedCrossChainsResponse, error)
-	// IsProtocolPaused checks if a specific protocol is paused.
-	IsProtocolPaused(ctx context.Context, in *QueryIsProtocolPausedRequest, opts ...grpc.CallOption) (*QueryIsProtocolPausedResponse, error)
-	// IsCrossChainPaused checks if a specific counterparty for a protocol is paused.
-	IsCrossChainPaused(ctx context.Context, in *QueryIsCrossChainPausedRequest, opts ...grpc.CallOption) (*QueryIsCrossChainPausedResponse, error)
+func (m *QueryInFlightPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryInFlightPacketsResponse is the response type for the Query/InFlightPackets RPC method.
+type QueryInFlightPacketsResponse struct {
+	// packets is the list of in-flight packets.
+	Packets []InFlightPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryInFlightPacketsResponse) Reset()         { *m = QueryInFlightPacketsResponse{} }
+func (m *QueryInFlightPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketsResponse) ProtoMessage()    {}
+func (*QueryInFlightPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{9}
 }
-
-func (c *queryClient) PausedProtocols(ctx context.Context, in *QueryPausedProtocolsRequest, opts ...grpc.CallOption) (*QueryPausedProtocolsResponse, error) {
-	out := new(QueryPausedProtocolsResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/PausedProtocols", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryInFlightPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) PausedCrossChains(ctx context.Context, in *QueryPausedCrossChainsRequest, opts ...grpc.CallOption) (*QueryPausedCrossChainsResponse, error) {
-	out := new(QueryPausedCrossChainsResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/PausedCrossChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInFlightPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryInFlightPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketsResponse.Merge(m, src)
+}
+func (m *QueryInFlightPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) IsProtocolPaused(ctx context.Context, in *QueryIsProtocolPausedRequest, opts ...grpc.CallOption) (*QueryIsProtocolPausedResponse, error) {
-	out := new(QueryIsProtocolPausedResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/IsProtocolPaused", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInFlightPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketsResponse) GetPackets() []InFlightPacket {
+	if m != nil {
+		return m.Packets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) IsCrossChainPaused(ctx context.Context, in *QueryIsCrossChainPausedRequest, opts ...grpc.CallOption) (*QueryIsCrossChainPausedResponse, error) {
-	out := new(QueryIsCrossChainPausedResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/IsCrossChainPaused", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryInFlightPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// PausedProtocols retrieves all paused protocol IDs.
-	PausedProtocols(context.Context, *QueryPausedProtocolsRequest) (*QueryPausedProtocolsResponse, error)
-	// PausedCrossChains retrieves all paused counterparties for a specific protocol.
-	PausedCrossChains(context.Context, *QueryPausedCrossChainsRequest) (*QueryPausedCrossChainsResponse, error)
-	// IsProtocolPaused checks if a specific protocol is paused.
-	IsProtocolPaused(context.Context, *QueryIsProtocolPausedRequest) (*QueryIsProtocolPausedResponse, error)
-	// IsCrossChainPaused checks if a specific counterparty for a protocol is paused.
-	IsCrossChainPaused(context.Context, *QueryIsCrossChainPausedRequest) (*QueryIsCrossChainPausedResponse, error)
+// QueryInFlightPacketRequest is the request type for the Query/InFlightPacket RPC method.
+type QueryInFlightPacketRequest struct {
+	// channel_id is the Noble channel used to send the packet.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet on the channel.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryInFlightPacketRequest) Reset()         { *m = QueryInFlightPacketRequest{} }
+func (m *QueryInFlightPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketRequest) ProtoMessage()    {}
+func (*QueryInFlightPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{10}
 }
-
-func (*UnimplementedQueryServer) PausedProtocols(ctx context.Context, req *QueryPausedProtocolsRequest) (*QueryPausedProtocolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PausedProtocols not implemented")
+func (m *QueryInFlightPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) PausedCrossChains(ctx context.Context, req *QueryPausedCrossChainsRequest) (*QueryPausedCrossChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PausedCrossChains not implemented")
+func (m *QueryInFlightPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) IsProtocolPaused(ctx context.Context, req *QueryIsProtocolPausedRequest) (*QueryIsProtocolPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsProtocolPaused not implemented")
+func (m *QueryInFlightPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) IsCrossChainPaused(ctx context.Context, req *QueryIsCrossChainPausedRequest) (*QueryIsCrossChainPausedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsCrossChainPaused not implemented")
+func (m *QueryInFlightPacketRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryInFlightPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketRequest.DiscardUnknown(m)
 }
 
-func _Query_PausedProtocols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPausedProtocolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PausedProtocols(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/PausedProtocols",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PausedProtocols(ctx, req.(*QueryPausedProtocolsRequest))
+var xxx_messageInfo_QueryInFlightPacketRequest proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_PausedCrossChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPausedCrossChainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PausedCrossChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/PausedCrossChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PausedCrossChains(ctx, req.(*QueryPausedCrossChainsRequest))
+func (m *QueryInFlightPacketRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_IsProtocolPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsProtocolPausedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsProtocolPaused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/IsProtocolPaused",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsProtocolPaused(ctx, req.(*QueryIsProtocolPausedRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryInFlightPacketResponse is the response type for the Query/InFlightPacket RPC method.
+type QueryInFlightPacketResponse struct {
+	Packet InFlightPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
 }
 
-func _Query_IsCrossChainPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIsCrossChainPausedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IsCrossChainPaused(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/IsCrossChainPaused",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IsCrossChainPaused(ctx, req.(*QueryIsCrossChainPausedRequest))
+func (m *QueryInFlightPacketResponse) Reset()         { *m = QueryInFlightPacketResponse{} }
+func (m *QueryInFlightPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInFlightPacketResponse) ProtoMessage()    {}
+func (*QueryInFlightPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{11}
+}
+func (m *QueryInFlightPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInFlightPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInFlightPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.orbiter.component.forwarder.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PausedProtocols",
-			Handler:    _Query_PausedProtocols_Handler,
-		},
-		{
-			MethodName: "PausedCrossChains",
-			Handler:    _Query_PausedCrossChains_Handler,
-		},
-		{
-			MethodName: "IsProtocolPaused",
-			Handler:    _Query_IsProtocolPaused_Handler,
-		},
-		{
-			MethodName: "IsCrossChainPaused",
-			Handler:    _Query_IsCrossChainPaused_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/orbiter/component/forwarder/v1/query.proto",
+func (m *QueryInFlightPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInFlightPacketResponse.Merge(m, src)
+}
+func (m *QueryInFlightPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInFlightPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInFlightPacketResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPausedProtocolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryInFlightPacketResponse proto.InternalMessageInfo
+
+func (m *QueryInFlightPacketResponse) GetPacket() InFlightPacket {
+	if m != nil {
+		return m.Packet
 	}
-	return dAtA[:n], nil
+	return InFlightPacket{}
 }
 
-func (m *QueryPausedProtocolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest is the request type for the Params query.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryPausedProtocolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{12}
 }
-
-func (m *QueryPausedProtocolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPausedProtocolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Params query.
+type QueryParamsResponse struct {
+	// params holds all the parameters of the forwarder component.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryPausedProtocolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProtocolIds) > 0 {
-		dAtA2 := make([]byte, len(m.ProtocolIds)*10)
-		var j1 int
-		for _, num := range m.ProtocolIds {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{13}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintQuery(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPausedCrossChainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *QueryPausedCrossChainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEscrowedPacketsRequest is the request type for the Query/EscrowedPackets RPC method.
+type QueryEscrowedPacketsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPausedCrossChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryEscrowedPacketsRequest) Reset()         { *m = QueryEscrowedPacketsRequest{} }
+func (m *QueryEscrowedPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowedPacketsRequest) ProtoMessage()    {}
+func (*QueryEscrowedPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{14}
+}
+func (m *QueryEscrowedPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowedPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowedPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProtocolId) > 0 {
-		i -= len(m.ProtocolId)
-		copy(dAtA[i:], m.ProtocolId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEscrowedPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowedPacketsRequest.Merge(m, src)
+}
+func (m *QueryEscrowedPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowedPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowedPacketsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPausedCrossChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEscrowedPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowedPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPausedCrossChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEscrowedPacketsResponse is the response type for the Query/EscrowedPackets RPC method.
+type QueryEscrowedPacketsResponse struct {
+	// packets is the list of escrowed packets.
+	Packets []EscrowedPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPausedCrossChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.CounterpartyIds) > 0 {
-		for iNdEx := len(m.CounterpartyIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CounterpartyIds[iNdEx])
-			copy(dAtA[i:], m.CounterpartyIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryEscrowedPacketsResponse) Reset()         { *m = QueryEscrowedPacketsResponse{} }
+func (m *QueryEscrowedPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowedPacketsResponse) ProtoMessage()    {}
+func (*QueryEscrowedPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{15}
+}
+func (m *QueryEscrowedPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowedPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowedPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryIsProtocolPausedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryEscrowedPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowedPacketsResponse.Merge(m, src)
 }
-
-func (m *QueryIsProtocolPausedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEscrowedPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowedPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowedPacketsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIsProtocolPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProtocolId) > 0 {
-		i -= len(m.ProtocolId)
-		copy(dAtA[i:], m.ProtocolId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryEscrowedPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowedPacketsResponse) GetPackets() []EscrowedPacket {
+	if m != nil {
+		return m.Packets
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryIsProtocolPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEscrowedPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIsProtocolPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEscrowedPacketRequest is the request type for the Query/EscrowedPacket RPC method.
+type QueryEscrowedPacketRequest struct {
+	// id is the identifier of the escrowed packet.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryIsProtocolPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsPaused {
-		i--
-		if m.IsPaused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryEscrowedPacketRequest) Reset()         { *m = QueryEscrowedPacketRequest{} }
+func (m *QueryEscrowedPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowedPacketRequest) ProtoMessage()    {}
+func (*QueryEscrowedPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{16}
+}
+func (m *QueryEscrowedPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowedPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowedPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryEscrowedPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowedPacketRequest.Merge(m, src)
+}
+func (m *QueryEscrowedPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowedPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowedPacketRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIsCrossChainPausedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEscrowedPacketRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowedPacketRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryIsCrossChainPausedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEscrowedPacketResponse is the response type for the Query/EscrowedPacket RPC method.
+type QueryEscrowedPacketResponse struct {
+	Packet EscrowedPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
 }
 
-func (m *QueryIsCrossChainPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CounterpartyId) > 0 {
-		i -= len(m.CounterpartyId)
-		copy(dAtA[i:], m.CounterpartyId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryEscrowedPacketResponse) Reset()         { *m = QueryEscrowedPacketResponse{} }
+func (m *QueryEscrowedPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowedPacketResponse) ProtoMessage()    {}
+func (*QueryEscrowedPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{17}
+}
+func (m *QueryEscrowedPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowedPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowedPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ProtocolId) > 0 {
-		i -= len(m.ProtocolId)
-		copy(dAtA[i:], m.ProtocolId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryEscrowedPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowedPacketResponse.Merge(m, src)
+}
+func (m *QueryEscrowedPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowedPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowedPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowedPacketResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowedPacketResponse) GetPacket() EscrowedPacket {
+	if m != nil {
+		return m.Packet
 	}
-	return len(dAtA) - i, nil
+	return EscrowedPacket{}
 }
 
-func (m *QueryIsCrossChainPausedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryDeferredPacketsRequest is the request type for the Query/DeferredPackets RPC method.
+type QueryDeferredPacketsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeferredPacketsRequest) Reset()         { *m = QueryDeferredPacketsRequest{} }
+func (m *QueryDeferredPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredPacketsRequest) ProtoMessage()    {}
+func (*QueryDeferredPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{18}
+}
+func (m *QueryDeferredPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDeferredPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredPacketsRequest.Merge(m, src)
+}
+func (m *QueryDeferredPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredPacketsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIsCrossChainPausedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDeferredPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryDeferredPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryIsCrossChainPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsPaused {
-		i--
-		if m.IsPaused {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// QueryDeferredPacketsResponse is the response type for the Query/DeferredPackets RPC method.
+type QueryDeferredPacketsResponse struct {
+	// packets is the list of deferred packets.
+	Packets []DeferredPacket `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDeferredPacketsResponse) Reset()         { *m = QueryDeferredPacketsResponse{} }
+func (m *QueryDeferredPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredPacketsResponse) ProtoMessage()    {}
+func (*QueryDeferredPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{19}
+}
+func (m *QueryDeferredPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDeferredPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredPacketsResponse.Merge(m, src)
+}
+func (m *QueryDeferredPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredPacketsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryDeferredPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryDeferredPacketsResponse) GetPackets() []DeferredPacket {
+	if m != nil {
+		return m.Packets
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryPausedProtocolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryDeferredPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryPausedProtocolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryDeferredPacketRequest is the request type for the Query/DeferredPacket RPC method.
+type QueryDeferredPacketRequest struct {
+	// id is the identifier of the deferred packet.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryDeferredPacketRequest) Reset()         { *m = QueryDeferredPacketRequest{} }
+func (m *QueryDeferredPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredPacketRequest) ProtoMessage()    {}
+func (*QueryDeferredPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{20}
+}
+func (m *QueryDeferredPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.ProtocolIds) > 0 {
-		l = 0
-		for _, e := range m.ProtocolIds {
-			l += sovQuery(uint64(e))
+}
+func (m *QueryDeferredPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredPacketRequest.Merge(m, src)
+}
+func (m *QueryDeferredPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredPacketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeferredPacketRequest proto.InternalMessageInfo
+
+func (m *QueryDeferredPacketRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryDeferredPacketResponse is the response type for the Query/DeferredPacket RPC method.
+type QueryDeferredPacketResponse struct {
+	Packet DeferredPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *QueryDeferredPacketResponse) Reset()         { *m = QueryDeferredPacketResponse{} }
+func (m *QueryDeferredPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeferredPacketResponse) ProtoMessage()    {}
+func (*QueryDeferredPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{21}
+}
+func (m *QueryDeferredPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeferredPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeferredPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovQuery(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryDeferredPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeferredPacketResponse.Merge(m, src)
+}
+func (m *QueryDeferredPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeferredPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeferredPacketResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPausedCrossChainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDeferredPacketResponse proto.InternalMessageInfo
+
+func (m *QueryDeferredPacketResponse) GetPacket() DeferredPacket {
+	if m != nil {
+		return m.Packet
 	}
-	var l int
-	_ = l
-	l = len(m.ProtocolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return DeferredPacket{}
+}
+
+// QueryAmountLimitsRequest is the request type for the Query/AmountLimits RPC method.
+type QueryAmountLimitsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAmountLimitsRequest) Reset()         { *m = QueryAmountLimitsRequest{} }
+func (m *QueryAmountLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAmountLimitsRequest) ProtoMessage()    {}
+func (*QueryAmountLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{22}
+}
+func (m *QueryAmountLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmountLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmountLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAmountLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmountLimitsRequest.Merge(m, src)
+}
+func (m *QueryAmountLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmountLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmountLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAmountLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryAmountLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryPausedCrossChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryAmountLimitsResponse is the response type for the Query/AmountLimits RPC method.
+type QueryAmountLimitsResponse struct {
+	// limits is the list of amount limits.
+	Limits []AmountLimit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAmountLimitsResponse) Reset()         { *m = QueryAmountLimitsResponse{} }
+func (m *QueryAmountLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAmountLimitsResponse) ProtoMessage()    {}
+func (*QueryAmountLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{23}
+}
+func (m *QueryAmountLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmountLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmountLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.CounterpartyIds) > 0 {
-		for _, s := range m.CounterpartyIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAmountLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmountLimitsResponse.Merge(m, src)
+}
+func (m *QueryAmountLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmountLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmountLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAmountLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryAmountLimitsResponse) GetLimits() []AmountLimit {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func (m *QueryAmountLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAmountLimitRequest is the request type for the Query/AmountLimit RPC method.
+type QueryAmountLimitRequest struct {
+	// protocol_id is the bridge protocol of the destination.
+	ProtocolId string `protobuf:"bytes,1,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	// counterparty_id is the identifier of the destination for the protocol.
+	CounterpartyId string `protobuf:"bytes,2,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// denom is the Noble denom forwarded.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryAmountLimitRequest) Reset()         { *m = QueryAmountLimitRequest{} }
+func (m *QueryAmountLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAmountLimitRequest) ProtoMessage()    {}
+func (*QueryAmountLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{24}
+}
+func (m *QueryAmountLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmountLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmountLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAmountLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmountLimitRequest.Merge(m, src)
+}
+func (m *QueryAmountLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmountLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmountLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAmountLimitRequest proto.InternalMessageInfo
+
+func (m *QueryAmountLimitRequest) GetProtocolId() string {
+	if m != nil {
+		return m.ProtocolId
+	}
+	return ""
+}
+
+func (m *QueryAmountLimitRequest) GetCounterpartyId() string {
+	if m != nil {
+		return m.CounterpartyId
+	}
+	return ""
+}
+
+func (m *QueryAmountLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryAmountLimitResponse is the response type for the Query/AmountLimit RPC method.
+type QueryAmountLimitResponse struct {
+	Limit AmountLimit `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+}
+
+func (m *QueryAmountLimitResponse) Reset()         { *m = QueryAmountLimitResponse{} }
+func (m *QueryAmountLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAmountLimitResponse) ProtoMessage()    {}
+func (*QueryAmountLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{25}
+}
+func (m *QueryAmountLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAmountLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAmountLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAmountLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAmountLimitResponse.Merge(m, src)
+}
+func (m *QueryAmountLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAmountLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAmountLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAmountLimitResponse proto.InternalMessageInfo
+
+func (m *QueryAmountLimitResponse) GetLimit() AmountLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return AmountLimit{}
+}
+
+// QueryHyperlaneTokenRoutesRequest is the request type for the Query/HyperlaneTokenRoutes RPC method.
+type QueryHyperlaneTokenRoutesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHyperlaneTokenRoutesRequest) Reset()         { *m = QueryHyperlaneTokenRoutesRequest{} }
+func (m *QueryHyperlaneTokenRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHyperlaneTokenRoutesRequest) ProtoMessage()    {}
+func (*QueryHyperlaneTokenRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{26}
+}
+func (m *QueryHyperlaneTokenRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHyperlaneTokenRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHyperlaneTokenRoutesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHyperlaneTokenRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHyperlaneTokenRoutesRequest.Merge(m, src)
+}
+func (m *QueryHyperlaneTokenRoutesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHyperlaneTokenRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHyperlaneTokenRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHyperlaneTokenRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryHyperlaneTokenRoutesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHyperlaneTokenRoutesResponse is the response type for the Query/HyperlaneTokenRoutes RPC method.
+type QueryHyperlaneTokenRoutesResponse struct {
+	// routes is the list of Hyperlane warp token routes.
+	Routes []HyperlaneTokenRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryHyperlaneTokenRoutesResponse) Reset()         { *m = QueryHyperlaneTokenRoutesResponse{} }
+func (m *QueryHyperlaneTokenRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHyperlaneTokenRoutesResponse) ProtoMessage()    {}
+func (*QueryHyperlaneTokenRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{27}
+}
+func (m *QueryHyperlaneTokenRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHyperlaneTokenRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHyperlaneTokenRoutesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHyperlaneTokenRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHyperlaneTokenRoutesResponse.Merge(m, src)
+}
+func (m *QueryHyperlaneTokenRoutesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHyperlaneTokenRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHyperlaneTokenRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHyperlaneTokenRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryHyperlaneTokenRoutesResponse) GetRoutes() []HyperlaneTokenRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *QueryHyperlaneTokenRoutesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryHyperlaneTokenRouteRequest is the request type for the Query/HyperlaneTokenRoute RPC method.
+type QueryHyperlaneTokenRouteRequest struct {
+	// denom is the Noble denom forwarded.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// destination_domain is the Hyperlane domain of the destination chain.
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+}
+
+func (m *QueryHyperlaneTokenRouteRequest) Reset()         { *m = QueryHyperlaneTokenRouteRequest{} }
+func (m *QueryHyperlaneTokenRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHyperlaneTokenRouteRequest) ProtoMessage()    {}
+func (*QueryHyperlaneTokenRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{28}
+}
+func (m *QueryHyperlaneTokenRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHyperlaneTokenRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHyperlaneTokenRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHyperlaneTokenRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHyperlaneTokenRouteRequest.Merge(m, src)
+}
+func (m *QueryHyperlaneTokenRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHyperlaneTokenRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHyperlaneTokenRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHyperlaneTokenRouteRequest proto.InternalMessageInfo
+
+func (m *QueryHyperlaneTokenRouteRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryHyperlaneTokenRouteRequest) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+// QueryHyperlaneTokenRouteResponse is the response type for the Query/HyperlaneTokenRoute RPC method.
+type QueryHyperlaneTokenRouteResponse struct {
+	Route HyperlaneTokenRoute `protobuf:"bytes,1,opt,name=route,proto3" json:"route"`
+}
+
+func (m *QueryHyperlaneTokenRouteResponse) Reset()         { *m = QueryHyperlaneTokenRouteResponse{} }
+func (m *QueryHyperlaneTokenRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHyperlaneTokenRouteResponse) ProtoMessage()    {}
+func (*QueryHyperlaneTokenRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aaf39c97a61ced58, []int{29}
+}
+func (m *QueryHyperlaneTokenRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHyperlaneTokenRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHyperlaneTokenRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHyperlaneTokenRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHyperlaneTokenRouteResponse.Merge(m, src)
+}
+func (m *QueryHyperlaneTokenRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHyperlaneTokenRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHyperlaneTokenRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHyperlaneTokenRouteResponse proto.InternalMessageInfo
+
+func (m *QueryHyperlaneTokenRouteResponse) GetRoute() HyperlaneTokenRoute {
+	if m != nil {
+		return m.Route
+	}
+	return HyperlaneTokenRoute{}
+}
+
+func init() {
+	proto.RegisterType((*QueryPausedProtocolsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryPausedProtocolsRequest")
+	proto.RegisterType((*QueryPausedProtocolsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryPausedProtocolsResponse")
+	proto.RegisterType((*QueryPausedCrossChainsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryPausedCrossChainsRequest")
+	proto.RegisterType((*QueryPausedCrossChainsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryPausedCrossChainsResponse")
+	proto.RegisterType((*QueryIsProtocolPausedRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryIsProtocolPausedRequest")
+	proto.RegisterType((*QueryIsProtocolPausedResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryIsProtocolPausedResponse")
+	proto.RegisterType((*QueryIsCrossChainPausedRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryIsCrossChainPausedRequest")
+	proto.RegisterType((*QueryIsCrossChainPausedResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryIsCrossChainPausedResponse")
+	proto.RegisterType((*QueryInFlightPacketsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryInFlightPacketsRequest")
+	proto.RegisterType((*QueryInFlightPacketsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryInFlightPacketsResponse")
+	proto.RegisterType((*QueryInFlightPacketRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryInFlightPacketRequest")
+	proto.RegisterType((*QueryInFlightPacketResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryInFlightPacketResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryEscrowedPacketsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryEscrowedPacketsRequest")
+	proto.RegisterType((*QueryEscrowedPacketsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryEscrowedPacketsResponse")
+	proto.RegisterType((*QueryEscrowedPacketRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryEscrowedPacketRequest")
+	proto.RegisterType((*QueryEscrowedPacketResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryEscrowedPacketResponse")
+	proto.RegisterType((*QueryDeferredPacketsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryDeferredPacketsRequest")
+	proto.RegisterType((*QueryDeferredPacketsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryDeferredPacketsResponse")
+	proto.RegisterType((*QueryDeferredPacketRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryDeferredPacketRequest")
+	proto.RegisterType((*QueryDeferredPacketResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryDeferredPacketResponse")
+	proto.RegisterType((*QueryAmountLimitsRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryAmountLimitsRequest")
+	proto.RegisterType((*QueryAmountLimitsResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryAmountLimitsResponse")
+	proto.RegisterType((*QueryAmountLimitRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryAmountLimitRequest")
+	proto.RegisterType((*QueryAmountLimitResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryAmountLimitResponse")
+	proto.RegisterType((*QueryHyperlaneTokenRoutesRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryHyperlaneTokenRoutesRequest")
+	proto.RegisterType((*QueryHyperlaneTokenRoutesResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryHyperlaneTokenRoutesResponse")
+	proto.RegisterType((*QueryHyperlaneTokenRouteRequest)(nil), "noble.orbiter.component.forwarder.v1.QueryHyperlaneTokenRouteRequest")
+	proto.RegisterType((*QueryHyperlaneTokenRouteResponse)(nil), "noble.orbiter.component.forwarder.v1.QueryHyperlaneTokenRouteResponse")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/component/forwarder/v1/query.proto", fileDescriptor_aaf39c97a61ced58)
+}
+
+var fileDescriptor_aaf39c97a61ced58 = []byte{
+	// 1445 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdf, 0x6f, 0x14, 0x55,
+	0x14, 0xee, 0x2d, 0xb4, 0xd2, 0x5b, 0x6c, 0xe1, 0xd2, 0xc4, 0x3a, 0xd0, 0xa5, 0x4c, 0x88, 0xfc,
+	0x10, 0xe6, 0xba, 0x85, 0x18, 0x21, 0x58, 0xec, 0xf6, 0x97, 0x1b, 0x34, 0x94, 0x11, 0x31, 0x2a,
+	0xba, 0x99, 0xdd, 0xb9, 0xdd, 0x0e, 0x74, 0xe7, 0x2e, 0x73, 0xa7, 0x25, 0xcd, 0xa6, 0x2f, 0x3c,
+	0x91, 0xf8, 0x62, 0xa2, 0x7f, 0x84, 0x4f, 0xc6, 0x67, 0x1f, 0x8d, 0x89, 0xc4, 0x27, 0x12, 0x34,
+	0xf1, 0xc9, 0x18, 0x20, 0x51, 0x13, 0x9f, 0x7d, 0x36, 0x33, 0x73, 0xe6, 0xe7, 0xce, 0x2e, 0x3b,
+	0x3b, 0x5b, 0x5f, 0x48, 0xf7, 0xce, 0x3d, 0xdf, 0x39, 0xdf, 0xf9, 0xce, 0xdc, 0x3b, 0x5f, 0xc0,
+	0x6f, 0x98, 0xbc, 0xba, 0xc9, 0x28, 0xb7, 0xaa, 0x86, 0xcd, 0x2c, 0x5a, 0xe3, 0x8d, 0x26, 0x37,
+	0x99, 0x69, 0xd3, 0x75, 0x6e, 0xdd, 0xd7, 0x2c, 0x9d, 0x59, 0x74, 0xbb, 0x48, 0xef, 0x6d, 0x31,
+	0x6b, 0x47, 0x69, 0x5a, 0xdc, 0xe6, 0xe4, 0xa4, 0x1b, 0xa1, 0x40, 0x84, 0x12, 0x44, 0x28, 0x41,
+	0x84, 0xb2, 0x5d, 0x94, 0x0e, 0x6b, 0x0d, 0xc3, 0xe4, 0xd4, 0xfd, 0xd7, 0x0b, 0x94, 0xce, 0xd6,
+	0xb8, 0x68, 0x70, 0x41, 0xab, 0x9a, 0x60, 0x1e, 0x22, 0xdd, 0x2e, 0x56, 0x99, 0xad, 0x15, 0x69,
+	0x53, 0xab, 0x1b, 0xa6, 0x66, 0x1b, 0xdc, 0x84, 0xbd, 0x47, 0x61, 0xaf, 0xbf, 0x2d, 0x5a, 0x81,
+	0x34, 0x55, 0xe7, 0x75, 0xee, 0xfe, 0x49, 0x9d, 0xbf, 0x60, 0xf5, 0x58, 0x9d, 0xf3, 0xfa, 0x26,
+	0xa3, 0x5a, 0xd3, 0xa0, 0x9a, 0x69, 0x72, 0xdb, 0xc5, 0x13, 0xf0, 0xf4, 0x62, 0x4f, 0x3c, 0x83,
+	0x1f, 0x10, 0x55, 0x48, 0x46, 0x59, 0xcc, 0xd9, 0x66, 0xe8, 0xde, 0x73, 0x79, 0x06, 0x1f, 0xbd,
+	0xe1, 0x14, 0xb6, 0xa6, 0x6d, 0x09, 0xa6, 0xaf, 0x39, 0x6b, 0x35, 0xbe, 0x29, 0x54, 0x76, 0x6f,
+	0x8b, 0x09, 0x5b, 0xbe, 0x8b, 0x8f, 0xa5, 0x3f, 0x16, 0x4d, 0x6e, 0x0a, 0x46, 0xae, 0xe1, 0x83,
+	0x4d, 0x58, 0xac, 0x18, 0xba, 0x98, 0x46, 0xb3, 0xfb, 0x4e, 0x4f, 0xcc, 0x9d, 0x50, 0x92, 0x1d,
+	0xb6, 0x98, 0xb2, 0x5d, 0x54, 0xfc, 0xf8, 0xf2, 0x52, 0x69, 0xe4, 0x9b, 0x3f, 0xbf, 0x3b, 0x8b,
+	0xd4, 0x71, 0x3f, 0xba, 0xac, 0x0b, 0xf9, 0x21, 0xc2, 0x33, 0x91, 0x6c, 0x8b, 0x16, 0x17, 0x62,
+	0x71, 0x43, 0x33, 0x4c, 0xbf, 0x1c, 0x72, 0x1c, 0x8f, 0x47, 0xd2, 0x4d, 0xa3, 0x59, 0x74, 0x7a,
+	0x4c, 0xc5, 0x21, 0x06, 0x59, 0xc1, 0x38, 0x54, 0x62, 0x7a, 0x78, 0x16, 0x9d, 0x1e, 0x9f, 0x7b,
+	0x4d, 0xf1, 0xa4, 0x50, 0x1c, 0xd9, 0x14, 0x4f, 0x06, 0x90, 0x4d, 0x59, 0xd3, 0xea, 0x0c, 0xc0,
+	0xd5, 0x48, 0xa4, 0xfc, 0x35, 0xc2, 0x85, 0x4e, 0xa5, 0x00, 0xf5, 0x33, 0xf8, 0x50, 0x8d, 0x6f,
+	0x99, 0x36, 0xb3, 0x9a, 0x9a, 0x65, 0xef, 0x04, 0xf4, 0xc7, 0xd4, 0xc9, 0xe8, 0x7a, 0x59, 0x17,
+	0x64, 0x35, 0xa5, 0xaa, 0x53, 0x2f, 0xac, 0xca, 0xcb, 0x13, 0x2b, 0xeb, 0x2a, 0xc8, 0x51, 0x16,
+	0x7e, 0x2b, 0xbd, 0xfa, 0x7a, 0xed, 0x8f, 0x7c, 0x05, 0xcf, 0x74, 0x00, 0x00, 0x56, 0x47, 0xf1,
+	0x98, 0x21, 0x2a, 0x4d, 0x77, 0xd1, 0x8d, 0x3f, 0xa0, 0x1e, 0x30, 0x84, 0xb7, 0x49, 0xbe, 0x03,
+	0x4d, 0x29, 0x8b, 0xb0, 0x21, 0xd9, 0x0a, 0x20, 0xa7, 0xf0, 0x64, 0xa2, 0x6b, 0x6e, 0x3f, 0xc6,
+	0xd4, 0x89, 0x78, 0xd3, 0xe4, 0x79, 0x7c, 0xbc, 0x63, 0xae, 0x5e, 0x6a, 0x65, 0x30, 0xd8, 0x65,
+	0x73, 0x65, 0xd3, 0xa8, 0x6f, 0xd8, 0x6b, 0x5a, 0xed, 0x2e, 0xb3, 0x83, 0x49, 0x8a, 0x0f, 0x0a,
+	0xea, 0x7b, 0x50, 0x7e, 0x40, 0xf8, 0x58, 0x7a, 0x1e, 0x28, 0xf2, 0x63, 0xfc, 0x52, 0xd3, 0x5b,
+	0x72, 0xa7, 0x63, 0x7c, 0xee, 0xa2, 0xd2, 0xcb, 0xf1, 0xa3, 0xc4, 0xf1, 0x4a, 0x63, 0x8f, 0x7e,
+	0x3f, 0x3e, 0xe4, 0xbd, 0x33, 0x3e, 0xde, 0xe0, 0xc6, 0xea, 0x23, 0x2c, 0xa5, 0x70, 0xf0, 0x5b,
+	0x35, 0x83, 0x71, 0x6d, 0x43, 0x33, 0x4d, 0x16, 0x91, 0x74, 0x0c, 0x56, 0xca, 0x3a, 0x91, 0xf0,
+	0x01, 0xe1, 0xec, 0x34, 0x6b, 0xcc, 0xad, 0x61, 0xbf, 0x1a, 0xfc, 0x96, 0xef, 0xa5, 0x8a, 0x10,
+	0xf4, 0x46, 0xc5, 0xa3, 0x1e, 0x17, 0x10, 0xa0, 0xbf, 0xd6, 0xec, 0x77, 0x5a, 0xa3, 0x02, 0x92,
+	0x3c, 0x85, 0x09, 0xbc, 0xb8, 0x96, 0xd6, 0x08, 0xce, 0xb1, 0x75, 0x7c, 0x24, 0xb6, 0x0a, 0x05,
+	0x5c, 0x77, 0x0a, 0x70, 0x56, 0xa0, 0x80, 0x73, 0xbd, 0x15, 0xe0, 0xa1, 0x44, 0x35, 0x01, 0x98,
+	0x60, 0xea, 0x96, 0x45, 0xcd, 0xe2, 0xf7, 0x99, 0xbe, 0xd7, 0x53, 0xd7, 0x96, 0x27, 0xe7, 0xd4,
+	0xc5, 0xf1, 0xf6, 0x76, 0xea, 0xce, 0xc1, 0xd4, 0xc5, 0x73, 0xfa, 0xad, 0x9a, 0xc0, 0xc3, 0x30,
+	0x6d, 0xfb, 0xd5, 0x61, 0x43, 0x0f, 0x46, 0x29, 0xb9, 0x3b, 0xdf, 0x28, 0x25, 0xf8, 0xc6, 0x47,
+	0xc9, 0x17, 0x73, 0x89, 0xad, 0x33, 0xcb, 0xda, 0x7b, 0x31, 0xdb, 0xf2, 0xe4, 0x14, 0x33, 0x8e,
+	0xf7, 0xff, 0x88, 0x19, 0xcf, 0xf9, 0x22, 0x31, 0x93, 0xbb, 0xf3, 0x89, 0x99, 0xe0, 0x1b, 0x17,
+	0xb3, 0x8a, 0xa7, 0xdd, 0x94, 0x0b, 0x0d, 0xe7, 0x9e, 0x79, 0xcf, 0x68, 0x18, 0x83, 0x57, 0xf2,
+	0x7b, 0x84, 0x5f, 0x4d, 0x49, 0x02, 0xac, 0x6e, 0xe2, 0xd1, 0x4d, 0x77, 0x05, 0x54, 0x2c, 0xf6,
+	0xc6, 0x2a, 0x82, 0x15, 0x3b, 0x71, 0x3c, 0xac, 0xc1, 0x29, 0xb8, 0x83, 0x5f, 0x49, 0xd6, 0x3e,
+	0xf0, 0x5b, 0x9d, 0x4c, 0xe1, 0x11, 0x9d, 0x99, 0xbc, 0x31, 0xbd, 0xcf, 0x7d, 0xec, 0xfd, 0x90,
+	0x8d, 0x76, 0x6d, 0x82, 0xae, 0xbd, 0x8f, 0x47, 0x5c, 0xa6, 0x20, 0x4b, 0x1f, 0x4d, 0xf3, 0xe6,
+	0xc0, 0x43, 0x91, 0xef, 0xe0, 0x59, 0x37, 0xd5, 0xbb, 0x3b, 0x4d, 0x66, 0x6d, 0x6a, 0x26, 0xbb,
+	0xc9, 0xef, 0x32, 0x53, 0xe5, 0x5b, 0x36, 0x1b, 0xf8, 0x38, 0xfc, 0x8c, 0xf0, 0x89, 0x2e, 0xc9,
+	0x80, 0xe0, 0x6d, 0x3c, 0x6a, 0xb9, 0x2b, 0x30, 0x16, 0x97, 0x7a, 0x63, 0x98, 0x82, 0x19, 0x1b,
+	0x0f, 0x0f, 0x73, 0x70, 0xe3, 0xb1, 0x0e, 0xdf, 0x63, 0x29, 0x79, 0xfd, 0xbe, 0x05, 0xe2, 0xa2,
+	0x88, 0xb8, 0xe4, 0x3c, 0x26, 0x3a, 0x13, 0x36, 0xe0, 0x54, 0x74, 0xde, 0xd0, 0x0c, 0xaf, 0x92,
+	0x97, 0xd5, 0xc3, 0x91, 0x27, 0x4b, 0xee, 0x03, 0x79, 0xa7, 0xb3, 0x40, 0x41, 0xcb, 0x3e, 0xc4,
+	0x23, 0x2e, 0x3d, 0xd0, 0x26, 0x47, 0xc7, 0x60, 0x36, 0x5c, 0xb4, 0xb9, 0x1f, 0x25, 0x3c, 0xe2,
+	0xe6, 0x26, 0x4f, 0x10, 0x9e, 0x4c, 0x58, 0x1e, 0xb2, 0xd0, 0x5b, 0x96, 0x2e, 0x6e, 0x4a, 0x2a,
+	0xe5, 0x81, 0xf0, 0xb8, 0xcb, 0x97, 0x1e, 0x3a, 0xfa, 0x3e, 0x78, 0xf2, 0xfc, 0xab, 0x61, 0x85,
+	0x9c, 0xa3, 0x71, 0x7b, 0x17, 0xb3, 0x82, 0xde, 0x87, 0x31, 0x6d, 0x06, 0x0c, 0xfe, 0x45, 0xf8,
+	0x70, 0x9b, 0x9f, 0x21, 0x8b, 0x99, 0x8b, 0x6a, 0x37, 0x66, 0xd2, 0x52, 0x3e, 0x10, 0xe0, 0x76,
+	0x2b, 0xe4, 0x76, 0x8d, 0x94, 0xb3, 0x70, 0xa3, 0xad, 0xc8, 0x01, 0xb5, 0x4b, 0x23, 0xa7, 0x8e,
+	0xc1, 0x04, 0x79, 0x8e, 0xf0, 0xa1, 0xa4, 0xe3, 0x21, 0x59, 0xc4, 0xe8, 0xe0, 0xb7, 0xa4, 0xc5,
+	0x5c, 0x18, 0xc0, 0x7a, 0x35, 0x64, 0x7d, 0x85, 0x5c, 0xee, 0x9f, 0x35, 0xf9, 0x62, 0x18, 0x93,
+	0x76, 0xbb, 0x44, 0x96, 0x32, 0x15, 0xd9, 0xc1, 0xd9, 0x49, 0xcb, 0x39, 0x51, 0x80, 0xec, 0x46,
+	0x48, 0xf6, 0x33, 0xf2, 0xe9, 0xc0, 0x24, 0xa6, 0xad, 0xc4, 0xfd, 0xb3, 0x4b, 0x7e, 0x41, 0x78,
+	0x32, 0x61, 0xca, 0x32, 0xbd, 0xc3, 0xe9, 0xc6, 0x51, 0x2a, 0xe5, 0x81, 0x80, 0x26, 0x5c, 0x0e,
+	0x9b, 0x40, 0xc9, 0xf9, 0x6e, 0x4d, 0x30, 0xcc, 0xca, 0xba, 0x0b, 0x51, 0xf1, 0xbf, 0xd8, 0xfe,
+	0x46, 0x78, 0x22, 0x8e, 0x4b, 0xde, 0xe9, 0xbb, 0x24, 0x9f, 0xd4, 0x42, 0x0e, 0x04, 0xe0, 0xa4,
+	0x86, 0x9c, 0x56, 0xc9, 0x72, 0x26, 0x4e, 0xb4, 0x15, 0xfa, 0xcb, 0x5d, 0xda, 0xf2, 0xdd, 0xe3,
+	0x2e, 0xf9, 0x16, 0xe1, 0x51, 0xcf, 0x6b, 0x91, 0xb7, 0x32, 0x1d, 0x30, 0x11, 0xeb, 0x27, 0x5d,
+	0xea, 0x23, 0x12, 0x38, 0xd1, 0x90, 0xd3, 0x49, 0x22, 0x77, 0x1f, 0x56, 0xb7, 0x4a, 0xe7, 0xde,
+	0x48, 0x58, 0xb2, 0x4c, 0x33, 0x97, 0x6e, 0x1b, 0xa5, 0x52, 0x1e, 0x88, 0xcc, 0xf7, 0x06, 0x03,
+	0x84, 0x60, 0xe4, 0x7e, 0x45, 0x78, 0x22, 0x0e, 0x9b, 0x69, 0xe4, 0x52, 0xfd, 0x9d, 0xb4, 0x90,
+	0x03, 0x01, 0x28, 0xcd, 0x87, 0x94, 0x2e, 0x90, 0x62, 0x16, 0x4a, 0xb4, 0xe5, 0x9c, 0x10, 0x8e,
+	0x5a, 0x09, 0xcf, 0x95, 0x49, 0xad, 0x74, 0x5f, 0x28, 0x95, 0xf2, 0x40, 0x64, 0x56, 0x4b, 0x07,
+	0x84, 0x98, 0x5a, 0x71, 0xd8, 0x4c, 0x6a, 0xa5, 0x1a, 0x38, 0x69, 0x21, 0x07, 0x42, 0x66, 0xb5,
+	0x92, 0x94, 0x3c, 0xb5, 0x7e, 0x42, 0xf8, 0x60, 0xd4, 0x57, 0x91, 0xf9, 0x0c, 0x35, 0xa5, 0xb8,
+	0x3e, 0xe9, 0x6a, 0xdf, 0xf1, 0xc0, 0xe8, 0xcd, 0x90, 0xd1, 0xeb, 0xe4, 0x4c, 0x37, 0x46, 0x9a,
+	0x1b, 0x5e, 0x01, 0xcb, 0xf6, 0x0f, 0xc2, 0xe3, 0x11, 0x40, 0xf2, 0x76, 0x7f, 0x85, 0xf8, 0x3c,
+	0xe6, 0xfb, 0x0d, 0x07, 0x1a, 0x9f, 0x87, 0x34, 0x3e, 0x20, 0x37, 0x7a, 0xa6, 0x91, 0xb8, 0x8f,
+	0xdb, 0x2e, 0x60, 0xda, 0x72, 0xbf, 0xff, 0x77, 0xc9, 0x5f, 0x08, 0x4f, 0xa5, 0x39, 0x20, 0xb2,
+	0x92, 0xa1, 0xf0, 0x2e, 0x7e, 0x4d, 0x5a, 0xcd, 0x8d, 0x03, 0x9d, 0xb8, 0x1a, 0x76, 0xe2, 0x22,
+	0x99, 0xeb, 0xd6, 0x89, 0x0d, 0x1f, 0xa6, 0x62, 0x3b, 0x38, 0x15, 0x70, 0x5b, 0x0f, 0x86, 0xf1,
+	0x91, 0x94, 0x0c, 0x64, 0x39, 0x5f, 0x85, 0x3e, 0xd1, 0x95, 0xbc, 0x30, 0xc0, 0xf3, 0x76, 0xc8,
+	0xf3, 0x06, 0xb9, 0x9e, 0x9d, 0x27, 0x6d, 0x45, 0x7c, 0x1b, 0x38, 0xba, 0x40, 0xef, 0xd2, 0xad,
+	0x47, 0x4f, 0x0b, 0xe8, 0xf1, 0xd3, 0x02, 0xfa, 0xe3, 0x69, 0x01, 0x7d, 0xf9, 0xac, 0x30, 0xf4,
+	0xf8, 0x59, 0x61, 0xe8, 0xb7, 0x67, 0x85, 0xa1, 0x4f, 0xae, 0xd4, 0x0d, 0x7b, 0x63, 0xab, 0xea,
+	0xd4, 0xed, 0x25, 0x3d, 0xaf, 0x09, 0xe1, 0xbc, 0xe0, 0x7e, 0xee, 0xed, 0x39, 0x6a, 0xef, 0x34,
+	0x99, 0x48, 0xfb, 0xdf, 0xad, 0xea, 0xa8, 0x3b, 0x6f, 0x17, 0xfe, 0x1b, 0x00, 0x79, 0x9a, 0xa8,
+	0xc4, 0xf1, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PausedProtocols retrieves all paused protocol IDs.
+	PausedProtocols(ctx context.Context, in *QueryPausedProtocolsRequest, opts ...grpc.CallOption) (*QueryPausedProtocolsResponse, error)
+	// PausedCrossChains retrieves all paused counterparties for a specific protocol.
+	PausedCrossChains(ctx context.Context, in *QueryPausedCrossChainsRequest, opts ...grpc.CallOption) (*QueryPausedCrossChainsResponse, error)
+	// IsProtocolPaused checks if a specific protocol is paused.
+	IsProtocolPaused(ctx context.Context, in *QueryIsProtocolPausedRequest, opts ...grpc.CallOption) (*QueryIsProtocolPausedResponse, error)
+	// IsCrossChainPaused checks if a specific counterparty for a protocol is paused.
+	IsCrossChainPaused(ctx context.Context, in *QueryIsCrossChainPausedRequest, opts ...grpc.CallOption) (*QueryIsCrossChainPausedResponse, error)
+	// InFlightPackets retrieves all the outgoing IBC packets not yet acknowledged.
+	InFlightPackets(ctx context.Context, in *QueryInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsResponse, error)
+	// InFlightPacket retrieves an outgoing IBC packet not yet acknowledged.
+	InFlightPacket(ctx context.Context, in *QueryInFlightPacketRequest, opts ...grpc.CallOption) (*QueryInFlightPacketResponse, error)
+	// Params retrieves the forwarder component parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EscrowedPackets retrieves all the packets held in the escrow queue.
+	EscrowedPackets(ctx context.Context, in *QueryEscrowedPacketsRequest, opts ...grpc.CallOption) (*QueryEscrowedPacketsResponse, error)
+	// EscrowedPacket retrieves a packet held in the escrow queue.
+	EscrowedPacket(ctx context.Context, in *QueryEscrowedPacketRequest, opts ...grpc.CallOption) (*QueryEscrowedPacketResponse, error)
+	// DeferredPackets retrieves all the packets held in the deferred queue.
+	DeferredPackets(ctx context.Context, in *QueryDeferredPacketsRequest, opts ...grpc.CallOption) (*QueryDeferredPacketsResponse, error)
+	// DeferredPacket retrieves a packet held in the deferred queue.
+	DeferredPacket(ctx context.Context, in *QueryDeferredPacketRequest, opts ...grpc.CallOption) (*QueryDeferredPacketResponse, error)
+	// AmountLimits retrieves all the amount limits of the destinations.
+	AmountLimits(ctx context.Context, in *QueryAmountLimitsRequest, opts ...grpc.CallOption) (*QueryAmountLimitsResponse, error)
+	// AmountLimit retrieves the amount limit of a destination and denom.
+	AmountLimit(ctx context.Context, in *QueryAmountLimitRequest, opts ...grpc.CallOption) (*QueryAmountLimitResponse, error)
+	// HyperlaneTokenRoutes retrieves all the Hyperlane warp token routes.
+	HyperlaneTokenRoutes(ctx context.Context, in *QueryHyperlaneTokenRoutesRequest, opts ...grpc.CallOption) (*QueryHyperlaneTokenRoutesResponse, error)
+	// HyperlaneTokenRoute retrieves the Hyperlane warp token route of a denom
+	// and destination domain.
+	HyperlaneTokenRoute(ctx context.Context, in *QueryHyperlaneTokenRouteRequest, opts ...grpc.CallOption) (*QueryHyperlaneTokenRouteResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PausedProtocols(ctx context.Context, in *QueryPausedProtocolsRequest, opts ...grpc.CallOption) (*QueryPausedProtocolsResponse, error) {
+	out := new(QueryPausedProtocolsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/PausedProtocols", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedCrossChains(ctx context.Context, in *QueryPausedCrossChainsRequest, opts ...grpc.CallOption) (*QueryPausedCrossChainsResponse, error) {
+	out := new(QueryPausedCrossChainsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/PausedCrossChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsProtocolPaused(ctx context.Context, in *QueryIsProtocolPausedRequest, opts ...grpc.CallOption) (*QueryIsProtocolPausedResponse, error) {
+	out := new(QueryIsProtocolPausedResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/IsProtocolPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsCrossChainPaused(ctx context.Context, in *QueryIsCrossChainPausedRequest, opts ...grpc.CallOption) (*QueryIsCrossChainPausedResponse, error) {
+	out := new(QueryIsCrossChainPausedResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/IsCrossChainPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InFlightPackets(ctx context.Context, in *QueryInFlightPacketsRequest, opts ...grpc.CallOption) (*QueryInFlightPacketsResponse, error) {
+	out := new(QueryInFlightPacketsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/InFlightPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InFlightPacket(ctx context.Context, in *QueryInFlightPacketRequest, opts ...grpc.CallOption) (*QueryInFlightPacketResponse, error) {
+	out := new(QueryInFlightPacketResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/InFlightPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowedPackets(ctx context.Context, in *QueryEscrowedPacketsRequest, opts ...grpc.CallOption) (*QueryEscrowedPacketsResponse, error) {
+	out := new(QueryEscrowedPacketsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/EscrowedPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EscrowedPacket(ctx context.Context, in *QueryEscrowedPacketRequest, opts ...grpc.CallOption) (*QueryEscrowedPacketResponse, error) {
+	out := new(QueryEscrowedPacketResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/EscrowedPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeferredPackets(ctx context.Context, in *QueryDeferredPacketsRequest, opts ...grpc.CallOption) (*QueryDeferredPacketsResponse, error) {
+	out := new(QueryDeferredPacketsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/DeferredPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeferredPacket(ctx context.Context, in *QueryDeferredPacketRequest, opts ...grpc.CallOption) (*QueryDeferredPacketResponse, error) {
+	out := new(QueryDeferredPacketResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/DeferredPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AmountLimits(ctx context.Context, in *QueryAmountLimitsRequest, opts ...grpc.CallOption) (*QueryAmountLimitsResponse, error) {
+	out := new(QueryAmountLimitsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/AmountLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AmountLimit(ctx context.Context, in *QueryAmountLimitRequest, opts ...grpc.CallOption) (*QueryAmountLimitResponse, error) {
+	out := new(QueryAmountLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/AmountLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HyperlaneTokenRoutes(ctx context.Context, in *QueryHyperlaneTokenRoutesRequest, opts ...grpc.CallOption) (*QueryHyperlaneTokenRoutesResponse, error) {
+	out := new(QueryHyperlaneTokenRoutesResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/HyperlaneTokenRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HyperlaneTokenRoute(ctx context.Context, in *QueryHyperlaneTokenRouteRequest, opts ...grpc.CallOption) (*QueryHyperlaneTokenRouteResponse, error) {
+	out := new(QueryHyperlaneTokenRouteResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Query/HyperlaneTokenRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PausedProtocols retrieves all paused protocol IDs.
+	PausedProtocols(context.Context, *QueryPausedProtocolsRequest) (*QueryPausedProtocolsResponse, error)
+	// PausedCrossChains retrieves all paused counterparties for a specific protocol.
+	PausedCrossChains(context.Context, *QueryPausedCrossChainsRequest) (*QueryPausedCrossChainsResponse, error)
+	// IsProtocolPaused checks if a specific protocol is paused.
+	IsProtocolPaused(context.Context, *QueryIsProtocolPausedRequest) (*QueryIsProtocolPausedResponse, error)
+	// IsCrossChainPaused checks if a specific counterparty for a protocol is paused.
+	IsCrossChainPaused(context.Context, *QueryIsCrossChainPausedRequest) (*QueryIsCrossChainPausedResponse, error)
+	// InFlightPackets retrieves all the outgoing IBC packets not yet acknowledged.
+	InFlightPackets(context.Context, *QueryInFlightPacketsRequest) (*QueryInFlightPacketsResponse, error)
+	// InFlightPacket retrieves an outgoing IBC packet not yet acknowledged.
+	InFlightPacket(context.Context, *QueryInFlightPacketRequest) (*QueryInFlightPacketResponse, error)
+	// Params retrieves the forwarder component parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EscrowedPackets retrieves all the packets held in the escrow queue.
+	EscrowedPackets(context.Context, *QueryEscrowedPacketsRequest) (*QueryEscrowedPacketsResponse, error)
+	// EscrowedPacket retrieves a packet held in the escrow queue.
+	EscrowedPacket(context.Context, *QueryEscrowedPacketRequest) (*QueryEscrowedPacketResponse, error)
+	// DeferredPackets retrieves all the packets held in the deferred queue.
+	DeferredPackets(context.Context, *QueryDeferredPacketsRequest) (*QueryDeferredPacketsResponse, error)
+	// DeferredPacket retrieves a packet held in the deferred queue.
+	DeferredPacket(context.Context, *QueryDeferredPacketRequest) (*QueryDeferredPacketResponse, error)
+	// AmountLimits retrieves all the amount limits of the destinations.
+	AmountLimits(context.Context, *QueryAmountLimitsRequest) (*QueryAmountLimitsResponse, error)
+	// AmountLimit retrieves the amount limit of a destination and denom.
+	AmountLimit(context.Context, *QueryAmountLimitRequest) (*QueryAmountLimitResponse, error)
+	// HyperlaneTokenRoutes retrieves all the Hyperlane warp token routes.
+	HyperlaneTokenRoutes(context.Context, *QueryHyperlaneTokenRoutesRequest) (*QueryHyperlaneTokenRoutesResponse, error)
+	// HyperlaneTokenRoute retrieves the Hyperlane warp token route of a denom
+	// and destination domain.
+	HyperlaneTokenRoute(context.Context, *QueryHyperlaneTokenRouteRequest) (*QueryHyperlaneTokenRouteResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PausedProtocols(ctx context.Context, req *QueryPausedProtocolsRequest) (*QueryPausedProtocolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedProtocols not implemented")
+}
+func (*UnimplementedQueryServer) PausedCrossChains(ctx context.Context, req *QueryPausedCrossChainsRequest) (*QueryPausedCrossChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedCrossChains not implemented")
+}
+func (*UnimplementedQueryServer) IsProtocolPaused(ctx context.Context, req *QueryIsProtocolPausedRequest) (*QueryIsProtocolPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsProtocolPaused not implemented")
+}
+func (*UnimplementedQueryServer) IsCrossChainPaused(ctx context.Context, req *QueryIsCrossChainPausedRequest) (*QueryIsCrossChainPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsCrossChainPaused not implemented")
+}
+func (*UnimplementedQueryServer) InFlightPackets(ctx context.Context, req *QueryInFlightPacketsRequest) (*QueryInFlightPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPackets not implemented")
+}
+func (*UnimplementedQueryServer) InFlightPacket(ctx context.Context, req *QueryInFlightPacketRequest) (*QueryInFlightPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InFlightPacket not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EscrowedPackets(ctx context.Context, req *QueryEscrowedPacketsRequest) (*QueryEscrowedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedPackets not implemented")
+}
+func (*UnimplementedQueryServer) EscrowedPacket(ctx context.Context, req *QueryEscrowedPacketRequest) (*QueryEscrowedPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowedPacket not implemented")
+}
+func (*UnimplementedQueryServer) DeferredPackets(ctx context.Context, req *QueryDeferredPacketsRequest) (*QueryDeferredPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferredPackets not implemented")
+}
+func (*UnimplementedQueryServer) DeferredPacket(ctx context.Context, req *QueryDeferredPacketRequest) (*QueryDeferredPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeferredPacket not implemented")
+}
+func (*UnimplementedQueryServer) AmountLimits(ctx context.Context, req *QueryAmountLimitsRequest) (*QueryAmountLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmountLimits not implemented")
+}
+func (*UnimplementedQueryServer) AmountLimit(ctx context.Context, req *QueryAmountLimitRequest) (*QueryAmountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmountLimit not implemented")
+}
+func (*UnimplementedQueryServer) HyperlaneTokenRoutes(ctx context.Context, req *QueryHyperlaneTokenRoutesRequest) (*QueryHyperlaneTokenRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HyperlaneTokenRoutes not implemented")
+}
+func (*UnimplementedQueryServer) HyperlaneTokenRoute(ctx context.Context, req *QueryHyperlaneTokenRouteRequest) (*QueryHyperlaneTokenRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HyperlaneTokenRoute not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PausedProtocols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedProtocolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedProtocols(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/PausedProtocols",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedProtocols(ctx, req.(*QueryPausedProtocolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedCrossChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedCrossChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedCrossChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/PausedCrossChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedCrossChains(ctx, req.(*QueryPausedCrossChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsProtocolPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsProtocolPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsProtocolPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/IsProtocolPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsProtocolPaused(ctx, req.(*QueryIsProtocolPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsCrossChainPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsCrossChainPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsCrossChainPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/IsCrossChainPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsCrossChainPaused(ctx, req.(*QueryIsCrossChainPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInFlightPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/InFlightPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPackets(ctx, req.(*QueryInFlightPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInFlightPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InFlightPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/InFlightPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InFlightPacket(ctx, req.(*QueryInFlightPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/EscrowedPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedPackets(ctx, req.(*QueryEscrowedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowedPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowedPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/EscrowedPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowedPacket(ctx, req.(*QueryEscrowedPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeferredPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeferredPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeferredPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/DeferredPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeferredPackets(ctx, req.(*QueryDeferredPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeferredPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeferredPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeferredPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/DeferredPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeferredPacket(ctx, req.(*QueryDeferredPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AmountLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmountLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AmountLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/AmountLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AmountLimits(ctx, req.(*QueryAmountLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AmountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAmountLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AmountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/AmountLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AmountLimit(ctx, req.(*QueryAmountLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HyperlaneTokenRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHyperlaneTokenRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HyperlaneTokenRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/HyperlaneTokenRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HyperlaneTokenRoutes(ctx, req.(*QueryHyperlaneTokenRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HyperlaneTokenRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHyperlaneTokenRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HyperlaneTokenRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Query/HyperlaneTokenRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HyperlaneTokenRoute(ctx, req.(*QueryHyperlaneTokenRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.orbiter.component.forwarder.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PausedProtocols",
+			Handler:    _Query_PausedProtocols_Handler,
+		},
+		{
+			MethodName: "PausedCrossChains",
+			Handler:    _Query_PausedCrossChains_Handler,
+		},
+		{
+			MethodName: "IsProtocolPaused",
+			Handler:    _Query_IsProtocolPaused_Handler,
+		},
+		{
+			MethodName: "IsCrossChainPaused",
+			Handler:    _Query_IsCrossChainPaused_Handler,
+		},
+		{
+			MethodName: "InFlightPackets",
+			Handler:    _Query_InFlightPackets_Handler,
+		},
+		{
+			MethodName: "InFlightPacket",
+			Handler:    _Query_InFlightPacket_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EscrowedPackets",
+			Handler:    _Query_EscrowedPackets_Handler,
+		},
+		{
+			MethodName: "EscrowedPacket",
+			Handler:    _Query_EscrowedPacket_Handler,
+		},
+		{
+			MethodName: "DeferredPackets",
+			Handler:    _Query_DeferredPackets_Handler,
+		},
+		{
+			MethodName: "DeferredPacket",
+			Handler:    _Query_DeferredPacket_Handler,
+		},
+		{
+			MethodName: "AmountLimits",
+			Handler:    _Query_AmountLimits_Handler,
+		},
+		{
+			MethodName: "AmountLimit",
+			Handler:    _Query_AmountLimit_Handler,
+		},
+		{
+			MethodName: "HyperlaneTokenRoutes",
+			Handler:    _Query_HyperlaneTokenRoutes_Handler,
+		},
+		{
+			MethodName: "HyperlaneTokenRoute",
+			Handler:    _Query_HyperlaneTokenRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/orbiter/component/forwarder/v1/query.proto",
+}
+
+func (m *QueryPausedProtocolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedProtocolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedProtocolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedProtocolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedProtocolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedProtocolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolIds) > 0 {
+		dAtA2 := make([]byte, len(m.ProtocolIds)*10)
+		var j1 int
+		for _, num := range m.ProtocolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintQuery(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedCrossChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedCrossChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedCrossChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedCrossChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedCrossChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedCrossChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CounterpartyIds) > 0 {
+		for iNdEx := len(m.CounterpartyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CounterpartyIds[iNdEx])
+			copy(dAtA[i:], m.CounterpartyIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsProtocolPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsProtocolPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsProtocolPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsProtocolPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsProtocolPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsProtocolPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsCrossChainPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsCrossChainPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsCrossChainPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyId) > 0 {
+		i -= len(m.CounterpartyId)
+		copy(dAtA[i:], m.CounterpartyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsCrossChainPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsCrossChainPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsCrossChainPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsPaused {
+		i--
+		if m.IsPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInFlightPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInFlightPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInFlightPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowedPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowedPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowedPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowedPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowedPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowedPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowedPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowedPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowedPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowedPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeferredPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeferredPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeferredPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmountLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmountLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmountLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmountLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmountLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmountLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Limits) > 0 {
+		for iNdEx := len(m.Limits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmountLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmountLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmountLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CounterpartyId) > 0 {
+		i -= len(m.CounterpartyId)
+		copy(dAtA[i:], m.CounterpartyId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAmountLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAmountLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAmountLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHyperlaneTokenRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHyperlaneTokenRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHyperlaneTokenRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHyperlaneTokenRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHyperlaneTokenRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHyperlaneTokenRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHyperlaneTokenRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHyperlaneTokenRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHyperlaneTokenRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHyperlaneTokenRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHyperlaneTokenRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHyperlaneTokenRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPausedProtocolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedProtocolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtocolIds) > 0 {
+		l = 0
+		for _, e := range m.ProtocolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryPausedCrossChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedCrossChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CounterpartyIds) > 0 {
+		for _, s := range m.CounterpartyIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsProtocolPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsProtocolPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryIsCrossChainPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsCrossChainPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPaused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryInFlightPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEscrowedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowedPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryEscrowedPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeferredPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeferredPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDeferredPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryDeferredPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAmountLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAmountLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for _, e := range m.Limits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAmountLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAmountLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHyperlaneTokenRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHyperlaneTokenRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHyperlaneTokenRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovQuery(uint64(m.DestinationDomain))
+	}
+	return n
+}
+
+func (m *QueryHyperlaneTokenRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Route.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPausedProtocolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedProtocolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedProtocolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedProtocolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedProtocolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedProtocolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v core.ProtocolID
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= core.ProtocolID(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ProtocolIds = append(m.ProtocolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.ProtocolIds) == 0 {
+					m.ProtocolIds = make([]core.ProtocolID, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v core.ProtocolID
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= core.ProtocolID(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ProtocolIds = append(m.ProtocolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedCrossChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedCrossChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedCrossChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedCrossChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedCrossChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedCrossChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyIds = append(m.CounterpartyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsProtocolPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsProtocolPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsProtocolPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsProtocolPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsProtocolPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsProtocolPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsCrossChainPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsCrossChainPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsCrossChainPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsCrossChainPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsCrossChainPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsCrossChainPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, InFlightPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInFlightPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInFlightPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowedPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowedPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowedPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowedPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, EscrowedPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowedPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowedPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowedPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowedPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeferredPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeferredPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeferredPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeferredPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeferredPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeferredPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, DeferredPacket{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryIsProtocolPausedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProtocolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryIsProtocolPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsPaused {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryIsCrossChainPausedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProtocolId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CounterpartyId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDeferredPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeferredPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeferredPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryIsCrossChainPausedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsPaused {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryPausedProtocolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDeferredPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1112,12 +5703,45 @@ func (m *QueryPausedProtocolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausedProtocolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDeferredPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausedProtocolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDeferredPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1139,7 +5763,7 @@ func (m *QueryPausedProtocolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPausedProtocolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAmountLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1162,81 +5786,48 @@ func (m *QueryPausedProtocolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausedProtocolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAmountLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausedProtocolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAmountLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v core.ProtocolID
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= core.ProtocolID(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ProtocolIds = append(m.ProtocolIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.ProtocolIds) == 0 {
-					m.ProtocolIds = make([]core.ProtocolID, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v core.ProtocolID
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= core.ProtocolID(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ProtocolIds = append(m.ProtocolIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolIds", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1258,7 +5849,7 @@ func (m *QueryPausedProtocolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPausedCrossChainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAmountLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1281,17 +5872,17 @@ func (m *QueryPausedCrossChainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausedCrossChainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAmountLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausedCrossChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAmountLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1301,23 +5892,25 @@ func (m *QueryPausedCrossChainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			m.Limits = append(m.Limits, AmountLimit{})
+			if err := m.Limits[len(m.Limits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1349,7 +5942,7 @@ func (m *QueryPausedCrossChainsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1376,7 +5969,7 @@ func (m *QueryPausedCrossChainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPausedCrossChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAmountLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1399,15 +5992,15 @@ func (m *QueryPausedCrossChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPausedCrossChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAmountLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPausedCrossChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAmountLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1435,13 +6028,13 @@ func (m *QueryPausedCrossChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyIds = append(m.CounterpartyIds, string(dAtA[iNdEx:postIndex]))
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1451,27 +6044,55 @@ func (m *QueryPausedCrossChainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.CounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1494,7 +6115,7 @@ func (m *QueryPausedCrossChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsProtocolPausedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAmountLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1517,17 +6138,17 @@ func (m *QueryIsProtocolPausedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsProtocolPausedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAmountLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsProtocolPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAmountLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1537,23 +6158,24 @@ func (m *QueryIsProtocolPausedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1576,7 +6198,7 @@ func (m *QueryIsProtocolPausedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsProtocolPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHyperlaneTokenRoutesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,17 +6221,17 @@ func (m *QueryIsProtocolPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsProtocolPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHyperlaneTokenRoutesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsProtocolPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHyperlaneTokenRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1619,12 +6241,28 @@ func (m *QueryIsProtocolPausedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPaused = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1646,7 +6284,7 @@ func (m *QueryIsProtocolPausedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsCrossChainPausedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHyperlaneTokenRoutesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1669,17 +6307,17 @@ func (m *QueryIsCrossChainPausedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsCrossChainPausedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHyperlaneTokenRoutesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsCrossChainPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHyperlaneTokenRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1689,27 +6327,115 @@ func (m *QueryIsCrossChainPausedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, HyperlaneTokenRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHyperlaneTokenRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHyperlaneTokenRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHyperlaneTokenRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,8 +6463,27 @@ func (m *QueryIsCrossChainPausedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyId = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1760,7 +6505,7 @@ func (m *QueryIsCrossChainPausedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIsCrossChainPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHyperlaneTokenRouteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,17 +6528,17 @@ func (m *QueryIsCrossChainPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIsCrossChainPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHyperlaneTokenRouteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIsCrossChainPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHyperlaneTokenRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsPaused", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1803,12 +6548,25 @@ func (m *QueryIsCrossChainPausedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsPaused = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
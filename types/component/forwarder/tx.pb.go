@@ -5,6 +5,7 @@ package forwarder
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -430,816 +431,4365 @@ func (m *MsgReplaceDepositForBurnResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgReplaceDepositForBurnResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgPauseProtocol)(nil), "noble.orbiter.component.forwarder.v1.MsgPauseProtocol")
-	proto.RegisterType((*MsgPauseProtocolResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgPauseProtocolResponse")
-	proto.RegisterType((*MsgPauseCrossChains)(nil), "noble.orbiter.component.forwarder.v1.MsgPauseCrossChains")
-	proto.RegisterType((*MsgPauseCrossChainsResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgPauseCrossChainsResponse")
-	proto.RegisterType((*MsgUnpauseProtocol)(nil), "noble.orbiter.component.forwarder.v1.MsgUnpauseProtocol")
-	proto.RegisterType((*MsgUnpauseProtocolResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgUnpauseProtocolResponse")
-	proto.RegisterType((*MsgUnpauseCrossChains)(nil), "noble.orbiter.component.forwarder.v1.MsgUnpauseCrossChains")
-	proto.RegisterType((*MsgUnpauseCrossChainsResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgUnpauseCrossChainsResponse")
-	proto.RegisterType((*MsgReplaceDepositForBurn)(nil), "noble.orbiter.component.forwarder.v1.MsgReplaceDepositForBurn")
-	proto.RegisterType((*MsgReplaceDepositForBurnResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgReplaceDepositForBurnResponse")
+// MsgSetHyperlaneTokenRoute sets the Hyperlane warp token used for a denom
+// and destination domain when a forwarding does not specify the token ID.
+type MsgSetHyperlaneTokenRoute struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Noble denom forwarded.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Hyperlane domain of the destination chain.
+	DestinationDomain uint32 `protobuf:"varint,3,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	// 32 bytes ID of the Hyperlane warp token.
+	TokenId []byte `protobuf:"bytes,4,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("noble/orbiter/component/forwarder/v1/tx.proto", fileDescriptor_6d12dabcf7836b4c)
+func (m *MsgSetHyperlaneTokenRoute) Reset()         { *m = MsgSetHyperlaneTokenRoute{} }
+func (m *MsgSetHyperlaneTokenRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHyperlaneTokenRoute) ProtoMessage()    {}
+func (*MsgSetHyperlaneTokenRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{10}
 }
-
-var fileDescriptor_6d12dabcf7836b4c = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x4f, 0xd4, 0x40,
-	0x18, 0xdd, 0x61, 0x85, 0x84, 0x51, 0xc3, 0x5a, 0x16, 0xad, 0x55, 0x76, 0xb1, 0x21, 0x11, 0x88,
-	0xb4, 0x82, 0x46, 0x65, 0x25, 0x2a, 0x2c, 0x21, 0xe1, 0xb0, 0x09, 0xa9, 0xd1, 0x83, 0x97, 0xa6,
-	0xdb, 0x8e, 0x65, 0x92, 0xed, 0x4c, 0x33, 0x33, 0xcb, 0xca, 0xcd, 0x78, 0xd1, 0x98, 0x98, 0x70,
-	0xf2, 0xa8, 0xc4, 0x5f, 0xc0, 0xc1, 0x1f, 0xe1, 0x4d, 0xa2, 0x17, 0x8f, 0x06, 0x0e, 0xf8, 0x03,
-	0xfc, 0x01, 0x66, 0xbb, 0x6d, 0x77, 0xd9, 0x6d, 0x13, 0x58, 0x42, 0xe2, 0x65, 0xb3, 0x7d, 0xef,
-	0x7b, 0xd3, 0xf7, 0xbe, 0x99, 0x7e, 0x19, 0x38, 0x4b, 0x68, 0xb5, 0x86, 0x74, 0xca, 0xaa, 0x58,
-	0x20, 0xa6, 0xdb, 0xd4, 0xf3, 0x29, 0x41, 0x44, 0xe8, 0x2f, 0x29, 0x6b, 0x58, 0xcc, 0x41, 0x4c,
-	0xdf, 0x9c, 0xd3, 0xc5, 0x2b, 0xcd, 0x67, 0x54, 0x50, 0x69, 0x32, 0x28, 0xd7, 0xc2, 0x72, 0x2d,
-	0x2e, 0xd7, 0xe2, 0x72, 0x6d, 0x73, 0x4e, 0xb9, 0x64, 0x79, 0x98, 0x50, 0x3d, 0xf8, 0x6d, 0x09,
-	0x95, 0x2b, 0x36, 0xe5, 0x1e, 0xe5, 0xba, 0xc7, 0xdd, 0xe6, 0x82, 0x1e, 0x77, 0x43, 0xe2, 0x6a,
-	0x8b, 0x30, 0x83, 0x27, 0xbd, 0xf5, 0x10, 0x52, 0x79, 0x97, 0xba, 0xb4, 0x85, 0x37, 0xff, 0xb5,
-	0x50, 0xf5, 0x13, 0x80, 0xb9, 0x0a, 0x77, 0xd7, 0xad, 0x3a, 0x47, 0xeb, 0x4d, 0xc4, 0xa6, 0x35,
-	0xe9, 0x36, 0x1c, 0xe2, 0xd8, 0x25, 0x88, 0xc9, 0x60, 0x02, 0x4c, 0x0d, 0x2f, 0xcb, 0x3f, 0xbe,
-	0xce, 0xe6, 0xc3, 0xc5, 0x96, 0x1c, 0x87, 0x21, 0xce, 0x9f, 0x0a, 0x86, 0x89, 0x6b, 0x84, 0x75,
-	0x52, 0x11, 0x9e, 0xf7, 0x43, 0xb5, 0x89, 0x1d, 0x79, 0xa0, 0x29, 0x33, 0x60, 0x04, 0xad, 0x39,
-	0xa5, 0xfb, 0xef, 0x76, 0x8a, 0x99, 0x3f, 0x3b, 0xc5, 0xcc, 0x9b, 0xc3, 0xdd, 0x99, 0x50, 0xf5,
-	0xfe, 0x70, 0x77, 0xa6, 0x18, 0xf5, 0xaa, 0xdd, 0xa1, 0x23, 0x5e, 0x54, 0x05, 0xca, 0xdd, 0xfe,
-	0x0c, 0xc4, 0x7d, 0x4a, 0x38, 0x52, 0xbf, 0x03, 0x38, 0x1a, 0x91, 0x65, 0x46, 0x39, 0x2f, 0x6f,
-	0x58, 0x98, 0xf0, 0x33, 0xf0, 0x2f, 0x4d, 0xc3, 0x9c, 0x4d, 0xeb, 0x44, 0x20, 0xe6, 0x5b, 0x4c,
-	0x6c, 0x99, 0xd8, 0xe1, 0x72, 0x76, 0x22, 0x3b, 0x35, 0x6c, 0x8c, 0x74, 0xe2, 0x6b, 0x0e, 0x2f,
-	0x95, 0x52, 0xa2, 0xaa, 0x29, 0x51, 0x3b, 0x9c, 0xab, 0xe3, 0xf0, 0x5a, 0x42, 0xa0, 0x38, 0xf0,
-	0x17, 0x00, 0xa5, 0x0a, 0x77, 0x9f, 0x11, 0xff, 0xac, 0xf7, 0x6b, 0x21, 0x25, 0xc4, 0x8d, 0xde,
-	0x10, 0x5d, 0x6e, 0xd4, 0xeb, 0x50, 0xe9, 0xf5, 0x18, 0x47, 0xf8, 0x09, 0xe0, 0x58, 0x9b, 0xfe,
-	0x7f, 0x76, 0x6d, 0x31, 0x25, 0xf0, 0x64, 0x6a, 0xe0, 0xce, 0x7d, 0x2b, 0xc2, 0xf1, 0xc4, 0x50,
-	0xed, 0xa3, 0x3a, 0x10, 0x9c, 0x63, 0x03, 0xf9, 0x35, 0xcb, 0x46, 0x2b, 0xc8, 0xa7, 0x1c, 0x8b,
-	0x55, 0xca, 0x96, 0xeb, 0x8c, 0xf4, 0x91, 0x7c, 0x1a, 0xe6, 0x28, 0xc3, 0x2e, 0x26, 0x56, 0xcd,
-	0xf4, 0x10, 0xe7, 0x96, 0x8b, 0x82, 0xf8, 0x17, 0x8c, 0x91, 0x08, 0xaf, 0xb4, 0x60, 0x69, 0x0e,
-	0xe6, 0xe3, 0x52, 0x4b, 0x08, 0xc4, 0x85, 0x25, 0x30, 0x25, 0x72, 0x36, 0x28, 0x1f, 0x8d, 0xb8,
-	0xa5, 0x36, 0x25, 0xdd, 0x85, 0x97, 0x09, 0x6a, 0x98, 0x0e, 0xe2, 0x02, 0x93, 0x00, 0x32, 0x6d,
-	0xab, 0x56, 0x43, 0x4c, 0x3e, 0x17, 0x88, 0xf2, 0x04, 0x35, 0x56, 0xda, 0x64, 0x39, 0xe0, 0xa4,
-	0x5b, 0x50, 0x6a, 0xaa, 0x3c, 0x4c, 0x84, 0xc9, 0x90, 0x8d, 0x7d, 0x8c, 0x88, 0x90, 0x07, 0x03,
-	0x45, 0x8e, 0xa0, 0x46, 0x05, 0x13, 0x61, 0x44, 0x78, 0xe9, 0x71, 0x4a, 0xbf, 0x6f, 0xf6, 0xf6,
-	0x3b, 0xb1, 0x69, 0xaa, 0x0a, 0x27, 0xd2, 0x1a, 0x1a, 0x75, 0x7d, 0xfe, 0xef, 0x20, 0xcc, 0x56,
-	0xb8, 0x2b, 0xbd, 0x05, 0xf0, 0xe2, 0xd1, 0x11, 0x77, 0x4f, 0x3b, 0xce, 0xec, 0xd5, 0xba, 0x47,
-	0x8f, 0xf2, 0xa8, 0x3f, 0x5d, 0xe4, 0x48, 0xfa, 0x00, 0xe0, 0x48, 0xf7, 0xe7, 0xfb, 0xe0, 0xd8,
-	0x6b, 0x76, 0x29, 0x95, 0x27, 0xfd, 0x2a, 0x63, 0x3f, 0xdb, 0x00, 0xe6, 0x7a, 0xe6, 0xe7, 0xc2,
-	0xc9, 0x42, 0x76, 0x48, 0x95, 0xa5, 0xbe, 0xa5, 0xb1, 0xa5, 0x8f, 0x00, 0x4a, 0x09, 0xe3, 0xe1,
-	0xe1, 0x49, 0xb3, 0x76, 0xda, 0x2a, 0x9f, 0x42, 0x1c, 0x1b, 0xfb, 0x0c, 0xe0, 0x58, 0xf2, 0x07,
-	0x7c, 0xfc, 0x53, 0x91, 0xa8, 0x57, 0x56, 0x4f, 0xa7, 0x8f, 0x1c, 0x2a, 0x83, 0xaf, 0x0f, 0x77,
-	0x67, 0xc0, 0xf2, 0xf3, 0x6f, 0xfb, 0x05, 0xb0, 0xb7, 0x5f, 0x00, 0xbf, 0xf7, 0x0b, 0x60, 0xfb,
-	0xa0, 0x90, 0xd9, 0x3b, 0x28, 0x64, 0x7e, 0x1d, 0x14, 0x32, 0x2f, 0x16, 0x5d, 0x2c, 0x36, 0xea,
-	0xd5, 0xe6, 0x0b, 0xf4, 0xe0, 0x95, 0xb3, 0x16, 0xe7, 0x48, 0xf0, 0xf8, 0xca, 0xb2, 0x39, 0xaf,
-	0x8b, 0x2d, 0x1f, 0xf1, 0xa4, 0xbb, 0x4b, 0x75, 0x28, 0x18, 0xad, 0x77, 0xfe, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0xe2, 0x3b, 0x55, 0x15, 0xe7, 0x08, 0x00, 0x00,
+func (m *MsgSetHyperlaneTokenRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHyperlaneTokenRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHyperlaneTokenRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHyperlaneTokenRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHyperlaneTokenRoute.Merge(m, src)
+}
+func (m *MsgSetHyperlaneTokenRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHyperlaneTokenRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHyperlaneTokenRoute.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetHyperlaneTokenRoute proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// PauseProtocol pauses an entire forwarding protocol.
-	PauseProtocol(ctx context.Context, in *MsgPauseProtocol, opts ...grpc.CallOption) (*MsgPauseProtocolResponse, error)
-	// UnpauseProtocol resumes an entire forwarding protocol.
-	UnpauseProtocol(ctx context.Context, in *MsgUnpauseProtocol, opts ...grpc.CallOption) (*MsgUnpauseProtocolResponse, error)
-	//  PauseCrossChains pauses specific counterparties for a protocol.
-	PauseCrossChains(ctx context.Context, in *MsgPauseCrossChains, opts ...grpc.CallOption) (*MsgPauseCrossChainsResponse, error)
-	// UnpauseCrossChains resumes specific counterparties for a protocol.
-	UnpauseCrossChains(ctx context.Context, in *MsgUnpauseCrossChains, opts ...grpc.CallOption) (*MsgUnpauseCrossChainsResponse, error)
-	ReplaceDepositForBurn(ctx context.Context, in *MsgReplaceDepositForBurn, opts ...grpc.CallOption) (*MsgReplaceDepositForBurnResponse, error)
+// MsgSetHyperlaneTokenRouteResponse is the response type from
+// a MsgSetHyperlaneTokenRoute request.
+type MsgSetHyperlaneTokenRouteResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetHyperlaneTokenRouteResponse) Reset()         { *m = MsgSetHyperlaneTokenRouteResponse{} }
+func (m *MsgSetHyperlaneTokenRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHyperlaneTokenRouteResponse) ProtoMessage()    {}
+func (*MsgSetHyperlaneTokenRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{11}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetHyperlaneTokenRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) PauseProtocol(ctx context.Context, in *MsgPauseProtocol, opts ...grpc.CallOption) (*MsgPauseProtocolResponse, error) {
-	out := new(MsgPauseProtocolResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/PauseProtocol", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetHyperlaneTokenRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHyperlaneTokenRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetHyperlaneTokenRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHyperlaneTokenRouteResponse.Merge(m, src)
+}
+func (m *MsgSetHyperlaneTokenRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHyperlaneTokenRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHyperlaneTokenRouteResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UnpauseProtocol(ctx context.Context, in *MsgUnpauseProtocol, opts ...grpc.CallOption) (*MsgUnpauseProtocolResponse, error) {
-	out := new(MsgUnpauseProtocolResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/UnpauseProtocol", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetHyperlaneTokenRouteResponse proto.InternalMessageInfo
+
+// MsgRemoveHyperlaneTokenRoute removes the Hyperlane warp token used for
+// a denom and destination domain.
+type MsgRemoveHyperlaneTokenRoute struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Noble denom forwarded.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Hyperlane domain of the destination chain.
+	DestinationDomain uint32 `protobuf:"varint,3,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
 }
 
-func (c *msgClient) PauseCrossChains(ctx context.Context, in *MsgPauseCrossChains, opts ...grpc.CallOption) (*MsgPauseCrossChainsResponse, error) {
-	out := new(MsgPauseCrossChainsResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/PauseCrossChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveHyperlaneTokenRoute) Reset()         { *m = MsgRemoveHyperlaneTokenRoute{} }
+func (m *MsgRemoveHyperlaneTokenRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHyperlaneTokenRoute) ProtoMessage()    {}
+func (*MsgRemoveHyperlaneTokenRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{12}
+}
+func (m *MsgRemoveHyperlaneTokenRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHyperlaneTokenRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHyperlaneTokenRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRemoveHyperlaneTokenRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHyperlaneTokenRoute.Merge(m, src)
+}
+func (m *MsgRemoveHyperlaneTokenRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHyperlaneTokenRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHyperlaneTokenRoute.DiscardUnknown(m)
 }
 
-func (c *msgClient) UnpauseCrossChains(ctx context.Context, in *MsgUnpauseCrossChains, opts ...grpc.CallOption) (*MsgUnpauseCrossChainsResponse, error) {
-	out := new(MsgUnpauseCrossChainsResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/UnpauseCrossChains", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRemoveHyperlaneTokenRoute proto.InternalMessageInfo
+
+// MsgRemoveHyperlaneTokenRouteResponse is the response type from
+// a MsgRemoveHyperlaneTokenRoute request.
+type MsgRemoveHyperlaneTokenRouteResponse struct {
 }
 
-func (c *msgClient) ReplaceDepositForBurn(ctx context.Context, in *MsgReplaceDepositForBurn, opts ...grpc.CallOption) (*MsgReplaceDepositForBurnResponse, error) {
-	out := new(MsgReplaceDepositForBurnResponse)
-	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/ReplaceDepositForBurn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveHyperlaneTokenRouteResponse) Reset()         { *m = MsgRemoveHyperlaneTokenRouteResponse{} }
+func (m *MsgRemoveHyperlaneTokenRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHyperlaneTokenRouteResponse) ProtoMessage()    {}
+func (*MsgRemoveHyperlaneTokenRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{13}
+}
+func (m *MsgRemoveHyperlaneTokenRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHyperlaneTokenRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHyperlaneTokenRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// PauseProtocol pauses an entire forwarding protocol.
-	PauseProtocol(context.Context, *MsgPauseProtocol) (*MsgPauseProtocolResponse, error)
-	// UnpauseProtocol resumes an entire forwarding protocol.
-	UnpauseProtocol(context.Context, *MsgUnpauseProtocol) (*MsgUnpauseProtocolResponse, error)
-	//  PauseCrossChains pauses specific counterparties for a protocol.
-	PauseCrossChains(context.Context, *MsgPauseCrossChains) (*MsgPauseCrossChainsResponse, error)
-	// UnpauseCrossChains resumes specific counterparties for a protocol.
-	UnpauseCrossChains(context.Context, *MsgUnpauseCrossChains) (*MsgUnpauseCrossChainsResponse, error)
-	ReplaceDepositForBurn(context.Context, *MsgReplaceDepositForBurn) (*MsgReplaceDepositForBurnResponse, error)
+func (m *MsgRemoveHyperlaneTokenRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHyperlaneTokenRouteResponse.Merge(m, src)
+}
+func (m *MsgRemoveHyperlaneTokenRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHyperlaneTokenRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHyperlaneTokenRouteResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgRemoveHyperlaneTokenRouteResponse proto.InternalMessageInfo
+
+// MsgSetAmountLimit sets the minimum and maximum amount of a denom
+// forwarded with a single transfer to a destination cross-chain ID.
+type MsgSetAmountLimit struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Bridge protocol of the destination.
+	ProtocolId string `protobuf:"bytes,2,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	// Identifier of the destination for the protocol.
+	CounterpartyId string `protobuf:"bytes,3,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// Noble denom forwarded.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Minimum amount forwarded with a single transfer, zero to disable it.
+	MinAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	// Maximum amount forwarded with a single transfer, zero to disable it.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
 }
 
-func (*UnimplementedMsgServer) PauseProtocol(ctx context.Context, req *MsgPauseProtocol) (*MsgPauseProtocolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PauseProtocol not implemented")
+func (m *MsgSetAmountLimit) Reset()         { *m = MsgSetAmountLimit{} }
+func (m *MsgSetAmountLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAmountLimit) ProtoMessage()    {}
+func (*MsgSetAmountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{14}
 }
-func (*UnimplementedMsgServer) UnpauseProtocol(ctx context.Context, req *MsgUnpauseProtocol) (*MsgUnpauseProtocolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnpauseProtocol not implemented")
+func (m *MsgSetAmountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) PauseCrossChains(ctx context.Context, req *MsgPauseCrossChains) (*MsgPauseCrossChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PauseCrossChains not implemented")
+func (m *MsgSetAmountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAmountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UnpauseCrossChains(ctx context.Context, req *MsgUnpauseCrossChains) (*MsgUnpauseCrossChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnpauseCrossChains not implemented")
+func (m *MsgSetAmountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAmountLimit.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ReplaceDepositForBurn(ctx context.Context, req *MsgReplaceDepositForBurn) (*MsgReplaceDepositForBurnResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReplaceDepositForBurn not implemented")
+func (m *MsgSetAmountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAmountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAmountLimit.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetAmountLimit proto.InternalMessageInfo
+
+// MsgSetAmountLimitResponse is the response type from
+// a MsgSetAmountLimit request.
+type MsgSetAmountLimitResponse struct {
 }
 
-func _Msg_PauseProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPauseProtocol)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PauseProtocol(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/PauseProtocol",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PauseProtocol(ctx, req.(*MsgPauseProtocol))
+func (m *MsgSetAmountLimitResponse) Reset()         { *m = MsgSetAmountLimitResponse{} }
+func (m *MsgSetAmountLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAmountLimitResponse) ProtoMessage()    {}
+func (*MsgSetAmountLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{15}
+}
+func (m *MsgSetAmountLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAmountLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAmountLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetAmountLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAmountLimitResponse.Merge(m, src)
+}
+func (m *MsgSetAmountLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAmountLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAmountLimitResponse.DiscardUnknown(m)
 }
 
-func _Msg_UnpauseProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnpauseProtocol)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnpauseProtocol(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/UnpauseProtocol",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnpauseProtocol(ctx, req.(*MsgUnpauseProtocol))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetAmountLimitResponse proto.InternalMessageInfo
+
+// MsgRemoveAmountLimit removes the amount limit of a denom
+// and destination cross-chain ID.
+type MsgRemoveAmountLimit struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Bridge protocol of the destination.
+	ProtocolId string `protobuf:"bytes,2,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	// Identifier of the destination for the protocol.
+	CounterpartyId string `protobuf:"bytes,3,opt,name=counterparty_id,json=counterpartyId,proto3" json:"counterparty_id,omitempty"`
+	// Noble denom forwarded.
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Msg_PauseCrossChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPauseCrossChains)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PauseCrossChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/PauseCrossChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PauseCrossChains(ctx, req.(*MsgPauseCrossChains))
+func (m *MsgRemoveAmountLimit) Reset()         { *m = MsgRemoveAmountLimit{} }
+func (m *MsgRemoveAmountLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAmountLimit) ProtoMessage()    {}
+func (*MsgRemoveAmountLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{16}
+}
+func (m *MsgRemoveAmountLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAmountLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAmountLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveAmountLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAmountLimit.Merge(m, src)
+}
+func (m *MsgRemoveAmountLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAmountLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAmountLimit.DiscardUnknown(m)
 }
 
-func _Msg_UnpauseCrossChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnpauseCrossChains)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnpauseCrossChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/UnpauseCrossChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnpauseCrossChains(ctx, req.(*MsgUnpauseCrossChains))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgRemoveAmountLimit proto.InternalMessageInfo
 
-func _Msg_ReplaceDepositForBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgReplaceDepositForBurn)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ReplaceDepositForBurn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/ReplaceDepositForBurn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ReplaceDepositForBurn(ctx, req.(*MsgReplaceDepositForBurn))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgRemoveAmountLimitResponse is the response type from
+// a MsgRemoveAmountLimit request.
+type MsgRemoveAmountLimitResponse struct {
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.orbiter.component.forwarder.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "PauseProtocol",
-			Handler:    _Msg_PauseProtocol_Handler,
-		},
-		{
-			MethodName: "UnpauseProtocol",
-			Handler:    _Msg_UnpauseProtocol_Handler,
-		},
-		{
-			MethodName: "PauseCrossChains",
-			Handler:    _Msg_PauseCrossChains_Handler,
-		},
-		{
-			MethodName: "UnpauseCrossChains",
-			Handler:    _Msg_UnpauseCrossChains_Handler,
-		},
-		{
-			MethodName: "ReplaceDepositForBurn",
-			Handler:    _Msg_ReplaceDepositForBurn_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/orbiter/component/forwarder/v1/tx.proto",
+func (m *MsgRemoveAmountLimitResponse) Reset()         { *m = MsgRemoveAmountLimitResponse{} }
+func (m *MsgRemoveAmountLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAmountLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveAmountLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{17}
 }
-
-func (m *MsgPauseProtocol) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveAmountLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAmountLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAmountLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPauseProtocol) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveAmountLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAmountLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveAmountLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAmountLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAmountLimitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPauseProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProtocolId) > 0 {
-		i -= len(m.ProtocolId)
-		copy(dAtA[i:], m.ProtocolId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgRemoveAmountLimitResponse proto.InternalMessageInfo
+
+// MsgClaimInFlightRefund sends the held funds of an in-flight packet whose
+// refund failed. It can be sent by the refund recipient of the packet or by
+// the authority of the module.
+type MsgClaimInFlightRefund struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Noble channel used to send the packet.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Sequence of the packet on the channel.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Noble address receiving the funds. When empty, the funds are
+	// sent to the refund recipient of the packet.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgPauseProtocolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimInFlightRefund) Reset()         { *m = MsgClaimInFlightRefund{} }
+func (m *MsgClaimInFlightRefund) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimInFlightRefund) ProtoMessage()    {}
+func (*MsgClaimInFlightRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{18}
+}
+func (m *MsgClaimInFlightRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimInFlightRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimInFlightRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgPauseProtocolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimInFlightRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimInFlightRefund.Merge(m, src)
+}
+func (m *MsgClaimInFlightRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimInFlightRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimInFlightRefund.DiscardUnknown(m)
 }
 
-func (m *MsgPauseProtocolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgClaimInFlightRefund proto.InternalMessageInfo
+
+// MsgClaimInFlightRefundResponse is the response type from
+// a MsgClaimInFlightRefund request.
+type MsgClaimInFlightRefundResponse struct {
 }
 
-func (m *MsgPauseCrossChains) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimInFlightRefundResponse) Reset()         { *m = MsgClaimInFlightRefundResponse{} }
+func (m *MsgClaimInFlightRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimInFlightRefundResponse) ProtoMessage()    {}
+func (*MsgClaimInFlightRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{19}
+}
+func (m *MsgClaimInFlightRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimInFlightRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimInFlightRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimInFlightRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimInFlightRefundResponse.Merge(m, src)
+}
+func (m *MsgClaimInFlightRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimInFlightRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimInFlightRefundResponse.DiscardUnknown(m)
 }
 
-func (m *MsgPauseCrossChains) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimInFlightRefundResponse proto.InternalMessageInfo
+
+// MsgClaimHeldBatchEntry sends the held funds of a batch entry that could not
+// be sent to its fallback recipient. It can be sent by the fallback recipient
+// of the entry or by the authority of the module.
+type MsgClaimHeldBatchEntry struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ID of the held batch entry.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Noble address receiving the funds. When empty, the funds are
+	// sent to the fallback recipient of the entry.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgPauseCrossChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CounterpartyIds) > 0 {
-		for iNdEx := len(m.CounterpartyIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CounterpartyIds[iNdEx])
-			copy(dAtA[i:], m.CounterpartyIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgClaimHeldBatchEntry) Reset()         { *m = MsgClaimHeldBatchEntry{} }
+func (m *MsgClaimHeldBatchEntry) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHeldBatchEntry) ProtoMessage()    {}
+func (*MsgClaimHeldBatchEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{20}
+}
+func (m *MsgClaimHeldBatchEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHeldBatchEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHeldBatchEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ProtocolId) > 0 {
-		i -= len(m.ProtocolId)
-		copy(dAtA[i:], m.ProtocolId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgPauseCrossChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimHeldBatchEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHeldBatchEntry.Merge(m, src)
 }
-
-func (m *MsgPauseCrossChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimHeldBatchEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHeldBatchEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHeldBatchEntry.DiscardUnknown(m)
 }
 
-func (m *MsgPauseCrossChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgClaimHeldBatchEntry proto.InternalMessageInfo
+
+// MsgClaimHeldBatchEntryResponse is the response type from
+// a MsgClaimHeldBatchEntry request.
+type MsgClaimHeldBatchEntryResponse struct {
 }
 
-func (m *MsgUnpauseProtocol) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimHeldBatchEntryResponse) Reset()         { *m = MsgClaimHeldBatchEntryResponse{} }
+func (m *MsgClaimHeldBatchEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHeldBatchEntryResponse) ProtoMessage()    {}
+func (*MsgClaimHeldBatchEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{21}
+}
+func (m *MsgClaimHeldBatchEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHeldBatchEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHeldBatchEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimHeldBatchEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHeldBatchEntryResponse.Merge(m, src)
+}
+func (m *MsgClaimHeldBatchEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHeldBatchEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHeldBatchEntryResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnpauseProtocol) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimHeldBatchEntryResponse proto.InternalMessageInfo
+
+// MsgClaimHeldEscrowedPacket sends the held funds of an escrowed packet
+// without a fallback recipient, which expired or failed to be dispatched.
+// It can only be sent by the authority of the module.
+type MsgClaimHeldEscrowedPacket struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// ID of the held escrowed packet.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Noble address receiving the funds.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgUnpauseProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProtocolId) > 0 {
-		i -= len(m.ProtocolId)
-		copy(dAtA[i:], m.ProtocolId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgClaimHeldEscrowedPacket) Reset()         { *m = MsgClaimHeldEscrowedPacket{} }
+func (m *MsgClaimHeldEscrowedPacket) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHeldEscrowedPacket) ProtoMessage()    {}
+func (*MsgClaimHeldEscrowedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{22}
+}
+func (m *MsgClaimHeldEscrowedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHeldEscrowedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHeldEscrowedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgClaimHeldEscrowedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHeldEscrowedPacket.Merge(m, src)
+}
+func (m *MsgClaimHeldEscrowedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHeldEscrowedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHeldEscrowedPacket.DiscardUnknown(m)
 }
 
-func (m *MsgUnpauseProtocolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimHeldEscrowedPacket proto.InternalMessageInfo
+
+// MsgClaimHeldEscrowedPacketResponse is the response type
+// from a MsgClaimHeldEscrowedPacket request.
+type MsgClaimHeldEscrowedPacketResponse struct {
+}
+
+func (m *MsgClaimHeldEscrowedPacketResponse) Reset()         { *m = MsgClaimHeldEscrowedPacketResponse{} }
+func (m *MsgClaimHeldEscrowedPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHeldEscrowedPacketResponse) ProtoMessage()    {}
+func (*MsgClaimHeldEscrowedPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{23}
+}
+func (m *MsgClaimHeldEscrowedPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHeldEscrowedPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHeldEscrowedPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgClaimHeldEscrowedPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHeldEscrowedPacketResponse.Merge(m, src)
+}
+func (m *MsgClaimHeldEscrowedPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHeldEscrowedPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHeldEscrowedPacketResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnpauseProtocolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgClaimHeldEscrowedPacketResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the request to update the forwarder component parameters.
+type MsgUpdateParams struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgUnpauseProtocolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{24}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgUnpauseCrossChains) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUnpauseCrossChains) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgUnpauseCrossChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CounterpartyIds) > 0 {
-		for iNdEx := len(m.CounterpartyIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CounterpartyIds[iNdEx])
-			copy(dAtA[i:], m.CounterpartyIds[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyIds[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+// MsgUpdateParamsResponse is the response to the MsgUpdateParams.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d12dabcf7836b4c, []int{25}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ProtocolId) > 0 {
-		i -= len(m.ProtocolId)
-		copy(dAtA[i:], m.ProtocolId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgPauseProtocol)(nil), "noble.orbiter.component.forwarder.v1.MsgPauseProtocol")
+	proto.RegisterType((*MsgPauseProtocolResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgPauseProtocolResponse")
+	proto.RegisterType((*MsgPauseCrossChains)(nil), "noble.orbiter.component.forwarder.v1.MsgPauseCrossChains")
+	proto.RegisterType((*MsgPauseCrossChainsResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgPauseCrossChainsResponse")
+	proto.RegisterType((*MsgUnpauseProtocol)(nil), "noble.orbiter.component.forwarder.v1.MsgUnpauseProtocol")
+	proto.RegisterType((*MsgUnpauseProtocolResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgUnpauseProtocolResponse")
+	proto.RegisterType((*MsgUnpauseCrossChains)(nil), "noble.orbiter.component.forwarder.v1.MsgUnpauseCrossChains")
+	proto.RegisterType((*MsgUnpauseCrossChainsResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgUnpauseCrossChainsResponse")
+	proto.RegisterType((*MsgReplaceDepositForBurn)(nil), "noble.orbiter.component.forwarder.v1.MsgReplaceDepositForBurn")
+	proto.RegisterType((*MsgReplaceDepositForBurnResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgReplaceDepositForBurnResponse")
+	proto.RegisterType((*MsgSetHyperlaneTokenRoute)(nil), "noble.orbiter.component.forwarder.v1.MsgSetHyperlaneTokenRoute")
+	proto.RegisterType((*MsgSetHyperlaneTokenRouteResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgSetHyperlaneTokenRouteResponse")
+	proto.RegisterType((*MsgRemoveHyperlaneTokenRoute)(nil), "noble.orbiter.component.forwarder.v1.MsgRemoveHyperlaneTokenRoute")
+	proto.RegisterType((*MsgRemoveHyperlaneTokenRouteResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgRemoveHyperlaneTokenRouteResponse")
+	proto.RegisterType((*MsgSetAmountLimit)(nil), "noble.orbiter.component.forwarder.v1.MsgSetAmountLimit")
+	proto.RegisterType((*MsgSetAmountLimitResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgSetAmountLimitResponse")
+	proto.RegisterType((*MsgRemoveAmountLimit)(nil), "noble.orbiter.component.forwarder.v1.MsgRemoveAmountLimit")
+	proto.RegisterType((*MsgRemoveAmountLimitResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgRemoveAmountLimitResponse")
+	proto.RegisterType((*MsgClaimInFlightRefund)(nil), "noble.orbiter.component.forwarder.v1.MsgClaimInFlightRefund")
+	proto.RegisterType((*MsgClaimInFlightRefundResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgClaimInFlightRefundResponse")
+	proto.RegisterType((*MsgClaimHeldBatchEntry)(nil), "noble.orbiter.component.forwarder.v1.MsgClaimHeldBatchEntry")
+	proto.RegisterType((*MsgClaimHeldBatchEntryResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgClaimHeldBatchEntryResponse")
+	proto.RegisterType((*MsgClaimHeldEscrowedPacket)(nil), "noble.orbiter.component.forwarder.v1.MsgClaimHeldEscrowedPacket")
+	proto.RegisterType((*MsgClaimHeldEscrowedPacketResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgClaimHeldEscrowedPacketResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "noble.orbiter.component.forwarder.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "noble.orbiter.component.forwarder.v1.MsgUpdateParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/component/forwarder/v1/tx.proto", fileDescriptor_6d12dabcf7836b4c)
+}
+
+var fileDescriptor_6d12dabcf7836b4c = []byte{
+	// 1354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x13, 0xc7,
+	0x1b, 0xce, 0x24, 0x21, 0x3f, 0xfc, 0xf2, 0x91, 0x64, 0x09, 0xe0, 0x2c, 0x60, 0x87, 0x25, 0xbf,
+	0xf2, 0x51, 0x62, 0x03, 0xa5, 0x7c, 0x18, 0x28, 0xc4, 0x09, 0x14, 0x57, 0xb5, 0x88, 0x96, 0xb6,
+	0x87, 0x5e, 0xac, 0x8d, 0x77, 0x58, 0x8f, 0xf0, 0xce, 0x6c, 0x77, 0xc6, 0x09, 0xb9, 0xb5, 0x55,
+	0xa5, 0x56, 0x48, 0x95, 0x90, 0x2a, 0x55, 0xea, 0xa5, 0x45, 0x48, 0x95, 0x2a, 0xf5, 0xc2, 0x81,
+	0x3f, 0xa1, 0x07, 0x6e, 0x05, 0x7a, 0x69, 0x39, 0xa0, 0x0a, 0x54, 0xd1, 0x5b, 0xff, 0x81, 0x1e,
+	0x2a, 0xef, 0xae, 0xc7, 0x6b, 0x7b, 0x97, 0xfa, 0x43, 0x51, 0x73, 0x89, 0x32, 0xef, 0x3b, 0xcf,
+	0x3b, 0xcf, 0xf3, 0xcc, 0xec, 0xe8, 0x1d, 0xc3, 0x1c, 0x65, 0xcb, 0x55, 0x9c, 0x65, 0xee, 0x32,
+	0x11, 0xd8, 0xcd, 0x96, 0x99, 0xed, 0x30, 0x8a, 0xa9, 0xc8, 0xde, 0x60, 0xee, 0xaa, 0xe1, 0x9a,
+	0xd8, 0xcd, 0xae, 0x1c, 0xcf, 0x8a, 0x5b, 0x19, 0xc7, 0x65, 0x82, 0x29, 0xb3, 0xde, 0xf4, 0x4c,
+	0x30, 0x3d, 0x23, 0xa7, 0x67, 0xe4, 0xf4, 0xcc, 0xca, 0x71, 0x75, 0xd2, 0xb0, 0x09, 0x65, 0x59,
+	0xef, 0xaf, 0x0f, 0x54, 0x77, 0x97, 0x19, 0xb7, 0x19, 0xcf, 0xda, 0xdc, 0xaa, 0x17, 0xb4, 0xb9,
+	0x15, 0x24, 0xa6, 0xfd, 0x44, 0xc9, 0x1b, 0x65, 0xfd, 0x41, 0x90, 0x9a, 0xb2, 0x98, 0xc5, 0xfc,
+	0x78, 0xfd, 0xbf, 0x20, 0x7a, 0xb2, 0x2b, 0xc6, 0x4d, 0x3e, 0x1e, 0x4a, 0xfb, 0x16, 0xc1, 0x44,
+	0x91, 0x5b, 0x4b, 0x46, 0x8d, 0xe3, 0xa5, 0x7a, 0xa4, 0xcc, 0xaa, 0xca, 0x31, 0x18, 0xe3, 0xc4,
+	0xa2, 0xd8, 0x4d, 0xa2, 0x19, 0x74, 0x28, 0x91, 0x4f, 0x3e, 0x79, 0x30, 0x37, 0x15, 0x50, 0x98,
+	0x37, 0x4d, 0x17, 0x73, 0x7e, 0x5d, 0xb8, 0x84, 0x5a, 0x7a, 0x30, 0x4f, 0x49, 0xc3, 0x16, 0x27,
+	0x40, 0x97, 0x88, 0x99, 0x1c, 0xae, 0xc3, 0x74, 0x68, 0x84, 0x0a, 0x66, 0xee, 0xf4, 0x17, 0x77,
+	0xd3, 0x43, 0x7f, 0xde, 0x4d, 0x0f, 0x7d, 0xfa, 0xf2, 0xfe, 0x91, 0x00, 0x75, 0xfb, 0xe5, 0xfd,
+	0x23, 0xe9, 0x06, 0xdf, 0x26, 0xcb, 0x16, 0x2e, 0x9a, 0x0a, 0xc9, 0x76, 0x7e, 0x3a, 0xe6, 0x0e,
+	0xa3, 0x1c, 0x6b, 0x3f, 0x23, 0xd8, 0xd1, 0x48, 0x2e, 0xb8, 0x8c, 0xf3, 0x85, 0x8a, 0x41, 0x28,
+	0x5f, 0x07, 0xfe, 0xca, 0x61, 0x98, 0x28, 0xb3, 0x1a, 0x15, 0xd8, 0x75, 0x0c, 0x57, 0xac, 0x95,
+	0x88, 0xc9, 0x93, 0x23, 0x33, 0x23, 0x87, 0x12, 0xfa, 0x78, 0x38, 0x5e, 0x30, 0x79, 0x2e, 0x17,
+	0x23, 0x55, 0x8b, 0x91, 0x1a, 0x62, 0xae, 0xed, 0x83, 0x3d, 0x11, 0x82, 0xa4, 0xe0, 0x7b, 0x08,
+	0x94, 0x22, 0xb7, 0xde, 0xa7, 0xce, 0x7a, 0xef, 0xd7, 0xd9, 0x18, 0x11, 0xfb, 0x3b, 0x45, 0xb4,
+	0xb1, 0xd1, 0xf6, 0x82, 0xda, 0xc9, 0x51, 0x4a, 0xf8, 0x05, 0xc1, 0xce, 0x66, 0x7a, 0xe3, 0xec,
+	0xda, 0xf9, 0x18, 0xc1, 0xb3, 0xb1, 0x82, 0xc3, 0xfb, 0x96, 0x86, 0x7d, 0x91, 0xa2, 0x9a, 0x47,
+	0x75, 0xd8, 0x3b, 0xc7, 0x3a, 0x76, 0xaa, 0x46, 0x19, 0x2f, 0x62, 0x87, 0x71, 0x22, 0xae, 0x30,
+	0x37, 0x5f, 0x73, 0x69, 0x1f, 0xca, 0x0f, 0xc3, 0x04, 0x73, 0x89, 0x45, 0xa8, 0x51, 0x2d, 0xd9,
+	0x98, 0x73, 0xc3, 0xc2, 0x9e, 0xfc, 0xad, 0xfa, 0x78, 0x23, 0x5e, 0xf4, 0xc3, 0xca, 0x71, 0x98,
+	0x92, 0x53, 0x0d, 0x21, 0x30, 0x17, 0x86, 0x20, 0x8c, 0x26, 0x47, 0xbc, 0xe9, 0x3b, 0x1a, 0xb9,
+	0xf9, 0x66, 0x4a, 0x39, 0x09, 0xbb, 0x28, 0x5e, 0x2d, 0x99, 0x98, 0x0b, 0x42, 0xbd, 0x50, 0xa9,
+	0x6c, 0x54, 0xab, 0xd8, 0x4d, 0x8e, 0x7a, 0xa0, 0x29, 0x8a, 0x57, 0x17, 0x9b, 0xc9, 0x05, 0x2f,
+	0xa7, 0x1c, 0x05, 0xa5, 0x8e, 0xb2, 0x09, 0x15, 0x25, 0x17, 0x97, 0x89, 0x43, 0x30, 0x15, 0xc9,
+	0x4d, 0x1e, 0x62, 0x82, 0xe2, 0xd5, 0x22, 0xa1, 0x42, 0x6f, 0xc4, 0x73, 0x17, 0x63, 0xfc, 0x3e,
+	0xd8, 0xe9, 0x77, 0xa4, 0x69, 0x9a, 0x06, 0x33, 0x71, 0x86, 0x4a, 0xd7, 0xff, 0x42, 0x30, 0x5d,
+	0xe4, 0xd6, 0x75, 0x2c, 0xae, 0xae, 0x39, 0xd8, 0xad, 0x1a, 0x14, 0xbf, 0xc7, 0x6e, 0x62, 0xaa,
+	0xb3, 0x9a, 0xc0, 0x7d, 0xd8, 0x3e, 0x05, 0x9b, 0x4c, 0x4c, 0x99, 0x1d, 0x1c, 0x35, 0x7f, 0xa0,
+	0xcc, 0x81, 0x12, 0xb6, 0xca, 0x64, 0xb6, 0x41, 0x7c, 0x7f, 0xb7, 0xe9, 0x93, 0xa1, 0xcc, 0xa2,
+	0x97, 0x50, 0xa6, 0x61, 0xb3, 0xa8, 0x93, 0xa8, 0x1f, 0x59, 0xdf, 0xcf, 0xff, 0x79, 0xe3, 0x82,
+	0x99, 0xbb, 0x14, 0x63, 0xca, 0xa1, 0x4e, 0x53, 0xa2, 0x35, 0x69, 0x07, 0x60, 0x7f, 0xac, 0x60,
+	0x69, 0xcb, 0x53, 0x04, 0x7b, 0x3d, 0xef, 0x6c, 0xb6, 0x82, 0x37, 0x8e, 0x33, 0xb9, 0x85, 0x18,
+	0xf9, 0xaf, 0x47, 0x9d, 0x89, 0x18, 0xee, 0xda, 0x6b, 0x30, 0xfb, 0x2a, 0x6d, 0xd2, 0x84, 0x4f,
+	0x46, 0x60, 0xd2, 0xb7, 0x6a, 0xde, 0xae, 0xdf, 0x05, 0xef, 0x12, 0x9b, 0x88, 0xf5, 0xb8, 0x84,
+	0x0e, 0xc2, 0x78, 0xdb, 0x25, 0xe4, 0x39, 0x90, 0xd0, 0xb7, 0xb7, 0xde, 0x41, 0x4d, 0x0f, 0x47,
+	0xc3, 0x1e, 0x5e, 0x03, 0xb0, 0x09, 0x2d, 0x19, 0x1e, 0x49, 0xef, 0x73, 0x4a, 0xe4, 0x8f, 0x3d,
+	0x7c, 0x96, 0x1e, 0x7a, 0xfa, 0x2c, 0xbd, 0xd3, 0x67, 0xc6, 0xcd, 0x9b, 0x19, 0xc2, 0xb2, 0xb6,
+	0x21, 0x2a, 0x99, 0x02, 0x15, 0x4f, 0x1e, 0xcc, 0x41, 0x40, 0xb9, 0x40, 0xc5, 0x0f, 0x2f, 0xef,
+	0x1f, 0x41, 0x7a, 0xc2, 0x26, 0xd4, 0xd7, 0xe9, 0x15, 0x34, 0x6e, 0x35, 0x0a, 0x8e, 0xf5, 0x5d,
+	0xd0, 0xb8, 0xe5, 0x17, 0xcc, 0x9d, 0x89, 0xd9, 0xb6, 0x99, 0xc8, 0x53, 0x1b, 0x72, 0x5b, 0xdb,
+	0xd3, 0xf8, 0x3c, 0x43, 0x41, 0xb9, 0x41, 0x7f, 0x20, 0x98, 0x92, 0x3b, 0xb9, 0xa1, 0xf7, 0x28,
+	0x77, 0x2e, 0xc6, 0x81, 0x03, 0x71, 0x07, 0x37, 0x6c, 0x42, 0x2a, 0xf4, 0x31, 0x46, 0xf9, 0xf0,
+	0x37, 0x82, 0x5d, 0x45, 0x6e, 0x2d, 0x54, 0x0d, 0x62, 0x17, 0xe8, 0x95, 0x2a, 0xb1, 0x2a, 0x42,
+	0xc7, 0x37, 0x6a, 0xd4, 0xec, 0xc3, 0x89, 0x7d, 0x00, 0xe5, 0x8a, 0x41, 0x29, 0x0e, 0x19, 0x91,
+	0x08, 0x22, 0x05, 0x53, 0x51, 0x61, 0x33, 0xc7, 0x1f, 0xd5, 0x30, 0x2d, 0x63, 0xcf, 0x80, 0x51,
+	0x5d, 0x8e, 0x95, 0x53, 0x90, 0x68, 0x5e, 0xeb, 0xa3, 0xff, 0xb2, 0x5e, 0x73, 0x6a, 0xee, 0x42,
+	0x8c, 0x39, 0xff, 0xef, 0x34, 0x27, 0x42, 0xa3, 0x36, 0x03, 0xa9, 0x68, 0xf5, 0xd2, 0xa0, 0xc7,
+	0x21, 0x83, 0xae, 0xe2, 0xaa, 0x99, 0x37, 0x44, 0xb9, 0x72, 0x99, 0x0a, 0x77, 0xad, 0x0f, 0x83,
+	0xb6, 0xc3, 0x70, 0x60, 0xcc, 0xa8, 0x3e, 0x4c, 0xcc, 0x56, 0xd5, 0x23, 0xeb, 0xa7, 0xba, 0x95,
+	0x78, 0x58, 0x75, 0x6b, 0x46, 0xaa, 0xfe, 0x0d, 0x81, 0x1a, 0x9e, 0x72, 0x99, 0x97, 0x5d, 0xb6,
+	0x8a, 0xcd, 0x25, 0xa3, 0x7c, 0x13, 0x8b, 0xff, 0x50, 0xf9, 0x7c, 0x8c, 0xf2, 0xc3, 0xaf, 0x50,
+	0xde, 0x4a, 0x5e, 0x9b, 0x05, 0x2d, 0x5e, 0x9a, 0x74, 0xe0, 0x27, 0x04, 0xe3, 0xf5, 0xae, 0xcb,
+	0x31, 0x0d, 0x81, 0x97, 0x0c, 0xd7, 0xb0, 0xfb, 0x69, 0x22, 0xaf, 0xc1, 0x98, 0xe3, 0x61, 0x3d,
+	0xe9, 0x5b, 0x4e, 0x1c, 0xcd, 0x74, 0xf3, 0x96, 0xcb, 0xf8, 0xeb, 0xe5, 0x13, 0xf5, 0x8b, 0xd3,
+	0xbf, 0x11, 0x83, 0x32, 0xb9, 0x4c, 0x9b, 0xee, 0x54, 0x44, 0x07, 0x19, 0xa2, 0xac, 0x4d, 0xc3,
+	0xee, 0x36, 0x15, 0x0d, 0x85, 0x27, 0x1e, 0x8f, 0xc3, 0x48, 0x91, 0x5b, 0xca, 0xe7, 0x08, 0xb6,
+	0xb5, 0x3e, 0xd1, 0x4e, 0x75, 0xc7, 0xb2, 0xfd, 0xe9, 0xa4, 0xbe, 0xd5, 0x1f, 0xae, 0xc1, 0x48,
+	0xf9, 0x12, 0xc1, 0x78, 0xfb, 0xf3, 0xe3, 0x4c, 0xd7, 0x35, 0xdb, 0x90, 0xea, 0xa5, 0x7e, 0x91,
+	0x92, 0xcf, 0x1d, 0x04, 0x13, 0x1d, 0xef, 0xbf, 0xb3, 0xbd, 0x89, 0x0c, 0x41, 0xd5, 0xf9, 0xbe,
+	0xa1, 0x92, 0xd2, 0xd7, 0x08, 0x94, 0x88, 0xe7, 0xcd, 0xb9, 0x5e, 0xb5, 0x86, 0x69, 0x2d, 0x0c,
+	0x00, 0x96, 0xc4, 0xbe, 0x43, 0xb0, 0x33, 0xfa, 0x01, 0xd2, 0xfd, 0xa9, 0x88, 0xc4, 0xab, 0x57,
+	0x06, 0xc3, 0x4b, 0x86, 0xf7, 0x10, 0xec, 0x8a, 0x69, 0xd6, 0x2f, 0x76, 0xbd, 0x44, 0x74, 0x01,
+	0xf5, 0xed, 0x01, 0x0b, 0x48, 0x92, 0x3f, 0x22, 0x98, 0x8e, 0x6f, 0x9d, 0xf3, 0x3d, 0x58, 0x11,
+	0x53, 0x43, 0x7d, 0x67, 0xf0, 0x1a, 0x92, 0xed, 0x6d, 0x04, 0xdb, 0xdb, 0x7a, 0xdc, 0xd3, 0xbd,
+	0x38, 0x11, 0x02, 0xaa, 0x17, 0xfb, 0x04, 0x4a, 0x32, 0x5f, 0x21, 0x98, 0xec, 0xec, 0xe7, 0x72,
+	0x3d, 0xca, 0x0d, 0x53, 0xca, 0xf7, 0x8f, 0x95, 0xac, 0xbe, 0x41, 0xb0, 0x23, 0xaa, 0xbb, 0x3a,
+	0xdf, 0x75, 0xed, 0x08, 0xb4, 0xba, 0x38, 0x08, 0xba, 0x93, 0x5b, 0x5b, 0x63, 0xd3, 0x23, 0xb7,
+	0x56, 0xb4, 0xba, 0x38, 0x08, 0x5a, 0x72, 0xfb, 0x1e, 0xc1, 0xee, 0xb8, 0xf6, 0xe3, 0x52, 0xef,
+	0x2b, 0xb4, 0x56, 0x50, 0xaf, 0x0e, 0x5a, 0x41, 0xf2, 0xfc, 0x0c, 0xc1, 0xd6, 0x96, 0x26, 0xe1,
+	0xcd, 0xee, 0x6f, 0xd3, 0x10, 0x4c, 0xbd, 0xd0, 0x17, 0xac, 0x41, 0x43, 0xdd, 0xf4, 0x71, 0xbd,
+	0x4d, 0xc8, 0x7f, 0xf0, 0xf0, 0x79, 0x0a, 0x3d, 0x7a, 0x9e, 0x42, 0xbf, 0x3f, 0x4f, 0xa1, 0x3b,
+	0x2f, 0x52, 0x43, 0x8f, 0x5e, 0xa4, 0x86, 0x7e, 0x7d, 0x91, 0x1a, 0xfa, 0xf0, 0xbc, 0x45, 0x44,
+	0xa5, 0xb6, 0x5c, 0xaf, 0x9b, 0xf5, 0x56, 0x9a, 0x33, 0x38, 0xc7, 0x82, 0xcb, 0xdf, 0x74, 0x57,
+	0x4e, 0x64, 0xc5, 0x9a, 0x83, 0x79, 0xd4, 0x8f, 0xbb, 0xcb, 0x63, 0xde, 0x73, 0xe6, 0x8d, 0x7f,
+	0x06, 0x00, 0x08, 0x08, 0xea, 0x41, 0xba, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// PauseProtocol pauses an entire forwarding protocol.
+	PauseProtocol(ctx context.Context, in *MsgPauseProtocol, opts ...grpc.CallOption) (*MsgPauseProtocolResponse, error)
+	// UnpauseProtocol resumes an entire forwarding protocol.
+	UnpauseProtocol(ctx context.Context, in *MsgUnpauseProtocol, opts ...grpc.CallOption) (*MsgUnpauseProtocolResponse, error)
+	//  PauseCrossChains pauses specific counterparties for a protocol.
+	PauseCrossChains(ctx context.Context, in *MsgPauseCrossChains, opts ...grpc.CallOption) (*MsgPauseCrossChainsResponse, error)
+	// UnpauseCrossChains resumes specific counterparties for a protocol.
+	UnpauseCrossChains(ctx context.Context, in *MsgUnpauseCrossChains, opts ...grpc.CallOption) (*MsgUnpauseCrossChainsResponse, error)
+	ReplaceDepositForBurn(ctx context.Context, in *MsgReplaceDepositForBurn, opts ...grpc.CallOption) (*MsgReplaceDepositForBurnResponse, error)
+	// SetHyperlaneTokenRoute sets the Hyperlane warp token used for a denom
+	// and destination domain.
+	SetHyperlaneTokenRoute(ctx context.Context, in *MsgSetHyperlaneTokenRoute, opts ...grpc.CallOption) (*MsgSetHyperlaneTokenRouteResponse, error)
+	// RemoveHyperlaneTokenRoute removes the Hyperlane warp token used for a
+	// denom and destination domain.
+	RemoveHyperlaneTokenRoute(ctx context.Context, in *MsgRemoveHyperlaneTokenRoute, opts ...grpc.CallOption) (*MsgRemoveHyperlaneTokenRouteResponse, error)
+	// SetAmountLimit sets the minimum and maximum amount of a denom
+	// forwarded with a single transfer to a destination.
+	SetAmountLimit(ctx context.Context, in *MsgSetAmountLimit, opts ...grpc.CallOption) (*MsgSetAmountLimitResponse, error)
+	// RemoveAmountLimit removes the amount limit of a denom and destination.
+	RemoveAmountLimit(ctx context.Context, in *MsgRemoveAmountLimit, opts ...grpc.CallOption) (*MsgRemoveAmountLimitResponse, error)
+	// ClaimInFlightRefund sends the held funds of an in-flight packet
+	// whose refund failed.
+	ClaimInFlightRefund(ctx context.Context, in *MsgClaimInFlightRefund, opts ...grpc.CallOption) (*MsgClaimInFlightRefundResponse, error)
+	// ClaimHeldBatchEntry sends the held funds of a batch entry
+	// that could not be sent to its fallback recipient.
+	ClaimHeldBatchEntry(ctx context.Context, in *MsgClaimHeldBatchEntry, opts ...grpc.CallOption) (*MsgClaimHeldBatchEntryResponse, error)
+	// ClaimHeldEscrowedPacket sends the held funds of an escrowed
+	// packet without a fallback recipient.
+	ClaimHeldEscrowedPacket(ctx context.Context, in *MsgClaimHeldEscrowedPacket, opts ...grpc.CallOption) (*MsgClaimHeldEscrowedPacketResponse, error)
+	// UpdateParams updates the Forwarder component params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) PauseProtocol(ctx context.Context, in *MsgPauseProtocol, opts ...grpc.CallOption) (*MsgPauseProtocolResponse, error) {
+	out := new(MsgPauseProtocolResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/PauseProtocol", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) UnpauseProtocol(ctx context.Context, in *MsgUnpauseProtocol, opts ...grpc.CallOption) (*MsgUnpauseProtocolResponse, error) {
+	out := new(MsgUnpauseProtocolResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/UnpauseProtocol", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUnpauseCrossChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PauseCrossChains(ctx context.Context, in *MsgPauseCrossChains, opts ...grpc.CallOption) (*MsgPauseCrossChainsResponse, error) {
+	out := new(MsgPauseCrossChainsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/PauseCrossChains", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUnpauseCrossChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UnpauseCrossChains(ctx context.Context, in *MsgUnpauseCrossChains, opts ...grpc.CallOption) (*MsgUnpauseCrossChainsResponse, error) {
+	out := new(MsgUnpauseCrossChainsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/UnpauseCrossChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUnpauseCrossChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ReplaceDepositForBurn(ctx context.Context, in *MsgReplaceDepositForBurn, opts ...grpc.CallOption) (*MsgReplaceDepositForBurnResponse, error) {
+	out := new(MsgReplaceDepositForBurnResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/ReplaceDepositForBurn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgReplaceDepositForBurn) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetHyperlaneTokenRoute(ctx context.Context, in *MsgSetHyperlaneTokenRoute, opts ...grpc.CallOption) (*MsgSetHyperlaneTokenRouteResponse, error) {
+	out := new(MsgSetHyperlaneTokenRouteResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/SetHyperlaneTokenRoute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgReplaceDepositForBurn) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) RemoveHyperlaneTokenRoute(ctx context.Context, in *MsgRemoveHyperlaneTokenRoute, opts ...grpc.CallOption) (*MsgRemoveHyperlaneTokenRouteResponse, error) {
+	out := new(MsgRemoveHyperlaneTokenRouteResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/RemoveHyperlaneTokenRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgReplaceDepositForBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewMintRecipient) > 0 {
-		i -= len(m.NewMintRecipient)
-		copy(dAtA[i:], m.NewMintRecipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewMintRecipient)))
-		i--
-		dAtA[i] = 0x2a
+func (c *msgClient) SetAmountLimit(ctx context.Context, in *MsgSetAmountLimit, opts ...grpc.CallOption) (*MsgSetAmountLimitResponse, error) {
+	out := new(MsgSetAmountLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/SetAmountLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.NewDestinationCaller) > 0 {
-		i -= len(m.NewDestinationCaller)
-		copy(dAtA[i:], m.NewDestinationCaller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewDestinationCaller)))
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *msgClient) RemoveAmountLimit(ctx context.Context, in *MsgRemoveAmountLimit, opts ...grpc.CallOption) (*MsgRemoveAmountLimitResponse, error) {
+	out := new(MsgRemoveAmountLimitResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/RemoveAmountLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OriginalAttestation) > 0 {
-		i -= len(m.OriginalAttestation)
-		copy(dAtA[i:], m.OriginalAttestation)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalAttestation)))
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *msgClient) ClaimInFlightRefund(ctx context.Context, in *MsgClaimInFlightRefund, opts ...grpc.CallOption) (*MsgClaimInFlightRefundResponse, error) {
+	out := new(MsgClaimInFlightRefundResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/ClaimInFlightRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OriginalMessage) > 0 {
-		i -= len(m.OriginalMessage)
-		copy(dAtA[i:], m.OriginalMessage)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalMessage)))
-		i--
-		dAtA[i] = 0x12
+	return out, nil
+}
+
+func (c *msgClient) ClaimHeldBatchEntry(ctx context.Context, in *MsgClaimHeldBatchEntry, opts ...grpc.CallOption) (*MsgClaimHeldBatchEntryResponse, error) {
+	out := new(MsgClaimHeldBatchEntryResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/ClaimHeldBatchEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) ClaimHeldEscrowedPacket(ctx context.Context, in *MsgClaimHeldEscrowedPacket, opts ...grpc.CallOption) (*MsgClaimHeldEscrowedPacketResponse, error) {
+	out := new(MsgClaimHeldEscrowedPacketResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/ClaimHeldEscrowedPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgReplaceDepositForBurnResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.component.forwarder.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgReplaceDepositForBurnResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// PauseProtocol pauses an entire forwarding protocol.
+	PauseProtocol(context.Context, *MsgPauseProtocol) (*MsgPauseProtocolResponse, error)
+	// UnpauseProtocol resumes an entire forwarding protocol.
+	UnpauseProtocol(context.Context, *MsgUnpauseProtocol) (*MsgUnpauseProtocolResponse, error)
+	//  PauseCrossChains pauses specific counterparties for a protocol.
+	PauseCrossChains(context.Context, *MsgPauseCrossChains) (*MsgPauseCrossChainsResponse, error)
+	// UnpauseCrossChains resumes specific counterparties for a protocol.
+	UnpauseCrossChains(context.Context, *MsgUnpauseCrossChains) (*MsgUnpauseCrossChainsResponse, error)
+	ReplaceDepositForBurn(context.Context, *MsgReplaceDepositForBurn) (*MsgReplaceDepositForBurnResponse, error)
+	// SetHyperlaneTokenRoute sets the Hyperlane warp token used for a denom
+	// and destination domain.
+	SetHyperlaneTokenRoute(context.Context, *MsgSetHyperlaneTokenRoute) (*MsgSetHyperlaneTokenRouteResponse, error)
+	// RemoveHyperlaneTokenRoute removes the Hyperlane warp token used for a
+	// denom and destination domain.
+	RemoveHyperlaneTokenRoute(context.Context, *MsgRemoveHyperlaneTokenRoute) (*MsgRemoveHyperlaneTokenRouteResponse, error)
+	// SetAmountLimit sets the minimum and maximum amount of a denom
+	// forwarded with a single transfer to a destination.
+	SetAmountLimit(context.Context, *MsgSetAmountLimit) (*MsgSetAmountLimitResponse, error)
+	// RemoveAmountLimit removes the amount limit of a denom and destination.
+	RemoveAmountLimit(context.Context, *MsgRemoveAmountLimit) (*MsgRemoveAmountLimitResponse, error)
+	// ClaimInFlightRefund sends the held funds of an in-flight packet
+	// whose refund failed.
+	ClaimInFlightRefund(context.Context, *MsgClaimInFlightRefund) (*MsgClaimInFlightRefundResponse, error)
+	// ClaimHeldBatchEntry sends the held funds of a batch entry
+	// that could not be sent to its fallback recipient.
+	ClaimHeldBatchEntry(context.Context, *MsgClaimHeldBatchEntry) (*MsgClaimHeldBatchEntryResponse, error)
+	// ClaimHeldEscrowedPacket sends the held funds of an escrowed
+	// packet without a fallback recipient.
+	ClaimHeldEscrowedPacket(context.Context, *MsgClaimHeldEscrowedPacket) (*MsgClaimHeldEscrowedPacketResponse, error)
+	// UpdateParams updates the Forwarder component params.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgReplaceDepositForBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (*UnimplementedMsgServer) PauseProtocol(ctx context.Context, req *MsgPauseProtocol) (*MsgPauseProtocolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseProtocol not implemented")
 }
-func (m *MsgPauseProtocol) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProtocolId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (*UnimplementedMsgServer) UnpauseProtocol(ctx context.Context, req *MsgUnpauseProtocol) (*MsgUnpauseProtocolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseProtocol not implemented")
+}
+func (*UnimplementedMsgServer) PauseCrossChains(ctx context.Context, req *MsgPauseCrossChains) (*MsgPauseCrossChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCrossChains not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseCrossChains(ctx context.Context, req *MsgUnpauseCrossChains) (*MsgUnpauseCrossChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseCrossChains not implemented")
+}
+func (*UnimplementedMsgServer) ReplaceDepositForBurn(ctx context.Context, req *MsgReplaceDepositForBurn) (*MsgReplaceDepositForBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceDepositForBurn not implemented")
+}
+func (*UnimplementedMsgServer) SetHyperlaneTokenRoute(ctx context.Context, req *MsgSetHyperlaneTokenRoute) (*MsgSetHyperlaneTokenRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHyperlaneTokenRoute not implemented")
+}
+func (*UnimplementedMsgServer) RemoveHyperlaneTokenRoute(ctx context.Context, req *MsgRemoveHyperlaneTokenRoute) (*MsgRemoveHyperlaneTokenRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHyperlaneTokenRoute not implemented")
+}
+func (*UnimplementedMsgServer) SetAmountLimit(ctx context.Context, req *MsgSetAmountLimit) (*MsgSetAmountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAmountLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAmountLimit(ctx context.Context, req *MsgRemoveAmountLimit) (*MsgRemoveAmountLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAmountLimit not implemented")
+}
+func (*UnimplementedMsgServer) ClaimInFlightRefund(ctx context.Context, req *MsgClaimInFlightRefund) (*MsgClaimInFlightRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimInFlightRefund not implemented")
+}
+func (*UnimplementedMsgServer) ClaimHeldBatchEntry(ctx context.Context, req *MsgClaimHeldBatchEntry) (*MsgClaimHeldBatchEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHeldBatchEntry not implemented")
+}
+func (*UnimplementedMsgServer) ClaimHeldEscrowedPacket(ctx context.Context, req *MsgClaimHeldEscrowedPacket) (*MsgClaimHeldEscrowedPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHeldEscrowedPacket not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgPauseProtocolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgPauseCrossChains) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PauseProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseProtocol)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).PauseProtocol(ctx, in)
 	}
-	l = len(m.ProtocolId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/PauseProtocol",
 	}
-	if len(m.CounterpartyIds) > 0 {
-		for _, s := range m.CounterpartyIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseProtocol(ctx, req.(*MsgPauseProtocol))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPauseCrossChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnpauseProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseProtocol)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgUnpauseProtocol) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseProtocol(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/UnpauseProtocol",
 	}
-	l = len(m.ProtocolId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseProtocol(ctx, req.(*MsgUnpauseProtocol))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnpauseProtocolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_PauseCrossChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseCrossChains)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).PauseCrossChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/PauseCrossChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseCrossChains(ctx, req.(*MsgPauseCrossChains))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnpauseCrossChains) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UnpauseCrossChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseCrossChains)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseCrossChains(ctx, in)
 	}
-	l = len(m.ProtocolId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/UnpauseCrossChains",
 	}
-	if len(m.CounterpartyIds) > 0 {
-		for _, s := range m.CounterpartyIds {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseCrossChains(ctx, req.(*MsgUnpauseCrossChains))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUnpauseCrossChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReplaceDepositForBurn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplaceDepositForBurn)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplaceDepositForBurn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/ReplaceDepositForBurn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplaceDepositForBurn(ctx, req.(*MsgReplaceDepositForBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (m *MsgReplaceDepositForBurn) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetHyperlaneTokenRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHyperlaneTokenRoute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetHyperlaneTokenRoute(ctx, in)
 	}
-	l = len(m.OriginalMessage)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/SetHyperlaneTokenRoute",
 	}
-	l = len(m.OriginalAttestation)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHyperlaneTokenRoute(ctx, req.(*MsgSetHyperlaneTokenRoute))
 	}
-	l = len(m.NewDestinationCaller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveHyperlaneTokenRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveHyperlaneTokenRoute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.NewMintRecipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveHyperlaneTokenRoute(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/RemoveHyperlaneTokenRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveHyperlaneTokenRoute(ctx, req.(*MsgRemoveHyperlaneTokenRoute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgReplaceDepositForBurnResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetAmountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAmountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAmountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/SetAmountLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAmountLimit(ctx, req.(*MsgSetAmountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAmountLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAmountLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAmountLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/RemoveAmountLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAmountLimit(ctx, req.(*MsgRemoveAmountLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimInFlightRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimInFlightRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimInFlightRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/ClaimInFlightRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimInFlightRefund(ctx, req.(*MsgClaimInFlightRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHeldBatchEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimHeldBatchEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHeldBatchEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/ClaimHeldBatchEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHeldBatchEntry(ctx, req.(*MsgClaimHeldBatchEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHeldEscrowedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimHeldEscrowedPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHeldEscrowedPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/ClaimHeldEscrowedPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHeldEscrowedPacket(ctx, req.(*MsgClaimHeldEscrowedPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.component.forwarder.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.orbiter.component.forwarder.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PauseProtocol",
+			Handler:    _Msg_PauseProtocol_Handler,
+		},
+		{
+			MethodName: "UnpauseProtocol",
+			Handler:    _Msg_UnpauseProtocol_Handler,
+		},
+		{
+			MethodName: "PauseCrossChains",
+			Handler:    _Msg_PauseCrossChains_Handler,
+		},
+		{
+			MethodName: "UnpauseCrossChains",
+			Handler:    _Msg_UnpauseCrossChains_Handler,
+		},
+		{
+			MethodName: "ReplaceDepositForBurn",
+			Handler:    _Msg_ReplaceDepositForBurn_Handler,
+		},
+		{
+			MethodName: "SetHyperlaneTokenRoute",
+			Handler:    _Msg_SetHyperlaneTokenRoute_Handler,
+		},
+		{
+			MethodName: "RemoveHyperlaneTokenRoute",
+			Handler:    _Msg_RemoveHyperlaneTokenRoute_Handler,
+		},
+		{
+			MethodName: "SetAmountLimit",
+			Handler:    _Msg_SetAmountLimit_Handler,
+		},
+		{
+			MethodName: "RemoveAmountLimit",
+			Handler:    _Msg_RemoveAmountLimit_Handler,
+		},
+		{
+			MethodName: "ClaimInFlightRefund",
+			Handler:    _Msg_ClaimInFlightRefund_Handler,
+		},
+		{
+			MethodName: "ClaimHeldBatchEntry",
+			Handler:    _Msg_ClaimHeldBatchEntry_Handler,
+		},
+		{
+			MethodName: "ClaimHeldEscrowedPacket",
+			Handler:    _Msg_ClaimHeldEscrowedPacket_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/orbiter/component/forwarder/v1/tx.proto",
+}
+
+func (m *MsgPauseProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgPauseProtocolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgPauseProtocolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseProtocolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseCrossChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseCrossChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseCrossChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyIds) > 0 {
+		for iNdEx := len(m.CounterpartyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CounterpartyIds[iNdEx])
+			copy(dAtA[i:], m.CounterpartyIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseCrossChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseCrossChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseCrossChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseProtocolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseProtocolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseProtocolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseCrossChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseCrossChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseCrossChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CounterpartyIds) > 0 {
+		for iNdEx := len(m.CounterpartyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CounterpartyIds[iNdEx])
+			copy(dAtA[i:], m.CounterpartyIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseCrossChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseCrossChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseCrossChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceDepositForBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceDepositForBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceDepositForBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewMintRecipient) > 0 {
+		i -= len(m.NewMintRecipient)
+		copy(dAtA[i:], m.NewMintRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewMintRecipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewDestinationCaller) > 0 {
+		i -= len(m.NewDestinationCaller)
+		copy(dAtA[i:], m.NewDestinationCaller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewDestinationCaller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OriginalAttestation) > 0 {
+		i -= len(m.OriginalAttestation)
+		copy(dAtA[i:], m.OriginalAttestation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalAttestation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginalMessage) > 0 {
+		i -= len(m.OriginalMessage)
+		copy(dAtA[i:], m.OriginalMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReplaceDepositForBurnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReplaceDepositForBurnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReplaceDepositForBurnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHyperlaneTokenRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHyperlaneTokenRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHyperlaneTokenRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenId) > 0 {
+		i -= len(m.TokenId)
+		copy(dAtA[i:], m.TokenId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHyperlaneTokenRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHyperlaneTokenRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHyperlaneTokenRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHyperlaneTokenRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHyperlaneTokenRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHyperlaneTokenRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHyperlaneTokenRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHyperlaneTokenRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHyperlaneTokenRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAmountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAmountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAmountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CounterpartyId) > 0 {
+		i -= len(m.CounterpartyId)
+		copy(dAtA[i:], m.CounterpartyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAmountLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAmountLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAmountLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAmountLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAmountLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAmountLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CounterpartyId) > 0 {
+		i -= len(m.CounterpartyId)
+		copy(dAtA[i:], m.CounterpartyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CounterpartyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAmountLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAmountLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAmountLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimInFlightRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimInFlightRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimInFlightRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimInFlightRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimInFlightRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimInFlightRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHeldBatchEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHeldBatchEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHeldBatchEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHeldBatchEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHeldBatchEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHeldBatchEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHeldEscrowedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHeldEscrowedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHeldEscrowedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHeldEscrowedPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHeldEscrowedPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHeldEscrowedPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPauseProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseProtocolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseCrossChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CounterpartyIds) > 0 {
+		for _, s := range m.CounterpartyIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPauseCrossChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseProtocolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpauseCrossChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CounterpartyIds) > 0 {
+		for _, s := range m.CounterpartyIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUnpauseCrossChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceDepositForBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalMessage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalAttestation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewDestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewMintRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplaceDepositForBurnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetHyperlaneTokenRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	l = len(m.TokenId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetHyperlaneTokenRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveHyperlaneTokenRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	return n
+}
+
+func (m *MsgRemoveHyperlaneTokenRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAmountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetAmountLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAmountLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CounterpartyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveAmountLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimInFlightRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimInFlightRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimHeldBatchEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimHeldBatchEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimHeldEscrowedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimHeldEscrowedPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPauseProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseProtocolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseProtocolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseProtocolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseCrossChains) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseCrossChains: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseCrossChains: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyIds = append(m.CounterpartyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseCrossChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseCrossChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseCrossChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseProtocolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseProtocolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseProtocolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseCrossChains) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseCrossChains: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseCrossChains: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyIds = append(m.CounterpartyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseCrossChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseCrossChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseCrossChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceDepositForBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceDepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalMessage = append(m.OriginalMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalMessage == nil {
+				m.OriginalMessage = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalAttestation = append(m.OriginalAttestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalAttestation == nil {
+				m.OriginalAttestation = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDestinationCaller = append(m.NewDestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewDestinationCaller == nil {
+				m.NewDestinationCaller = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMintRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewMintRecipient = append(m.NewMintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewMintRecipient == nil {
+				m.NewMintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceDepositForBurnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceDepositForBurnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceDepositForBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHyperlaneTokenRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHyperlaneTokenRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHyperlaneTokenRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenId = append(m.TokenId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenId == nil {
+				m.TokenId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHyperlaneTokenRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHyperlaneTokenRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHyperlaneTokenRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveHyperlaneTokenRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveHyperlaneTokenRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveHyperlaneTokenRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveHyperlaneTokenRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveHyperlaneTokenRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveHyperlaneTokenRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAmountLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAmountLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAmountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAmountLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAmountLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAmountLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgPauseProtocol) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAmountLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1262,10 +4812,10 @@ func (m *MsgPauseProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAmountLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAmountLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1332,6 +4882,70 @@ func (m *MsgPauseProtocol) Unmarshal(dAtA []byte) error {
 			}
 			m.ProtocolId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1353,7 +4967,7 @@ func (m *MsgPauseProtocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseProtocolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAmountLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,10 +4990,10 @@ func (m *MsgPauseProtocolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseProtocolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAmountLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseProtocolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAmountLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1403,7 +5017,7 @@ func (m *MsgPauseProtocolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseCrossChains) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimInFlightRefund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1426,10 +5040,10 @@ func (m *MsgPauseCrossChains) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseCrossChains: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimInFlightRefund: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseCrossChains: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimInFlightRefund: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1466,7 +5080,7 @@ func (m *MsgPauseCrossChains) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1494,11 +5108,30 @@ func (m *MsgPauseCrossChains) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1526,7 +5159,7 @@ func (m *MsgPauseCrossChains) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyIds = append(m.CounterpartyIds, string(dAtA[iNdEx:postIndex]))
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1549,7 +5182,7 @@ func (m *MsgPauseCrossChains) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseCrossChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimInFlightRefundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1572,10 +5205,10 @@ func (m *MsgPauseCrossChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseCrossChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimInFlightRefundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseCrossChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimInFlightRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1599,7 +5232,7 @@ func (m *MsgPauseCrossChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseProtocol) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimHeldBatchEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1622,10 +5255,10 @@ func (m *MsgUnpauseProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimHeldBatchEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimHeldBatchEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1661,8 +5294,27 @@ func (m *MsgUnpauseProtocol) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1690,7 +5342,7 @@ func (m *MsgUnpauseProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1713,7 +5365,7 @@ func (m *MsgUnpauseProtocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseProtocolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimHeldBatchEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1736,10 +5388,10 @@ func (m *MsgUnpauseProtocolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseProtocolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimHeldBatchEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseProtocolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimHeldBatchEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1763,7 +5415,7 @@ func (m *MsgUnpauseProtocolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseCrossChains) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimHeldEscrowedPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1786,10 +5438,10 @@ func (m *MsgUnpauseCrossChains) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseCrossChains: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimHeldEscrowedPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseCrossChains: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimHeldEscrowedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1825,10 +5477,10 @@ func (m *MsgUnpauseCrossChains) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1838,27 +5490,14 @@ func (m *MsgUnpauseCrossChains) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProtocolId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1886,7 +5525,7 @@ func (m *MsgUnpauseCrossChains) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CounterpartyIds = append(m.CounterpartyIds, string(dAtA[iNdEx:postIndex]))
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1909,7 +5548,7 @@ func (m *MsgUnpauseCrossChains) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseCrossChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimHeldEscrowedPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1932,10 +5571,10 @@ func (m *MsgUnpauseCrossChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseCrossChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimHeldEscrowedPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseCrossChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimHeldEscrowedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1959,7 +5598,7 @@ func (m *MsgUnpauseCrossChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1982,10 +5621,10 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceDepositForBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceDepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2022,111 +5661,9 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalMessage", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalMessage = append(m.OriginalMessage[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalMessage == nil {
-				m.OriginalMessage = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttestation", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalAttestation = append(m.OriginalAttestation[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalAttestation == nil {
-				m.OriginalAttestation = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewDestinationCaller", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewDestinationCaller = append(m.NewDestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewDestinationCaller == nil {
-				m.NewDestinationCaller = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMintRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2136,24 +5673,23 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewMintRecipient = append(m.NewMintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewMintRecipient == nil {
-				m.NewMintRecipient = []byte{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2177,7 +5713,7 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceDepositForBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2200,10 +5736,10 @@ func (m *MsgReplaceDepositForBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceDepositForBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceDepositForBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
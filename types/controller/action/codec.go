@@ -29,5 +29,12 @@ import (
 // RegisterInterfaces registers the actions attributes
 // satisfying the ActionAttributes interface in the module codec.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*core.ActionAttributes)(nil), &FeeAttributes{})
+	registry.RegisterImplementations(
+		(*core.ActionAttributes)(nil),
+		&FeeAttributes{},
+		&SwapAttributes{},
+		&RouteFeeAttributes{},
+		&IntegratorFeeAttributes{},
+		&DeadlineAttributes{},
+	)
 }
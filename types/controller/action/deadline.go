@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action
+
+import (
+	"errors"
+	"time"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// NewDeadlineAction returns a validated deadline action.
+func NewDeadlineAction(attr *DeadlineAttributes) (*core.Action, error) {
+	if err := attr.Validate(); err != nil {
+		return nil, err
+	}
+
+	return core.NewAction(core.ACTION_DEADLINE, attr)
+}
+
+// NewDeadlineAttributes returns validated deadline attributes. A zero
+// height or a nil time disables the associated check.
+func NewDeadlineAttributes(height uint64, t *time.Time) (*DeadlineAttributes, error) {
+	attr := DeadlineAttributes{
+		Height: height,
+		Time:   t,
+	}
+
+	return &attr, attr.Validate()
+}
+
+// Validate returns an error if the deadline specifies
+// neither a block height nor a block time.
+func (a *DeadlineAttributes) Validate() error {
+	if a == nil {
+		return core.ErrNilPointer.Wrap("deadline attributes")
+	}
+	if a.Height == 0 && (a.Time == nil || a.Time.IsZero()) {
+		return errors.New("deadline must specify a block height or a block time")
+	}
+
+	return nil
+}
+
+// IsExceeded returns true if the given block height or time
+// is after the deadline.
+func (a *DeadlineAttributes) IsExceeded(height int64, t time.Time) bool {
+	if a == nil {
+		return false
+	}
+	if a.Height > 0 && height > 0 && uint64(height) > a.Height {
+		return true
+	}
+	if a.Time != nil && !a.Time.IsZero() && t.After(*a.Time) {
+		return true
+	}
+
+	return false
+}
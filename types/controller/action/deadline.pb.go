@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/controller/action/v2/deadline.proto
+
+package action
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DeadlineAttributes defines the concrete implementation of the
+// ActionAttributes interface to reject the transfers dispatched
+// after a block height or time. When both are set, the deadline
+// is passed once any of them is exceeded.
+type DeadlineAttributes struct {
+	// height is the last block height at which the transfer can be
+	// dispatched. A zero value disables the check.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the last block time at which the transfer can be
+	// dispatched. An unset value disables the check.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *DeadlineAttributes) Reset()         { *m = DeadlineAttributes{} }
+func (m *DeadlineAttributes) String() string { return proto.CompactTextString(m) }
+func (*DeadlineAttributes) ProtoMessage()    {}
+func (*DeadlineAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a3df4c4d7c7a2a0a, []int{0}
+}
+func (m *DeadlineAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeadlineAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeadlineAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeadlineAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadlineAttributes.Merge(m, src)
+}
+func (m *DeadlineAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeadlineAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadlineAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadlineAttributes proto.InternalMessageInfo
+
+func (m *DeadlineAttributes) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DeadlineAttributes) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DeadlineAttributes)(nil), "noble.orbiter.controller.action.v2.DeadlineAttributes")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/controller/action/v2/deadline.proto", fileDescriptor_a3df4c4d7c7a2a0a)
+}
+
+var fileDescriptor_a3df4c4d7c7a2a0a = []byte{
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x6a, 0xf3, 0x30,
+	0x10, 0xc7, 0xa3, 0x8f, 0x90, 0xc1, 0xdf, 0x66, 0x4a, 0x49, 0x33, 0x28, 0x69, 0xa0, 0x90, 0x25,
+	0x12, 0x71, 0x3b, 0x65, 0x4b, 0xe8, 0x13, 0x84, 0x4c, 0x5d, 0x8a, 0xe5, 0xa8, 0xb2, 0xc0, 0xf6,
+	0x19, 0xe9, 0x6c, 0xe8, 0x23, 0x74, 0xcb, 0xc3, 0xf4, 0x21, 0x4a, 0xa7, 0x8c, 0xdd, 0x5a, 0xec,
+	0x17, 0x29, 0x91, 0x9c, 0x86, 0xd2, 0x4d, 0xff, 0xe3, 0x7e, 0x3f, 0xdd, 0x5d, 0xb0, 0x28, 0x40,
+	0x64, 0x92, 0x83, 0x11, 0x1a, 0xa5, 0xe1, 0x09, 0x14, 0x68, 0x20, 0xcb, 0xa4, 0xe1, 0x71, 0x82,
+	0x1a, 0x0a, 0x5e, 0x47, 0x7c, 0x27, 0xe3, 0x5d, 0xa6, 0x0b, 0xc9, 0x4a, 0x03, 0x08, 0xe1, 0xd4,
+	0x21, 0xac, 0x43, 0xd8, 0x19, 0x61, 0x1e, 0x61, 0x75, 0x34, 0xba, 0x4a, 0xc0, 0xe6, 0x60, 0x1f,
+	0x1d, 0xc1, 0x7d, 0xf0, 0xf8, 0xe8, 0x42, 0x81, 0x02, 0x5f, 0x3f, 0xbe, 0xba, 0xea, 0x58, 0x01,
+	0xa8, 0x4c, 0x72, 0x97, 0x44, 0xf5, 0xc4, 0x51, 0xe7, 0xd2, 0x62, 0x9c, 0x97, 0xbe, 0x61, 0xfa,
+	0x42, 0x82, 0xf0, 0xbe, 0x1b, 0x64, 0x85, 0x68, 0xb4, 0xa8, 0x50, 0xda, 0xf0, 0x32, 0x18, 0xa4,
+	0x52, 0xab, 0x14, 0x87, 0x64, 0x42, 0x66, 0xfd, 0x4d, 0x97, 0xc2, 0xbb, 0xa0, 0x7f, 0x34, 0x0c,
+	0xff, 0x4d, 0xc8, 0xec, 0x7f, 0x34, 0x62, 0x5e, 0xcf, 0x4e, 0x7a, 0xb6, 0x3d, 0xe9, 0xd7, 0xfd,
+	0xfd, 0xe7, 0x98, 0x6c, 0x5c, 0xf7, 0xf2, 0xe6, 0xfd, 0x75, 0x7e, 0xfd, 0x7b, 0xbd, 0x7a, 0xc1,
+	0x56, 0x6e, 0xad, 0xf3, 0xa7, 0xeb, 0xed, 0x5b, 0x43, 0xc9, 0xa1, 0xa1, 0xe4, 0xab, 0xa1, 0x64,
+	0xdf, 0xd2, 0xde, 0xa1, 0xa5, 0xbd, 0x8f, 0x96, 0xf6, 0x1e, 0x96, 0x4a, 0x63, 0x5a, 0x09, 0x96,
+	0x40, 0xce, 0x9d, 0x67, 0x1e, 0x5b, 0x2b, 0xd1, 0xfe, 0x1c, 0xb8, 0x8e, 0x38, 0x3e, 0x97, 0xd2,
+	0xfe, 0xbd, 0xb4, 0x18, 0xb8, 0xe1, 0x6e, 0xbf, 0x07, 0x00, 0xb1, 0xf3, 0x79, 0x27, 0x93, 0x01,
+	0x00, 0x00,
+}
+
+func (m *DeadlineAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadlineAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeadlineAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintDeadline(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintDeadline(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeadline(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeadline(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeadlineAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDeadline(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovDeadline(uint64(l))
+	}
+	return n
+}
+
+func sovDeadline(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeadline(x uint64) (n int) {
+	return sovDeadline(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeadlineAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeadline
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadlineAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadlineAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadline
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeadline
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeadline
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeadline(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeadline
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeadline(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeadline
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeadline
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeadline
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeadline
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeadline
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeadline
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeadline        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeadline          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeadline = fmt.Errorf("proto: unexpected end of group")
+)
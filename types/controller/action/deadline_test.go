@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestValidateDeadlineAttributes(t *testing.T) {
+	deadline := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		attr   *actiontypes.DeadlineAttributes
+		expErr string
+	}{
+		{
+			name:   "error - nil attributes",
+			expErr: core.ErrNilPointer.Error(),
+		},
+		{
+			name:   "error - neither height nor time",
+			attr:   &actiontypes.DeadlineAttributes{},
+			expErr: "deadline must specify a block height or a block time",
+		},
+		{
+			name:   "error - zero time",
+			attr:   &actiontypes.DeadlineAttributes{Time: &time.Time{}},
+			expErr: "deadline must specify a block height or a block time",
+		},
+		{
+			name: "success - height only",
+			attr: &actiontypes.DeadlineAttributes{Height: 100},
+		},
+		{
+			name: "success - time only",
+			attr: &actiontypes.DeadlineAttributes{Time: &deadline},
+		},
+		{
+			name: "success - height and time",
+			attr: &actiontypes.DeadlineAttributes{Height: 100, Time: &deadline},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			err := tC.attr.Validate()
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeadlineIsExceeded(t *testing.T) {
+	deadline := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		attr        *actiontypes.DeadlineAttributes
+		height      int64
+		time        time.Time
+		expExceeded bool
+	}{
+		{
+			name:   "success - height reached",
+			attr:   &actiontypes.DeadlineAttributes{Height: 100},
+			height: 100,
+			time:   deadline.Add(time.Hour),
+		},
+		{
+			name:        "success - height exceeded",
+			attr:        &actiontypes.DeadlineAttributes{Height: 100},
+			height:      101,
+			time:        deadline,
+			expExceeded: true,
+		},
+		{
+			name:   "success - time reached",
+			attr:   &actiontypes.DeadlineAttributes{Time: &deadline},
+			height: 1_000,
+			time:   deadline,
+		},
+		{
+			name:        "success - time exceeded",
+			attr:        &actiontypes.DeadlineAttributes{Time: &deadline},
+			height:      1,
+			time:        deadline.Add(time.Second),
+			expExceeded: true,
+		},
+		{
+			name:        "success - height and time with only time exceeded",
+			attr:        &actiontypes.DeadlineAttributes{Height: 100, Time: &deadline},
+			height:      50,
+			time:        deadline.Add(time.Second),
+			expExceeded: true,
+		},
+		{
+			name:   "success - height and time not exceeded",
+			attr:   &actiontypes.DeadlineAttributes{Height: 100, Time: &deadline},
+			height: 50,
+			time:   deadline.Add(-time.Second),
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			require.Equal(t, tC.expExceeded, tC.attr.IsExceeded(tC.height, tC.time))
+		})
+	}
+}
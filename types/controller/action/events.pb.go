@@ -5,6 +5,9 @@ package action
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -24,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type EventFeeAction struct {
 	FeesInfo []*FeeInfo `protobuf:"bytes,1,rep,name=fees_info,json=feesInfo,proto3" json:"fees_info,omitempty"`
+	// fees_paid are the fees computed and paid to each recipient.
+	FeesPaid []FeePaid `protobuf:"bytes,2,rep,name=fees_paid,json=feesPaid,proto3" json:"fees_paid"`
 }
 
 func (m *EventFeeAction) Reset()         { *m = EventFeeAction{} }
@@ -66,8 +71,257 @@ func (m *EventFeeAction) GetFeesInfo() []*FeeInfo {
 	return nil
 }
 
+func (m *EventFeeAction) GetFeesPaid() []FeePaid {
+	if m != nil {
+		return m.FeesPaid
+	}
+	return nil
+}
+
+// FeePaid represents the fee paid to a recipient.
+type FeePaid struct {
+	// recipient is the Noble address which received the fee.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the fee paid.
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *FeePaid) Reset()         { *m = FeePaid{} }
+func (m *FeePaid) String() string { return proto.CompactTextString(m) }
+func (*FeePaid) ProtoMessage()    {}
+func (*FeePaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51251001b86a766d, []int{1}
+}
+func (m *FeePaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePaid.Merge(m, src)
+}
+func (m *FeePaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePaid proto.InternalMessageInfo
+
+func (m *FeePaid) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *FeePaid) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// EventSwapAction is emitted when a swap action is executed.
+type EventSwapAction struct {
+	// coin_in is the coin swapped.
+	CoinIn types.Coin `protobuf:"bytes,1,opt,name=coin_in,json=coinIn,proto3" json:"coin_in"`
+	// coin_out is the coin received from the swap.
+	CoinOut types.Coin `protobuf:"bytes,2,opt,name=coin_out,json=coinOut,proto3" json:"coin_out"`
+	// routes are the pools used for the swap.
+	Routes []*SwapRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (m *EventSwapAction) Reset()         { *m = EventSwapAction{} }
+func (m *EventSwapAction) String() string { return proto.CompactTextString(m) }
+func (*EventSwapAction) ProtoMessage()    {}
+func (*EventSwapAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51251001b86a766d, []int{2}
+}
+func (m *EventSwapAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSwapAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSwapAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSwapAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSwapAction.Merge(m, src)
+}
+func (m *EventSwapAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSwapAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSwapAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSwapAction proto.InternalMessageInfo
+
+func (m *EventSwapAction) GetCoinIn() types.Coin {
+	if m != nil {
+		return m.CoinIn
+	}
+	return types.Coin{}
+}
+
+func (m *EventSwapAction) GetCoinOut() types.Coin {
+	if m != nil {
+		return m.CoinOut
+	}
+	return types.Coin{}
+}
+
+func (m *EventSwapAction) GetRoutes() []*SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type EventRouteFeeAction struct {
+	// fee is the coin collected.
+	Fee types.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// attributes are the route fee attributes applied.
+	Attributes *RouteFeeAttributes `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (m *EventRouteFeeAction) Reset()         { *m = EventRouteFeeAction{} }
+func (m *EventRouteFeeAction) String() string { return proto.CompactTextString(m) }
+func (*EventRouteFeeAction) ProtoMessage()    {}
+func (*EventRouteFeeAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51251001b86a766d, []int{3}
+}
+func (m *EventRouteFeeAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRouteFeeAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRouteFeeAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRouteFeeAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRouteFeeAction.Merge(m, src)
+}
+func (m *EventRouteFeeAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRouteFeeAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRouteFeeAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRouteFeeAction proto.InternalMessageInfo
+
+func (m *EventRouteFeeAction) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *EventRouteFeeAction) GetAttributes() *RouteFeeAttributes {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// EventIntegratorFeeAction is emitted when the fee of an integrator
+// is paid.
+type EventIntegratorFeeAction struct {
+	// integrator_id is the identifier of the integrator.
+	IntegratorId string `protobuf:"bytes,1,opt,name=integrator_id,json=integratorId,proto3" json:"integrator_id,omitempty"`
+	// integrator_fee is the coin sent to the integrator payout address.
+	IntegratorFee types.Coin `protobuf:"bytes,2,opt,name=integrator_fee,json=integratorFee,proto3" json:"integrator_fee"`
+	// treasury_fee is the coin sent to the treasury.
+	TreasuryFee types.Coin `protobuf:"bytes,3,opt,name=treasury_fee,json=treasuryFee,proto3" json:"treasury_fee"`
+}
+
+func (m *EventIntegratorFeeAction) Reset()         { *m = EventIntegratorFeeAction{} }
+func (m *EventIntegratorFeeAction) String() string { return proto.CompactTextString(m) }
+func (*EventIntegratorFeeAction) ProtoMessage()    {}
+func (*EventIntegratorFeeAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51251001b86a766d, []int{4}
+}
+func (m *EventIntegratorFeeAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIntegratorFeeAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIntegratorFeeAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventIntegratorFeeAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIntegratorFeeAction.Merge(m, src)
+}
+func (m *EventIntegratorFeeAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIntegratorFeeAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIntegratorFeeAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIntegratorFeeAction proto.InternalMessageInfo
+
+func (m *EventIntegratorFeeAction) GetIntegratorId() string {
+	if m != nil {
+		return m.IntegratorId
+	}
+	return ""
+}
+
+func (m *EventIntegratorFeeAction) GetIntegratorFee() types.Coin {
+	if m != nil {
+		return m.IntegratorFee
+	}
+	return types.Coin{}
+}
+
+func (m *EventIntegratorFeeAction) GetTreasuryFee() types.Coin {
+	if m != nil {
+		return m.TreasuryFee
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventFeeAction)(nil), "noble.orbiter.controller.action.v2.EventFeeAction")
+	proto.RegisterType((*FeePaid)(nil), "noble.orbiter.controller.action.v2.FeePaid")
+	proto.RegisterType((*EventSwapAction)(nil), "noble.orbiter.controller.action.v2.EventSwapAction")
+	proto.RegisterType((*EventRouteFeeAction)(nil), "noble.orbiter.controller.action.v2.EventRouteFeeAction")
+	proto.RegisterType((*EventIntegratorFeeAction)(nil), "noble.orbiter.controller.action.v2.EventIntegratorFeeAction")
 }
 
 func init() {
@@ -75,21 +329,42 @@ func init() {
 }
 
 var fileDescriptor_51251001b86a766d = []byte{
-	// 217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcf, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0xcf, 0x2f, 0x4a, 0xca, 0x2c, 0x49, 0x2d, 0xd2, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca,
-	0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0x4f, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0xd2, 0x4f,
-	0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0x02, 0x6b, 0xd0,
-	0x83, 0x6a, 0xd0, 0x43, 0x68, 0xd0, 0x83, 0x68, 0xd0, 0x2b, 0x33, 0x92, 0xd2, 0x21, 0xc2, 0xd0,
-	0xb4, 0xd4, 0x54, 0x88, 0x89, 0x4a, 0x51, 0x5c, 0x7c, 0xae, 0x20, 0x1b, 0xdc, 0x52, 0x53, 0x1d,
-	0xc1, 0xd2, 0x42, 0x1e, 0x5c, 0x9c, 0x69, 0xa9, 0xa9, 0xc5, 0xf1, 0x99, 0x79, 0x69, 0xf9, 0x12,
-	0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0xda, 0x7a, 0x84, 0xed, 0xd5, 0x73, 0x4b, 0x4d, 0xf5, 0xcc,
-	0x4b, 0xcb, 0x0f, 0xe2, 0x00, 0xe9, 0x06, 0xb1, 0x9c, 0x42, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x2a, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17,
-	0x12, 0x06, 0xba, 0x89, 0xc5, 0xc5, 0xa9, 0x25, 0xc5, 0x70, 0x57, 0x97, 0x19, 0xe9, 0x97, 0x54,
-	0x16, 0xa4, 0x16, 0x63, 0x3a, 0x3f, 0x89, 0x0d, 0xec, 0x70, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x6a, 0x6e, 0x9e, 0x69, 0x3d, 0x01, 0x00, 0x00,
+	// 548 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0x33, 0x0d, 0xb4, 0xcd, 0xb4, 0x56, 0x5c, 0x3d, 0xac, 0x45, 0xd6, 0xb2, 0x5e, 0x8a,
+	0xda, 0x19, 0xba, 0x85, 0x1e, 0x44, 0x11, 0x2b, 0x8d, 0x06, 0x0f, 0x4a, 0x2a, 0x1e, 0xbc, 0x84,
+	0xd9, 0xcd, 0x4b, 0x1c, 0x48, 0x66, 0x96, 0x99, 0xd9, 0x94, 0x7e, 0x0b, 0xbf, 0x84, 0xe0, 0x4d,
+	0x3f, 0x46, 0x8f, 0xbd, 0x08, 0x3d, 0x89, 0x24, 0x07, 0xbf, 0x86, 0xcc, 0xec, 0x98, 0x0d, 0x78,
+	0x70, 0xf7, 0x12, 0x86, 0x37, 0xef, 0xf7, 0xde, 0x7f, 0xfe, 0x2f, 0x6f, 0x31, 0x15, 0x32, 0x9d,
+	0x00, 0x95, 0x2a, 0xe5, 0x06, 0x14, 0xcd, 0xa4, 0x30, 0x4a, 0x4e, 0x26, 0xa0, 0x28, 0xcb, 0x0c,
+	0x97, 0x82, 0xce, 0x12, 0x0a, 0x33, 0x10, 0x46, 0x93, 0x5c, 0x49, 0x23, 0x83, 0xd8, 0x01, 0xc4,
+	0x03, 0xa4, 0x02, 0x48, 0x09, 0x90, 0x59, 0xb2, 0x7b, 0x8b, 0x4d, 0xb9, 0x90, 0xd4, 0xfd, 0x96,
+	0xd8, 0x6e, 0x94, 0x49, 0x3d, 0x95, 0x9a, 0xa6, 0x4c, 0x03, 0x9d, 0x1d, 0xa6, 0x60, 0xd8, 0x21,
+	0xcd, 0x24, 0x17, 0xfe, 0xfe, 0xce, 0x58, 0x8e, 0xa5, 0x3b, 0x52, 0x7b, 0xf2, 0xd1, 0xc7, 0x35,
+	0xd4, 0x8d, 0x00, 0x7c, 0xf6, 0x51, 0x8d, 0x6c, 0x2e, 0x0c, 0x8c, 0x15, 0x33, 0x52, 0x79, 0x28,
+	0xa9, 0x01, 0x29, 0x59, 0x18, 0x18, 0x54, 0x8d, 0x0e, 0x6a, 0x30, 0xfa, 0x9c, 0xe5, 0x65, 0x7a,
+	0xfc, 0x0d, 0xe1, 0x9d, 0x53, 0xeb, 0x61, 0x17, 0xe0, 0x85, 0x4b, 0x08, 0x5e, 0xe3, 0xce, 0x08,
+	0x40, 0x0f, 0xb8, 0x18, 0xc9, 0x10, 0xed, 0xb5, 0xf7, 0xb7, 0x92, 0x47, 0xe4, 0xff, 0xce, 0x92,
+	0x2e, 0x40, 0x4f, 0x8c, 0x64, 0x7f, 0xd3, 0xd2, 0xf6, 0x14, 0x9c, 0xf9, 0x4a, 0x39, 0xe3, 0xc3,
+	0x70, 0xad, 0x51, 0xa5, 0x77, 0x8c, 0x0f, 0x4f, 0x3a, 0x97, 0x3f, 0xef, 0xb7, 0xbe, 0xfe, 0xfe,
+	0xfe, 0x10, 0x95, 0x45, 0x6d, 0x30, 0x06, 0xbc, 0xe1, 0xef, 0x83, 0x7b, 0xb8, 0xa3, 0x20, 0xe3,
+	0x39, 0x07, 0x61, 0x42, 0xb4, 0x87, 0xf6, 0x3b, 0xfd, 0x2a, 0x10, 0x3c, 0xc5, 0xeb, 0x6c, 0x2a,
+	0x0b, 0x61, 0xc2, 0xb5, 0x3d, 0xb4, 0xbf, 0x95, 0xdc, 0x25, 0xe5, 0x9c, 0x89, 0x9d, 0x33, 0xf1,
+	0x73, 0x26, 0x2f, 0x25, 0x17, 0xab, 0x8d, 0x3c, 0x13, 0x5f, 0x23, 0x7c, 0xd3, 0x19, 0x73, 0x76,
+	0xce, 0x72, 0xef, 0xcc, 0x33, 0xbc, 0x61, 0xff, 0x16, 0x03, 0x2e, 0x42, 0xd4, 0xa4, 0xa4, 0x85,
+	0x7a, 0x22, 0x78, 0x8e, 0x37, 0x1d, 0x2e, 0x8b, 0x66, 0x92, 0x5c, 0xd3, 0xb7, 0x85, 0x09, 0x4e,
+	0xf1, 0xba, 0x1b, 0xb7, 0x0e, 0xdb, 0xce, 0xcc, 0x83, 0x3a, 0x66, 0x5a, 0xfd, 0x7d, 0x4b, 0xf5,
+	0x3d, 0x1c, 0x7f, 0x41, 0xf8, 0xb6, 0x7b, 0x9a, 0x0b, 0x57, 0x83, 0x3f, 0xc6, 0xed, 0x11, 0x40,
+	0xa3, 0xa7, 0x59, 0x20, 0xf8, 0x80, 0x31, 0x33, 0x46, 0xf1, 0xd4, 0x49, 0x2b, 0x5f, 0x76, 0x5c,
+	0x47, 0xda, 0xb2, 0xff, 0x92, 0xee, 0xaf, 0x54, 0x8a, 0x7f, 0x20, 0x1c, 0x3a, 0x9d, 0xbd, 0xe5,
+	0x62, 0x54, 0x62, 0x1f, 0xe0, 0x1b, 0xd5, 0xbe, 0x0c, 0xf8, 0xd0, 0xcf, 0x7f, 0xbb, 0x0a, 0xf6,
+	0x86, 0xc1, 0x1b, 0xbc, 0xb3, 0x92, 0x64, 0x1f, 0xd7, 0xc4, 0xf7, 0x95, 0x06, 0x5d, 0x80, 0xe0,
+	0x15, 0xde, 0x36, 0x0a, 0x98, 0x2e, 0xd4, 0x85, 0x2b, 0xd5, 0x6e, 0x50, 0x6a, 0xeb, 0x2f, 0xd9,
+	0x05, 0x38, 0x79, 0x7f, 0x39, 0x8f, 0xd0, 0xd5, 0x3c, 0x42, 0xbf, 0xe6, 0x11, 0xfa, 0xbc, 0x88,
+	0x5a, 0x57, 0x8b, 0xa8, 0x75, 0xbd, 0x88, 0x5a, 0x1f, 0x9f, 0x8c, 0xb9, 0xf9, 0x54, 0xa4, 0x24,
+	0x93, 0xd3, 0xf2, 0xe3, 0x77, 0xc0, 0xb4, 0x06, 0xa3, 0x97, 0xeb, 0x3c, 0x4b, 0xa8, 0xb9, 0xc8,
+	0x41, 0xff, 0xbb, 0xd7, 0xe9, 0xba, 0x5b, 0xe8, 0xa3, 0x3f, 0x03, 0x00, 0xbc, 0xe6, 0xb9, 0x41,
+	0x36, 0x05, 0x00, 0x00,
 }
 
 func (m *EventFeeAction) Marshal() (dAtA []byte, err error) {
@@ -112,6 +387,20 @@ func (m *EventFeeAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeesPaid) > 0 {
+		for iNdEx := len(m.FeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.FeesInfo) > 0 {
 		for iNdEx := len(m.FeesInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -129,6 +418,198 @@ func (m *EventFeeAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeePaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSwapAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSwapAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSwapAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.CoinOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.CoinIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRouteFeeAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRouteFeeAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRouteFeeAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Attributes != nil {
+		{
+			size, err := m.Attributes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIntegratorFeeAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIntegratorFeeAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIntegratorFeeAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TreasuryFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.IntegratorFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.IntegratorId) > 0 {
+		i -= len(m.IntegratorId)
+		copy(dAtA[i:], m.IntegratorId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.IntegratorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -152,7 +633,79 @@ func (m *EventFeeAction) Size() (n int) {
 			n += 1 + l + sovEvents(uint64(l))
 		}
 	}
-	return n
+	if len(m.FeesPaid) > 0 {
+		for _, e := range m.FeesPaid {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeePaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSwapAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinIn.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CoinOut.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRouteFeeAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Attributes != nil {
+		l = m.Attributes.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventIntegratorFeeAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntegratorId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.IntegratorFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.TreasuryFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
 }
 
 func sovEvents(x uint64) (n int) {
@@ -224,6 +777,572 @@ func (m *EventFeeAction) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesPaid = append(m.FeesPaid, FeePaid{})
+			if err := m.FeesPaid[len(m.FeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeePaid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePaid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePaid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSwapAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSwapAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSwapAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRouteFeeAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRouteFeeAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRouteFeeAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attributes == nil {
+				m.Attributes = &RouteFeeAttributes{}
+			}
+			if err := m.Attributes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventIntegratorFeeAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIntegratorFeeAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIntegratorFeeAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegratorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IntegratorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
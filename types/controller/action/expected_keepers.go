@@ -23,6 +23,7 @@ package action
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -31,3 +32,35 @@ import (
 type BankKeeperFee interface {
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
+
+// SwapKeeper defines the behaviors the Swap action
+// expects from the Noble swap module.
+type SwapKeeper interface {
+	// Swap swaps the coin held by the signer through the routes and
+	// returns the coin received. It must fail if the coin received is
+	// lower than the minimum.
+	Swap(
+		ctx context.Context,
+		signer sdk.AccAddress,
+		coin sdk.Coin,
+		routes []*SwapRoute,
+		minCoinOut sdk.Coin,
+	) (sdk.Coin, error)
+}
+
+// IntegratorKeeper defines the behaviors the Integrator fee action
+// expects from the integrators registry.
+type IntegratorKeeper interface {
+	// GetIntegrator returns the registered integrator associated with
+	// the identifier and a boolean indicating if it was found.
+	GetIntegrator(ctx context.Context, id string) (Integrator, bool, error)
+	// AddIntegratorStats adds the amount transferred and the fee paid
+	// to the stats of the integrator for the denom.
+	AddIntegratorStats(
+		ctx context.Context,
+		id string,
+		denom string,
+		volume math.Int,
+		fee math.Int,
+	) error
+}
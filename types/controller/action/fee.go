@@ -24,6 +24,7 @@ import (
 	"errors"
 	fmt "fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -93,6 +94,26 @@ func NewFeeBasisPoints(value uint32) (*FeeInfo_BasisPoints_, error) {
 	}, nil
 }
 
+func NewFeeBoundedBasisPoints(
+	value uint32,
+	minAmount string,
+	maxAmount string,
+) (*FeeInfo_BoundedBasisPoints_, error) {
+	bounded := &FeeInfo_BoundedBasisPoints{
+		Value:     value,
+		MinAmount: minAmount,
+		MaxAmount: maxAmount,
+	}
+
+	if err := validateBoundedBasisPoints(bounded); err != nil {
+		return nil, err
+	}
+
+	return &FeeInfo_BoundedBasisPoints_{
+		BoundedBasisPoints: bounded,
+	}, nil
+}
+
 func (f *FeeAttributes) Validate() error {
 	if f == nil {
 		return core.ErrNilPointer.Wrap("fee attributes")
@@ -112,9 +133,28 @@ func (f *FeeAttributes) Validate() error {
 		}
 	}
 
+	if f.MaxTotalFee != "" {
+		if _, err := parseAmount(f.MaxTotalFee); err != nil {
+			return errorsmod.Wrap(err, "invalid maximum total fee")
+		}
+	}
+
 	return nil
 }
 
+// MaxTotalFeeAmount returns the maximum total fee accepted by the
+// sender. A zero value is returned if the cap is disabled.
+//
+// CONTRACT: the attributes have already been validated.
+func (f *FeeAttributes) MaxTotalFeeAmount() math.Int {
+	if f.MaxTotalFee == "" {
+		return math.ZeroInt()
+	}
+	maxTotalFee, _ := math.NewIntFromString(f.MaxTotalFee)
+
+	return maxTotalFee
+}
+
 func (f *FeeInfo) Validate() error {
 	if f == nil {
 		return core.ErrNilPointer.Wrap("fee info")
@@ -139,6 +179,13 @@ func (f *FeeInfo) Validate() error {
 		if err := validateBasisPoints(feeType.BasisPoints); err != nil {
 			return err
 		}
+	case *FeeInfo_BoundedBasisPoints_:
+		if feeType == nil {
+			return core.ErrNilPointer.Wrap("fee info bounded bps wrapper")
+		}
+		if err := validateBoundedBasisPoints(feeType.BoundedBasisPoints); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("unknown fee type %T", feeType)
 	}
@@ -163,6 +210,51 @@ func validateAmount(amt *FeeInfo_Amount) error {
 	return nil
 }
 
+func validateBoundedBasisPoints(bounded *FeeInfo_BoundedBasisPoints) error {
+	if bounded == nil {
+		return core.ErrNilPointer.Wrap("fee info bounded bps")
+	}
+
+	if err := validateBasisPoints(&FeeInfo_BasisPoints{Value: bounded.Value}); err != nil {
+		return err
+	}
+
+	minAmount, maxAmount := math.ZeroInt(), math.ZeroInt()
+	var err error
+	if bounded.MinAmount != "" {
+		if minAmount, err = parseAmount(bounded.MinAmount); err != nil {
+			return errorsmod.Wrap(err, "invalid fee minimum amount")
+		}
+	}
+	if bounded.MaxAmount != "" {
+		if maxAmount, err = parseAmount(bounded.MaxAmount); err != nil {
+			return errorsmod.Wrap(err, "invalid fee maximum amount")
+		}
+	}
+	if maxAmount.IsPositive() && minAmount.GT(maxAmount) {
+		return fmt.Errorf(
+			"fee minimum amount %s is greater than the maximum amount %s",
+			minAmount,
+			maxAmount,
+		)
+	}
+
+	return nil
+}
+
+// parseAmount returns the positive amount represented by the string.
+func parseAmount(value string) (math.Int, error) {
+	amount, ok := math.NewIntFromString(value)
+	if !ok {
+		return math.Int{}, fmt.Errorf("cannot convert %s into a number", value)
+	}
+	if !amount.IsPositive() {
+		return math.Int{}, errors.New("amount must be positive")
+	}
+
+	return amount, nil
+}
+
 func validateBasisPoints(bps *FeeInfo_BasisPoints) error {
 	if bps == nil {
 		return core.ErrNilPointer.Wrap("fee info bps")
@@ -180,6 +272,27 @@ func validateBasisPoints(bps *FeeInfo_BasisPoints) error {
 	return nil
 }
 
+// Bound returns the fee raised to the minimum amount and capped to
+// the maximum amount, when set.
+//
+// CONTRACT: the bounded basis points have already been validated.
+func (b *FeeInfo_BoundedBasisPoints) Bound(fee math.Int) math.Int {
+	if b.MinAmount != "" {
+		minAmount, _ := math.NewIntFromString(b.MinAmount)
+		if fee.LT(minAmount) {
+			fee = minAmount
+		}
+	}
+	if b.MaxAmount != "" {
+		maxAmount, _ := math.NewIntFromString(b.MaxAmount)
+		if fee.GT(maxAmount) {
+			fee = maxAmount
+		}
+	}
+
+	return fee
+}
+
 type RecipientAmount struct {
 	Recipient sdk.AccAddress
 	Amount    sdk.Coins
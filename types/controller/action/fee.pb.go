@@ -28,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type FeeAttributes struct {
 	// fees_info defines the fees to be applied.
 	FeesInfo []*FeeInfo `protobuf:"bytes,1,rep,name=fees_info,json=feesInfo,proto3" json:"fees_info,omitempty"`
+	// max_total_fee is the maximum total amount of fees the sender
+	// accepts to pay, in the denom transferred when the action is
+	// executed. An empty value disables the cap.
+	MaxTotalFee string `protobuf:"bytes,2,opt,name=max_total_fee,json=maxTotalFee,proto3" json:"max_total_fee,omitempty"`
 }
 
 func (m *FeeAttributes) Reset()         { *m = FeeAttributes{} }
@@ -70,6 +74,13 @@ func (m *FeeAttributes) GetFeesInfo() []*FeeInfo {
 	return nil
 }
 
+func (m *FeeAttributes) GetMaxTotalFee() string {
+	if m != nil {
+		return m.MaxTotalFee
+	}
+	return ""
+}
+
 // FeeInfo allows to specify a fee to apply to a forwarding and a recipient address.
 type FeeInfo struct {
 	// recipient is the Noble address which is going to receive the fee.
@@ -79,6 +90,7 @@ type FeeInfo struct {
 	// Types that are valid to be assigned to FeeType:
 	//	*FeeInfo_BasisPoints_
 	//	*FeeInfo_Amount_
+	//	*FeeInfo_BoundedBasisPoints_
 	FeeType isFeeInfo_FeeType `protobuf_oneof:"fee_type"`
 }
 
@@ -127,9 +139,13 @@ type FeeInfo_BasisPoints_ struct {
 type FeeInfo_Amount_ struct {
 	Amount *FeeInfo_Amount `protobuf:"bytes,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
 }
+type FeeInfo_BoundedBasisPoints_ struct {
+	BoundedBasisPoints *FeeInfo_BoundedBasisPoints `protobuf:"bytes,4,opt,name=bounded_basis_points,json=boundedBasisPoints,proto3,oneof" json:"bounded_basis_points,omitempty"`
+}
 
-func (*FeeInfo_BasisPoints_) isFeeInfo_FeeType() {}
-func (*FeeInfo_Amount_) isFeeInfo_FeeType()      {}
+func (*FeeInfo_BasisPoints_) isFeeInfo_FeeType()        {}
+func (*FeeInfo_Amount_) isFeeInfo_FeeType()             {}
+func (*FeeInfo_BoundedBasisPoints_) isFeeInfo_FeeType() {}
 
 func (m *FeeInfo) GetFeeType() isFeeInfo_FeeType {
 	if m != nil {
@@ -159,11 +175,19 @@ func (m *FeeInfo) GetAmount() *FeeInfo_Amount {
 	return nil
 }
 
+func (m *FeeInfo) GetBoundedBasisPoints() *FeeInfo_BoundedBasisPoints {
+	if x, ok := m.GetFeeType().(*FeeInfo_BoundedBasisPoints_); ok {
+		return x.BoundedBasisPoints
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*FeeInfo) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*FeeInfo_BasisPoints_)(nil),
 		(*FeeInfo_Amount_)(nil),
+		(*FeeInfo_BoundedBasisPoints_)(nil),
 	}
 }
 
@@ -259,11 +283,77 @@ func (m *FeeInfo_Amount) GetValue() string {
 	return ""
 }
 
+// BoundedBasisPoints allows to define a fee in terms of basis points
+// bounded by a minimum and a maximum amount.
+type FeeInfo_BoundedBasisPoints struct {
+	// Basis points value.
+	Value uint32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Minimum fee amount. An empty value disables the minimum.
+	MinAmount string `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	// Maximum fee amount. An empty value disables the maximum.
+	MaxAmount string `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+}
+
+func (m *FeeInfo_BoundedBasisPoints) Reset()         { *m = FeeInfo_BoundedBasisPoints{} }
+func (m *FeeInfo_BoundedBasisPoints) String() string { return proto.CompactTextString(m) }
+func (*FeeInfo_BoundedBasisPoints) ProtoMessage()    {}
+func (*FeeInfo_BoundedBasisPoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab730bd6e839fd86, []int{1, 2}
+}
+func (m *FeeInfo_BoundedBasisPoints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeInfo_BoundedBasisPoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeInfo_BoundedBasisPoints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeInfo_BoundedBasisPoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeInfo_BoundedBasisPoints.Merge(m, src)
+}
+func (m *FeeInfo_BoundedBasisPoints) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeInfo_BoundedBasisPoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeInfo_BoundedBasisPoints.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeInfo_BoundedBasisPoints proto.InternalMessageInfo
+
+func (m *FeeInfo_BoundedBasisPoints) GetValue() uint32 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *FeeInfo_BoundedBasisPoints) GetMinAmount() string {
+	if m != nil {
+		return m.MinAmount
+	}
+	return ""
+}
+
+func (m *FeeInfo_BoundedBasisPoints) GetMaxAmount() string {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*FeeAttributes)(nil), "noble.orbiter.controller.action.v2.FeeAttributes")
 	proto.RegisterType((*FeeInfo)(nil), "noble.orbiter.controller.action.v2.FeeInfo")
 	proto.RegisterType((*FeeInfo_BasisPoints)(nil), "noble.orbiter.controller.action.v2.FeeInfo.BasisPoints")
 	proto.RegisterType((*FeeInfo_Amount)(nil), "noble.orbiter.controller.action.v2.FeeInfo.Amount")
+	proto.RegisterType((*FeeInfo_BoundedBasisPoints)(nil), "noble.orbiter.controller.action.v2.FeeInfo.BoundedBasisPoints")
 }
 
 func init() {
@@ -271,32 +361,37 @@ func init() {
 }
 
 var fileDescriptor_ab730bd6e839fd86 = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0xeb, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xff, 0x3f, 0x56, 0x73, 0xb1, 0x4b, 0xe8, 0x10, 0x3b, 0x84, 0x5a, 0x11, 0x0a,
-	0xda, 0x3b, 0x8c, 0xa0, 0xd0, 0x2d, 0x19, 0x4a, 0x05, 0x07, 0x89, 0x4e, 0x22, 0x84, 0x24, 0x7d,
-	0x53, 0x0f, 0xd2, 0xbb, 0x70, 0x77, 0x09, 0xb8, 0xf9, 0x11, 0xfc, 0x30, 0x9d, 0x9d, 0xc5, 0xa9,
-	0x38, 0x39, 0x4a, 0xfb, 0x45, 0x24, 0x97, 0x60, 0x2c, 0x0e, 0xd2, 0x2d, 0x2f, 0xef, 0xf3, 0x7b,
-	0xde, 0x27, 0xc7, 0x83, 0x9f, 0x72, 0x91, 0x95, 0x40, 0x85, 0xcc, 0x98, 0x06, 0x49, 0x73, 0xc1,
-	0xb5, 0x14, 0x65, 0x09, 0x92, 0xa6, 0xb9, 0x66, 0x82, 0xd3, 0x26, 0xa0, 0x05, 0x00, 0xa9, 0xa4,
-	0xd0, 0xc2, 0x9d, 0x1b, 0x35, 0xe9, 0xd5, 0x64, 0x50, 0x93, 0x4e, 0x4d, 0x9a, 0x60, 0xfa, 0x20,
-	0x17, 0x6a, 0x2f, 0x54, 0x62, 0x08, 0xda, 0x0d, 0x1d, 0x3e, 0xff, 0x8c, 0xf0, 0x78, 0x0d, 0x10,
-	0x6a, 0x2d, 0x59, 0x56, 0x6b, 0x50, 0xee, 0x06, 0xdb, 0x05, 0x80, 0x4a, 0x18, 0x2f, 0x84, 0x87,
-	0x66, 0xb7, 0x0b, 0x27, 0x78, 0x42, 0xfe, 0x7f, 0x84, 0xac, 0x01, 0x5e, 0xf1, 0x42, 0xc4, 0xf7,
-	0x5a, 0xba, 0xfd, 0x5a, 0x3d, 0xfe, 0x7e, 0x58, 0x3e, 0xbc, 0x24, 0x9b, 0x67, 0x24, 0x34, 0xc4,
-	0x70, 0x70, 0xfe, 0xf5, 0x06, 0xdf, 0xed, 0x61, 0xf7, 0x05, 0xb6, 0x25, 0xe4, 0xac, 0x62, 0xc0,
-	0xb5, 0x87, 0x66, 0x68, 0x61, 0x47, 0xde, 0x8f, 0xc3, 0x72, 0xd2, 0x67, 0x0e, 0xb7, 0x5b, 0x09,
-	0x4a, 0xbd, 0xd5, 0x92, 0xf1, 0x5d, 0x3c, 0x48, 0xdd, 0x0f, 0xf8, 0x7e, 0x96, 0x2a, 0xa6, 0x92,
-	0x4a, 0x30, 0xae, 0x95, 0x77, 0x33, 0x43, 0x0b, 0x27, 0x78, 0x79, 0x45, 0x6e, 0x12, 0xb5, 0xfc,
-	0x1b, 0x83, 0x6f, 0xac, 0xd8, 0xc9, 0x86, 0xd1, 0x7d, 0x8d, 0x47, 0xe9, 0x5e, 0xd4, 0x5c, 0x7b,
-	0xb7, 0xc6, 0x37, 0xb8, 0xc6, 0x37, 0x34, 0xe4, 0xc6, 0x8a, 0x7b, 0x8f, 0xe9, 0x23, 0xec, 0xfc,
-	0x75, 0xcb, 0x9d, 0xe0, 0x3b, 0x4d, 0x5a, 0xd6, 0x60, 0x7e, 0x77, 0x1c, 0x77, 0xc3, 0xd4, 0xc7,
-	0xa3, 0x0e, 0xbc, 0xdc, 0xdb, 0xfd, 0x3e, 0xc2, 0xb8, 0x7d, 0xe7, 0x44, 0x7f, 0xaa, 0x20, 0x7a,
-	0xf7, 0xed, 0xe4, 0xa3, 0xe3, 0xc9, 0x47, 0xbf, 0x4e, 0x3e, 0xfa, 0x72, 0xf6, 0xad, 0xe3, 0xd9,
-	0xb7, 0x7e, 0x9e, 0x7d, 0xeb, 0xfd, 0x6a, 0xc7, 0xf4, 0xc7, 0x3a, 0x23, 0xb9, 0xd8, 0x53, 0x13,
-	0x79, 0x99, 0x2a, 0x05, 0x5a, 0xfd, 0x29, 0x57, 0x13, 0xd0, 0xd6, 0x42, 0xfd, 0xdb, 0xb2, 0x6c,
-	0x64, 0x0a, 0xf2, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x46, 0x50, 0xee, 0x8f, 0x02,
-	0x00, 0x00,
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x13, 0x77, 0xad, 0x66, 0x62, 0x2f, 0x43, 0x0f, 0xb1, 0x60, 0x58, 0x2b, 0xc2, 0x82,
+	0x76, 0x82, 0x11, 0x14, 0xf6, 0x20, 0xb4, 0x87, 0x52, 0xc1, 0x83, 0xc4, 0x3d, 0x89, 0x10, 0x26,
+	0xe9, 0xcb, 0xee, 0x40, 0x32, 0x53, 0x66, 0x26, 0xa1, 0x7e, 0x0b, 0x3f, 0x80, 0x47, 0x3f, 0xc2,
+	0x7e, 0x08, 0xf1, 0xb4, 0x78, 0xf2, 0x28, 0xed, 0x17, 0x91, 0x99, 0x44, 0xbb, 0xa5, 0xc2, 0xd2,
+	0xdb, 0xbc, 0x79, 0xef, 0xff, 0x7b, 0xff, 0x79, 0xcc, 0x43, 0xcf, 0xb9, 0xc8, 0x4a, 0x88, 0x84,
+	0xcc, 0x98, 0x06, 0x19, 0xe5, 0x82, 0x6b, 0x29, 0xca, 0x12, 0x64, 0x44, 0x73, 0xcd, 0x04, 0x8f,
+	0x9a, 0x38, 0x2a, 0x00, 0xc8, 0x52, 0x0a, 0x2d, 0xf0, 0xc8, 0x56, 0x93, 0xae, 0x9a, 0x6c, 0xab,
+	0x49, 0x5b, 0x4d, 0x9a, 0x78, 0xf8, 0x30, 0x17, 0xaa, 0x12, 0x2a, 0xb5, 0x8a, 0xa8, 0x0d, 0x5a,
+	0xf9, 0xe8, 0x9b, 0x8b, 0xfa, 0x33, 0x80, 0x89, 0xd6, 0x92, 0x65, 0xb5, 0x06, 0x85, 0xe7, 0xc8,
+	0x2b, 0x00, 0x54, 0xca, 0x78, 0x21, 0x02, 0xf7, 0xe4, 0xe8, 0xd4, 0x8f, 0x9f, 0x91, 0xdb, 0x9b,
+	0x90, 0x19, 0xc0, 0x5b, 0x5e, 0x88, 0xe4, 0xbe, 0x51, 0x9b, 0x13, 0x1e, 0xa1, 0x7e, 0x45, 0x57,
+	0xa9, 0x16, 0x9a, 0x96, 0x69, 0x01, 0x10, 0xdc, 0x39, 0x71, 0x4f, 0xbd, 0xc4, 0xaf, 0xe8, 0xea,
+	0xdc, 0xdc, 0xcd, 0x00, 0xce, 0x9e, 0xfe, 0xb8, 0x1a, 0x3f, 0xde, 0xa5, 0x37, 0x2f, 0xc8, 0xc4,
+	0x52, 0xb7, 0xa6, 0x46, 0x5f, 0x8f, 0xd1, 0xbd, 0xae, 0x01, 0x7e, 0x85, 0x3c, 0x09, 0x39, 0x5b,
+	0x32, 0xe0, 0x3a, 0x70, 0x0d, 0x72, 0x1a, 0xfc, 0xbc, 0x1a, 0x0f, 0xba, 0x77, 0x4d, 0x16, 0x0b,
+	0x09, 0x4a, 0x7d, 0xd0, 0x92, 0xf1, 0x8b, 0x64, 0x5b, 0x8a, 0x3f, 0xa1, 0x07, 0x19, 0x55, 0x4c,
+	0xa5, 0x4b, 0xc1, 0xb8, 0x56, 0xd6, 0x8d, 0x1f, 0xbf, 0x3e, 0xe0, 0x6d, 0x64, 0x6a, 0xf4, 0xef,
+	0xad, 0x7c, 0xee, 0x24, 0x7e, 0xb6, 0x0d, 0xf1, 0x3b, 0xd4, 0xa3, 0x95, 0xa8, 0xb9, 0x0e, 0x8e,
+	0x2c, 0x37, 0x3e, 0x84, 0x3b, 0xb1, 0xca, 0xb9, 0x93, 0x74, 0x0c, 0x2c, 0xd1, 0x20, 0x13, 0x35,
+	0x5f, 0xc0, 0x22, 0xdd, 0xf1, 0x7c, 0x6c, 0xd9, 0x6f, 0x0e, 0xf2, 0xdc, 0x72, 0x76, 0xad, 0xe3,
+	0x6c, 0xef, 0x76, 0xf8, 0x04, 0xf9, 0x37, 0x42, 0x3c, 0x40, 0x77, 0x1b, 0x5a, 0xd6, 0x60, 0x47,
+	0xdc, 0x4f, 0xda, 0x60, 0x18, 0xa2, 0x5e, 0x6b, 0x76, 0x37, 0xef, 0xfd, 0xcd, 0x5f, 0x22, 0xbc,
+	0xdf, 0xf0, 0xff, 0x2c, 0xfc, 0x08, 0xa1, 0x8a, 0xf1, 0xb4, 0x1b, 0x5b, 0xfb, 0x39, 0xbc, 0x8a,
+	0xf1, 0xae, 0x81, 0x49, 0xd3, 0x55, 0x7a, 0x63, 0xaa, 0x26, 0x4d, 0x57, 0x6d, 0x7a, 0x8a, 0x90,
+	0xf9, 0x69, 0xa9, 0xfe, 0xbc, 0x84, 0xe9, 0xf9, 0xf7, 0x75, 0xe8, 0x5e, 0xaf, 0x43, 0xf7, 0xf7,
+	0x3a, 0x74, 0xbf, 0x6c, 0x42, 0xe7, 0x7a, 0x13, 0x3a, 0xbf, 0x36, 0xa1, 0xf3, 0xf1, 0xec, 0x82,
+	0xe9, 0xcb, 0x3a, 0x23, 0xb9, 0xa8, 0x22, 0x3b, 0xb4, 0x31, 0x55, 0x0a, 0xb4, 0xfa, 0xb7, 0x5e,
+	0x4d, 0x1c, 0x19, 0x84, 0xda, 0xdf, 0xb3, 0xac, 0x67, 0x57, 0xe4, 0xe5, 0x9f, 0x01, 0x00, 0xde,
+	0xed, 0x2f, 0x03, 0x91, 0x03, 0x00, 0x00,
 }
 
 func (m *FeeAttributes) Marshal() (dAtA []byte, err error) {
@@ -319,6 +414,13 @@ func (m *FeeAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxTotalFee) > 0 {
+		i -= len(m.MaxTotalFee)
+		copy(dAtA[i:], m.MaxTotalFee)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.MaxTotalFee)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.FeesInfo) > 0 {
 		for iNdEx := len(m.FeesInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -417,6 +519,27 @@ func (m *FeeInfo_Amount_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *FeeInfo_BoundedBasisPoints_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeInfo_BoundedBasisPoints_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.BoundedBasisPoints != nil {
+		{
+			size, err := m.BoundedBasisPoints.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
 func (m *FeeInfo_BasisPoints) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -475,6 +598,48 @@ func (m *FeeInfo_Amount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeInfo_BoundedBasisPoints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeInfo_BoundedBasisPoints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeInfo_BoundedBasisPoints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		i -= len(m.MaxAmount)
+		copy(dAtA[i:], m.MaxAmount)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.MaxAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinAmount) > 0 {
+		i -= len(m.MinAmount)
+		copy(dAtA[i:], m.MinAmount)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.MinAmount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Value != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFee(v)
 	base := offset
@@ -498,6 +663,10 @@ func (m *FeeAttributes) Size() (n int) {
 			n += 1 + l + sovFee(uint64(l))
 		}
 	}
+	l = len(m.MaxTotalFee)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
 	return n
 }
 
@@ -541,6 +710,18 @@ func (m *FeeInfo_Amount_) Size() (n int) {
 	}
 	return n
 }
+func (m *FeeInfo_BoundedBasisPoints_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BoundedBasisPoints != nil {
+		l = m.BoundedBasisPoints.Size()
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
 func (m *FeeInfo_BasisPoints) Size() (n int) {
 	if m == nil {
 		return 0
@@ -566,6 +747,26 @@ func (m *FeeInfo_Amount) Size() (n int) {
 	return n
 }
 
+func (m *FeeInfo_BoundedBasisPoints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovFee(uint64(m.Value))
+	}
+	l = len(m.MinAmount)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.MaxAmount)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	return n
+}
+
 func sovFee(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -635,6 +836,38 @@ func (m *FeeAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxTotalFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -787,6 +1020,41 @@ func (m *FeeInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.FeeType = &FeeInfo_Amount_{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundedBasisPoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FeeInfo_BoundedBasisPoints{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.FeeType = &FeeInfo_BoundedBasisPoints_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -959,6 +1227,139 @@ func (m *FeeInfo_Amount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FeeInfo_BoundedBasisPoints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BoundedBasisPoints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BoundedBasisPoints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFee(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -168,6 +168,90 @@ func TestValidateFee(t *testing.T) {
 			},
 			expErr: "",
 		},
+		{
+			name: "error - bounded zero basis points",
+			feeInfo: &actiontypes.FeeInfo{
+				Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+				FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+					BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+						Value:     0,
+						MinAmount: "1",
+						MaxAmount: "10",
+					},
+				},
+			},
+			expErr: "fee basis point must be > 0 and < 10000",
+		},
+		{
+			name: "error - bounded invalid minimum amount",
+			feeInfo: &actiontypes.FeeInfo{
+				Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+				FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+					BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+						Value:     1,
+						MinAmount: "abc",
+						MaxAmount: "10",
+					},
+				},
+			},
+			expErr: "invalid fee minimum amount",
+		},
+		{
+			name: "error - bounded zero maximum amount",
+			feeInfo: &actiontypes.FeeInfo{
+				Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+				FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+					BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+						Value:     1,
+						MinAmount: "1",
+						MaxAmount: "0",
+					},
+				},
+			},
+			expErr: "invalid fee maximum amount",
+		},
+		{
+			name: "error - bounded minimum greater than maximum",
+			feeInfo: &actiontypes.FeeInfo{
+				Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+				FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+					BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+						Value:     1,
+						MinAmount: "11",
+						MaxAmount: "10",
+					},
+				},
+			},
+			expErr: "is greater than the maximum amount",
+		},
+		{
+			name: "success - bounded basis points",
+			feeInfo: &actiontypes.FeeInfo{
+				Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+				FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+					BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+						Value:     1,
+						MinAmount: "1",
+						MaxAmount: "10",
+					},
+				},
+			},
+			expErr: "",
+		},
+		{
+			name: "success - bounded basis points without bounds",
+			feeInfo: &actiontypes.FeeInfo{
+				Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+				FeeType: &actiontypes.FeeInfo_BoundedBasisPoints_{
+					BoundedBasisPoints: &actiontypes.FeeInfo_BoundedBasisPoints{
+						Value:     1,
+						MinAmount: "",
+						MaxAmount: "",
+					},
+				},
+			},
+			expErr: "",
+		},
 	}
 
 	for _, tC := range testCases {
@@ -266,6 +350,57 @@ func TestValidateFeeAttributes(t *testing.T) {
 			},
 			expErr: "",
 		},
+		{
+			name: "error - invalid maximum total fee",
+			feeInfo: &actiontypes.FeeAttributes{
+				FeesInfo: []*actiontypes.FeeInfo{
+					{
+						Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+						FeeType: &actiontypes.FeeInfo_BasisPoints_{
+							BasisPoints: &actiontypes.FeeInfo_BasisPoints{
+								Value: 1,
+							},
+						},
+					},
+				},
+				MaxTotalFee: "abc",
+			},
+			expErr: "invalid maximum total fee",
+		},
+		{
+			name: "error - zero maximum total fee",
+			feeInfo: &actiontypes.FeeAttributes{
+				FeesInfo: []*actiontypes.FeeInfo{
+					{
+						Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+						FeeType: &actiontypes.FeeInfo_BasisPoints_{
+							BasisPoints: &actiontypes.FeeInfo_BasisPoints{
+								Value: 1,
+							},
+						},
+					},
+				},
+				MaxTotalFee: "0",
+			},
+			expErr: "invalid maximum total fee",
+		},
+		{
+			name: "success - maximum total fee",
+			feeInfo: &actiontypes.FeeAttributes{
+				FeesInfo: []*actiontypes.FeeInfo{
+					{
+						Recipient: "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za",
+						FeeType: &actiontypes.FeeInfo_BasisPoints_{
+							BasisPoints: &actiontypes.FeeInfo_BasisPoints{
+								Value: 1,
+							},
+						},
+					},
+				},
+				MaxTotalFee: "1000",
+			},
+			expErr: "",
+		},
 	}
 
 	for _, tC := range testCases {
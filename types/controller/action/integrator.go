@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// MaxIntegratorIDLength is the maximum length of an integrator identifier.
+const MaxIntegratorIDLength = 64
+
+// NewIntegratorFeeAction returns a validated integrator fee action.
+func NewIntegratorFeeAction(attr *IntegratorFeeAttributes) (*core.Action, error) {
+	if err := attr.Validate(); err != nil {
+		return nil, err
+	}
+
+	return core.NewAction(core.ACTION_INTEGRATOR_FEE, attr)
+}
+
+// NewIntegratorFeeAttributes returns validated integrator fee attributes.
+func NewIntegratorFeeAttributes(
+	integratorID string,
+	basisPoints uint32,
+) (*IntegratorFeeAttributes, error) {
+	attr := IntegratorFeeAttributes{
+		IntegratorId: integratorID,
+		BasisPoints:  basisPoints,
+	}
+
+	return &attr, attr.Validate()
+}
+
+// Validate returns an error if the integrator fee attributes are not valid.
+func (a *IntegratorFeeAttributes) Validate() error {
+	if a == nil {
+		return core.ErrNilPointer.Wrap("integrator fee attributes")
+	}
+
+	if err := ValidateIntegratorID(a.IntegratorId); err != nil {
+		return err
+	}
+	if a.BasisPoints > BPSNormalizer {
+		return fmt.Errorf(
+			"integrator fee basis points must be <= %d, received %d",
+			BPSNormalizer,
+			a.BasisPoints,
+		)
+	}
+
+	return nil
+}
+
+// NewIntegrator returns a validated integrator.
+func NewIntegrator(
+	id string,
+	payoutAddress string,
+	basisPoints uint32,
+	treasuryShare uint32,
+	treasuryAddress string,
+) (Integrator, error) {
+	i := Integrator{
+		Id:              id,
+		PayoutAddress:   payoutAddress,
+		BasisPoints:     basisPoints,
+		TreasuryShare:   treasuryShare,
+		TreasuryAddress: treasuryAddress,
+	}
+
+	return i, i.Validate()
+}
+
+// Validate returns an error if any of the integrator fields is not valid.
+func (i *Integrator) Validate() error {
+	if i == nil {
+		return core.ErrNilPointer.Wrap("integrator")
+	}
+
+	if err := ValidateIntegratorID(i.Id); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(i.PayoutAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid payout address")
+	}
+	if i.BasisPoints > BPSNormalizer {
+		return fmt.Errorf(
+			"integrator basis points must be <= %d, received %d",
+			BPSNormalizer,
+			i.BasisPoints,
+		)
+	}
+	if i.TreasuryShare > BPSNormalizer {
+		return fmt.Errorf(
+			"integrator treasury share must be <= %d, received %d",
+			BPSNormalizer,
+			i.TreasuryShare,
+		)
+	}
+	if i.TreasuryShare > 0 {
+		if _, err := sdk.AccAddressFromBech32(i.TreasuryAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid treasury address")
+		}
+	} else if i.TreasuryAddress != "" {
+		return errors.New("treasury address cannot be set without a treasury share")
+	}
+
+	return nil
+}
+
+// SplitFee returns the integrator and treasury shares of the fee. The
+// function returns an error in case of overflow.
+//
+// CONTRACT: the integrator has already been validated.
+func (i *Integrator) SplitFee(fee math.Int) (math.Int, math.Int, error) {
+	treasuryFee, err := fee.SafeMul(math.NewIntFromUint64(uint64(i.TreasuryShare)))
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), err
+	}
+	treasuryFee = treasuryFee.QuoRaw(BPSNormalizer)
+
+	return fee.Sub(treasuryFee), treasuryFee, nil
+}
+
+// ValidateIntegratorID returns an error if the integrator identifier
+// is not valid.
+func ValidateIntegratorID(id string) error {
+	if id == "" {
+		return errors.New("integrator ID cannot be empty")
+	}
+	if len(id) > MaxIntegratorIDLength {
+		return fmt.Errorf(
+			"integrator ID length must be <= %d, received %d",
+			MaxIntegratorIDLength,
+			len(id),
+		)
+	}
+
+	return nil
+}
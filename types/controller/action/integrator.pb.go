@@ -0,0 +1,723 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/controller/action/v2/integrator.proto
+
+package action
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Integrator represents a frontend registered by the authority to
+// collect a fee on the transfers referencing it.
+type Integrator struct {
+	// id is the unique identifier of the integrator.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// payout_address is the Noble address receiving the integrator
+	// share of the fee.
+	PayoutAddress string `protobuf:"bytes,2,opt,name=payout_address,json=payoutAddress,proto3" json:"payout_address,omitempty"`
+	// basis_points is the default fee applied to the transferred amount.
+	// One basis point equals 1/100th of a part.
+	BasisPoints uint32 `protobuf:"varint,3,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	// treasury_share is the share of the fee, in basis points, sent to
+	// the treasury. A zero value disables the revenue share.
+	TreasuryShare uint32 `protobuf:"varint,4,opt,name=treasury_share,json=treasuryShare,proto3" json:"treasury_share,omitempty"`
+	// treasury_address is the Noble protocol treasury address receiving
+	// the treasury share of the fee. Required only if the share is set.
+	TreasuryAddress string `protobuf:"bytes,5,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+}
+
+func (m *Integrator) Reset()         { *m = Integrator{} }
+func (m *Integrator) String() string { return proto.CompactTextString(m) }
+func (*Integrator) ProtoMessage()    {}
+func (*Integrator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3523bf117794f9d3, []int{0}
+}
+func (m *Integrator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Integrator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Integrator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Integrator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Integrator.Merge(m, src)
+}
+func (m *Integrator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Integrator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Integrator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Integrator proto.InternalMessageInfo
+
+func (m *Integrator) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Integrator) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+func (m *Integrator) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *Integrator) GetTreasuryShare() uint32 {
+	if m != nil {
+		return m.TreasuryShare
+	}
+	return 0
+}
+
+func (m *Integrator) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
+// IntegratorFeeAttributes defines the concrete implementation of the
+// ActionAttributes interface to pay the fee of a registered integrator.
+type IntegratorFeeAttributes struct {
+	// integrator_id is the identifier of the registered integrator.
+	IntegratorId string `protobuf:"bytes,1,opt,name=integrator_id,json=integratorId,proto3" json:"integrator_id,omitempty"`
+	// basis_points overrides the default fee of the integrator. A zero
+	// value applies the default fee.
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *IntegratorFeeAttributes) Reset()         { *m = IntegratorFeeAttributes{} }
+func (m *IntegratorFeeAttributes) String() string { return proto.CompactTextString(m) }
+func (*IntegratorFeeAttributes) ProtoMessage()    {}
+func (*IntegratorFeeAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3523bf117794f9d3, []int{1}
+}
+func (m *IntegratorFeeAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntegratorFeeAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntegratorFeeAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntegratorFeeAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegratorFeeAttributes.Merge(m, src)
+}
+func (m *IntegratorFeeAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntegratorFeeAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegratorFeeAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegratorFeeAttributes proto.InternalMessageInfo
+
+func (m *IntegratorFeeAttributes) GetIntegratorId() string {
+	if m != nil {
+		return m.IntegratorId
+	}
+	return ""
+}
+
+func (m *IntegratorFeeAttributes) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Integrator)(nil), "noble.orbiter.controller.action.v2.Integrator")
+	proto.RegisterType((*IntegratorFeeAttributes)(nil), "noble.orbiter.controller.action.v2.IntegratorFeeAttributes")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/controller/action/v2/integrator.proto", fileDescriptor_3523bf117794f9d3)
+}
+
+var fileDescriptor_3523bf117794f9d3 = []byte{
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x3f, 0x4b, 0xf3, 0x40,
+	0x18, 0xc0, 0x7b, 0x79, 0x5f, 0x05, 0xcf, 0xa6, 0x4a, 0x10, 0x8c, 0x0e, 0xa1, 0xad, 0x14, 0xba,
+	0x34, 0x87, 0xed, 0xd6, 0x45, 0x5a, 0x41, 0xe8, 0x26, 0xad, 0x93, 0x4b, 0xb8, 0x24, 0x47, 0x7b,
+	0xd0, 0xe6, 0xc2, 0xdd, 0x93, 0x40, 0xbf, 0x81, 0xa3, 0x1f, 0xa6, 0x1f, 0x42, 0x9c, 0x8a, 0x93,
+	0xa3, 0xb4, 0xab, 0x1f, 0x42, 0x7a, 0x49, 0x13, 0xa4, 0x83, 0x63, 0x7e, 0xf7, 0xfc, 0x9e, 0x3c,
+	0xff, 0x70, 0x2f, 0x12, 0xfe, 0x9c, 0x11, 0x21, 0x7d, 0x0e, 0x4c, 0x92, 0x40, 0x44, 0x20, 0xc5,
+	0x7c, 0xce, 0x24, 0xa1, 0x01, 0x70, 0x11, 0x91, 0xb4, 0x4b, 0x78, 0x04, 0x6c, 0x2a, 0x29, 0x08,
+	0xe9, 0xc6, 0x52, 0x80, 0xb0, 0x9a, 0x5a, 0x72, 0x73, 0xc9, 0x2d, 0x25, 0x37, 0x93, 0xdc, 0xb4,
+	0x7b, 0x7d, 0x15, 0x08, 0xb5, 0x10, 0xca, 0xd3, 0x06, 0xc9, 0x3e, 0x32, 0xbd, 0xf9, 0x8d, 0x30,
+	0x1e, 0x15, 0x39, 0xad, 0x1a, 0x36, 0x78, 0x68, 0xa3, 0x3a, 0x6a, 0x9f, 0x8c, 0x0d, 0x1e, 0x5a,
+	0x77, 0xb8, 0x16, 0xd3, 0xa5, 0x48, 0xc0, 0xa3, 0x61, 0x28, 0x99, 0x52, 0xb6, 0xb1, 0x7b, 0x1b,
+	0xda, 0x1f, 0xab, 0xce, 0x45, 0x9e, 0x68, 0x90, 0xbd, 0x4c, 0x40, 0xf2, 0x68, 0x3a, 0x36, 0xb3,
+	0xf8, 0x1c, 0x5a, 0x0d, 0x5c, 0xf5, 0xa9, 0xe2, 0xca, 0x8b, 0x05, 0x8f, 0x40, 0xd9, 0xff, 0xea,
+	0xa8, 0x6d, 0x8e, 0x4f, 0x35, 0x7b, 0xd4, 0xc8, 0x6a, 0xe1, 0x1a, 0x48, 0x46, 0x55, 0x22, 0x97,
+	0x9e, 0x9a, 0x51, 0xc9, 0xec, 0xff, 0x3a, 0xc8, 0xdc, 0xd3, 0xc9, 0x0e, 0x5a, 0xf7, 0xf8, 0xbc,
+	0x08, 0xdb, 0x17, 0x73, 0xf4, 0x47, 0x31, 0x67, 0x7b, 0x23, 0xc7, 0xcd, 0x17, 0x84, 0x2f, 0xcb,
+	0x76, 0x1f, 0x18, 0x1b, 0x00, 0x48, 0xee, 0x27, 0xc0, 0x94, 0x75, 0x83, 0xcd, 0x72, 0xba, 0x5e,
+	0x31, 0x86, 0x6a, 0x09, 0x47, 0xe1, 0x41, 0x3f, 0xc6, 0x41, 0x3f, 0xfd, 0xd6, 0xfb, 0xaa, 0xd3,
+	0xf8, 0xbd, 0x95, 0xf4, 0xd6, 0x1d, 0xe8, 0x6d, 0x94, 0xbf, 0x1b, 0x3e, 0xbd, 0x6d, 0x1c, 0xb4,
+	0xde, 0x38, 0xe8, 0x6b, 0xe3, 0xa0, 0xd7, 0xad, 0x53, 0x59, 0x6f, 0x9d, 0xca, 0xe7, 0xd6, 0xa9,
+	0x3c, 0xf7, 0xa7, 0x1c, 0x66, 0x89, 0xef, 0x06, 0x62, 0x41, 0x74, 0x9e, 0x0e, 0x55, 0x8a, 0x81,
+	0x2a, 0x2e, 0x23, 0xed, 0x12, 0x58, 0xc6, 0x4c, 0x1d, 0x9e, 0x88, 0x7f, 0xac, 0xd7, 0xda, 0xfb,
+	0x19, 0x00, 0xb3, 0xf0, 0x2f, 0xf1, 0x4c, 0x02, 0x00, 0x00,
+}
+
+func (m *Integrator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Integrator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Integrator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintIntegrator(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TreasuryShare != 0 {
+		i = encodeVarintIntegrator(dAtA, i, uint64(m.TreasuryShare))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BasisPoints != 0 {
+		i = encodeVarintIntegrator(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PayoutAddress) > 0 {
+		i -= len(m.PayoutAddress)
+		copy(dAtA[i:], m.PayoutAddress)
+		i = encodeVarintIntegrator(dAtA, i, uint64(len(m.PayoutAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintIntegrator(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntegratorFeeAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntegratorFeeAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntegratorFeeAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintIntegrator(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IntegratorId) > 0 {
+		i -= len(m.IntegratorId)
+		copy(dAtA[i:], m.IntegratorId)
+		i = encodeVarintIntegrator(dAtA, i, uint64(len(m.IntegratorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIntegrator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIntegrator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Integrator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovIntegrator(uint64(l))
+	}
+	l = len(m.PayoutAddress)
+	if l > 0 {
+		n += 1 + l + sovIntegrator(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovIntegrator(uint64(m.BasisPoints))
+	}
+	if m.TreasuryShare != 0 {
+		n += 1 + sovIntegrator(uint64(m.TreasuryShare))
+	}
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovIntegrator(uint64(l))
+	}
+	return n
+}
+
+func (m *IntegratorFeeAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntegratorId)
+	if l > 0 {
+		n += 1 + l + sovIntegrator(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovIntegrator(uint64(m.BasisPoints))
+	}
+	return n
+}
+
+func sovIntegrator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIntegrator(x uint64) (n int) {
+	return sovIntegrator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Integrator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntegrator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Integrator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Integrator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryShare", wireType)
+			}
+			m.TreasuryShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntegrator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntegratorFeeAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntegrator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntegratorFeeAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntegratorFeeAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegratorId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegratorId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntegrator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntegrator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIntegrator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIntegrator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntegrator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIntegrator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIntegrator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIntegrator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIntegrator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIntegrator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIntegrator = fmt.Errorf("proto: unexpected end of group")
+)
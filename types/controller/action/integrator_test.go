@@ -0,0 +1,232 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+const (
+	integratorPayout   = "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za"
+	integratorTreasury = "noble1d9ugvfp4j9hr4tz7h4uxdj4x9ynxpcwyc2tulh"
+)
+
+func TestValidateIntegrator(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	testCases := []struct {
+		name       string
+		integrator *actiontypes.Integrator
+		expErr     string
+	}{
+		{
+			name:   "error - nil integrator",
+			expErr: core.ErrNilPointer.Error(),
+		},
+		{
+			name: "success - without treasury share",
+			integrator: &actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: integratorPayout,
+				BasisPoints:   10,
+			},
+		},
+		{
+			name: "success - with treasury share",
+			integrator: &actiontypes.Integrator{
+				Id:              "frontend",
+				PayoutAddress:   integratorPayout,
+				BasisPoints:     10,
+				TreasuryShare:   1_000,
+				TreasuryAddress: integratorTreasury,
+			},
+		},
+		{
+			name: "error - empty ID",
+			integrator: &actiontypes.Integrator{
+				PayoutAddress: integratorPayout,
+			},
+			expErr: "integrator ID cannot be empty",
+		},
+		{
+			name: "error - ID too long",
+			integrator: &actiontypes.Integrator{
+				Id:            strings.Repeat("a", actiontypes.MaxIntegratorIDLength+1),
+				PayoutAddress: integratorPayout,
+			},
+			expErr: "integrator ID length must be <= 64",
+		},
+		{
+			name: "error - invalid payout address",
+			integrator: &actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: "cosmos1invalid",
+			},
+			expErr: "invalid payout address",
+		},
+		{
+			name: "error - basis points above the normalizer",
+			integrator: &actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: integratorPayout,
+				BasisPoints:   actiontypes.BPSNormalizer + 1,
+			},
+			expErr: "integrator basis points must be <= 10000",
+		},
+		{
+			name: "error - treasury share above the normalizer",
+			integrator: &actiontypes.Integrator{
+				Id:              "frontend",
+				PayoutAddress:   integratorPayout,
+				TreasuryShare:   actiontypes.BPSNormalizer + 1,
+				TreasuryAddress: integratorTreasury,
+			},
+			expErr: "integrator treasury share must be <= 10000",
+		},
+		{
+			name: "error - treasury share without address",
+			integrator: &actiontypes.Integrator{
+				Id:            "frontend",
+				PayoutAddress: integratorPayout,
+				TreasuryShare: 1_000,
+			},
+			expErr: "invalid treasury address",
+		},
+		{
+			name: "error - treasury address without share",
+			integrator: &actiontypes.Integrator{
+				Id:              "frontend",
+				PayoutAddress:   integratorPayout,
+				TreasuryAddress: integratorTreasury,
+			},
+			expErr: "treasury address cannot be set without a treasury share",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			err := tC.integrator.Validate()
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateIntegratorFeeAttributes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		attr   *actiontypes.IntegratorFeeAttributes
+		expErr string
+	}{
+		{
+			name:   "error - nil attributes",
+			expErr: core.ErrNilPointer.Error(),
+		},
+		{
+			name: "success - default fee",
+			attr: &actiontypes.IntegratorFeeAttributes{IntegratorId: "frontend"},
+		},
+		{
+			name: "success - overridden fee",
+			attr: &actiontypes.IntegratorFeeAttributes{IntegratorId: "frontend", BasisPoints: 5},
+		},
+		{
+			name:   "error - empty integrator ID",
+			attr:   &actiontypes.IntegratorFeeAttributes{BasisPoints: 5},
+			expErr: "integrator ID cannot be empty",
+		},
+		{
+			name: "error - basis points above the normalizer",
+			attr: &actiontypes.IntegratorFeeAttributes{
+				IntegratorId: "frontend",
+				BasisPoints:  actiontypes.BPSNormalizer + 1,
+			},
+			expErr: "integrator fee basis points must be <= 10000",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			err := tC.attr.Validate()
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIntegratorSplitFee(t *testing.T) {
+	testCases := []struct {
+		name          string
+		treasuryShare uint32
+		fee           math.Int
+		expIntegrator math.Int
+		expTreasury   math.Int
+	}{
+		{
+			name:          "success - without treasury share",
+			fee:           math.NewInt(1_000),
+			expIntegrator: math.NewInt(1_000),
+			expTreasury:   math.ZeroInt(),
+		},
+		{
+			name:          "success - with treasury share",
+			treasuryShare: 2_500,
+			fee:           math.NewInt(1_000),
+			expIntegrator: math.NewInt(750),
+			expTreasury:   math.NewInt(250),
+		},
+		{
+			name:          "success - treasury share rounded down",
+			treasuryShare: 3_333,
+			fee:           math.NewInt(10),
+			expIntegrator: math.NewInt(7),
+			expTreasury:   math.NewInt(3),
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			integrator := actiontypes.Integrator{TreasuryShare: tC.treasuryShare}
+
+			integratorFee, treasuryFee, err := integrator.SplitFee(tC.fee)
+			require.NoError(t, err)
+			require.Equal(t, tC.expIntegrator, integratorFee)
+			require.Equal(t, tC.expTreasury, treasuryFee)
+		})
+	}
+}
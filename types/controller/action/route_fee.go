@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// NewRouteFeeAction returns a validated route fee action.
+func NewRouteFeeAction(attr *RouteFeeAttributes) (*core.Action, error) {
+	if err := attr.Validate(); err != nil {
+		return nil, err
+	}
+
+	return core.NewAction(core.ACTION_ROUTE_FEE, attr)
+}
+
+// NewRouteFeeAttributes returns validated route fee attributes.
+func NewRouteFeeAttributes(
+	basisPoints uint32,
+	minAmount math.Int,
+	maxAmount math.Int,
+	recipient string,
+) (*RouteFeeAttributes, error) {
+	attr := RouteFeeAttributes{
+		BasisPoints: basisPoints,
+		MinAmount:   minAmount,
+		MaxAmount:   maxAmount,
+		Recipient:   recipient,
+	}
+
+	return &attr, attr.Validate()
+}
+
+// Validate returns an error if the route fee attributes are not valid.
+func (a *RouteFeeAttributes) Validate() error {
+	if a == nil {
+		return core.ErrNilPointer.Wrap("route fee attributes")
+	}
+
+	if a.BasisPoints > BPSNormalizer {
+		return fmt.Errorf(
+			"route fee basis points must be <= %d, received %d",
+			BPSNormalizer,
+			a.BasisPoints,
+		)
+	}
+	if a.MinAmount.IsNil() || a.MinAmount.IsNegative() {
+		return errors.New("route fee minimum amount must be non-negative")
+	}
+	if a.MaxAmount.IsNil() || a.MaxAmount.IsNegative() {
+		return errors.New("route fee maximum amount must be non-negative")
+	}
+	if a.MaxAmount.IsPositive() && a.MinAmount.GT(a.MaxAmount) {
+		return fmt.Errorf(
+			"route fee minimum amount %s is greater than the maximum amount %s",
+			a.MinAmount,
+			a.MaxAmount,
+		)
+	}
+	if a.BasisPoints == 0 && a.MinAmount.IsZero() {
+		return errors.New("route fee basis points or minimum amount must be set")
+	}
+
+	_, err := sdk.AccAddressFromBech32(a.Recipient)
+
+	return err
+}
+
+// ComputeFee returns the fee associated with the transferred amount. The
+// basis points fee is raised to the minimum amount and capped to the
+// maximum amount, when set.
+//
+// CONTRACT: the attributes have already been validated.
+func (a *RouteFeeAttributes) ComputeFee(amount math.Int) (math.Int, error) {
+	fee, err := amount.SafeMul(math.NewIntFromUint64(uint64(a.BasisPoints)))
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	fee = fee.QuoRaw(BPSNormalizer)
+
+	if fee.LT(a.MinAmount) {
+		fee = a.MinAmount
+	}
+	if a.MaxAmount.IsPositive() && fee.GT(a.MaxAmount) {
+		fee = a.MaxAmount
+	}
+
+	return fee, nil
+}
@@ -0,0 +1,474 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/controller/action/v2/route_fee.proto
+
+package action
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RouteFeeAttributes defines the concrete implementation of the
+// ActionAttributes interface to collect the fee defined by the
+// authority for a route. The attributes are never set by users
+// but built from the route fee schedule.
+type RouteFeeAttributes struct {
+	// basis_points is the fee applied to the transferred amount.
+	// One basis point equals 1/100th of a part.
+	BasisPoints uint32 `protobuf:"varint,1,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+	// min_amount is the minimum fee collected. A zero value
+	// disables the minimum.
+	MinAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	// max_amount is the maximum fee collected. A zero value
+	// disables the maximum.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+	// recipient is the Noble address receiving the fee.
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *RouteFeeAttributes) Reset()         { *m = RouteFeeAttributes{} }
+func (m *RouteFeeAttributes) String() string { return proto.CompactTextString(m) }
+func (*RouteFeeAttributes) ProtoMessage()    {}
+func (*RouteFeeAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed730ab6f829a166, []int{0}
+}
+func (m *RouteFeeAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RouteFeeAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RouteFeeAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RouteFeeAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteFeeAttributes.Merge(m, src)
+}
+func (m *RouteFeeAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *RouteFeeAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteFeeAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteFeeAttributes proto.InternalMessageInfo
+
+func (m *RouteFeeAttributes) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+func (m *RouteFeeAttributes) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*RouteFeeAttributes)(nil), "noble.orbiter.controller.action.v2.RouteFeeAttributes")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/controller/action/v2/route_fee.proto", fileDescriptor_ed730ab6f829a166)
+}
+
+var fileDescriptor_ed730ab6f829a166 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x93, 0x2a, 0x42, 0xa3, 0x2e, 0x0c, 0x15, 0x62, 0x17, 0xe9, 0x1f, 0x10, 0x8a, 0x90,
+	0x19, 0x8d, 0xe0, 0xa2, 0xbb, 0x74, 0x21, 0x74, 0xa5, 0x44, 0x57, 0x6e, 0xc2, 0x24, 0x1d, 0xd3,
+	0xc1, 0x66, 0x4e, 0x98, 0x39, 0x09, 0xf5, 0x2d, 0x7c, 0x0c, 0x97, 0x2e, 0xba, 0xf0, 0x11, 0x8a,
+	0xab, 0xd2, 0x95, 0xb8, 0x28, 0xd2, 0x2e, 0x7c, 0x0d, 0xe9, 0x24, 0xf7, 0x96, 0xcb, 0xdd, 0xdd,
+	0xcd, 0x30, 0xe7, 0x9b, 0xf9, 0x7e, 0x67, 0xf8, 0xe6, 0x38, 0xa1, 0x84, 0x74, 0xc5, 0x29, 0xa8,
+	0x54, 0x20, 0x57, 0x34, 0x03, 0x89, 0x0a, 0x56, 0x2b, 0xae, 0x28, 0xcb, 0x50, 0x80, 0xa4, 0x75,
+	0x48, 0x15, 0x54, 0xc8, 0x93, 0xcf, 0x9c, 0x93, 0x52, 0x01, 0x82, 0x3b, 0x36, 0x1e, 0xd2, 0x7a,
+	0xc8, 0xc5, 0x43, 0x1a, 0x0f, 0xa9, 0xc3, 0xfe, 0x13, 0x56, 0x08, 0x09, 0xd4, 0xac, 0x8d, 0xad,
+	0xff, 0x2c, 0x03, 0x5d, 0x80, 0x4e, 0x4c, 0x45, 0x9b, 0xa2, 0x3d, 0xea, 0xe5, 0x90, 0x43, 0xa3,
+	0x9f, 0x77, 0x8d, 0x3a, 0xfe, 0xd9, 0x71, 0xdc, 0xf8, 0xdc, 0xfb, 0x2d, 0xe7, 0x11, 0xa2, 0x12,
+	0x69, 0x85, 0x5c, 0xbb, 0x23, 0xe7, 0x51, 0xca, 0xb4, 0xd0, 0x49, 0x09, 0x42, 0xa2, 0xf6, 0xec,
+	0xa1, 0x3d, 0x79, 0x1c, 0x3f, 0x34, 0xda, 0x7b, 0x23, 0xb9, 0xef, 0x1c, 0xa7, 0x10, 0x32, 0x61,
+	0x05, 0x54, 0x12, 0xbd, 0xce, 0xd0, 0x9e, 0x74, 0x67, 0x2f, 0xb7, 0x87, 0x81, 0xf5, 0xe7, 0x30,
+	0x78, 0xda, 0x74, 0xd6, 0x8b, 0x2f, 0x44, 0x00, 0x2d, 0x18, 0x2e, 0xc9, 0x5c, 0xe2, 0x7e, 0x13,
+	0x38, 0xed, 0x93, 0xe6, 0x12, 0xbf, 0xff, 0xfb, 0xf1, 0xc2, 0x8e, 0xbb, 0x85, 0x90, 0x91, 0x41,
+	0x18, 0x20, 0x5b, 0x5f, 0x01, 0xef, 0xdd, 0x19, 0xc8, 0xd6, 0x2d, 0xf0, 0x8d, 0xd3, 0x55, 0x3c,
+	0x13, 0xa5, 0xe0, 0x12, 0xbd, 0xfb, 0x86, 0xe7, 0xed, 0x37, 0x41, 0xaf, 0xb5, 0x44, 0x8b, 0x85,
+	0xe2, 0x5a, 0x7f, 0x40, 0x25, 0x64, 0x1e, 0x5f, 0xae, 0x4e, 0x9f, 0xff, 0xda, 0x04, 0xa3, 0x9b,
+	0xf9, 0xd7, 0xaf, 0x48, 0x64, 0x72, 0xbf, 0x64, 0x34, 0xfb, 0xb8, 0x3d, 0xfa, 0xf6, 0xee, 0xe8,
+	0xdb, 0x7f, 0x8f, 0xbe, 0xfd, 0xed, 0xe4, 0x5b, 0xbb, 0x93, 0x6f, 0xfd, 0x3e, 0xf9, 0xd6, 0xa7,
+	0x69, 0x2e, 0x70, 0x59, 0xa5, 0x24, 0x83, 0x82, 0x1a, 0x4e, 0xc0, 0xb4, 0xe6, 0xa8, 0xaf, 0x47,
+	0xa0, 0x0e, 0x29, 0x7e, 0x2d, 0xb9, 0xbe, 0x3d, 0x0b, 0xe9, 0x03, 0xf3, 0x2f, 0xaf, 0xff, 0x0f,
+	0x00, 0xc5, 0x19, 0xc5, 0x14, 0x35, 0x02, 0x00, 0x00,
+}
+
+func (m *RouteFeeAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RouteFeeAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RouteFeeAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintRouteFee(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRouteFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRouteFee(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BasisPoints != 0 {
+		i = encodeVarintRouteFee(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRouteFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRouteFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RouteFeeAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		n += 1 + sovRouteFee(uint64(m.BasisPoints))
+	}
+	l = m.MinAmount.Size()
+	n += 1 + l + sovRouteFee(uint64(l))
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovRouteFee(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovRouteFee(uint64(l))
+	}
+	return n
+}
+
+func sovRouteFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRouteFee(x uint64) (n int) {
+	return sovRouteFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RouteFeeAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRouteFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RouteFeeAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RouteFeeAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouteFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouteFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouteFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouteFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouteFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouteFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouteFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRouteFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRouteFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRouteFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRouteFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRouteFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRouteFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRouteFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRouteFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRouteFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRouteFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRouteFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRouteFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRouteFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRouteFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRouteFee = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+const routeFeeRecipient = "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za"
+
+func TestValidateRouteFeeAttributes(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	testCases := []struct {
+		name   string
+		attr   *actiontypes.RouteFeeAttributes
+		expErr string
+	}{
+		{
+			name:   "error - nil attributes",
+			expErr: core.ErrNilPointer.Error(),
+		},
+		{
+			name: "success - basis points only",
+			attr: &actiontypes.RouteFeeAttributes{
+				BasisPoints: 10,
+				MinAmount:   math.ZeroInt(),
+				MaxAmount:   math.ZeroInt(),
+				Recipient:   routeFeeRecipient,
+			},
+		},
+		{
+			name: "success - minimum amount only",
+			attr: &actiontypes.RouteFeeAttributes{
+				MinAmount: math.NewInt(1),
+				MaxAmount: math.ZeroInt(),
+				Recipient: routeFeeRecipient,
+			},
+		},
+		{
+			name: "success - basis points with minimum and maximum",
+			attr: &actiontypes.RouteFeeAttributes{
+				BasisPoints: 10,
+				MinAmount:   math.NewInt(1),
+				MaxAmount:   math.NewInt(100),
+				Recipient:   routeFeeRecipient,
+			},
+		},
+		{
+			name: "error - basis points above the normalizer",
+			attr: &actiontypes.RouteFeeAttributes{
+				BasisPoints: actiontypes.BPSNormalizer + 1,
+				MinAmount:   math.ZeroInt(),
+				MaxAmount:   math.ZeroInt(),
+				Recipient:   routeFeeRecipient,
+			},
+			expErr: "route fee basis points must be <= 10000",
+		},
+		{
+			name: "error - nil minimum amount",
+			attr: &actiontypes.RouteFeeAttributes{
+				BasisPoints: 10,
+				MaxAmount:   math.ZeroInt(),
+				Recipient:   routeFeeRecipient,
+			},
+			expErr: "route fee minimum amount must be non-negative",
+		},
+		{
+			name: "error - negative maximum amount",
+			attr: &actiontypes.RouteFeeAttributes{
+				BasisPoints: 10,
+				MinAmount:   math.ZeroInt(),
+				MaxAmount:   math.NewInt(-1),
+				Recipient:   routeFeeRecipient,
+			},
+			expErr: "route fee maximum amount must be non-negative",
+		},
+		{
+			name: "error - minimum greater than maximum",
+			attr: &actiontypes.RouteFeeAttributes{
+				BasisPoints: 10,
+				MinAmount:   math.NewInt(10),
+				MaxAmount:   math.NewInt(5),
+				Recipient:   routeFeeRecipient,
+			},
+			expErr: "route fee minimum amount 10 is greater than the maximum amount 5",
+		},
+		{
+			name: "error - no fee",
+			attr: &actiontypes.RouteFeeAttributes{
+				MinAmount: math.ZeroInt(),
+				MaxAmount: math.NewInt(5),
+				Recipient: routeFeeRecipient,
+			},
+			expErr: "route fee basis points or minimum amount must be set",
+		},
+		{
+			name: "error - invalid recipient",
+			attr: &actiontypes.RouteFeeAttributes{
+				BasisPoints: 10,
+				MinAmount:   math.ZeroInt(),
+				MaxAmount:   math.ZeroInt(),
+				Recipient:   "cosmos1invalid",
+			},
+			expErr: "decoding bech32 failed",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			err := tC.attr.Validate()
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRouteFeeComputeFee(t *testing.T) {
+	testCases := []struct {
+		name        string
+		basisPoints uint32
+		minAmount   math.Int
+		maxAmount   math.Int
+		amount      math.Int
+		expFee      math.Int
+	}{
+		{
+			name:        "success - basis points",
+			basisPoints: 100,
+			minAmount:   math.ZeroInt(),
+			maxAmount:   math.ZeroInt(),
+			amount:      math.NewInt(1_000),
+			expFee:      math.NewInt(10),
+		},
+		{
+			name:        "success - basis points raised to the minimum",
+			basisPoints: 100,
+			minAmount:   math.NewInt(50),
+			maxAmount:   math.ZeroInt(),
+			amount:      math.NewInt(1_000),
+			expFee:      math.NewInt(50),
+		},
+		{
+			name:        "success - basis points capped to the maximum",
+			basisPoints: 100,
+			minAmount:   math.ZeroInt(),
+			maxAmount:   math.NewInt(5),
+			amount:      math.NewInt(1_000),
+			expFee:      math.NewInt(5),
+		},
+		{
+			name:        "success - minimum amount only",
+			basisPoints: 0,
+			minAmount:   math.NewInt(7),
+			maxAmount:   math.ZeroInt(),
+			amount:      math.NewInt(1_000),
+			expFee:      math.NewInt(7),
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			attr := actiontypes.RouteFeeAttributes{
+				BasisPoints: tC.basisPoints,
+				MinAmount:   tC.minAmount,
+				MaxAmount:   tC.maxAmount,
+			}
+
+			fee, err := attr.ComputeFee(tC.amount)
+			require.NoError(t, err)
+			require.Equal(t, tC.expFee, fee)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// MaxSwapRoutes is the maximum number of pools that can
+// be specified for a swap.
+const MaxSwapRoutes = 5
+
+func NewSwapAction(
+	routes []*SwapRoute,
+	denomOut string,
+	minAmountOut math.Int,
+) (*core.Action, error) {
+	attr, err := NewSwapAttributes(routes, denomOut, minAmountOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.NewAction(core.ACTION_SWAP, attr)
+}
+
+func NewSwapAttributes(
+	routes []*SwapRoute,
+	denomOut string,
+	minAmountOut math.Int,
+) (*SwapAttributes, error) {
+	attr := SwapAttributes{
+		Routes:       routes,
+		DenomOut:     denomOut,
+		MinAmountOut: minAmountOut,
+	}
+
+	return &attr, attr.Validate()
+}
+
+func (s *SwapAttributes) Validate() error {
+	if s == nil {
+		return core.ErrNilPointer.Wrap("swap attributes")
+	}
+
+	if len(s.Routes) == 0 {
+		return errors.New("swap must specify at least one route")
+	}
+	if len(s.Routes) > MaxSwapRoutes {
+		return fmt.Errorf(
+			"maximum swap routes %d, received %d",
+			MaxSwapRoutes,
+			len(s.Routes),
+		)
+	}
+
+	for _, r := range s.Routes {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := sdk.ValidateDenom(s.DenomOut); err != nil {
+		return errorsmod.Wrap(err, "invalid output denom")
+	}
+	if last := s.Routes[len(s.Routes)-1]; last.DenomTo != s.DenomOut {
+		return fmt.Errorf(
+			"last swap route must output %s, received %s",
+			s.DenomOut,
+			last.DenomTo,
+		)
+	}
+
+	if s.MinAmountOut.IsNil() || !s.MinAmountOut.IsPositive() {
+		return errors.New("minimum output amount must be positive")
+	}
+
+	return nil
+}
+
+// MinCoinOut returns the minimum coin received from the swap.
+func (s *SwapAttributes) MinCoinOut() sdk.Coin {
+	return sdk.NewCoin(s.DenomOut, s.MinAmountOut)
+}
+
+func (r *SwapRoute) Validate() error {
+	if r == nil {
+		return core.ErrNilPointer.Wrap("swap route")
+	}
+
+	if err := sdk.ValidateDenom(r.DenomTo); err != nil {
+		return errorsmod.Wrapf(err, "invalid denom of swap route for pool %d", r.PoolId)
+	}
+
+	return nil
+}
@@ -0,0 +1,659 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/controller/action/v2/swap.proto
+
+package action
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SwapAttributes defines the concrete implementation of the
+// ActionAttributes interface to execute a swap via the Noble
+// swap module.
+type SwapAttributes struct {
+	// routes are the pools used to swap the transferred coin, in order.
+	Routes []*SwapRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	// denom_out is the denom received from the swap.
+	DenomOut string `protobuf:"bytes,2,opt,name=denom_out,json=denomOut,proto3" json:"denom_out,omitempty"`
+	// min_amount_out is the minimum amount of the output denom received
+	// from the swap. The swap fails if the output is lower.
+	MinAmountOut cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=min_amount_out,json=minAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount_out"`
+}
+
+func (m *SwapAttributes) Reset()         { *m = SwapAttributes{} }
+func (m *SwapAttributes) String() string { return proto.CompactTextString(m) }
+func (*SwapAttributes) ProtoMessage()    {}
+func (*SwapAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14cd5dc8e9334113, []int{0}
+}
+func (m *SwapAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapAttributes.Merge(m, src)
+}
+func (m *SwapAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapAttributes proto.InternalMessageInfo
+
+func (m *SwapAttributes) GetRoutes() []*SwapRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *SwapAttributes) GetDenomOut() string {
+	if m != nil {
+		return m.DenomOut
+	}
+	return ""
+}
+
+// SwapRoute represents a single step of a swap.
+type SwapRoute struct {
+	// pool_id is the identifier of the swap module pool.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// denom_to is the denom received from the pool.
+	DenomTo string `protobuf:"bytes,2,opt,name=denom_to,json=denomTo,proto3" json:"denom_to,omitempty"`
+}
+
+func (m *SwapRoute) Reset()         { *m = SwapRoute{} }
+func (m *SwapRoute) String() string { return proto.CompactTextString(m) }
+func (*SwapRoute) ProtoMessage()    {}
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14cd5dc8e9334113, []int{1}
+}
+func (m *SwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRoute.Merge(m, src)
+}
+func (m *SwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRoute proto.InternalMessageInfo
+
+func (m *SwapRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SwapRoute) GetDenomTo() string {
+	if m != nil {
+		return m.DenomTo
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*SwapAttributes)(nil), "noble.orbiter.controller.action.v2.SwapAttributes")
+	proto.RegisterType((*SwapRoute)(nil), "noble.orbiter.controller.action.v2.SwapRoute")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/controller/action/v2/swap.proto", fileDescriptor_14cd5dc8e9334113)
+}
+
+var fileDescriptor_14cd5dc8e9334113 = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0x5e, 0xe9, 0xb5, 0xa3, 0x5c, 0x30, 0x28, 0xf6, 0x5e, 0x21, 0xad, 0x05, 0xa1,
+	0x08, 0x99, 0xd1, 0xb8, 0xeb, 0x46, 0x5a, 0x70, 0xd1, 0x55, 0x21, 0x16, 0x17, 0x6e, 0x42, 0xfe,
+	0xd1, 0x0e, 0x66, 0xe6, 0x84, 0xcc, 0x49, 0x8a, 0x6f, 0xe1, 0x63, 0xb8, 0x74, 0xd1, 0x87, 0x28,
+	0xae, 0x8a, 0x2b, 0x71, 0x51, 0xa4, 0x5d, 0xf8, 0x00, 0xbe, 0x80, 0x64, 0x12, 0x5a, 0xc4, 0xc5,
+	0xdd, 0x84, 0xf3, 0x9d, 0x7c, 0xe7, 0xf0, 0xfb, 0x38, 0x43, 0x5d, 0x05, 0x51, 0x96, 0x72, 0x28,
+	0x22, 0x81, 0x69, 0xc1, 0x63, 0x50, 0x58, 0x40, 0x96, 0xa5, 0x05, 0x0f, 0x63, 0x14, 0xa0, 0x78,
+	0xe5, 0x71, 0xbd, 0x0e, 0x73, 0x96, 0x17, 0x80, 0x60, 0x0f, 0x8d, 0x9d, 0xb5, 0x76, 0x76, 0xb6,
+	0xb3, 0xc6, 0xce, 0x2a, 0xef, 0xe6, 0x61, 0x28, 0x85, 0x02, 0x6e, 0xbe, 0xcd, 0xd8, 0xcd, 0x75,
+	0x0c, 0x5a, 0x82, 0x0e, 0x8c, 0xe2, 0x8d, 0x68, 0x7f, 0x3d, 0x5a, 0xc2, 0x12, 0x9a, 0x7e, 0x5d,
+	0x35, 0xdd, 0xe1, 0x1f, 0x42, 0xaf, 0xde, 0xad, 0xc3, 0x7c, 0x82, 0x58, 0x88, 0xa8, 0xc4, 0x54,
+	0xdb, 0x6f, 0x69, 0xa7, 0x80, 0xba, 0xea, 0x91, 0xc1, 0xc5, 0xe8, 0xbe, 0xe7, 0xb2, 0xdb, 0x59,
+	0x58, 0xbd, 0xc3, 0xaf, 0xa7, 0xfc, 0x76, 0xd8, 0x7e, 0x4a, 0xbb, 0x49, 0xaa, 0x40, 0x06, 0x50,
+	0x62, 0xef, 0xce, 0x80, 0x8c, 0xba, 0xfe, 0x3d, 0xd3, 0x98, 0x97, 0x68, 0xbf, 0xa7, 0x57, 0x52,
+	0xa8, 0x20, 0x94, 0x50, 0x2a, 0x34, 0x8e, 0x8b, 0xda, 0x31, 0x7d, 0xb9, 0xdd, 0xf7, 0xad, 0x9f,
+	0xfb, 0xfe, 0xe3, 0x06, 0x5d, 0x27, 0x1f, 0x99, 0x00, 0x2e, 0x43, 0x5c, 0xb1, 0x99, 0xc2, 0xef,
+	0x1b, 0x97, 0xb6, 0x99, 0x66, 0x0a, 0xbf, 0xfc, 0xfe, 0xfa, 0x82, 0xf8, 0x0f, 0xa4, 0x50, 0x13,
+	0xb3, 0x66, 0x5e, 0xe2, 0xf8, 0xf9, 0xb7, 0x8d, 0xfb, 0xec, 0x5f, 0xdc, 0xea, 0x15, 0x9b, 0x18,
+	0xcc, 0x73, 0xc4, 0xe1, 0x1b, 0xda, 0x3d, 0x01, 0xdb, 0x4f, 0xe8, 0x65, 0x0e, 0x90, 0x05, 0x22,
+	0xe9, 0x91, 0x01, 0x19, 0xdd, 0xf5, 0x3b, 0xb5, 0x9c, 0x25, 0xf6, 0x35, 0x6d, 0x80, 0x03, 0x84,
+	0x36, 0xc0, 0xa5, 0xd1, 0x0b, 0x98, 0x2e, 0xb6, 0x07, 0x87, 0xec, 0x0e, 0x0e, 0xf9, 0x75, 0x70,
+	0xc8, 0xe7, 0xa3, 0x63, 0xed, 0x8e, 0x8e, 0xf5, 0xe3, 0xe8, 0x58, 0x1f, 0xc6, 0x4b, 0x81, 0xab,
+	0x32, 0x62, 0x31, 0x48, 0x6e, 0x40, 0xdc, 0x50, 0xeb, 0x14, 0xf5, 0xe9, 0xf2, 0x95, 0xc7, 0xf1,
+	0x53, 0x9e, 0xea, 0xff, 0x9f, 0x40, 0xd4, 0x31, 0x37, 0x79, 0xfd, 0x77, 0x00, 0x16, 0xf7, 0xab,
+	0xf5, 0x2c, 0x02, 0x00, 0x00,
+}
+
+func (m *SwapAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinAmountOut.Size()
+		i -= size
+		if _, err := m.MinAmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DenomOut) > 0 {
+		i -= len(m.DenomOut)
+		copy(dAtA[i:], m.DenomOut)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.DenomOut)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomTo) > 0 {
+		i -= len(m.DenomTo)
+		copy(dAtA[i:], m.DenomTo)
+		i = encodeVarintSwap(dAtA, i, uint64(len(m.DenomTo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintSwap(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwapAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovSwap(uint64(l))
+		}
+	}
+	l = len(m.DenomOut)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	l = m.MinAmountOut.Size()
+	n += 1 + l + sovSwap(uint64(l))
+	return n
+}
+
+func (m *SwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovSwap(uint64(m.PoolId))
+	}
+	l = len(m.DenomTo)
+	if l > 0 {
+		n += 1 + l + sovSwap(uint64(l))
+	}
+	return n
+}
+
+func sovSwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSwap(x uint64) (n int) {
+	return sovSwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwapAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &SwapRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSwap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package action_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	actiontypes "github.com/noble-assets/orbiter/v2/types/controller/action"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestValidateSwapAttributes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		attr   *actiontypes.SwapAttributes
+		expErr string
+	}{
+		{
+			name:   "error - nil attributes",
+			expErr: core.ErrNilPointer.Error(),
+		},
+		{
+			name: "success - single route",
+			attr: &actiontypes.SwapAttributes{
+				Routes:       []*actiontypes.SwapRoute{{PoolId: 0, DenomTo: "uusdn"}},
+				DenomOut:     "uusdn",
+				MinAmountOut: math.NewInt(1),
+			},
+		},
+		{
+			name: "success - multiple routes",
+			attr: &actiontypes.SwapAttributes{
+				Routes: []*actiontypes.SwapRoute{
+					{PoolId: 0, DenomTo: "uusdn"},
+					{PoolId: 1, DenomTo: "ueure"},
+				},
+				DenomOut:     "ueure",
+				MinAmountOut: math.NewInt(1),
+			},
+		},
+		{
+			name: "error - no routes",
+			attr: &actiontypes.SwapAttributes{
+				DenomOut:     "uusdn",
+				MinAmountOut: math.NewInt(1),
+			},
+			expErr: "swap must specify at least one route",
+		},
+		{
+			name: "error - too many routes",
+			attr: &actiontypes.SwapAttributes{
+				Routes: make(
+					[]*actiontypes.SwapRoute,
+					actiontypes.MaxSwapRoutes+1,
+				),
+				DenomOut:     "uusdn",
+				MinAmountOut: math.NewInt(1),
+			},
+			expErr: "maximum swap routes 5, received 6",
+		},
+		{
+			name: "error - nil route",
+			attr: &actiontypes.SwapAttributes{
+				Routes:       []*actiontypes.SwapRoute{nil},
+				DenomOut:     "uusdn",
+				MinAmountOut: math.NewInt(1),
+			},
+			expErr: "swap route",
+		},
+		{
+			name: "error - invalid route denom",
+			attr: &actiontypes.SwapAttributes{
+				Routes:       []*actiontypes.SwapRoute{{PoolId: 0, DenomTo: "1"}},
+				DenomOut:     "uusdn",
+				MinAmountOut: math.NewInt(1),
+			},
+			expErr: "invalid denom of swap route for pool 0",
+		},
+		{
+			name: "error - last route does not output the denom",
+			attr: &actiontypes.SwapAttributes{
+				Routes:       []*actiontypes.SwapRoute{{PoolId: 0, DenomTo: "ueure"}},
+				DenomOut:     "uusdn",
+				MinAmountOut: math.NewInt(1),
+			},
+			expErr: "last swap route must output uusdn, received ueure",
+		},
+		{
+			name: "error - nil minimum output amount",
+			attr: &actiontypes.SwapAttributes{
+				Routes:   []*actiontypes.SwapRoute{{PoolId: 0, DenomTo: "uusdn"}},
+				DenomOut: "uusdn",
+			},
+			expErr: "minimum output amount must be positive",
+		},
+		{
+			name: "error - zero minimum output amount",
+			attr: &actiontypes.SwapAttributes{
+				Routes:       []*actiontypes.SwapRoute{{PoolId: 0, DenomTo: "uusdn"}},
+				DenomOut:     "uusdn",
+				MinAmountOut: math.ZeroInt(),
+			},
+			expErr: "minimum output amount must be positive",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			err := tC.attr.Validate()
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
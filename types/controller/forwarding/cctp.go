@@ -21,15 +21,31 @@
 package forwarding
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
-// CCTPNobleDomain is the identifier of the Noble domain
-// in the CCTP protocol.
-const CCTPNobleDomain = 4
+const (
+	// CCTPNobleDomain is the identifier of the Noble domain
+	// in the CCTP protocol.
+	CCTPNobleDomain = 4
+	// CCTPTransferNonceLen is the length in bytes of the transfer nonce
+	// prepended to the payload in the CCTP payload message body.
+	CCTPTransferNonceLen = 8
+)
+
+// NewCCTPPayloadMessageBody returns the body of the CCTP general message
+// carrying a payload linked to the transfer with the given nonce. The body
+// is equal to abi.encodePacked(transferNonce, payload).
+func NewCCTPPayloadMessageBody(transferNonce uint64, payload []byte) []byte {
+	body := make([]byte, 0, CCTPTransferNonceLen+len(payload))
+	body = binary.BigEndian.AppendUint64(body, transferNonce)
+
+	return append(body, payload...)
+}
 
 var _ core.ForwardingAttributes = &CCTPAttributes{}
 
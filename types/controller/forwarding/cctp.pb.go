@@ -101,7 +101,7 @@ func init() {
 }
 
 var fileDescriptor_93b3e2fd35801f19 = []byte{
-	// 291 bytes of a gzipped FileDescriptorProto
+	// 288 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4a, 0xec, 0x40,
 	0x14, 0x86, 0x77, 0xee, 0x05, 0x8b, 0xe0, 0x2e, 0x98, 0x2a, 0x5a, 0x0c, 0x8b, 0xb0, 0xb2, 0x16,
 	0x99, 0x21, 0xda, 0x59, 0x08, 0x1a, 0xb1, 0x96, 0x60, 0x21, 0x36, 0x21, 0x99, 0x8c, 0xeb, 0x40,
@@ -119,8 +119,7 @@ var fileDescriptor_93b3e2fd35801f19 = []byte{
 	0xbf, 0xef, 0xf9, 0xcd, 0x6b, 0x4b, 0xc9, 0xa2, 0xa5, 0xe4, 0xa3, 0xa5, 0xe4, 0xa9, 0xa3, 0x83,
 	0x45, 0x47, 0x07, 0xef, 0x1d, 0x1d, 0xdc, 0x9e, 0xce, 0x14, 0xde, 0xcf, 0x73, 0x26, 0xa0, 0xe2,
 	0xce, 0x15, 0x66, 0xd6, 0x4a, 0xb4, 0xeb, 0x92, 0x9b, 0x23, 0x8e, 0x8f, 0xb5, 0xb4, 0x7f, 0xb7,
-	0x9d, 0x6f, 0xb9, 0x8e, 0x8e, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xac, 0x90, 0x18, 0xc3, 0x9b,
-	0x01, 0x00, 0x00,
+	0x9d, 0x6f, 0xb9, 0x8e, 0x8e, 0x3f, 0x07, 0x00, 0xac, 0x90, 0x18, 0xc3, 0x9b, 0x01, 0x00, 0x00,
 }
 
 func (m *CCTPAttributes) Marshal() (dAtA []byte, err error) {
@@ -33,6 +33,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*core.ForwardingAttributes)(nil),
 		&CCTPAttributes{},
 		&HypAttributes{},
+		&IBCAttributes{},
 		&InternalAttributes{},
 	)
 }
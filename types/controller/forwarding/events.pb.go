@@ -0,0 +1,953 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/controller/forwarding/v1/events.proto
+
+package forwarding
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventCCTPPayloadMessageSent is emitted when the passthrough payload of a
+// CCTP forwarding is sent as a general message linked to the burn message.
+type EventCCTPPayloadMessageSent struct {
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	TransferNonce     uint64 `protobuf:"varint,2,opt,name=transfer_nonce,json=transferNonce,proto3" json:"transfer_nonce,omitempty"`
+	PayloadNonce      uint64 `protobuf:"varint,3,opt,name=payload_nonce,json=payloadNonce,proto3" json:"payload_nonce,omitempty"`
+}
+
+func (m *EventCCTPPayloadMessageSent) Reset()         { *m = EventCCTPPayloadMessageSent{} }
+func (m *EventCCTPPayloadMessageSent) String() string { return proto.CompactTextString(m) }
+func (*EventCCTPPayloadMessageSent) ProtoMessage()    {}
+func (*EventCCTPPayloadMessageSent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f357e7caee62d817, []int{0}
+}
+func (m *EventCCTPPayloadMessageSent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCCTPPayloadMessageSent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCCTPPayloadMessageSent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCCTPPayloadMessageSent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCCTPPayloadMessageSent.Merge(m, src)
+}
+func (m *EventCCTPPayloadMessageSent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCCTPPayloadMessageSent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCCTPPayloadMessageSent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCCTPPayloadMessageSent proto.InternalMessageInfo
+
+func (m *EventCCTPPayloadMessageSent) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *EventCCTPPayloadMessageSent) GetTransferNonce() uint64 {
+	if m != nil {
+		return m.TransferNonce
+	}
+	return 0
+}
+
+func (m *EventCCTPPayloadMessageSent) GetPayloadNonce() uint64 {
+	if m != nil {
+		return m.PayloadNonce
+	}
+	return 0
+}
+
+// EventHyperlanePayloadMessageSent is emitted when the passthrough payload of a
+// Hyperlane forwarding is dispatched as a message linked to the warp transfer.
+type EventHyperlanePayloadMessageSent struct {
+	DestinationDomain uint32 `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	TransferMessageId string `protobuf:"bytes,2,opt,name=transfer_message_id,json=transferMessageId,proto3" json:"transfer_message_id,omitempty"`
+	PayloadMessageId  string `protobuf:"bytes,3,opt,name=payload_message_id,json=payloadMessageId,proto3" json:"payload_message_id,omitempty"`
+}
+
+func (m *EventHyperlanePayloadMessageSent) Reset()         { *m = EventHyperlanePayloadMessageSent{} }
+func (m *EventHyperlanePayloadMessageSent) String() string { return proto.CompactTextString(m) }
+func (*EventHyperlanePayloadMessageSent) ProtoMessage()    {}
+func (*EventHyperlanePayloadMessageSent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f357e7caee62d817, []int{1}
+}
+func (m *EventHyperlanePayloadMessageSent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHyperlanePayloadMessageSent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHyperlanePayloadMessageSent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHyperlanePayloadMessageSent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHyperlanePayloadMessageSent.Merge(m, src)
+}
+func (m *EventHyperlanePayloadMessageSent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHyperlanePayloadMessageSent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHyperlanePayloadMessageSent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHyperlanePayloadMessageSent proto.InternalMessageInfo
+
+func (m *EventHyperlanePayloadMessageSent) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *EventHyperlanePayloadMessageSent) GetTransferMessageId() string {
+	if m != nil {
+		return m.TransferMessageId
+	}
+	return ""
+}
+
+func (m *EventHyperlanePayloadMessageSent) GetPayloadMessageId() string {
+	if m != nil {
+		return m.PayloadMessageId
+	}
+	return ""
+}
+
+// EventHyperlaneFeePaid is emitted when the quoted post dispatch hooks fee of
+// a Hyperlane forwarding with automatic fee is paid.
+type EventHyperlaneFeePaid struct {
+	DestinationDomain uint32     `protobuf:"varint,1,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	TransferMessageId string     `protobuf:"bytes,2,opt,name=transfer_message_id,json=transferMessageId,proto3" json:"transfer_message_id,omitempty"`
+	Fee               types.Coin `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+	// deducted is true if the fee has been deducted from the transferred amount.
+	Deducted bool `protobuf:"varint,4,opt,name=deducted,proto3" json:"deducted,omitempty"`
+}
+
+func (m *EventHyperlaneFeePaid) Reset()         { *m = EventHyperlaneFeePaid{} }
+func (m *EventHyperlaneFeePaid) String() string { return proto.CompactTextString(m) }
+func (*EventHyperlaneFeePaid) ProtoMessage()    {}
+func (*EventHyperlaneFeePaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f357e7caee62d817, []int{2}
+}
+func (m *EventHyperlaneFeePaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHyperlaneFeePaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHyperlaneFeePaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHyperlaneFeePaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHyperlaneFeePaid.Merge(m, src)
+}
+func (m *EventHyperlaneFeePaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHyperlaneFeePaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHyperlaneFeePaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHyperlaneFeePaid proto.InternalMessageInfo
+
+func (m *EventHyperlaneFeePaid) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
+	}
+	return 0
+}
+
+func (m *EventHyperlaneFeePaid) GetTransferMessageId() string {
+	if m != nil {
+		return m.TransferMessageId
+	}
+	return ""
+}
+
+func (m *EventHyperlaneFeePaid) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *EventHyperlaneFeePaid) GetDeducted() bool {
+	if m != nil {
+		return m.Deducted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*EventCCTPPayloadMessageSent)(nil), "noble.orbiter.controller.forwarding.v1.EventCCTPPayloadMessageSent")
+	proto.RegisterType((*EventHyperlanePayloadMessageSent)(nil), "noble.orbiter.controller.forwarding.v1.EventHyperlanePayloadMessageSent")
+	proto.RegisterType((*EventHyperlaneFeePaid)(nil), "noble.orbiter.controller.forwarding.v1.EventHyperlaneFeePaid")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/controller/forwarding/v1/events.proto", fileDescriptor_f357e7caee62d817)
+}
+
+var fileDescriptor_f357e7caee62d817 = []byte{
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0x8a, 0xd3, 0x40,
+	0x1c, 0xc7, 0x3b, 0x76, 0x91, 0xdd, 0xd1, 0x8a, 0x8d, 0x0a, 0xb5, 0x42, 0x2c, 0x15, 0xa5, 0x88,
+	0x3b, 0x43, 0x77, 0xc1, 0xa3, 0x87, 0xad, 0x8a, 0x7b, 0x50, 0x4a, 0xf4, 0x20, 0x5e, 0xca, 0x24,
+	0xf3, 0x6b, 0x1c, 0x48, 0xe6, 0x17, 0x66, 0x66, 0x23, 0x7d, 0x0b, 0x8f, 0x3e, 0x82, 0x37, 0x7d,
+	0x8c, 0xc5, 0xd3, 0x1e, 0x3d, 0x89, 0xb4, 0x07, 0x5f, 0x43, 0x32, 0x49, 0xbb, 0x5b, 0xf0, 0x26,
+	0x7b, 0x09, 0x93, 0xef, 0x9f, 0xcc, 0x87, 0xf0, 0xa5, 0x87, 0x1a, 0xe3, 0x0c, 0x38, 0x9a, 0x58,
+	0x39, 0x30, 0x3c, 0x41, 0xed, 0x0c, 0x66, 0x19, 0x18, 0x3e, 0x47, 0xf3, 0x49, 0x18, 0xa9, 0x74,
+	0xca, 0xcb, 0x31, 0x87, 0x12, 0xb4, 0xb3, 0xac, 0x30, 0xe8, 0x30, 0x78, 0xe4, 0x4b, 0xac, 0x29,
+	0xb1, 0xf3, 0x12, 0x3b, 0x2f, 0xb1, 0x72, 0xdc, 0xef, 0x8a, 0x5c, 0x69, 0xe4, 0xfe, 0x59, 0x57,
+	0xfb, 0x61, 0x82, 0x36, 0x47, 0xcb, 0x63, 0x61, 0x81, 0x97, 0xe3, 0x18, 0x9c, 0x18, 0xf3, 0x04,
+	0x95, 0x6e, 0xfc, 0xdb, 0x29, 0xa6, 0xe8, 0x8f, 0xbc, 0x3a, 0xd5, 0xea, 0xf0, 0x0b, 0xa1, 0xf7,
+	0x5e, 0x54, 0x04, 0x93, 0xc9, 0xbb, 0xe9, 0x54, 0x2c, 0x32, 0x14, 0xf2, 0x35, 0x58, 0x2b, 0x52,
+	0x78, 0x0b, 0xda, 0x05, 0xfb, 0x34, 0x90, 0x60, 0x9d, 0xd2, 0xc2, 0x29, 0xd4, 0x33, 0x89, 0xb9,
+	0x50, 0xba, 0x47, 0x06, 0x64, 0xd4, 0x89, 0xba, 0x17, 0x9c, 0xe7, 0xde, 0x08, 0x1e, 0xd2, 0x1b,
+	0xce, 0x08, 0x6d, 0xe7, 0x60, 0x66, 0x1a, 0x75, 0x02, 0xbd, 0x2b, 0x03, 0x32, 0xda, 0x89, 0x3a,
+	0x6b, 0xf5, 0x4d, 0x25, 0x06, 0x0f, 0x68, 0xa7, 0xa8, 0xef, 0x6a, 0x52, 0x6d, 0x9f, 0xba, 0xde,
+	0x88, 0x3e, 0x34, 0xfc, 0x46, 0xe8, 0xc0, 0xa3, 0xbd, 0x5a, 0x14, 0x60, 0x32, 0xa1, 0xe1, 0xff,
+	0xf9, 0x18, 0xbd, 0xb5, 0xe1, 0xcb, 0xeb, 0xcf, 0xcc, 0x94, 0xf4, 0x90, 0x7b, 0x51, 0x77, 0x6d,
+	0x35, 0x17, 0x1c, 0xcb, 0xe0, 0x09, 0x0d, 0xd6, 0xa0, 0x17, 0xe2, 0x6d, 0x1f, 0xbf, 0x59, 0x6c,
+	0xe1, 0x1c, 0xcb, 0xe1, 0x0f, 0x42, 0xef, 0x6c, 0x13, 0xbf, 0x04, 0x98, 0x0a, 0x25, 0x2f, 0x1b,
+	0xf3, 0x29, 0x6d, 0xcf, 0xa1, 0xfe, 0x8b, 0xd7, 0x0e, 0xee, 0xb2, 0x7a, 0x09, 0xac, 0x5a, 0x02,
+	0x6b, 0x96, 0xc0, 0x26, 0xa8, 0xf4, 0xd1, 0xde, 0xe9, 0xaf, 0xfb, 0xad, 0xaf, 0x7f, 0xbe, 0x3f,
+	0x26, 0x51, 0x55, 0x08, 0xfa, 0x74, 0x57, 0x82, 0x3c, 0x49, 0x1c, 0xc8, 0xde, 0xce, 0x80, 0x8c,
+	0x76, 0xa3, 0xcd, 0xfb, 0xd1, 0xfb, 0xd3, 0x65, 0x48, 0xce, 0x96, 0x21, 0xf9, 0xbd, 0x0c, 0xc9,
+	0xe7, 0x55, 0xd8, 0x3a, 0x5b, 0x85, 0xad, 0x9f, 0xab, 0xb0, 0xf5, 0xe1, 0x59, 0xaa, 0xdc, 0xc7,
+	0x93, 0x98, 0x25, 0x98, 0x73, 0xbf, 0xd7, 0x7d, 0x61, 0x2d, 0x38, 0xbb, 0xd9, 0x7a, 0x79, 0xc0,
+	0xdd, 0xa2, 0x00, 0xfb, 0xef, 0xd1, 0xc7, 0x57, 0xfd, 0xf4, 0x0e, 0xff, 0x0e, 0x00, 0xb9, 0x0b,
+	0xe6, 0x78, 0x22, 0x03, 0x00, 0x00,
+}
+
+func (m *EventCCTPPayloadMessageSent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCCTPPayloadMessageSent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCCTPPayloadMessageSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayloadNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PayloadNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TransferNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TransferNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHyperlanePayloadMessageSent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHyperlanePayloadMessageSent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHyperlanePayloadMessageSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayloadMessageId) > 0 {
+		i -= len(m.PayloadMessageId)
+		copy(dAtA[i:], m.PayloadMessageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PayloadMessageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TransferMessageId) > 0 {
+		i -= len(m.TransferMessageId)
+		copy(dAtA[i:], m.TransferMessageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TransferMessageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHyperlaneFeePaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHyperlaneFeePaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHyperlaneFeePaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deducted {
+		i--
+		if m.Deducted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TransferMessageId) > 0 {
+		i -= len(m.TransferMessageId)
+		copy(dAtA[i:], m.TransferMessageId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TransferMessageId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCCTPPayloadMessageSent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvents(uint64(m.DestinationDomain))
+	}
+	if m.TransferNonce != 0 {
+		n += 1 + sovEvents(uint64(m.TransferNonce))
+	}
+	if m.PayloadNonce != 0 {
+		n += 1 + sovEvents(uint64(m.PayloadNonce))
+	}
+	return n
+}
+
+func (m *EventHyperlanePayloadMessageSent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvents(uint64(m.DestinationDomain))
+	}
+	l = len(m.TransferMessageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PayloadMessageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventHyperlaneFeePaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvents(uint64(m.DestinationDomain))
+	}
+	l = len(m.TransferMessageId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Deducted {
+		n += 2
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCCTPPayloadMessageSent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTPPayloadMessageSent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTPPayloadMessageSent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferNonce", wireType)
+			}
+			m.TransferNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransferNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadNonce", wireType)
+			}
+			m.PayloadNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHyperlanePayloadMessageSent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHyperlanePayloadMessageSent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHyperlanePayloadMessageSent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadMessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadMessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHyperlaneFeePaid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHyperlaneFeePaid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHyperlaneFeePaid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+			}
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deducted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deducted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
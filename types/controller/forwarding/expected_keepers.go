@@ -23,10 +23,13 @@ package forwarding
 import (
 	"context"
 
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
 // CCTPMsgServer defines the expected behavior for the CCTP server.
@@ -43,6 +46,10 @@ type CCTPMsgServer interface {
 		context.Context,
 		*cctptypes.MsgReplaceDepositForBurn,
 	) (*cctptypes.MsgReplaceDepositForBurnResponse, error)
+	SendMessageWithCaller(
+		context.Context,
+		*cctptypes.MsgSendMessageWithCaller,
+	) (*cctptypes.MsgSendMessageWithCallerResponse, error)
 }
 
 // HyperlaneHandler defines the expected behavior for the Hyperlane server.
@@ -55,9 +62,64 @@ type HyperlaneHandler interface {
 		ctx context.Context,
 		request *warptypes.QueryTokenRequest,
 	) (*warptypes.QueryTokenResponse, error)
+	QuoteRemoteTransfer(
+		ctx context.Context,
+		request *warptypes.QueryQuoteRemoteTransferRequest,
+	) (*warptypes.QueryQuoteRemoteTransferResponse, error)
+	DispatchMessage(
+		ctx context.Context,
+		originMailboxID hyperlaneutil.HexAddress,
+		sender hyperlaneutil.HexAddress,
+		maxFee sdk.Coins,
+		destinationDomain uint32,
+		recipient hyperlaneutil.HexAddress,
+		body []byte,
+		metadata hyperlaneutil.StandardHookMetadata,
+		postDispatchHookID *hyperlaneutil.HexAddress,
+	) (hyperlaneutil.HexAddress, error)
+}
+
+// HyperlaneCoreKeeper defines the expected behavior of the Hyperlane core
+// keeper to dispatch messages via a mailbox.
+type HyperlaneCoreKeeper interface {
+	DispatchMessage(
+		ctx sdk.Context,
+		originMailboxID hyperlaneutil.HexAddress,
+		sender hyperlaneutil.HexAddress,
+		maxFee sdk.Coins,
+		destinationDomain uint32,
+		recipient hyperlaneutil.HexAddress,
+		body []byte,
+		metadata hyperlaneutil.StandardHookMetadata,
+		postDispatchHookID *hyperlaneutil.HexAddress,
+	) (hyperlaneutil.HexAddress, error)
+}
+
+// HyperlaneTokenRouteResolver defines the expected behavior to resolve the
+// Hyperlane warp token used for a denom and a destination domain.
+type HyperlaneTokenRouteResolver interface {
+	GetHyperlaneTokenRoute(
+		ctx context.Context,
+		denom string,
+		destinationDomain uint32,
+	) ([]byte, bool, error)
 }
 
 // InternalHandler defines the expected behavior for the internal transfer handler.
 type InternalHandler interface {
 	Send(ctx context.Context, msg *banktypes.MsgSend) (*banktypes.MsgSendResponse, error)
 }
+
+// IBCHandler defines the expected behavior for the ICS-20 transfer handler.
+type IBCHandler interface {
+	Transfer(
+		ctx context.Context,
+		msg *transfertypes.MsgTransfer,
+	) (*transfertypes.MsgTransferResponse, error)
+}
+
+// IBCBankKeeper defines the expected bank behavior
+// for the IBC forwarding controller.
+type IBCBankKeeper interface {
+	BlockedAddr(addr sdk.AccAddress) bool
+}
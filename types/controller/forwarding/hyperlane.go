@@ -21,11 +21,14 @@
 package forwarding
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
 	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -44,25 +47,54 @@ const (
 	HypNobleMainnetDomain = 1313817164
 )
 
-// hyperlaneServers is a wrapper around the message and query servers to fulfill
-// the HyperlaneHandler interface.
+// hyperlaneServers is a wrapper around the message and query servers and the core
+// keeper to fulfill the HyperlaneHandler interface.
 type hyperlaneServers struct {
 	warptypes.MsgServer
 	warptypes.QueryServer
+
+	coreKeeper HyperlaneCoreKeeper
 }
 
 // NewHyperlaneHandler creates a wrapper around the Hyperlane Warp module's message and query
-// servers.
+// servers, and the Hyperlane core keeper.
 func NewHyperlaneHandler(
 	msgServer warptypes.MsgServer,
 	queryServer warptypes.QueryServer,
+	coreKeeper HyperlaneCoreKeeper,
 ) HyperlaneHandler {
 	return &hyperlaneServers{
 		MsgServer:   msgServer,
 		QueryServer: queryServer,
+		coreKeeper:  coreKeeper,
 	}
 }
 
+// DispatchMessage dispatches a Hyperlane message via the core keeper.
+func (s *hyperlaneServers) DispatchMessage(
+	ctx context.Context,
+	originMailboxID hyperlaneutil.HexAddress,
+	sender hyperlaneutil.HexAddress,
+	maxFee sdk.Coins,
+	destinationDomain uint32,
+	recipient hyperlaneutil.HexAddress,
+	body []byte,
+	metadata hyperlaneutil.StandardHookMetadata,
+	postDispatchHookID *hyperlaneutil.HexAddress,
+) (hyperlaneutil.HexAddress, error) {
+	return s.coreKeeper.DispatchMessage(
+		sdk.UnwrapSDKContext(ctx),
+		originMailboxID,
+		sender,
+		maxFee,
+		destinationDomain,
+		recipient,
+		body,
+		metadata,
+		postDispatchHookID,
+	)
+}
+
 // NewHyperlaneAttributes creates and validates new Hyperlane forwarding attributes.
 // Returns an error if validation fails.
 func NewHyperlaneAttributes(
@@ -97,11 +129,12 @@ func (a *HypAttributes) Validate() error {
 		return core.ErrNilPointer.Wrap("Hyperlane attributes are not set")
 	}
 
-	if len(a.TokenId) != HypTokenIDLen {
+	// NOTE: an empty token ID is resolved from the registered token routes.
+	if l := len(a.TokenId); l != 0 && l != HypTokenIDLen {
 		return fmt.Errorf(
-			"token ID must be %d bytes, received %d bytes",
+			"token ID must be %d bytes when set, received %d bytes",
 			HypTokenIDLen,
-			len(a.TokenId),
+			l,
 		)
 	}
 
@@ -126,6 +159,12 @@ func (a *HypAttributes) Validate() error {
 		return fmt.Errorf("destination domain %d is a Noble domain", a.DestinationDomain)
 	}
 
+	if a.AutoFee && !a.GasLimit.IsNil() && !a.GasLimit.IsZero() {
+		return errors.New(
+			"gas limit cannot be set with auto fee, the gas of the enrolled router is used",
+		)
+	}
+
 	if a.CustomHookMetadata != "" {
 		if !strings.HasPrefix(a.CustomHookMetadata, HypHookMetadataPrefix) {
 			return fmt.Errorf("hook metadata must have the %s prefix, got: %s",
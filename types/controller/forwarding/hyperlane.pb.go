@@ -32,7 +32,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // via the Hyperlane protocol.
 type HypAttributes struct {
 	// token_id represents the unique ID assigned to a token by the Hyperlane
-	// app router.
+	// app router. When empty, the token registered by the authority for the
+	// forwarded denom and the destination domain is used.
 	TokenId []byte `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 	// destination_domain represents the Hyperlane destination
 	// domain identifier: https://docs.hyperlane.xyz/docs/reference/domains.
@@ -52,6 +53,12 @@ type HypAttributes struct {
 	GasLimit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3,customtype=cosmossdk.io/math.Int" json:"gas_limit"`
 	// max_fee is the maximum fee allowed for the execution of post dispatch hooks.
 	MaxFee types.Coin `protobuf:"bytes,7,opt,name=max_fee,json=maxFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee"`
+	// auto_fee enables the on-chain quoting of the post dispatch hooks fee.
+	// The quoted fee is deducted from the transferred amount when the denoms
+	// match, otherwise it is paid by the module account. When enabled, the gas
+	// limit of the enrolled router is used and the max_fee, if set, caps the
+	// quoted fee.
+	AutoFee bool `protobuf:"varint,8,opt,name=auto_fee,json=autoFee,proto3" json:"auto_fee,omitempty"`
 }
 
 func (m *HypAttributes) Reset()         { *m = HypAttributes{} }
@@ -129,6 +136,13 @@ func (m *HypAttributes) GetMaxFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *HypAttributes) GetAutoFee() bool {
+	if m != nil {
+		return m.AutoFee
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*HypAttributes)(nil), "noble.orbiter.controller.forwarding.v1.HypAttributes")
 }
@@ -138,39 +152,40 @@ func init() {
 }
 
 var fileDescriptor_9712c0c871a3a243 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x5a, 0x6d, 0x9a, 0x35, 0x15, 0xba, 0x54, 0xd8, 0x14, 0xd9, 0x04, 0x51, 0x89, 0x85,
-	0xcc, 0x34, 0x15, 0x3c, 0x78, 0x10, 0x8c, 0x12, 0x1a, 0xb0, 0x97, 0x9c, 0xc4, 0xcb, 0x32, 0xbb,
-	0xfb, 0xba, 0x19, 0xb2, 0x3b, 0x2f, 0xec, 0xbc, 0xc4, 0xc6, 0x5f, 0xe1, 0xd9, 0x5f, 0x20, 0x9e,
-	0x7a, 0xe8, 0x5f, 0x10, 0x8a, 0xa7, 0xe2, 0x49, 0x3c, 0x54, 0x49, 0x0e, 0xfd, 0x1b, 0xb2, 0xb3,
-	0x4b, 0x13, 0xc1, 0xcb, 0xee, 0xbc, 0xf7, 0x7d, 0xdf, 0x7b, 0xc3, 0x7c, 0x9f, 0xfd, 0x5c, 0x61,
-	0x90, 0x00, 0xc7, 0x2c, 0x90, 0x04, 0x19, 0x0f, 0x51, 0x51, 0x86, 0x49, 0x02, 0x19, 0x3f, 0xc1,
-	0xec, 0x83, 0xc8, 0x22, 0xa9, 0x62, 0x3e, 0xeb, 0xf2, 0xd1, 0x7c, 0x02, 0x59, 0x22, 0x14, 0xb0,
-	0x49, 0x86, 0x84, 0xce, 0x13, 0xa3, 0x63, 0xa5, 0x8e, 0xad, 0x74, 0x6c, 0xa5, 0x63, 0xb3, 0xee,
-	0xde, 0x8e, 0x48, 0xa5, 0x42, 0x6e, 0xbe, 0x85, 0x74, 0xcf, 0x0b, 0x51, 0xa7, 0xa8, 0x79, 0x20,
-	0x34, 0xf0, 0x59, 0x37, 0x00, 0x12, 0x5d, 0x1e, 0xa2, 0x54, 0x25, 0xde, 0x28, 0x70, 0xdf, 0x54,
-	0xbc, 0x28, 0x4a, 0x68, 0x37, 0xc6, 0x18, 0x8b, 0x7e, 0x7e, 0x2a, 0xba, 0x0f, 0xbf, 0x6d, 0xd8,
-	0xdb, 0x47, 0xf3, 0xc9, 0x2b, 0xa2, 0x4c, 0x06, 0x53, 0x02, 0xed, 0x34, 0xec, 0x2d, 0xc2, 0x31,
-	0x28, 0x5f, 0x46, 0xae, 0xd5, 0xb2, 0xda, 0xf5, 0x61, 0xd5, 0xd4, 0x83, 0xc8, 0xe9, 0xd8, 0x4e,
-	0x04, 0x9a, 0xa4, 0x12, 0x24, 0x51, 0xf9, 0x11, 0xa6, 0x42, 0x2a, 0xf7, 0x56, 0xcb, 0x6a, 0x6f,
-	0x0f, 0x77, 0xd6, 0x90, 0x37, 0x06, 0x70, 0x1e, 0xd8, 0xb5, 0x0c, 0x42, 0x39, 0x91, 0xa0, 0xc8,
-	0xdd, 0x30, 0xa3, 0x56, 0x0d, 0xe7, 0x91, 0x7d, 0x2f, 0x9c, 0x6a, 0xc2, 0xd4, 0x1f, 0x21, 0x8e,
-	0xf3, 0x6d, 0xb7, 0x0d, 0xa5, 0x5e, 0x74, 0x8f, 0x10, 0xc7, 0x83, 0xc8, 0x39, 0xb0, 0x77, 0xd7,
-	0x59, 0x29, 0x90, 0x88, 0x04, 0x09, 0xf7, 0x4e, 0xcb, 0x6a, 0xd7, 0x86, 0xce, 0x8a, 0x7b, 0x5c,
-	0x22, 0xce, 0xb1, 0x5d, 0x8b, 0x85, 0xf6, 0x13, 0x99, 0x4a, 0x72, 0x37, 0x73, 0x5a, 0xef, 0xe0,
-	0xe2, 0xaa, 0x59, 0xf9, 0x75, 0xd5, 0xbc, 0x5f, 0x3c, 0x88, 0x8e, 0xc6, 0x4c, 0x22, 0x4f, 0x05,
-	0x8d, 0xd8, 0x40, 0xd1, 0x8f, 0xf3, 0x8e, 0x5d, 0xbe, 0xd4, 0x40, 0xd1, 0x97, 0xeb, 0xb3, 0x7d,
-	0x6b, 0xb8, 0x15, 0x0b, 0xfd, 0x36, 0x9f, 0xe0, 0x7c, 0xb4, 0xab, 0xa9, 0x38, 0xf5, 0x4f, 0x00,
-	0xdc, 0x6a, 0xcb, 0x6a, 0xdf, 0x3d, 0x6c, 0xb0, 0x92, 0x9c, 0x7b, 0xc0, 0x4a, 0x0f, 0xd8, 0x6b,
-	0x94, 0xaa, 0xd7, 0xcf, 0xf7, 0x7c, 0xfd, 0xdd, 0x6c, 0xc7, 0x92, 0x46, 0xd3, 0x80, 0x85, 0x98,
-	0x96, 0x1e, 0x94, 0xbf, 0x8e, 0x8e, 0xc6, 0x9c, 0xe6, 0x13, 0xd0, 0x46, 0xa0, 0x3f, 0x5f, 0x9f,
-	0xed, 0xd7, 0x13, 0x88, 0x45, 0x38, 0xf7, 0x73, 0x17, 0x75, 0xb1, 0x7d, 0x33, 0x15, 0xa7, 0x7d,
-	0x80, 0x17, 0x4f, 0xbf, 0x9f, 0x77, 0x1e, 0xff, 0x1b, 0x96, 0x59, 0x97, 0xf5, 0x6f, 0x42, 0xb2,
-	0x72, 0xad, 0xf7, 0xee, 0x62, 0xe1, 0x59, 0x97, 0x0b, 0xcf, 0xfa, 0xb3, 0xf0, 0xac, 0x4f, 0x4b,
-	0xaf, 0x72, 0xb9, 0xf4, 0x2a, 0x3f, 0x97, 0x5e, 0xe5, 0xfd, 0xcb, 0xb5, 0xcb, 0x98, 0x59, 0x1d,
-	0xa1, 0x35, 0x90, 0xbe, 0xc9, 0xed, 0xec, 0xb0, 0xb8, 0xd2, 0xff, 0x03, 0x1c, 0x6c, 0x9a, 0xa0,
-	0x3c, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x61, 0xc3, 0x59, 0x0f, 0xee, 0x02, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x4d, 0x6b, 0x13, 0x51,
+	0x14, 0xcd, 0x58, 0xcd, 0xc7, 0x98, 0x0a, 0x1d, 0x2a, 0x4c, 0x8a, 0x4c, 0x82, 0xa8, 0x8c, 0x85,
+	0xbc, 0xd7, 0x54, 0x70, 0xe1, 0x42, 0x30, 0x4a, 0x68, 0xc0, 0x6e, 0xb2, 0x12, 0x37, 0xc3, 0x9b,
+	0x99, 0xdb, 0xc9, 0x23, 0x33, 0xef, 0x86, 0x79, 0x37, 0xb1, 0xf1, 0x57, 0xb8, 0xf6, 0x17, 0x88,
+	0xab, 0x2e, 0xfa, 0x23, 0x8a, 0xab, 0xe2, 0x4a, 0x5c, 0xd4, 0x92, 0x2c, 0xfa, 0x37, 0x64, 0x3e,
+	0x68, 0x22, 0xb8, 0x99, 0x79, 0xf7, 0x9e, 0x73, 0xee, 0x7d, 0xbc, 0x73, 0xcc, 0x97, 0x0a, 0xfd,
+	0x18, 0x38, 0xa6, 0xbe, 0x24, 0x48, 0x79, 0x80, 0x8a, 0x52, 0x8c, 0x63, 0x48, 0xf9, 0x09, 0xa6,
+	0x9f, 0x44, 0x1a, 0x4a, 0x15, 0xf1, 0x79, 0x8f, 0x8f, 0x17, 0x53, 0x48, 0x63, 0xa1, 0x80, 0x4d,
+	0x53, 0x24, 0xb4, 0x9e, 0xe5, 0x3a, 0x56, 0xea, 0xd8, 0x5a, 0xc7, 0xd6, 0x3a, 0x36, 0xef, 0xed,
+	0xed, 0x88, 0x44, 0x2a, 0xe4, 0xf9, 0xb7, 0x90, 0xee, 0x39, 0x01, 0xea, 0x04, 0x35, 0xf7, 0x85,
+	0x06, 0x3e, 0xef, 0xf9, 0x40, 0xa2, 0xc7, 0x03, 0x94, 0xaa, 0xc4, 0x5b, 0x05, 0xee, 0xe5, 0x15,
+	0x2f, 0x8a, 0x12, 0xda, 0x8d, 0x30, 0xc2, 0xa2, 0x9f, 0x9d, 0x8a, 0xee, 0xe3, 0xeb, 0x2d, 0x73,
+	0xfb, 0x68, 0x31, 0x7d, 0x43, 0x94, 0x4a, 0x7f, 0x46, 0xa0, 0xad, 0x96, 0x59, 0x27, 0x9c, 0x80,
+	0xf2, 0x64, 0x68, 0x1b, 0x1d, 0xc3, 0x6d, 0x8e, 0x6a, 0x79, 0x3d, 0x0c, 0xad, 0xae, 0x69, 0x85,
+	0xa0, 0x49, 0x2a, 0x41, 0x12, 0x95, 0x17, 0x62, 0x22, 0xa4, 0xb2, 0xef, 0x74, 0x0c, 0x77, 0x7b,
+	0xb4, 0xb3, 0x81, 0xbc, 0xcb, 0x01, 0xeb, 0x91, 0xd9, 0x48, 0x21, 0x90, 0x53, 0x09, 0x8a, 0xec,
+	0xad, 0x7c, 0xd4, 0xba, 0x61, 0x3d, 0x31, 0x1f, 0x04, 0x33, 0x4d, 0x98, 0x78, 0x63, 0xc4, 0x49,
+	0xb6, 0xed, 0x6e, 0x4e, 0x69, 0x16, 0xdd, 0x23, 0xc4, 0xc9, 0x30, 0xb4, 0x0e, 0xcc, 0xdd, 0x4d,
+	0x56, 0x02, 0x24, 0x42, 0x41, 0xc2, 0xbe, 0xd7, 0x31, 0xdc, 0xc6, 0xc8, 0x5a, 0x73, 0x8f, 0x4b,
+	0xc4, 0x3a, 0x36, 0x1b, 0x91, 0xd0, 0x5e, 0x2c, 0x13, 0x49, 0x76, 0x35, 0xa3, 0xf5, 0x0f, 0x2e,
+	0xae, 0xda, 0x95, 0xdf, 0x57, 0xed, 0x87, 0xc5, 0x83, 0xe8, 0x70, 0xc2, 0x24, 0xf2, 0x44, 0xd0,
+	0x98, 0x0d, 0x15, 0xfd, 0x3c, 0xef, 0x9a, 0xe5, 0x4b, 0x0d, 0x15, 0x7d, 0xbb, 0x39, 0xdb, 0x37,
+	0x46, 0xf5, 0x48, 0xe8, 0xf7, 0xd9, 0x04, 0xeb, 0xb3, 0x59, 0x4b, 0xc4, 0xa9, 0x77, 0x02, 0x60,
+	0xd7, 0x3a, 0x86, 0x7b, 0xff, 0xb0, 0xc5, 0x4a, 0x72, 0xe6, 0x01, 0x2b, 0x3d, 0x60, 0x6f, 0x51,
+	0xaa, 0xfe, 0x20, 0xdb, 0xf3, 0xfd, 0x4f, 0xdb, 0x8d, 0x24, 0x8d, 0x67, 0x3e, 0x0b, 0x30, 0x29,
+	0x3d, 0x28, 0x7f, 0x5d, 0x1d, 0x4e, 0x38, 0x2d, 0xa6, 0xa0, 0x73, 0x81, 0xfe, 0x7a, 0x73, 0xb6,
+	0xdf, 0x8c, 0x21, 0x12, 0xc1, 0xc2, 0xcb, 0x5c, 0xd4, 0xc5, 0xf6, 0x6a, 0x22, 0x4e, 0x07, 0x00,
+	0x99, 0x15, 0x62, 0x46, 0x98, 0x2f, 0xaf, 0x77, 0x0c, 0xb7, 0x3e, 0xaa, 0x65, 0xf5, 0x00, 0xe0,
+	0xd5, 0xf3, 0x1f, 0xe7, 0xdd, 0xa7, 0xff, 0xe6, 0x68, 0xde, 0x63, 0x83, 0xdb, 0xfc, 0xac, 0x0d,
+	0xed, 0x7f, 0xb8, 0x58, 0x3a, 0xc6, 0xe5, 0xd2, 0x31, 0xae, 0x97, 0x8e, 0xf1, 0x65, 0xe5, 0x54,
+	0x2e, 0x57, 0x4e, 0xe5, 0xd7, 0xca, 0xa9, 0x7c, 0x7c, 0xbd, 0x71, 0xcf, 0x7c, 0x56, 0x57, 0x68,
+	0x0d, 0xa4, 0x6f, 0x23, 0x3d, 0x3f, 0x2c, 0x6e, 0xfb, 0xff, 0x6c, 0xfb, 0xd5, 0x3c, 0x43, 0x2f,
+	0xfe, 0x0e, 0x00, 0x02, 0x04, 0x90, 0xe9, 0x09, 0x03, 0x00, 0x00,
 }
 
 func (m *HypAttributes) Marshal() (dAtA []byte, err error) {
@@ -193,6 +208,16 @@ func (m *HypAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoFee {
+		i--
+		if m.AutoFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size, err := m.MaxFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -289,6 +314,9 @@ func (m *HypAttributes) Size() (n int) {
 	n += 1 + l + sovHyperlane(uint64(l))
 	l = m.MaxFee.Size()
 	n += 1 + l + sovHyperlane(uint64(l))
+	if m.AutoFee {
+		n += 2
+	}
 	return n
 }
 
@@ -547,6 +575,26 @@ func (m *HypAttributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperlane
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoFee = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHyperlane(dAtA[iNdEx:])
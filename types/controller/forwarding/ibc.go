@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarding
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// DefaultIBCTimeout is the timeout applied to an outgoing ICS-20
+// transfer when no timeout is specified in the attributes.
+const DefaultIBCTimeout = 10 * time.Minute
+
+// MaxIBCTimeout is the maximum relative timeout of an outgoing ICS-20 transfer.
+const MaxIBCTimeout = 72 * time.Hour
+
+var _ core.ForwardingAttributes = &IBCAttributes{}
+
+// CounterpartyID implements core.ForwardingAttributes.
+func (a *IBCAttributes) CounterpartyID() string {
+	return a.GetChannelId()
+}
+
+// NewIBCAttributes returns a validated instance of the IBC attributes.
+func NewIBCAttributes(
+	channelID string,
+	receiver string,
+	timeoutSeconds uint64,
+	refundRecipient string,
+) (*IBCAttributes, error) {
+	attr := IBCAttributes{
+		ChannelId:       channelID,
+		Receiver:        receiver,
+		TimeoutSeconds:  timeoutSeconds,
+		RefundRecipient: refundRecipient,
+	}
+
+	return &attr, attr.Validate()
+}
+
+// Validate returns an error if the IBC attributes are not valid.
+func (a *IBCAttributes) Validate() error {
+	if a == nil {
+		return core.ErrNilPointer.Wrap("IBC attributes")
+	}
+
+	if !channeltypes.IsValidChannelID(a.ChannelId) {
+		return errors.New("invalid channel ID")
+	}
+	if a.Receiver == "" {
+		return core.ErrEmptyString.Wrap("invalid receiver")
+	}
+	if a.TimeoutSeconds > uint64(MaxIBCTimeout/time.Second) {
+		return fmt.Errorf(
+			"timeout of %d seconds exceeds the maximum of %s",
+			a.TimeoutSeconds,
+			MaxIBCTimeout,
+		)
+	}
+	if a.RefundRecipient == "" {
+		return core.ErrEmptyString.Wrap("invalid refund recipient address")
+	}
+	refundRecipient, err := sdk.AccAddressFromBech32(a.RefundRecipient)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid refund recipient address")
+	}
+	// NOTE: the module accounts are blocked from receiving funds,
+	// which would make the refund of the packet fail.
+	if core.IsModuleAddress(refundRecipient) {
+		return errors.New("refund recipient cannot be an orbiter module account")
+	}
+
+	return nil
+}
+
+// Timeout returns the relative timeout of the transfer.
+func (a *IBCAttributes) Timeout() time.Duration {
+	if a.TimeoutSeconds == 0 {
+		return DefaultIBCTimeout
+	}
+
+	return time.Duration(a.TimeoutSeconds) * time.Second
+}
+
+// NewIBCForwarding returns a reference to a validated IBC forwarding.
+// The passthrough payload is used as the memo of the ICS-20 transfer.
+func NewIBCForwarding(
+	channelID string,
+	receiver string,
+	timeoutSeconds uint64,
+	refundRecipient string,
+	passthroughPayload []byte,
+) (*core.Forwarding, error) {
+	attributes, err := NewIBCAttributes(channelID, receiver, timeoutSeconds, refundRecipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.NewForwarding(core.PROTOCOL_IBC, attributes, passthroughPayload)
+}
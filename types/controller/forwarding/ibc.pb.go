@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/controller/forwarding/v1/ibc.proto
+
+package forwarding
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IBCAttributes defines the concrete implementation of the
+// ForwardingAttributes interface to execute a cross-chain routing
+// via an ICS-20 transfer.
+type IBCAttributes struct {
+	// channel_id is the Noble channel identifier used
+	// to send the ICS-20 transfer.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// receiver is the address which will receive the
+	// transfer on the counterparty chain.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout_seconds is the relative timeout of the transfer
+	// from the current block time. When zero, the default
+	// timeout is used.
+	TimeoutSeconds uint64 `protobuf:"varint,3,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// refund_recipient is the Noble address receiving the funds
+	// back if the transfer fails or times out.
+	RefundRecipient string `protobuf:"bytes,4,opt,name=refund_recipient,json=refundRecipient,proto3" json:"refund_recipient,omitempty"`
+}
+
+func (m *IBCAttributes) Reset()         { *m = IBCAttributes{} }
+func (m *IBCAttributes) String() string { return proto.CompactTextString(m) }
+func (*IBCAttributes) ProtoMessage()    {}
+func (*IBCAttributes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd7c7d54cc9a576a, []int{0}
+}
+func (m *IBCAttributes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCAttributes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCAttributes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCAttributes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCAttributes.Merge(m, src)
+}
+func (m *IBCAttributes) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCAttributes) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCAttributes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCAttributes proto.InternalMessageInfo
+
+func (m *IBCAttributes) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *IBCAttributes) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *IBCAttributes) GetTimeoutSeconds() uint64 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+func (m *IBCAttributes) GetRefundRecipient() string {
+	if m != nil {
+		return m.RefundRecipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*IBCAttributes)(nil), "noble.orbiter.controller.forwarding.v1.IBCAttributes")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/controller/forwarding/v1/ibc.proto", fileDescriptor_bd7c7d54cc9a576a)
+}
+
+var fileDescriptor_bd7c7d54cc9a576a = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
+	0x18, 0x85, 0x3b, 0xf7, 0x96, 0xcb, 0xed, 0x80, 0x56, 0x82, 0x8b, 0x58, 0x30, 0x14, 0x41, 0xad,
+	0x8b, 0xce, 0x58, 0xdd, 0xb9, 0x10, 0xda, 0x82, 0xd0, 0x6d, 0xba, 0x11, 0x37, 0xa1, 0x99, 0xf9,
+	0xdb, 0x0e, 0xa4, 0x33, 0xe5, 0x9f, 0x49, 0xc4, 0xb7, 0xf0, 0x61, 0xfa, 0x10, 0xe2, 0xaa, 0xb8,
+	0x72, 0x29, 0xcd, 0x8b, 0x88, 0x49, 0x6c, 0x11, 0x5c, 0xfe, 0x67, 0xf8, 0x0e, 0x73, 0xce, 0xa1,
+	0x97, 0xda, 0xc4, 0x09, 0x70, 0x83, 0xb1, 0x72, 0x80, 0x5c, 0x18, 0xed, 0xd0, 0x24, 0x09, 0x20,
+	0x9f, 0x1a, 0x7c, 0x9c, 0xa0, 0x54, 0x7a, 0xc6, 0xb3, 0x1e, 0x57, 0xb1, 0x60, 0x4b, 0x34, 0xce,
+	0x78, 0x67, 0x05, 0xc1, 0x2a, 0x82, 0xed, 0x08, 0xb6, 0x23, 0x58, 0xd6, 0x6b, 0x1d, 0x09, 0x63,
+	0x17, 0xc6, 0x46, 0x05, 0xc5, 0xcb, 0xa3, 0xb4, 0x38, 0xc9, 0x09, 0xdd, 0x1b, 0x0d, 0x86, 0x7d,
+	0xe7, 0x50, 0xc5, 0xa9, 0x03, 0xeb, 0x1d, 0x53, 0x2a, 0xe6, 0x13, 0xad, 0x21, 0x89, 0x94, 0xf4,
+	0x49, 0x9b, 0x74, 0x1a, 0x61, 0xa3, 0x52, 0x46, 0xd2, 0x6b, 0xd1, 0xff, 0x08, 0x02, 0x54, 0x06,
+	0xe8, 0xff, 0x29, 0x1e, 0xb7, 0xb7, 0x77, 0x4e, 0x9b, 0x4e, 0x2d, 0xc0, 0xa4, 0x2e, 0xb2, 0x20,
+	0x8c, 0x96, 0xd6, 0xff, 0xdb, 0x26, 0x9d, 0x7a, 0xb8, 0x5f, 0xc9, 0xe3, 0x52, 0xf5, 0x86, 0xf4,
+	0x00, 0x61, 0x9a, 0x6a, 0x19, 0x21, 0x08, 0xb5, 0x54, 0xa0, 0x9d, 0x5f, 0xff, 0x32, 0x1b, 0xf8,
+	0x6f, 0xab, 0xee, 0x61, 0xf5, 0xc3, 0xbe, 0x94, 0x08, 0xd6, 0x8e, 0x1d, 0x2a, 0x3d, 0x0b, 0x9b,
+	0x25, 0x11, 0x7e, 0x03, 0x37, 0x17, 0xaf, 0xab, 0xee, 0xe9, 0xcf, 0x06, 0xb2, 0x1e, 0xbb, 0xdb,
+	0x26, 0xdf, 0x65, 0x1a, 0xdc, 0xbf, 0x6c, 0x02, 0xb2, 0xde, 0x04, 0xe4, 0x63, 0x13, 0x90, 0xe7,
+	0x3c, 0xa8, 0xad, 0xf3, 0xa0, 0xf6, 0x9e, 0x07, 0xb5, 0x87, 0xdb, 0x99, 0x72, 0xf3, 0x34, 0x66,
+	0xc2, 0x2c, 0x78, 0xe1, 0xd5, 0x9d, 0x58, 0x0b, 0xce, 0x6e, 0x67, 0xc8, 0xae, 0xb8, 0x7b, 0x5a,
+	0x82, 0xfd, 0x7d, 0x8f, 0xf8, 0x5f, 0x51, 0xe3, 0xf5, 0xe7, 0x00, 0xfd, 0x6b, 0x89, 0x6a, 0xbd,
+	0x01, 0x00, 0x00,
+}
+
+func (m *IBCAttributes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCAttributes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundRecipient) > 0 {
+		i -= len(m.RefundRecipient)
+		copy(dAtA[i:], m.RefundRecipient)
+		i = encodeVarintIbc(dAtA, i, uint64(len(m.RefundRecipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TimeoutSeconds != 0 {
+		i = encodeVarintIbc(dAtA, i, uint64(m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintIbc(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintIbc(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIbc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIbc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IBCAttributes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovIbc(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovIbc(uint64(l))
+	}
+	if m.TimeoutSeconds != 0 {
+		n += 1 + sovIbc(uint64(m.TimeoutSeconds))
+	}
+	l = len(m.RefundRecipient)
+	if l > 0 {
+		n += 1 + l + sovIbc(uint64(l))
+	}
+	return n
+}
+
+func sovIbc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIbc(x uint64) (n int) {
+	return sovIbc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IBCAttributes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIbc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCAttributes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCAttributes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIbc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIbc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIbc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIbc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIbc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIbc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIbc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIbc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIbc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIbc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIbc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIbc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIbc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIbc = fmt.Errorf("proto: unexpected end of group")
+)
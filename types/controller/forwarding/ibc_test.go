@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forwarding_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	forwardingtypes "github.com/noble-assets/orbiter/v2/types/controller/forwarding"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestValidateIBCAttributes(t *testing.T) {
+	testutil.SetSDKConfig()
+
+	refundRecipient := testutil.NewNobleAddress()
+	maxTimeoutSeconds := uint64(forwardingtypes.MaxIBCTimeout / time.Second)
+
+	testCases := []struct {
+		name            string
+		timeoutSeconds  uint64
+		refundRecipient string
+		expErr          string
+	}{
+		{
+			name:            "success - default timeout",
+			refundRecipient: refundRecipient,
+		},
+		{
+			name:            "success - maximum timeout",
+			timeoutSeconds:  maxTimeoutSeconds,
+			refundRecipient: refundRecipient,
+		},
+		{
+			name:            "error - timeout exceeds the maximum",
+			timeoutSeconds:  maxTimeoutSeconds + 1,
+			refundRecipient: refundRecipient,
+			expErr:          "exceeds the maximum",
+		},
+		{
+			name:            "error - timeout overflowing the duration",
+			timeoutSeconds:  ^uint64(0),
+			refundRecipient: refundRecipient,
+			expErr:          "exceeds the maximum",
+		},
+		{
+			name:            "error - refund recipient is a module account",
+			refundRecipient: core.ModuleAddress.String(),
+			expErr:          "refund recipient cannot be an orbiter module account",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			attr, err := forwardingtypes.NewIBCAttributes(
+				"channel-1",
+				"cosmos1receiver",
+				tC.timeoutSeconds,
+				tC.refundRecipient,
+			)
+
+			if tC.expErr != "" {
+				require.ErrorContains(t, err, tC.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Positive(t, attr.Timeout())
+				require.LessOrEqual(t, attr.Timeout(), forwardingtypes.MaxIBCTimeout)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core
+
+import (
+	"bytes"
+)
+
+// PayloadEnvelopeMagic is the prefix identifying a binary encoded orbiter
+// payload.
+var PayloadEnvelopeMagic = []byte("ORBT")
+
+const (
+	// PayloadEnvelopeV1 is the envelope version whose body is the
+	// protobuf encoding of the PayloadWrapper type.
+	PayloadEnvelopeV1 uint8 = 1
+
+	// PayloadEnvelopeHeaderLen is the length in bytes of the envelope
+	// header, made of the magic prefix and the version byte.
+	PayloadEnvelopeHeaderLen = 5
+)
+
+// NewPayloadEnvelope returns the binary envelope for the payload wrapper,
+// using the latest envelope version.
+func NewPayloadEnvelope(pw *PayloadWrapper) ([]byte, error) {
+	if err := pw.Validate(); err != nil {
+		return nil, err
+	}
+
+	body, err := pw.Marshal()
+	if err != nil {
+		return nil, ErrParsingPayload.Wrapf("failed to marshal payload wrapper: %s", err.Error())
+	}
+
+	envelope := make([]byte, 0, PayloadEnvelopeHeaderLen+len(body))
+	envelope = append(envelope, PayloadEnvelopeMagic...)
+	envelope = append(envelope, PayloadEnvelopeV1)
+	envelope = append(envelope, body...)
+
+	return envelope, nil
+}
+
+// IsPayloadEnvelope returns true if the bytes start with the magic prefix
+// of the binary payload envelope.
+func IsPayloadEnvelope(bz []byte) bool {
+	return bytes.HasPrefix(bz, PayloadEnvelopeMagic)
+}
+
+// ParsePayloadEnvelope returns the version and the body of a binary payload
+// envelope. The body interpretation depends on the version, and it is up to
+// the caller to reject unsupported versions.
+func ParsePayloadEnvelope(bz []byte) (uint8, []byte, error) {
+	if !IsPayloadEnvelope(bz) {
+		return 0, nil, ErrParsingPayload.Wrap("payload envelope magic prefix not found")
+	}
+
+	if len(bz) < PayloadEnvelopeHeaderLen {
+		return 0, nil, ErrParsingPayload.Wrap("payload envelope version not found")
+	}
+
+	return bz[len(PayloadEnvelopeMagic)], bz[PayloadEnvelopeHeaderLen:], nil
+}
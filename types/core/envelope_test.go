@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package core_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/orbiter/v2/testutil"
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+func TestPayloadEnvelope(t *testing.T) {
+	payload, envelope := testutil.CreatePayloadEnvelope(t)
+
+	require.True(t, core.IsPayloadEnvelope(envelope))
+	require.False(t, core.IsPayloadEnvelope([]byte(testutil.CreateValidOrbiterPayload())))
+
+	version, body, err := core.ParsePayloadEnvelope(envelope)
+	require.NoError(t, err)
+	require.Equal(t, core.PayloadEnvelopeV1, version)
+
+	expBody, err := (&core.PayloadWrapper{Orbiter: payload}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, expBody, body)
+
+	_, err = core.NewPayloadEnvelope(nil)
+	require.ErrorContains(t, err, "payload wrapper is not set")
+
+	_, _, err = core.ParsePayloadEnvelope(core.PayloadEnvelopeMagic)
+	require.ErrorContains(t, err, "version not found")
+}
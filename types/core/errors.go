@@ -35,4 +35,16 @@ var (
 	ErrAlreadySet        = errorsmod.Register(ModuleName, 10, "value already set")
 	// ErrNoOrbiterPacket is a sentinel error used in the incoming IBC flow.
 	ErrNoOrbiterPacket = errorsmod.Register(ModuleName, 11, "packet is not for orbiter")
+	// ErrPaused is returned when a packet targets a paused protocol,
+	// cross-chain ID, or action.
+	ErrPaused = errorsmod.Register(ModuleName, 12, "route is paused")
+	// ErrAmountBelowMinimum is returned when a forwarding transfers less
+	// than the minimum amount set for its destination and denom.
+	ErrAmountBelowMinimum = errorsmod.Register(ModuleName, 13, "amount below the route minimum")
+	// ErrAmountAboveMaximum is returned when a forwarding transfers more
+	// than the maximum amount set for its destination and denom.
+	ErrAmountAboveMaximum = errorsmod.Register(ModuleName, 14, "amount above the route maximum")
+	// ErrDeadlineExceeded is returned when a payload is dispatched after
+	// the deadline defined by its actions.
+	ErrDeadlineExceeded = errorsmod.Register(ModuleName, 15, "deadline exceeded")
 )
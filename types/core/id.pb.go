@@ -36,18 +36,33 @@ const (
 	ACTION_FEE ActionID = 1
 	// ACTION_SWAP represents a token swap action.
 	ACTION_SWAP ActionID = 2
+	// ACTION_ROUTE_FEE represents the collection of the fee defined
+	// by the authority for a route.
+	ACTION_ROUTE_FEE ActionID = 3
+	// ACTION_INTEGRATOR_FEE represents the payment of the fee of
+	// a registered integrator.
+	ACTION_INTEGRATOR_FEE ActionID = 4
+	// ACTION_DEADLINE represents the rejection of the transfers
+	// dispatched after a block height or time.
+	ACTION_DEADLINE ActionID = 5
 )
 
 var ActionID_name = map[int32]string{
 	0: "ACTION_UNSUPPORTED",
 	1: "ACTION_FEE",
 	2: "ACTION_SWAP",
+	3: "ACTION_ROUTE_FEE",
+	4: "ACTION_INTEGRATOR_FEE",
+	5: "ACTION_DEADLINE",
 }
 
 var ActionID_value = map[string]int32{
-	"ACTION_UNSUPPORTED": 0,
-	"ACTION_FEE":         1,
-	"ACTION_SWAP":        2,
+	"ACTION_UNSUPPORTED":    0,
+	"ACTION_FEE":            1,
+	"ACTION_SWAP":           2,
+	"ACTION_ROUTE_FEE":      3,
+	"ACTION_INTEGRATOR_FEE": 4,
+	"ACTION_DEADLINE":       5,
 }
 
 func (x ActionID) String() string {
@@ -165,31 +180,33 @@ func init() {
 func init() { proto.RegisterFile("noble/orbiter/core/v1/id.proto", fileDescriptor_02f991dccbb42578) }
 
 var fileDescriptor_02f991dccbb42578 = []byte{
-	// 376 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0xcf, 0x2f, 0x4a, 0xca, 0x2c, 0x49, 0x2d, 0xd2, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f,
-	0x33, 0xd4, 0xcf, 0x4c, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x05, 0xcb, 0xeb, 0x41,
-	0xe5, 0xf5, 0x40, 0xf2, 0x7a, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x15, 0xfa,
-	0x20, 0x16, 0x44, 0xb1, 0x52, 0x23, 0x23, 0x17, 0x8f, 0x73, 0x51, 0x7e, 0x71, 0xb1, 0x73, 0x46,
-	0x62, 0x66, 0x9e, 0xa7, 0x8b, 0x90, 0x13, 0x17, 0x37, 0x58, 0x26, 0x39, 0x3f, 0x27, 0x3e, 0x33,
-	0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0xcf, 0x48, 0x51, 0x0f, 0xab, 0x99, 0x7a, 0x01, 0x50, 0x95,
-	0x9e, 0x2e, 0x41, 0x5c, 0x30, 0x5d, 0x9e, 0x29, 0x42, 0xea, 0x5c, 0xfc, 0xc9, 0xf9, 0xa5, 0x79,
-	0x25, 0xa9, 0x45, 0x05, 0x89, 0x45, 0x25, 0x95, 0x20, 0x73, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83,
-	0xf8, 0x90, 0x85, 0x3d, 0x53, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0xd0, 0xf2, 0xe4, 0xe2, 0x70,
-	0x4c, 0x2e, 0xc9, 0xcc, 0x07, 0x59, 0x2f, 0xc6, 0x25, 0xe4, 0xe8, 0x1c, 0xe2, 0xe9, 0xef, 0x17,
-	0x1f, 0xea, 0x17, 0x1c, 0x1a, 0x10, 0xe0, 0x1f, 0x14, 0xe2, 0xea, 0x22, 0xc0, 0x20, 0xc4, 0xc7,
-	0xc5, 0x05, 0x15, 0x77, 0x73, 0x75, 0x15, 0x60, 0x14, 0xe2, 0xe7, 0xe2, 0x86, 0xf2, 0x83, 0xc3,
-	0x1d, 0x03, 0x04, 0x98, 0xa4, 0x58, 0x3a, 0x16, 0xcb, 0x31, 0x68, 0x35, 0x30, 0x72, 0x71, 0x21,
-	0x1c, 0x25, 0x24, 0xc1, 0x25, 0x12, 0x10, 0xe4, 0x1f, 0xe2, 0xef, 0xec, 0xef, 0x83, 0x66, 0x9e,
-	0x00, 0x17, 0x0f, 0x5c, 0xc6, 0xd3, 0xc9, 0x59, 0x80, 0x51, 0x48, 0x90, 0x8b, 0x17, 0x2e, 0xe2,
-	0xec, 0x1c, 0x12, 0x20, 0xc0, 0x04, 0x72, 0x0c, 0x5c, 0xc8, 0x23, 0x32, 0xc0, 0x35, 0xc8, 0xc7,
-	0xd1, 0xcf, 0x55, 0x80, 0x59, 0x48, 0x94, 0x4b, 0x10, 0xa1, 0xd9, 0x2f, 0xc4, 0x35, 0xc8, 0xcf,
-	0xd1, 0x47, 0x80, 0x05, 0xe2, 0x04, 0x27, 0xf7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63,
-	0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96,
-	0x63, 0x88, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x87,
-	0xa7, 0x6e, 0x62, 0x71, 0x71, 0x6a, 0x49, 0x31, 0x3c, 0x2a, 0xcb, 0x8c, 0xf4, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0xc1, 0x71, 0x9a, 0xc4, 0x06, 0x0e, 0x51, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x58, 0xc3, 0xd5, 0xce, 0xf0, 0x01, 0x00, 0x00,
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x6f, 0xd4, 0x30,
+	0x14, 0xc7, 0xe3, 0x12, 0x10, 0xbc, 0x96, 0x3b, 0xd7, 0xdc, 0x55, 0xa5, 0x83, 0x29, 0x2c, 0x54,
+	0x95, 0x9a, 0xa8, 0x65, 0x63, 0xcb, 0x25, 0xa6, 0x58, 0x8a, 0x92, 0xc8, 0xf5, 0x09, 0xc1, 0x52,
+	0x5d, 0x7e, 0xe8, 0x1a, 0xa9, 0x9c, 0x4f, 0x89, 0x7b, 0x52, 0x37, 0xd8, 0xd8, 0x60, 0x64, 0x44,
+	0xe2, 0x9f, 0x61, 0xec, 0xc8, 0x88, 0xee, 0xfe, 0x11, 0x14, 0x37, 0xe4, 0x10, 0x62, 0xb3, 0xbf,
+	0x9f, 0xef, 0x7b, 0xfe, 0x5a, 0xef, 0x01, 0x9d, 0xa9, 0xf4, 0xb2, 0x70, 0x55, 0x95, 0x96, 0xba,
+	0xa8, 0xdc, 0x4c, 0x55, 0x85, 0xbb, 0x38, 0x76, 0xcb, 0xdc, 0x99, 0x57, 0x4a, 0x2b, 0x32, 0x34,
+	0xdc, 0x69, 0xb9, 0xd3, 0x70, 0x67, 0x71, 0xbc, 0x37, 0x98, 0xaa, 0xa9, 0x32, 0x0e, 0xb7, 0x39,
+	0xdd, 0x9a, 0x9f, 0x7d, 0x44, 0xb0, 0xe5, 0x57, 0xaa, 0xae, 0xfd, 0x8b, 0x49, 0x39, 0xe3, 0x01,
+	0x19, 0xc1, 0xa6, 0x21, 0x99, 0xba, 0x3c, 0x2f, 0xf3, 0x5d, 0xb4, 0x8f, 0x0e, 0x7a, 0x27, 0x4f,
+	0x9d, 0xff, 0xf6, 0x74, 0x92, 0xd6, 0xc9, 0x03, 0x01, 0x7f, 0xaa, 0x78, 0x4e, 0x9e, 0x43, 0x3f,
+	0x53, 0x57, 0x33, 0x5d, 0x54, 0xf3, 0x49, 0xa5, 0xaf, 0x9b, 0x3e, 0x1b, 0xfb, 0xe8, 0xe0, 0x81,
+	0xe8, 0xfd, 0x2d, 0xf3, 0xfc, 0xa5, 0xfd, 0xf5, 0xdb, 0x13, 0xeb, 0xf0, 0x33, 0x82, 0xfb, 0x5e,
+	0xa6, 0x4b, 0xd5, 0xbc, 0xbf, 0x03, 0xc4, 0xf3, 0x25, 0x8f, 0xa3, 0xf3, 0x71, 0x74, 0x36, 0x4e,
+	0x92, 0x58, 0x48, 0x16, 0x60, 0x8b, 0xf4, 0x00, 0x5a, 0xfd, 0x15, 0x63, 0x18, 0x91, 0x3e, 0x6c,
+	0xb6, 0xf7, 0xb3, 0x37, 0x5e, 0x82, 0x37, 0xc8, 0x00, 0x70, 0x2b, 0x88, 0x78, 0x2c, 0x99, 0xb1,
+	0xdd, 0x21, 0x8f, 0x61, 0xd8, 0xaa, 0x3c, 0x92, 0xec, 0x54, 0x78, 0x32, 0x16, 0x06, 0xd9, 0xe4,
+	0x11, 0xf4, 0x5b, 0x14, 0x30, 0x2f, 0x08, 0x79, 0xc4, 0xf0, 0xdd, 0x3d, 0xfb, 0xd3, 0x77, 0x6a,
+	0x1d, 0x7e, 0x40, 0x00, 0xeb, 0xbf, 0x91, 0x5d, 0x18, 0x24, 0x22, 0x96, 0xb1, 0x1f, 0x87, 0xff,
+	0xa4, 0xc2, 0xb0, 0xd5, 0x11, 0x3e, 0xf2, 0x31, 0x22, 0xdb, 0xf0, 0xb0, 0x53, 0x7c, 0x5f, 0x36,
+	0xc9, 0x76, 0x80, 0x74, 0xd2, 0xeb, 0xb7, 0x09, 0x13, 0xa1, 0x17, 0x35, 0xd9, 0x86, 0xb0, 0xbd,
+	0x2e, 0x8e, 0x24, 0x13, 0x91, 0x17, 0x62, 0xfb, 0x36, 0xc2, 0xe8, 0xf4, 0xc7, 0x92, 0xa2, 0x9b,
+	0x25, 0x45, 0xbf, 0x96, 0x14, 0x7d, 0x59, 0x51, 0xeb, 0x66, 0x45, 0xad, 0x9f, 0x2b, 0x6a, 0xbd,
+	0x3b, 0x9a, 0x96, 0xfa, 0xe2, 0x2a, 0x75, 0x32, 0xf5, 0xde, 0x35, 0x63, 0x39, 0x9a, 0xd4, 0x75,
+	0xa1, 0xeb, 0x6e, 0x23, 0x16, 0x27, 0xae, 0xbe, 0x9e, 0x17, 0xb5, 0x59, 0x8d, 0xf4, 0x9e, 0x19,
+	0xcc, 0x8b, 0xdf, 0x03, 0x00, 0xe3, 0x1b, 0x61, 0x41, 0x37, 0x02, 0x00, 0x00,
 }
 
 func (m *CrossChainID) Marshal() (dAtA []byte, err error) {
@@ -21,6 +21,7 @@
 package core
 
 import (
+	"bytes"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -35,10 +36,51 @@ const (
 
 var (
 	ModuleAddress = authtypes.NewModuleAddress(ModuleName)
+	// PaddedModuleAddress is the module address left padded to 32 bytes, which
+	// is the address representation used by EVM based protocols like CCTP.
+	PaddedModuleAddress = leftPadAddress(ModuleAddress)
 
 	DustCollectorName = fmt.Sprintf("%s/%s", ModuleName, "dust_collector")
+	// EscrowName is the sub-account holding the funds of the packets
+	// waiting in the escrow queue.
+	EscrowName = fmt.Sprintf("%s/%s", ModuleName, "escrow")
+	// DeferredName is the sub-account holding the funds of the packets
+	// waiting in the deferred queue.
+	DeferredName = fmt.Sprintf("%s/%s", ModuleName, "deferred")
+	// BatchName is the sub-account holding the funds of the forwardings
+	// aggregated in the forwarding batches of the block.
+	BatchName = fmt.Sprintf("%s/%s", ModuleName, "batch")
+	// RefundsName is the sub-account holding the funds of the in-flight
+	// packets whose refund to the refund recipient failed.
+	RefundsName = fmt.Sprintf("%s/%s", ModuleName, "refunds")
 )
 
+// IsModuleAddress returns true if the address is the module
+// account or one of its sub-accounts.
+func IsModuleAddress(addr []byte) bool {
+	for _, name := range []string{
+		ModuleName,
+		DustCollectorName,
+		EscrowName,
+		DeferredName,
+		BatchName,
+		RefundsName,
+	} {
+		if bytes.Equal(addr, authtypes.NewModuleAddress(name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func leftPadAddress(addr []byte) []byte {
+	padded := make([]byte, 32)
+	copy(padded[32-len(addr):], addr)
+
+	return padded
+}
+
 // ====================================================================================================
 // Forwarding
 // ====================================================================================================.
@@ -47,15 +89,59 @@ const (
 	ForwardingControllerName = "forwarding_controller"
 
 	// Maps names.
-	PausedCrossChainsName = "paused_cross_chains"
-	PausedProtocolsName   = "paused_protocols"
+	PausedCrossChainsName   = "paused_cross_chains"
+	PausedProtocolsName     = "paused_protocols"
+	InFlightPacketsName     = "in_flight_packets"
+	ForwarderParamsName     = "forwarder_params"
+	EscrowedPacketsName     = "escrowed_packets"
+	EscrowedPacketsSeqName  = "escrowed_packets_sequence"
+	HypTokenRoutesName      = "hyperlane_token_routes"
+	DeferredPacketsName     = "deferred_packets"
+	DeferredPacketsSeqName  = "deferred_packets_sequence"
+	ForwardingBatchesName   = "forwarding_batches"
+	AmountLimitsName        = "amount_limits"
+	EscrowCursorName        = "escrow_cursor"
+	DeferredByHeightName    = "deferred_packets_by_height"
+	DeferredByTimeName      = "deferred_packets_by_time"
+	ReadyDeferredName       = "ready_deferred_packets"
+	DeferredCursorName      = "deferred_cursor"
+	HeldBatchEntriesName    = "held_batch_entries"
+	HeldBatchEntriesSeqName = "held_batch_entries_sequence"
+	HeldEscrowedPacketsName = "held_escrowed_packets"
 
 	MaxTargetCounterparties = 100
+	// MaxEscrowedPacketsPerBlock is the maximum number of escrowed
+	// packets processed in a single block.
+	MaxEscrowedPacketsPerBlock = 50
+	// MaxDeferredPacketsPerBlock is the maximum number of deferred
+	// packets executed in a single block.
+	MaxDeferredPacketsPerBlock = 50
 )
 
 var (
-	PausedCrossChainsPrefix = collections.NewPrefix(10)
-	PausedProtocolsPrefix   = collections.NewPrefix(11)
+	PausedCrossChainsPrefix  = collections.NewPrefix(10)
+	PausedProtocolsPrefix    = collections.NewPrefix(11)
+	InFlightPacketsPrefix    = collections.NewPrefix(12)
+	ForwarderParamsPrefix    = collections.NewPrefix(13)
+	EscrowedPacketsSeqPrefix = collections.NewPrefix(14)
+	EscrowedPacketsPrefix    = collections.NewPrefix(15)
+	HypTokenRoutesPrefix     = collections.NewPrefix(16)
+	DeferredPacketsSeqPrefix = collections.NewPrefix(17)
+	DeferredPacketsPrefix    = collections.NewPrefix(18)
+	ForwardingBatchesPrefix  = collections.NewPrefix(19)
+	// NOTE: the forwarder prefixes continue from 50 since
+	// the 10-19 range is exhausted.
+	AmountLimitsPrefix = collections.NewPrefix(50)
+	EscrowCursorPrefix = collections.NewPrefix(51)
+	// NOTE: the deferred packets indexes are used to iterate
+	// only the packets whose deferral is reached.
+	DeferredByHeightPrefix    = collections.NewPrefix(52)
+	DeferredByTimePrefix      = collections.NewPrefix(53)
+	ReadyDeferredPrefix       = collections.NewPrefix(54)
+	DeferredCursorPrefix      = collections.NewPrefix(55)
+	HeldBatchEntriesSeqPrefix = collections.NewPrefix(56)
+	HeldBatchEntriesPrefix    = collections.NewPrefix(57)
+	HeldEscrowedPacketsPrefix = collections.NewPrefix(58)
 )
 
 // ====================================================================================================
@@ -66,10 +152,20 @@ const (
 	ActionControllerName = "action_controller"
 
 	// Maps names.
-	PausedActionsName = "paused_actions"
+	PausedActionsName      = "paused_actions"
+	RouteFeesName          = "route_fees"
+	RouteFeesCollectedName = "route_fees_collected"
+	IntegratorsName        = "integrators"
+	IntegratorsStatsName   = "integrators_stats"
 )
 
-var PausedActionsPrefix = collections.NewPrefix(20)
+var (
+	PausedActionsPrefix      = collections.NewPrefix(20)
+	RouteFeesPrefix          = collections.NewPrefix(21)
+	RouteFeesCollectedPrefix = collections.NewPrefix(22)
+	IntegratorsPrefix        = collections.NewPrefix(23)
+	IntegratorsStatsPrefix   = collections.NewPrefix(24)
+)
 
 // ====================================================================================================
 // Dispatcher
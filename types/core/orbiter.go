@@ -23,6 +23,7 @@ package core
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -41,6 +42,9 @@ type TransferAttributes struct {
 	// Destination field have both setters and getters
 	// because they can be mutated by actions.
 	destinationCoin sdk.Coin
+	// Forwarding fees are the fees paid to the bridge protocols
+	// by the forwarding controllers.
+	forwardingFees sdk.Coins
 }
 
 // NewTransferAttributes returns a validated reference to a
@@ -167,6 +171,87 @@ func (a *TransferAttributes) SetDestinationDenom(denom string) {
 	a.destinationCoin.Denom = denom
 }
 
+func (a *TransferAttributes) ForwardingFees() sdk.Coins {
+	if a == nil {
+		return nil
+	}
+
+	return a.forwardingFees
+}
+
+// AddForwardingFee adds the input coin to the fees paid
+// to forward the transfer.
+//
+// CONTRACT: receiver should not be nil but we handle
+// nil defensively for robustness.
+func (a *TransferAttributes) AddForwardingFee(fee sdk.Coin) {
+	if a == nil || fee.Amount.IsNil() || !fee.IsPositive() {
+		return
+	}
+	a.forwardingFees = a.forwardingFees.Add(fee)
+}
+
+// Clone returns a copy of the transfer attributes which can be
+// mutated without affecting the receiver.
+func (a *TransferAttributes) Clone() *TransferAttributes {
+	if a == nil {
+		return nil
+	}
+
+	clone := *a
+	if a.forwardingFees != nil {
+		clone.forwardingFees = make(sdk.Coins, len(a.forwardingFees))
+		copy(clone.forwardingFees, a.forwardingFees)
+	}
+
+	return &clone
+}
+
+// Split returns the transfer attributes of the legs of a split forwarding,
+// one for each of the destination amounts. The source amount is split
+// proportionally to the destination amounts, assigning the rounding dust
+// to the leg at dustIndex.
+//
+// CONTRACT: the destination amounts must sum to the destination amount.
+func (a *TransferAttributes) Split(
+	amounts []math.Int,
+	dustIndex int,
+) ([]*TransferAttributes, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+	if dustIndex < 0 || dustIndex >= len(amounts) {
+		return nil, fmt.Errorf("invalid dust index %d for %d legs", dustIndex, len(amounts))
+	}
+
+	destAmount := a.DestinationAmount()
+	allocated := math.ZeroInt()
+	legs := make([]*TransferAttributes, len(amounts))
+
+	for i, amount := range amounts {
+		legs[i] = &TransferAttributes{
+			sourceID: a.sourceID,
+			sourceCoin: sdk.Coin{
+				Denom:  a.SourceDenom(),
+				Amount: a.SourceAmount().Mul(amount).Quo(destAmount),
+			},
+			destinationCoin: sdk.Coin{Denom: a.DestinationDenom(), Amount: amount},
+		}
+		allocated = allocated.Add(legs[i].sourceCoin.Amount)
+	}
+
+	dust := a.SourceAmount().Sub(allocated)
+	legs[dustIndex].sourceCoin.Amount = legs[dustIndex].sourceCoin.Amount.Add(dust)
+
+	for i, leg := range legs {
+		if err := leg.Validate(); err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid transfer attributes for leg %d", i)
+		}
+	}
+
+	return legs, nil
+}
+
 // ====================================================================================================
 // Action
 // ====================================================================================================
@@ -277,9 +362,8 @@ var _ cdctypes.UnpackInterfacesMessage = &Forwarding{}
 
 // NewForwarding returns a reference to a validated forwarding. The
 // function automatically sets the attributes in the Any type of the
-// return instance.
-//
-// NOTE: passthroughPayload is currently ignored.
+// return instance. The passthrough payload is delivered to the
+// destination by the forwarding controller of the protocol.
 func NewForwarding(
 	id ProtocolID,
 	a ForwardingAttributes,
@@ -308,10 +392,46 @@ func (f *Forwarding) Validate() error {
 	if f.Attributes == nil {
 		return ErrNilPointer.Wrap("forwarding attributes are not set")
 	}
+	if f.DeferredUntil != nil {
+		if err := f.DeferredUntil.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid deferral")
+		}
+	}
+	if f.Batch {
+		if err := f.validateBatch(); err != nil {
+			return errorsmod.Wrap(err, "invalid batched forwarding")
+		}
+	}
+
+	return nil
+}
+
+// validateBatch returns an error if the forwarding
+// cannot be aggregated in a forwarding batch.
+func (f *Forwarding) validateBatch() error {
+	if f.ProtocolId != PROTOCOL_CCTP && f.ProtocolId != PROTOCOL_HYPERLANE {
+		return fmt.Errorf("batching is not supported for protocol %s", f.ProtocolId)
+	}
+	if len(f.PassthroughPayload) > 0 {
+		return errors.New("batched forwardings cannot have a passthrough payload")
+	}
+	if f.DeferredUntil != nil {
+		return errors.New("batched forwardings cannot be deferred")
+	}
 
 	return nil
 }
 
+// IsDeferred returns true if the forwarding execution is deferred
+// after the given block height and time.
+func (f *Forwarding) IsDeferred(height int64, t time.Time) bool {
+	if f == nil || f.DeferredUntil == nil {
+		return false
+	}
+
+	return !f.DeferredUntil.IsReached(height, t)
+}
+
 // ProtocolID returns the protocol ID associated with the forwarding. If
 // the id is not set, the default value is returned.
 func (f *Forwarding) ProtocolID() ProtocolID {
@@ -380,10 +500,209 @@ func (f *Forwarding) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(f.Attributes, &attributes)
 }
 
+// ====================================================================================================
+// Deferral
+// ====================================================================================================
+
+// Validate returns an error if the deferral specifies
+// neither a block height nor a block time.
+func (d *Deferral) Validate() error {
+	if d == nil {
+		return ErrNilPointer.Wrap("deferral is not set")
+	}
+	if d.Height == 0 && (d.Time == nil || d.Time.IsZero()) {
+		return errors.New("deferral must specify a block height or a block time")
+	}
+
+	return nil
+}
+
+// IsReached returns true if the given block height and time
+// reached all the conditions specified by the deferral.
+func (d *Deferral) IsReached(height int64, t time.Time) bool {
+	if d == nil {
+		return true
+	}
+	if d.Height > 0 && (height < 0 || uint64(height) < d.Height) {
+		return false
+	}
+	if d.Time != nil && t.Before(*d.Time) {
+		return false
+	}
+
+	return true
+}
+
+// ====================================================================================================
+// Forwarding leg
+// ====================================================================================================
+
+const (
+	// MaxForwardingLegs is the maximum number of legs a payload can split a transfer into.
+	MaxForwardingLegs = 8
+	// BPSNormalizer is used to normalize the basis points of the forwarding legs.
+	BPSNormalizer = 10_000
+)
+
+// NewForwardingLeg returns a reference to a validated forwarding leg. Exactly
+// one of the basis points, the fixed amount, and the remainder flag must be set.
+func NewForwardingLeg(
+	forwarding *Forwarding,
+	bps uint32,
+	amount math.Int,
+	remainder bool,
+) (*ForwardingLeg, error) {
+	l := ForwardingLeg{
+		Forwarding: forwarding,
+		Bps:        bps,
+		Amount:     amount,
+		Remainder:  remainder,
+	}
+
+	return &l, l.Validate()
+}
+
+// Validate returns an error if the forwarding leg is not valid.
+func (l *ForwardingLeg) Validate() error {
+	if l == nil {
+		return ErrNilPointer.Wrap("forwarding leg is not set")
+	}
+	if err := l.Forwarding.Validate(); err != nil {
+		return err
+	}
+
+	shares := 0
+	if l.Bps != 0 {
+		if l.Bps > BPSNormalizer {
+			return fmt.Errorf("basis points cannot be higher than %d", BPSNormalizer)
+		}
+		shares++
+	}
+	if l.HasFixedAmount() {
+		if l.Amount.IsNegative() {
+			return errors.New("amount cannot be negative")
+		}
+		shares++
+	}
+	if l.Remainder {
+		shares++
+	}
+	if shares != 1 {
+		return errors.New("forwarding leg must specify exactly one of bps, amount, or remainder")
+	}
+
+	return nil
+}
+
+// HasFixedAmount returns true if the leg routes a fixed amount.
+func (l *ForwardingLeg) HasFixedAmount() bool {
+	return !l.Amount.IsNil() && !l.Amount.IsZero()
+}
+
+// UnpackInterfaces is the method required to correctly unpack
+// the leg forwarding attributes.
+func (l *ForwardingLeg) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if l == nil {
+		return ErrNilPointer.Wrap("forwarding leg is not set")
+	}
+
+	return l.Forwarding.UnpackInterfaces(unpacker)
+}
+
+// ValidateForwardingLegs returns an error if the legs cannot be used to
+// split a transfer. Fixed amount legs require a remainder leg, while the
+// basis points of the legs must sum to 100% when no remainder leg is set.
+func ValidateForwardingLegs(legs []*ForwardingLeg) error {
+	if len(legs) < 2 {
+		return errors.New("a split forwarding requires at least 2 legs")
+	}
+	if len(legs) > MaxForwardingLegs {
+		return fmt.Errorf("a split forwarding cannot have more than %d legs", MaxForwardingLegs)
+	}
+
+	var remainders, fixed, totalBPS uint32
+	for i, leg := range legs {
+		if err := leg.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid forwarding leg %d", i)
+		}
+
+		totalBPS += leg.Bps
+		if leg.HasFixedAmount() {
+			fixed++
+		}
+		if leg.Remainder {
+			remainders++
+		}
+	}
+
+	if remainders > 1 {
+		return errors.New("a split forwarding can have only one remainder leg")
+	}
+	if totalBPS > BPSNormalizer {
+		return fmt.Errorf("basis points of the legs cannot sum to more than %d", BPSNormalizer)
+	}
+	if remainders == 0 {
+		if fixed != 0 {
+			return errors.New("fixed amount legs require a remainder leg")
+		}
+		if totalBPS != BPSNormalizer {
+			return fmt.Errorf(
+				"basis points of the legs must sum to %d without a remainder leg",
+				BPSNormalizer,
+			)
+		}
+	}
+
+	return nil
+}
+
+// SplitAmount returns the amount routed by each of the legs and the index
+// of the leg receiving the rounding dust. The dust is assigned to the
+// remainder leg when set, and to the first leg otherwise. Returns an error
+// if the legs exceed the total or if any leg amount is not positive.
+func SplitAmount(total math.Int, legs []*ForwardingLeg) ([]math.Int, int, error) {
+	amounts := make([]math.Int, len(legs))
+	dustIndex := 0
+	allocated := math.ZeroInt()
+
+	for i, leg := range legs {
+		switch {
+		case leg.Remainder:
+			amounts[i] = math.ZeroInt()
+			dustIndex = i
+		case leg.Bps != 0:
+			amounts[i] = total.MulRaw(int64(leg.Bps)).QuoRaw(BPSNormalizer)
+		default:
+			amounts[i] = leg.Amount
+		}
+		allocated = allocated.Add(amounts[i])
+	}
+
+	if allocated.GT(total) {
+		return nil, 0, fmt.Errorf(
+			"legs amount %s exceeds the transfer amount %s",
+			allocated,
+			total,
+		)
+	}
+	amounts[dustIndex] = amounts[dustIndex].Add(total.Sub(allocated))
+
+	for i, a := range amounts {
+		if !a.IsPositive() {
+			return nil, 0, fmt.Errorf("forwarding leg %d amount must be positive", i)
+		}
+	}
+
+	return amounts, dustIndex, nil
+}
+
 // ====================================================================================================
 // Payload
 // ====================================================================================================
 
+// MaxFallbackForwardings is the maximum number of fallback forwardings a payload can define.
+const MaxFallbackForwardings = 4
+
 // NewPayload returns a validated instance reference of
 // an orbiter payload. Empty preActions slice is normalized to nil.
 func NewPayload(
@@ -415,6 +734,11 @@ func (p *Payload) Validate() error {
 			return fmt.Errorf("received repeated action ID: %v", action.ID())
 		}
 		visitedIDs[int32(action.Id)] = nil
+		// NOTE: the route fee action is only built by the module
+		// from the route fees defined by the authority.
+		if action.ID() == ACTION_ROUTE_FEE {
+			return fmt.Errorf("action ID %s cannot be set in the payload", action.ID())
+		}
 	}
 
 	for _, action := range p.PreActions {
@@ -423,7 +747,113 @@ func (p *Payload) Validate() error {
 		}
 	}
 
-	return p.Forwarding.Validate()
+	if p.FallbackRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(p.FallbackRecipient); err != nil {
+			return errorsmod.Wrap(err, "invalid fallback recipient address")
+		}
+	}
+
+	if err := p.validateFallbackForwardings(); err != nil {
+		return err
+	}
+
+	if err := p.validateHeldForwardings(); err != nil {
+		return err
+	}
+
+	if len(p.ForwardingLegs) == 0 {
+		return p.Forwarding.Validate()
+	}
+
+	if p.Forwarding != nil {
+		return errors.New("forwarding and forwarding legs cannot be set together")
+	}
+
+	return ValidateForwardingLegs(p.ForwardingLegs)
+}
+
+// validateFallbackForwardings returns an error if the fallback
+// forwardings are not valid or are combined with forwarding legs.
+func (p *Payload) validateFallbackForwardings() error {
+	if len(p.FallbackForwardings) == 0 {
+		return nil
+	}
+
+	if len(p.ForwardingLegs) > 0 {
+		return errors.New("fallback forwardings and forwarding legs cannot be set together")
+	}
+
+	if len(p.FallbackForwardings) > MaxFallbackForwardings {
+		return fmt.Errorf(
+			"a payload cannot have more than %d fallback forwardings",
+			MaxFallbackForwardings,
+		)
+	}
+
+	for i, f := range p.FallbackForwardings {
+		if err := f.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid fallback forwarding %d", i)
+		}
+	}
+
+	return nil
+}
+
+// validateHeldForwardings returns an error if the payload defers or batches
+// a forwarding without a fallback recipient or together with fallback
+// forwardings. These forwardings are held by the module and executed in a
+// later block or at the end of the block.
+func (p *Payload) validateHeldForwardings() error {
+	for _, f := range p.Forwardings() {
+		if f == nil {
+			continue
+		}
+
+		var kind string
+		switch {
+		case f.DeferredUntil != nil:
+			kind = "deferred"
+		case f.Batch:
+			kind = "batched"
+		default:
+			continue
+		}
+
+		if p.FallbackRecipient == "" {
+			return fmt.Errorf("%s forwardings require a fallback recipient", kind)
+		}
+		if len(p.FallbackForwardings) > 0 {
+			return fmt.Errorf("%s forwardings cannot be used with fallback forwardings", kind)
+		}
+	}
+
+	return nil
+}
+
+// Forwardings returns the forwardings of the payload. These are the
+// forwardings of the legs when the payload splits the transfer, or
+// the payload forwarding followed by its fallback forwardings otherwise.
+func (p *Payload) Forwardings() []*Forwarding {
+	if p == nil {
+		return nil
+	}
+
+	if len(p.ForwardingLegs) == 0 {
+		if p.Forwarding == nil {
+			return nil
+		}
+
+		return append([]*Forwarding{p.Forwarding}, p.FallbackForwardings...)
+	}
+
+	forwardings := make([]*Forwarding, 0, len(p.ForwardingLegs))
+	for _, leg := range p.ForwardingLegs {
+		if leg != nil && leg.Forwarding != nil {
+			forwardings = append(forwardings, leg.Forwarding)
+		}
+	}
+
+	return forwardings
 }
 
 var _ cdctypes.UnpackInterfacesMessage = &Payload{}
@@ -449,9 +879,43 @@ func (p *Payload) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 		}
 	}
 
+	for _, l := range p.ForwardingLegs {
+		if l != nil {
+			if err := l.UnpackInterfaces(unpacker); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, f := range p.FallbackForwardings {
+		if f != nil {
+			if err := f.UnpackInterfaces(unpacker); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// NewSplitPayload returns a validated instance reference of an
+// orbiter payload splitting the transfer across the forwarding legs.
+func NewSplitPayload(
+	legs []*ForwardingLeg,
+	preActions ...*Action,
+) (*Payload, error) {
+	if len(preActions) == 0 {
+		preActions = nil
+	}
+
+	payload := Payload{
+		ForwardingLegs: legs,
+		PreActions:     preActions,
+	}
+
+	return &payload, payload.Validate()
+}
+
 // NewPayloadWrapper returns a validated instance reference
 // to a payload wrapper.
 func NewPayloadWrapper(
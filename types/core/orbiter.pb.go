@@ -4,20 +4,26 @@
 package core
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -88,6 +94,13 @@ type Forwarding struct {
 	// to the destination chain. This field is not interpreted by
 	// the orbiter module.
 	PassthroughPayload []byte `protobuf:"bytes,3,opt,name=passthrough_payload,json=passthroughPayload,proto3" json:"passthrough_payload,omitempty"`
+	// deferred_until optionally holds the forwarding on Noble until the
+	// specified block height and time are reached.
+	DeferredUntil *Deferral `protobuf:"bytes,4,opt,name=deferred_until,json=deferredUntil,proto3" json:"deferred_until,omitempty"`
+	// batch opts in to aggregate the forwarding with the forwardings of the
+	// same block having identical protocol, attributes, and denom. The
+	// aggregated amount is sent with a single transfer at the end of the block.
+	Batch bool `protobuf:"varint,5,opt,name=batch,proto3" json:"batch,omitempty"`
 }
 
 func (m *Forwarding) Reset()         { *m = Forwarding{} }
@@ -123,6 +136,132 @@ func (m *Forwarding) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Forwarding proto.InternalMessageInfo
 
+// Deferral defines the block height and time from which a deferred
+// forwarding can be executed. When both are set, the forwarding is
+// executed once both are reached.
+type Deferral struct {
+	// height is the block height from which the forwarding can be executed.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time from which the forwarding can be executed.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *Deferral) Reset()         { *m = Deferral{} }
+func (m *Deferral) String() string { return proto.CompactTextString(m) }
+func (*Deferral) ProtoMessage()    {}
+func (*Deferral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24aab38bf890c9f2, []int{2}
+}
+func (m *Deferral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deferral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deferral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Deferral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deferral.Merge(m, src)
+}
+func (m *Deferral) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deferral) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deferral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deferral proto.InternalMessageInfo
+
+func (m *Deferral) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Deferral) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// ForwardingLeg represents a share of the transferred amount routed
+// through its own forwarding when a payload splits the transfer.
+type ForwardingLeg struct {
+	// forwarding contains the required information to route the leg amount.
+	Forwarding *Forwarding `protobuf:"bytes,1,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// bps is the share of the amount routed by the leg, expressed
+	// in basis points.
+	Bps uint32 `protobuf:"varint,2,opt,name=bps,proto3" json:"bps,omitempty"`
+	// amount is the fixed amount routed by the leg.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// remainder specifies if the leg routes the amount left after
+	// all the other legs, including the rounding dust.
+	Remainder bool `protobuf:"varint,4,opt,name=remainder,proto3" json:"remainder,omitempty"`
+}
+
+func (m *ForwardingLeg) Reset()         { *m = ForwardingLeg{} }
+func (m *ForwardingLeg) String() string { return proto.CompactTextString(m) }
+func (*ForwardingLeg) ProtoMessage()    {}
+func (*ForwardingLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24aab38bf890c9f2, []int{3}
+}
+func (m *ForwardingLeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardingLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardingLeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardingLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingLeg.Merge(m, src)
+}
+func (m *ForwardingLeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardingLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingLeg proto.InternalMessageInfo
+
+func (m *ForwardingLeg) GetForwarding() *Forwarding {
+	if m != nil {
+		return m.Forwarding
+	}
+	return nil
+}
+
+func (m *ForwardingLeg) GetBps() uint32 {
+	if m != nil {
+		return m.Bps
+	}
+	return 0
+}
+
+func (m *ForwardingLeg) GetRemainder() bool {
+	if m != nil {
+		return m.Remainder
+	}
+	return false
+}
+
 // Payload represents the data the orbiter module
 // requires to handle cross-chain packets.
 type Payload struct {
@@ -132,13 +271,23 @@ type Payload struct {
 	// forwarding contains the required information to complete a cross-chain
 	// routing through an orbiter-supported protocol.
 	Forwarding *Forwarding `protobuf:"bytes,2,opt,name=forwarding,proto3" json:"forwarding,omitempty"`
+	// fallback_recipient is an optional Noble address receiving the
+	// transferred funds when the payload dispatch fails.
+	FallbackRecipient string `protobuf:"bytes,3,opt,name=fallback_recipient,json=fallbackRecipient,proto3" json:"fallback_recipient,omitempty"`
+	// forwarding_legs split the transferred amount across multiple
+	// forwardings. It cannot be used together with forwarding.
+	ForwardingLegs []*ForwardingLeg `protobuf:"bytes,4,rep,name=forwarding_legs,json=forwardingLegs,proto3" json:"forwarding_legs,omitempty"`
+	// fallback_forwardings are alternative forwardings tried in order
+	// when the forwarding fails. It cannot be used together with
+	// forwarding_legs.
+	FallbackForwardings []*Forwarding `protobuf:"bytes,5,rep,name=fallback_forwardings,json=fallbackForwardings,proto3" json:"fallback_forwardings,omitempty"`
 }
 
 func (m *Payload) Reset()         { *m = Payload{} }
 func (m *Payload) String() string { return proto.CompactTextString(m) }
 func (*Payload) ProtoMessage()    {}
 func (*Payload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24aab38bf890c9f2, []int{2}
+	return fileDescriptor_24aab38bf890c9f2, []int{4}
 }
 func (m *Payload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -181,6 +330,27 @@ func (m *Payload) GetForwarding() *Forwarding {
 	return nil
 }
 
+func (m *Payload) GetFallbackRecipient() string {
+	if m != nil {
+		return m.FallbackRecipient
+	}
+	return ""
+}
+
+func (m *Payload) GetForwardingLegs() []*ForwardingLeg {
+	if m != nil {
+		return m.ForwardingLegs
+	}
+	return nil
+}
+
+func (m *Payload) GetFallbackForwardings() []*Forwarding {
+	if m != nil {
+		return m.FallbackForwardings
+	}
+	return nil
+}
+
 // PayloadWrapper defines the expected JSON structure the module expect when receiving
 // the payload from protocols encoding metadata as string. This wrapper is used to
 // easily identify if the metadata containing the payload is correctly defined.
@@ -194,7 +364,7 @@ func (m *PayloadWrapper) Reset()         { *m = PayloadWrapper{} }
 func (m *PayloadWrapper) String() string { return proto.CompactTextString(m) }
 func (*PayloadWrapper) ProtoMessage()    {}
 func (*PayloadWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24aab38bf890c9f2, []int{3}
+	return fileDescriptor_24aab38bf890c9f2, []int{5}
 }
 func (m *PayloadWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,6 +403,8 @@ func (m *PayloadWrapper) GetOrbiter() *Payload {
 func init() {
 	proto.RegisterType((*Action)(nil), "noble.orbiter.core.v1.Action")
 	proto.RegisterType((*Forwarding)(nil), "noble.orbiter.core.v1.Forwarding")
+	proto.RegisterType((*Deferral)(nil), "noble.orbiter.core.v1.Deferral")
+	proto.RegisterType((*ForwardingLeg)(nil), "noble.orbiter.core.v1.ForwardingLeg")
 	proto.RegisterType((*Payload)(nil), "noble.orbiter.core.v1.Payload")
 	proto.RegisterType((*PayloadWrapper)(nil), "noble.orbiter.core.v1.PayloadWrapper")
 }
@@ -242,36 +414,54 @@ func init() {
 }
 
 var fileDescriptor_24aab38bf890c9f2 = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x6e, 0x13, 0x31,
-	0x18, 0xc7, 0xe3, 0x14, 0xb5, 0x92, 0x83, 0x3a, 0x98, 0x22, 0xa5, 0x95, 0xb8, 0xa6, 0x41, 0x95,
-	0xc2, 0x10, 0x5b, 0x3d, 0x16, 0xc4, 0x80, 0x94, 0xa8, 0x02, 0x85, 0xa9, 0xba, 0x05, 0x09, 0x86,
-	0x93, 0xef, 0xce, 0xbd, 0x58, 0x4a, 0xef, 0x3b, 0xd9, 0x4e, 0x50, 0xde, 0x80, 0x81, 0x81, 0x07,
-	0x60, 0xe0, 0x21, 0xfa, 0x10, 0xa8, 0x53, 0x47, 0x46, 0x94, 0x2c, 0x3c, 0x06, 0x8a, 0xed, 0xbb,
-	0x04, 0x91, 0x20, 0x36, 0xfb, 0xfb, 0xfe, 0xdf, 0x5f, 0xbf, 0xff, 0x67, 0xe3, 0xa7, 0x05, 0x24,
-	0x13, 0xc1, 0x40, 0x25, 0xd2, 0x08, 0xc5, 0x52, 0x50, 0x82, 0xcd, 0x2e, 0xaa, 0x3b, 0x2d, 0x15,
-	0x18, 0x20, 0x8f, 0xad, 0x88, 0x56, 0xc5, 0x95, 0x88, 0xce, 0x2e, 0x4e, 0x8e, 0x53, 0xd0, 0x37,
-	0xa0, 0x63, 0x2b, 0x62, 0xee, 0xe2, 0x26, 0x4e, 0x8e, 0x72, 0xc8, 0xc1, 0xd5, 0x57, 0x27, 0x5f,
-	0x3d, 0xce, 0x01, 0xf2, 0x89, 0x60, 0xf6, 0x96, 0x4c, 0xaf, 0x19, 0x2f, 0xe6, 0xbe, 0x15, 0x6c,
-	0xe7, 0x90, 0x99, 0xeb, 0x77, 0xbf, 0x22, 0xbc, 0x3f, 0x48, 0x8d, 0x84, 0x82, 0x30, 0xdc, 0x94,
-	0x59, 0x1b, 0x75, 0x50, 0xef, 0x30, 0x3c, 0xa5, 0x5b, 0xd1, 0xa8, 0x93, 0x8e, 0x2e, 0xa3, 0xa6,
-	0xcc, 0xc8, 0x07, 0x8c, 0xb9, 0x31, 0x4a, 0x26, 0x53, 0x23, 0x74, 0xbb, 0xd9, 0x41, 0xbd, 0x56,
-	0x78, 0x44, 0x1d, 0x0b, 0xad, 0x58, 0xe8, 0xa0, 0x98, 0x0f, 0xcf, 0xef, 0x6e, 0xfb, 0x67, 0x7f,
-	0x3a, 0xd6, 0x66, 0x83, 0xda, 0x22, 0xda, 0xb0, 0x7b, 0xf9, 0xe0, 0xd3, 0xb7, 0xd3, 0x46, 0xf7,
-	0x17, 0xc2, 0xf8, 0x35, 0xa8, 0x8f, 0x5c, 0x65, 0xb2, 0xc8, 0xc9, 0x10, 0xb7, 0xac, 0x6f, 0x0a,
-	0x93, 0xb8, 0x66, 0x3d, 0xdb, 0xc1, 0x7a, 0xe5, 0x95, 0xa3, 0xcb, 0x08, 0x57, 0x53, 0xa3, 0x8c,
-	0xc4, 0xff, 0x4d, 0xfd, 0xec, 0xee, 0xb6, 0x7f, 0xfe, 0x17, 0xf5, 0x1a, 0x67, 0x3b, 0x39, 0x61,
-	0xf8, 0x51, 0xc9, 0xb5, 0x36, 0x63, 0x05, 0xd3, 0x7c, 0x1c, 0x97, 0x7c, 0x3e, 0x01, 0x9e, 0xb5,
-	0xf7, 0x3a, 0xa8, 0xf7, 0x30, 0x22, 0x1b, 0xad, 0x2b, 0xd7, 0xf1, 0x51, 0x3f, 0x23, 0x7c, 0xe0,
-	0x2b, 0xe4, 0xd5, 0x2a, 0xa7, 0x88, 0xb9, 0x5d, 0x90, 0x6e, 0xa3, 0xce, 0x5e, 0xaf, 0x15, 0x3e,
-	0xf9, 0xe7, 0x9b, 0xac, 0x32, 0x0a, 0x77, 0xd4, 0x64, 0x80, 0xf1, 0x75, 0x8d, 0xe9, 0x33, 0xee,
-	0x5a, 0xd3, 0x3a, 0x4f, 0xb4, 0x31, 0xd4, 0x7d, 0x8b, 0x0f, 0x3d, 0xcd, 0x3b, 0xc5, 0xcb, 0x52,
-	0x28, 0xf2, 0x02, 0x1f, 0xf8, 0x59, 0xbb, 0xf8, 0x56, 0x18, 0xec, 0x5a, 0xbc, 0x9b, 0x8b, 0x2a,
-	0xf9, 0xf0, 0xcd, 0xf7, 0x45, 0x80, 0xee, 0x17, 0x01, 0xfa, 0xb9, 0x08, 0xd0, 0x97, 0x65, 0xd0,
-	0xb8, 0x5f, 0x06, 0x8d, 0x1f, 0xcb, 0xa0, 0xf1, 0xbe, 0x9f, 0x4b, 0x33, 0x9e, 0x26, 0x34, 0x85,
-	0x1b, 0x66, 0xcd, 0xfa, 0x5c, 0x6b, 0x61, 0x74, 0xfd, 0x61, 0x67, 0x21, 0x33, 0xf3, 0x52, 0x68,
-	0xfb, 0x73, 0x93, 0x7d, 0xfb, 0x3e, 0xcf, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x79, 0x8f,
-	0x23, 0x5e, 0x03, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xdb, 0x36,
+	0x18, 0xb5, 0x6c, 0xc7, 0x49, 0xe8, 0xc5, 0x5b, 0x18, 0x67, 0xd0, 0x82, 0x4d, 0x76, 0xbc, 0x05,
+	0xf0, 0x06, 0x58, 0x5a, 0xbc, 0x1d, 0x86, 0x1d, 0x06, 0xd8, 0x08, 0x92, 0x79, 0xc8, 0x80, 0x40,
+	0xcb, 0xb0, 0x61, 0x3b, 0x08, 0x94, 0x44, 0xcb, 0x44, 0x24, 0x51, 0x20, 0xe9, 0x14, 0xfe, 0x07,
+	0x3d, 0xe6, 0x07, 0xf4, 0xd0, 0x63, 0x8f, 0x3d, 0xf8, 0x37, 0x14, 0x41, 0xd0, 0x43, 0x90, 0x53,
+	0xd1, 0x43, 0x5a, 0x24, 0x87, 0xfe, 0x87, 0x9e, 0x0a, 0x51, 0x92, 0xed, 0x26, 0x71, 0x1b, 0xf4,
+	0x62, 0xf0, 0xe3, 0xf7, 0xde, 0xf3, 0xe3, 0xe3, 0x47, 0x81, 0x6f, 0x43, 0x6a, 0xfb, 0xd8, 0xa0,
+	0xcc, 0x26, 0x02, 0x33, 0xc3, 0xa1, 0x0c, 0x1b, 0xc7, 0xdb, 0x59, 0xad, 0x47, 0x8c, 0x0a, 0x0a,
+	0xd7, 0x25, 0x48, 0xcf, 0x36, 0x63, 0x90, 0x7e, 0xbc, 0xbd, 0xb1, 0x8a, 0x02, 0x12, 0x52, 0x43,
+	0xfe, 0x26, 0xc8, 0x8d, 0xaf, 0x1c, 0xca, 0x03, 0xca, 0x2d, 0x59, 0x19, 0x49, 0x91, 0xb6, 0xaa,
+	0x1e, 0xf5, 0x68, 0xb2, 0x1f, 0xaf, 0x32, 0x82, 0x47, 0xa9, 0xe7, 0x63, 0x43, 0x56, 0xf6, 0xb0,
+	0x6f, 0xa0, 0x70, 0x94, 0xb6, 0x6a, 0x37, 0x5b, 0x82, 0x04, 0x98, 0x0b, 0x14, 0x44, 0x29, 0x40,
+	0xbb, 0xdb, 0x3b, 0x71, 0x93, 0x7e, 0xe3, 0x91, 0x02, 0x4a, 0x1d, 0x47, 0x10, 0x1a, 0x42, 0x03,
+	0xe4, 0x89, 0xab, 0x2a, 0x75, 0xa5, 0x59, 0x69, 0xd7, 0xf4, 0x3b, 0x8f, 0xa3, 0x27, 0xd0, 0xde,
+	0x8e, 0x99, 0x27, 0x2e, 0xfc, 0x1f, 0x00, 0x24, 0x04, 0x23, 0xf6, 0x50, 0x60, 0xae, 0xe6, 0xeb,
+	0x4a, 0xb3, 0xdc, 0xae, 0xea, 0x89, 0x23, 0x3d, 0x73, 0xa4, 0x77, 0xc2, 0x51, 0x77, 0xeb, 0x6c,
+	0xdc, 0xda, 0x7c, 0x5f, 0x71, 0x22, 0xd6, 0x99, 0x48, 0x98, 0x33, 0x72, 0xbf, 0x16, 0x1f, 0x3e,
+	0xae, 0xe5, 0x1a, 0xcf, 0xf2, 0x00, 0xec, 0x52, 0xf6, 0x00, 0x31, 0x97, 0x84, 0x1e, 0xec, 0x82,
+	0xb2, 0xd4, 0x75, 0xa8, 0x6f, 0x4d, 0xbc, 0x6e, 0xce, 0xf1, 0x7a, 0x90, 0x22, 0x7b, 0x3b, 0x26,
+	0xc8, 0x58, 0x3d, 0x17, 0x5a, 0xf7, 0x76, 0xfd, 0xfd, 0xd9, 0xb8, 0xb5, 0x75, 0xcb, 0xf5, 0xd4,
+	0xce, 0xdd, 0xce, 0xa1, 0x01, 0xd6, 0x22, 0xc4, 0xb9, 0x18, 0x30, 0x3a, 0xf4, 0x06, 0x56, 0x84,
+	0x46, 0x3e, 0x45, 0xae, 0x5a, 0xa8, 0x2b, 0xcd, 0xcf, 0x4c, 0x38, 0xd3, 0x3a, 0x48, 0x3a, 0x70,
+	0x17, 0x54, 0x5c, 0xdc, 0xc7, 0x8c, 0x61, 0xd7, 0x1a, 0x86, 0x82, 0xf8, 0x6a, 0x51, 0xba, 0x9a,
+	0x77, 0x09, 0x3b, 0x12, 0x8c, 0x7c, 0x73, 0x25, 0xa3, 0xfd, 0x1d, 0xb3, 0x60, 0x15, 0x2c, 0xd8,
+	0x48, 0x38, 0x03, 0x75, 0xa1, 0xae, 0x34, 0x97, 0xcc, 0xa4, 0x48, 0x83, 0xfc, 0x17, 0x2c, 0x65,
+	0x34, 0xf8, 0x25, 0x28, 0x0d, 0x30, 0xf1, 0x06, 0x42, 0x06, 0x58, 0x34, 0xd3, 0x0a, 0xfe, 0x0c,
+	0x8a, 0xf1, 0xf8, 0xa4, 0x99, 0x6c, 0xdc, 0xca, 0xe4, 0x30, 0x9b, 0xad, 0x6e, 0xf1, 0xe4, 0x55,
+	0x4d, 0x31, 0x25, 0xba, 0xf1, 0x5c, 0x01, 0x2b, 0xd3, 0x4c, 0xf6, 0xb1, 0x07, 0x3b, 0x00, 0xf4,
+	0x27, 0x1b, 0xf2, 0x3f, 0xca, 0x73, 0x2f, 0x69, 0xca, 0x34, 0x67, 0x48, 0xf0, 0x0b, 0x50, 0xb0,
+	0xa3, 0xe4, 0x76, 0x56, 0xcc, 0x78, 0x09, 0x7f, 0x07, 0x25, 0x14, 0xd0, 0x61, 0x28, 0x64, 0x90,
+	0xcb, 0xdd, 0x1f, 0x4f, 0x2f, 0x6b, 0xb9, 0x97, 0x97, 0xb5, 0xf5, 0xe4, 0x01, 0x71, 0xf7, 0x48,
+	0x27, 0xd4, 0x08, 0x90, 0x18, 0xe8, 0xbd, 0x50, 0x5c, 0x8c, 0x5b, 0x20, 0x69, 0xc4, 0xd5, 0x93,
+	0x37, 0x4f, 0x7f, 0x50, 0xcc, 0x94, 0x0f, 0xbf, 0x06, 0xcb, 0x0c, 0x07, 0x88, 0x84, 0x2e, 0x66,
+	0x32, 0xe9, 0x25, 0x73, 0xba, 0xd1, 0x78, 0x9b, 0x07, 0x8b, 0xd9, 0xc5, 0xfc, 0x16, 0x8f, 0x1b,
+	0xb6, 0x90, 0x9c, 0x53, 0xae, 0x2a, 0xf5, 0x42, 0xb3, 0xdc, 0xfe, 0xe6, 0x83, 0x4f, 0x23, 0x1e,
+	0x35, 0x9c, 0x2c, 0xf9, 0x8d, 0x20, 0xf2, 0x9f, 0x12, 0xc4, 0x1e, 0x80, 0x7d, 0xe4, 0xfb, 0x36,
+	0x72, 0x8e, 0x2c, 0x86, 0x1d, 0x12, 0x11, 0x3c, 0x89, 0x40, 0xbd, 0x18, 0xb7, 0xaa, 0xe9, 0x29,
+	0x3b, 0xae, 0xcb, 0x30, 0xe7, 0x7f, 0x09, 0x16, 0x2b, 0xac, 0x66, 0x1c, 0x33, 0xa3, 0xc0, 0x3f,
+	0xc1, 0xe7, 0x53, 0x59, 0xcb, 0xc7, 0x1e, 0x57, 0x8b, 0xf2, 0x3c, 0xdf, 0x7d, 0xd4, 0xd0, 0x3e,
+	0xf6, 0xcc, 0x4a, 0x7f, 0xb6, 0xe4, 0xf0, 0x10, 0x54, 0x27, 0xbe, 0xa6, 0x2d, 0xae, 0x2e, 0xd4,
+	0x0b, 0xf7, 0x3b, 0xe4, 0x5a, 0x46, 0x9f, 0xee, 0xf1, 0xc6, 0x1f, 0xa0, 0x92, 0x66, 0xff, 0x0f,
+	0x43, 0x51, 0x84, 0x19, 0xfc, 0x05, 0x2c, 0xa6, 0x22, 0xe9, 0x20, 0x69, 0xf3, 0x5e, 0x7b, 0xc2,
+	0x33, 0x33, 0x78, 0x77, 0xef, 0xf4, 0x4a, 0x53, 0xce, 0xaf, 0x34, 0xe5, 0xf5, 0x95, 0xa6, 0x9c,
+	0x5c, 0x6b, 0xb9, 0xf3, 0x6b, 0x2d, 0xf7, 0xe2, 0x5a, 0xcb, 0xfd, 0xd7, 0xf2, 0x88, 0x18, 0x0c,
+	0x6d, 0xdd, 0xa1, 0x81, 0x21, 0xc5, 0x5a, 0x88, 0x73, 0x2c, 0xf8, 0xe4, 0x2b, 0x79, 0xdc, 0x36,
+	0xc4, 0x28, 0xc2, 0x5c, 0x7e, 0x2e, 0xed, 0x92, 0x7c, 0x00, 0x3f, 0xbd, 0x1b, 0x00, 0xff, 0x1f,
+	0x10, 0xb2, 0x07, 0x06, 0x00, 0x00,
 }
 
 func (m *Action) Marshal() (dAtA []byte, err error) {
@@ -334,6 +524,28 @@ func (m *Forwarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Batch {
+		i--
+		if m.Batch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DeferredUntil != nil {
+		{
+			size, err := m.DeferredUntil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrbiter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.PassthroughPayload) > 0 {
 		i -= len(m.PassthroughPayload)
 		copy(dAtA[i:], m.PassthroughPayload)
@@ -361,6 +573,104 @@ func (m *Forwarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Deferral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deferral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deferral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintOrbiter(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintOrbiter(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardingLeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardingLeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardingLeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remainder {
+		i--
+		if m.Remainder {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrbiter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Bps != 0 {
+		i = encodeVarintOrbiter(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Forwarding != nil {
+		{
+			size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrbiter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Payload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -381,6 +691,41 @@ func (m *Payload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FallbackForwardings) > 0 {
+		for iNdEx := len(m.FallbackForwardings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FallbackForwardings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrbiter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ForwardingLegs) > 0 {
+		for iNdEx := len(m.ForwardingLegs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardingLegs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrbiter(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FallbackRecipient) > 0 {
+		i -= len(m.FallbackRecipient)
+		copy(dAtA[i:], m.FallbackRecipient)
+		i = encodeVarintOrbiter(dAtA, i, uint64(len(m.FallbackRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Forwarding != nil {
 		{
 			size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
@@ -489,6 +834,50 @@ func (m *Forwarding) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrbiter(uint64(l))
 	}
+	if m.DeferredUntil != nil {
+		l = m.DeferredUntil.Size()
+		n += 1 + l + sovOrbiter(uint64(l))
+	}
+	if m.Batch {
+		n += 2
+	}
+	return n
+}
+
+func (m *Deferral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovOrbiter(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovOrbiter(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardingLeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Forwarding != nil {
+		l = m.Forwarding.Size()
+		n += 1 + l + sovOrbiter(uint64(l))
+	}
+	if m.Bps != 0 {
+		n += 1 + sovOrbiter(uint64(m.Bps))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovOrbiter(uint64(l))
+	if m.Remainder {
+		n += 2
+	}
 	return n
 }
 
@@ -508,6 +897,22 @@ func (m *Payload) Size() (n int) {
 		l = m.Forwarding.Size()
 		n += 1 + l + sovOrbiter(uint64(l))
 	}
+	l = len(m.FallbackRecipient)
+	if l > 0 {
+		n += 1 + l + sovOrbiter(uint64(l))
+	}
+	if len(m.ForwardingLegs) > 0 {
+		for _, e := range m.ForwardingLegs {
+			l = e.Size()
+			n += 1 + l + sovOrbiter(uint64(l))
+		}
+	}
+	if len(m.FallbackForwardings) > 0 {
+		for _, e := range m.FallbackForwardings {
+			l = e.Size()
+			n += 1 + l + sovOrbiter(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -753,19 +1158,339 @@ func (m *Forwarding) Unmarshal(dAtA []byte) error {
 				m.PassthroughPayload = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOrbiter(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredUntil", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthOrbiter
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
+			if m.DeferredUntil == nil {
+				m.DeferredUntil = &Deferral{}
+			}
+			if err := m.DeferredUntil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Batch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrbiter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Deferral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrbiter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deferral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deferral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrbiter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardingLeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrbiter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardingLeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardingLeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Forwarding == nil {
+				m.Forwarding = &Forwarding{}
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+			}
+			m.Bps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remainder = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrbiter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -873,6 +1598,106 @@ func (m *Payload) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardingLegs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardingLegs = append(m.ForwardingLegs, &ForwardingLeg{})
+			if err := m.ForwardingLegs[len(m.ForwardingLegs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackForwardings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrbiter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrbiter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackForwardings = append(m.FallbackForwardings, &Forwarding{})
+			if err := m.FallbackForwardings[len(m.FallbackForwardings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrbiter(dAtA[iNdEx:])
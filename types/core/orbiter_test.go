@@ -22,10 +22,13 @@ package core_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/noble-assets/orbiter/v2/testutil/testdata"
 	"github.com/noble-assets/orbiter/v2/types/core"
@@ -129,6 +132,62 @@ func TestValidateForwarding(t *testing.T) {
 			},
 			expErr: "",
 		},
+		{
+			name: "success - with deferral",
+			forwarding: &core.Forwarding{
+				ProtocolId:    core.PROTOCOL_IBC,
+				Attributes:    &codectypes.Any{},
+				DeferredUntil: &core.Deferral{Height: 10},
+			},
+			expErr: "",
+		},
+		{
+			name: "error - with empty deferral",
+			forwarding: &core.Forwarding{
+				ProtocolId:    core.PROTOCOL_IBC,
+				Attributes:    &codectypes.Any{},
+				DeferredUntil: &core.Deferral{},
+			},
+			expErr: "deferral must specify a block height or a block time",
+		},
+		{
+			name: "success - with batch",
+			forwarding: &core.Forwarding{
+				ProtocolId: core.PROTOCOL_CCTP,
+				Attributes: &codectypes.Any{},
+				Batch:      true,
+			},
+			expErr: "",
+		},
+		{
+			name: "error - with batch for unsupported protocol",
+			forwarding: &core.Forwarding{
+				ProtocolId: core.PROTOCOL_IBC,
+				Attributes: &codectypes.Any{},
+				Batch:      true,
+			},
+			expErr: "batching is not supported for protocol",
+		},
+		{
+			name: "error - with batch and passthrough payload",
+			forwarding: &core.Forwarding{
+				ProtocolId:         core.PROTOCOL_HYPERLANE,
+				Attributes:         &codectypes.Any{},
+				PassthroughPayload: []byte("payload"),
+				Batch:              true,
+			},
+			expErr: "batched forwardings cannot have a passthrough payload",
+		},
+		{
+			name: "error - with batch and deferral",
+			forwarding: &core.Forwarding{
+				ProtocolId:    core.PROTOCOL_CCTP,
+				Attributes:    &codectypes.Any{},
+				DeferredUntil: &core.Deferral{Height: 10},
+				Batch:         true,
+			},
+			expErr: "batched forwardings cannot be deferred",
+		},
 	}
 
 	for _, tC := range testCases {
@@ -144,6 +203,64 @@ func TestValidateForwarding(t *testing.T) {
 	}
 }
 
+func TestIsReached_Deferral(t *testing.T) {
+	deferralTime := time.Unix(1_000, 0)
+
+	testCases := []struct {
+		name       string
+		deferral   *core.Deferral
+		height     int64
+		time       time.Time
+		expReached bool
+	}{
+		{
+			name:       "success - nil deferral",
+			expReached: true,
+		},
+		{
+			name:     "success - height not reached",
+			deferral: &core.Deferral{Height: 10},
+			height:   9,
+		},
+		{
+			name:       "success - height reached",
+			deferral:   &core.Deferral{Height: 10},
+			height:     10,
+			expReached: true,
+		},
+		{
+			name:     "success - time not reached",
+			deferral: &core.Deferral{Time: &deferralTime},
+			time:     deferralTime.Add(-time.Second),
+		},
+		{
+			name:       "success - time reached",
+			deferral:   &core.Deferral{Time: &deferralTime},
+			time:       deferralTime,
+			expReached: true,
+		},
+		{
+			name:     "success - height reached but time not reached",
+			deferral: &core.Deferral{Height: 10, Time: &deferralTime},
+			height:   10,
+			time:     deferralTime.Add(-time.Second),
+		},
+		{
+			name:       "success - height and time reached",
+			deferral:   &core.Deferral{Height: 10, Time: &deferralTime},
+			height:     11,
+			time:       deferralTime.Add(time.Second),
+			expReached: true,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			require.Equal(t, tC.expReached, tC.deferral.IsReached(tC.height, tC.time))
+		})
+	}
+}
+
 func TestProtocolID(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -194,6 +311,13 @@ func TestValidate_Payload(t *testing.T) {
 			},
 			expError: "repeated action",
 		},
+		{
+			name: "error - route fee action",
+			payload: &core.Payload{
+				PreActions: []*core.Action{{Id: core.ACTION_ROUTE_FEE}},
+			},
+			expError: "action ID ACTION_ROUTE_FEE cannot be set in the payload",
+		},
 		{
 			name: "error - invalid ID",
 			payload: &core.Payload{
@@ -239,6 +363,159 @@ func TestValidate_Payload(t *testing.T) {
 			},
 			expError: "",
 		},
+		{
+			name: "error - invalid fallback recipient",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId: core.PROTOCOL_IBC,
+					Attributes: &codectypes.Any{},
+				},
+				FallbackRecipient: "invalid",
+			},
+			expError: "invalid fallback recipient address",
+		},
+		{
+			name: "success - forwarding legs",
+			payload: &core.Payload{
+				ForwardingLegs: []*core.ForwardingLeg{
+					{
+						Forwarding: &core.Forwarding{
+							ProtocolId: core.PROTOCOL_IBC,
+							Attributes: &codectypes.Any{},
+						},
+						Bps: 7_000,
+					},
+					{
+						Forwarding: &core.Forwarding{
+							ProtocolId: core.PROTOCOL_CCTP,
+							Attributes: &codectypes.Any{},
+						},
+						Remainder: true,
+					},
+				},
+			},
+			expError: "",
+		},
+		{
+			name: "error - forwarding and forwarding legs",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId: core.PROTOCOL_IBC,
+					Attributes: &codectypes.Any{},
+				},
+				ForwardingLegs: []*core.ForwardingLeg{{}},
+			},
+			expError: "forwarding and forwarding legs cannot be set together",
+		},
+		{
+			name: "success - forwarding with fallback recipient",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId: core.PROTOCOL_IBC,
+					Attributes: &codectypes.Any{},
+				},
+				FallbackRecipient: sdk.AccAddress([]byte("fallback_recipient")).String(),
+			},
+			expError: "",
+		},
+		{
+			name: "success - forwarding with fallback forwardings",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId: core.PROTOCOL_CCTP,
+					Attributes: &codectypes.Any{},
+				},
+				FallbackForwardings: []*core.Forwarding{
+					{ProtocolId: core.PROTOCOL_HYPERLANE, Attributes: &codectypes.Any{}},
+					{ProtocolId: core.PROTOCOL_INTERNAL, Attributes: &codectypes.Any{}},
+				},
+			},
+			expError: "",
+		},
+		{
+			name: "error - invalid fallback forwarding",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId: core.PROTOCOL_CCTP,
+					Attributes: &codectypes.Any{},
+				},
+				FallbackForwardings: []*core.Forwarding{
+					{ProtocolId: core.PROTOCOL_HYPERLANE, Attributes: &codectypes.Any{}},
+					{ProtocolId: core.PROTOCOL_UNSUPPORTED, Attributes: &codectypes.Any{}},
+				},
+			},
+			expError: "invalid fallback forwarding 1",
+		},
+		{
+			name: "error - too many fallback forwardings",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId: core.PROTOCOL_CCTP,
+					Attributes: &codectypes.Any{},
+				},
+				FallbackForwardings: make([]*core.Forwarding, core.MaxFallbackForwardings+1),
+			},
+			expError: "cannot have more than 4 fallback forwardings",
+		},
+		{
+			name: "success - deferred forwarding with fallback recipient",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId:    core.PROTOCOL_CCTP,
+					Attributes:    &codectypes.Any{},
+					DeferredUntil: &core.Deferral{Height: 10},
+				},
+				FallbackRecipient: sdk.AccAddress([]byte("fallback_recipient")).String(),
+			},
+			expError: "",
+		},
+		{
+			name: "error - deferred forwarding without fallback recipient",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId:    core.PROTOCOL_CCTP,
+					Attributes:    &codectypes.Any{},
+					DeferredUntil: &core.Deferral{Height: 10},
+				},
+			},
+			expError: "deferred forwardings require a fallback recipient",
+		},
+		{
+			name: "error - deferred forwarding with fallback forwardings",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId:    core.PROTOCOL_CCTP,
+					Attributes:    &codectypes.Any{},
+					DeferredUntil: &core.Deferral{Height: 10},
+				},
+				FallbackForwardings: []*core.Forwarding{
+					{ProtocolId: core.PROTOCOL_HYPERLANE, Attributes: &codectypes.Any{}},
+				},
+				FallbackRecipient: sdk.AccAddress([]byte("fallback_recipient")).String(),
+			},
+			expError: "deferred forwardings cannot be used with fallback forwardings",
+		},
+		{
+			name: "error - batched forwarding without fallback recipient",
+			payload: &core.Payload{
+				Forwarding: &core.Forwarding{
+					ProtocolId: core.PROTOCOL_CCTP,
+					Attributes: &codectypes.Any{},
+					Batch:      true,
+				},
+			},
+			expError: "batched forwardings require a fallback recipient",
+		},
+		{
+			name: "error - fallback forwardings and forwarding legs",
+			payload: &core.Payload{
+				ForwardingLegs: []*core.ForwardingLeg{{}},
+				FallbackForwardings: []*core.Forwarding{
+					{ProtocolId: core.PROTOCOL_HYPERLANE, Attributes: &codectypes.Any{}},
+				},
+			},
+			expError: "fallback forwardings and forwarding legs cannot be set together",
+		},
 	}
 
 	for _, tC := range testCases {
@@ -253,3 +530,226 @@ func TestValidate_Payload(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateForwardingLegs(t *testing.T) {
+	forwarding := &core.Forwarding{
+		ProtocolId: core.PROTOCOL_IBC,
+		Attributes: &codectypes.Any{},
+	}
+	bpsLeg := func(bps uint32) *core.ForwardingLeg {
+		return &core.ForwardingLeg{Forwarding: forwarding, Bps: bps}
+	}
+	amountLeg := &core.ForwardingLeg{Forwarding: forwarding, Amount: math.NewInt(10)}
+	remainderLeg := &core.ForwardingLeg{Forwarding: forwarding, Remainder: true}
+
+	testCases := []struct {
+		name     string
+		legs     []*core.ForwardingLeg
+		expError string
+	}{
+		{
+			name: "success - basis points legs",
+			legs: []*core.ForwardingLeg{bpsLeg(7_000), bpsLeg(3_000)},
+		},
+		{
+			name: "success - fixed amount, basis points and remainder legs",
+			legs: []*core.ForwardingLeg{amountLeg, bpsLeg(5_000), remainderLeg},
+		},
+		{
+			name:     "error - single leg",
+			legs:     []*core.ForwardingLeg{remainderLeg},
+			expError: "at least 2 legs",
+		},
+		{
+			name: "error - too many legs",
+			legs: []*core.ForwardingLeg{
+				bpsLeg(1), bpsLeg(1), bpsLeg(1), bpsLeg(1), bpsLeg(1),
+				bpsLeg(1), bpsLeg(1), bpsLeg(1), remainderLeg,
+			},
+			expError: "cannot have more than 8 legs",
+		},
+		{
+			name:     "error - nil leg",
+			legs:     []*core.ForwardingLeg{bpsLeg(10_000), nil},
+			expError: "forwarding leg is not set",
+		},
+		{
+			name: "error - leg without forwarding",
+			legs: []*core.ForwardingLeg{
+				bpsLeg(5_000),
+				{Bps: 5_000},
+			},
+			expError: "forwarding is not set",
+		},
+		{
+			name: "error - leg with multiple shares",
+			legs: []*core.ForwardingLeg{
+				bpsLeg(5_000),
+				{Forwarding: forwarding, Bps: 5_000, Remainder: true},
+			},
+			expError: "exactly one of bps, amount, or remainder",
+		},
+		{
+			name: "error - leg without shares",
+			legs: []*core.ForwardingLeg{
+				bpsLeg(10_000),
+				{Forwarding: forwarding},
+			},
+			expError: "exactly one of bps, amount, or remainder",
+		},
+		{
+			name: "error - negative amount",
+			legs: []*core.ForwardingLeg{
+				remainderLeg,
+				{Forwarding: forwarding, Amount: math.NewInt(-1)},
+			},
+			expError: "amount cannot be negative",
+		},
+		{
+			name:     "error - multiple remainder legs",
+			legs:     []*core.ForwardingLeg{remainderLeg, remainderLeg},
+			expError: "only one remainder leg",
+		},
+		{
+			name:     "error - basis points higher than 100%",
+			legs:     []*core.ForwardingLeg{bpsLeg(7_000), bpsLeg(4_000), remainderLeg},
+			expError: "cannot sum to more than 10000",
+		},
+		{
+			name:     "error - fixed amount without remainder leg",
+			legs:     []*core.ForwardingLeg{amountLeg, bpsLeg(10_000)},
+			expError: "fixed amount legs require a remainder leg",
+		},
+		{
+			name:     "error - basis points lower than 100% without remainder leg",
+			legs:     []*core.ForwardingLeg{bpsLeg(7_000), bpsLeg(2_000)},
+			expError: "must sum to 10000 without a remainder leg",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			err := core.ValidateForwardingLegs(tC.legs)
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitAmount(t *testing.T) {
+	forwarding := &core.Forwarding{
+		ProtocolId: core.PROTOCOL_IBC,
+		Attributes: &codectypes.Any{},
+	}
+
+	testCases := []struct {
+		name         string
+		total        int64
+		legs         []*core.ForwardingLeg
+		expAmounts   []int64
+		expDustIndex int
+		expError     string
+	}{
+		{
+			name:  "success - dust assigned to the first leg without remainder leg",
+			total: 100,
+			legs: []*core.ForwardingLeg{
+				{Forwarding: forwarding, Bps: 3_333},
+				{Forwarding: forwarding, Bps: 3_333},
+				{Forwarding: forwarding, Bps: 3_334},
+			},
+			expAmounts:   []int64{34, 33, 33},
+			expDustIndex: 0,
+		},
+		{
+			name:  "success - dust assigned to the remainder leg",
+			total: 1_001,
+			legs: []*core.ForwardingLeg{
+				{Forwarding: forwarding, Bps: 7_000},
+				{Forwarding: forwarding, Amount: math.NewInt(100)},
+				{Forwarding: forwarding, Remainder: true},
+			},
+			expAmounts:   []int64{700, 100, 201},
+			expDustIndex: 2,
+		},
+		{
+			name:  "error - fixed amounts exceed the total",
+			total: 100,
+			legs: []*core.ForwardingLeg{
+				{Forwarding: forwarding, Amount: math.NewInt(101)},
+				{Forwarding: forwarding, Remainder: true},
+			},
+			expError: "exceeds the transfer amount",
+		},
+		{
+			name:  "error - nothing left for the remainder leg",
+			total: 100,
+			legs: []*core.ForwardingLeg{
+				{Forwarding: forwarding, Amount: math.NewInt(100)},
+				{Forwarding: forwarding, Remainder: true},
+			},
+			expError: "forwarding leg 1 amount must be positive",
+		},
+		{
+			name:  "error - basis points leg rounded to zero",
+			total: 1,
+			legs: []*core.ForwardingLeg{
+				{Forwarding: forwarding, Bps: 5_000},
+				{Forwarding: forwarding, Bps: 5_000},
+			},
+			expError: "forwarding leg 1 amount must be positive",
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.name, func(t *testing.T) {
+			amounts, dustIndex, err := core.SplitAmount(math.NewInt(tC.total), tC.legs)
+
+			if tC.expError != "" {
+				require.ErrorContains(t, err, tC.expError)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tC.expDustIndex, dustIndex)
+			require.Len(t, amounts, len(tC.expAmounts))
+			for i, a := range amounts {
+				require.Equal(t, tC.expAmounts[i], a.Int64())
+			}
+		})
+	}
+}
+
+func TestSplit_TransferAttributes(t *testing.T) {
+	transferAttr, err := core.NewTransferAttributes(
+		core.PROTOCOL_IBC,
+		"channel-1",
+		"uusdc",
+		math.NewInt(100),
+	)
+	require.NoError(t, err)
+	// Simulate an action reducing the destination amount.
+	transferAttr.SetDestinationAmount(math.NewInt(90))
+
+	legs, err := transferAttr.Split([]math.Int{math.NewInt(60), math.NewInt(30)}, 1)
+	require.NoError(t, err)
+	require.Len(t, legs, 2)
+
+	require.Equal(t, int64(60), legs[0].DestinationAmount().Int64())
+	require.Equal(t, int64(66), legs[0].SourceAmount().Int64())
+	require.Equal(t, int64(30), legs[1].DestinationAmount().Int64())
+	require.Equal(t, int64(34), legs[1].SourceAmount().Int64())
+	for _, leg := range legs {
+		require.Equal(t, "uusdc", leg.SourceDenom())
+		require.Equal(t, "uusdc", leg.DestinationDenom())
+		require.Equal(t, core.PROTOCOL_IBC, leg.SourceProtocolID())
+		require.Equal(t, "channel-1", leg.SourceCounterpartyID())
+	}
+
+	_, err = transferAttr.Split([]math.Int{math.NewInt(90)}, 1)
+	require.ErrorContains(t, err, "invalid dust index")
+}
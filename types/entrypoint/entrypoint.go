@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package entrypoint
+
+import (
+	"context"
+
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+
+	"github.com/noble-assets/orbiter/v2/types/core"
+)
+
+// HyperlaneAppModuleType is the module type used to register the Orbiter
+// as an application in the Hyperlane core router.
+const HyperlaneAppModuleType uint8 = 100
+
+// HyperlaneAppAddress is the Hyperlane address of the Orbiter application,
+// which is the recipient of the messages containing the orbiter payload.
+var HyperlaneAppAddress = newHyperlaneAppAddress()
+
+func newHyperlaneAppAddress() hyperlaneutil.HexAddress {
+	var moduleSpecifier [20]byte
+	copy(moduleSpecifier[:], core.ModuleName)
+
+	return hyperlaneutil.GenerateHexAddress(moduleSpecifier, uint32(HyperlaneAppModuleType), 0)
+}
+
+// CCTPEntrypoint defines the handler of the CCTP transfers with an
+// orbiter payload.
+type CCTPEntrypoint interface {
+	ReceiveCCTPMessages(
+		context.Context,
+		*MsgReceiveCCTPMessages,
+	) (*MsgReceiveCCTPMessagesResponse, error)
+}
+
+// HyperlaneEntrypoint defines the handler of the Hyperlane transfers with
+// an orbiter payload.
+type HyperlaneEntrypoint interface {
+	ReceiveHyperlaneMessages(
+		context.Context,
+		*MsgReceiveHyperlaneMessages,
+	) (*MsgReceiveHyperlaneMessagesResponse, error)
+}
@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package entrypoint
+
+import (
+	"context"
+
+	hyperlaneutil "github.com/bcp-innovations/hyperlane-cosmos/util"
+	warptypes "github.com/bcp-innovations/hyperlane-cosmos/x/warp/types"
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CCTPMessageReceiver defines the interface used by the CCTP entrypoint
+// to deliver messages to the CCTP module.
+type CCTPMessageReceiver interface {
+	ReceiveMessage(
+		context.Context,
+		*cctptypes.MsgReceiveMessage,
+	) (*cctptypes.MsgReceiveMessageResponse, error)
+}
+
+// CCTPTokenPairProvider defines the interface used by the CCTP entrypoint
+// to retrieve the Noble denom minted for a remote burn token.
+type CCTPTokenPairProvider interface {
+	GetTokenPair(
+		ctx context.Context,
+		remoteDomain uint32,
+		remoteToken []byte,
+	) (cctptypes.TokenPair, bool)
+}
+
+// HyperlaneMessageProcessor defines the interface used by the Hyperlane
+// entrypoint to verify and deliver messages via the Hyperlane core module.
+type HyperlaneMessageProcessor interface {
+	ProcessMessage(
+		ctx sdk.Context,
+		mailboxID hyperlaneutil.HexAddress,
+		rawMessage []byte,
+		metadata []byte,
+	) error
+}
+
+// HyperlaneTokenProvider defines the interface used by the Hyperlane
+// entrypoint to retrieve the Noble denom of a warp token.
+type HyperlaneTokenProvider interface {
+	Token(
+		context.Context,
+		*warptypes.QueryTokenRequest,
+	) (*warptypes.QueryTokenResponse, error)
+}
@@ -0,0 +1,1834 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: noble/orbiter/entrypoint/v1/tx.proto
+
+package entrypoint
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	core "github.com/noble-assets/orbiter/v2/types/core"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgReceiveCCTPMessages is the request to receive a CCTP burn message
+// together with the general message containing the Orbiter payload.
+type MsgReceiveCCTPMessages struct {
+	// The relayer of the messages. It must be the destination caller
+	// of both messages.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The CCTP message containing the burn message.
+	TransferMessage []byte `protobuf:"bytes,2,opt,name=transfer_message,json=transferMessage,proto3" json:"transfer_message,omitempty"`
+	// The attestation of the transfer message.
+	TransferAttestation []byte `protobuf:"bytes,3,opt,name=transfer_attestation,json=transferAttestation,proto3" json:"transfer_attestation,omitempty"`
+	// The CCTP message containing the transfer nonce and the Orbiter payload.
+	PayloadMessage []byte `protobuf:"bytes,4,opt,name=payload_message,json=payloadMessage,proto3" json:"payload_message,omitempty"`
+	// The attestation of the payload message.
+	PayloadAttestation []byte `protobuf:"bytes,5,opt,name=payload_attestation,json=payloadAttestation,proto3" json:"payload_attestation,omitempty"`
+}
+
+func (m *MsgReceiveCCTPMessages) Reset()         { *m = MsgReceiveCCTPMessages{} }
+func (m *MsgReceiveCCTPMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgReceiveCCTPMessages) ProtoMessage()    {}
+func (*MsgReceiveCCTPMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6627381c46bf71f8, []int{0}
+}
+func (m *MsgReceiveCCTPMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReceiveCCTPMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReceiveCCTPMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReceiveCCTPMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReceiveCCTPMessages.Merge(m, src)
+}
+func (m *MsgReceiveCCTPMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReceiveCCTPMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReceiveCCTPMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReceiveCCTPMessages proto.InternalMessageInfo
+
+func (m *MsgReceiveCCTPMessages) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgReceiveCCTPMessages) GetTransferMessage() []byte {
+	if m != nil {
+		return m.TransferMessage
+	}
+	return nil
+}
+
+func (m *MsgReceiveCCTPMessages) GetTransferAttestation() []byte {
+	if m != nil {
+		return m.TransferAttestation
+	}
+	return nil
+}
+
+func (m *MsgReceiveCCTPMessages) GetPayloadMessage() []byte {
+	if m != nil {
+		return m.PayloadMessage
+	}
+	return nil
+}
+
+func (m *MsgReceiveCCTPMessages) GetPayloadAttestation() []byte {
+	if m != nil {
+		return m.PayloadAttestation
+	}
+	return nil
+}
+
+// MsgReceiveCCTPMessagesResponse is the response to the MsgReceiveCCTPMessages.
+type MsgReceiveCCTPMessagesResponse struct {
+}
+
+func (m *MsgReceiveCCTPMessagesResponse) Reset()         { *m = MsgReceiveCCTPMessagesResponse{} }
+func (m *MsgReceiveCCTPMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReceiveCCTPMessagesResponse) ProtoMessage()    {}
+func (*MsgReceiveCCTPMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6627381c46bf71f8, []int{1}
+}
+func (m *MsgReceiveCCTPMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReceiveCCTPMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReceiveCCTPMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReceiveCCTPMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReceiveCCTPMessagesResponse.Merge(m, src)
+}
+func (m *MsgReceiveCCTPMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReceiveCCTPMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReceiveCCTPMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReceiveCCTPMessagesResponse proto.InternalMessageInfo
+
+// MsgReceiveHyperlaneMessages is the request to receive a Hyperlane warp
+// transfer message together with the message containing the Orbiter payload.
+type MsgReceiveHyperlaneMessages struct {
+	// The relayer of the messages.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// The hex encoded identifier of the mailbox receiving the messages.
+	MailboxId string `protobuf:"bytes,2,opt,name=mailbox_id,json=mailboxId,proto3" json:"mailbox_id,omitempty"`
+	// The Hyperlane message containing the warp transfer.
+	TransferMessage []byte `protobuf:"bytes,3,opt,name=transfer_message,json=transferMessage,proto3" json:"transfer_message,omitempty"`
+	// The ISM metadata of the transfer message.
+	TransferMetadata []byte `protobuf:"bytes,4,opt,name=transfer_metadata,json=transferMetadata,proto3" json:"transfer_metadata,omitempty"`
+	// The Hyperlane message containing the transfer message ID and the Orbiter payload.
+	PayloadMessage []byte `protobuf:"bytes,5,opt,name=payload_message,json=payloadMessage,proto3" json:"payload_message,omitempty"`
+	// The ISM metadata of the payload message.
+	PayloadMetadata []byte `protobuf:"bytes,6,opt,name=payload_metadata,json=payloadMetadata,proto3" json:"payload_metadata,omitempty"`
+}
+
+func (m *MsgReceiveHyperlaneMessages) Reset()         { *m = MsgReceiveHyperlaneMessages{} }
+func (m *MsgReceiveHyperlaneMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgReceiveHyperlaneMessages) ProtoMessage()    {}
+func (*MsgReceiveHyperlaneMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6627381c46bf71f8, []int{2}
+}
+func (m *MsgReceiveHyperlaneMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReceiveHyperlaneMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReceiveHyperlaneMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReceiveHyperlaneMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReceiveHyperlaneMessages.Merge(m, src)
+}
+func (m *MsgReceiveHyperlaneMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReceiveHyperlaneMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReceiveHyperlaneMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReceiveHyperlaneMessages proto.InternalMessageInfo
+
+func (m *MsgReceiveHyperlaneMessages) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgReceiveHyperlaneMessages) GetMailboxId() string {
+	if m != nil {
+		return m.MailboxId
+	}
+	return ""
+}
+
+func (m *MsgReceiveHyperlaneMessages) GetTransferMessage() []byte {
+	if m != nil {
+		return m.TransferMessage
+	}
+	return nil
+}
+
+func (m *MsgReceiveHyperlaneMessages) GetTransferMetadata() []byte {
+	if m != nil {
+		return m.TransferMetadata
+	}
+	return nil
+}
+
+func (m *MsgReceiveHyperlaneMessages) GetPayloadMessage() []byte {
+	if m != nil {
+		return m.PayloadMessage
+	}
+	return nil
+}
+
+func (m *MsgReceiveHyperlaneMessages) GetPayloadMetadata() []byte {
+	if m != nil {
+		return m.PayloadMetadata
+	}
+	return nil
+}
+
+// MsgReceiveHyperlaneMessagesResponse is the response to the MsgReceiveHyperlaneMessages.
+type MsgReceiveHyperlaneMessagesResponse struct {
+}
+
+func (m *MsgReceiveHyperlaneMessagesResponse) Reset()         { *m = MsgReceiveHyperlaneMessagesResponse{} }
+func (m *MsgReceiveHyperlaneMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReceiveHyperlaneMessagesResponse) ProtoMessage()    {}
+func (*MsgReceiveHyperlaneMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6627381c46bf71f8, []int{3}
+}
+func (m *MsgReceiveHyperlaneMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReceiveHyperlaneMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReceiveHyperlaneMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReceiveHyperlaneMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReceiveHyperlaneMessagesResponse.Merge(m, src)
+}
+func (m *MsgReceiveHyperlaneMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReceiveHyperlaneMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReceiveHyperlaneMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReceiveHyperlaneMessagesResponse proto.InternalMessageInfo
+
+// MsgOrbit is the request to dispatch an Orbiter payload using
+// funds held on Noble by the sender.
+type MsgOrbit struct {
+	// The account sending the funds to the Orbiter module.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The funds to dispatch.
+	Coin types.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	// The Orbiter payload to dispatch.
+	Payload *core.Payload `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *MsgOrbit) Reset()         { *m = MsgOrbit{} }
+func (m *MsgOrbit) String() string { return proto.CompactTextString(m) }
+func (*MsgOrbit) ProtoMessage()    {}
+func (*MsgOrbit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6627381c46bf71f8, []int{4}
+}
+func (m *MsgOrbit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOrbit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOrbit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOrbit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOrbit.Merge(m, src)
+}
+func (m *MsgOrbit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOrbit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOrbit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOrbit proto.InternalMessageInfo
+
+func (m *MsgOrbit) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgOrbit) GetCoin() types.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return types.Coin{}
+}
+
+func (m *MsgOrbit) GetPayload() *core.Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+// MsgOrbitResponse is the response to the MsgOrbit.
+type MsgOrbitResponse struct {
+}
+
+func (m *MsgOrbitResponse) Reset()         { *m = MsgOrbitResponse{} }
+func (m *MsgOrbitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOrbitResponse) ProtoMessage()    {}
+func (*MsgOrbitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6627381c46bf71f8, []int{5}
+}
+func (m *MsgOrbitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOrbitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOrbitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgOrbitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOrbitResponse.Merge(m, src)
+}
+func (m *MsgOrbitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOrbitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOrbitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOrbitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgReceiveCCTPMessages)(nil), "noble.orbiter.entrypoint.v1.MsgReceiveCCTPMessages")
+	proto.RegisterType((*MsgReceiveCCTPMessagesResponse)(nil), "noble.orbiter.entrypoint.v1.MsgReceiveCCTPMessagesResponse")
+	proto.RegisterType((*MsgReceiveHyperlaneMessages)(nil), "noble.orbiter.entrypoint.v1.MsgReceiveHyperlaneMessages")
+	proto.RegisterType((*MsgReceiveHyperlaneMessagesResponse)(nil), "noble.orbiter.entrypoint.v1.MsgReceiveHyperlaneMessagesResponse")
+	proto.RegisterType((*MsgOrbit)(nil), "noble.orbiter.entrypoint.v1.MsgOrbit")
+	proto.RegisterType((*MsgOrbitResponse)(nil), "noble.orbiter.entrypoint.v1.MsgOrbitResponse")
+}
+
+func init() {
+	proto.RegisterFile("noble/orbiter/entrypoint/v1/tx.proto", fileDescriptor_6627381c46bf71f8)
+}
+
+var fileDescriptor_6627381c46bf71f8 = []byte{
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x4f, 0x13, 0x4f,
+	0x18, 0xc6, 0xbb, 0x94, 0xf2, 0xff, 0x77, 0x30, 0x52, 0x16, 0xa2, 0xa5, 0xc4, 0x95, 0x14, 0x88,
+	0x80, 0x61, 0xc6, 0x16, 0x13, 0x09, 0x5e, 0xa4, 0x1c, 0xd4, 0x43, 0x23, 0x59, 0x3d, 0x99, 0x18,
+	0x32, 0xdb, 0x1d, 0xd7, 0x49, 0xba, 0x33, 0xcd, 0xce, 0xd8, 0xd0, 0x9b, 0xf1, 0x62, 0xe2, 0xc1,
+	0x78, 0xf2, 0x33, 0x78, 0xe4, 0xe0, 0x67, 0x30, 0x1c, 0x89, 0x27, 0x4f, 0xc6, 0xd0, 0x44, 0xbe,
+	0x86, 0xd9, 0x99, 0xd9, 0x6d, 0x91, 0x15, 0x1a, 0xbc, 0x34, 0xbb, 0xef, 0xfb, 0x9b, 0xa7, 0x33,
+	0xcf, 0xb3, 0xef, 0x80, 0x25, 0xc6, 0xbd, 0x36, 0x41, 0x3c, 0xf2, 0xa8, 0x24, 0x11, 0x22, 0x4c,
+	0x46, 0xbd, 0x0e, 0xa7, 0x4c, 0xa2, 0x6e, 0x0d, 0xc9, 0x7d, 0xd8, 0x89, 0xb8, 0xe4, 0xf6, 0xbc,
+	0xa2, 0xa0, 0xa1, 0xe0, 0x80, 0x82, 0xdd, 0x5a, 0x65, 0x1a, 0x87, 0x94, 0x71, 0xa4, 0x7e, 0x35,
+	0x5f, 0xb9, 0xde, 0xe2, 0x22, 0xe4, 0x02, 0x85, 0x22, 0x88, 0x75, 0x42, 0x11, 0x98, 0x86, 0x63,
+	0x1a, 0x1e, 0x16, 0x04, 0x75, 0x6b, 0x1e, 0x91, 0xb8, 0x86, 0x5a, 0x9c, 0x32, 0xd3, 0x9f, 0xd3,
+	0xfd, 0x3d, 0xf5, 0x86, 0xf4, 0x8b, 0x69, 0xcd, 0x06, 0x3c, 0xe0, 0xba, 0x1e, 0x3f, 0x99, 0xea,
+	0xe2, 0xe9, 0xfd, 0xb7, 0x78, 0x14, 0xeb, 0x26, 0xef, 0x1a, 0xaa, 0x7e, 0x1d, 0x03, 0xd7, 0x9a,
+	0x22, 0x70, 0x49, 0x8b, 0xd0, 0x2e, 0xd9, 0xd9, 0x79, 0xb6, 0xdb, 0x24, 0x42, 0xe0, 0x80, 0x08,
+	0xfb, 0x0e, 0x98, 0x10, 0x34, 0x60, 0x24, 0x2a, 0x5b, 0x0b, 0xd6, 0x4a, 0xb1, 0x51, 0xfe, 0xf6,
+	0x65, 0x7d, 0xd6, 0xfc, 0xef, 0xb6, 0xef, 0x47, 0x44, 0x88, 0xa7, 0x32, 0xa2, 0x2c, 0x70, 0x0d,
+	0x67, 0xaf, 0x82, 0x92, 0x8c, 0x30, 0x13, 0x2f, 0x49, 0xb4, 0x17, 0x6a, 0x99, 0xf2, 0xd8, 0x82,
+	0xb5, 0x72, 0xc5, 0x9d, 0x4a, 0xea, 0x46, 0xdd, 0xae, 0x81, 0xd9, 0x14, 0xc5, 0x52, 0x12, 0x21,
+	0xb1, 0xa4, 0x9c, 0x95, 0xf3, 0x0a, 0x9f, 0x49, 0x7a, 0xdb, 0x83, 0x96, 0x7d, 0x0b, 0x4c, 0x75,
+	0x70, 0xaf, 0xcd, 0xb1, 0x9f, 0x8a, 0x8f, 0x2b, 0xfa, 0xaa, 0x29, 0x27, 0xda, 0x08, 0xcc, 0x24,
+	0xe0, 0xb0, 0x74, 0x41, 0xc1, 0xb6, 0x69, 0x0d, 0x29, 0x6f, 0x35, 0xde, 0x9e, 0x1c, 0xac, 0x99,
+	0x43, 0xbc, 0x3f, 0x39, 0x58, 0xab, 0x9f, 0x97, 0x7c, 0xb6, 0x5b, 0xd5, 0x05, 0xe0, 0x64, 0x77,
+	0x5c, 0x22, 0x3a, 0x9c, 0x09, 0x52, 0xed, 0x8f, 0x81, 0xf9, 0x01, 0xf2, 0xa8, 0xd7, 0x21, 0x51,
+	0x1b, 0x33, 0xf2, 0x0f, 0x7e, 0xdf, 0x00, 0x20, 0xc4, 0xb4, 0xed, 0xf1, 0xfd, 0x3d, 0xea, 0x2b,
+	0xa7, 0x8b, 0x6e, 0xd1, 0x54, 0x1e, 0xfb, 0x99, 0x71, 0xe4, 0xb3, 0xe3, 0xb8, 0x0d, 0xa6, 0x87,
+	0x50, 0x89, 0x7d, 0x2c, 0xb1, 0x71, 0xb7, 0x34, 0x60, 0x75, 0x3d, 0x2b, 0x88, 0x42, 0x66, 0x10,
+	0xab, 0xa0, 0x34, 0x00, 0x8d, 0xe8, 0x84, 0xde, 0x40, 0x4a, 0xea, 0xf2, 0xd6, 0xc3, 0x3f, 0x22,
+	0xb8, 0x37, 0x5a, 0x04, 0x67, 0x5c, 0xac, 0x2e, 0x83, 0xc5, 0x73, 0xda, 0x69, 0x18, 0xbf, 0x2c,
+	0xf0, 0x7f, 0x53, 0x04, 0x4f, 0x62, 0x7d, 0xe5, 0x3c, 0x61, 0xfe, 0x48, 0xce, 0x2b, 0xce, 0xde,
+	0x04, 0xe3, 0xf1, 0x68, 0x2a, 0xcf, 0x27, 0xeb, 0x73, 0xd0, 0xc0, 0xf1, 0xec, 0x42, 0x33, 0xbb,
+	0x70, 0x87, 0x53, 0xd6, 0x28, 0x1e, 0xfe, 0xb8, 0x99, 0xfb, 0x7c, 0x72, 0xb0, 0x66, 0xb9, 0x6a,
+	0x85, 0xbd, 0x09, 0xfe, 0x33, 0x67, 0x57, 0x59, 0x4c, 0xd6, 0x1d, 0x78, 0xfa, 0x06, 0x89, 0xe7,
+	0x14, 0x76, 0x6b, 0x70, 0x57, 0x53, 0x6e, 0x82, 0x6f, 0xdd, 0xd5, 0x16, 0xa9, 0x0d, 0xc4, 0x16,
+	0x2d, 0x5d, 0x60, 0x91, 0x3a, 0x5b, 0xd5, 0x06, 0xa5, 0xe4, 0x39, 0x39, 0x7c, 0xfd, 0x43, 0x1e,
+	0xe4, 0x9b, 0x22, 0xb0, 0xdf, 0x59, 0x60, 0x26, 0x6b, 0xf2, 0x37, 0xe0, 0x39, 0x97, 0x1a, 0xcc,
+	0xfe, 0xcc, 0x2b, 0xf7, 0x2f, 0xb1, 0x28, 0xd9, 0x91, 0xfd, 0xc9, 0x02, 0xe5, 0xbf, 0x0e, 0xc6,
+	0xe6, 0x88, 0xca, 0x67, 0x56, 0x56, 0x1e, 0x5c, 0x76, 0x65, 0xba, 0xb1, 0x17, 0xa0, 0xa0, 0xbf,
+	0x91, 0xe5, 0x8b, 0xa4, 0x14, 0x56, 0x59, 0x1f, 0x09, 0x4b, 0xe4, 0x2b, 0x85, 0x37, 0xf1, 0xa7,
+	0xd1, 0x68, 0x1e, 0x1e, 0x3b, 0xd6, 0xd1, 0xb1, 0x63, 0xfd, 0x3c, 0x76, 0xac, 0x8f, 0x7d, 0x27,
+	0x77, 0xd4, 0x77, 0x72, 0xdf, 0xfb, 0x4e, 0xee, 0xf9, 0x46, 0x40, 0xe5, 0xab, 0xd7, 0x1e, 0x6c,
+	0xf1, 0x10, 0x29, 0xe5, 0x75, 0x2c, 0x04, 0x91, 0x22, 0x8d, 0xbd, 0x5b, 0x47, 0xb2, 0xd7, 0x21,
+	0x62, 0x28, 0x7f, 0x6f, 0x42, 0xdd, 0xed, 0x1b, 0xbf, 0x07, 0x00, 0x0f, 0xce, 0x8e, 0x44, 0xc2,
+	0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ReceiveCCTPMessages relays a CCTP transfer message along with the
+	// associated Orbiter payload message in the same transaction.
+	ReceiveCCTPMessages(ctx context.Context, in *MsgReceiveCCTPMessages, opts ...grpc.CallOption) (*MsgReceiveCCTPMessagesResponse, error)
+	// ReceiveHyperlaneMessages relays a Hyperlane warp transfer message along
+	// with the associated Orbiter payload message in the same transaction.
+	ReceiveHyperlaneMessages(ctx context.Context, in *MsgReceiveHyperlaneMessages, opts ...grpc.CallOption) (*MsgReceiveHyperlaneMessagesResponse, error)
+	// Orbit dispatches an Orbiter payload using funds already held
+	// on Noble by the sender.
+	Orbit(ctx context.Context, in *MsgOrbit, opts ...grpc.CallOption) (*MsgOrbitResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ReceiveCCTPMessages(ctx context.Context, in *MsgReceiveCCTPMessages, opts ...grpc.CallOption) (*MsgReceiveCCTPMessagesResponse, error) {
+	out := new(MsgReceiveCCTPMessagesResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.entrypoint.v1.Msg/ReceiveCCTPMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReceiveHyperlaneMessages(ctx context.Context, in *MsgReceiveHyperlaneMessages, opts ...grpc.CallOption) (*MsgReceiveHyperlaneMessagesResponse, error) {
+	out := new(MsgReceiveHyperlaneMessagesResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.entrypoint.v1.Msg/ReceiveHyperlaneMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Orbit(ctx context.Context, in *MsgOrbit, opts ...grpc.CallOption) (*MsgOrbitResponse, error) {
+	out := new(MsgOrbitResponse)
+	err := c.cc.Invoke(ctx, "/noble.orbiter.entrypoint.v1.Msg/Orbit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ReceiveCCTPMessages relays a CCTP transfer message along with the
+	// associated Orbiter payload message in the same transaction.
+	ReceiveCCTPMessages(context.Context, *MsgReceiveCCTPMessages) (*MsgReceiveCCTPMessagesResponse, error)
+	// ReceiveHyperlaneMessages relays a Hyperlane warp transfer message along
+	// with the associated Orbiter payload message in the same transaction.
+	ReceiveHyperlaneMessages(context.Context, *MsgReceiveHyperlaneMessages) (*MsgReceiveHyperlaneMessagesResponse, error)
+	// Orbit dispatches an Orbiter payload using funds already held
+	// on Noble by the sender.
+	Orbit(context.Context, *MsgOrbit) (*MsgOrbitResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ReceiveCCTPMessages(ctx context.Context, req *MsgReceiveCCTPMessages) (*MsgReceiveCCTPMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveCCTPMessages not implemented")
+}
+func (*UnimplementedMsgServer) ReceiveHyperlaneMessages(ctx context.Context, req *MsgReceiveHyperlaneMessages) (*MsgReceiveHyperlaneMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveHyperlaneMessages not implemented")
+}
+func (*UnimplementedMsgServer) Orbit(ctx context.Context, req *MsgOrbit) (*MsgOrbitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Orbit not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ReceiveCCTPMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReceiveCCTPMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReceiveCCTPMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.entrypoint.v1.Msg/ReceiveCCTPMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReceiveCCTPMessages(ctx, req.(*MsgReceiveCCTPMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReceiveHyperlaneMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReceiveHyperlaneMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReceiveHyperlaneMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.entrypoint.v1.Msg/ReceiveHyperlaneMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReceiveHyperlaneMessages(ctx, req.(*MsgReceiveHyperlaneMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Orbit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOrbit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Orbit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.orbiter.entrypoint.v1.Msg/Orbit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Orbit(ctx, req.(*MsgOrbit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.orbiter.entrypoint.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReceiveCCTPMessages",
+			Handler:    _Msg_ReceiveCCTPMessages_Handler,
+		},
+		{
+			MethodName: "ReceiveHyperlaneMessages",
+			Handler:    _Msg_ReceiveHyperlaneMessages_Handler,
+		},
+		{
+			MethodName: "Orbit",
+			Handler:    _Msg_Orbit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/orbiter/entrypoint/v1/tx.proto",
+}
+
+func (m *MsgReceiveCCTPMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReceiveCCTPMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReceiveCCTPMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayloadAttestation) > 0 {
+		i -= len(m.PayloadAttestation)
+		copy(dAtA[i:], m.PayloadAttestation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayloadAttestation)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PayloadMessage) > 0 {
+		i -= len(m.PayloadMessage)
+		copy(dAtA[i:], m.PayloadMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayloadMessage)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TransferAttestation) > 0 {
+		i -= len(m.TransferAttestation)
+		copy(dAtA[i:], m.TransferAttestation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferAttestation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TransferMessage) > 0 {
+		i -= len(m.TransferMessage)
+		copy(dAtA[i:], m.TransferMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReceiveCCTPMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReceiveCCTPMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReceiveCCTPMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReceiveHyperlaneMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReceiveHyperlaneMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReceiveHyperlaneMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PayloadMetadata) > 0 {
+		i -= len(m.PayloadMetadata)
+		copy(dAtA[i:], m.PayloadMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayloadMetadata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PayloadMessage) > 0 {
+		i -= len(m.PayloadMessage)
+		copy(dAtA[i:], m.PayloadMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PayloadMessage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TransferMetadata) > 0 {
+		i -= len(m.TransferMetadata)
+		copy(dAtA[i:], m.TransferMetadata)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferMetadata)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TransferMessage) > 0 {
+		i -= len(m.TransferMessage)
+		copy(dAtA[i:], m.TransferMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MailboxId) > 0 {
+		i -= len(m.MailboxId)
+		copy(dAtA[i:], m.MailboxId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MailboxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReceiveHyperlaneMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReceiveHyperlaneMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReceiveHyperlaneMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOrbit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOrbit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOrbit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOrbitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOrbitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOrbitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgReceiveCCTPMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferMessage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferAttestation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayloadMessage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayloadAttestation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReceiveCCTPMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReceiveHyperlaneMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MailboxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferMessage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TransferMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayloadMessage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PayloadMetadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReceiveHyperlaneMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgOrbit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOrbitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgReceiveCCTPMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReceiveCCTPMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReceiveCCTPMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMessage = append(m.TransferMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransferMessage == nil {
+				m.TransferMessage = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAttestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferAttestation = append(m.TransferAttestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransferAttestation == nil {
+				m.TransferAttestation = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadMessage = append(m.PayloadMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadMessage == nil {
+				m.PayloadMessage = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadAttestation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadAttestation = append(m.PayloadAttestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadAttestation == nil {
+				m.PayloadAttestation = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReceiveCCTPMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReceiveCCTPMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReceiveCCTPMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReceiveHyperlaneMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReceiveHyperlaneMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReceiveHyperlaneMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MailboxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MailboxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMessage = append(m.TransferMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransferMessage == nil {
+				m.TransferMessage = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferMetadata = append(m.TransferMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransferMetadata == nil {
+				m.TransferMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadMessage = append(m.PayloadMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadMessage == nil {
+				m.PayloadMessage = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadMetadata = append(m.PayloadMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadMetadata == nil {
+				m.PayloadMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReceiveHyperlaneMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReceiveHyperlaneMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReceiveHyperlaneMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOrbit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOrbit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOrbit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &core.Payload{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOrbitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOrbitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOrbitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -31,6 +31,7 @@ import (
 type BankKeeper interface {
 	BankKeeperForwarder
 	BankKeeperAdapter
+	BankKeeperEntrypoint
 }
 
 // BankKeeperForwarder represents the bank behavior expected
@@ -38,6 +39,19 @@ type BankKeeper interface {
 type BankKeeperForwarder interface {
 	// Queries
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
+	// Txs
+	SendCoinsFromModuleToModule(
+		ctx context.Context,
+		senderModule, recipientModule string,
+		amt sdk.Coins,
+	) error
+	SendCoinsFromModuleToAccount(
+		ctx context.Context,
+		senderModule string,
+		recipientAddr sdk.AccAddress,
+		amt sdk.Coins,
+	) error
 }
 
 // BankKeeperAdapter represents the bank behavior expected
@@ -51,4 +65,21 @@ type BankKeeperAdapter interface {
 		senderModule, recipientModule string,
 		amt sdk.Coins,
 	) error
+	SendCoinsFromModuleToAccount(
+		ctx context.Context,
+		senderModule string,
+		recipientAddr sdk.AccAddress,
+		amt sdk.Coins,
+	) error
+}
+
+// BankKeeperEntrypoint represents the bank behavior expected
+// by the Noble native entrypoint.
+type BankKeeperEntrypoint interface {
+	SendCoinsFromAccountToModule(
+		ctx context.Context,
+		senderAddr sdk.AccAddress,
+		recipientModule string,
+		amt sdk.Coins,
+	) error
 }
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+
+	forwardertypes "github.com/noble-assets/orbiter/v2/types/component/forwarder"
+)
+
+// InFlightPacketTracker defines the behavior expected to keep
+// track of the outgoing IBC packets sent by the module.
+type InFlightPacketTracker interface {
+	SetInFlightPacket(context.Context, forwardertypes.InFlightPacket) error
+}
+
+// InFlightPacketHandler defines the behavior expected to finalize
+// an in-flight packet once its lifecycle is completed.
+type InFlightPacketHandler interface {
+	// CompleteInFlightPacket removes the packet from the in-flight
+	// packets after a successful acknowledgement.
+	CompleteInFlightPacket(ctx context.Context, channelID string, sequence uint64) error
+	// RefundInFlightPacket sends back the funds of the packet to the
+	// refund recipient after an error acknowledgement or a timeout.
+	RefundInFlightPacket(
+		ctx context.Context,
+		channelID string,
+		sequence uint64,
+		reason string,
+	) error
+}
+
+// PacketEscrow defines the behavior expected to hold the packets whose
+// dispatch failed because of a paused protocol, cross-chain ID, or action.
+type PacketEscrow interface {
+	// EscrowPacket moves the packet funds to the escrow account and stores
+	// the packet in the escrow queue. It returns false when the escrow mode
+	// is disabled.
+	EscrowPacket(ctx context.Context, packet *OrbiterPacket, reason string) (bool, error)
+	// GetNextEscrowedPackets returns up to limit escrowed packets to retry,
+	// continuing after the packets returned by the previous call.
+	GetNextEscrowedPackets(
+		ctx context.Context,
+		limit int,
+	) ([]forwardertypes.EscrowedPacket, error)
+	// IsEscrowedPacketExpired returns true if the packet has been held in
+	// escrow for the maximum allowed age.
+	IsEscrowedPacketExpired(
+		ctx context.Context,
+		packet forwardertypes.EscrowedPacket,
+	) (bool, error)
+	// ReleaseEscrowedPacket removes the packet from the escrow queue and
+	// sends its funds back to the module account.
+	ReleaseEscrowedPacket(ctx context.Context, id uint64) error
+	// HoldEscrowedPacket removes the packet from the escrow queue and holds
+	// its funds in the refunds account, to be claimed by the authority. It is
+	// used for the packets without a fallback recipient which cannot be
+	// dispatched.
+	HoldEscrowedPacket(ctx context.Context, id uint64, reason string) error
+}
+
+// PacketDeferrer defines the behavior expected to hold the forwarding
+// packets whose execution is deferred to a later block.
+type PacketDeferrer interface {
+	// DeferPacket moves the packet funds to the deferred account and stores
+	// the packet in the deferred queue. The funds are sent to the fallback
+	// recipient if the forwarding fails once executed.
+	DeferPacket(ctx context.Context, packet *ForwardingPacket, fallbackRecipient string) error
+}
+
+// PacketBatcher defines the behavior expected to aggregate the forwarding
+// packets sent with a single transfer at the end of the block.
+type PacketBatcher interface {
+	// BatchPacket moves the packet funds to the batch account and adds the
+	// packet to the forwarding batch of its route. The funds are sent to the
+	// fallback recipient if the batch transfer fails.
+	BatchPacket(ctx context.Context, packet *ForwardingPacket, fallbackRecipient string) error
+}
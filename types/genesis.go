@@ -22,6 +22,7 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 
 	"github.com/noble-assets/orbiter/v2/types/component/adapter"
 	"github.com/noble-assets/orbiter/v2/types/component/dispatcher"
@@ -60,3 +61,15 @@ func (g *GenesisState) Validate() error {
 
 	return nil
 }
+
+var _ cdctypes.UnpackInterfacesMessage = &GenesisState{}
+
+// UnpackInterfaces is the method required to correctly unpack
+// the payloads of the escrowed packets.
+func (g *GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	if g.ForwarderGenesis == nil {
+		return nil
+	}
+
+	return g.ForwarderGenesis.UnpackInterfaces(unpacker)
+}
@@ -21,6 +21,8 @@
 package types
 
 import (
+	"cosmossdk.io/math"
+
 	"github.com/noble-assets/orbiter/v2/types/core"
 )
 
@@ -35,6 +37,9 @@ type OrbiterPacket struct {
 type ForwardingPacket struct {
 	TransferAttributes *core.TransferAttributes
 	Forwarding         *core.Forwarding
+	// ReservedAmount is the amount of the destination denom held by the
+	// module for the legs of a split forwarding not yet dispatched.
+	ReservedAmount math.Int
 }
 
 // NewForwardingPacket returns a pointer to a validated instance of the
@@ -48,4 +48,7 @@ type ParsedData struct {
 	Coin sdk.Coin
 	// Orbiter Payload contained in the packet.
 	Payload core.Payload
+	// CoexistingKeys are the root level keys of the packet metadata
+	// which are not consumed by the Orbiter.
+	CoexistingKeys []string
 }